@@ -0,0 +1,270 @@
+// Package assert provides assertions for tests, in the style of testify. Each
+// assertion logs a failure with Errorf and returns false if it doesn't hold, the
+// test continues. The require package has the same assertions, but stops the test.
+//
+//	func TestOrders(t *e2e.T) {
+//		resp, err := t.HTTPClient().Get(url)
+//		if !assert.NoError(t, err) {
+//			return
+//		}
+//		assert.StatusCode(t, resp, http.StatusOK)
+//	}
+package assert
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+)
+
+// TestingT is the subset of testing.TB used by assertions, it's satisfied by both
+// *e2e.T and *testing.T. Assertions call Helper so failures are reported at the line
+// of the assertion rather than inside this package.
+type TestingT interface {
+	Errorf(format string, args ...interface{})
+	Helper()
+}
+
+// Fail reports a failure, with an optional message which is formatted with fmt.Sprintf
+// if there is more than one argument
+func Fail(t TestingT, failure string, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	if msg := message(msgAndArgs); msg != "" {
+		failure = msg + ": " + failure
+	}
+	t.Errorf("%s", failure)
+	return false
+}
+
+func message(msgAndArgs []interface{}) string {
+	switch len(msgAndArgs) {
+	case 0:
+		return ""
+	case 1:
+		return fmt.Sprint(msgAndArgs[0])
+	default:
+		format, ok := msgAndArgs[0].(string)
+		if !ok {
+			return fmt.Sprint(msgAndArgs...)
+		}
+		return fmt.Sprintf(format, msgAndArgs[1:]...)
+	}
+}
+
+// Equal asserts that expected and actual are deeply equal, []byte values are compared with bytes.Equal
+func Equal(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	if equal(expected, actual) {
+		return true
+	}
+	e, a := format(expected), format(actual)
+	if d := diff(e, a); d != "" {
+		return Fail(t, "not equal:\n"+d, msgAndArgs...)
+	}
+	return Fail(t, fmt.Sprintf("expected %s, got %s", e, a), msgAndArgs...)
+}
+
+// NotEqual asserts that expected and actual are not deeply equal
+func NotEqual(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	if !equal(expected, actual) {
+		return true
+	}
+	return Fail(t, fmt.Sprintf("expected value other than %s", format(actual)), msgAndArgs...)
+}
+
+func equal(expected, actual interface{}) bool {
+	if eb, ok := expected.([]byte); ok {
+		ab, ok := actual.([]byte)
+		return ok && bytes.Equal(eb, ab)
+	}
+	return reflect.DeepEqual(expected, actual)
+}
+
+// True asserts that value is true
+func True(t TestingT, value bool, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	if value {
+		return true
+	}
+	return Fail(t, "expected true, got false", msgAndArgs...)
+}
+
+// False asserts that value is false
+func False(t TestingT, value bool, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	if !value {
+		return true
+	}
+	return Fail(t, "expected false, got true", msgAndArgs...)
+}
+
+// Nil asserts that value is nil, or a nil pointer, map, slice, chan or func
+func Nil(t TestingT, value interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	if isNil(value) {
+		return true
+	}
+	return Fail(t, fmt.Sprintf("expected nil, got %s", format(value)), msgAndArgs...)
+}
+
+// NotNil asserts that value is not nil
+func NotNil(t TestingT, value interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	if !isNil(value) {
+		return true
+	}
+	return Fail(t, "expected value not to be nil", msgAndArgs...)
+}
+
+func isNil(value interface{}) bool {
+	if value == nil {
+		return true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Map, reflect.Slice, reflect.Chan, reflect.Func, reflect.Interface:
+		return v.IsNil()
+	}
+	return false
+}
+
+// NoError asserts that err is nil
+func NoError(t TestingT, err error, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	if err == nil {
+		return true
+	}
+	return Fail(t, fmt.Sprintf("unexpected error: %v", err), msgAndArgs...)
+}
+
+// Error asserts that err is not nil
+func Error(t TestingT, err error, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	if err != nil {
+		return true
+	}
+	return Fail(t, "expected an error, got nil", msgAndArgs...)
+}
+
+// Contains asserts that s contains element, s can be a string, in which case
+// element is a substring, a slice or array, or a map, in which case element is a key
+func Contains(t TestingT, s, element interface{}, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	ok, found := contains(s, element)
+	if !ok {
+		return Fail(t, fmt.Sprintf("%s can't contain elements", format(s)), msgAndArgs...)
+	}
+	if found {
+		return true
+	}
+	return Fail(t, fmt.Sprintf("%s does not contain %s", format(s), format(element)), msgAndArgs...)
+}
+
+// contains reports whether s contains element, ok is false if s isn't a type that can contain elements
+func contains(s, element interface{}) (ok, found bool) {
+	v := reflect.ValueOf(s)
+	switch v.Kind() {
+	case reflect.String:
+		e, isString := element.(string)
+		return true, isString && strings.Contains(v.String(), e)
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			if equal(v.Index(i).Interface(), element) {
+				return true, true
+			}
+		}
+		return true, false
+	case reflect.Map:
+		for _, k := range v.MapKeys() {
+			if equal(k.Interface(), element) {
+				return true, true
+			}
+		}
+		return true, false
+	}
+	return false, false
+}
+
+// JSONEq asserts that expected and actual are equivalent JSON documents,
+// ignoring differences in white space and the order of object keys
+func JSONEq(t TestingT, expected, actual string, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	var e, a interface{}
+	if err := json.Unmarshal([]byte(expected), &e); err != nil {
+		return Fail(t, fmt.Sprintf("expected is not valid JSON: %v", err), msgAndArgs...)
+	}
+	if err := json.Unmarshal([]byte(actual), &a); err != nil {
+		return Fail(t, fmt.Sprintf("actual is not valid JSON: %v\n%s", err, actual), msgAndArgs...)
+	}
+	if reflect.DeepEqual(e, a) {
+		return true
+	}
+	eb, _ := json.MarshalIndent(e, "", "  ")
+	ab, _ := json.MarshalIndent(a, "", "  ")
+	if d := diff(string(eb), string(ab)); d != "" {
+		return Fail(t, "JSON not equal:\n"+d, msgAndArgs...)
+	}
+	return Fail(t, fmt.Sprintf("expected JSON %s, got %s", eb, ab), msgAndArgs...)
+}
+
+// maxBodySnippet is the amount of a response body included in a StatusCode failure
+const maxBodySnippet = 1024
+
+// StatusCode asserts that the response has the expected status code, if it doesn't
+// the start of the response body is included in the failure
+func StatusCode(t TestingT, resp *http.Response, expected int, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	if resp == nil {
+		return Fail(t, fmt.Sprintf("expected status %d, got nil response", expected), msgAndArgs...)
+	}
+	if resp.StatusCode == expected {
+		return true
+	}
+	failure := fmt.Sprintf("expected status %d %s, got %s", expected, http.StatusText(expected), resp.Status)
+	if resp.Body != nil {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxBodySnippet))
+		// put the read part of the body back so the test can still use it
+		resp.Body = struct {
+			io.Reader
+			io.Closer
+		}{io.MultiReader(bytes.NewReader(body), resp.Body), resp.Body}
+		if len(body) > 0 {
+			failure += "\n" + string(body)
+		}
+	}
+	return Fail(t, failure, msgAndArgs...)
+}
+
+// Eventually asserts that condition returns true within waitFor, it's called
+// every tick until it does. If t has a Context, as *e2e.T does, Eventually
+// gives up once it's done, so it doesn't outlive the test's timeout.
+func Eventually(t TestingT, condition func() bool, waitFor, tick time.Duration, msgAndArgs ...interface{}) bool {
+	t.Helper()
+	ctx := context.Background()
+	if c, ok := t.(interface{ Context() context.Context }); ok {
+		ctx = c.Context()
+	}
+	timeout := time.NewTimer(waitFor)
+	defer timeout.Stop()
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+	for {
+		if condition() {
+			return true
+		}
+		select {
+		case <-timeout.C:
+			return Fail(t, fmt.Sprintf("condition not satisfied within %s", waitFor), msgAndArgs...)
+		case <-ctx.Done():
+			return Fail(t, fmt.Sprintf("condition not satisfied before the test finished: %v", ctx.Err()), msgAndArgs...)
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,138 @@
+package assert_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arussellsaw/e2e"
+	"github.com/arussellsaw/e2e/assert"
+)
+
+// recorder records the failures of assertions
+type recorder struct {
+	failures []string
+}
+
+func (r *recorder) Errorf(format string, args ...interface{}) {
+	r.failures = append(r.failures, fmt.Sprintf(format, args...))
+}
+
+func (r *recorder) Helper() {}
+
+func TestAssertions(t *testing.T) {
+	type order struct {
+		ID    int
+		Items []string
+	}
+	tc := []struct {
+		name   string
+		assert func(t assert.TestingT) bool
+		ok     bool
+		failed string
+	}{
+		{"equal", func(t assert.TestingT) bool { return assert.Equal(t, 1, 1) }, true, ""},
+		{"equal bytes", func(t assert.TestingT) bool { return assert.Equal(t, []byte("a"), []byte("a")) }, true, ""},
+		{"not equal", func(t assert.TestingT) bool { return assert.Equal(t, "a", "b") }, false, `expected "a", got "b"`},
+		{"not equal with message", func(t assert.TestingT) bool { return assert.Equal(t, 1, 2, "order %d", 7) }, false, "order 7: expected 1, got 2"},
+		{"not equal diff", func(t assert.TestingT) bool {
+			return assert.Equal(t, order{1, []string{"a", "b"}}, order{1, []string{"a", "c"}})
+		}, false, "-     \"b\"\n+     \"c\""},
+		{"no error", func(t assert.TestingT) bool { return assert.NoError(t, nil) }, true, ""},
+		{"error", func(t assert.TestingT) bool { return assert.NoError(t, io.EOF) }, false, "unexpected error: EOF"},
+		{"nil pointer", func(t assert.TestingT) bool { return assert.Nil(t, (*order)(nil)) }, true, ""},
+		{"contains string", func(t assert.TestingT) bool { return assert.Contains(t, "hello world", "world") }, true, ""},
+		{"contains slice", func(t assert.TestingT) bool { return assert.Contains(t, []int{1, 2}, 3) }, false, "does not contain 3"},
+		{"contains map", func(t assert.TestingT) bool { return assert.Contains(t, map[string]int{"a": 1}, "a") }, true, ""},
+		{"contains invalid", func(t assert.TestingT) bool { return assert.Contains(t, 1, 1) }, false, "can't contain elements"},
+		{"json equal", func(t assert.TestingT) bool { return assert.JSONEq(t, `{"a":1,"b":[1,2]}`, `{"b": [1, 2], "a": 1}`) }, true, ""},
+		{"json not equal", func(t assert.TestingT) bool { return assert.JSONEq(t, `{"a":1,"b":2}`, `{"a":1,"b":3}`) }, false, "-   \"b\": 2\n+   \"b\": 3"},
+		{"json invalid", func(t assert.TestingT) bool { return assert.JSONEq(t, `{}`, `{`) }, false, "actual is not valid JSON"},
+	}
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			r := &recorder{}
+			if ok := tt.assert(r); ok != tt.ok {
+				t.Errorf("Expected %v, got %v", tt.ok, ok)
+			}
+			if tt.ok {
+				if len(r.failures) != 0 {
+					t.Errorf("Expected no failures, got %q", r.failures)
+				}
+				return
+			}
+			if len(r.failures) != 1 || !strings.Contains(r.failures[0], tt.failed) {
+				t.Errorf("Expected failure containing %q, got %q", tt.failed, r.failures)
+			}
+		})
+	}
+}
+
+func TestStatusCode(t *testing.T) {
+	resp := &http.Response{
+		StatusCode: 500,
+		Status:     "500 Internal Server Error",
+		Body:       io.NopCloser(strings.NewReader("database unavailable")),
+	}
+	r := &recorder{}
+	assert.StatusCode(r, resp, http.StatusOK)
+	if len(r.failures) != 1 || !strings.Contains(r.failures[0], "expected status 200 OK, got 500 Internal Server Error\ndatabase unavailable") {
+		t.Errorf("Unexpected failures %q", r.failures)
+	}
+	body, _ := io.ReadAll(resp.Body)
+	if string(body) != "database unavailable" {
+		t.Errorf("Expected body to still be readable, got %q", body)
+	}
+}
+
+func TestEventually(t *testing.T) {
+	var calls int
+	r := &recorder{}
+	if !assert.Eventually(r, func() bool { calls++; return calls == 3 }, time.Second, time.Millisecond) {
+		t.Errorf("Expected condition to be satisfied, got %q", r.failures)
+	}
+	if assert.Eventually(r, func() bool { return false }, 10*time.Millisecond, time.Millisecond) {
+		t.Error("Expected condition not to be satisfied")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+	cr := &contextRecorder{recorder: r, ctx: ctx}
+	start := time.Now()
+	if assert.Eventually(cr, func() bool { return false }, time.Minute, time.Millisecond) {
+		t.Error("Expected condition not to be satisfied")
+	}
+	if elapsed := time.Since(start); elapsed > time.Second {
+		t.Errorf("Expected Eventually to stop with the test's context, took %s", elapsed)
+	}
+}
+
+// contextRecorder is a recorder with a Context, like *e2e.T
+type contextRecorder struct {
+	*recorder
+	ctx context.Context
+}
+
+func (cr *contextRecorder) Context() context.Context {
+	return cr.ctx
+}
+
+func TestHelperLine(t *testing.T) {
+	var line int
+	et := e2e.Run("test", func(t *e2e.T) {
+		_, _, line, _ = runtime.Caller(0)
+		assert.Equal(t, 1, 2)
+	})
+	records := et.Records()
+	if len(records) != 1 {
+		t.Fatalf("Expected 1 record, got %d", len(records))
+	}
+	if records[0].File != "assert_test.go" || records[0].Line != line+1 {
+		t.Errorf("Expected failure at assert_test.go:%d, got %s:%d", line+1, records[0].File, records[0].Line)
+	}
+}
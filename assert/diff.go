@@ -0,0 +1,66 @@
+package assert
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+)
+
+// format renders a value for a failure message, structs, maps and slices are
+// rendered as indented JSON so they can be diffed line by line
+func format(v interface{}) string {
+	if v == nil {
+		return "<nil>"
+	}
+	switch reflect.ValueOf(v).Kind() {
+	case reflect.Struct, reflect.Map, reflect.Slice, reflect.Array, reflect.Ptr:
+		if b, err := json.MarshalIndent(v, "", "  "); err == nil {
+			return fmt.Sprintf("%T%s", v, b)
+		}
+	case reflect.String:
+		return fmt.Sprintf("%q", v)
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
+// diff gives a line diff of expected and actual, or an empty string if they're
+// single lines, where the values in the message are enough to spot the difference
+func diff(expected, actual string) string {
+	a, b := strings.Split(expected, "\n"), strings.Split(actual, "\n")
+	if len(a) == 1 && len(b) == 1 {
+		return ""
+	}
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else {
+				lcs[i][j] = max(lcs[i+1][j], lcs[i][j+1])
+			}
+		}
+	}
+	var sb strings.Builder
+	sb.WriteString("--- expected\n+++ actual\n")
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			sb.WriteString("  " + a[i] + "\n")
+			i++
+			j++
+		case j < len(b) && (i == len(a) || lcs[i][j+1] > lcs[i+1][j]):
+			sb.WriteString("+ " + b[j] + "\n")
+			j++
+		default:
+			sb.WriteString("- " + a[i] + "\n")
+			i++
+		}
+	}
+	return sb.String()
+}
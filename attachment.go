@@ -0,0 +1,194 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"mime"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+const (
+	// MaxAttachmentSize is the largest attachment that is stored, larger
+	// attachments are truncated
+	MaxAttachmentSize = 1 << 20
+	// MaxRunAttachmentSize is the total size of attachments stored for a test
+	// and its subtests, attachments beyond this are dropped
+	MaxRunAttachmentSize = 8 << 20
+	// DefaultArtifactRetention is how long attachments are kept in the history
+	// if the Runner doesn't set ArtifactRetention
+	DefaultArtifactRetention = 24 * time.Hour
+)
+
+// Attachment is a file saved by a test with Attach, such as a response body or a screenshot
+type Attachment struct {
+	Name string
+	// Test is the full name of the test or subtest that added the attachment
+	Test        string
+	ContentType string
+	// Size is the size of the data as passed to Attach, before any truncation
+	Size      int
+	Truncated bool `json:",omitempty"`
+	Time      time.Time
+	Data      []byte `json:"-"`
+}
+
+// Attach saves data with the result of the test, it can be downloaded from
+// /api/runs/{id}/artifacts/{test}/{name} while the run is in the history, where
+// test is the full name of the test or subtest, e.g. Parent/child. Attaching
+// the same name twice replaces the earlier attachment. Attachments over
+// MaxAttachmentSize are truncated, and once a test and its subtests have
+// attached MaxRunAttachmentSize bytes further attachments are dropped.
+func (t *T) Attach(name, contentType string, data []byte) {
+	a := Attachment{
+		Name:        name,
+		ContentType: contentType,
+		Size:        len(data),
+		Time:        time.Now(),
+	}
+	if len(data) > MaxAttachmentSize {
+		data = data[:MaxAttachmentSize]
+		a.Truncated = true
+	}
+	a.Data = append([]byte(nil), data...)
+
+	// the size of every attachment is counted on the root test, it's locked
+	// for the whole update so a replaced attachment is only discounted once
+	root := t
+	for root.parent != nil {
+		root = root.parent
+	}
+	root.mu.Lock()
+	if t != root {
+		t.mu.Lock()
+	}
+	replace := -1
+	size := root.attachmentBytes + len(a.Data)
+	for i, existing := range t.attachments {
+		if existing.Name == name {
+			replace = i
+			size -= len(existing.Data)
+		}
+	}
+	ok := size <= MaxRunAttachmentSize
+	if ok {
+		root.attachmentBytes = size
+		a.Test = t.fullName()
+		if replace >= 0 {
+			t.attachments[replace] = a
+		} else {
+			t.attachments = append(t.attachments, a)
+		}
+	}
+	if t != root {
+		t.mu.Unlock()
+	}
+	root.mu.Unlock()
+	if !ok {
+		t.log(slog.LevelWarn, fmt.Sprintf("attachment %q dropped, test exceeded %d bytes of attachments", name, MaxRunAttachmentSize))
+		return
+	}
+	if a.Truncated {
+		t.log(slog.LevelWarn, fmt.Sprintf("attachment %q truncated from %d to %d bytes", name, a.Size, MaxAttachmentSize))
+	}
+}
+
+// Attachments returns the attachments of the test and its subtests
+func (t *T) Attachments() []Attachment {
+	t.mu.RLock()
+	attachments := append([]Attachment(nil), t.attachments...)
+	subTests := t.subTests
+	t.mu.RUnlock()
+	for _, st := range subTests {
+		attachments = append(attachments, st.Attachments()...)
+	}
+	return attachments
+}
+
+// expireArtifacts drops the attachments of runs that finished longer than the
+// retention period ago. This must be called with r.mu held.
+func (r *Runner) expireArtifacts(now time.Time) {
+	retention := r.ArtifactRetention
+	if retention == 0 {
+		retention = DefaultArtifactRetention
+	}
+	for _, runs := range r.history {
+		for i := range runs {
+			if runs[i].Attachments != nil && now.Sub(runs[i].Start.Add(runs[i].Duration)) > retention {
+				runs[i].Attachments = nil
+			}
+		}
+	}
+}
+
+// newRunID returns a unique ID for a run of a test
+func (r *Runner) newRunID() string {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.lastRunID++
+	return strconv.FormatUint(r.lastRunID, 10)
+}
+
+// Run returns the run with the given ID from the history
+func (r *Runner) Run(id string) (RunRecord, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, runs := range r.history {
+		for _, rec := range runs {
+			if rec.ID == id {
+				return rec, true
+			}
+		}
+	}
+	return RunRecord{}, false
+}
+
+// RunHandler writes a run from the history as JSON, including the list of its attachments
+func (r *Runner) RunHandler(w http.ResponseWriter, req *http.Request) {
+	rec, ok := r.Run(mux.Vars(req)["id"])
+	if !ok {
+		http.Error(w, "404 not found", http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(rec)
+}
+
+// ArtifactHandler serves an attachment of a run in the history, the attachment is
+// identified by the full name of the test that added it and its name, e.g.
+// /api/runs/1/artifacts/Parent/child/body.json. Attachments hold whatever a test
+// recorded, such as the body of a response, so they are always served as a
+// download and sandboxed, rather than rendered as part of the dashboard's origin.
+func (r *Runner) ArtifactHandler(w http.ResponseWriter, req *http.Request) {
+	vars := mux.Vars(req)
+	rec, ok := r.Run(vars["id"])
+	if !ok {
+		http.Error(w, "404 not found (no run with id)", http.StatusNotFound)
+		return
+	}
+	for _, a := range rec.Attachments {
+		if a.Test+"/"+a.Name != vars["path"] {
+			continue
+		}
+		contentType := a.ContentType
+		if contentType == "" {
+			contentType = "application/octet-stream"
+		}
+		disposition := mime.FormatMediaType("attachment", map[string]string{"filename": a.Name})
+		if disposition == "" {
+			disposition = "attachment"
+		}
+		w.Header().Set("Content-Type", contentType)
+		w.Header().Set("Content-Disposition", disposition)
+		w.Header().Set("X-Content-Type-Options", "nosniff")
+		w.Header().Set("Content-Security-Policy", "sandbox")
+		w.Header().Set("Content-Length", strconv.Itoa(len(a.Data)))
+		w.Write(a.Data)
+		return
+	}
+	http.Error(w, "404 not found (no attachment with name, it may have expired)", http.StatusNotFound)
+}
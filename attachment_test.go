@@ -0,0 +1,119 @@
+package e2e
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+func TestAttachmentArtifacts(t *testing.T) {
+	r := &Runner{}
+	n := &testNotifier{}
+	tr := &testRunner{
+		Name: "test",
+		t: func(t *T) {
+			t.Attach("body.json", "application/json", []byte(`{"ok":false}`))
+			t.Run("child", func(t *T) {
+				t.Attach("large.bin", "application/octet-stream", make([]byte, MaxAttachmentSize+1))
+				t.Attach("body.json", "application/json", []byte(`{"child":true}`))
+			})
+			t.Error("failed")
+		},
+		n: n,
+	}
+	r.tests = map[string]*testRunner{tr.Name: tr}
+	r.runTest(tr)
+
+	runs := r.History("test")
+	if len(runs) != 1 || runs[0].ID == "" {
+		t.Fatalf("Expected a run with an ID, got %+v", runs)
+	}
+	rec := runs[0]
+	if n.n.RunID != rec.ID {
+		t.Errorf("Expected notification for run %q, got %q", rec.ID, n.n.RunID)
+	}
+	if len(rec.Attachments) != 3 {
+		t.Fatalf("Expected 3 attachments, got %d", len(rec.Attachments))
+	}
+	large := rec.Attachments[1]
+	if large.Test != "test/child" || !large.Truncated || len(large.Data) != MaxAttachmentSize {
+		t.Errorf("Expected truncated attachment from test/child, got %q %v %d", large.Test, large.Truncated, len(large.Data))
+	}
+
+	for path, expected := range map[string]string{
+		"test/body.json":       `{"ok":false}`,
+		"test/child/body.json": `{"child":true}`,
+	} {
+		w := httptest.NewRecorder()
+		r.Mux().ServeHTTP(w, httptest.NewRequest("GET", "/api/runs/"+rec.ID+"/artifacts/"+path, nil))
+		if w.Code != 200 {
+			t.Fatalf("%s: expected 200, got %d", path, w.Code)
+		}
+		if ct := w.Header().Get("Content-Type"); ct != "application/json" {
+			t.Errorf("%s: expected %q, got %q", path, "application/json", ct)
+		}
+		for header, value := range map[string]string{
+			"Content-Disposition":     "attachment; filename=body.json",
+			"X-Content-Type-Options":  "nosniff",
+			"Content-Security-Policy": "sandbox",
+		} {
+			if v := w.Header().Get(header); v != value {
+				t.Errorf("%s: expected %s %q, got %q", path, header, value, v)
+			}
+		}
+		if !bytes.Equal(w.Body.Bytes(), []byte(expected)) {
+			t.Errorf("%s: unexpected body %q", path, w.Body.String())
+		}
+	}
+
+	w := httptest.NewRecorder()
+	r.Mux().ServeHTTP(w, httptest.NewRequest("GET", "/api/runs/"+rec.ID+"/artifacts/test/missing", nil))
+	if w.Code != 404 {
+		t.Errorf("Expected 404, got %d", w.Code)
+	}
+
+	r.mu.Lock()
+	r.expireArtifacts(time.Now().Add(DefaultArtifactRetention + time.Minute))
+	r.mu.Unlock()
+	if rec, _ := r.Run(rec.ID); rec.Attachments != nil {
+		t.Errorf("Expected attachments to expire, got %d", len(rec.Attachments))
+	}
+	if tr.lastRun.Attachments != nil {
+		t.Error("Expected the latest run not to hold the attachments")
+	}
+}
+
+func TestAttachmentReplace(t *testing.T) {
+	tt := &T{name: "test"}
+	doRun("test", func(t *T) {
+		for i := 0; i < MaxRunAttachmentSize/MaxAttachmentSize+1; i++ {
+			t.Attach("data", "", make([]byte, MaxAttachmentSize))
+		}
+		t.Attach("small", "", []byte("ok"))
+	}, tt)
+	if n := len(tt.Attachments()); n != 2 {
+		t.Errorf("Expected replaced attachments not to count towards the limit, got %d attachments", n)
+	}
+	if tt.attachmentBytes != MaxAttachmentSize+2 {
+		t.Errorf("Expected %d bytes of attachments, got %d", MaxAttachmentSize+2, tt.attachmentBytes)
+	}
+}
+
+func TestAttachmentRunLimit(t *testing.T) {
+	tt := &T{name: "test"}
+	doRun("test", func(t *T) {
+		for i := 0; i < MaxRunAttachmentSize/MaxAttachmentSize+1; i++ {
+			t.Run("child", func(t *T) {
+				t.Attach("data", "", make([]byte, MaxAttachmentSize))
+			})
+		}
+	}, tt)
+	var size int
+	for _, a := range tt.Attachments() {
+		size += len(a.Data)
+	}
+	if size != MaxRunAttachmentSize {
+		t.Errorf("Expected %d bytes of attachments, got %d", MaxRunAttachmentSize, size)
+	}
+}
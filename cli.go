@@ -0,0 +1,351 @@
+package e2e
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+)
+
+// RunOnce runs the tests in the registry a single time, rather than on a schedule,
+// this allows the same tests to be run from CI or a laptop. args are parsed as a
+// subset of the flags accepted by go test:
+//
+//	-run regexp     only run tests and subtests matching the regexp, split by '/' for each level of subtest
+//	-tags list      only run tests with one of the comma separated tags
+//	-v              verbose output, log all tests as they are run
+//	-json           write test2json events, as with go test -json
+//	-tap            write TAP version 14 output
+//	-junit file     write a JUnit XML report to file
+//	-count n        run each test n times
+//	-timeout d      fail if the tests take longer than d
+//
+// Output is written to w in the same format as go test, and the returned
+// exit code is non-zero if any test failed. Tests are run with the options they
+// were registered with, a Runner's Config isn't loaded so its overrides, such as
+// timeouts and disabled tests, don't apply.
+func RunOnce(reg *Registry, args []string, w io.Writer) int {
+	fs := flag.NewFlagSet("run", flag.ContinueOnError)
+	fs.SetOutput(w)
+	var (
+		run     = fs.String("run", "", "only run tests matching `regexp`")
+		tags    = fs.String("tags", "", "only run tests with one of these comma separated `tags`")
+		verbose = fs.Bool("v", false, "verbose: print output for all tests as they are run")
+		jsonOut = fs.Bool("json", false, "write test2json events instead of text output")
+		tap     = fs.Bool("tap", false, "write TAP version 14 output instead of text output")
+		junit   = fs.String("junit", "", "write a JUnit XML report to `file`")
+		count   = fs.Int("count", 1, "run each test `n` times")
+		timeout = fs.Duration("timeout", 10*time.Minute, "fail if the tests run for longer than `d`, 0 to disable")
+	)
+	if err := fs.Parse(args); err != nil {
+		return 2
+	}
+	filters, err := compileRunFilter(*run)
+	if err != nil {
+		fmt.Fprintf(w, "invalid value %q for flag -run: %v\n", *run, err)
+		return 2
+	}
+	var f Filter
+	if len(filters) > 0 {
+		f.Include = filters[0]
+		filters = filters[1:]
+	}
+	if *tags != "" {
+		f.Tags = strings.Split(*tags, ",")
+	}
+
+	ctx := context.Background()
+	if *timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, *timeout)
+		defer cancel()
+	}
+	var rep runReporter = &textReporter{w: w, verbose: *verbose}
+	switch {
+	case *jsonOut && *tap:
+		fmt.Fprintln(w, "flags -json and -tap can't be used together")
+		return 2
+	case *jsonOut:
+		rep = newJSONReporter(w, filepath.Base(os.Args[0]))
+	case *tap:
+		rep = newTAPReporter(w)
+	}
+	start := time.Now()
+	var (
+		mu      sync.Mutex
+		results []junitTestSuite
+	)
+	testRep := &detachableReporter{rep: rep}
+	done := make(chan bool, 1)
+	go func() {
+		failed := false
+		for i := 0; i < *count; i++ {
+			for _, reg := range reg.Tests(f) {
+				if ctx.Err() != nil {
+					done <- true
+					return
+				}
+				t := runRegistration(ctx, reg, filters, testRep)
+				if t.Failed() {
+					failed = true
+				}
+				mu.Lock()
+				results = append(results, junitSuite(t.Result()))
+				mu.Unlock()
+			}
+		}
+		done <- failed
+	}()
+
+	var (
+		failed   bool
+		timedOut error
+	)
+	select {
+	case failed = <-done:
+	case <-ctx.Done():
+		timedOut = fmt.Errorf("test timed out after %s", *timeout)
+		failed = true
+		// give the running test a chance to return now its context is done,
+		// then stop it writing any more output after the summary
+		select {
+		case <-done:
+		case <-time.After(timeoutGrace):
+		}
+		testRep.detach()
+	}
+	rep.summary(failed, time.Since(start), timedOut)
+	if *junit != "" {
+		mu.Lock()
+		err := writeJUnitFile(*junit, results)
+		mu.Unlock()
+		if err != nil {
+			fmt.Fprintf(w, "writing junit report: %v\n", err)
+			return 1
+		}
+	}
+	if failed {
+		return 1
+	}
+	return 0
+}
+
+// timeoutGrace is how long RunOnce waits for a running test to return once
+// the -timeout has passed, before writing the summary without it
+var timeoutGrace = 5 * time.Second
+
+// detachableReporter forwards to a reporter until it is detached, so a test that
+// ignores its context can't write output once a timed out run has finished
+type detachableReporter struct {
+	mu       sync.Mutex
+	rep      reporter
+	detached bool
+}
+
+func (dr *detachableReporter) started(t *T) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	if !dr.detached {
+		dr.rep.started(t)
+	}
+}
+
+func (dr *detachableReporter) logged(t *T, s string) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	if !dr.detached {
+		dr.rep.logged(t, s)
+	}
+}
+
+func (dr *detachableReporter) finished(t *T) {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	if !dr.detached {
+		dr.rep.finished(t)
+	}
+}
+
+// detach stops any further calls to the reporter, it waits for calls in progress to return
+func (dr *detachableReporter) detach() {
+	dr.mu.Lock()
+	defer dr.mu.Unlock()
+	dr.detached = true
+}
+
+// runReporter is a reporter for a whole run of tests from the command line
+type runReporter interface {
+	reporter
+	// summary is called once all tests have finished, timedOut is
+	// set if the tests didn't finish before the -timeout flag
+	summary(failed bool, elapsed time.Duration, timedOut error)
+}
+
+func writeJUnitFile(path string, suites []junitTestSuite) error {
+	f, err := os.Create(path)
+	if err != nil {
+		return err
+	}
+	if err := writeJUnit(f, suites); err != nil {
+		f.Close()
+		return err
+	}
+	return f.Close()
+}
+
+// runRegistration runs a registered test once, applying the timeout from its options,
+// the test fails if it returns after its timeout as it would on a schedule
+func runRegistration(ctx context.Context, reg Registration, filters []*regexp.Regexp, rep reporter) *T {
+	tr := &testRunner{}
+	for _, opt := range reg.Options {
+		opt(tr)
+	}
+	test := reg.Test
+	if tr.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, tr.timeout)
+		defer cancel()
+		test = failOnDeadline(ctx, tr.timeout, test)
+	}
+	t := &T{
+		name:     reg.Name,
+		ctx:      ctx,
+		filter:   filters,
+		reporter: rep,
+	}
+	doRun(reg.Name, test, t)
+	return t
+}
+
+// compileRunFilter splits a -run pattern into a regexp for each level of subtest,
+// slashes inside brackets or parentheses don't split the pattern
+func compileRunFilter(pattern string) ([]*regexp.Regexp, error) {
+	if pattern == "" {
+		return nil, nil
+	}
+	var (
+		parts []string
+		depth int
+		start int
+	)
+	for i := 0; i < len(pattern); i++ {
+		switch pattern[i] {
+		case '[', '(':
+			depth++
+		case ']', ')':
+			depth--
+		case '\\':
+			i++
+		case '/':
+			if depth == 0 {
+				parts = append(parts, pattern[start:i])
+				start = i + 1
+			}
+		}
+	}
+	parts = append(parts, pattern[start:])
+	filters := make([]*regexp.Regexp, 0, len(parts))
+	for _, p := range parts {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, err
+		}
+		filters = append(filters, re)
+	}
+	return filters, nil
+}
+
+// textReporter writes test results in the same format as go test
+type textReporter struct {
+	w       io.Writer
+	verbose bool
+
+	mu sync.Mutex
+}
+
+func (tr *textReporter) started(t *T) {
+	if !tr.verbose {
+		return
+	}
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	fmt.Fprintf(tr.w, "=== RUN   %s\n", t.fullName())
+}
+
+func (tr *textReporter) logged(t *T, s string) {
+	if !tr.verbose {
+		return
+	}
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	io.WriteString(tr.w, indent(s, t.depth()))
+}
+
+func (tr *textReporter) finished(t *T) {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tr.verbose {
+		fmt.Fprintln(tr.w, resultLine(t))
+		return
+	}
+	if t.parent == nil && t.Failed() {
+		tr.writeFailure(t)
+	}
+}
+
+func (tr *textReporter) summary(failed bool, elapsed time.Duration, timedOut error) {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if timedOut != nil {
+		fmt.Fprintf(tr.w, "panic: %v\n", timedOut)
+	}
+	if failed {
+		fmt.Fprintln(tr.w, "FAIL")
+		return
+	}
+	fmt.Fprintln(tr.w, "PASS")
+}
+
+// writeFailure writes the result and output of a failed test and its failed subtests,
+// this must be called with tr.mu held
+func (tr *textReporter) writeFailure(t *T) {
+	fmt.Fprintln(tr.w, resultLine(t))
+	t.mu.RLock()
+	subTests := t.subTests
+	t.mu.RUnlock()
+	io.WriteString(tr.w, indent(t.logs(), t.depth()))
+	for _, st := range subTests {
+		if st.Failed() {
+			tr.writeFailure(st)
+		}
+	}
+}
+
+// resultLine gives the indented --- PASS/FAIL/SKIP line for a finished test
+func resultLine(t *T) string {
+	return strings.Repeat("    ", t.depth()) + t.resultHeader()
+}
+
+// indent adds depth levels of indentation to every line of s, and expands the
+// leading tabs added by decorate to spaces to match the output of go test
+func indent(s string, depth int) string {
+	if s == "" {
+		return s
+	}
+	prefix := strings.Repeat("    ", depth)
+	lines := strings.SplitAfter(s, "\n")
+	for i, line := range lines {
+		if line == "" {
+			continue
+		}
+		trimmed := strings.TrimLeft(line, "\t")
+		lines[i] = prefix + strings.Repeat("    ", len(line)-len(trimmed)) + trimmed
+	}
+	return strings.Join(lines, "")
+}
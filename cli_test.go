@@ -0,0 +1,201 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func testRegistry() *Registry {
+	reg := &Registry{}
+	reg.Register("TestPass", func(t *T) {
+		t.Log("passing")
+	})
+	reg.Register("TestSubtests", func(t *T) {
+		t.Run("pass", func(t *T) {})
+		t.Run("fail", func(t *T) {
+			t.Error("failing")
+		})
+	})
+	return reg
+}
+
+func TestRunOnce(t *testing.T) {
+	var buf bytes.Buffer
+	code := RunOnce(testRegistry(), nil, &buf)
+	if code != 1 {
+		t.Errorf("Expected exit code 1, got %d", code)
+	}
+	out := buf.String()
+	for _, expected := range []string{
+		"--- FAIL: TestSubtests (",
+		"    --- FAIL: TestSubtests/fail (",
+		"        cli_test.go:",
+		": failing\n",
+		"FAIL\n",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("Expected output to contain %q, got %q", expected, out)
+		}
+	}
+	if strings.Contains(out, "passing") {
+		t.Errorf("Expected passing test output to be omitted without -v, got %q", out)
+	}
+}
+
+func TestRunOnceVerboseFilter(t *testing.T) {
+	var buf bytes.Buffer
+	code := RunOnce(testRegistry(), []string{"-v", "-count", "2", "-run", "Subtests/pass"}, &buf)
+	if code != 0 {
+		t.Errorf("Expected exit code 0, got %d: %s", code, buf.String())
+	}
+	out := buf.String()
+	if n := strings.Count(out, "=== RUN   TestSubtests/pass\n"); n != 2 {
+		t.Errorf("Expected subtest to run twice, ran %d times: %q", n, out)
+	}
+	for _, unexpected := range []string{"TestPass", "TestSubtests/fail"} {
+		if strings.Contains(out, unexpected) {
+			t.Errorf("Expected %s to be filtered out, got %q", unexpected, out)
+		}
+	}
+	if !strings.HasSuffix(out, "PASS\n") {
+		t.Errorf("Expected output to end with PASS, got %q", out)
+	}
+}
+
+func TestCompileRunFilter(t *testing.T) {
+	filters, err := compileRunFilter("Checkout/[a/b]/.*")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(filters) != 3 {
+		t.Fatalf("Expected 3 filters, got %d", len(filters))
+	}
+	if filters[1].String() != "[a/b]" {
+		t.Errorf("Expected %q, got %q", "[a/b]", filters[1].String())
+	}
+}
+
+func TestRunOnceJSON(t *testing.T) {
+	var buf bytes.Buffer
+	code := RunOnce(testRegistry(), []string{"-json", "-run", "TestSubtests"}, &buf)
+	if code != 1 {
+		t.Errorf("Expected exit code 1, got %d", code)
+	}
+	var actions []string
+	dec := json.NewDecoder(&buf)
+	for dec.More() {
+		var e testEvent
+		if err := dec.Decode(&e); err != nil {
+			t.Fatal(err)
+		}
+		if e.Time == nil {
+			t.Errorf("Expected event to have a time: %+v", e)
+		}
+		if e.Action != "output" {
+			actions = append(actions, e.Action+" "+e.Test)
+		}
+	}
+	expected := []string{
+		"run TestSubtests",
+		"run TestSubtests/pass",
+		"pass TestSubtests/pass",
+		"run TestSubtests/fail",
+		"fail TestSubtests/fail",
+		"fail TestSubtests",
+		"fail ",
+	}
+	if !reflect.DeepEqual(actions, expected) {
+		t.Errorf("Expected %q, got %q", expected, actions)
+	}
+}
+
+func TestRunOnceTAP(t *testing.T) {
+	reg := testRegistry()
+	reg.Register("TestSkip", func(t *T) {
+		t.Skip("not today")
+	})
+	var buf bytes.Buffer
+	code := RunOnce(reg, []string{"-tap"}, &buf)
+	if code != 1 {
+		t.Errorf("Expected exit code 1, got %d", code)
+	}
+	lines := strings.Split(buf.String(), "\n")
+	expected := []string{
+		"TAP version 14",
+		"ok 1 - TestPass",
+		"# Subtest: TestSubtests",
+		"    ok 1 - pass",
+		"    not ok 2 - fail",
+		"      ---",
+	}
+	for i := range expected {
+		if i >= len(lines) || lines[i] != expected[i] {
+			t.Fatalf("Expected output to start with %q, got %q", expected, lines)
+		}
+	}
+	for _, line := range []string{
+		"    1..2",
+		"not ok 2 - TestSubtests",
+		"ok 3 - TestSkip # SKIP not today",
+		"1..3",
+	} {
+		if !strings.Contains(buf.String(), line+"\n") {
+			t.Errorf("Expected output to contain %q, got %q", line, buf.String())
+		}
+	}
+}
+
+func TestRunOnceTimeout(t *testing.T) {
+	defer func(d time.Duration) { timeoutGrace = d }(timeoutGrace)
+	timeoutGrace = 50 * time.Millisecond
+	release := make(chan struct{})
+	defer close(release)
+	reg := &Registry{}
+	reg.Register("TestSlow", func(t *T) {
+		<-t.Context().Done()
+		t.Log("cancelled")
+	})
+	reg.Register("TestStuck", func(t *T) {
+		<-release
+		t.Log("too late")
+	})
+
+	var buf bytes.Buffer
+	if code := RunOnce(reg, []string{"-v", "-run", "TestSlow", "-timeout", "10ms"}, &buf); code != 1 {
+		t.Errorf("Expected exit code 1, got %d", code)
+	}
+	out := buf.String()
+	logged, summary := strings.Index(out, "cancelled\n"), strings.Index(out, "panic: test timed out after 10ms\n")
+	if logged < 0 || summary < logged {
+		t.Errorf("Expected the test to finish before the summary, got %q", out)
+	}
+
+	buf.Reset()
+	if code := RunOnce(reg, []string{"-v", "-run", "TestStuck", "-timeout", "10ms"}, &buf); code != 1 {
+		t.Errorf("Expected exit code 1, got %d", code)
+	}
+	out = buf.String()
+	if !strings.HasSuffix(out, "panic: test timed out after 10ms\nFAIL\n") {
+		t.Errorf("Expected output to end with the summary, got %q", out)
+	}
+}
+
+func TestRunOnceTestTimeout(t *testing.T) {
+	reg := &Registry{}
+	reg.Register("TestSlow", func(t *T) {
+		<-t.Context().Done()
+	}, WithTimeout(10*time.Millisecond))
+
+	var buf bytes.Buffer
+	if code := RunOnce(reg, nil, &buf); code != 1 {
+		t.Errorf("Expected exit code 1, got %d", code)
+	}
+	out := buf.String()
+	if !strings.Contains(out, "--- FAIL: TestSlow") || !strings.Contains(out, "test timed out after 10ms") {
+		t.Errorf("Expected TestSlow to fail with a timeout, got %q", out)
+	}
+}
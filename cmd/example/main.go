@@ -1,19 +1,81 @@
 package main
 
 import (
+	"context"
+	"flag"
+	"log"
 	"net/http"
+	"os"
+	"regexp"
 	"time"
 
 	"github.com/arussellsaw/e2e"
+	"github.com/arussellsaw/e2e/probe"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
 )
 
+func init() {
+	opts := []e2e.ScheduleOption{
+		e2e.WithInterval(10 * time.Second),
+		e2e.RunOnStart(),
+		e2e.WithStagger(),
+		e2e.WithJitter(time.Second),
+	}
+
+	e2e.Register("TestAlwaysPasses", TestAlwaysPasses, opts...)
+	e2e.Register("TestAlwaysFails", TestAlwaysFails, append(opts,
+		e2e.WithDescription("a test that always fails"),
+		e2e.WithOwner("platform"),
+		e2e.WithTags("example"),
+		e2e.WithSeverity(e2e.SeverityWarning),
+	)...)
+	e2e.Register("TestSubtests", TestSubtests, opts...)
+	e2e.Register("TestSlow", TestSlow, append(opts, e2e.WithInterval(time.Minute), e2e.WithTags("slow"))...)
+}
+
 func main() {
-	r := e2e.Runner{}
+	// run the tests once with go test style flags, e.g. example run -v -run 'Subtests/.*'
+	if len(os.Args) > 1 && os.Args[1] == "run" {
+		os.Exit(e2e.RunOnce(e2e.DefaultRegistry, os.Args[2:], os.Stdout))
+	}
+
+	config := flag.String("config", "", "path to a YAML or JSON config file")
+	run := flag.String("run", "", "only schedule tests matching this regexp")
+	probes := flag.String("probes", "", "path to a YAML or JSON file of HTTP probes")
+	flag.Parse()
 
-	r.Schedule("TestAlwaysPasses", TestAlwaysPasses, 10*time.Second)
-	r.Schedule("TestAlwaysFails", TestAlwaysFails, 10*time.Second)
-	r.Schedule("TestSubtests", TestSubtests, 10*time.Second)
-	r.Schedule("TestSlow", TestSlow, 1*time.Minute)
+	if *probes != "" {
+		c, err := probe.LoadHTTPConfig(*probes)
+		if err != nil {
+			log.Fatal(err)
+		}
+		c.Register(e2e.DefaultRegistry)
+	}
+
+	r := e2e.Runner{}
+	if *config != "" {
+		c, err := e2e.LoadConfig(*config)
+		if err != nil {
+			log.Fatal(err)
+		}
+		r.Config = c
+	}
+	var f e2e.Filter
+	if *run != "" {
+		f.Include = regexp.MustCompile(*run)
+	}
+	// export a span for every run and subtest if a collector is configured
+	if os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT") != "" {
+		exp, err := otlptracehttp.New(context.Background())
+		if err != nil {
+			log.Fatal(err)
+		}
+		tp := sdktrace.NewTracerProvider(sdktrace.WithBatcher(exp))
+		defer tp.Shutdown(context.Background())
+		r.TracerProvider = tp
+	}
+	r.Load(e2e.DefaultRegistry, f)
 
 	http.ListenAndServe(":8080", r.Mux())
 }
@@ -0,0 +1,58 @@
+package e2e
+
+import "context"
+
+// InConcurrencyGroup adds the test to a named concurrency group, the number of tests
+// in the group that can run at once is set in Runner.ConcurrencyGroups. This is useful
+// for tests that share a fragile dependency and shouldn't overlap.
+func InConcurrencyGroup(group string) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.group = group
+	}
+}
+
+// semaphore limits concurrent runs, a slot is taken by sending and released by receiving
+type semaphore chan struct{}
+
+// semaphores returns the semaphores a test in the given group must acquire
+// before it can run. This must be called with r.mu held.
+func (r *Runner) semaphores(group string) []semaphore {
+	var sems []semaphore
+	if limit, ok := r.ConcurrencyGroups[group]; ok && group != "" && limit > 0 {
+		if r.groups == nil {
+			r.groups = make(map[string]semaphore)
+		}
+		if _, ok := r.groups[group]; !ok {
+			r.groups[group] = make(semaphore, limit)
+		}
+		sems = append(sems, r.groups[group])
+	}
+	if r.MaxConcurrentRuns > 0 {
+		if r.sem == nil {
+			r.sem = make(semaphore, r.MaxConcurrentRuns)
+		}
+		sems = append(sems, r.sem)
+	}
+	return sems
+}
+
+// acquire blocks until a slot is free in every semaphore, group semaphores come before
+// the runner wide one so a queued test doesn't hold a global slot while it waits. If ctx
+// is done before every slot is taken, the slots already taken are freed and ok is false.
+func acquire(ctx context.Context, sems []semaphore) (release func(), ok bool) {
+	for i, s := range sems {
+		select {
+		case s <- struct{}{}:
+		case <-ctx.Done():
+			for _, s := range sems[:i] {
+				<-s
+			}
+			return nil, false
+		}
+	}
+	return func() {
+		for _, s := range sems {
+			<-s
+		}
+	}, true
+}
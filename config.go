@@ -0,0 +1,123 @@
+package e2e
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"gopkg.in/yaml.v2"
+)
+
+// Config overrides the scheduling of tests without recompiling, it can be
+// loaded from a YAML or JSON file with LoadConfig, e.g.
+//
+//	tests:
+//	  TestCheckout:
+//	    interval: 30s
+//	    timeout: 10s
+//	    notifiers: [slack]
+//	  TestSlow:
+//	    disabled: true
+type Config struct {
+	Tests map[string]TestConfig `json:"tests" yaml:"tests"`
+}
+
+// TestConfig holds the overrides for a single test, zero values are ignored
+type TestConfig struct {
+	Interval Duration `json:"interval" yaml:"interval"`
+	Timeout  Duration `json:"timeout" yaml:"timeout"`
+	// Notifiers are the names of notifiers in Runner.Notifiers to send notifications to
+	Notifiers []string `json:"notifiers" yaml:"notifiers"`
+	// Disabled tests are not scheduled
+	Disabled bool `json:"disabled" yaml:"disabled"`
+}
+
+// LoadConfig reads a Config from a file, files with a .yaml or .yml extension
+// are parsed as YAML, anything else is parsed as JSON
+func LoadConfig(path string) (*Config, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &Config{}
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(b, c)
+	default:
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(c)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("e2e: parsing config %s: %v", path, err)
+	}
+	return c, nil
+}
+
+func (c *Config) test(name string) (TestConfig, bool) {
+	if c == nil {
+		return TestConfig{}, false
+	}
+	tc, ok := c.Tests[name]
+	return tc, ok
+}
+
+// applyConfig applies the overrides to a test. This must be called with r.mu held.
+func (r *Runner) applyConfig(tr *testRunner, tc TestConfig) {
+	if tc.Interval > 0 {
+		tr.interval = time.Duration(tc.Interval)
+	}
+	if tc.Timeout > 0 {
+		tr.timeout = time.Duration(tc.Timeout)
+	}
+	if len(tc.Notifiers) == 0 {
+		return
+	}
+	var notifiers MultiNotifier
+	for _, name := range tc.Notifiers {
+		n, ok := r.Notifiers[name]
+		if !ok {
+			log.Printf("e2e: unknown notifier %q in config for %s", name, tr.Name)
+			continue
+		}
+		notifiers = append(notifiers, n)
+	}
+	tr.n = notifiers
+}
+
+// Duration is a time.Duration which is written in config files as
+// a string parsed by time.ParseDuration, such as "1m30s"
+type Duration time.Duration
+
+func (d *Duration) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	return d.set(s)
+}
+
+func (d *Duration) UnmarshalYAML(unmarshal func(interface{}) error) error {
+	var s string
+	if err := unmarshal(&s); err != nil {
+		return err
+	}
+	return d.set(s)
+}
+
+func (d Duration) MarshalJSON() ([]byte, error) {
+	return json.Marshal(time.Duration(d).String())
+}
+
+func (d *Duration) set(s string) error {
+	v, err := time.ParseDuration(s)
+	if err != nil {
+		return err
+	}
+	*d = Duration(v)
+	return nil
+}
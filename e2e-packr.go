@@ -11,9 +11,8 @@ import "github.com/gobuffalo/packr"
 func init() {
 	packr.PackJSONBytes("frontend/dist", "css/app.ffc96071.css", "\"LmxvZ2JveFtkYXRhLXYtNDExYjAwZWJde2JvcmRlci1yYWRpdXM6M3B4O21hcmdpbi1yaWdodDoxLjVyZW07b3ZlcmZsb3cteDpzY3JvbGw7b3ZlcmZsb3cteTpzY3JvbGw7cGFkZGluZzouNXJlbX0=\"")
 	packr.PackJSONBytes("frontend/dist", "favicon.ico", "\"AAABAAEAEBAAAAEAIABoBAAAFgAAACgAAAAQAAAAIAAAAAEAIAAAAAAAAAQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACDuEEAg7hBAIO4QSWDuEEgg7hBAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAg7hBAIO4QQiDuEGlg7hBmIO4QQSDuEEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAg7hBAIO4QQCDuEFQg7hB9IO4Qe6DuEFDg7hBAIO4QQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIO4QQCDuEEUg7hBwYO4Qf+DuEH/g7hBtIO4QQ2DuEEAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAIO4QQCDuEEAg7hBb4O4QfyDuUH/g7lB/4O4QfmDuEFgg7hBAIO4QQAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAACDuEEAg7hBJoO4QdmDuUH/f6tA/3+tQP+DuUH/g7hBzoO4QR2DuEEAAAAAAAAAAAAAAAAAAAAAAAAAAACDuEEAg7hBA4O4QY+DuEH/grZB/2xzOv9ueTr/g7dB/4O4Qf+DuEGAg7hBAIO4QQAAAAAAAAAAAAAAAACDuEEAg7hBAIO4QT6DuEHrhLpB/3mYPv9gTjb/YVE2/3qePv+DuUH/g7hB44O4QTKDuEEAg7hBAAAAAAAAAAAAg7hBAIO4QQuDuEGvg7lB/4GxQP9oZjj/XUc1/11HNf9qbDn/grRB/4O4Qf+DuEGhg7hBB4O4QQAAAAAAg7hBAIO4QQCDuEFbg7hB94O5Qf90izz/Xko1/15JNf5eSTX+X0w1/3aRPf+DuUH/g7hB8oO4QU2DuEEAg7hBAIO4QQCDuEEZg7hByoO5Qf9+qkD/ZFs3/15INf9eSjW/Xko1xl5INf9mYDj/gK5A/4O5Qf+DuEG+g7hBEoO4QQCDuEEAg7hBe4O4Qf6Dt0H/b3w7/15INf9eSTXtX0s1P19LNUxeSTXzXkk1/3GDO/+DuEH/g7hB/IO4QWyDuEEAg7hBKIO4QciDuUHpfKM/52JUNudeSDXqXko1jmFRNgNgTjYHXko1m15INepjWDfnfqg/54O5QeqDuEG+g7hBHoO4QQ6DuEEmhLpBJnWNPCZdRzUmXkk1Jl9KNQ9eSTUAXUc1AF9KNRFeSTUnXkk1JneUPSaEukEmg7hBJoO4QQwAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAAA//8AAP5/AAD8PwAA/D8AAPgfAAD4HwAA8A8AAOAPAADgBwAAwAMAAMADAACAAQAAgAEAAAAAAAABgAAA//8AAA==\"")
-	packr.PackJSONBytes("frontend/dist", "index.html", "\"PCFET0NUWVBFIGh0bWw+PGh0bWwgbGFuZz1lbj48aGVhZD48bWV0YSBjaGFyc2V0PXV0Zi04PjxtZXRhIGh0dHAtZXF1aXY9WC1VQS1Db21wYXRpYmxlIGNvbnRlbnQ9IklFPWVkZ2UiPjxtZXRhIG5hbWU9dmlld3BvcnQgY29udGVudD0id2lkdGg9ZGV2aWNlLXdpZHRoLGluaXRpYWwtc2NhbGU9MSI+PGxpbmsgcmVsPWljb24gaHJlZj0vdWkvZmF2aWNvbi5pY28+PGxpbmsgcmVsPXN0eWxlc2hlZXQgdHlwZT10ZXh0L2NzcyBocmVmPWh0dHBzOi8vY2RuanMuY2xvdWRmbGFyZS5jb20vYWpheC9saWJzL2J1bG1hLzAuNy4xL2Nzcy9idWxtYS5taW4uY3NzPjx0aXRsZT5lMmU8L3RpdGxlPjxsaW5rIGhyZWY9L3VpL2Nzcy9hcHAuZmZjOTYwNzEuY3NzIHJlbD1wcmVsb2FkIGFzPXN0eWxlPjxsaW5rIGhyZWY9L3VpL2pzL2FwcC45ZjQ0ZTAxMS5qcyByZWw9cHJlbG9hZCBhcz1zY3JpcHQ+PGxpbmsgaHJlZj0vdWkvanMvY2h1bmstdmVuZG9ycy42NzExYmVlYy5qcyByZWw9cHJlbG9hZCBhcz1zY3JpcHQ+PGxpbmsgaHJlZj0vdWkvY3NzL2FwcC5mZmM5NjA3MS5jc3MgcmVsPXN0eWxlc2hlZXQ+PC9oZWFkPjxib2R5Pjxub3NjcmlwdD48c3Ryb25nPldlJ3JlIHNvcnJ5IGJ1dCBlMmUgZG9lc24ndCB3b3JrIHByb3Blcmx5IHdpdGhvdXQgSmF2YVNjcmlwdCBlbmFibGVkLiBQbGVhc2UgZW5hYmxlIGl0IHRvIGNvbnRpbnVlLjwvc3Ryb25nPjwvbm9zY3JpcHQ+PGRpdiBpZD1hcHA+PC9kaXY+PHNjcmlwdCBzcmM9L3VpL2pzL2NodW5rLXZlbmRvcnMuNjcxMWJlZWMuanM+PC9zY3JpcHQ+PHNjcmlwdCBzcmM9L3VpL2pzL2FwcC45ZjQ0ZTAxMS5qcz48L3NjcmlwdD48L2JvZHk+PC9odG1sPg==\"")
-	packr.PackJSONBytes("frontend/dist", "js/app.9f44e011.js", "\"KGZ1bmN0aW9uKHQpe2Z1bmN0aW9uIGUoZSl7Zm9yKHZhciBzLGksbz1lWzBdLHU9ZVsxXSxjPWVbMl0sZj0wLHA9W107ZjxvLmxlbmd0aDtmKyspaT1vW2ZdLGFbaV0mJnAucHVzaChhW2ldWzBdKSxhW2ldPTA7Zm9yKHMgaW4gdSlPYmplY3QucHJvdG90eXBlLmhhc093blByb3BlcnR5LmNhbGwodSxzKSYmKHRbc109dVtzXSk7bCYmbChlKTt3aGlsZShwLmxlbmd0aClwLnNoaWZ0KCkoKTtyZXR1cm4gci5wdXNoLmFwcGx5KHIsY3x8W10pLG4oKX1mdW5jdGlvbiBuKCl7Zm9yKHZhciB0LGU9MDtlPHIubGVuZ3RoO2UrKyl7Zm9yKHZhciBuPXJbZV0scz0hMCxvPTE7bzxuLmxlbmd0aDtvKyspe3ZhciB1PW5bb107MCE9PWFbdV0mJihzPSExKX1zJiYoci5zcGxpY2UoZS0tLDEpLHQ9aShpLnM9blswXSkpfXJldHVybiB0fXZhciBzPXt9LGE9e2FwcDowfSxyPVtdO2Z1bmN0aW9uIGkoZSl7aWYoc1tlXSlyZXR1cm4gc1tlXS5leHBvcnRzO3ZhciBuPXNbZV09e2k6ZSxsOiExLGV4cG9ydHM6e319O3JldHVybiB0W2VdLmNhbGwobi5leHBvcnRzLG4sbi5leHBvcnRzLGkpLG4ubD0hMCxuLmV4cG9ydHN9aS5tPXQsaS5jPXMsaS5kPWZ1bmN0aW9uKHQsZSxuKXtpLm8odCxlKXx8T2JqZWN0LmRlZmluZVByb3BlcnR5KHQsZSx7ZW51bWVyYWJsZTohMCxnZXQ6bn0pfSxpLnI9ZnVuY3Rpb24odCl7InVuZGVmaW5lZCIhPT10eXBlb2YgU3ltYm9sJiZTeW1ib2wudG9TdHJpbmdUYWcmJk9iamVjdC5kZWZpbmVQcm9wZXJ0eSh0LFN5bWJvbC50b1N0cmluZ1RhZyx7dmFsdWU6Ik1vZHVsZSJ9KSxPYmplY3QuZGVmaW5lUHJvcGVydHkodCwiX19lc01vZHVsZSIse3ZhbHVlOiEwfSl9LGkudD1mdW5jdGlvbih0LGUpe2lmKDEmZSYmKHQ9aSh0KSksOCZlKXJldHVybiB0O2lmKDQmZSYmIm9iamVjdCI9PT10eXBlb2YgdCYmdCYmdC5fX2VzTW9kdWxlKXJldHVybiB0O3ZhciBuPU9iamVjdC5jcmVhdGUobnVsbCk7aWYoaS5yKG4pLE9iamVjdC5kZWZpbmVQcm9wZXJ0eShuLCJkZWZhdWx0Iix7ZW51bWVyYWJsZTohMCx2YWx1ZTp0fSksMiZlJiYic3RyaW5nIiE9dHlwZW9mIHQpZm9yKHZhciBzIGluIHQpaS5kKG4scyxmdW5jdGlvbihlKXtyZXR1cm4gdFtlXX0uYmluZChudWxsLHMpKTtyZXR1cm4gbn0saS5uPWZ1bmN0aW9uKHQpe3ZhciBlPXQmJnQuX19lc01vZHVsZT9mdW5jdGlvbigpe3JldHVybiB0WyJkZWZhdWx0Il19OmZ1bmN0aW9uKCl7cmV0dXJuIHR9O3JldHVybiBpLmQoZSwiYSIsZSksZX0saS5vPWZ1bmN0aW9uKHQsZSl7cmV0dXJuIE9iamVjdC5wcm90b3R5cGUuaGFzT3duUHJvcGVydHkuY2FsbCh0LGUpfSxpLnA9Ii91aS8iO3ZhciBvPXdpbmRvd1sid2VicGFja0pzb25wIl09d2luZG93WyJ3ZWJwYWNrSnNvbnAiXXx8W10sdT1vLnB1c2guYmluZChvKTtvLnB1c2g9ZSxvPW8uc2xpY2UoKTtmb3IodmFyIGM9MDtjPG8ubGVuZ3RoO2MrKyllKG9bY10pO3ZhciBsPXU7ci5wdXNoKFswLCJjaHVuay12ZW5kb3JzIl0pLG4oKX0pKHswOmZ1bmN0aW9uKHQsZSxuKXt0LmV4cG9ydHM9bigiNTZkNyIpfSwiNTZkNyI6ZnVuY3Rpb24odCxlLG4peyJ1c2Ugc3RyaWN0IjtuLnIoZSk7bigiY2FkZiIpLG4oIjU1MWMiKSxuKCIwOTdkIik7dmFyIHM9bigiMmIwZSIpLGE9ZnVuY3Rpb24oKXt2YXIgdD10aGlzLGU9dC4kY3JlYXRlRWxlbWVudCxuPXQuX3NlbGYuX2N8fGU7cmV0dXJuIG4oImRpdiIse2F0dHJzOntpZDoiYXBwIn19LFt0Ll9tKDApLG4oImRpdiIse3N0YXRpY0NsYXNzOiJjb250YWluZXIifSxbbigiZGl2Iix7c3RhdGljQ2xhc3M6ImNvbHVtbnMgaXMtbXVsdGlsaW5lIn0sdC5fbCh0LnRlc3REYXRhLGZ1bmN0aW9uKHQpe3JldHVybiBuKCJkaXYiLHtrZXk6dC5OYW1lLHN0YXRpY0NsYXNzOiJjb2x1bW4gaXMtaGFsZiJ9LFtuKCJUZXN0Qm94Iix7YXR0cnM6e3Rlc3Q6dH19KV0sMSl9KSldKV0pfSxyPVtmdW5jdGlvbigpe3ZhciB0PXRoaXMsZT10LiRjcmVhdGVFbGVtZW50LG49dC5fc2VsZi5fY3x8ZTtyZXR1cm4gbigibmF2Iix7c3RhdGljQ2xhc3M6Im5hdmJhciIsYXR0cnM6e3JvbGU6Im5hdmlnYXRpb24iLCJhcmlhLWxhYmVsIjoibWFpbiBuYXZpZ2F0aW9uIn19LFtuKCJkaXYiLHtzdGF0aWNDbGFzczoibmF2YmFyLWJyYW5kIn0sW24oImEiLHtzdGF0aWNDbGFzczoibmF2YmFyLWl0ZW0iLGF0dHJzOntocmVmOiJodHRwczovL2F2b2NldC5pbyJ9fSxbbigicCIse3N0YXRpY0NsYXNzOiJ0aXRsZSIsc3RhdGljU3R5bGU6e3BhZGRpbmc6IjAuNXJlbSIsbWFyZ2luOiIwLjVyZW0ifX0sW3QuX3YoImNhbmFyeSIpXSldKV0pXSl9XSxpPWZ1bmN0aW9uKCl7dmFyIHQ9dGhpcyxlPXQuJGNyZWF0ZUVsZW1lbnQsbj10Ll9zZWxmLl9jfHxlO3JldHVybiBuKCJkaXYiLHtzdGF0aWNDbGFzczoiaGFzLXRleHQtd2hpdGUiLGNsYXNzOnQudGVzdENsYXNzZXModC50ZXN0KSxzdGF0aWNTdHlsZTp7ImJvcmRlci1yYWRpdXMiOiIzcHgiLHBhZGRpbmc6IjJyZW0ifX0sW24oImgxIix7c3RhdGljQ2xhc3M6InRpdGxlIGlzLTQgaGFzLXRleHQtd2hpdGUifSxbdC5fdigiXG5cdFx0Iit0Ll9zKHQudGVzdC5OYW1lKSsiXG5cdCIpXSksbigiZGl2Iix7c3RhdGljQ2xhc3M6ImNvbHVtbnMiLHN0YXRpY1N0eWxlOntoZWlnaHQ6IjEwMHB4In19LFtuKCJkaXYiLHtzdGF0aWNDbGFzczoiY29sdW1uIGlzLW9uZS10aGlyZCJ9LFtuKCJwIix7c3RhdGljQ2xhc3M6ImlzLXNpemUtNyJ9LFt0Ll92KCJzdGF0ZTogIit0Ll9zKHQudGVzdC5TdGF0ZSkpXSksbigicCIse3N0YXRpY0NsYXNzOiJpcy1zaXplLTcifSxbdC5fdigicGFzcyByYXRlOiAiK3QuX3MoTWF0aC5yb3VuZCh0LnRlc3QuU3VjY2Vzc2VzLyh0LnRlc3QuU3VjY2Vzc2VzK3QudGVzdC5GYWlsdXJlcykqMTAwfHwwKSkrIiUiKV0pXSksdC5pc0ZhaWxpbmdPclJ1bm5pbmcodC50ZXN0KT9uKCJMb2ciLHthdHRyczp7bmFtZTp0LnRlc3QuTmFtZSxiYXNlT3V0cHV0OnQudGVzdC5MYXN0RmFpbHVyZU91dHB1dCxzdGF0ZTp0LnRlc3QuU3RhdGV9fSk6dC5fZSgpXSwxKV0pfSxvPVtdLHU9ZnVuY3Rpb24oKXt2YXIgdD10aGlzLGU9dC4kY3JlYXRlRWxlbWVudCxuPXQuX3NlbGYuX2N8fGU7cmV0dXJuIG4oImRpdiIse3N0YXRpY0NsYXNzOiJsb2dib3ggaGFzLWJhY2tncm91bmQtZ3JleS1kYXJrIGNvbHVtbiBpcy10d28tdGhpcmRzIn0sW24oInAiLHtzdGF0aWNDbGFzczoiaXMtc2l6ZS03IixzdGF0aWNTdHlsZTp7IndoaXRlLXNwYWNlIjoicHJlIn19LFt0Ll92KHQuX3ModC5vdXRwdXQpKV0pXSl9LGM9W10sbD0obigiN2Y3ZiIpLHtuYW1lOiJMb2ciLGRhdGE6ZnVuY3Rpb24oKXtyZXR1cm57b3V0cHV0OiIifX0scHJvcHM6e25hbWU6IiIsc3RhdGU6IiIsYmFzZU91dHB1dDoiIn0sbW91bnRlZDpmdW5jdGlvbigpeyJSVU5OSU5HIiE9dGhpcy5zdGF0ZSYmKHRoaXMub3V0cHV0PXRoaXMuYmFzZU91dHB1dCk7dmFyIHQ9dGhpcztmKHQpLHNldEludGVydmFsKGZ1bmN0aW9uKCl7Zih0KX0sMWUzKX0sbWV0aG9kczp7fX0pLGY9ZnVuY3Rpb24odCl7aWYoIlJVTk5JTkciPT10LnN0YXRlKXt2YXIgZT1uZXcgUmVxdWVzdCgiL2FwaS9sb2cvIit0Lm5hbWUpO2ZldGNoKGUpLnRoZW4oZnVuY3Rpb24odCl7cmV0dXJuIHQudGV4dCgpfSkudGhlbihmdW5jdGlvbihlKXt0LiRuZXh0VGljayhmdW5jdGlvbigpe3Qub3V0cHV0PWV9KX0pfX0scD1sLGQ9KG4oIjcxMTEiKSxuKCIyODc3IikpLHY9T2JqZWN0KGRbImEiXSkocCx1LGMsITEsbnVsbCwiNDExYjAwZWIiLG51bGwpO3Yub3B0aW9ucy5fX2ZpbGU9IkxvZy52dWUiO3ZhciBoPXYuZXhwb3J0cyxiPXtuYW1lOiJUZXN0Qm94Iixwcm9wczp7dGVzdDp7fX0sY29tcG9uZW50czp7TG9nOmh9LG1ldGhvZHM6e3Rlc3RDbGFzc2VzOmZ1bmN0aW9uKHQpe3JldHVybnsiaGFzLWJhY2tncm91bmQtZGFuZ2VyIjoiRkFJTEVEIj09dC5TdGF0ZSwiaGFzLWJhY2tncm91bmQtc3VjY2VzcyI6IlBBU1NFRCI9PXQuU3RhdGUsImhhcy1iYWNrZ3JvdW5kLWdyZXktbGlnaHQiOiJSVU5OSU5HIj09dC5TdGF0ZSwiaGFzLWJhY2tncm91bmQtZ3JleS1kYXJrIjoiIj09dC5TdGF0ZX19LGlzRmFpbGluZzpmdW5jdGlvbih0KXtyZXR1cm4iRkFJTEVEIj09dC5TdGF0ZX0saXNGYWlsaW5nT3JSdW5uaW5nOmZ1bmN0aW9uKHQpe3JldHVybiJSVU5OSU5HIj09dC5TdGF0ZXx8IkZBSUxFRCI9PXQuU3RhdGV9fX0sbT1iLGc9T2JqZWN0KGRbImEiXSkobSxpLG8sITEsbnVsbCxudWxsLG51bGwpO2cub3B0aW9ucy5fX2ZpbGU9IlRlc3RCb3gudnVlIjt2YXIgXz1nLmV4cG9ydHMseT17bmFtZToiYXBwIixkYXRhOmZ1bmN0aW9uKCl7cmV0dXJue3Rlc3REYXRhOltdfX0sY29tcG9uZW50czp7VGVzdEJveDpffSxtb3VudGVkOmZ1bmN0aW9uKCl7Uyh0aGlzKTt2YXIgdD10aGlzO3NldEludGVydmFsKGZ1bmN0aW9uKCl7Uyh0KX0sMWU0KX0sbWV0aG9kczp7fX0sUz1mdW5jdGlvbih0KXt2YXIgZT1uZXcgUmVxdWVzdCgiL2FwaS9zdGF0dXMiKTtmZXRjaChlKS50aGVuKGZ1bmN0aW9uKHQpe3JldHVybiB0Lmpzb24oKX0pLnRoZW4oZnVuY3Rpb24oZSl7dC4kbmV4dFRpY2soZnVuY3Rpb24oKXt0LnRlc3REYXRhPWV9KX0pfSx4PXksTz1PYmplY3QoZFsiYSJdKSh4LGEsciwhMSxudWxsLG51bGwsbnVsbCk7Ty5vcHRpb25zLl9fZmlsZT0iQXBwLnZ1ZSI7dmFyIEM9Ty5leHBvcnRzO3NbImEiXS5jb25maWcucHJvZHVjdGlvblRpcD0hMSxuZXcgc1siYSJdKHtyZW5kZXI6ZnVuY3Rpb24odCl7cmV0dXJuIHQoQyl9fSkuJG1vdW50KCIjYXBwIil9LDcxMTE6ZnVuY3Rpb24odCxlLG4peyJ1c2Ugc3RyaWN0Ijt2YXIgcz1uKCJmM2I5IiksYT1uLm4ocyk7YS5hfSxmM2I5OmZ1bmN0aW9uKHQsZSxuKXt9fSk7Ci8vIyBzb3VyY2VNYXBwaW5nVVJMPWFwcC45ZjQ0ZTAxMS5qcy5tYXA=\"")
-	packr.PackJSONBytes("frontend/dist", "js/app.9f44e011.js.map", "\"eyJ2ZXJzaW9uIjozLCJzb3VyY2VzIjpbIndlYnBhY2s6Ly8vd2VicGFjay9ib290c3RyYXAiLCJ3ZWJwYWNrOi8vLy4vc3JjL0FwcC52dWU/MzgzOSIsIndlYnBhY2s6Ly8vLi9zcmMvY29tcG9uZW50cy9UZXN0Qm94LnZ1ZT85OTA3Iiwid2VicGFjazovLy8uL3NyYy9jb21wb25lbnRzL0xvZy52dWU/MzBmNyIsIndlYnBhY2s6Ly8vc3JjL2NvbXBvbmVudHMvTG9nLnZ1ZSIsIndlYnBhY2s6Ly8vLi9zcmMvY29tcG9uZW50cy9Mb2cudnVlP2IxZGEiLCJ3ZWJwYWNrOi8vLy4vc3JjL2NvbXBvbmVudHMvTG9nLnZ1ZT8xZmQ4Iiwid2VicGFjazovLy9zcmMvY29tcG9uZW50cy9UZXN0Qm94LnZ1ZSIsIndlYnBhY2s6Ly8vLi9zcmMvY29tcG9uZW50cy9UZXN0Qm94LnZ1ZT8wMDA4Iiwid2VicGFjazovLy8uL3NyYy9jb21wb25lbnRzL1Rlc3RCb3gudnVlIiwid2VicGFjazovLy9zcmMvQXBwLnZ1ZSIsIndlYnBhY2s6Ly8vLi9zcmMvQXBwLnZ1ZT8xMTYwIiwid2VicGFjazovLy8uL3NyYy9BcHAudnVlIiwid2VicGFjazovLy8uL3NyYy9tYWluLmpzIiwid2VicGFjazovLy8uL3NyYy9jb21wb25lbnRzL0xvZy52dWU/NmUzMiJdLCJuYW1lcyI6WyJ3ZWJwYWNrSnNvbnBDYWxsYmFjayIsImRhdGEiLCJtb2R1bGVJZCIsImNodW5rSWQiLCJjaHVua0lkcyIsIm1vcmVNb2R1bGVzIiwiZXhlY3V0ZU1vZHVsZXMiLCJpIiwicmVzb2x2ZXMiLCJsZW5ndGgiLCJpbnN0YWxsZWRDaHVua3MiLCJwdXNoIiwiT2JqZWN0IiwicHJvdG90eXBlIiwiaGFzT3duUHJvcGVydHkiLCJjYWxsIiwibW9kdWxlcyIsInBhcmVudEpzb25wRnVuY3Rpb24iLCJzaGlmdCIsImRlZmVycmVkTW9kdWxlcyIsImFwcGx5IiwiY2hlY2tEZWZlcnJlZE1vZHVsZXMiLCJyZXN1bHQiLCJkZWZlcnJlZE1vZHVsZSIsImZ1bGZpbGxlZCIsImoiLCJkZXBJZCIsInNwbGljZSIsIl9fd2VicGFja19yZXF1aXJlX18iLCJzIiwiaW5zdGFsbGVkTW9kdWxlcyIsImFwcCIsImV4cG9ydHMiLCJtb2R1bGUiLCJsIiwibSIsImMiLCJkIiwibmFtZSIsImdldHRlciIsIm8iLCJkZWZpbmVQcm9wZXJ0eSIsImVudW1lcmFibGUiLCJnZXQiLCJyIiwiU3ltYm9sIiwidG9TdHJpbmdUYWciLCJ2YWx1ZSIsInQiLCJtb2RlIiwiX19lc01vZHVsZSIsIm5zIiwiY3JlYXRlIiwia2V5IiwiYmluZCIsIm4iLCJvYmplY3QiLCJwcm9wZXJ0eSIsInAiLCJqc29ucEFycmF5Iiwid2luZG93Iiwib2xkSnNvbnBGdW5jdGlvbiIsInNsaWNlIiwiQXBwdnVlX3R5cGVfdGVtcGxhdGVfaWRfZmVmNTRmMjRfcmVuZGVyIiwiX3ZtIiwidGhpcyIsIl9oIiwiJGNyZWF0ZUVsZW1lbnQiLCJfYyIsIl9zZWxmIiwiYXR0cnMiLCJpZCIsIl9tIiwic3RhdGljQ2xhc3MiLCJfbCIsInRlc3QiLCJOYW1lIiwic3RhdGljUmVuZGVyRm5zIiwicm9sZSIsImFyaWEtbGFiZWwiLCJocmVmIiwic3RhdGljU3R5bGUiLCJwYWRkaW5nIiwibWFyZ2luIiwiX3YiLCJUZXN0Qm94dnVlX3R5cGVfdGVtcGxhdGVfaWRfY2JlNzc2NzZfcmVuZGVyIiwiY2xhc3MiLCJ0ZXN0Q2xhc3NlcyIsImJvcmRlci1yYWRpdXMiLCJfcyIsImhlaWdodCIsIlN0YXRlIiwiTWF0aCIsInJvdW5kIiwiU3VjY2Vzc2VzIiwiRmFpbHVyZXMiLCJpc0ZhaWxpbmdPclJ1bm5pbmciLCJiYXNlT3V0cHV0IiwiTGFzdEZhaWx1cmVPdXRwdXQiLCJzdGF0ZSIsIl9lIiwiVGVzdEJveHZ1ZV90eXBlX3RlbXBsYXRlX2lkX2NiZTc3Njc2X3N0YXRpY1JlbmRlckZucyIsIkxvZ3Z1ZV90eXBlX3RlbXBsYXRlX2lkXzQxMWIwMGViX3Njb3BlZF90cnVlX3JlbmRlciIsIndoaXRlLXNwYWNlIiwib3V0cHV0IiwiTG9ndnVlX3R5cGVfdGVtcGxhdGVfaWRfNDExYjAwZWJfc2NvcGVkX3RydWVfc3RhdGljUmVuZGVyRm5zIiwiTG9ndnVlX3R5cGVfc2NyaXB0X2xhbmdfanNfIiwicHJvcHMiLCJtb3VudGVkIiwic2VsZiIsImdldE91dHB1dCIsInNldEludGVydmFsIiwibWV0aG9kcyIsInJlcSIsIlJlcXVlc3QiLCJmZXRjaCIsInRoZW4iLCJyZXMiLCJ0ZXh0IiwiYm9keSIsIiRuZXh0VGljayIsImNvbXBvbmVudHNfTG9ndnVlX3R5cGVfc2NyaXB0X2xhbmdfanNfIiwiY29tcG9uZW50IiwiY29tcG9uZW50Tm9ybWFsaXplciIsIm9wdGlvbnMiLCJfX2ZpbGUiLCJMb2ciLCJUZXN0Qm94dnVlX3R5cGVfc2NyaXB0X2xhbmdfanNfIiwiY29tcG9uZW50cyIsImhhcy1iYWNrZ3JvdW5kLWRhbmdlciIsImhhcy1iYWNrZ3JvdW5kLXN1Y2Nlc3MiLCJoYXMtYmFja2dyb3VuZC1ncmV5LWxpZ2h0IiwiaGFzLWJhY2tncm91bmQtZ3JleS1kYXJrIiwiaXNGYWlsaW5nIiwiY29tcG9uZW50c19UZXN0Qm94dnVlX3R5cGVfc2NyaXB0X2xhbmdfanNfIiwiVGVzdEJveF9jb21wb25lbnQiLCJUZXN0Qm94IiwiQXBwdnVlX3R5cGVfc2NyaXB0X2xhbmdfanNfIiwidGVzdERhdGEiLCJnZXRUZXN0U3RhdHVzIiwianNvbiIsInNyY19BcHB2dWVfdHlwZV9zY3JpcHRfbGFuZ19qc18iLCJBcHBfY29tcG9uZW50IiwiQXBwIiwidnVlX3J1bnRpbWVfZXNtIiwiY29uZmlnIiwicHJvZHVjdGlvblRpcCIsInJlbmRlciIsImgiLCIkbW91bnQiLCJfbm9kZV9tb2R1bGVzX21pbmlfY3NzX2V4dHJhY3RfcGx1Z2luX2Rpc3RfbG9hZGVyX2pzX3JlZl82X29uZU9mXzFfMF9ub2RlX21vZHVsZXNfY3NzX2xvYWRlcl9pbmRleF9qc19yZWZfNl9vbmVPZl8xXzFfbm9kZV9tb2R1bGVzX3Z1ZV9sb2FkZXJfbGliX2xvYWRlcnNfc3R5bGVQb3N0TG9hZGVyX2pzX25vZGVfbW9kdWxlc19wb3N0Y3NzX2xvYWRlcl9saWJfaW5kZXhfanNfcmVmXzZfb25lT2ZfMV8yX25vZGVfbW9kdWxlc19jYWNoZV9sb2FkZXJfZGlzdF9janNfanNfcmVmXzBfMF9ub2RlX21vZHVsZXNfdnVlX2xvYWRlcl9saWJfaW5kZXhfanNfdnVlX2xvYWRlcl9vcHRpb25zX0xvZ192dWVfdnVlX3R5cGVfc3R5bGVfaW5kZXhfMF9pZF80MTFiMDBlYl9zY29wZWRfdHJ1ZV9sYW5nX2Nzc19fX1dFQlBBQ0tfSU1QT1JURURfTU9EVUxFXzBfX19kZWZhdWx0Il0sIm1hcHBpbmdzIjoiYUFDQSxTQUFBQSxFQUFBQyxHQVFBLElBUEEsSUFNQUMsRUFBQUMsRUFOQUMsRUFBQUgsRUFBQSxHQUNBSSxFQUFBSixFQUFBLEdBQ0FLLEVBQUFMLEVBQUEsR0FJQU0sRUFBQSxFQUFBQyxLQUNRRCxFQUFBSCxFQUFBSyxPQUFvQkYsSUFDNUJKLEVBQUFDLEVBQUFHLEdBQ0FHLEVBQUFQLElBQ0FLLEVBQUFHLEtBQUFELEVBQUFQLEdBQUEsSUFFQU8sRUFBQVAsR0FBQSxFQUVBLElBQUFELEtBQUFHLEVBQ0FPLE9BQUFDLFVBQUFDLGVBQUFDLEtBQUFWLEVBQUFILEtBQ0FjLEVBQUFkLEdBQUFHLEVBQUFILElBR0FlLEtBQUFoQixHQUVBLE1BQUFPLEVBQUFDLE9BQ0FELEVBQUFVLE9BQUFWLEdBT0EsT0FIQVcsRUFBQVIsS0FBQVMsTUFBQUQsRUFBQWIsT0FHQWUsSUFFQSxTQUFBQSxJQUVBLElBREEsSUFBQUMsRUFDQWYsRUFBQSxFQUFpQkEsRUFBQVksRUFBQVYsT0FBNEJGLElBQUEsQ0FHN0MsSUFGQSxJQUFBZ0IsRUFBQUosRUFBQVosR0FDQWlCLEdBQUEsRUFDQUMsRUFBQSxFQUFrQkEsRUFBQUYsRUFBQWQsT0FBMkJnQixJQUFBLENBQzdDLElBQUFDLEVBQUFILEVBQUFFLEdBQ0EsSUFBQWYsRUFBQWdCLEtBQUFGLEdBQUEsR0FFQUEsSUFDQUwsRUFBQVEsT0FBQXBCLElBQUEsR0FDQWUsRUFBQU0sSUFBQUMsRUFBQU4sRUFBQSxLQUdBLE9BQUFELEVBSUEsSUFBQVEsS0FLQXBCLEdBQ0FxQixJQUFBLEdBR0FaLEtBR0EsU0FBQVMsRUFBQTFCLEdBR0EsR0FBQTRCLEVBQUE1QixHQUNBLE9BQUE0QixFQUFBNUIsR0FBQThCLFFBR0EsSUFBQUMsRUFBQUgsRUFBQTVCLElBQ0FLLEVBQUFMLEVBQ0FnQyxHQUFBLEVBQ0FGLFlBVUEsT0FOQWhCLEVBQUFkLEdBQUFhLEtBQUFrQixFQUFBRCxRQUFBQyxJQUFBRCxRQUFBSixHQUdBSyxFQUFBQyxHQUFBLEVBR0FELEVBQUFELFFBS0FKLEVBQUFPLEVBQUFuQixFQUdBWSxFQUFBUSxFQUFBTixFQUdBRixFQUFBUyxFQUFBLFNBQUFMLEVBQUFNLEVBQUFDLEdBQ0FYLEVBQUFZLEVBQUFSLEVBQUFNLElBQ0ExQixPQUFBNkIsZUFBQVQsRUFBQU0sR0FBMENJLFlBQUEsRUFBQUMsSUFBQUosS0FLMUNYLEVBQUFnQixFQUFBLFNBQUFaLEdBQ0EscUJBQUFhLGVBQUFDLGFBQ0FsQyxPQUFBNkIsZUFBQVQsRUFBQWEsT0FBQUMsYUFBd0RDLE1BQUEsV0FFeERuQyxPQUFBNkIsZUFBQVQsRUFBQSxjQUFpRGUsT0FBQSxLQVFqRG5CLEVBQUFvQixFQUFBLFNBQUFELEVBQUFFLEdBRUEsR0FEQSxFQUFBQSxJQUFBRixFQUFBbkIsRUFBQW1CLElBQ0EsRUFBQUUsRUFBQSxPQUFBRixFQUNBLEtBQUFFLEdBQUEsa0JBQUFGLFFBQUFHLFdBQUEsT0FBQUgsRUFDQSxJQUFBSSxFQUFBdkMsT0FBQXdDLE9BQUEsTUFHQSxHQUZBeEIsRUFBQWdCLEVBQUFPLEdBQ0F2QyxPQUFBNkIsZUFBQVUsRUFBQSxXQUF5Q1QsWUFBQSxFQUFBSyxVQUN6QyxFQUFBRSxHQUFBLGlCQUFBRixFQUFBLFFBQUFNLEtBQUFOLEVBQUFuQixFQUFBUyxFQUFBYyxFQUFBRSxFQUFBLFNBQUFBLEdBQWdILE9BQUFOLEVBQUFNLElBQXFCQyxLQUFBLEtBQUFELElBQ3JJLE9BQUFGLEdBSUF2QixFQUFBMkIsRUFBQSxTQUFBdEIsR0FDQSxJQUFBTSxFQUFBTixLQUFBaUIsV0FDQSxXQUEyQixPQUFBakIsRUFBQSxZQUMzQixXQUFpQyxPQUFBQSxHQUVqQyxPQURBTCxFQUFBUyxFQUFBRSxFQUFBLElBQUFBLEdBQ0FBLEdBSUFYLEVBQUFZLEVBQUEsU0FBQWdCLEVBQUFDLEdBQXNELE9BQUE3QyxPQUFBQyxVQUFBQyxlQUFBQyxLQUFBeUMsRUFBQUMsSUFHdEQ3QixFQUFBOEIsRUFBQSxPQUVBLElBQUFDLEVBQUFDLE9BQUEsZ0JBQUFBLE9BQUEsb0JBQ0FDLEVBQUFGLEVBQUFoRCxLQUFBMkMsS0FBQUssR0FDQUEsRUFBQWhELEtBQUFYLEVBQ0EyRCxJQUFBRyxRQUNBLFFBQUF2RCxFQUFBLEVBQWdCQSxFQUFBb0QsRUFBQWxELE9BQXVCRixJQUFBUCxFQUFBMkQsRUFBQXBELElBQ3ZDLElBQUFVLEVBQUE0QyxFQUlBMUMsRUFBQVIsTUFBQSxvQkFFQVUsdUlDdEpBMEMsRUFBQSxXQUEwQixJQUFBQyxFQUFBQyxLQUFhQyxFQUFBRixFQUFBRyxlQUEwQkMsRUFBQUosRUFBQUssTUFBQUQsSUFBQUYsRUFBd0IsT0FBQUUsRUFBQSxPQUFpQkUsT0FBT0MsR0FBQSxTQUFZUCxFQUFBUSxHQUFBLEdBQUFKLEVBQUEsT0FBc0JLLFlBQUEsY0FBd0JMLEVBQUEsT0FBWUssWUFBQSx3QkFBbUNULEVBQUFVLEdBQUFWLEVBQUEsa0JBQUFXLEdBQXNDLE9BQUFQLEVBQUEsT0FBaUJmLElBQUFzQixFQUFBQyxLQUFBSCxZQUFBLG1CQUEyQ0wsRUFBQSxXQUFnQkUsT0FBT0ssV0FBYSxXQUNoV0UsR0FBQSxXQUFvQyxJQUFBYixFQUFBQyxLQUFhQyxFQUFBRixFQUFBRyxlQUEwQkMsRUFBQUosRUFBQUssTUFBQUQsSUFBQUYsRUFBd0IsT0FBQUUsRUFBQSxPQUFpQkssWUFBQSxTQUFBSCxPQUE0QlEsS0FBQSxhQUFBQyxhQUFBLHFCQUFvRFgsRUFBQSxPQUFZSyxZQUFBLGlCQUEyQkwsRUFBQSxLQUFVSyxZQUFBLGNBQUFILE9BQWlDVSxLQUFBLHVCQUE0QlosRUFBQSxLQUFVSyxZQUFBLFFBQUFRLGFBQWlDQyxRQUFBLFNBQUFDLE9BQUEsWUFBc0NuQixFQUFBb0IsR0FBQSxvQkNEbllDLEVBQUEsV0FBMEIsSUFBQXJCLEVBQUFDLEtBQWFDLEVBQUFGLEVBQUFHLGVBQTBCQyxFQUFBSixFQUFBSyxNQUFBRCxJQUFBRixFQUF3QixPQUFBRSxFQUFBLE9BQWlCSyxZQUFBLGlCQUFBYSxNQUFBdEIsRUFBQXVCLFlBQUF2QixFQUFBVyxNQUFBTSxhQUEwRU8sZ0JBQUEsTUFBQU4sUUFBQSxVQUF3Q2QsRUFBQSxNQUFXSyxZQUFBLDhCQUF3Q1QsRUFBQW9CLEdBQUEsU0FBQXBCLEVBQUF5QixHQUFBekIsRUFBQVcsS0FBQUMsTUFBQSxVQUFBUixFQUFBLE9BQTRESyxZQUFBLFVBQUFRLGFBQW1DUyxPQUFBLFdBQWtCdEIsRUFBQSxPQUFZSyxZQUFBLHdCQUFrQ0wsRUFBQSxLQUFVSyxZQUFBLGNBQXdCVCxFQUFBb0IsR0FBQSxVQUFBcEIsRUFBQXlCLEdBQUF6QixFQUFBVyxLQUFBZ0IsVUFBQXZCLEVBQUEsS0FBcURLLFlBQUEsY0FBd0JULEVBQUFvQixHQUFBLGNBQUFwQixFQUFBeUIsR0FBQUcsS0FBQUMsTUFBQTdCLEVBQUFXLEtBQUFtQixXQUFBOUIsRUFBQVcsS0FBQW1CLFVBQUE5QixFQUFBVyxLQUFBb0IsVUFBQSxrQkFBQS9CLEVBQUFnQyxtQkFBQWhDLEVBQUFXLE1BQUFQLEVBQUEsT0FBd0tFLE9BQU9oQyxLQUFBMEIsRUFBQVcsS0FBQUMsS0FBQXFCLFdBQUFqQyxFQUFBVyxLQUFBdUIsa0JBQUFDLE1BQUFuQyxFQUFBVyxLQUFBZ0IsU0FBcUYzQixFQUFBb0MsTUFBQSxNQUNqeUJDLEtDREFDLEVBQUEsV0FBMEIsSUFBQXRDLEVBQUFDLEtBQWFDLEVBQUFGLEVBQUFHLGVBQTBCQyxFQUFBSixFQUFBSyxNQUFBRCxJQUFBRixFQUF3QixPQUFBRSxFQUFBLE9BQWlCSyxZQUFBLHlEQUFtRUwsRUFBQSxLQUFVSyxZQUFBLFlBQUFRLGFBQXFDc0IsY0FBQSxTQUFxQnZDLEVBQUFvQixHQUFBcEIsRUFBQXlCLEdBQUF6QixFQUFBd0MsY0FDalBDLEtDTUFDLGNBQ0FwRSxLQUFBLE1BQ0FyQyxLQUFBLFdBQ0EsT0FDQXVHLE9BQUEsS0FHQUcsT0FDQXJFLEtBQUEsR0FDQTZELE1BQUEsR0FDQUYsV0FBQSxJQUVBVyxRQUFBLFdBQ0EsV0FBQTNDLEtBQUFrQyxRQUNBbEMsS0FBQXVDLE9BQUF2QyxLQUFBZ0MsWUFFQSxJQUFBWSxFQUFBNUMsS0FDQTZDLEVBQUFELEdBQ0FFLFlBQUEsV0FDQUQsRUFBQUQsSUFDQSxNQUVBRyxhQUlBRixFQUFBLFNBQUFELEdBQ0EsY0FBQUEsRUFBQVYsTUFBQSxDQUdBLElBQUFjLEVBQUEsSUFBQUMsUUFBQSxZQUFBTCxFQUFBdkUsTUFDQTZFLE1BQUFGLEdBQUFHLEtBQUEsU0FBQUMsR0FBQSxPQUFBQSxFQUFBQyxTQUNBRixLQUFBLFNBQUFHLEdBQ0FWLEVBQUFXLFVBQUEsV0FDQVgsRUFBQUwsT0FBQWUsUUN6Q3VRRSxFQUFBLDBCQ1F2UUMsRUFBQTlHLE9BQUErRyxFQUFBLEtBQUEvRyxDQUNBNkcsRUFDQW5CLEVBQ0FHLEdBQ0EsRUFDQSxLQUNBLFdBQ0EsTUFJQWlCLEVBQUFFLFFBQUFDLE9BQUEsVUFDQSxJQUFBQyxFQUFBSixVQ0hBSyxHQUNBekYsS0FBQSxVQUNBcUUsT0FDQWhDLFNBRUFxRCxZQUNBRixPQUVBZCxTQUNBekIsWUFBQSxTQUFBWixHQUNBLE9BQ0FzRCx3QkFBQSxVQUFBdEQsRUFBQWdCLE1BQ0F1Qyx5QkFBQSxVQUFBdkQsRUFBQWdCLE1BQ0F3Qyw0QkFBQSxXQUFBeEQsRUFBQWdCLE1BQ0F5QywyQkFBQSxJQUFBekQsRUFBQWdCLFFBR0EwQyxVQUFBLFNBQUExRCxHQUNBLGdCQUFBQSxFQUFBZ0IsT0FFQUssbUJBQUEsU0FBQXJCLEdBQ0EsaUJBQUFBLEVBQUFnQixPQUFBLFVBQUFoQixFQUFBZ0IsU0N0QzJRMkMsRUFBQSxFQ08zUUMsRUFBQTNILE9BQUErRyxFQUFBLEtBQUEvRyxDQUNBMEgsRUFDQWpELEVBQ0FnQixHQUNBLEVBQ0EsS0FDQSxLQUNBLE1BSUFrQyxFQUFBWCxRQUFBQyxPQUFBLGNBQ0EsSUFBQVcsRUFBQUQsVUNHQUUsR0FDQW5HLEtBQUEsTUFDQXJDLEtBQUEsV0FDQSxPQUNBeUksY0FHQVYsWUFDQVEsV0FFQTVCLFFBQUEsV0FDQStCLEVBQUExRSxNQUNBLElBQUE0QyxFQUFBNUMsS0FDQThDLFlBQUEsV0FBQTRCLEVBQUE5QixJQUFBLE1BRUFHLFlBSUEyQixFQUFBLFNBQUE5QixHQUNBLElBQUFJLEVBQUEsSUFBQUMsUUFBQSxlQUNBQyxNQUFBRixHQUFBRyxLQUFBLFNBQUFDLEdBQUEsT0FBQUEsRUFBQXVCLFNBQ0F4QixLQUFBLFNBQUFHLEdBQ0FWLEVBQUFXLFVBQUEsV0FDQVgsRUFBQTZCLFNBQUFuQixPQzlDMlBzQixFQUFBLEVDTzNQQyxFQUFBbEksT0FBQStHLEVBQUEsS0FBQS9HLENBQ0FpSSxFQUNBOUUsRUFDQWMsR0FDQSxFQUNBLEtBQ0EsS0FDQSxNQUlBaUUsRUFBQWxCLFFBQUFDLE9BQUEsVUFDQSxJQUFBa0IsRUFBQUQsVUNoQkFFLEVBQUEsS0FBSUMsT0FBT0MsZUFBZ0IsRUFFM0IsSUFBSUYsRUFBQSxNQUNGRyxPQUFRLFNBQUFDLEdBQUEsT0FBS0EsRUFBRUwsTUFDZE0sT0FBTyxvRUNQcWNDLEVBQUEiLCJmaWxlIjoianMvYXBwLjlmNDRlMDExLmpzIiwic291cmNlc0NvbnRlbnQiOlsiIFx0Ly8gaW5zdGFsbCBhIEpTT05QIGNhbGxiYWNrIGZvciBjaHVuayBsb2FkaW5nXG4gXHRmdW5jdGlvbiB3ZWJwYWNrSnNvbnBDYWxsYmFjayhkYXRhKSB7XG4gXHRcdHZhciBjaHVua0lkcyA9IGRhdGFbMF07XG4gXHRcdHZhciBtb3JlTW9kdWxlcyA9IGRhdGFbMV07XG4gXHRcdHZhciBleGVjdXRlTW9kdWxlcyA9IGRhdGFbMl07XG5cbiBcdFx0Ly8gYWRkIFwibW9yZU1vZHVsZXNcIiB0byB0aGUgbW9kdWxlcyBvYmplY3QsXG4gXHRcdC8vIHRoZW4gZmxhZyBhbGwgXCJjaHVua0lkc1wiIGFzIGxvYWRlZCBhbmQgZmlyZSBjYWxsYmFja1xuIFx0XHR2YXIgbW9kdWxlSWQsIGNodW5rSWQsIGkgPSAwLCByZXNvbHZlcyA9IFtdO1xuIFx0XHRmb3IoO2kgPCBjaHVua0lkcy5sZW5ndGg7IGkrKykge1xuIFx0XHRcdGNodW5rSWQgPSBjaHVua0lkc1tpXTtcbiBcdFx0XHRpZihpbnN0YWxsZWRDaHVua3NbY2h1bmtJZF0pIHtcbiBcdFx0XHRcdHJlc29sdmVzLnB1c2goaW5zdGFsbGVkQ2h1bmtzW2NodW5rSWRdWzBdKTtcbiBcdFx0XHR9XG4gXHRcdFx0aW5zdGFsbGVkQ2h1bmtzW2NodW5rSWRdID0gMDtcbiBcdFx0fVxuIFx0XHRmb3IobW9kdWxlSWQgaW4gbW9yZU1vZHVsZXMpIHtcbiBcdFx0XHRpZihPYmplY3QucHJvdG90eXBlLmhhc093blByb3BlcnR5LmNhbGwobW9yZU1vZHVsZXMsIG1vZHVsZUlkKSkge1xuIFx0XHRcdFx0bW9kdWxlc1ttb2R1bGVJZF0gPSBtb3JlTW9kdWxlc1ttb2R1bGVJZF07XG4gXHRcdFx0fVxuIFx0XHR9XG4gXHRcdGlmKHBhcmVudEpzb25wRnVuY3Rpb24pIHBhcmVudEpzb25wRnVuY3Rpb24oZGF0YSk7XG5cbiBcdFx0d2hpbGUocmVzb2x2ZXMubGVuZ3RoKSB7XG4gXHRcdFx0cmVzb2x2ZXMuc2hpZnQoKSgpO1xuIFx0XHR9XG5cbiBcdFx0Ly8gYWRkIGVudHJ5IG1vZHVsZXMgZnJvbSBsb2FkZWQgY2h1bmsgdG8gZGVmZXJyZWQgbGlzdFxuIFx0XHRkZWZlcnJlZE1vZHVsZXMucHVzaC5hcHBseShkZWZlcnJlZE1vZHVsZXMsIGV4ZWN1dGVNb2R1bGVzIHx8IFtdKTtcblxuIFx0XHQvLyBydW4gZGVmZXJyZWQgbW9kdWxlcyB3aGVuIGFsbCBjaHVua3MgcmVhZHlcbiBcdFx0cmV0dXJuIGNoZWNrRGVmZXJyZWRNb2R1bGVzKCk7XG4gXHR9O1xuIFx0ZnVuY3Rpb24gY2hlY2tEZWZlcnJlZE1vZHVsZXMoKSB7XG4gXHRcdHZhciByZXN1bHQ7XG4gXHRcdGZvcih2YXIgaSA9IDA7IGkgPCBkZWZlcnJlZE1vZHVsZXMubGVuZ3RoOyBpKyspIHtcbiBcdFx0XHR2YXIgZGVmZXJyZWRNb2R1bGUgPSBkZWZlcnJlZE1vZHVsZXNbaV07XG4gXHRcdFx0dmFyIGZ1bGZpbGxlZCA9IHRydWU7XG4gXHRcdFx0Zm9yKHZhciBqID0gMTsgaiA8IGRlZmVycmVkTW9kdWxlLmxlbmd0aDsgaisrKSB7XG4gXHRcdFx0XHR2YXIgZGVwSWQgPSBkZWZlcnJlZE1vZHVsZVtqXTtcbiBcdFx0XHRcdGlmKGluc3RhbGxlZENodW5rc1tkZXBJZF0gIT09IDApIGZ1bGZpbGxlZCA9IGZhbHNlO1xuIFx0XHRcdH1cbiBcdFx0XHRpZihmdWxmaWxsZWQpIHtcbiBcdFx0XHRcdGRlZmVycmVkTW9kdWxlcy5zcGxpY2UoaS0tLCAxKTtcbiBcdFx0XHRcdHJlc3VsdCA9IF9fd2VicGFja19yZXF1aXJlX18oX193ZWJwYWNrX3JlcXVpcmVfXy5zID0gZGVmZXJyZWRNb2R1bGVbMF0pO1xuIFx0XHRcdH1cbiBcdFx0fVxuIFx0XHRyZXR1cm4gcmVzdWx0O1xuIFx0fVxuXG4gXHQvLyBUaGUgbW9kdWxlIGNhY2hlXG4gXHR2YXIgaW5zdGFsbGVkTW9kdWxlcyA9IHt9O1xuXG4gXHQvLyBvYmplY3QgdG8gc3RvcmUgbG9hZGVkIGFuZCBsb2FkaW5nIGNodW5rc1xuIFx0Ly8gdW5kZWZpbmVkID0gY2h1bmsgbm90IGxvYWRlZCwgbnVsbCA9IGNodW5rIHByZWxvYWRlZC9wcmVmZXRjaGVkXG4gXHQvLyBQcm9taXNlID0gY2h1bmsgbG9hZGluZywgMCA9IGNodW5rIGxvYWRlZFxuIFx0dmFyIGluc3RhbGxlZENodW5rcyA9IHtcbiBcdFx0XCJhcHBcIjogMFxuIFx0fTtcblxuIFx0dmFyIGRlZmVycmVkTW9kdWxlcyA9IFtdO1xuXG4gXHQvLyBUaGUgcmVxdWlyZSBmdW5jdGlvblxuIFx0ZnVuY3Rpb24gX193ZWJwYWNrX3JlcXVpcmVfXyhtb2R1bGVJZCkge1xuXG4gXHRcdC8vIENoZWNrIGlmIG1vZHVsZSBpcyBpbiBjYWNoZVxuIFx0XHRpZihpbnN0YWxsZWRNb2R1bGVzW21vZHVsZUlkXSkge1xuIFx0XHRcdHJldHVybiBpbnN0YWxsZWRNb2R1bGVzW21vZHVsZUlkXS5leHBvcnRzO1xuIFx0XHR9XG4gXHRcdC8vIENyZWF0ZSBhIG5ldyBtb2R1bGUgKGFuZCBwdXQgaXQgaW50byB0aGUgY2FjaGUpXG4gXHRcdHZhciBtb2R1bGUgPSBpbnN0YWxsZWRNb2R1bGVzW21vZHVsZUlkXSA9IHtcbiBcdFx0XHRpOiBtb2R1bGVJZCxcbiBcdFx0XHRsOiBmYWxzZSxcbiBcdFx0XHRleHBvcnRzOiB7fVxuIFx0XHR9O1xuXG4gXHRcdC8vIEV4ZWN1dGUgdGhlIG1vZHVsZSBmdW5jdGlvblxuIFx0XHRtb2R1bGVzW21vZHVsZUlkXS5jYWxsKG1vZHVsZS5leHBvcnRzLCBtb2R1bGUsIG1vZHVsZS5leHBvcnRzLCBfX3dlYnBhY2tfcmVxdWlyZV9fKTtcblxuIFx0XHQvLyBGbGFnIHRoZSBtb2R1bGUgYXMgbG9hZGVkXG4gXHRcdG1vZHVsZS5sID0gdHJ1ZTtcblxuIFx0XHQvLyBSZXR1cm4gdGhlIGV4cG9ydHMgb2YgdGhlIG1vZHVsZVxuIFx0XHRyZXR1cm4gbW9kdWxlLmV4cG9ydHM7XG4gXHR9XG5cblxuIFx0Ly8gZXhwb3NlIHRoZSBtb2R1bGVzIG9iamVjdCAoX193ZWJwYWNrX21vZHVsZXNfXylcbiBcdF9fd2VicGFja19yZXF1aXJlX18ubSA9IG1vZHVsZXM7XG5cbiBcdC8vIGV4cG9zZSB0aGUgbW9kdWxlIGNhY2hlXG4gXHRfX3dlYnBhY2tfcmVxdWlyZV9fLmMgPSBpbnN0YWxsZWRNb2R1bGVzO1xuXG4gXHQvLyBkZWZpbmUgZ2V0dGVyIGZ1bmN0aW9uIGZvciBoYXJtb255IGV4cG9ydHNcbiBcdF9fd2VicGFja19yZXF1aXJlX18uZCA9IGZ1bmN0aW9uKGV4cG9ydHMsIG5hbWUsIGdldHRlcikge1xuIFx0XHRpZighX193ZWJwYWNrX3JlcXVpcmVfXy5vKGV4cG9ydHMsIG5hbWUpKSB7XG4gXHRcdFx0T2JqZWN0LmRlZmluZVByb3BlcnR5KGV4cG9ydHMsIG5hbWUsIHsgZW51bWVyYWJsZTogdHJ1ZSwgZ2V0OiBnZXR0ZXIgfSk7XG4gXHRcdH1cbiBcdH07XG5cbiBcdC8vIGRlZmluZSBfX2VzTW9kdWxlIG9uIGV4cG9ydHNcbiBcdF9fd2VicGFja19yZXF1aXJlX18uciA9IGZ1bmN0aW9uKGV4cG9ydHMpIHtcbiBcdFx0aWYodHlwZW9mIFN5bWJvbCAhPT0gJ3VuZGVmaW5lZCcgJiYgU3ltYm9sLnRvU3RyaW5nVGFnKSB7XG4gXHRcdFx0T2JqZWN0LmRlZmluZVByb3BlcnR5KGV4cG9ydHMsIFN5bWJvbC50b1N0cmluZ1RhZywgeyB2YWx1ZTogJ01vZHVsZScgfSk7XG4gXHRcdH1cbiBcdFx0T2JqZWN0LmRlZmluZVByb3BlcnR5KGV4cG9ydHMsICdfX2VzTW9kdWxlJywgeyB2YWx1ZTogdHJ1ZSB9KTtcbiBcdH07XG5cbiBcdC8vIGNyZWF0ZSBhIGZha2UgbmFtZXNwYWNlIG9iamVjdFxuIFx0Ly8gbW9kZSAmIDE6IHZhbHVlIGlzIGEgbW9kdWxlIGlkLCByZXF1aXJlIGl0XG4gXHQvLyBtb2RlICYgMjogbWVyZ2UgYWxsIHByb3BlcnRpZXMgb2YgdmFsdWUgaW50byB0aGUgbnNcbiBcdC8vIG1vZGUgJiA0OiByZXR1cm4gdmFsdWUgd2hlbiBhbHJlYWR5IG5zIG9iamVjdFxuIFx0Ly8gbW9kZSAmIDh8MTogYmVoYXZlIGxpa2UgcmVxdWlyZVxuIFx0X193ZWJwYWNrX3JlcXVpcmVfXy50ID0gZnVuY3Rpb24odmFsdWUsIG1vZGUpIHtcbiBcdFx0aWYobW9kZSAmIDEpIHZhbHVlID0gX193ZWJwYWNrX3JlcXVpcmVfXyh2YWx1ZSk7XG4gXHRcdGlmKG1vZGUgJiA4KSByZXR1cm4gdmFsdWU7XG4gXHRcdGlmKChtb2RlICYgNCkgJiYgdHlwZW9mIHZhbHVlID09PSAnb2JqZWN0JyAmJiB2YWx1ZSAmJiB2YWx1ZS5fX2VzTW9kdWxlKSByZXR1cm4gdmFsdWU7XG4gXHRcdHZhciBucyA9IE9iamVjdC5jcmVhdGUobnVsbCk7XG4gXHRcdF9fd2VicGFja19yZXF1aXJlX18ucihucyk7XG4gXHRcdE9iamVjdC5kZWZpbmVQcm9wZXJ0eShucywgJ2RlZmF1bHQnLCB7IGVudW1lcmFibGU6IHRydWUsIHZhbHVlOiB2YWx1ZSB9KTtcbiBcdFx0aWYobW9kZSAmIDIgJiYgdHlwZW9mIHZhbHVlICE9ICdzdHJpbmcnKSBmb3IodmFyIGtleSBpbiB2YWx1ZSkgX193ZWJwYWNrX3JlcXVpcmVfXy5kKG5zLCBrZXksIGZ1bmN0aW9uKGtleSkgeyByZXR1cm4gdmFsdWVba2V5XTsgfS5iaW5kKG51bGwsIGtleSkpO1xuIFx0XHRyZXR1cm4gbnM7XG4gXHR9O1xuXG4gXHQvLyBnZXREZWZhdWx0RXhwb3J0IGZ1bmN0aW9uIGZvciBjb21wYXRpYmlsaXR5IHdpdGggbm9uLWhhcm1vbnkgbW9kdWxlc1xuIFx0X193ZWJwYWNrX3JlcXVpcmVfXy5uID0gZnVuY3Rpb24obW9kdWxlKSB7XG4gXHRcdHZhciBnZXR0ZXIgPSBtb2R1bGUgJiYgbW9kdWxlLl9fZXNNb2R1bGUgP1xuIFx0XHRcdGZ1bmN0aW9uIGdldERlZmF1bHQoKSB7IHJldHVybiBtb2R1bGVbJ2RlZmF1bHQnXTsgfSA6XG4gXHRcdFx0ZnVuY3Rpb24gZ2V0TW9kdWxlRXhwb3J0cygpIHsgcmV0dXJuIG1vZHVsZTsgfTtcbiBcdFx0X193ZWJwYWNrX3JlcXVpcmVfXy5kKGdldHRlciwgJ2EnLCBnZXR0ZXIpO1xuIFx0XHRyZXR1cm4gZ2V0dGVyO1xuIFx0fTtcblxuIFx0Ly8gT2JqZWN0LnByb3RvdHlwZS5oYXNPd25Qcm9wZXJ0eS5jYWxsXG4gXHRfX3dlYnBhY2tfcmVxdWlyZV9fLm8gPSBmdW5jdGlvbihvYmplY3QsIHByb3BlcnR5KSB7IHJldHVybiBPYmplY3QucHJvdG90eXBlLmhhc093blByb3BlcnR5LmNhbGwob2JqZWN0LCBwcm9wZXJ0eSk7IH07XG5cbiBcdC8vIF9fd2VicGFja19wdWJsaWNfcGF0aF9fXG4gXHRfX3dlYnBhY2tfcmVxdWlyZV9fLnAgPSBcIi91aS9cIjtcblxuIFx0dmFyIGpzb25wQXJyYXkgPSB3aW5kb3dbXCJ3ZWJwYWNrSnNvbnBcIl0gPSB3aW5kb3dbXCJ3ZWJwYWNrSnNvbnBcIl0gfHwgW107XG4gXHR2YXIgb2xkSnNvbnBGdW5jdGlvbiA9IGpzb25wQXJyYXkucHVzaC5iaW5kKGpzb25wQXJyYXkpO1xuIFx0anNvbnBBcnJheS5wdXNoID0gd2VicGFja0pzb25wQ2FsbGJhY2s7XG4gXHRqc29ucEFycmF5ID0ganNvbnBBcnJheS5zbGljZSgpO1xuIFx0Zm9yKHZhciBpID0gMDsgaSA8IGpzb25wQXJyYXkubGVuZ3RoOyBpKyspIHdlYnBhY2tKc29ucENhbGxiYWNrKGpzb25wQXJyYXlbaV0pO1xuIFx0dmFyIHBhcmVudEpzb25wRnVuY3Rpb24gPSBvbGRKc29ucEZ1bmN0aW9uO1xuXG5cbiBcdC8vIGFkZCBlbnRyeSBtb2R1bGUgdG8gZGVmZXJyZWQgbGlzdFxuIFx0ZGVmZXJyZWRNb2R1bGVzLnB1c2goWzAsXCJjaHVuay12ZW5kb3JzXCJdKTtcbiBcdC8vIHJ1biBkZWZlcnJlZCBtb2R1bGVzIHdoZW4gcmVhZHlcbiBcdHJldHVybiBjaGVja0RlZmVycmVkTW9kdWxlcygpO1xuIiwidmFyIHJlbmRlciA9IGZ1bmN0aW9uICgpIHt2YXIgX3ZtPXRoaXM7dmFyIF9oPV92bS4kY3JlYXRlRWxlbWVudDt2YXIgX2M9X3ZtLl9zZWxmLl9jfHxfaDtyZXR1cm4gX2MoJ2Rpdicse2F0dHJzOntcImlkXCI6XCJhcHBcIn19LFtfdm0uX20oMCksX2MoJ2Rpdicse3N0YXRpY0NsYXNzOlwiY29udGFpbmVyXCJ9LFtfYygnZGl2Jyx7c3RhdGljQ2xhc3M6XCJjb2x1bW5zIGlzLW11bHRpbGluZVwifSxfdm0uX2woKF92bS50ZXN0RGF0YSksZnVuY3Rpb24odGVzdCl7cmV0dXJuIF9jKCdkaXYnLHtrZXk6dGVzdC5OYW1lLHN0YXRpY0NsYXNzOlwiY29sdW1uIGlzLWhhbGZcIn0sW19jKCdUZXN0Qm94Jyx7YXR0cnM6e1widGVzdFwiOnRlc3R9fSldLDEpfSkpXSldKX1cbnZhciBzdGF0aWNSZW5kZXJGbnMgPSBbZnVuY3Rpb24gKCkge3ZhciBfdm09dGhpczt2YXIgX2g9X3ZtLiRjcmVhdGVFbGVtZW50O3ZhciBfYz1fdm0uX3NlbGYuX2N8fF9oO3JldHVybiBfYygnbmF2Jyx7c3RhdGljQ2xhc3M6XCJuYXZiYXJcIixhdHRyczp7XCJyb2xlXCI6XCJuYXZpZ2F0aW9uXCIsXCJhcmlhLWxhYmVsXCI6XCJtYWluIG5hdmlnYXRpb25cIn19LFtfYygnZGl2Jyx7c3RhdGljQ2xhc3M6XCJuYXZiYXItYnJhbmRcIn0sW19jKCdhJyx7c3RhdGljQ2xhc3M6XCJuYXZiYXItaXRlbVwiLGF0dHJzOntcImhyZWZcIjpcImh0dHBzOi8vYXZvY2V0LmlvXCJ9fSxbX2MoJ3AnLHtzdGF0aWNDbGFzczpcInRpdGxlXCIsc3RhdGljU3R5bGU6e1wicGFkZGluZ1wiOlwiMC41cmVtXCIsXCJtYXJnaW5cIjpcIjAuNXJlbVwifX0sW192bS5fdihcImNhbmFyeVwiKV0pXSldKV0pfV1cblxuZXhwb3J0IHsgcmVuZGVyLCBzdGF0aWNSZW5kZXJGbnMgfSIsInZhciByZW5kZXIgPSBmdW5jdGlvbiAoKSB7dmFyIF92bT10aGlzO3ZhciBfaD1fdm0uJGNyZWF0ZUVsZW1lbnQ7dmFyIF9jPV92bS5fc2VsZi5fY3x8X2g7cmV0dXJuIF9jKCdkaXYnLHtzdGF0aWNDbGFzczpcImhhcy10ZXh0LXdoaXRlXCIsY2xhc3M6X3ZtLnRlc3RDbGFzc2VzKF92bS50ZXN0KSxzdGF0aWNTdHlsZTp7XCJib3JkZXItcmFkaXVzXCI6XCIzcHhcIixcInBhZGRpbmdcIjpcIjJyZW1cIn19LFtfYygnaDEnLHtzdGF0aWNDbGFzczpcInRpdGxlIGlzLTQgaGFzLXRleHQtd2hpdGVcIn0sW192bS5fdihcIlxcblxcdFxcdFwiK192bS5fcyhfdm0udGVzdC5OYW1lKStcIlxcblxcdFwiKV0pLF9jKCdkaXYnLHtzdGF0aWNDbGFzczpcImNvbHVtbnNcIixzdGF0aWNTdHlsZTp7XCJoZWlnaHRcIjpcIjEwMHB4XCJ9fSxbX2MoJ2Rpdicse3N0YXRpY0NsYXNzOlwiY29sdW1uIGlzLW9uZS10aGlyZFwifSxbX2MoJ3AnLHtzdGF0aWNDbGFzczpcImlzLXNpemUtN1wifSxbX3ZtLl92KFwic3RhdGU6IFwiK192bS5fcyhfdm0udGVzdC5TdGF0ZSkpXSksX2MoJ3AnLHtzdGF0aWNDbGFzczpcImlzLXNpemUtN1wifSxbX3ZtLl92KFwicGFzcyByYXRlOiBcIitfdm0uX3MoTWF0aC5yb3VuZCgoKF92bS50ZXN0LlN1Y2Nlc3Nlcy8oX3ZtLnRlc3QuU3VjY2Vzc2VzK192bS50ZXN0LkZhaWx1cmVzKSkgKiAxMDApfHwwKSkrXCIlXCIpXSldKSwoX3ZtLmlzRmFpbGluZ09yUnVubmluZyhfdm0udGVzdCkpP19jKCdMb2cnLHthdHRyczp7XCJuYW1lXCI6X3ZtLnRlc3QuTmFtZSxcImJhc2VPdXRwdXRcIjpfdm0udGVzdC5MYXN0RmFpbHVyZU91dHB1dCxcInN0YXRlXCI6X3ZtLnRlc3QuU3RhdGV9fSk6X3ZtLl9lKCldLDEpXSl9XG52YXIgc3RhdGljUmVuZGVyRm5zID0gW11cblxuZXhwb3J0IHsgcmVuZGVyLCBzdGF0aWNSZW5kZXJGbnMgfSIsInZhciByZW5kZXIgPSBmdW5jdGlvbiAoKSB7dmFyIF92bT10aGlzO3ZhciBfaD1fdm0uJGNyZWF0ZUVsZW1lbnQ7dmFyIF9jPV92bS5fc2VsZi5fY3x8X2g7cmV0dXJuIF9jKCdkaXYnLHtzdGF0aWNDbGFzczpcImxvZ2JveCBoYXMtYmFja2dyb3VuZC1ncmV5LWRhcmsgY29sdW1uIGlzLXR3by10aGlyZHNcIn0sW19jKCdwJyx7c3RhdGljQ2xhc3M6XCJpcy1zaXplLTdcIixzdGF0aWNTdHlsZTp7XCJ3aGl0ZS1zcGFjZVwiOlwicHJlXCJ9fSxbX3ZtLl92KF92bS5fcyhfdm0ub3V0cHV0KSldKV0pfVxudmFyIHN0YXRpY1JlbmRlckZucyA9IFtdXG5cbmV4cG9ydCB7IHJlbmRlciwgc3RhdGljUmVuZGVyRm5zIH0iLCI8dGVtcGxhdGU+XG5cdDxkaXYgY2xhc3M9XCJsb2dib3ggaGFzLWJhY2tncm91bmQtZ3JleS1kYXJrIGNvbHVtbiBpcy10d28tdGhpcmRzXCI+XG5cdFx0PHAgc3R5bGU9XCJ3aGl0ZS1zcGFjZTogcHJlO1wiIGNsYXNzPVwiaXMtc2l6ZS03XCI+e3sgb3V0cHV0IH19PC9wPlxuXHQ8L2Rpdj5cbjwvdGVtcGxhdGU+XG5cbjxzY3JpcHQ+XG5leHBvcnQgZGVmYXVsdCB7XG4gIG5hbWU6ICdMb2cnLFxuXHRkYXRhOiBmdW5jdGlvbigpIHtcblx0XHRyZXR1cm4ge1xuXHRcdFx0b3V0cHV0OiBcIlwiXG5cdFx0fVxuXHR9LFxuICBwcm9wczoge1xuXHRcdG5hbWU6IFwiXCIsXG5cdFx0c3RhdGU6IFwiXCIsXG5cdFx0YmFzZU91dHB1dDogXCJcIixcbiAgfSxcblx0bW91bnRlZDogZnVuY3Rpb24oKSB7XG5cdFx0aWYgKHRoaXMuc3RhdGUgIT0gXCJSVU5OSU5HXCIpIHtcblx0XHRcdHRoaXMub3V0cHV0ID0gdGhpcy5iYXNlT3V0cHV0XG5cdFx0fVxuXHRcdHZhciBzZWxmID0gdGhpcztcblx0XHRnZXRPdXRwdXQoc2VsZik7XG5cdFx0c2V0SW50ZXJ2YWwoZnVuY3Rpb24oKSB7XG5cdFx0XHRnZXRPdXRwdXQoc2VsZik7XG5cdFx0fSwgMTAwMClcblx0fSxcblx0bWV0aG9kczoge1xuXHR9IFxufVxuXG52YXIgZ2V0T3V0cHV0ID0gZnVuY3Rpb24oc2VsZikge1xuXHRpZiAoc2VsZi5zdGF0ZSAhPSBcIlJVTk5JTkdcIikge1xuXHRcdHJldHVyblxuXHR9XG5cdHZhciByZXEgPSBuZXcgUmVxdWVzdChcIi9hcGkvbG9nL1wiK3NlbGYubmFtZSlcblx0ZmV0Y2gocmVxKS50aGVuKHJlcyA9PiByZXMudGV4dCgpKVxuXHRcdC50aGVuKGZ1bmN0aW9uKGJvZHkpIHtcblx0XHRcdHNlbGYuJG5leHRUaWNrKGZ1bmN0aW9uKCkge1xuXHRcdFx0XHRzZWxmLm91dHB1dCA9IGJvZHlcblx0XHRcdH0pXG5cdFx0fSlcbn1cblxuPC9zY3JpcHQ+XG5cbjxzdHlsZSBzY29wZWQ+XG4ubG9nYm94IHtcblx0b3ZlcmZsb3cteTogc2Nyb2xsOyBcblx0b3ZlcmZsb3cteDogc2Nyb2xsOyBcblx0cGFkZGluZzogMC41cmVtOyBcblx0bWFyZ2luLXJpZ2h0OiAxLjVyZW07XG5cdGJvcmRlci1yYWRpdXM6IDNweDtcbn1cbjwvc3R5bGU+XG4iLCJpbXBvcnQgbW9kIGZyb20gXCItIS4uLy4uL25vZGVfbW9kdWxlcy9jYWNoZS1sb2FkZXIvZGlzdC9janMuanM/P3JlZi0tMTItMCEuLi8uLi9ub2RlX21vZHVsZXMvdGhyZWFkLWxvYWRlci9kaXN0L2Nqcy5qcyEuLi8uLi9ub2RlX21vZHVsZXMvYmFiZWwtbG9hZGVyL2xpYi9pbmRleC5qcyEuLi8uLi9ub2RlX21vZHVsZXMvdnVlLWxvYWRlci9saWIvaW5kZXguanM/P3Z1ZS1sb2FkZXItb3B0aW9ucyEuL0xvZy52dWU/dnVlJnR5cGU9c2NyaXB0Jmxhbmc9anMmXCI7IGV4cG9ydCBkZWZhdWx0IG1vZDsgZXhwb3J0ICogZnJvbSBcIi0hLi4vLi4vbm9kZV9tb2R1bGVzL2NhY2hlLWxvYWRlci9kaXN0L2Nqcy5qcz8/cmVmLS0xMi0wIS4uLy4uL25vZGVfbW9kdWxlcy90aHJlYWQtbG9hZGVyL2Rpc3QvY2pzLmpzIS4uLy4uL25vZGVfbW9kdWxlcy9iYWJlbC1sb2FkZXIvbGliL2luZGV4LmpzIS4uLy4uL25vZGVfbW9kdWxlcy92dWUtbG9hZGVyL2xpYi9pbmRleC5qcz8/dnVlLWxvYWRlci1vcHRpb25zIS4vTG9nLnZ1ZT92dWUmdHlwZT1zY3JpcHQmbGFuZz1qcyZcIiIsImltcG9ydCB7IHJlbmRlciwgc3RhdGljUmVuZGVyRm5zIH0gZnJvbSBcIi4vTG9nLnZ1ZT92dWUmdHlwZT10ZW1wbGF0ZSZpZD00MTFiMDBlYiZzY29wZWQ9dHJ1ZSZcIlxuaW1wb3J0IHNjcmlwdCBmcm9tIFwiLi9Mb2cudnVlP3Z1ZSZ0eXBlPXNjcmlwdCZsYW5nPWpzJlwiXG5leHBvcnQgKiBmcm9tIFwiLi9Mb2cudnVlP3Z1ZSZ0eXBlPXNjcmlwdCZsYW5nPWpzJlwiXG5pbXBvcnQgc3R5bGUwIGZyb20gXCIuL0xvZy52dWU/dnVlJnR5cGU9c3R5bGUmaW5kZXg9MCZpZD00MTFiMDBlYiZzY29wZWQ9dHJ1ZSZsYW5nPWNzcyZcIlxuXG5cbi8qIG5vcm1hbGl6ZSBjb21wb25lbnQgKi9cbmltcG9ydCBub3JtYWxpemVyIGZyb20gXCIhLi4vLi4vbm9kZV9tb2R1bGVzL3Z1ZS1sb2FkZXIvbGliL3J1bnRpbWUvY29tcG9uZW50Tm9ybWFsaXplci5qc1wiXG52YXIgY29tcG9uZW50ID0gbm9ybWFsaXplcihcbiAgc2NyaXB0LFxuICByZW5kZXIsXG4gIHN0YXRpY1JlbmRlckZucyxcbiAgZmFsc2UsXG4gIG51bGwsXG4gIFwiNDExYjAwZWJcIixcbiAgbnVsbFxuICBcbilcblxuY29tcG9uZW50Lm9wdGlvbnMuX19maWxlID0gXCJMb2cudnVlXCJcbmV4cG9ydCBkZWZhdWx0IGNvbXBvbmVudC5leHBvcnRzIiwiPHRlbXBsYXRlPlxuXHQ8ZGl2IGNsYXNzPVwiaGFzLXRleHQtd2hpdGVcIiBzdHlsZT1cImJvcmRlci1yYWRpdXM6IDNweDsgcGFkZGluZzogMnJlbTtcIiB2LWJpbmQ6Y2xhc3M9XCJ0ZXN0Q2xhc3Nlcyh0ZXN0KVwiPlxuXHRcdDxoMSBjbGFzcz1cInRpdGxlIGlzLTQgaGFzLXRleHQtd2hpdGVcIj5cblx0XHRcdHt7IHRlc3QuTmFtZSB9fVxuXHRcdDwvaDE+XG5cdFx0PGRpdiBjbGFzcz1cImNvbHVtbnNcIiBzdHlsZT1cImhlaWdodDoxMDBweDtcIj5cblx0XHRcdDxkaXYgY2xhc3M9XCJjb2x1bW4gaXMtb25lLXRoaXJkXCI+XG5cdFx0XHRcdDxwIGNsYXNzPVwiaXMtc2l6ZS03XCI+c3RhdGU6IHt7IHRlc3QuU3RhdGUgfX08L3A+XG5cdFx0XHRcdDxwIGNsYXNzPVwiaXMtc2l6ZS03XCI+cGFzcyByYXRlOiB7eyBNYXRoLnJvdW5kKCgodGVzdC5TdWNjZXNzZXMvKHRlc3QuU3VjY2Vzc2VzK3Rlc3QuRmFpbHVyZXMpKSAqIDEwMCl8fDApIH19JTwvcD5cblx0XHRcdDwvZGl2PlxuXHRcdFx0PExvZyB2LWlmPVwiaXNGYWlsaW5nT3JSdW5uaW5nKHRlc3QpXCIgdi1iaW5kOm5hbWU9XCJ0ZXN0Lk5hbWVcIiB2LWJpbmQ6YmFzZU91dHB1dD1cInRlc3QuTGFzdEZhaWx1cmVPdXRwdXRcIiB2LWJpbmQ6c3RhdGU9XCJ0ZXN0LlN0YXRlXCIvPlxuXHRcdDwvZGl2PlxuXHQ8L2Rpdj5cbjwvdGVtcGxhdGU+XG5cbjxzY3JpcHQ+XG5pbXBvcnQgTG9nIGZyb20gJy4vTG9nLnZ1ZSdcbmV4cG9ydCBkZWZhdWx0IHtcbiAgbmFtZTogJ1Rlc3RCb3gnLFxuICBwcm9wczoge1xuXHRcdHRlc3Q6IHt9LCBcbiAgfSxcblx0Y29tcG9uZW50czoge1xuXHRcdExvZ1xuXHR9LFxuXHRtZXRob2RzOiB7XG5cdFx0dGVzdENsYXNzZXM6IGZ1bmN0aW9uICh0ZXN0KSB7XG5cdFx0XHRyZXR1cm4ge1xuXHRcdFx0XHQnaGFzLWJhY2tncm91bmQtZGFuZ2VyJzogdGVzdC5TdGF0ZSA9PSBcIkZBSUxFRFwiLFxuXHRcdFx0XHQnaGFzLWJhY2tncm91bmQtc3VjY2Vzcyc6IHRlc3QuU3RhdGUgPT0gXCJQQVNTRURcIixcblx0XHRcdFx0J2hhcy1iYWNrZ3JvdW5kLWdyZXktbGlnaHQnOiB0ZXN0LlN0YXRlID09IFwiUlVOTklOR1wiLFxuXHRcdFx0XHQnaGFzLWJhY2tncm91bmQtZ3JleS1kYXJrJzogdGVzdC5TdGF0ZSA9PSBcIlwiLFxuXHRcdFx0fVxuXHRcdH0sXG5cdFx0aXNGYWlsaW5nOiBmdW5jdGlvbiAodGVzdCkge1xuXHRcdFx0cmV0dXJuIHRlc3QuU3RhdGUgPT0gXCJGQUlMRURcIlxuXHRcdH0sXG5cdFx0aXNGYWlsaW5nT3JSdW5uaW5nOiBmdW5jdGlvbiAodGVzdCkge1xuXHRcdFx0cmV0dXJuICh0ZXN0LlN0YXRlID09IFwiUlVOTklOR1wiIHx8IHRlc3QuU3RhdGUgPT0gXCJGQUlMRURcIilcblx0XHR9XG5cblx0fSBcbn1cbjwvc2NyaXB0PlxuIiwiaW1wb3J0IG1vZCBmcm9tIFwiLSEuLi8uLi9ub2RlX21vZHVsZXMvY2FjaGUtbG9hZGVyL2Rpc3QvY2pzLmpzPz9yZWYtLTEyLTAhLi4vLi4vbm9kZV9tb2R1bGVzL3RocmVhZC1sb2FkZXIvZGlzdC9janMuanMhLi4vLi4vbm9kZV9tb2R1bGVzL2JhYmVsLWxvYWRlci9saWIvaW5kZXguanMhLi4vLi4vbm9kZV9tb2R1bGVzL3Z1ZS1sb2FkZXIvbGliL2luZGV4LmpzPz92dWUtbG9hZGVyLW9wdGlvbnMhLi9UZXN0Qm94LnZ1ZT92dWUmdHlwZT1zY3JpcHQmbGFuZz1qcyZcIjsgZXhwb3J0IGRlZmF1bHQgbW9kOyBleHBvcnQgKiBmcm9tIFwiLSEuLi8uLi9ub2RlX21vZHVsZXMvY2FjaGUtbG9hZGVyL2Rpc3QvY2pzLmpzPz9yZWYtLTEyLTAhLi4vLi4vbm9kZV9tb2R1bGVzL3RocmVhZC1sb2FkZXIvZGlzdC9janMuanMhLi4vLi4vbm9kZV9tb2R1bGVzL2JhYmVsLWxvYWRlci9saWIvaW5kZXguanMhLi4vLi4vbm9kZV9tb2R1bGVzL3Z1ZS1sb2FkZXIvbGliL2luZGV4LmpzPz92dWUtbG9hZGVyLW9wdGlvbnMhLi9UZXN0Qm94LnZ1ZT92dWUmdHlwZT1zY3JpcHQmbGFuZz1qcyZcIiIsImltcG9ydCB7IHJlbmRlciwgc3RhdGljUmVuZGVyRm5zIH0gZnJvbSBcIi4vVGVzdEJveC52dWU/dnVlJnR5cGU9dGVtcGxhdGUmaWQ9Y2JlNzc2NzYmXCJcbmltcG9ydCBzY3JpcHQgZnJvbSBcIi4vVGVzdEJveC52dWU/dnVlJnR5cGU9c2NyaXB0Jmxhbmc9anMmXCJcbmV4cG9ydCAqIGZyb20gXCIuL1Rlc3RCb3gudnVlP3Z1ZSZ0eXBlPXNjcmlwdCZsYW5nPWpzJlwiXG5cblxuLyogbm9ybWFsaXplIGNvbXBvbmVudCAqL1xuaW1wb3J0IG5vcm1hbGl6ZXIgZnJvbSBcIiEuLi8uLi9ub2RlX21vZHVsZXMvdnVlLWxvYWRlci9saWIvcnVudGltZS9jb21wb25lbnROb3JtYWxpemVyLmpzXCJcbnZhciBjb21wb25lbnQgPSBub3JtYWxpemVyKFxuICBzY3JpcHQsXG4gIHJlbmRlcixcbiAgc3RhdGljUmVuZGVyRm5zLFxuICBmYWxzZSxcbiAgbnVsbCxcbiAgbnVsbCxcbiAgbnVsbFxuICBcbilcblxuY29tcG9uZW50Lm9wdGlvbnMuX19maWxlID0gXCJUZXN0Qm94LnZ1ZVwiXG5leHBvcnQgZGVmYXVsdCBjb21wb25lbnQuZXhwb3J0cyIsIjx0ZW1wbGF0ZT5cbiAgPGRpdiBpZD1cImFwcFwiPlxuXHRcdFx0PG5hdiBjbGFzcz1cIm5hdmJhclwiIHJvbGU9XCJuYXZpZ2F0aW9uXCIgYXJpYS1sYWJlbD1cIm1haW4gbmF2aWdhdGlvblwiPlxuXHRcdFx0XHQ8ZGl2IGNsYXNzPVwibmF2YmFyLWJyYW5kXCI+XG5cdFx0XHRcdFx0PGEgY2xhc3M9XCJuYXZiYXItaXRlbVwiIGhyZWY9XCJodHRwczovL2F2b2NldC5pb1wiPlxuXHRcdFx0XHRcdFx0PHAgY2xhc3M9XCJ0aXRsZVwiIHN0eWxlPVwicGFkZGluZzowLjVyZW07IG1hcmdpbjowLjVyZW07XCI+Y2FuYXJ5PC9wPlxuXHRcdFx0XHRcdDwvYT5cblx0XHRcdFx0PC9kaXY+XG5cdFx0XHQ8L25hdj5cblx0XHRcdDxkaXYgY2xhc3M9XCJjb250YWluZXJcIj5cblx0XHRcdFx0PGRpdiBjbGFzcz1cImNvbHVtbnMgaXMtbXVsdGlsaW5lXCI+XG5cdFx0XHRcdFx0PGRpdiBjbGFzcz1cImNvbHVtbiBpcy1oYWxmXCIgdi1mb3I9XCJ0ZXN0IGluIHRlc3REYXRhXCIgdi1iaW5kOmtleT1cInRlc3QuTmFtZVwiPlxuXHRcdFx0XHRcdFx0PFRlc3RCb3ggdi1iaW5kOnRlc3Q9XCJ0ZXN0XCIvPlxuXHRcdFx0XHRcdDwvZGl2PlxuXHRcdFx0XHQ8L2Rpdj5cblx0XHRcdDwvZGl2PlxuICA8L2Rpdj5cbjwvdGVtcGxhdGU+XG5cbjxzY3JpcHQ+XG5pbXBvcnQgVGVzdEJveCBmcm9tICcuL2NvbXBvbmVudHMvVGVzdEJveC52dWUnXG5cbmV4cG9ydCBkZWZhdWx0IHtcbiAgbmFtZTogJ2FwcCcsXG5cdGRhdGE6IGZ1bmN0aW9uKCkge1xuXHRcdHJldHVybiB7XG5cdFx0XHR0ZXN0RGF0YTogW10sXG5cdFx0fVxuXHR9LFxuICBjb21wb25lbnRzOiB7XG5cdFx0VGVzdEJveFxuICB9LFxuXHRtb3VudGVkOiBmdW5jdGlvbiAoKSB7XG5cdFx0Z2V0VGVzdFN0YXR1cyh0aGlzKVxuXHRcdHZhciBzZWxmID0gdGhpcztcblx0XHRzZXRJbnRlcnZhbChmdW5jdGlvbigpIHtnZXRUZXN0U3RhdHVzKHNlbGYpfSwgMTAwMDApXG5cdH0sXG5cdG1ldGhvZHM6IHtcblx0fSxcbn1cblxudmFyIGdldFRlc3RTdGF0dXMgPSBmdW5jdGlvbihzZWxmKSB7XG5cdFx0XHR2YXIgcmVxID0gbmV3IFJlcXVlc3QoXCIvYXBpL3N0YXR1c1wiKVxuXHRcdFx0ZmV0Y2gocmVxKS50aGVuKHJlcyA9PiByZXMuanNvbigpKVxuXHRcdFx0XHQudGhlbigoYm9keSkgPT4ge1xuXHRcdFx0XHRcdHNlbGYuJG5leHRUaWNrKGZ1bmN0aW9uICgpIHtcblx0XHRcdFx0XHRcdHNlbGYudGVzdERhdGEgPSBib2R5O1xuXHRcdFx0XHRcdH0pXG5cdFx0XHRcdH0pXG59XG48L3NjcmlwdD5cblxuIiwiaW1wb3J0IG1vZCBmcm9tIFwiLSEuLi9ub2RlX21vZHVsZXMvY2FjaGUtbG9hZGVyL2Rpc3QvY2pzLmpzPz9yZWYtLTEyLTAhLi4vbm9kZV9tb2R1bGVzL3RocmVhZC1sb2FkZXIvZGlzdC9janMuanMhLi4vbm9kZV9tb2R1bGVzL2JhYmVsLWxvYWRlci9saWIvaW5kZXguanMhLi4vbm9kZV9tb2R1bGVzL3Z1ZS1sb2FkZXIvbGliL2luZGV4LmpzPz92dWUtbG9hZGVyLW9wdGlvbnMhLi9BcHAudnVlP3Z1ZSZ0eXBlPXNjcmlwdCZsYW5nPWpzJlwiOyBleHBvcnQgZGVmYXVsdCBtb2Q7IGV4cG9ydCAqIGZyb20gXCItIS4uL25vZGVfbW9kdWxlcy9jYWNoZS1sb2FkZXIvZGlzdC9janMuanM/P3JlZi0tMTItMCEuLi9ub2RlX21vZHVsZXMvdGhyZWFkLWxvYWRlci9kaXN0L2Nqcy5qcyEuLi9ub2RlX21vZHVsZXMvYmFiZWwtbG9hZGVyL2xpYi9pbmRleC5qcyEuLi9ub2RlX21vZHVsZXMvdnVlLWxvYWRlci9saWIvaW5kZXguanM/P3Z1ZS1sb2FkZXItb3B0aW9ucyEuL0FwcC52dWU/dnVlJnR5cGU9c2NyaXB0Jmxhbmc9anMmXCIiLCJpbXBvcnQgeyByZW5kZXIsIHN0YXRpY1JlbmRlckZucyB9IGZyb20gXCIuL0FwcC52dWU/dnVlJnR5cGU9dGVtcGxhdGUmaWQ9ZmVmNTRmMjQmXCJcbmltcG9ydCBzY3JpcHQgZnJvbSBcIi4vQXBwLnZ1ZT92dWUmdHlwZT1zY3JpcHQmbGFuZz1qcyZcIlxuZXhwb3J0ICogZnJvbSBcIi4vQXBwLnZ1ZT92dWUmdHlwZT1zY3JpcHQmbGFuZz1qcyZcIlxuXG5cbi8qIG5vcm1hbGl6ZSBjb21wb25lbnQgKi9cbmltcG9ydCBub3JtYWxpemVyIGZyb20gXCIhLi4vbm9kZV9tb2R1bGVzL3Z1ZS1sb2FkZXIvbGliL3J1bnRpbWUvY29tcG9uZW50Tm9ybWFsaXplci5qc1wiXG52YXIgY29tcG9uZW50ID0gbm9ybWFsaXplcihcbiAgc2NyaXB0LFxuICByZW5kZXIsXG4gIHN0YXRpY1JlbmRlckZucyxcbiAgZmFsc2UsXG4gIG51bGwsXG4gIG51bGwsXG4gIG51bGxcbiAgXG4pXG5cbmNvbXBvbmVudC5vcHRpb25zLl9fZmlsZSA9IFwiQXBwLnZ1ZVwiXG5leHBvcnQgZGVmYXVsdCBjb21wb25lbnQuZXhwb3J0cyIsImltcG9ydCBWdWUgZnJvbSAndnVlJ1xuaW1wb3J0IEFwcCBmcm9tICcuL0FwcC52dWUnXG5cblZ1ZS5jb25maWcucHJvZHVjdGlvblRpcCA9IGZhbHNlXG5cbm5ldyBWdWUoe1xuICByZW5kZXI6IGggPT4gaChBcHApXG59KS4kbW91bnQoJyNhcHAnKVxuIiwiaW1wb3J0IG1vZCBmcm9tIFwiLSEuLi8uLi9ub2RlX21vZHVsZXMvbWluaS1jc3MtZXh0cmFjdC1wbHVnaW4vZGlzdC9sb2FkZXIuanM/P3JlZi0tNi1vbmVPZi0xLTAhLi4vLi4vbm9kZV9tb2R1bGVzL2Nzcy1sb2FkZXIvaW5kZXguanM/P3JlZi0tNi1vbmVPZi0xLTEhLi4vLi4vbm9kZV9tb2R1bGVzL3Z1ZS1sb2FkZXIvbGliL2xvYWRlcnMvc3R5bGVQb3N0TG9hZGVyLmpzIS4uLy4uL25vZGVfbW9kdWxlcy9wb3N0Y3NzLWxvYWRlci9saWIvaW5kZXguanM/P3JlZi0tNi1vbmVPZi0xLTIhLi4vLi4vbm9kZV9tb2R1bGVzL2NhY2hlLWxvYWRlci9kaXN0L2Nqcy5qcz8/cmVmLS0wLTAhLi4vLi4vbm9kZV9tb2R1bGVzL3Z1ZS1sb2FkZXIvbGliL2luZGV4LmpzPz92dWUtbG9hZGVyLW9wdGlvbnMhLi9Mb2cudnVlP3Z1ZSZ0eXBlPXN0eWxlJmluZGV4PTAmaWQ9NDExYjAwZWImc2NvcGVkPXRydWUmbGFuZz1jc3MmXCI7IGV4cG9ydCBkZWZhdWx0IG1vZDsgZXhwb3J0ICogZnJvbSBcIi0hLi4vLi4vbm9kZV9tb2R1bGVzL21pbmktY3NzLWV4dHJhY3QtcGx1Z2luL2Rpc3QvbG9hZGVyLmpzPz9yZWYtLTYtb25lT2YtMS0wIS4uLy4uL25vZGVfbW9kdWxlcy9jc3MtbG9hZGVyL2luZGV4LmpzPz9yZWYtLTYtb25lT2YtMS0xIS4uLy4uL25vZGVfbW9kdWxlcy92dWUtbG9hZGVyL2xpYi9sb2FkZXJzL3N0eWxlUG9zdExvYWRlci5qcyEuLi8uLi9ub2RlX21vZHVsZXMvcG9zdGNzcy1sb2FkZXIvbGliL2luZGV4LmpzPz9yZWYtLTYtb25lT2YtMS0yIS4uLy4uL25vZGVfbW9kdWxlcy9jYWNoZS1sb2FkZXIvZGlzdC9janMuanM/P3JlZi0tMC0wIS4uLy4uL25vZGVfbW9kdWxlcy92dWUtbG9hZGVyL2xpYi9pbmRleC5qcz8/dnVlLWxvYWRlci1vcHRpb25zIS4vTG9nLnZ1ZT92dWUmdHlwZT1zdHlsZSZpbmRleD0wJmlkPTQxMWIwMGViJnNjb3BlZD10cnVlJmxhbmc9Y3NzJlwiIl0sInNvdXJjZVJvb3QiOiIifQ==\"")
+	packr.PackJSONBytes("frontend/dist", "index.html", "\"PCFET0NUWVBFIGh0bWw+PGh0bWwgbGFuZz1lbj48aGVhZD48bWV0YSBjaGFyc2V0PXV0Zi04PjxtZXRhIGh0dHAtZXF1aXY9WC1VQS1Db21wYXRpYmxlIGNvbnRlbnQ9IklFPWVkZ2UiPjxtZXRhIG5hbWU9dmlld3BvcnQgY29udGVudD0id2lkdGg9ZGV2aWNlLXdpZHRoLGluaXRpYWwtc2NhbGU9MSI+PGxpbmsgcmVsPWljb24gaHJlZj0vdWkvZmF2aWNvbi5pY28+PGxpbmsgcmVsPXN0eWxlc2hlZXQgdHlwZT10ZXh0L2NzcyBocmVmPWh0dHBzOi8vY2RuanMuY2xvdWRmbGFyZS5jb20vYWpheC9saWJzL2J1bG1hLzAuNy4xL2Nzcy9idWxtYS5taW4uY3NzPjx0aXRsZT5lMmU8L3RpdGxlPjxsaW5rIGhyZWY9L3VpL2Nzcy9hcHAuZmZjOTYwNzEuY3NzIHJlbD1wcmVsb2FkIGFzPXN0eWxlPjxsaW5rIGhyZWY9L3VpL2pzL2FwcC5mMTZiODUzYi5qcyByZWw9cHJlbG9hZCBhcz1zY3JpcHQ+PGxpbmsgaHJlZj0vdWkvanMvY2h1bmstdmVuZG9ycy42NzExYmVlYy5qcyByZWw9cHJlbG9hZCBhcz1zY3JpcHQ+PGxpbmsgaHJlZj0vdWkvY3NzL2FwcC5mZmM5NjA3MS5jc3MgcmVsPXN0eWxlc2hlZXQ+PC9oZWFkPjxib2R5Pjxub3NjcmlwdD48c3Ryb25nPldlJ3JlIHNvcnJ5IGJ1dCBlMmUgZG9lc24ndCB3b3JrIHByb3Blcmx5IHdpdGhvdXQgSmF2YVNjcmlwdCBlbmFibGVkLiBQbGVhc2UgZW5hYmxlIGl0IHRvIGNvbnRpbnVlLjwvc3Ryb25nPjwvbm9zY3JpcHQ+PGRpdiBpZD1hcHA+PC9kaXY+PHNjcmlwdCBzcmM9L3VpL2pzL2NodW5rLXZlbmRvcnMuNjcxMWJlZWMuanM+PC9zY3JpcHQ+PHNjcmlwdCBzcmM9L3VpL2pzL2FwcC5mMTZiODUzYi5qcz48L3NjcmlwdD48L2JvZHk+PC9odG1sPg==\"")
+	packr.PackJSONBytes("frontend/dist", "js/app.f16b853b.js", "\"KGZ1bmN0aW9uKHQpe2Z1bmN0aW9uIGUoZSl7Zm9yKHZhciBzLGksbz1lWzBdLHU9ZVsxXSxjPWVbMl0sZj0wLHA9W107ZjxvLmxlbmd0aDtmKyspaT1vW2ZdLGFbaV0mJnAucHVzaChhW2ldWzBdKSxhW2ldPTA7Zm9yKHMgaW4gdSlPYmplY3QucHJvdG90eXBlLmhhc093blByb3BlcnR5LmNhbGwodSxzKSYmKHRbc109dVtzXSk7bCYmbChlKTt3aGlsZShwLmxlbmd0aClwLnNoaWZ0KCkoKTtyZXR1cm4gci5wdXNoLmFwcGx5KHIsY3x8W10pLG4oKX1mdW5jdGlvbiBuKCl7Zm9yKHZhciB0LGU9MDtlPHIubGVuZ3RoO2UrKyl7Zm9yKHZhciBuPXJbZV0scz0hMCxvPTE7bzxuLmxlbmd0aDtvKyspe3ZhciB1PW5bb107MCE9PWFbdV0mJihzPSExKX1zJiYoci5zcGxpY2UoZS0tLDEpLHQ9aShpLnM9blswXSkpfXJldHVybiB0fXZhciBzPXt9LGE9e2FwcDowfSxyPVtdO2Z1bmN0aW9uIGkoZSl7aWYoc1tlXSlyZXR1cm4gc1tlXS5leHBvcnRzO3ZhciBuPXNbZV09e2k6ZSxsOiExLGV4cG9ydHM6e319O3JldHVybiB0W2VdLmNhbGwobi5leHBvcnRzLG4sbi5leHBvcnRzLGkpLG4ubD0hMCxuLmV4cG9ydHN9aS5tPXQsaS5jPXMsaS5kPWZ1bmN0aW9uKHQsZSxuKXtpLm8odCxlKXx8T2JqZWN0LmRlZmluZVByb3BlcnR5KHQsZSx7ZW51bWVyYWJsZTohMCxnZXQ6bn0pfSxpLnI9ZnVuY3Rpb24odCl7InVuZGVmaW5lZCIhPT10eXBlb2YgU3ltYm9sJiZTeW1ib2wudG9TdHJpbmdUYWcmJk9iamVjdC5kZWZpbmVQcm9wZXJ0eSh0LFN5bWJvbC50b1N0cmluZ1RhZyx7dmFsdWU6Ik1vZHVsZSJ9KSxPYmplY3QuZGVmaW5lUHJvcGVydHkodCwiX19lc01vZHVsZSIse3ZhbHVlOiEwfSl9LGkudD1mdW5jdGlvbih0LGUpe2lmKDEmZSYmKHQ9aSh0KSksOCZlKXJldHVybiB0O2lmKDQmZSYmIm9iamVjdCI9PT10eXBlb2YgdCYmdCYmdC5fX2VzTW9kdWxlKXJldHVybiB0O3ZhciBuPU9iamVjdC5jcmVhdGUobnVsbCk7aWYoaS5yKG4pLE9iamVjdC5kZWZpbmVQcm9wZXJ0eShuLCJkZWZhdWx0Iix7ZW51bWVyYWJsZTohMCx2YWx1ZTp0fSksMiZlJiYic3RyaW5nIiE9dHlwZW9mIHQpZm9yKHZhciBzIGluIHQpaS5kKG4scyxmdW5jdGlvbihlKXtyZXR1cm4gdFtlXX0uYmluZChudWxsLHMpKTtyZXR1cm4gbn0saS5uPWZ1bmN0aW9uKHQpe3ZhciBlPXQmJnQuX19lc01vZHVsZT9mdW5jdGlvbigpe3JldHVybiB0WyJkZWZhdWx0Il19OmZ1bmN0aW9uKCl7cmV0dXJuIHR9O3JldHVybiBpLmQoZSwiYSIsZSksZX0saS5vPWZ1bmN0aW9uKHQsZSl7cmV0dXJuIE9iamVjdC5wcm90b3R5cGUuaGFzT3duUHJvcGVydHkuY2FsbCh0LGUpfSxpLnA9Ii91aS8iO3ZhciBvPXdpbmRvd1sid2VicGFja0pzb25wIl09d2luZG93WyJ3ZWJwYWNrSnNvbnAiXXx8W10sdT1vLnB1c2guYmluZChvKTtvLnB1c2g9ZSxvPW8uc2xpY2UoKTtmb3IodmFyIGM9MDtjPG8ubGVuZ3RoO2MrKyllKG9bY10pO3ZhciBsPXU7ci5wdXNoKFswLCJjaHVuay12ZW5kb3JzIl0pLG4oKX0pKHswOmZ1bmN0aW9uKHQsZSxuKXt0LmV4cG9ydHM9bigiNTZkNyIpfSwiNTZkNyI6ZnVuY3Rpb24odCxlLG4peyJ1c2Ugc3RyaWN0IjtuLnIoZSk7bigiY2FkZiIpLG4oIjU1MWMiKSxuKCIwOTdkIik7dmFyIHM9bigiMmIwZSIpLGE9ZnVuY3Rpb24oKXt2YXIgdD10aGlzLGU9dC4kY3JlYXRlRWxlbWVudCxuPXQuX3NlbGYuX2N8fGU7cmV0dXJuIG4oImRpdiIse2F0dHJzOntpZDoiYXBwIn19LFt0Ll9tKDApLG4oImRpdiIse3N0YXRpY0NsYXNzOiJjb250YWluZXIifSxbbigiZGl2Iix7c3RhdGljQ2xhc3M6ImNvbHVtbnMgaXMtbXVsdGlsaW5lIn0sdC5fbCh0LnRlc3REYXRhLGZ1bmN0aW9uKHQpe3JldHVybiBuKCJkaXYiLHtrZXk6dC5OYW1lLHN0YXRpY0NsYXNzOiJjb2x1bW4gaXMtaGFsZiJ9LFtuKCJUZXN0Qm94Iix7YXR0cnM6e3Rlc3Q6dH19KV0sMSl9KSldKV0pfSxyPVtmdW5jdGlvbigpe3ZhciB0PXRoaXMsZT10LiRjcmVhdGVFbGVtZW50LG49dC5fc2VsZi5fY3x8ZTtyZXR1cm4gbigibmF2Iix7c3RhdGljQ2xhc3M6Im5hdmJhciIsYXR0cnM6e3JvbGU6Im5hdmlnYXRpb24iLCJhcmlhLWxhYmVsIjoibWFpbiBuYXZpZ2F0aW9uIn19LFtuKCJkaXYiLHtzdGF0aWNDbGFzczoibmF2YmFyLWJyYW5kIn0sW24oImEiLHtzdGF0aWNDbGFzczoibmF2YmFyLWl0ZW0iLGF0dHJzOntocmVmOiJodHRwczovL2F2b2NldC5pbyJ9fSxbbigicCIse3N0YXRpY0NsYXNzOiJ0aXRsZSIsc3RhdGljU3R5bGU6e3BhZGRpbmc6IjAuNXJlbSIsbWFyZ2luOiIwLjVyZW0ifX0sW3QuX3YoImNhbmFyeSIpXSldKV0pXSl9XSxpPWZ1bmN0aW9uKCl7dmFyIHQ9dGhpcyxlPXQuJGNyZWF0ZUVsZW1lbnQsbj10Ll9zZWxmLl9jfHxlO3JldHVybiBuKCJkaXYiLHtzdGF0aWNDbGFzczoiaGFzLXRleHQtd2hpdGUiLGNsYXNzOnQudGVzdENsYXNzZXModC50ZXN0KSxzdGF0aWNTdHlsZTp7ImJvcmRlci1yYWRpdXMiOiIzcHgiLHBhZGRpbmc6IjJyZW0ifX0sW24oImgxIix7c3RhdGljQ2xhc3M6InRpdGxlIGlzLTQgaGFzLXRleHQtd2hpdGUifSxbdC5fdigiXG5cdFx0Iit0Ll9zKHQudGVzdC5OYW1lKSsiXG5cdCIpXSksdC50ZXN0Lk1ldGFkYXRhLkRlc2NyaXB0aW9uP24oInAiLHtzdGF0aWNDbGFzczoic3VidGl0bGUgaXMtNiBoYXMtdGV4dC13aGl0ZSJ9LFt0Ll92KHQuX3ModC50ZXN0Lk1ldGFkYXRhLkRlc2NyaXB0aW9uKSldKTp0Ll9lKCksdC50ZXN0Lk1ldGFkYXRhLlRhZ3N8fHQudGVzdC5NZXRhZGF0YS5TZXZlcml0eT9uKCJkaXYiLHtzdGF0aWNDbGFzczoidGFncyJ9LFt0LnRlc3QuTWV0YWRhdGEuU2V2ZXJpdHk/bigic3BhbiIse3N0YXRpY0NsYXNzOiJ0YWcgaXMtZGFyayJ9LFt0Ll92KHQuX3ModC50ZXN0Lk1ldGFkYXRhLlNldmVyaXR5KSldKTp0Ll9lKCksdC5fbCh0LnRlc3QuTWV0YWRhdGEuVGFncyxmdW5jdGlvbihlKXtyZXR1cm4gbigic3BhbiIse2tleTplLHN0YXRpY0NsYXNzOiJ0YWcgaXMtbGlnaHQifSxbdC5fdih0Ll9zKGUpKV0pfSldLDIpOnQuX2UoKSxuKCJkaXYiLHtzdGF0aWNDbGFzczoiY29sdW1ucyIsc3RhdGljU3R5bGU6e2hlaWdodDoiMTAwcHgifX0sW24oImRpdiIse3N0YXRpY0NsYXNzOiJjb2x1bW4gaXMtb25lLXRoaXJkIn0sW24oInAiLHtzdGF0aWNDbGFzczoiaXMtc2l6ZS03In0sW3QuX3YoInN0YXRlOiAiK3QuX3ModC50ZXN0LlN0YXRlKSldKSxuKCJwIix7c3RhdGljQ2xhc3M6ImlzLXNpemUtNyJ9LFt0Ll92KCJwYXNzIHJhdGU6ICIrdC5fcyhNYXRoLnJvdW5kKHQudGVzdC5TdWNjZXNzZXMvKHQudGVzdC5TdWNjZXNzZXMrdC50ZXN0LkZhaWx1cmVzKSoxMDB8fDApKSsiJSIpXSksdC50ZXN0Lk1ldGFkYXRhLk93bmVyP24oInAiLHtzdGF0aWNDbGFzczoiaXMtc2l6ZS03In0sW3QuX3YoIm93bmVyOiAiK3QuX3ModC50ZXN0Lk1ldGFkYXRhLk93bmVyKSldKTp0Ll9lKCksdC5fbCh0LnN1YnRlc3RzKHQudGVzdCksZnVuY3Rpb24oZSl7cmV0dXJuIG4oInAiLHtrZXk6ZS5OYW1lLHN0YXRpY0NsYXNzOiJpcy1zaXplLTcifSxbdC5fdih0Ll9zKGUuTmFtZSkrIjogIit0Ll9zKGUuU3RhdGUpKV0pfSksdC50ZXN0Lk1ldGFkYXRhLlJ1bmJvb2tVUkw/bigicCIse3N0YXRpY0NsYXNzOiJpcy1zaXplLTcifSxbbigiYSIse3N0YXRpY0NsYXNzOiJoYXMtdGV4dC13aGl0ZSIsc3RhdGljU3R5bGU6eyJ0ZXh0LWRlY29yYXRpb24iOiJ1bmRlcmxpbmUifSxhdHRyczp7aHJlZjp0LnRlc3QuTWV0YWRhdGEuUnVuYm9va1VSTH19LFt0Ll92KCJydW5ib29rIildKV0pOnQuX2UoKV0sMiksdC5pc0ZhaWxpbmdPclJ1bm5pbmcodC50ZXN0KT9uKCJMb2ciLHthdHRyczp7bmFtZTp0LnRlc3QuTmFtZSxiYXNlT3V0cHV0OnQudGVzdC5MYXN0RmFpbHVyZU91dHB1dCxzdGF0ZTp0LnRlc3QuU3RhdGV9fSk6dC5fZSgpXSwxKV0pfSxvPVtdLHU9ZnVuY3Rpb24oKXt2YXIgdD10aGlzLGU9dC4kY3JlYXRlRWxlbWVudCxuPXQuX3NlbGYuX2N8fGU7cmV0dXJuIG4oImRpdiIse3N0YXRpY0NsYXNzOiJsb2dib3ggaGFzLWJhY2tncm91bmQtZ3JleS1kYXJrIGNvbHVtbiBpcy10d28tdGhpcmRzIn0sW24oInAiLHtzdGF0aWNDbGFzczoiaXMtc2l6ZS03IixzdGF0aWNTdHlsZTp7IndoaXRlLXNwYWNlIjoicHJlIn19LFt0Ll92KHQuX3ModC5vdXRwdXQpKV0pXSl9LGM9W10sbD0obigiN2Y3ZiIpLHtuYW1lOiJMb2ciLGRhdGE6ZnVuY3Rpb24oKXtyZXR1cm57b3V0cHV0OiIifX0scHJvcHM6e25hbWU6IiIsc3RhdGU6IiIsYmFzZU91dHB1dDoiIn0sbW91bnRlZDpmdW5jdGlvbigpeyJSVU5OSU5HIiE9dGhpcy5zdGF0ZSYmKHRoaXMub3V0cHV0PXRoaXMuYmFzZU91dHB1dCk7dmFyIHQ9dGhpcztmKHQpLHNldEludGVydmFsKGZ1bmN0aW9uKCl7Zih0KX0sMWUzKX0sbWV0aG9kczp7fX0pLGY9ZnVuY3Rpb24odCl7aWYoIlJVTk5JTkciPT10LnN0YXRlKXt2YXIgZT1uZXcgUmVxdWVzdCgiL2FwaS9sb2cvIit0Lm5hbWUpO2ZldGNoKGUpLnRoZW4oZnVuY3Rpb24odCl7cmV0dXJuIHQudGV4dCgpfSkudGhlbihmdW5jdGlvbihlKXt0LiRuZXh0VGljayhmdW5jdGlvbigpe3Qub3V0cHV0PWV9KX0pfX0scD1sLGQ9KG4oIjcxMTEiKSxuKCIyODc3IikpLHY9T2JqZWN0KGRbImEiXSkocCx1LGMsITEsbnVsbCwiNDExYjAwZWIiLG51bGwpO3Yub3B0aW9ucy5fX2ZpbGU9IkxvZy52dWUiO3ZhciBoPXYuZXhwb3J0cyxiPXtuYW1lOiJUZXN0Qm94Iixwcm9wczp7dGVzdDp7fX0sY29tcG9uZW50czp7TG9nOmh9LG1ldGhvZHM6e3Rlc3RDbGFzc2VzOmZ1bmN0aW9uKHQpe3JldHVybnsiaGFzLWJhY2tncm91bmQtZGFuZ2VyIjoiRkFJTEVEIj09dC5TdGF0ZSwiaGFzLWJhY2tncm91bmQtc3VjY2VzcyI6IlBBU1NFRCI9PXQuU3RhdGUsImhhcy1iYWNrZ3JvdW5kLWdyZXktbGlnaHQiOiJSVU5OSU5HIj09dC5TdGF0ZXx8IlFVRVVFRCI9PXQuU3RhdGUsImhhcy1iYWNrZ3JvdW5kLXdhcm5pbmciOiJCTE9DS0VEIj09dC5TdGF0ZSwiaGFzLWJhY2tncm91bmQtZ3JleS1kYXJrIjoiIj09dC5TdGF0ZX19LHN1YnRlc3RzOmZ1bmN0aW9uKHQpe3JldHVybiB0Lkxhc3RSZXN1bHQ/dC5MYXN0UmVzdWx0LkNoaWxkcmVufHxbXTpbXX0saXNGYWlsaW5nOmZ1bmN0aW9uKHQpe3JldHVybiJGQUlMRUQiPT10LlN0YXRlfSxpc0ZhaWxpbmdPclJ1bm5pbmc6ZnVuY3Rpb24odCl7cmV0dXJuIlJVTk5JTkciPT10LlN0YXRlfHwiRkFJTEVEIj09dC5TdGF0ZX19fSxtPWIsZz1PYmplY3QoZFsiYSJdKShtLGksbywhMSxudWxsLG51bGwsbnVsbCk7Zy5vcHRpb25zLl9fZmlsZT0iVGVzdEJveC52dWUiO3ZhciBfPWcuZXhwb3J0cyx5PXtuYW1lOiJhcHAiLGRhdGE6ZnVuY3Rpb24oKXtyZXR1cm57dGVzdERhdGE6W119fSxjb21wb25lbnRzOntUZXN0Qm94Ol99LG1vdW50ZWQ6ZnVuY3Rpb24oKXtTKHRoaXMpO3ZhciB0PXRoaXM7c2V0SW50ZXJ2YWwoZnVuY3Rpb24oKXtTKHQpfSwxZTQpfSxtZXRob2RzOnt9fSxTPWZ1bmN0aW9uKHQpe3ZhciBlPW5ldyBSZXF1ZXN0KCIvYXBpL3N0YXR1cyIpO2ZldGNoKGUpLnRoZW4oZnVuY3Rpb24odCl7cmV0dXJuIHQuanNvbigpfSkudGhlbihmdW5jdGlvbihlKXt0LiRuZXh0VGljayhmdW5jdGlvbigpe3QudGVzdERhdGE9ZX0pfSl9LHg9eSxPPU9iamVjdChkWyJhIl0pKHgsYSxyLCExLG51bGwsbnVsbCxudWxsKTtPLm9wdGlvbnMuX19maWxlPSJBcHAudnVlIjt2YXIgQz1PLmV4cG9ydHM7c1siYSJdLmNvbmZpZy5wcm9kdWN0aW9uVGlwPSExLG5ldyBzWyJhIl0oe3JlbmRlcjpmdW5jdGlvbih0KXtyZXR1cm4gdChDKX19KS4kbW91bnQoIiNhcHAiKX0sNzExMTpmdW5jdGlvbih0LGUsbil7InVzZSBzdHJpY3QiO3ZhciBzPW4oImYzYjkiKSxhPW4ubihzKTthLmF9LGYzYjk6ZnVuY3Rpb24odCxlLG4pe319KTs=\"")
 	packr.PackJSONBytes("frontend/dist", "js/chunk-vendors.6711beec.js", "\"KHdpbmRvd1sid2VicGFja0pzb25wIl09d2luZG93WyJ3ZWJwYWNrSnNvbnAiXXx8W10pLnB1c2goW1siY2h1bmstdmVuZG9ycyJdLHsiMDFmOSI6ZnVuY3Rpb24odCxlLG4peyJ1c2Ugc3RyaWN0Ijt2YXIgcj1uKCIyZDAwIiksbz1uKCI1Y2ExIiksaT1uKCIyYWJhIiksYT1uKCIzMmU5Iikscz1uKCI4NGYyIiksYz1uKCI0MWEwIiksdT1uKCI3ZjIwIiksZj1uKCIzOGZkIiksbD1uKCIyYjRjIikoIml0ZXJhdG9yIikscD0hKFtdLmtleXMmJiJuZXh0ImluW10ua2V5cygpKSxkPSJAQGl0ZXJhdG9yIix2PSJrZXlzIixoPSJ2YWx1ZXMiLG09ZnVuY3Rpb24oKXtyZXR1cm4gdGhpc307dC5leHBvcnRzPWZ1bmN0aW9uKHQsZSxuLHksXyxnLGIpe2MobixlLHkpO3ZhciB3LHgsQyxBPWZ1bmN0aW9uKHQpe2lmKCFwJiZ0IGluIFMpcmV0dXJuIFNbdF07c3dpdGNoKHQpe2Nhc2UgdjpyZXR1cm4gZnVuY3Rpb24oKXtyZXR1cm4gbmV3IG4odGhpcyx0KX07Y2FzZSBoOnJldHVybiBmdW5jdGlvbigpe3JldHVybiBuZXcgbih0aGlzLHQpfX1yZXR1cm4gZnVuY3Rpb24oKXtyZXR1cm4gbmV3IG4odGhpcyx0KX19LE89ZSsiIEl0ZXJhdG9yIiwkPV89PWgsaz0hMSxTPXQucHJvdG90eXBlLGo9U1tsXXx8U1tkXXx8XyYmU1tfXSxFPWp8fEEoXyksVD1fPyQ/QSgiZW50cmllcyIpOkU6dm9pZCAwLEk9IkFycmF5Ij09ZSYmUy5lbnRyaWVzfHxqO2lmKEkmJihDPWYoSS5jYWxsKG5ldyB0KSksQyE9PU9iamVjdC5wcm90b3R5cGUmJkMubmV4dCYmKHUoQyxPLCEwKSxyfHwiZnVuY3Rpb24iPT10eXBlb2YgQ1tsXXx8YShDLGwsbSkpKSwkJiZqJiZqLm5hbWUhPT1oJiYoaz0hMCxFPWZ1bmN0aW9uKCl7cmV0dXJuIGouY2FsbCh0aGlzKX0pLHImJiFifHwhcCYmIWsmJlNbbF18fGEoUyxsLEUpLHNbZV09RSxzW09dPW0sXylpZih3PXt2YWx1ZXM6JD9FOkEoaCksa2V5czpnP0U6QSh2KSxlbnRyaWVzOlR9LGIpZm9yKHggaW4gdyl4IGluIFN8fGkoUyx4LHdbeF0pO2Vsc2UgbyhvLlArby5GKihwfHxrKSxlLHcpO3JldHVybiB3fX0sIjA5N2QiOmZ1bmN0aW9uKHQsZSxuKXsidXNlIHN0cmljdCI7dmFyIHI9bigiNWNhMSIpLG89bigiODM3OCIpLGk9bigiNzcyNiIpLGE9bigiZWJkNiIpLHM9bigiYmNhYSIpO3Ioci5QK3IuUiwiUHJvbWlzZSIse2ZpbmFsbHk6ZnVuY3Rpb24odCl7dmFyIGU9YSh0aGlzLG8uUHJvbWlzZXx8aS5Qcm9taXNlKSxuPSJmdW5jdGlvbiI9PXR5cGVvZiB0O3JldHVybiB0aGlzLnRoZW4obj9mdW5jdGlvbihuKXtyZXR1cm4gcyhlLHQoKSkudGhlbihmdW5jdGlvbigpe3JldHVybiBufSl9OnQsbj9mdW5jdGlvbihuKXtyZXR1cm4gcyhlLHQoKSkudGhlbihmdW5jdGlvbigpe3Rocm93IG59KX06dCl9fSl9LCIwZDU4IjpmdW5jdGlvbih0LGUsbil7dmFyIHI9bigiY2UxMCIpLG89bigiZTExZSIpO3QuZXhwb3J0cz1PYmplY3Qua2V5c3x8ZnVuY3Rpb24odCl7cmV0dXJuIHIodCxvKX19LDE0OTU6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oIjg2Y2MiKSxvPW4oImNiN2MiKSxpPW4oIjBkNTgiKTt0LmV4cG9ydHM9bigiOWUxZSIpP09iamVjdC5kZWZpbmVQcm9wZXJ0aWVzOmZ1bmN0aW9uKHQsZSl7byh0KTt2YXIgbixhPWkoZSkscz1hLmxlbmd0aCxjPTA7d2hpbGUocz5jKXIuZih0LG49YVtjKytdLGVbbl0pO3JldHVybiB0fX0sMTk5MTpmdW5jdGlvbih0LGUsbil7dmFyIHIsbyxpLGE9bigiOWI0MyIpLHM9bigiMzFmNCIpLGM9bigiZmFiMiIpLHU9bigiMjMwZSIpLGY9bigiNzcyNiIpLGw9Zi5wcm9jZXNzLHA9Zi5zZXRJbW1lZGlhdGUsZD1mLmNsZWFySW1tZWRpYXRlLHY9Zi5NZXNzYWdlQ2hhbm5lbCxoPWYuRGlzcGF0Y2gsbT0wLHk9e30sXz0ib25yZWFkeXN0YXRlY2hhbmdlIixnPWZ1bmN0aW9uKCl7dmFyIHQ9K3RoaXM7aWYoeS5oYXNPd25Qcm9wZXJ0eSh0KSl7dmFyIGU9eVt0XTtkZWxldGUgeVt0XSxlKCl9fSxiPWZ1bmN0aW9uKHQpe2cuY2FsbCh0LmRhdGEpfTtwJiZkfHwocD1mdW5jdGlvbih0KXt2YXIgZT1bXSxuPTE7d2hpbGUoYXJndW1lbnRzLmxlbmd0aD5uKWUucHVzaChhcmd1bWVudHNbbisrXSk7cmV0dXJuIHlbKyttXT1mdW5jdGlvbigpe3MoImZ1bmN0aW9uIj09dHlwZW9mIHQ/dDpGdW5jdGlvbih0KSxlKX0scihtKSxtfSxkPWZ1bmN0aW9uKHQpe2RlbGV0ZSB5W3RdfSwicHJvY2VzcyI9PW4oIjJkOTUiKShsKT9yPWZ1bmN0aW9uKHQpe2wubmV4dFRpY2soYShnLHQsMSkpfTpoJiZoLm5vdz9yPWZ1bmN0aW9uKHQpe2gubm93KGEoZyx0LDEpKX06dj8obz1uZXcgdixpPW8ucG9ydDIsby5wb3J0MS5vbm1lc3NhZ2U9YixyPWEoaS5wb3N0TWVzc2FnZSxpLDEpKTpmLmFkZEV2ZW50TGlzdGVuZXImJiJmdW5jdGlvbiI9PXR5cGVvZiBwb3N0TWVzc2FnZSYmIWYuaW1wb3J0U2NyaXB0cz8ocj1mdW5jdGlvbih0KXtmLnBvc3RNZXNzYWdlKHQrIiIsIioiKX0sZi5hZGRFdmVudExpc3RlbmVyKCJtZXNzYWdlIixiLCExKSk6cj1fIGluIHUoInNjcmlwdCIpP2Z1bmN0aW9uKHQpe2MuYXBwZW5kQ2hpbGQodSgic2NyaXB0IikpW19dPWZ1bmN0aW9uKCl7Yy5yZW1vdmVDaGlsZCh0aGlzKSxnLmNhbGwodCl9fTpmdW5jdGlvbih0KXtzZXRUaW1lb3V0KGEoZyx0LDEpLDApfSksdC5leHBvcnRzPXtzZXQ6cCxjbGVhcjpkfX0sIjFmYTgiOmZ1bmN0aW9uKHQsZSxuKXt2YXIgcj1uKCJjYjdjIik7dC5leHBvcnRzPWZ1bmN0aW9uKHQsZSxuLG8pe3RyeXtyZXR1cm4gbz9lKHIobilbMF0sblsxXSk6ZShuKX1jYXRjaChlKXt2YXIgaT10WyJyZXR1cm4iXTt0aHJvdyB2b2lkIDAhPT1pJiZyKGkuY2FsbCh0KSksZX19fSwiMjMwZSI6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oImQzZjQiKSxvPW4oIjc3MjYiKS5kb2N1bWVudCxpPXIobykmJnIoby5jcmVhdGVFbGVtZW50KTt0LmV4cG9ydHM9ZnVuY3Rpb24odCl7cmV0dXJuIGk/by5jcmVhdGVFbGVtZW50KHQpOnt9fX0sIjIzYzYiOmZ1bmN0aW9uKHQsZSxuKXt2YXIgcj1uKCIyZDk1Iiksbz1uKCIyYjRjIikoInRvU3RyaW5nVGFnIiksaT0iQXJndW1lbnRzIj09cihmdW5jdGlvbigpe3JldHVybiBhcmd1bWVudHN9KCkpLGE9ZnVuY3Rpb24odCxlKXt0cnl7cmV0dXJuIHRbZV19Y2F0Y2godCl7fX07dC5leHBvcnRzPWZ1bmN0aW9uKHQpe3ZhciBlLG4scztyZXR1cm4gdm9pZCAwPT09dD8iVW5kZWZpbmVkIjpudWxsPT09dD8iTnVsbCI6InN0cmluZyI9PXR5cGVvZihuPWEoZT1PYmplY3QodCksbykpP246aT9yKGUpOiJPYmplY3QiPT0ocz1yKGUpKSYmImZ1bmN0aW9uIj09dHlwZW9mIGUuY2FsbGVlPyJBcmd1bWVudHMiOnN9fSwiMjdlZSI6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oIjIzYzYiKSxvPW4oIjJiNGMiKSgiaXRlcmF0b3IiKSxpPW4oIjg0ZjIiKTt0LmV4cG9ydHM9bigiODM3OCIpLmdldEl0ZXJhdG9yTWV0aG9kPWZ1bmN0aW9uKHQpe2lmKHZvaWQgMCE9dClyZXR1cm4gdFtvXXx8dFsiQEBpdGVyYXRvciJdfHxpW3IodCldfX0sMjg3NzpmdW5jdGlvbih0LGUsbil7InVzZSBzdHJpY3QiO2Z1bmN0aW9uIHIodCxlLG4scixvLGksYSxzKXt2YXIgYyx1PSJmdW5jdGlvbiI9PT10eXBlb2YgdD90Lm9wdGlvbnM6dDtpZihlJiYodS5yZW5kZXI9ZSx1LnN0YXRpY1JlbmRlckZucz1uLHUuX2NvbXBpbGVkPSEwKSxyJiYodS5mdW5jdGlvbmFsPSEwKSxpJiYodS5fc2NvcGVJZD0iZGF0YS12LSIraSksYT8oYz1mdW5jdGlvbih0KXt0PXR8fHRoaXMuJHZub2RlJiZ0aGlzLiR2bm9kZS5zc3JDb250ZXh0fHx0aGlzLnBhcmVudCYmdGhpcy5wYXJlbnQuJHZub2RlJiZ0aGlzLnBhcmVudC4kdm5vZGUuc3NyQ29udGV4dCx0fHwidW5kZWZpbmVkIj09PXR5cGVvZiBfX1ZVRV9TU1JfQ09OVEVYVF9ffHwodD1fX1ZVRV9TU1JfQ09OVEVYVF9fKSxvJiZvLmNhbGwodGhpcyx0KSx0JiZ0Ll9yZWdpc3RlcmVkQ29tcG9uZW50cyYmdC5fcmVnaXN0ZXJlZENvbXBvbmVudHMuYWRkKGEpfSx1Ll9zc3JSZWdpc3Rlcj1jKTpvJiYoYz1zP2Z1bmN0aW9uKCl7by5jYWxsKHRoaXMsdGhpcy4kcm9vdC4kb3B0aW9ucy5zaGFkb3dSb290KX06byksYylpZih1LmZ1bmN0aW9uYWwpe3UuX2luamVjdFN0eWxlcz1jO3ZhciBmPXUucmVuZGVyO3UucmVuZGVyPWZ1bmN0aW9uKHQsZSl7cmV0dXJuIGMuY2FsbChlKSxmKHQsZSl9fWVsc2V7dmFyIGw9dS5iZWZvcmVDcmVhdGU7dS5iZWZvcmVDcmVhdGU9bD9bXS5jb25jYXQobCxjKTpbY119cmV0dXJue2V4cG9ydHM6dCxvcHRpb25zOnV9fW4uZChlLCJhIixmdW5jdGlvbigpe3JldHVybiByfSl9LCIyYWJhIjpmdW5jdGlvbih0LGUsbil7dmFyIHI9bigiNzcyNiIpLG89bigiMzJlOSIpLGk9bigiNjlhOCIpLGE9bigiY2E1YSIpKCJzcmMiKSxzPSJ0b1N0cmluZyIsYz1GdW5jdGlvbltzXSx1PSgiIitjKS5zcGxpdChzKTtuKCI4Mzc4IikuaW5zcGVjdFNvdXJjZT1mdW5jdGlvbih0KXtyZXR1cm4gYy5jYWxsKHQpfSwodC5leHBvcnRzPWZ1bmN0aW9uKHQsZSxuLHMpe3ZhciBjPSJmdW5jdGlvbiI9PXR5cGVvZiBuO2MmJihpKG4sIm5hbWUiKXx8byhuLCJuYW1lIixlKSksdFtlXSE9PW4mJihjJiYoaShuLGEpfHxvKG4sYSx0W2VdPyIiK3RbZV06dS5qb2luKFN0cmluZyhlKSkpKSx0PT09cj90W2VdPW46cz90W2VdP3RbZV09bjpvKHQsZSxuKTooZGVsZXRlIHRbZV0sbyh0LGUsbikpKX0pKEZ1bmN0aW9uLnByb3RvdHlwZSxzLGZ1bmN0aW9uKCl7cmV0dXJuImZ1bmN0aW9uIj09dHlwZW9mIHRoaXMmJnRoaXNbYV18fGMuY2FsbCh0aGlzKX0pfSwiMmFlYiI6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oImNiN2MiKSxvPW4oIjE0OTUiKSxpPW4oImUxMWUiKSxhPW4oIjYxM2IiKSgiSUVfUFJPVE8iKSxzPWZ1bmN0aW9uKCl7fSxjPSJwcm90b3R5cGUiLHU9ZnVuY3Rpb24oKXt2YXIgdCxlPW4oIjIzMGUiKSgiaWZyYW1lIikscj1pLmxlbmd0aCxvPSI8IixhPSI+IjtlLnN0eWxlLmRpc3BsYXk9Im5vbmUiLG4oImZhYjIiKS5hcHBlbmRDaGlsZChlKSxlLnNyYz0iamF2YXNjcmlwdDoiLHQ9ZS5jb250ZW50V2luZG93LmRvY3VtZW50LHQub3BlbigpLHQud3JpdGUobysic2NyaXB0IithKyJkb2N1bWVudC5GPU9iamVjdCIrbysiL3NjcmlwdCIrYSksdC5jbG9zZSgpLHU9dC5GO3doaWxlKHItLSlkZWxldGUgdVtjXVtpW3JdXTtyZXR1cm4gdSgpfTt0LmV4cG9ydHM9T2JqZWN0LmNyZWF0ZXx8ZnVuY3Rpb24odCxlKXt2YXIgbjtyZXR1cm4gbnVsbCE9PXQ/KHNbY109cih0KSxuPW5ldyBzLHNbY109bnVsbCxuW2FdPXQpOm49dSgpLHZvaWQgMD09PWU/bjpvKG4sZSl9fSwiMmIwZSI6ZnVuY3Rpb24odCxlLG4peyJ1c2Ugc3RyaWN0IjsoZnVuY3Rpb24odCl7Ci8qIQogKiBWdWUuanMgdjIuNS4xNwogKiAoYykgMjAxNC0yMDE4IEV2YW4gWW91CiAqIFJlbGVhc2VkIHVuZGVyIHRoZSBNSVQgTGljZW5zZS4KICovCnZhciBuPU9iamVjdC5mcmVlemUoe30pO2Z1bmN0aW9uIHIodCl7cmV0dXJuIHZvaWQgMD09PXR8fG51bGw9PT10fWZ1bmN0aW9uIG8odCl7cmV0dXJuIHZvaWQgMCE9PXQmJm51bGwhPT10fWZ1bmN0aW9uIGkodCl7cmV0dXJuITA9PT10fWZ1bmN0aW9uIGEodCl7cmV0dXJuITE9PT10fWZ1bmN0aW9uIHModCl7cmV0dXJuInN0cmluZyI9PT10eXBlb2YgdHx8Im51bWJlciI9PT10eXBlb2YgdHx8InN5bWJvbCI9PT10eXBlb2YgdHx8ImJvb2xlYW4iPT09dHlwZW9mIHR9ZnVuY3Rpb24gYyh0KXtyZXR1cm4gbnVsbCE9PXQmJiJvYmplY3QiPT09dHlwZW9mIHR9dmFyIHU9T2JqZWN0LnByb3RvdHlwZS50b1N0cmluZztmdW5jdGlvbiBmKHQpe3JldHVybiJbb2JqZWN0IE9iamVjdF0iPT09dS5jYWxsKHQpfWZ1bmN0aW9uIGwodCl7cmV0dXJuIltvYmplY3QgUmVnRXhwXSI9PT11LmNhbGwodCl9ZnVuY3Rpb24gcCh0KXt2YXIgZT1wYXJzZUZsb2F0KFN0cmluZyh0KSk7cmV0dXJuIGU+PTAmJk1hdGguZmxvb3IoZSk9PT1lJiZpc0Zpbml0ZSh0KX1mdW5jdGlvbiBkKHQpe3JldHVybiBudWxsPT10PyIiOiJvYmplY3QiPT09dHlwZW9mIHQ/SlNPTi5zdHJpbmdpZnkodCxudWxsLDIpOlN0cmluZyh0KX1mdW5jdGlvbiB2KHQpe3ZhciBlPXBhcnNlRmxvYXQodCk7cmV0dXJuIGlzTmFOKGUpP3Q6ZX1mdW5jdGlvbiBoKHQsZSl7Zm9yKHZhciBuPU9iamVjdC5jcmVhdGUobnVsbCkscj10LnNwbGl0KCIsIiksbz0wO288ci5sZW5ndGg7bysrKW5bcltvXV09ITA7cmV0dXJuIGU/ZnVuY3Rpb24odCl7cmV0dXJuIG5bdC50b0xvd2VyQ2FzZSgpXX06ZnVuY3Rpb24odCl7cmV0dXJuIG5bdF19fWgoInNsb3QsY29tcG9uZW50IiwhMCk7dmFyIG09aCgia2V5LHJlZixzbG90LHNsb3Qtc2NvcGUsaXMiKTtmdW5jdGlvbiB5KHQsZSl7aWYodC5sZW5ndGgpe3ZhciBuPXQuaW5kZXhPZihlKTtpZihuPi0xKXJldHVybiB0LnNwbGljZShuLDEpfX12YXIgXz1PYmplY3QucHJvdG90eXBlLmhhc093blByb3BlcnR5O2Z1bmN0aW9uIGcodCxlKXtyZXR1cm4gXy5jYWxsKHQsZSl9ZnVuY3Rpb24gYih0KXt2YXIgZT1PYmplY3QuY3JlYXRlKG51bGwpO3JldHVybiBmdW5jdGlvbihuKXt2YXIgcj1lW25dO3JldHVybiByfHwoZVtuXT10KG4pKX19dmFyIHc9Ly0oXHcpL2cseD1iKGZ1bmN0aW9uKHQpe3JldHVybiB0LnJlcGxhY2UodyxmdW5jdGlvbih0LGUpe3JldHVybiBlP2UudG9VcHBlckNhc2UoKToiIn0pfSksQz1iKGZ1bmN0aW9uKHQpe3JldHVybiB0LmNoYXJBdCgwKS50b1VwcGVyQ2FzZSgpK3Quc2xpY2UoMSl9KSxBPS9cQihbQS1aXSkvZyxPPWIoZnVuY3Rpb24odCl7cmV0dXJuIHQucmVwbGFjZShBLCItJDEiKS50b0xvd2VyQ2FzZSgpfSk7ZnVuY3Rpb24gJCh0LGUpe2Z1bmN0aW9uIG4obil7dmFyIHI9YXJndW1lbnRzLmxlbmd0aDtyZXR1cm4gcj9yPjE/dC5hcHBseShlLGFyZ3VtZW50cyk6dC5jYWxsKGUsbik6dC5jYWxsKGUpfXJldHVybiBuLl9sZW5ndGg9dC5sZW5ndGgsbn1mdW5jdGlvbiBrKHQsZSl7cmV0dXJuIHQuYmluZChlKX12YXIgUz1GdW5jdGlvbi5wcm90b3R5cGUuYmluZD9rOiQ7ZnVuY3Rpb24gaih0LGUpe2U9ZXx8MDt2YXIgbj10Lmxlbmd0aC1lLHI9bmV3IEFycmF5KG4pO3doaWxlKG4tLSlyW25dPXRbbitlXTtyZXR1cm4gcn1mdW5jdGlvbiBFKHQsZSl7Zm9yKHZhciBuIGluIGUpdFtuXT1lW25dO3JldHVybiB0fWZ1bmN0aW9uIFQodCl7Zm9yKHZhciBlPXt9LG49MDtuPHQubGVuZ3RoO24rKyl0W25dJiZFKGUsdFtuXSk7cmV0dXJuIGV9ZnVuY3Rpb24gSSh0LGUsbil7fXZhciBQPWZ1bmN0aW9uKHQsZSxuKXtyZXR1cm4hMX0sTT1mdW5jdGlvbih0KXtyZXR1cm4gdH07ZnVuY3Rpb24gTih0LGUpe2lmKHQ9PT1lKXJldHVybiEwO3ZhciBuPWModCkscj1jKGUpO2lmKCFufHwhcilyZXR1cm4hbiYmIXImJlN0cmluZyh0KT09PVN0cmluZyhlKTt0cnl7dmFyIG89QXJyYXkuaXNBcnJheSh0KSxpPUFycmF5LmlzQXJyYXkoZSk7aWYobyYmaSlyZXR1cm4gdC5sZW5ndGg9PT1lLmxlbmd0aCYmdC5ldmVyeShmdW5jdGlvbih0LG4pe3JldHVybiBOKHQsZVtuXSl9KTtpZihvfHxpKXJldHVybiExO3ZhciBhPU9iamVjdC5rZXlzKHQpLHM9T2JqZWN0LmtleXMoZSk7cmV0dXJuIGEubGVuZ3RoPT09cy5sZW5ndGgmJmEuZXZlcnkoZnVuY3Rpb24obil7cmV0dXJuIE4odFtuXSxlW25dKX0pfWNhdGNoKHQpe3JldHVybiExfX1mdW5jdGlvbiBEKHQsZSl7Zm9yKHZhciBuPTA7bjx0Lmxlbmd0aDtuKyspaWYoTih0W25dLGUpKXJldHVybiBuO3JldHVybi0xfWZ1bmN0aW9uIEwodCl7dmFyIGU9ITE7cmV0dXJuIGZ1bmN0aW9uKCl7ZXx8KGU9ITAsdC5hcHBseSh0aGlzLGFyZ3VtZW50cykpfX12YXIgRj0iZGF0YS1zZXJ2ZXItcmVuZGVyZWQiLFI9WyJjb21wb25lbnQiLCJkaXJlY3RpdmUiLCJmaWx0ZXIiXSxVPVsiYmVmb3JlQ3JlYXRlIiwiY3JlYXRlZCIsImJlZm9yZU1vdW50IiwibW91bnRlZCIsImJlZm9yZVVwZGF0ZSIsInVwZGF0ZWQiLCJiZWZvcmVEZXN0cm95IiwiZGVzdHJveWVkIiwiYWN0aXZhdGVkIiwiZGVhY3RpdmF0ZWQiLCJlcnJvckNhcHR1cmVkIl0sQj17b3B0aW9uTWVyZ2VTdHJhdGVnaWVzOk9iamVjdC5jcmVhdGUobnVsbCksc2lsZW50OiExLHByb2R1Y3Rpb25UaXA6ITEsZGV2dG9vbHM6ITEscGVyZm9ybWFuY2U6ITEsZXJyb3JIYW5kbGVyOm51bGwsd2FybkhhbmRsZXI6bnVsbCxpZ25vcmVkRWxlbWVudHM6W10sa2V5Q29kZXM6T2JqZWN0LmNyZWF0ZShudWxsKSxpc1Jlc2VydmVkVGFnOlAsaXNSZXNlcnZlZEF0dHI6UCxpc1Vua25vd25FbGVtZW50OlAsZ2V0VGFnTmFtZXNwYWNlOkkscGFyc2VQbGF0Zm9ybVRhZ05hbWU6TSxtdXN0VXNlUHJvcDpQLF9saWZlY3ljbGVIb29rczpVfTtmdW5jdGlvbiBWKHQpe3ZhciBlPSh0KyIiKS5jaGFyQ29kZUF0KDApO3JldHVybiAzNj09PWV8fDk1PT09ZX1mdW5jdGlvbiBIKHQsZSxuLHIpe09iamVjdC5kZWZpbmVQcm9wZXJ0eSh0LGUse3ZhbHVlOm4sZW51bWVyYWJsZTohIXIsd3JpdGFibGU6ITAsY29uZmlndXJhYmxlOiEwfSl9dmFyIHo9L1teXHcuJF0vO2Z1bmN0aW9uIFcodCl7aWYoIXoudGVzdCh0KSl7dmFyIGU9dC5zcGxpdCgiLiIpO3JldHVybiBmdW5jdGlvbih0KXtmb3IodmFyIG49MDtuPGUubGVuZ3RoO24rKyl7aWYoIXQpcmV0dXJuO3Q9dFtlW25dXX1yZXR1cm4gdH19fXZhciBLLHE9Il9fcHJvdG9fXyJpbnt9LFg9InVuZGVmaW5lZCIhPT10eXBlb2Ygd2luZG93LEc9InVuZGVmaW5lZCIhPT10eXBlb2YgV1hFbnZpcm9ubWVudCYmISFXWEVudmlyb25tZW50LnBsYXRmb3JtLEo9RyYmV1hFbnZpcm9ubWVudC5wbGF0Zm9ybS50b0xvd2VyQ2FzZSgpLFo9WCYmd2luZG93Lm5hdmlnYXRvci51c2VyQWdlbnQudG9Mb3dlckNhc2UoKSxRPVomJi9tc2llfHRyaWRlbnQvLnRlc3QoWiksWT1aJiZaLmluZGV4T2YoIm1zaWUgOS4wIik+MCx0dD1aJiZaLmluZGV4T2YoImVkZ2UvIik+MCxldD0oWiYmWi5pbmRleE9mKCJhbmRyb2lkIiksWiYmL2lwaG9uZXxpcGFkfGlwb2R8aW9zLy50ZXN0KFopfHwiaW9zIj09PUopLG50PShaJiYvY2hyb21lXC9cZCsvLnRlc3QoWikse30ud2F0Y2gpLHJ0PSExO2lmKFgpdHJ5e3ZhciBvdD17fTtPYmplY3QuZGVmaW5lUHJvcGVydHkob3QsInBhc3NpdmUiLHtnZXQ6ZnVuY3Rpb24oKXtydD0hMH19KSx3aW5kb3cuYWRkRXZlbnRMaXN0ZW5lcigidGVzdC1wYXNzaXZlIixudWxsLG90KX1jYXRjaCh0KXt9dmFyIGl0PWZ1bmN0aW9uKCl7cmV0dXJuIHZvaWQgMD09PUsmJihLPSFYJiYhRyYmInVuZGVmaW5lZCIhPT10eXBlb2YgdCYmInNlcnZlciI9PT10WyJwcm9jZXNzIl0uZW52LlZVRV9FTlYpLEt9LGF0PVgmJndpbmRvdy5fX1ZVRV9ERVZUT09MU19HTE9CQUxfSE9PS19fO2Z1bmN0aW9uIHN0KHQpe3JldHVybiJmdW5jdGlvbiI9PT10eXBlb2YgdCYmL25hdGl2ZSBjb2RlLy50ZXN0KHQudG9TdHJpbmcoKSl9dmFyIGN0LHV0PSJ1bmRlZmluZWQiIT09dHlwZW9mIFN5bWJvbCYmc3QoU3ltYm9sKSYmInVuZGVmaW5lZCIhPT10eXBlb2YgUmVmbGVjdCYmc3QoUmVmbGVjdC5vd25LZXlzKTtjdD0idW5kZWZpbmVkIiE9PXR5cGVvZiBTZXQmJnN0KFNldCk/U2V0OmZ1bmN0aW9uKCl7ZnVuY3Rpb24gdCgpe3RoaXMuc2V0PU9iamVjdC5jcmVhdGUobnVsbCl9cmV0dXJuIHQucHJvdG90eXBlLmhhcz1mdW5jdGlvbih0KXtyZXR1cm4hMD09PXRoaXMuc2V0W3RdfSx0LnByb3RvdHlwZS5hZGQ9ZnVuY3Rpb24odCl7dGhpcy5zZXRbdF09ITB9LHQucHJvdG90eXBlLmNsZWFyPWZ1bmN0aW9uKCl7dGhpcy5zZXQ9T2JqZWN0LmNyZWF0ZShudWxsKX0sdH0oKTt2YXIgZnQ9SSxsdD0wLHB0PWZ1bmN0aW9uKCl7dGhpcy5pZD1sdCsrLHRoaXMuc3Vicz1bXX07cHQucHJvdG90eXBlLmFkZFN1Yj1mdW5jdGlvbih0KXt0aGlzLnN1YnMucHVzaCh0KX0scHQucHJvdG90eXBlLnJlbW92ZVN1Yj1mdW5jdGlvbih0KXt5KHRoaXMuc3Vicyx0KX0scHQucHJvdG90eXBlLmRlcGVuZD1mdW5jdGlvbigpe3B0LnRhcmdldCYmcHQudGFyZ2V0LmFkZERlcCh0aGlzKX0scHQucHJvdG90eXBlLm5vdGlmeT1mdW5jdGlvbigpe2Zvcih2YXIgdD10aGlzLnN1YnMuc2xpY2UoKSxlPTAsbj10Lmxlbmd0aDtlPG47ZSsrKXRbZV0udXBkYXRlKCl9LHB0LnRhcmdldD1udWxsO3ZhciBkdD1bXTtmdW5jdGlvbiB2dCh0KXtwdC50YXJnZXQmJmR0LnB1c2gocHQudGFyZ2V0KSxwdC50YXJnZXQ9dH1mdW5jdGlvbiBodCgpe3B0LnRhcmdldD1kdC5wb3AoKX12YXIgbXQ9ZnVuY3Rpb24odCxlLG4scixvLGksYSxzKXt0aGlzLnRhZz10LHRoaXMuZGF0YT1lLHRoaXMuY2hpbGRyZW49bix0aGlzLnRleHQ9cix0aGlzLmVsbT1vLHRoaXMubnM9dm9pZCAwLHRoaXMuY29udGV4dD1pLHRoaXMuZm5Db250ZXh0PXZvaWQgMCx0aGlzLmZuT3B0aW9ucz12b2lkIDAsdGhpcy5mblNjb3BlSWQ9dm9pZCAwLHRoaXMua2V5PWUmJmUua2V5LHRoaXMuY29tcG9uZW50T3B0aW9ucz1hLHRoaXMuY29tcG9uZW50SW5zdGFuY2U9dm9pZCAwLHRoaXMucGFyZW50PXZvaWQgMCx0aGlzLnJhdz0hMSx0aGlzLmlzU3RhdGljPSExLHRoaXMuaXNSb290SW5zZXJ0PSEwLHRoaXMuaXNDb21tZW50PSExLHRoaXMuaXNDbG9uZWQ9ITEsdGhpcy5pc09uY2U9ITEsdGhpcy5hc3luY0ZhY3Rvcnk9cyx0aGlzLmFzeW5jTWV0YT12b2lkIDAsdGhpcy5pc0FzeW5jUGxhY2Vob2xkZXI9ITF9LHl0PXtjaGlsZDp7Y29uZmlndXJhYmxlOiEwfX07eXQuY2hpbGQuZ2V0PWZ1bmN0aW9uKCl7cmV0dXJuIHRoaXMuY29tcG9uZW50SW5zdGFuY2V9LE9iamVjdC5kZWZpbmVQcm9wZXJ0aWVzKG10LnByb3RvdHlwZSx5dCk7dmFyIF90PWZ1bmN0aW9uKHQpe3ZvaWQgMD09PXQmJih0PSIiKTt2YXIgZT1uZXcgbXQ7cmV0dXJuIGUudGV4dD10LGUuaXNDb21tZW50PSEwLGV9O2Z1bmN0aW9uIGd0KHQpe3JldHVybiBuZXcgbXQodm9pZCAwLHZvaWQgMCx2b2lkIDAsU3RyaW5nKHQpKX1mdW5jdGlvbiBidCh0KXt2YXIgZT1uZXcgbXQodC50YWcsdC5kYXRhLHQuY2hpbGRyZW4sdC50ZXh0LHQuZWxtLHQuY29udGV4dCx0LmNvbXBvbmVudE9wdGlvbnMsdC5hc3luY0ZhY3RvcnkpO3JldHVybiBlLm5zPXQubnMsZS5pc1N0YXRpYz10LmlzU3RhdGljLGUua2V5PXQua2V5LGUuaXNDb21tZW50PXQuaXNDb21tZW50LGUuZm5Db250ZXh0PXQuZm5Db250ZXh0LGUuZm5PcHRpb25zPXQuZm5PcHRpb25zLGUuZm5TY29wZUlkPXQuZm5TY29wZUlkLGUuaXNDbG9uZWQ9ITAsZX12YXIgd3Q9QXJyYXkucHJvdG90eXBlLHh0PU9iamVjdC5jcmVhdGUod3QpLEN0PVsicHVzaCIsInBvcCIsInNoaWZ0IiwidW5zaGlmdCIsInNwbGljZSIsInNvcnQiLCJyZXZlcnNlIl07Q3QuZm9yRWFjaChmdW5jdGlvbih0KXt2YXIgZT13dFt0XTtIKHh0LHQsZnVuY3Rpb24oKXt2YXIgbj1bXSxyPWFyZ3VtZW50cy5sZW5ndGg7d2hpbGUoci0tKW5bcl09YXJndW1lbnRzW3JdO3ZhciBvLGk9ZS5hcHBseSh0aGlzLG4pLGE9dGhpcy5fX29iX187c3dpdGNoKHQpe2Nhc2UicHVzaCI6Y2FzZSJ1bnNoaWZ0IjpvPW47YnJlYWs7Y2FzZSJzcGxpY2UiOm89bi5zbGljZSgyKTticmVha31yZXR1cm4gbyYmYS5vYnNlcnZlQXJyYXkobyksYS5kZXAubm90aWZ5KCksaX0pfSk7dmFyIEF0PU9iamVjdC5nZXRPd25Qcm9wZXJ0eU5hbWVzKHh0KSxPdD0hMDtmdW5jdGlvbiAkdCh0KXtPdD10fXZhciBrdD1mdW5jdGlvbih0KXtpZih0aGlzLnZhbHVlPXQsdGhpcy5kZXA9bmV3IHB0LHRoaXMudm1Db3VudD0wLEgodCwiX19vYl9fIix0aGlzKSxBcnJheS5pc0FycmF5KHQpKXt2YXIgZT1xP1N0Omp0O2UodCx4dCxBdCksdGhpcy5vYnNlcnZlQXJyYXkodCl9ZWxzZSB0aGlzLndhbGsodCl9O2Z1bmN0aW9uIFN0KHQsZSxuKXt0Ll9fcHJvdG9fXz1lfWZ1bmN0aW9uIGp0KHQsZSxuKXtmb3IodmFyIHI9MCxvPW4ubGVuZ3RoO3I8bztyKyspe3ZhciBpPW5bcl07SCh0LGksZVtpXSl9fWZ1bmN0aW9uIEV0KHQsZSl7dmFyIG47aWYoYyh0KSYmISh0IGluc3RhbmNlb2YgbXQpKXJldHVybiBnKHQsIl9fb2JfXyIpJiZ0Ll9fb2JfXyBpbnN0YW5jZW9mIGt0P249dC5fX29iX186T3QmJiFpdCgpJiYoQXJyYXkuaXNBcnJheSh0KXx8Zih0KSkmJk9iamVjdC5pc0V4dGVuc2libGUodCkmJiF0Ll9pc1Z1ZSYmKG49bmV3IGt0KHQpKSxlJiZuJiZuLnZtQ291bnQrKyxufWZ1bmN0aW9uIFR0KHQsZSxuLHIsbyl7dmFyIGk9bmV3IHB0LGE9T2JqZWN0LmdldE93blByb3BlcnR5RGVzY3JpcHRvcih0LGUpO2lmKCFhfHwhMSE9PWEuY29uZmlndXJhYmxlKXt2YXIgcz1hJiZhLmdldDtzfHwyIT09YXJndW1lbnRzLmxlbmd0aHx8KG49dFtlXSk7dmFyIGM9YSYmYS5zZXQsdT0hbyYmRXQobik7T2JqZWN0LmRlZmluZVByb3BlcnR5KHQsZSx7ZW51bWVyYWJsZTohMCxjb25maWd1cmFibGU6ITAsZ2V0OmZ1bmN0aW9uKCl7dmFyIGU9cz9zLmNhbGwodCk6bjtyZXR1cm4gcHQudGFyZ2V0JiYoaS5kZXBlbmQoKSx1JiYodS5kZXAuZGVwZW5kKCksQXJyYXkuaXNBcnJheShlKSYmTXQoZSkpKSxlfSxzZXQ6ZnVuY3Rpb24oZSl7dmFyIHI9cz9zLmNhbGwodCk6bjtlPT09cnx8ZSE9PWUmJnIhPT1yfHwoYz9jLmNhbGwodCxlKTpuPWUsdT0hbyYmRXQoZSksaS5ub3RpZnkoKSl9fSl9fWZ1bmN0aW9uIEl0KHQsZSxuKXtpZihBcnJheS5pc0FycmF5KHQpJiZwKGUpKXJldHVybiB0Lmxlbmd0aD1NYXRoLm1heCh0Lmxlbmd0aCxlKSx0LnNwbGljZShlLDEsbiksbjtpZihlIGluIHQmJiEoZSBpbiBPYmplY3QucHJvdG90eXBlKSlyZXR1cm4gdFtlXT1uLG47dmFyIHI9dC5fX29iX187cmV0dXJuIHQuX2lzVnVlfHxyJiZyLnZtQ291bnQ/bjpyPyhUdChyLnZhbHVlLGUsbiksci5kZXAubm90aWZ5KCksbik6KHRbZV09bixuKX1mdW5jdGlvbiBQdCh0LGUpe2lmKEFycmF5LmlzQXJyYXkodCkmJnAoZSkpdC5zcGxpY2UoZSwxKTtlbHNle3ZhciBuPXQuX19vYl9fO3QuX2lzVnVlfHxuJiZuLnZtQ291bnR8fGcodCxlKSYmKGRlbGV0ZSB0W2VdLG4mJm4uZGVwLm5vdGlmeSgpKX19ZnVuY3Rpb24gTXQodCl7Zm9yKHZhciBlPXZvaWQgMCxuPTAscj10Lmxlbmd0aDtuPHI7bisrKWU9dFtuXSxlJiZlLl9fb2JfXyYmZS5fX29iX18uZGVwLmRlcGVuZCgpLEFycmF5LmlzQXJyYXkoZSkmJk10KGUpfWt0LnByb3RvdHlwZS53YWxrPWZ1bmN0aW9uKHQpe2Zvcih2YXIgZT1PYmplY3Qua2V5cyh0KSxuPTA7bjxlLmxlbmd0aDtuKyspVHQodCxlW25dKX0sa3QucHJvdG90eXBlLm9ic2VydmVBcnJheT1mdW5jdGlvbih0KXtmb3IodmFyIGU9MCxuPXQubGVuZ3RoO2U8bjtlKyspRXQodFtlXSl9O3ZhciBOdD1CLm9wdGlvbk1lcmdlU3RyYXRlZ2llcztmdW5jdGlvbiBEdCh0LGUpe2lmKCFlKXJldHVybiB0O2Zvcih2YXIgbixyLG8saT1PYmplY3Qua2V5cyhlKSxhPTA7YTxpLmxlbmd0aDthKyspbj1pW2FdLHI9dFtuXSxvPWVbbl0sZyh0LG4pP2YocikmJmYobykmJkR0KHIsbyk6SXQodCxuLG8pO3JldHVybiB0fWZ1bmN0aW9uIEx0KHQsZSxuKXtyZXR1cm4gbj9mdW5jdGlvbigpe3ZhciByPSJmdW5jdGlvbiI9PT10eXBlb2YgZT9lLmNhbGwobixuKTplLG89ImZ1bmN0aW9uIj09PXR5cGVvZiB0P3QuY2FsbChuLG4pOnQ7cmV0dXJuIHI/RHQocixvKTpvfTplP3Q/ZnVuY3Rpb24oKXtyZXR1cm4gRHQoImZ1bmN0aW9uIj09PXR5cGVvZiBlP2UuY2FsbCh0aGlzLHRoaXMpOmUsImZ1bmN0aW9uIj09PXR5cGVvZiB0P3QuY2FsbCh0aGlzLHRoaXMpOnQpfTplOnR9ZnVuY3Rpb24gRnQodCxlKXtyZXR1cm4gZT90P3QuY29uY2F0KGUpOkFycmF5LmlzQXJyYXkoZSk/ZTpbZV06dH1mdW5jdGlvbiBSdCh0LGUsbixyKXt2YXIgbz1PYmplY3QuY3JlYXRlKHR8fG51bGwpO3JldHVybiBlP0UobyxlKTpvfU50LmRhdGE9ZnVuY3Rpb24odCxlLG4pe3JldHVybiBuP0x0KHQsZSxuKTplJiYiZnVuY3Rpb24iIT09dHlwZW9mIGU/dDpMdCh0LGUpfSxVLmZvckVhY2goZnVuY3Rpb24odCl7TnRbdF09RnR9KSxSLmZvckVhY2goZnVuY3Rpb24odCl7TnRbdCsicyJdPVJ0fSksTnQud2F0Y2g9ZnVuY3Rpb24odCxlLG4scil7aWYodD09PW50JiYodD12b2lkIDApLGU9PT1udCYmKGU9dm9pZCAwKSwhZSlyZXR1cm4gT2JqZWN0LmNyZWF0ZSh0fHxudWxsKTtpZighdClyZXR1cm4gZTt2YXIgbz17fTtmb3IodmFyIGkgaW4gRShvLHQpLGUpe3ZhciBhPW9baV0scz1lW2ldO2EmJiFBcnJheS5pc0FycmF5KGEpJiYoYT1bYV0pLG9baV09YT9hLmNvbmNhdChzKTpBcnJheS5pc0FycmF5KHMpP3M6W3NdfXJldHVybiBvfSxOdC5wcm9wcz1OdC5tZXRob2RzPU50LmluamVjdD1OdC5jb21wdXRlZD1mdW5jdGlvbih0LGUsbixyKXtpZighdClyZXR1cm4gZTt2YXIgbz1PYmplY3QuY3JlYXRlKG51bGwpO3JldHVybiBFKG8sdCksZSYmRShvLGUpLG99LE50LnByb3ZpZGU9THQ7dmFyIFV0PWZ1bmN0aW9uKHQsZSl7cmV0dXJuIHZvaWQgMD09PWU/dDplfTtmdW5jdGlvbiBCdCh0LGUpe3ZhciBuPXQucHJvcHM7aWYobil7dmFyIHIsbyxpLGE9e307aWYoQXJyYXkuaXNBcnJheShuKSl7cj1uLmxlbmd0aDt3aGlsZShyLS0pbz1uW3JdLCJzdHJpbmciPT09dHlwZW9mIG8mJihpPXgobyksYVtpXT17dHlwZTpudWxsfSl9ZWxzZSBpZihmKG4pKWZvcih2YXIgcyBpbiBuKW89bltzXSxpPXgocyksYVtpXT1mKG8pP286e3R5cGU6b307ZWxzZSAwO3QucHJvcHM9YX19ZnVuY3Rpb24gVnQodCxlKXt2YXIgbj10LmluamVjdDtpZihuKXt2YXIgcj10LmluamVjdD17fTtpZihBcnJheS5pc0FycmF5KG4pKWZvcih2YXIgbz0wO288bi5sZW5ndGg7bysrKXJbbltvXV09e2Zyb206bltvXX07ZWxzZSBpZihmKG4pKWZvcih2YXIgaSBpbiBuKXt2YXIgYT1uW2ldO3JbaV09ZihhKT9FKHtmcm9tOml9LGEpOntmcm9tOmF9fWVsc2UgMH19ZnVuY3Rpb24gSHQodCl7dmFyIGU9dC5kaXJlY3RpdmVzO2lmKGUpZm9yKHZhciBuIGluIGUpe3ZhciByPWVbbl07ImZ1bmN0aW9uIj09PXR5cGVvZiByJiYoZVtuXT17YmluZDpyLHVwZGF0ZTpyfSl9fWZ1bmN0aW9uIHp0KHQsZSxuKXsiZnVuY3Rpb24iPT09dHlwZW9mIGUmJihlPWUub3B0aW9ucyksQnQoZSxuKSxWdChlLG4pLEh0KGUpO3ZhciByPWUuZXh0ZW5kcztpZihyJiYodD16dCh0LHIsbikpLGUubWl4aW5zKWZvcih2YXIgbz0wLGk9ZS5taXhpbnMubGVuZ3RoO288aTtvKyspdD16dCh0LGUubWl4aW5zW29dLG4pO3ZhciBhLHM9e307Zm9yKGEgaW4gdCljKGEpO2ZvcihhIGluIGUpZyh0LGEpfHxjKGEpO2Z1bmN0aW9uIGMocil7dmFyIG89TnRbcl18fFV0O3Nbcl09byh0W3JdLGVbcl0sbixyKX1yZXR1cm4gc31mdW5jdGlvbiBXdCh0LGUsbixyKXtpZigic3RyaW5nIj09PXR5cGVvZiBuKXt2YXIgbz10W2VdO2lmKGcobyxuKSlyZXR1cm4gb1tuXTt2YXIgaT14KG4pO2lmKGcobyxpKSlyZXR1cm4gb1tpXTt2YXIgYT1DKGkpO2lmKGcobyxhKSlyZXR1cm4gb1thXTt2YXIgcz1vW25dfHxvW2ldfHxvW2FdO3JldHVybiBzfX1mdW5jdGlvbiBLdCh0LGUsbixyKXt2YXIgbz1lW3RdLGk9IWcobix0KSxhPW5bdF0scz1KdChCb29sZWFuLG8udHlwZSk7aWYocz4tMSlpZihpJiYhZyhvLCJkZWZhdWx0IikpYT0hMTtlbHNlIGlmKCIiPT09YXx8YT09PU8odCkpe3ZhciBjPUp0KFN0cmluZyxvLnR5cGUpOyhjPDB8fHM8YykmJihhPSEwKX1pZih2b2lkIDA9PT1hKXthPXF0KHIsbyx0KTt2YXIgdT1PdDskdCghMCksRXQoYSksJHQodSl9cmV0dXJuIGF9ZnVuY3Rpb24gcXQodCxlLG4pe2lmKGcoZSwiZGVmYXVsdCIpKXt2YXIgcj1lLmRlZmF1bHQ7cmV0dXJuIHQmJnQuJG9wdGlvbnMucHJvcHNEYXRhJiZ2b2lkIDA9PT10LiRvcHRpb25zLnByb3BzRGF0YVtuXSYmdm9pZCAwIT09dC5fcHJvcHNbbl0/dC5fcHJvcHNbbl06ImZ1bmN0aW9uIj09PXR5cGVvZiByJiYiRnVuY3Rpb24iIT09WHQoZS50eXBlKT9yLmNhbGwodCk6cn19ZnVuY3Rpb24gWHQodCl7dmFyIGU9dCYmdC50b1N0cmluZygpLm1hdGNoKC9eXHMqZnVuY3Rpb24gKFx3KykvKTtyZXR1cm4gZT9lWzFdOiIifWZ1bmN0aW9uIEd0KHQsZSl7cmV0dXJuIFh0KHQpPT09WHQoZSl9ZnVuY3Rpb24gSnQodCxlKXtpZighQXJyYXkuaXNBcnJheShlKSlyZXR1cm4gR3QoZSx0KT8wOi0xO2Zvcih2YXIgbj0wLHI9ZS5sZW5ndGg7bjxyO24rKylpZihHdChlW25dLHQpKXJldHVybiBuO3JldHVybi0xfWZ1bmN0aW9uIFp0KHQsZSxuKXtpZihlKXt2YXIgcj1lO3doaWxlKHI9ci4kcGFyZW50KXt2YXIgbz1yLiRvcHRpb25zLmVycm9yQ2FwdHVyZWQ7aWYobylmb3IodmFyIGk9MDtpPG8ubGVuZ3RoO2krKyl0cnl7dmFyIGE9ITE9PT1vW2ldLmNhbGwocix0LGUsbik7aWYoYSlyZXR1cm59Y2F0Y2godCl7UXQodCxyLCJlcnJvckNhcHR1cmVkIGhvb2siKX19fVF0KHQsZSxuKX1mdW5jdGlvbiBRdCh0LGUsbil7aWYoQi5lcnJvckhhbmRsZXIpdHJ5e3JldHVybiBCLmVycm9ySGFuZGxlci5jYWxsKG51bGwsdCxlLG4pfWNhdGNoKHQpe1l0KHQsbnVsbCwiY29uZmlnLmVycm9ySGFuZGxlciIpfVl0KHQsZSxuKX1mdW5jdGlvbiBZdCh0LGUsbil7aWYoIVgmJiFHfHwidW5kZWZpbmVkIj09PXR5cGVvZiBjb25zb2xlKXRocm93IHQ7Y29uc29sZS5lcnJvcih0KX12YXIgdGUsZWUsbmU9W10scmU9ITE7ZnVuY3Rpb24gb2UoKXtyZT0hMTt2YXIgdD1uZS5zbGljZSgwKTtuZS5sZW5ndGg9MDtmb3IodmFyIGU9MDtlPHQubGVuZ3RoO2UrKyl0W2VdKCl9dmFyIGllPSExO2lmKCJ1bmRlZmluZWQiIT09dHlwZW9mIHNldEltbWVkaWF0ZSYmc3Qoc2V0SW1tZWRpYXRlKSllZT1mdW5jdGlvbigpe3NldEltbWVkaWF0ZShvZSl9O2Vsc2UgaWYoInVuZGVmaW5lZCI9PT10eXBlb2YgTWVzc2FnZUNoYW5uZWx8fCFzdChNZXNzYWdlQ2hhbm5lbCkmJiJbb2JqZWN0IE1lc3NhZ2VDaGFubmVsQ29uc3RydWN0b3JdIiE9PU1lc3NhZ2VDaGFubmVsLnRvU3RyaW5nKCkpZWU9ZnVuY3Rpb24oKXtzZXRUaW1lb3V0KG9lLDApfTtlbHNle3ZhciBhZT1uZXcgTWVzc2FnZUNoYW5uZWwsc2U9YWUucG9ydDI7YWUucG9ydDEub25tZXNzYWdlPW9lLGVlPWZ1bmN0aW9uKCl7c2UucG9zdE1lc3NhZ2UoMSl9fWlmKCJ1bmRlZmluZWQiIT09dHlwZW9mIFByb21pc2UmJnN0KFByb21pc2UpKXt2YXIgY2U9UHJvbWlzZS5yZXNvbHZlKCk7dGU9ZnVuY3Rpb24oKXtjZS50aGVuKG9lKSxldCYmc2V0VGltZW91dChJKX19ZWxzZSB0ZT1lZTtmdW5jdGlvbiB1ZSh0KXtyZXR1cm4gdC5fd2l0aFRhc2t8fCh0Ll93aXRoVGFzaz1mdW5jdGlvbigpe2llPSEwO3ZhciBlPXQuYXBwbHkobnVsbCxhcmd1bWVudHMpO3JldHVybiBpZT0hMSxlfSl9ZnVuY3Rpb24gZmUodCxlKXt2YXIgbjtpZihuZS5wdXNoKGZ1bmN0aW9uKCl7aWYodCl0cnl7dC5jYWxsKGUpfWNhdGNoKHQpe1p0KHQsZSwibmV4dFRpY2siKX1lbHNlIG4mJm4oZSl9KSxyZXx8KHJlPSEwLGllP2VlKCk6dGUoKSksIXQmJiJ1bmRlZmluZWQiIT09dHlwZW9mIFByb21pc2UpcmV0dXJuIG5ldyBQcm9taXNlKGZ1bmN0aW9uKHQpe249dH0pfXZhciBsZT1uZXcgY3Q7ZnVuY3Rpb24gcGUodCl7ZGUodCxsZSksbGUuY2xlYXIoKX1mdW5jdGlvbiBkZSh0LGUpe3ZhciBuLHIsbz1BcnJheS5pc0FycmF5KHQpO2lmKCEoIW8mJiFjKHQpfHxPYmplY3QuaXNGcm96ZW4odCl8fHQgaW5zdGFuY2VvZiBtdCkpe2lmKHQuX19vYl9fKXt2YXIgaT10Ll9fb2JfXy5kZXAuaWQ7aWYoZS5oYXMoaSkpcmV0dXJuO2UuYWRkKGkpfWlmKG8pe249dC5sZW5ndGg7d2hpbGUobi0tKWRlKHRbbl0sZSl9ZWxzZXtyPU9iamVjdC5rZXlzKHQpLG49ci5sZW5ndGg7d2hpbGUobi0tKWRlKHRbcltuXV0sZSl9fX12YXIgdmUsaGU9YihmdW5jdGlvbih0KXt2YXIgZT0iJiI9PT10LmNoYXJBdCgwKTt0PWU/dC5zbGljZSgxKTp0O3ZhciBuPSJ+Ij09PXQuY2hhckF0KDApO3Q9bj90LnNsaWNlKDEpOnQ7dmFyIHI9IiEiPT09dC5jaGFyQXQoMCk7cmV0dXJuIHQ9cj90LnNsaWNlKDEpOnQse25hbWU6dCxvbmNlOm4sY2FwdHVyZTpyLHBhc3NpdmU6ZX19KTtmdW5jdGlvbiBtZSh0KXtmdW5jdGlvbiBlKCl7dmFyIHQ9YXJndW1lbnRzLG49ZS5mbnM7aWYoIUFycmF5LmlzQXJyYXkobikpcmV0dXJuIG4uYXBwbHkobnVsbCxhcmd1bWVudHMpO2Zvcih2YXIgcj1uLnNsaWNlKCksbz0wO288ci5sZW5ndGg7bysrKXJbb10uYXBwbHkobnVsbCx0KX1yZXR1cm4gZS5mbnM9dCxlfWZ1bmN0aW9uIHllKHQsZSxuLG8saSl7dmFyIGEscyxjLHU7Zm9yKGEgaW4gdClzPXRbYV0sYz1lW2FdLHU9aGUoYSkscihzKXx8KHIoYyk/KHIocy5mbnMpJiYocz10W2FdPW1lKHMpKSxuKHUubmFtZSxzLHUub25jZSx1LmNhcHR1cmUsdS5wYXNzaXZlLHUucGFyYW1zKSk6cyE9PWMmJihjLmZucz1zLHRbYV09YykpO2ZvcihhIGluIGUpcih0W2FdKSYmKHU9aGUoYSksbyh1Lm5hbWUsZVthXSx1LmNhcHR1cmUpKX1mdW5jdGlvbiBfZSh0LGUsbil7dmFyIGE7dCBpbnN0YW5jZW9mIG10JiYodD10LmRhdGEuaG9va3x8KHQuZGF0YS5ob29rPXt9KSk7dmFyIHM9dFtlXTtmdW5jdGlvbiBjKCl7bi5hcHBseSh0aGlzLGFyZ3VtZW50cykseShhLmZucyxjKX1yKHMpP2E9bWUoW2NdKTpvKHMuZm5zKSYmaShzLm1lcmdlZCk/KGE9cyxhLmZucy5wdXNoKGMpKTphPW1lKFtzLGNdKSxhLm1lcmdlZD0hMCx0W2VdPWF9ZnVuY3Rpb24gZ2UodCxlLG4pe3ZhciBpPWUub3B0aW9ucy5wcm9wcztpZighcihpKSl7dmFyIGE9e30scz10LmF0dHJzLGM9dC5wcm9wcztpZihvKHMpfHxvKGMpKWZvcih2YXIgdSBpbiBpKXt2YXIgZj1PKHUpO2JlKGEsYyx1LGYsITApfHxiZShhLHMsdSxmLCExKX1yZXR1cm4gYX19ZnVuY3Rpb24gYmUodCxlLG4scixpKXtpZihvKGUpKXtpZihnKGUsbikpcmV0dXJuIHRbbl09ZVtuXSxpfHxkZWxldGUgZVtuXSwhMDtpZihnKGUscikpcmV0dXJuIHRbbl09ZVtyXSxpfHxkZWxldGUgZVtyXSwhMH1yZXR1cm4hMX1mdW5jdGlvbiB3ZSh0KXtmb3IodmFyIGU9MDtlPHQubGVuZ3RoO2UrKylpZihBcnJheS5pc0FycmF5KHRbZV0pKXJldHVybiBBcnJheS5wcm90b3R5cGUuY29uY2F0LmFwcGx5KFtdLHQpO3JldHVybiB0fWZ1bmN0aW9uIHhlKHQpe3JldHVybiBzKHQpP1tndCh0KV06QXJyYXkuaXNBcnJheSh0KT9BZSh0KTp2b2lkIDB9ZnVuY3Rpb24gQ2UodCl7cmV0dXJuIG8odCkmJm8odC50ZXh0KSYmYSh0LmlzQ29tbWVudCl9ZnVuY3Rpb24gQWUodCxlKXt2YXIgbixhLGMsdSxmPVtdO2ZvcihuPTA7bjx0Lmxlbmd0aDtuKyspYT10W25dLHIoYSl8fCJib29sZWFuIj09PXR5cGVvZiBhfHwoYz1mLmxlbmd0aC0xLHU9ZltjXSxBcnJheS5pc0FycmF5KGEpP2EubGVuZ3RoPjAmJihhPUFlKGEsKGV8fCIiKSsiXyIrbiksQ2UoYVswXSkmJkNlKHUpJiYoZltjXT1ndCh1LnRleHQrYVswXS50ZXh0KSxhLnNoaWZ0KCkpLGYucHVzaC5hcHBseShmLGEpKTpzKGEpP0NlKHUpP2ZbY109Z3QodS50ZXh0K2EpOiIiIT09YSYmZi5wdXNoKGd0KGEpKTpDZShhKSYmQ2UodSk/ZltjXT1ndCh1LnRleHQrYS50ZXh0KTooaSh0Ll9pc1ZMaXN0KSYmbyhhLnRhZykmJnIoYS5rZXkpJiZvKGUpJiYoYS5rZXk9Il9fdmxpc3QiK2UrIl8iK24rIl9fIiksZi5wdXNoKGEpKSk7cmV0dXJuIGZ9ZnVuY3Rpb24gT2UodCxlKXtyZXR1cm4odC5fX2VzTW9kdWxlfHx1dCYmIk1vZHVsZSI9PT10W1N5bWJvbC50b1N0cmluZ1RhZ10pJiYodD10LmRlZmF1bHQpLGModCk/ZS5leHRlbmQodCk6dH1mdW5jdGlvbiAkZSh0LGUsbixyLG8pe3ZhciBpPV90KCk7cmV0dXJuIGkuYXN5bmNGYWN0b3J5PXQsaS5hc3luY01ldGE9e2RhdGE6ZSxjb250ZXh0Om4sY2hpbGRyZW46cix0YWc6b30saX1mdW5jdGlvbiBrZSh0LGUsbil7aWYoaSh0LmVycm9yKSYmbyh0LmVycm9yQ29tcCkpcmV0dXJuIHQuZXJyb3JDb21wO2lmKG8odC5yZXNvbHZlZCkpcmV0dXJuIHQucmVzb2x2ZWQ7aWYoaSh0LmxvYWRpbmcpJiZvKHQubG9hZGluZ0NvbXApKXJldHVybiB0LmxvYWRpbmdDb21wO2lmKCFvKHQuY29udGV4dHMpKXt2YXIgYT10LmNvbnRleHRzPVtuXSxzPSEwLHU9ZnVuY3Rpb24oKXtmb3IodmFyIHQ9MCxlPWEubGVuZ3RoO3Q8ZTt0KyspYVt0XS4kZm9yY2VVcGRhdGUoKX0sZj1MKGZ1bmN0aW9uKG4pe3QucmVzb2x2ZWQ9T2UobixlKSxzfHx1KCl9KSxsPUwoZnVuY3Rpb24oZSl7byh0LmVycm9yQ29tcCkmJih0LmVycm9yPSEwLHUoKSl9KSxwPXQoZixsKTtyZXR1cm4gYyhwKSYmKCJmdW5jdGlvbiI9PT10eXBlb2YgcC50aGVuP3IodC5yZXNvbHZlZCkmJnAudGhlbihmLGwpOm8ocC5jb21wb25lbnQpJiYiZnVuY3Rpb24iPT09dHlwZW9mIHAuY29tcG9uZW50LnRoZW4mJihwLmNvbXBvbmVudC50aGVuKGYsbCksbyhwLmVycm9yKSYmKHQuZXJyb3JDb21wPU9lKHAuZXJyb3IsZSkpLG8ocC5sb2FkaW5nKSYmKHQubG9hZGluZ0NvbXA9T2UocC5sb2FkaW5nLGUpLDA9PT1wLmRlbGF5P3QubG9hZGluZz0hMDpzZXRUaW1lb3V0KGZ1bmN0aW9uKCl7cih0LnJlc29sdmVkKSYmcih0LmVycm9yKSYmKHQubG9hZGluZz0hMCx1KCkpfSxwLmRlbGF5fHwyMDApKSxvKHAudGltZW91dCkmJnNldFRpbWVvdXQoZnVuY3Rpb24oKXtyKHQucmVzb2x2ZWQpJiZsKG51bGwpfSxwLnRpbWVvdXQpKSkscz0hMSx0LmxvYWRpbmc/dC5sb2FkaW5nQ29tcDp0LnJlc29sdmVkfXQuY29udGV4dHMucHVzaChuKX1mdW5jdGlvbiBTZSh0KXtyZXR1cm4gdC5pc0NvbW1lbnQmJnQuYXN5bmNGYWN0b3J5fWZ1bmN0aW9uIGplKHQpe2lmKEFycmF5LmlzQXJyYXkodCkpZm9yKHZhciBlPTA7ZTx0Lmxlbmd0aDtlKyspe3ZhciBuPXRbZV07aWYobyhuKSYmKG8obi5jb21wb25lbnRPcHRpb25zKXx8U2UobikpKXJldHVybiBufX1mdW5jdGlvbiBFZSh0KXt0Ll9ldmVudHM9T2JqZWN0LmNyZWF0ZShudWxsKSx0Ll9oYXNIb29rRXZlbnQ9ITE7dmFyIGU9dC4kb3B0aW9ucy5fcGFyZW50TGlzdGVuZXJzO2UmJlBlKHQsZSl9ZnVuY3Rpb24gVGUodCxlLG4pe24/dmUuJG9uY2UodCxlKTp2ZS4kb24odCxlKX1mdW5jdGlvbiBJZSh0LGUpe3ZlLiRvZmYodCxlKX1mdW5jdGlvbiBQZSh0LGUsbil7dmU9dCx5ZShlLG58fHt9LFRlLEllLHQpLHZlPXZvaWQgMH1mdW5jdGlvbiBNZSh0KXt2YXIgZT0vXmhvb2s6Lzt0LnByb3RvdHlwZS4kb249ZnVuY3Rpb24odCxuKXt2YXIgcj10aGlzLG89dGhpcztpZihBcnJheS5pc0FycmF5KHQpKWZvcih2YXIgaT0wLGE9dC5sZW5ndGg7aTxhO2krKylyLiRvbih0W2ldLG4pO2Vsc2Uoby5fZXZlbnRzW3RdfHwoby5fZXZlbnRzW3RdPVtdKSkucHVzaChuKSxlLnRlc3QodCkmJihvLl9oYXNIb29rRXZlbnQ9ITApO3JldHVybiBvfSx0LnByb3RvdHlwZS4kb25jZT1mdW5jdGlvbih0LGUpe3ZhciBuPXRoaXM7ZnVuY3Rpb24gcigpe24uJG9mZih0LHIpLGUuYXBwbHkobixhcmd1bWVudHMpfXJldHVybiByLmZuPWUsbi4kb24odCxyKSxufSx0LnByb3RvdHlwZS4kb2ZmPWZ1bmN0aW9uKHQsZSl7dmFyIG49dGhpcyxyPXRoaXM7aWYoIWFyZ3VtZW50cy5sZW5ndGgpcmV0dXJuIHIuX2V2ZW50cz1PYmplY3QuY3JlYXRlKG51bGwpLHI7aWYoQXJyYXkuaXNBcnJheSh0KSl7Zm9yKHZhciBvPTAsaT10Lmxlbmd0aDtvPGk7bysrKW4uJG9mZih0W29dLGUpO3JldHVybiByfXZhciBhPXIuX2V2ZW50c1t0XTtpZighYSlyZXR1cm4gcjtpZighZSlyZXR1cm4gci5fZXZlbnRzW3RdPW51bGwscjtpZihlKXt2YXIgcyxjPWEubGVuZ3RoO3doaWxlKGMtLSlpZihzPWFbY10scz09PWV8fHMuZm49PT1lKXthLnNwbGljZShjLDEpO2JyZWFrfX1yZXR1cm4gcn0sdC5wcm90b3R5cGUuJGVtaXQ9ZnVuY3Rpb24odCl7dmFyIGU9dGhpcyxuPWUuX2V2ZW50c1t0XTtpZihuKXtuPW4ubGVuZ3RoPjE/aihuKTpuO2Zvcih2YXIgcj1qKGFyZ3VtZW50cywxKSxvPTAsaT1uLmxlbmd0aDtvPGk7bysrKXRyeXtuW29dLmFwcGx5KGUscil9Y2F0Y2gobil7WnQobixlLCdldmVudCBoYW5kbGVyIGZvciAiJyt0KyciJyl9fXJldHVybiBlfX1mdW5jdGlvbiBOZSh0LGUpe3ZhciBuPXt9O2lmKCF0KXJldHVybiBuO2Zvcih2YXIgcj0wLG89dC5sZW5ndGg7cjxvO3IrKyl7dmFyIGk9dFtyXSxhPWkuZGF0YTtpZihhJiZhLmF0dHJzJiZhLmF0dHJzLnNsb3QmJmRlbGV0ZSBhLmF0dHJzLnNsb3QsaS5jb250ZXh0IT09ZSYmaS5mbkNvbnRleHQhPT1lfHwhYXx8bnVsbD09YS5zbG90KShuLmRlZmF1bHR8fChuLmRlZmF1bHQ9W10pKS5wdXNoKGkpO2Vsc2V7dmFyIHM9YS5zbG90LGM9bltzXXx8KG5bc109W10pOyJ0ZW1wbGF0ZSI9PT1pLnRhZz9jLnB1c2guYXBwbHkoYyxpLmNoaWxkcmVufHxbXSk6Yy5wdXNoKGkpfX1mb3IodmFyIHUgaW4gbiluW3VdLmV2ZXJ5KERlKSYmZGVsZXRlIG5bdV07cmV0dXJuIG59ZnVuY3Rpb24gRGUodCl7cmV0dXJuIHQuaXNDb21tZW50JiYhdC5hc3luY0ZhY3Rvcnl8fCIgIj09PXQudGV4dH1mdW5jdGlvbiBMZSh0LGUpe2U9ZXx8e307Zm9yKHZhciBuPTA7bjx0Lmxlbmd0aDtuKyspQXJyYXkuaXNBcnJheSh0W25dKT9MZSh0W25dLGUpOmVbdFtuXS5rZXldPXRbbl0uZm47cmV0dXJuIGV9dmFyIEZlPW51bGw7ZnVuY3Rpb24gUmUodCl7dmFyIGU9dC4kb3B0aW9ucyxuPWUucGFyZW50O2lmKG4mJiFlLmFic3RyYWN0KXt3aGlsZShuLiRvcHRpb25zLmFic3RyYWN0JiZuLiRwYXJlbnQpbj1uLiRwYXJlbnQ7bi4kY2hpbGRyZW4ucHVzaCh0KX10LiRwYXJlbnQ9bix0LiRyb290PW4/bi4kcm9vdDp0LHQuJGNoaWxkcmVuPVtdLHQuJHJlZnM9e30sdC5fd2F0Y2hlcj1udWxsLHQuX2luYWN0aXZlPW51bGwsdC5fZGlyZWN0SW5hY3RpdmU9ITEsdC5faXNNb3VudGVkPSExLHQuX2lzRGVzdHJveWVkPSExLHQuX2lzQmVpbmdEZXN0cm95ZWQ9ITF9ZnVuY3Rpb24gVWUodCl7dC5wcm90b3R5cGUuX3VwZGF0ZT1mdW5jdGlvbih0LGUpe3ZhciBuPXRoaXM7bi5faXNNb3VudGVkJiZLZShuLCJiZWZvcmVVcGRhdGUiKTt2YXIgcj1uLiRlbCxvPW4uX3Zub2RlLGk9RmU7RmU9bixuLl92bm9kZT10LG8/bi4kZWw9bi5fX3BhdGNoX18obyx0KToobi4kZWw9bi5fX3BhdGNoX18obi4kZWwsdCxlLCExLG4uJG9wdGlvbnMuX3BhcmVudEVsbSxuLiRvcHRpb25zLl9yZWZFbG0pLG4uJG9wdGlvbnMuX3BhcmVudEVsbT1uLiRvcHRpb25zLl9yZWZFbG09bnVsbCksRmU9aSxyJiYoci5fX3Z1ZV9fPW51bGwpLG4uJGVsJiYobi4kZWwuX192dWVfXz1uKSxuLiR2bm9kZSYmbi4kcGFyZW50JiZuLiR2bm9kZT09PW4uJHBhcmVudC5fdm5vZGUmJihuLiRwYXJlbnQuJGVsPW4uJGVsKX0sdC5wcm90b3R5cGUuJGZvcmNlVXBkYXRlPWZ1bmN0aW9uKCl7dmFyIHQ9dGhpczt0Ll93YXRjaGVyJiZ0Ll93YXRjaGVyLnVwZGF0ZSgpfSx0LnByb3RvdHlwZS4kZGVzdHJveT1mdW5jdGlvbigpe3ZhciB0PXRoaXM7aWYoIXQuX2lzQmVpbmdEZXN0cm95ZWQpe0tlKHQsImJlZm9yZURlc3Ryb3kiKSx0Ll9pc0JlaW5nRGVzdHJveWVkPSEwO3ZhciBlPXQuJHBhcmVudDshZXx8ZS5faXNCZWluZ0Rlc3Ryb3llZHx8dC4kb3B0aW9ucy5hYnN0cmFjdHx8eShlLiRjaGlsZHJlbix0KSx0Ll93YXRjaGVyJiZ0Ll93YXRjaGVyLnRlYXJkb3duKCk7dmFyIG49dC5fd2F0Y2hlcnMubGVuZ3RoO3doaWxlKG4tLSl0Ll93YXRjaGVyc1tuXS50ZWFyZG93bigpO3QuX2RhdGEuX19vYl9fJiZ0Ll9kYXRhLl9fb2JfXy52bUNvdW50LS0sdC5faXNEZXN0cm95ZWQ9ITAsdC5fX3BhdGNoX18odC5fdm5vZGUsbnVsbCksS2UodCwiZGVzdHJveWVkIiksdC4kb2ZmKCksdC4kZWwmJih0LiRlbC5fX3Z1ZV9fPW51bGwpLHQuJHZub2RlJiYodC4kdm5vZGUucGFyZW50PW51bGwpfX19ZnVuY3Rpb24gQmUodCxlLG4pe3ZhciByO3JldHVybiB0LiRlbD1lLHQuJG9wdGlvbnMucmVuZGVyfHwodC4kb3B0aW9ucy5yZW5kZXI9X3QpLEtlKHQsImJlZm9yZU1vdW50Iikscj1mdW5jdGlvbigpe3QuX3VwZGF0ZSh0Ll9yZW5kZXIoKSxuKX0sbmV3IHNuKHQscixJLG51bGwsITApLG49ITEsbnVsbD09dC4kdm5vZGUmJih0Ll9pc01vdW50ZWQ9ITAsS2UodCwibW91bnRlZCIpKSx0fWZ1bmN0aW9uIFZlKHQsZSxyLG8saSl7dmFyIGE9ISEoaXx8dC4kb3B0aW9ucy5fcmVuZGVyQ2hpbGRyZW58fG8uZGF0YS5zY29wZWRTbG90c3x8dC4kc2NvcGVkU2xvdHMhPT1uKTtpZih0LiRvcHRpb25zLl9wYXJlbnRWbm9kZT1vLHQuJHZub2RlPW8sdC5fdm5vZGUmJih0Ll92bm9kZS5wYXJlbnQ9byksdC4kb3B0aW9ucy5fcmVuZGVyQ2hpbGRyZW49aSx0LiRhdHRycz1vLmRhdGEuYXR0cnN8fG4sdC4kbGlzdGVuZXJzPXJ8fG4sZSYmdC4kb3B0aW9ucy5wcm9wcyl7JHQoITEpO2Zvcih2YXIgcz10Ll9wcm9wcyxjPXQuJG9wdGlvbnMuX3Byb3BLZXlzfHxbXSx1PTA7dTxjLmxlbmd0aDt1Kyspe3ZhciBmPWNbdV0sbD10LiRvcHRpb25zLnByb3BzO3NbZl09S3QoZixsLGUsdCl9JHQoITApLHQuJG9wdGlvbnMucHJvcHNEYXRhPWV9cj1yfHxuO3ZhciBwPXQuJG9wdGlvbnMuX3BhcmVudExpc3RlbmVyczt0LiRvcHRpb25zLl9wYXJlbnRMaXN0ZW5lcnM9cixQZSh0LHIscCksYSYmKHQuJHNsb3RzPU5lKGksby5jb250ZXh0KSx0LiRmb3JjZVVwZGF0ZSgpKX1mdW5jdGlvbiBIZSh0KXt3aGlsZSh0JiYodD10LiRwYXJlbnQpKWlmKHQuX2luYWN0aXZlKXJldHVybiEwO3JldHVybiExfWZ1bmN0aW9uIHplKHQsZSl7aWYoZSl7aWYodC5fZGlyZWN0SW5hY3RpdmU9ITEsSGUodCkpcmV0dXJufWVsc2UgaWYodC5fZGlyZWN0SW5hY3RpdmUpcmV0dXJuO2lmKHQuX2luYWN0aXZlfHxudWxsPT09dC5faW5hY3RpdmUpe3QuX2luYWN0aXZlPSExO2Zvcih2YXIgbj0wO248dC4kY2hpbGRyZW4ubGVuZ3RoO24rKyl6ZSh0LiRjaGlsZHJlbltuXSk7S2UodCwiYWN0aXZhdGVkIil9fWZ1bmN0aW9uIFdlKHQsZSl7aWYoKCFlfHwodC5fZGlyZWN0SW5hY3RpdmU9ITAsIUhlKHQpKSkmJiF0Ll9pbmFjdGl2ZSl7dC5faW5hY3RpdmU9ITA7Zm9yKHZhciBuPTA7bjx0LiRjaGlsZHJlbi5sZW5ndGg7bisrKVdlKHQuJGNoaWxkcmVuW25dKTtLZSh0LCJkZWFjdGl2YXRlZCIpfX1mdW5jdGlvbiBLZSh0LGUpe3Z0KCk7dmFyIG49dC4kb3B0aW9uc1tlXTtpZihuKWZvcih2YXIgcj0wLG89bi5sZW5ndGg7cjxvO3IrKyl0cnl7bltyXS5jYWxsKHQpfWNhdGNoKG4pe1p0KG4sdCxlKyIgaG9vayIpfXQuX2hhc0hvb2tFdmVudCYmdC4kZW1pdCgiaG9vazoiK2UpLGh0KCl9dmFyIHFlPVtdLFhlPVtdLEdlPXt9LEplPSExLFplPSExLFFlPTA7ZnVuY3Rpb24gWWUoKXtRZT1xZS5sZW5ndGg9WGUubGVuZ3RoPTAsR2U9e30sSmU9WmU9ITF9ZnVuY3Rpb24gdG4oKXt2YXIgdCxlO2ZvcihaZT0hMCxxZS5zb3J0KGZ1bmN0aW9uKHQsZSl7cmV0dXJuIHQuaWQtZS5pZH0pLFFlPTA7UWU8cWUubGVuZ3RoO1FlKyspdD1xZVtRZV0sZT10LmlkLEdlW2VdPW51bGwsdC5ydW4oKTt2YXIgbj1YZS5zbGljZSgpLHI9cWUuc2xpY2UoKTtZZSgpLHJuKG4pLGVuKHIpLGF0JiZCLmRldnRvb2xzJiZhdC5lbWl0KCJmbHVzaCIpfWZ1bmN0aW9uIGVuKHQpe3ZhciBlPXQubGVuZ3RoO3doaWxlKGUtLSl7dmFyIG49dFtlXSxyPW4udm07ci5fd2F0Y2hlcj09PW4mJnIuX2lzTW91bnRlZCYmS2UociwidXBkYXRlZCIpfX1mdW5jdGlvbiBubih0KXt0Ll9pbmFjdGl2ZT0hMSxYZS5wdXNoKHQpfWZ1bmN0aW9uIHJuKHQpe2Zvcih2YXIgZT0wO2U8dC5sZW5ndGg7ZSsrKXRbZV0uX2luYWN0aXZlPSEwLHplKHRbZV0sITApfWZ1bmN0aW9uIG9uKHQpe3ZhciBlPXQuaWQ7aWYobnVsbD09R2VbZV0pe2lmKEdlW2VdPSEwLFplKXt2YXIgbj1xZS5sZW5ndGgtMTt3aGlsZShuPlFlJiZxZVtuXS5pZD50LmlkKW4tLTtxZS5zcGxpY2UobisxLDAsdCl9ZWxzZSBxZS5wdXNoKHQpO0plfHwoSmU9ITAsZmUodG4pKX19dmFyIGFuPTAsc249ZnVuY3Rpb24odCxlLG4scixvKXt0aGlzLnZtPXQsbyYmKHQuX3dhdGNoZXI9dGhpcyksdC5fd2F0Y2hlcnMucHVzaCh0aGlzKSxyPyh0aGlzLmRlZXA9ISFyLmRlZXAsdGhpcy51c2VyPSEhci51c2VyLHRoaXMubGF6eT0hIXIubGF6eSx0aGlzLnN5bmM9ISFyLnN5bmMpOnRoaXMuZGVlcD10aGlzLnVzZXI9dGhpcy5sYXp5PXRoaXMuc3luYz0hMSx0aGlzLmNiPW4sdGhpcy5pZD0rK2FuLHRoaXMuYWN0aXZlPSEwLHRoaXMuZGlydHk9dGhpcy5sYXp5LHRoaXMuZGVwcz1bXSx0aGlzLm5ld0RlcHM9W10sdGhpcy5kZXBJZHM9bmV3IGN0LHRoaXMubmV3RGVwSWRzPW5ldyBjdCx0aGlzLmV4cHJlc3Npb249IiIsImZ1bmN0aW9uIj09PXR5cGVvZiBlP3RoaXMuZ2V0dGVyPWU6KHRoaXMuZ2V0dGVyPVcoZSksdGhpcy5nZXR0ZXJ8fCh0aGlzLmdldHRlcj1mdW5jdGlvbigpe30pKSx0aGlzLnZhbHVlPXRoaXMubGF6eT92b2lkIDA6dGhpcy5nZXQoKX07c24ucHJvdG90eXBlLmdldD1mdW5jdGlvbigpe3ZhciB0O3Z0KHRoaXMpO3ZhciBlPXRoaXMudm07dHJ5e3Q9dGhpcy5nZXR0ZXIuY2FsbChlLGUpfWNhdGNoKHQpe2lmKCF0aGlzLnVzZXIpdGhyb3cgdDtadCh0LGUsJ2dldHRlciBmb3Igd2F0Y2hlciAiJyt0aGlzLmV4cHJlc3Npb24rJyInKX1maW5hbGx5e3RoaXMuZGVlcCYmcGUodCksaHQoKSx0aGlzLmNsZWFudXBEZXBzKCl9cmV0dXJuIHR9LHNuLnByb3RvdHlwZS5hZGREZXA9ZnVuY3Rpb24odCl7dmFyIGU9dC5pZDt0aGlzLm5ld0RlcElkcy5oYXMoZSl8fCh0aGlzLm5ld0RlcElkcy5hZGQoZSksdGhpcy5uZXdEZXBzLnB1c2godCksdGhpcy5kZXBJZHMuaGFzKGUpfHx0LmFkZFN1Yih0aGlzKSl9LHNuLnByb3RvdHlwZS5jbGVhbnVwRGVwcz1mdW5jdGlvbigpe3ZhciB0PXRoaXMsZT10aGlzLmRlcHMubGVuZ3RoO3doaWxlKGUtLSl7dmFyIG49dC5kZXBzW2VdO3QubmV3RGVwSWRzLmhhcyhuLmlkKXx8bi5yZW1vdmVTdWIodCl9dmFyIHI9dGhpcy5kZXBJZHM7dGhpcy5kZXBJZHM9dGhpcy5uZXdEZXBJZHMsdGhpcy5uZXdEZXBJZHM9cix0aGlzLm5ld0RlcElkcy5jbGVhcigpLHI9dGhpcy5kZXBzLHRoaXMuZGVwcz10aGlzLm5ld0RlcHMsdGhpcy5uZXdEZXBzPXIsdGhpcy5uZXdEZXBzLmxlbmd0aD0wfSxzbi5wcm90b3R5cGUudXBkYXRlPWZ1bmN0aW9uKCl7dGhpcy5sYXp5P3RoaXMuZGlydHk9ITA6dGhpcy5zeW5jP3RoaXMucnVuKCk6b24odGhpcyl9LHNuLnByb3RvdHlwZS5ydW49ZnVuY3Rpb24oKXtpZih0aGlzLmFjdGl2ZSl7dmFyIHQ9dGhpcy5nZXQoKTtpZih0IT09dGhpcy52YWx1ZXx8Yyh0KXx8dGhpcy5kZWVwKXt2YXIgZT10aGlzLnZhbHVlO2lmKHRoaXMudmFsdWU9dCx0aGlzLnVzZXIpdHJ5e3RoaXMuY2IuY2FsbCh0aGlzLnZtLHQsZSl9Y2F0Y2godCl7WnQodCx0aGlzLnZtLCdjYWxsYmFjayBmb3Igd2F0Y2hlciAiJyt0aGlzLmV4cHJlc3Npb24rJyInKX1lbHNlIHRoaXMuY2IuY2FsbCh0aGlzLnZtLHQsZSl9fX0sc24ucHJvdG90eXBlLmV2YWx1YXRlPWZ1bmN0aW9uKCl7dGhpcy52YWx1ZT10aGlzLmdldCgpLHRoaXMuZGlydHk9ITF9LHNuLnByb3RvdHlwZS5kZXBlbmQ9ZnVuY3Rpb24oKXt2YXIgdD10aGlzLGU9dGhpcy5kZXBzLmxlbmd0aDt3aGlsZShlLS0pdC5kZXBzW2VdLmRlcGVuZCgpfSxzbi5wcm90b3R5cGUudGVhcmRvd249ZnVuY3Rpb24oKXt2YXIgdD10aGlzO2lmKHRoaXMuYWN0aXZlKXt0aGlzLnZtLl9pc0JlaW5nRGVzdHJveWVkfHx5KHRoaXMudm0uX3dhdGNoZXJzLHRoaXMpO3ZhciBlPXRoaXMuZGVwcy5sZW5ndGg7d2hpbGUoZS0tKXQuZGVwc1tlXS5yZW1vdmVTdWIodCk7dGhpcy5hY3RpdmU9ITF9fTt2YXIgY249e2VudW1lcmFibGU6ITAsY29uZmlndXJhYmxlOiEwLGdldDpJLHNldDpJfTtmdW5jdGlvbiB1bih0LGUsbil7Y24uZ2V0PWZ1bmN0aW9uKCl7cmV0dXJuIHRoaXNbZV1bbl19LGNuLnNldD1mdW5jdGlvbih0KXt0aGlzW2VdW25dPXR9LE9iamVjdC5kZWZpbmVQcm9wZXJ0eSh0LG4sY24pfWZ1bmN0aW9uIGZuKHQpe3QuX3dhdGNoZXJzPVtdO3ZhciBlPXQuJG9wdGlvbnM7ZS5wcm9wcyYmbG4odCxlLnByb3BzKSxlLm1ldGhvZHMmJl9uKHQsZS5tZXRob2RzKSxlLmRhdGE/cG4odCk6RXQodC5fZGF0YT17fSwhMCksZS5jb21wdXRlZCYmaG4odCxlLmNvbXB1dGVkKSxlLndhdGNoJiZlLndhdGNoIT09bnQmJmduKHQsZS53YXRjaCl9ZnVuY3Rpb24gbG4odCxlKXt2YXIgbj10LiRvcHRpb25zLnByb3BzRGF0YXx8e30scj10Ll9wcm9wcz17fSxvPXQuJG9wdGlvbnMuX3Byb3BLZXlzPVtdLGk9IXQuJHBhcmVudDtpfHwkdCghMSk7dmFyIGE9ZnVuY3Rpb24oaSl7by5wdXNoKGkpO3ZhciBhPUt0KGksZSxuLHQpO1R0KHIsaSxhKSxpIGluIHR8fHVuKHQsIl9wcm9wcyIsaSl9O2Zvcih2YXIgcyBpbiBlKWEocyk7JHQoITApfWZ1bmN0aW9uIHBuKHQpe3ZhciBlPXQuJG9wdGlvbnMuZGF0YTtlPXQuX2RhdGE9ImZ1bmN0aW9uIj09PXR5cGVvZiBlP2RuKGUsdCk6ZXx8e30sZihlKXx8KGU9e30pO3ZhciBuPU9iamVjdC5rZXlzKGUpLHI9dC4kb3B0aW9ucy5wcm9wcyxvPSh0LiRvcHRpb25zLm1ldGhvZHMsbi5sZW5ndGgpO3doaWxlKG8tLSl7dmFyIGk9bltvXTswLHImJmcocixpKXx8VihpKXx8dW4odCwiX2RhdGEiLGkpfUV0KGUsITApfWZ1bmN0aW9uIGRuKHQsZSl7dnQoKTt0cnl7cmV0dXJuIHQuY2FsbChlLGUpfWNhdGNoKHQpe3JldHVybiBadCh0LGUsImRhdGEoKSIpLHt9fWZpbmFsbHl7aHQoKX19dmFyIHZuPXtsYXp5OiEwfTtmdW5jdGlvbiBobih0LGUpe3ZhciBuPXQuX2NvbXB1dGVkV2F0Y2hlcnM9T2JqZWN0LmNyZWF0ZShudWxsKSxyPWl0KCk7Zm9yKHZhciBvIGluIGUpe3ZhciBpPWVbb10sYT0iZnVuY3Rpb24iPT09dHlwZW9mIGk/aTppLmdldDswLHJ8fChuW29dPW5ldyBzbih0LGF8fEksSSx2bikpLG8gaW4gdHx8bW4odCxvLGkpfX1mdW5jdGlvbiBtbih0LGUsbil7dmFyIHI9IWl0KCk7ImZ1bmN0aW9uIj09PXR5cGVvZiBuPyhjbi5nZXQ9cj95bihlKTpuLGNuLnNldD1JKTooY24uZ2V0PW4uZ2V0P3ImJiExIT09bi5jYWNoZT95bihlKTpuLmdldDpJLGNuLnNldD1uLnNldD9uLnNldDpJKSxPYmplY3QuZGVmaW5lUHJvcGVydHkodCxlLGNuKX1mdW5jdGlvbiB5bih0KXtyZXR1cm4gZnVuY3Rpb24oKXt2YXIgZT10aGlzLl9jb21wdXRlZFdhdGNoZXJzJiZ0aGlzLl9jb21wdXRlZFdhdGNoZXJzW3RdO2lmKGUpcmV0dXJuIGUuZGlydHkmJmUuZXZhbHVhdGUoKSxwdC50YXJnZXQmJmUuZGVwZW5kKCksZS52YWx1ZX19ZnVuY3Rpb24gX24odCxlKXt0LiRvcHRpb25zLnByb3BzO2Zvcih2YXIgbiBpbiBlKXRbbl09bnVsbD09ZVtuXT9JOlMoZVtuXSx0KX1mdW5jdGlvbiBnbih0LGUpe2Zvcih2YXIgbiBpbiBlKXt2YXIgcj1lW25dO2lmKEFycmF5LmlzQXJyYXkocikpZm9yKHZhciBvPTA7bzxyLmxlbmd0aDtvKyspYm4odCxuLHJbb10pO2Vsc2UgYm4odCxuLHIpfX1mdW5jdGlvbiBibih0LGUsbixyKXtyZXR1cm4gZihuKSYmKHI9bixuPW4uaGFuZGxlciksInN0cmluZyI9PT10eXBlb2YgbiYmKG49dFtuXSksdC4kd2F0Y2goZSxuLHIpfWZ1bmN0aW9uIHduKHQpe3ZhciBlPXtnZXQ6ZnVuY3Rpb24oKXtyZXR1cm4gdGhpcy5fZGF0YX19LG49e2dldDpmdW5jdGlvbigpe3JldHVybiB0aGlzLl9wcm9wc319O09iamVjdC5kZWZpbmVQcm9wZXJ0eSh0LnByb3RvdHlwZSwiJGRhdGEiLGUpLE9iamVjdC5kZWZpbmVQcm9wZXJ0eSh0LnByb3RvdHlwZSwiJHByb3BzIixuKSx0LnByb3RvdHlwZS4kc2V0PUl0LHQucHJvdG90eXBlLiRkZWxldGU9UHQsdC5wcm90b3R5cGUuJHdhdGNoPWZ1bmN0aW9uKHQsZSxuKXt2YXIgcj10aGlzO2lmKGYoZSkpcmV0dXJuIGJuKHIsdCxlLG4pO249bnx8e30sbi51c2VyPSEwO3ZhciBvPW5ldyBzbihyLHQsZSxuKTtyZXR1cm4gbi5pbW1lZGlhdGUmJmUuY2FsbChyLG8udmFsdWUpLGZ1bmN0aW9uKCl7by50ZWFyZG93bigpfX19ZnVuY3Rpb24geG4odCl7dmFyIGU9dC4kb3B0aW9ucy5wcm92aWRlO2UmJih0Ll9wcm92aWRlZD0iZnVuY3Rpb24iPT09dHlwZW9mIGU/ZS5jYWxsKHQpOmUpfWZ1bmN0aW9uIENuKHQpe3ZhciBlPUFuKHQuJG9wdGlvbnMuaW5qZWN0LHQpO2UmJigkdCghMSksT2JqZWN0LmtleXMoZSkuZm9yRWFjaChmdW5jdGlvbihuKXtUdCh0LG4sZVtuXSl9KSwkdCghMCkpfWZ1bmN0aW9uIEFuKHQsZSl7aWYodCl7Zm9yKHZhciBuPU9iamVjdC5jcmVhdGUobnVsbCkscj11dD9SZWZsZWN0Lm93bktleXModCkuZmlsdGVyKGZ1bmN0aW9uKGUpe3JldHVybiBPYmplY3QuZ2V0T3duUHJvcGVydHlEZXNjcmlwdG9yKHQsZSkuZW51bWVyYWJsZX0pOk9iamVjdC5rZXlzKHQpLG89MDtvPHIubGVuZ3RoO28rKyl7dmFyIGk9cltvXSxhPXRbaV0uZnJvbSxzPWU7d2hpbGUocyl7aWYocy5fcHJvdmlkZWQmJmcocy5fcHJvdmlkZWQsYSkpe25baV09cy5fcHJvdmlkZWRbYV07YnJlYWt9cz1zLiRwYXJlbnR9aWYoIXMpaWYoImRlZmF1bHQiaW4gdFtpXSl7dmFyIGM9dFtpXS5kZWZhdWx0O25baV09ImZ1bmN0aW9uIj09PXR5cGVvZiBjP2MuY2FsbChlKTpjfWVsc2UgMH1yZXR1cm4gbn19ZnVuY3Rpb24gT24odCxlKXt2YXIgbixyLGksYSxzO2lmKEFycmF5LmlzQXJyYXkodCl8fCJzdHJpbmciPT09dHlwZW9mIHQpZm9yKG49bmV3IEFycmF5KHQubGVuZ3RoKSxyPTAsaT10Lmxlbmd0aDtyPGk7cisrKW5bcl09ZSh0W3JdLHIpO2Vsc2UgaWYoIm51bWJlciI9PT10eXBlb2YgdClmb3Iobj1uZXcgQXJyYXkodCkscj0wO3I8dDtyKyspbltyXT1lKHIrMSxyKTtlbHNlIGlmKGModCkpZm9yKGE9T2JqZWN0LmtleXModCksbj1uZXcgQXJyYXkoYS5sZW5ndGgpLHI9MCxpPWEubGVuZ3RoO3I8aTtyKyspcz1hW3JdLG5bcl09ZSh0W3NdLHMscik7cmV0dXJuIG8obikmJihuLl9pc1ZMaXN0PSEwKSxufWZ1bmN0aW9uICRuKHQsZSxuLHIpe3ZhciBvLGk9dGhpcy4kc2NvcGVkU2xvdHNbdF07aWYoaSluPW58fHt9LHImJihuPUUoRSh7fSxyKSxuKSksbz1pKG4pfHxlO2Vsc2V7dmFyIGE9dGhpcy4kc2xvdHNbdF07YSYmKGEuX3JlbmRlcmVkPSEwKSxvPWF8fGV9dmFyIHM9biYmbi5zbG90O3JldHVybiBzP3RoaXMuJGNyZWF0ZUVsZW1lbnQoInRlbXBsYXRlIix7c2xvdDpzfSxvKTpvfWZ1bmN0aW9uIGtuKHQpe3JldHVybiBXdCh0aGlzLiRvcHRpb25zLCJmaWx0ZXJzIix0LCEwKXx8TX1mdW5jdGlvbiBTbih0LGUpe3JldHVybiBBcnJheS5pc0FycmF5KHQpPy0xPT09dC5pbmRleE9mKGUpOnQhPT1lfWZ1bmN0aW9uIGpuKHQsZSxuLHIsbyl7dmFyIGk9Qi5rZXlDb2Rlc1tlXXx8bjtyZXR1cm4gbyYmciYmIUIua2V5Q29kZXNbZV0/U24obyxyKTppP1NuKGksdCk6cj9PKHIpIT09ZTp2b2lkIDB9ZnVuY3Rpb24gRW4odCxlLG4scixvKXtpZihuKWlmKGMobikpe3ZhciBpO0FycmF5LmlzQXJyYXkobikmJihuPVQobikpO3ZhciBhPWZ1bmN0aW9uKGEpe2lmKCJjbGFzcyI9PT1hfHwic3R5bGUiPT09YXx8bShhKSlpPXQ7ZWxzZXt2YXIgcz10LmF0dHJzJiZ0LmF0dHJzLnR5cGU7aT1yfHxCLm11c3RVc2VQcm9wKGUscyxhKT90LmRvbVByb3BzfHwodC5kb21Qcm9wcz17fSk6dC5hdHRyc3x8KHQuYXR0cnM9e30pfWlmKCEoYSBpbiBpKSYmKGlbYV09blthXSxvKSl7dmFyIGM9dC5vbnx8KHQub249e30pO2NbInVwZGF0ZToiK2FdPWZ1bmN0aW9uKHQpe25bYV09dH19fTtmb3IodmFyIHMgaW4gbilhKHMpfWVsc2U7cmV0dXJuIHR9ZnVuY3Rpb24gVG4odCxlKXt2YXIgbj10aGlzLl9zdGF0aWNUcmVlc3x8KHRoaXMuX3N0YXRpY1RyZWVzPVtdKSxyPW5bdF07cmV0dXJuIHImJiFlP3I6KHI9blt0XT10aGlzLiRvcHRpb25zLnN0YXRpY1JlbmRlckZuc1t0XS5jYWxsKHRoaXMuX3JlbmRlclByb3h5LG51bGwsdGhpcyksUG4ociwiX19zdGF0aWNfXyIrdCwhMSkscil9ZnVuY3Rpb24gSW4odCxlLG4pe3JldHVybiBQbih0LCJfX29uY2VfXyIrZSsobj8iXyIrbjoiIiksITApLHR9ZnVuY3Rpb24gUG4odCxlLG4pe2lmKEFycmF5LmlzQXJyYXkodCkpZm9yKHZhciByPTA7cjx0Lmxlbmd0aDtyKyspdFtyXSYmInN0cmluZyIhPT10eXBlb2YgdFtyXSYmTW4odFtyXSxlKyJfIityLG4pO2Vsc2UgTW4odCxlLG4pfWZ1bmN0aW9uIE1uKHQsZSxuKXt0LmlzU3RhdGljPSEwLHQua2V5PWUsdC5pc09uY2U9bn1mdW5jdGlvbiBObih0LGUpe2lmKGUpaWYoZihlKSl7dmFyIG49dC5vbj10Lm9uP0Uoe30sdC5vbik6e307Zm9yKHZhciByIGluIGUpe3ZhciBvPW5bcl0saT1lW3JdO25bcl09bz9bXS5jb25jYXQobyxpKTppfX1lbHNlO3JldHVybiB0fWZ1bmN0aW9uIERuKHQpe3QuX289SW4sdC5fbj12LHQuX3M9ZCx0Ll9sPU9uLHQuX3Q9JG4sdC5fcT1OLHQuX2k9RCx0Ll9tPVRuLHQuX2Y9a24sdC5faz1qbix0Ll9iPUVuLHQuX3Y9Z3QsdC5fZT1fdCx0Ll91PUxlLHQuX2c9Tm59ZnVuY3Rpb24gTG4odCxlLHIsbyxhKXt2YXIgcyxjPWEub3B0aW9ucztnKG8sIl91aWQiKT8ocz1PYmplY3QuY3JlYXRlKG8pLHMuX29yaWdpbmFsPW8pOihzPW8sbz1vLl9vcmlnaW5hbCk7dmFyIHU9aShjLl9jb21waWxlZCksZj0hdTt0aGlzLmRhdGE9dCx0aGlzLnByb3BzPWUsdGhpcy5jaGlsZHJlbj1yLHRoaXMucGFyZW50PW8sdGhpcy5saXN0ZW5lcnM9dC5vbnx8bix0aGlzLmluamVjdGlvbnM9QW4oYy5pbmplY3QsbyksdGhpcy5zbG90cz1mdW5jdGlvbigpe3JldHVybiBOZShyLG8pfSx1JiYodGhpcy4kb3B0aW9ucz1jLHRoaXMuJHNsb3RzPXRoaXMuc2xvdHMoKSx0aGlzLiRzY29wZWRTbG90cz10LnNjb3BlZFNsb3RzfHxuKSxjLl9zY29wZUlkP3RoaXMuX2M9ZnVuY3Rpb24odCxlLG4scil7dmFyIGk9R24ocyx0LGUsbixyLGYpO3JldHVybiBpJiYhQXJyYXkuaXNBcnJheShpKSYmKGkuZm5TY29wZUlkPWMuX3Njb3BlSWQsaS5mbkNvbnRleHQ9byksaX06dGhpcy5fYz1mdW5jdGlvbih0LGUsbixyKXtyZXR1cm4gR24ocyx0LGUsbixyLGYpfX1mdW5jdGlvbiBGbih0LGUscixpLGEpe3ZhciBzPXQub3B0aW9ucyxjPXt9LHU9cy5wcm9wcztpZihvKHUpKWZvcih2YXIgZiBpbiB1KWNbZl09S3QoZix1LGV8fG4pO2Vsc2UgbyhyLmF0dHJzKSYmVW4oYyxyLmF0dHJzKSxvKHIucHJvcHMpJiZVbihjLHIucHJvcHMpO3ZhciBsPW5ldyBMbihyLGMsYSxpLHQpLHA9cy5yZW5kZXIuY2FsbChudWxsLGwuX2MsbCk7aWYocCBpbnN0YW5jZW9mIG10KXJldHVybiBSbihwLHIsbC5wYXJlbnQscyk7aWYoQXJyYXkuaXNBcnJheShwKSl7Zm9yKHZhciBkPXhlKHApfHxbXSx2PW5ldyBBcnJheShkLmxlbmd0aCksaD0wO2g8ZC5sZW5ndGg7aCsrKXZbaF09Um4oZFtoXSxyLGwucGFyZW50LHMpO3JldHVybiB2fX1mdW5jdGlvbiBSbih0LGUsbixyKXt2YXIgbz1idCh0KTtyZXR1cm4gby5mbkNvbnRleHQ9bixvLmZuT3B0aW9ucz1yLGUuc2xvdCYmKChvLmRhdGF8fChvLmRhdGE9e30pKS5zbG90PWUuc2xvdCksb31mdW5jdGlvbiBVbih0LGUpe2Zvcih2YXIgbiBpbiBlKXRbeChuKV09ZVtuXX1EbihMbi5wcm90b3R5cGUpO3ZhciBCbj17aW5pdDpmdW5jdGlvbih0LGUsbixyKXtpZih0LmNvbXBvbmVudEluc3RhbmNlJiYhdC5jb21wb25lbnRJbnN0YW5jZS5faXNEZXN0cm95ZWQmJnQuZGF0YS5rZWVwQWxpdmUpe3ZhciBvPXQ7Qm4ucHJlcGF0Y2gobyxvKX1lbHNle3ZhciBpPXQuY29tcG9uZW50SW5zdGFuY2U9em4odCxGZSxuLHIpO2kuJG1vdW50KGU/dC5lbG06dm9pZCAwLGUpfX0scHJlcGF0Y2g6ZnVuY3Rpb24odCxlKXt2YXIgbj1lLmNvbXBvbmVudE9wdGlvbnMscj1lLmNvbXBvbmVudEluc3RhbmNlPXQuY29tcG9uZW50SW5zdGFuY2U7VmUocixuLnByb3BzRGF0YSxuLmxpc3RlbmVycyxlLG4uY2hpbGRyZW4pfSxpbnNlcnQ6ZnVuY3Rpb24odCl7dmFyIGU9dC5jb250ZXh0LG49dC5jb21wb25lbnRJbnN0YW5jZTtuLl9pc01vdW50ZWR8fChuLl9pc01vdW50ZWQ9ITAsS2UobiwibW91bnRlZCIpKSx0LmRhdGEua2VlcEFsaXZlJiYoZS5faXNNb3VudGVkP25uKG4pOnplKG4sITApKX0sZGVzdHJveTpmdW5jdGlvbih0KXt2YXIgZT10LmNvbXBvbmVudEluc3RhbmNlO2UuX2lzRGVzdHJveWVkfHwodC5kYXRhLmtlZXBBbGl2ZT9XZShlLCEwKTplLiRkZXN0cm95KCkpfX0sVm49T2JqZWN0LmtleXMoQm4pO2Z1bmN0aW9uIEhuKHQsZSxuLGEscyl7aWYoIXIodCkpe3ZhciB1PW4uJG9wdGlvbnMuX2Jhc2U7aWYoYyh0KSYmKHQ9dS5leHRlbmQodCkpLCJmdW5jdGlvbiI9PT10eXBlb2YgdCl7dmFyIGY7aWYocih0LmNpZCkmJihmPXQsdD1rZShmLHUsbiksdm9pZCAwPT09dCkpcmV0dXJuICRlKGYsZSxuLGEscyk7ZT1lfHx7fSxvcih0KSxvKGUubW9kZWwpJiZLbih0Lm9wdGlvbnMsZSk7dmFyIGw9Z2UoZSx0LHMpO2lmKGkodC5vcHRpb25zLmZ1bmN0aW9uYWwpKXJldHVybiBGbih0LGwsZSxuLGEpO3ZhciBwPWUub247aWYoZS5vbj1lLm5hdGl2ZU9uLGkodC5vcHRpb25zLmFic3RyYWN0KSl7dmFyIGQ9ZS5zbG90O2U9e30sZCYmKGUuc2xvdD1kKX1XbihlKTt2YXIgdj10Lm9wdGlvbnMubmFtZXx8cyxoPW5ldyBtdCgidnVlLWNvbXBvbmVudC0iK3QuY2lkKyh2PyItIit2OiIiKSxlLHZvaWQgMCx2b2lkIDAsdm9pZCAwLG4se0N0b3I6dCxwcm9wc0RhdGE6bCxsaXN0ZW5lcnM6cCx0YWc6cyxjaGlsZHJlbjphfSxmKTtyZXR1cm4gaH19fWZ1bmN0aW9uIHpuKHQsZSxuLHIpe3ZhciBpPXtfaXNDb21wb25lbnQ6ITAscGFyZW50OmUsX3BhcmVudFZub2RlOnQsX3BhcmVudEVsbTpufHxudWxsLF9yZWZFbG06cnx8bnVsbH0sYT10LmRhdGEuaW5saW5lVGVtcGxhdGU7cmV0dXJuIG8oYSkmJihpLnJlbmRlcj1hLnJlbmRlcixpLnN0YXRpY1JlbmRlckZucz1hLnN0YXRpY1JlbmRlckZucyksbmV3IHQuY29tcG9uZW50T3B0aW9ucy5DdG9yKGkpfWZ1bmN0aW9uIFduKHQpe2Zvcih2YXIgZT10Lmhvb2t8fCh0Lmhvb2s9e30pLG49MDtuPFZuLmxlbmd0aDtuKyspe3ZhciByPVZuW25dO2Vbcl09Qm5bcl19fWZ1bmN0aW9uIEtuKHQsZSl7dmFyIG49dC5tb2RlbCYmdC5tb2RlbC5wcm9wfHwidmFsdWUiLHI9dC5tb2RlbCYmdC5tb2RlbC5ldmVudHx8ImlucHV0IjsoZS5wcm9wc3x8KGUucHJvcHM9e30pKVtuXT1lLm1vZGVsLnZhbHVlO3ZhciBpPWUub258fChlLm9uPXt9KTtvKGlbcl0pP2lbcl09W2UubW9kZWwuY2FsbGJhY2tdLmNvbmNhdChpW3JdKTppW3JdPWUubW9kZWwuY2FsbGJhY2t9dmFyIHFuPTEsWG49MjtmdW5jdGlvbiBHbih0LGUsbixyLG8sYSl7cmV0dXJuKEFycmF5LmlzQXJyYXkobil8fHMobikpJiYobz1yLHI9bixuPXZvaWQgMCksaShhKSYmKG89WG4pLEpuKHQsZSxuLHIsbyl9ZnVuY3Rpb24gSm4odCxlLG4scixpKXtpZihvKG4pJiZvKG4uX19vYl9fKSlyZXR1cm4gX3QoKTtpZihvKG4pJiZvKG4uaXMpJiYoZT1uLmlzKSwhZSlyZXR1cm4gX3QoKTt2YXIgYSxzLGM7KEFycmF5LmlzQXJyYXkocikmJiJmdW5jdGlvbiI9PT10eXBlb2YgclswXSYmKG49bnx8e30sbi5zY29wZWRTbG90cz17ZGVmYXVsdDpyWzBdfSxyLmxlbmd0aD0wKSxpPT09WG4/cj14ZShyKTppPT09cW4mJihyPXdlKHIpKSwic3RyaW5nIj09PXR5cGVvZiBlKT8ocz10LiR2bm9kZSYmdC4kdm5vZGUubnN8fEIuZ2V0VGFnTmFtZXNwYWNlKGUpLGE9Qi5pc1Jlc2VydmVkVGFnKGUpP25ldyBtdChCLnBhcnNlUGxhdGZvcm1UYWdOYW1lKGUpLG4scix2b2lkIDAsdm9pZCAwLHQpOm8oYz1XdCh0LiRvcHRpb25zLCJjb21wb25lbnRzIixlKSk/SG4oYyxuLHQscixlKTpuZXcgbXQoZSxuLHIsdm9pZCAwLHZvaWQgMCx0KSk6YT1IbihlLG4sdCxyKTtyZXR1cm4gQXJyYXkuaXNBcnJheShhKT9hOm8oYSk/KG8ocykmJlpuKGEscyksbyhuKSYmUW4obiksYSk6X3QoKX1mdW5jdGlvbiBabih0LGUsbil7aWYodC5ucz1lLCJmb3JlaWduT2JqZWN0Ij09PXQudGFnJiYoZT12b2lkIDAsbj0hMCksbyh0LmNoaWxkcmVuKSlmb3IodmFyIGE9MCxzPXQuY2hpbGRyZW4ubGVuZ3RoO2E8czthKyspe3ZhciBjPXQuY2hpbGRyZW5bYV07byhjLnRhZykmJihyKGMubnMpfHxpKG4pJiYic3ZnIiE9PWMudGFnKSYmWm4oYyxlLG4pfX1mdW5jdGlvbiBRbih0KXtjKHQuc3R5bGUpJiZwZSh0LnN0eWxlKSxjKHQuY2xhc3MpJiZwZSh0LmNsYXNzKX1mdW5jdGlvbiBZbih0KXt0Ll92bm9kZT1udWxsLHQuX3N0YXRpY1RyZWVzPW51bGw7dmFyIGU9dC4kb3B0aW9ucyxyPXQuJHZub2RlPWUuX3BhcmVudFZub2RlLG89ciYmci5jb250ZXh0O3QuJHNsb3RzPU5lKGUuX3JlbmRlckNoaWxkcmVuLG8pLHQuJHNjb3BlZFNsb3RzPW4sdC5fYz1mdW5jdGlvbihlLG4scixvKXtyZXR1cm4gR24odCxlLG4scixvLCExKX0sdC4kY3JlYXRlRWxlbWVudD1mdW5jdGlvbihlLG4scixvKXtyZXR1cm4gR24odCxlLG4scixvLCEwKX07dmFyIGk9ciYmci5kYXRhO1R0KHQsIiRhdHRycyIsaSYmaS5hdHRyc3x8bixudWxsLCEwKSxUdCh0LCIkbGlzdGVuZXJzIixlLl9wYXJlbnRMaXN0ZW5lcnN8fG4sbnVsbCwhMCl9ZnVuY3Rpb24gdHIodCl7RG4odC5wcm90b3R5cGUpLHQucHJvdG90eXBlLiRuZXh0VGljaz1mdW5jdGlvbih0KXtyZXR1cm4gZmUodCx0aGlzKX0sdC5wcm90b3R5cGUuX3JlbmRlcj1mdW5jdGlvbigpe3ZhciB0LGU9dGhpcyxyPWUuJG9wdGlvbnMsbz1yLnJlbmRlcixpPXIuX3BhcmVudFZub2RlO2kmJihlLiRzY29wZWRTbG90cz1pLmRhdGEuc2NvcGVkU2xvdHN8fG4pLGUuJHZub2RlPWk7dHJ5e3Q9by5jYWxsKGUuX3JlbmRlclByb3h5LGUuJGNyZWF0ZUVsZW1lbnQpfWNhdGNoKG4pe1p0KG4sZSwicmVuZGVyIiksdD1lLl92bm9kZX1yZXR1cm4gdCBpbnN0YW5jZW9mIG10fHwodD1fdCgpKSx0LnBhcmVudD1pLHR9fXZhciBlcj0wO2Z1bmN0aW9uIG5yKHQpe3QucHJvdG90eXBlLl9pbml0PWZ1bmN0aW9uKHQpe3ZhciBlPXRoaXM7ZS5fdWlkPWVyKyssZS5faXNWdWU9ITAsdCYmdC5faXNDb21wb25lbnQ/cnIoZSx0KTplLiRvcHRpb25zPXp0KG9yKGUuY29uc3RydWN0b3IpLHR8fHt9LGUpLGUuX3JlbmRlclByb3h5PWUsZS5fc2VsZj1lLFJlKGUpLEVlKGUpLFluKGUpLEtlKGUsImJlZm9yZUNyZWF0ZSIpLENuKGUpLGZuKGUpLHhuKGUpLEtlKGUsImNyZWF0ZWQiKSxlLiRvcHRpb25zLmVsJiZlLiRtb3VudChlLiRvcHRpb25zLmVsKX19ZnVuY3Rpb24gcnIodCxlKXt2YXIgbj10LiRvcHRpb25zPU9iamVjdC5jcmVhdGUodC5jb25zdHJ1Y3Rvci5vcHRpb25zKSxyPWUuX3BhcmVudFZub2RlO24ucGFyZW50PWUucGFyZW50LG4uX3BhcmVudFZub2RlPXIsbi5fcGFyZW50RWxtPWUuX3BhcmVudEVsbSxuLl9yZWZFbG09ZS5fcmVmRWxtO3ZhciBvPXIuY29tcG9uZW50T3B0aW9ucztuLnByb3BzRGF0YT1vLnByb3BzRGF0YSxuLl9wYXJlbnRMaXN0ZW5lcnM9by5saXN0ZW5lcnMsbi5fcmVuZGVyQ2hpbGRyZW49by5jaGlsZHJlbixuLl9jb21wb25lbnRUYWc9by50YWcsZS5yZW5kZXImJihuLnJlbmRlcj1lLnJlbmRlcixuLnN0YXRpY1JlbmRlckZucz1lLnN0YXRpY1JlbmRlckZucyl9ZnVuY3Rpb24gb3IodCl7dmFyIGU9dC5vcHRpb25zO2lmKHQuc3VwZXIpe3ZhciBuPW9yKHQuc3VwZXIpLHI9dC5zdXBlck9wdGlvbnM7aWYobiE9PXIpe3Quc3VwZXJPcHRpb25zPW47dmFyIG89aXIodCk7byYmRSh0LmV4dGVuZE9wdGlvbnMsbyksZT10Lm9wdGlvbnM9enQobix0LmV4dGVuZE9wdGlvbnMpLGUubmFtZSYmKGUuY29tcG9uZW50c1tlLm5hbWVdPXQpfX1yZXR1cm4gZX1mdW5jdGlvbiBpcih0KXt2YXIgZSxuPXQub3B0aW9ucyxyPXQuZXh0ZW5kT3B0aW9ucyxvPXQuc2VhbGVkT3B0aW9ucztmb3IodmFyIGkgaW4gbiluW2ldIT09b1tpXSYmKGV8fChlPXt9KSxlW2ldPWFyKG5baV0scltpXSxvW2ldKSk7cmV0dXJuIGV9ZnVuY3Rpb24gYXIodCxlLG4pe2lmKEFycmF5LmlzQXJyYXkodCkpe3ZhciByPVtdO249QXJyYXkuaXNBcnJheShuKT9uOltuXSxlPUFycmF5LmlzQXJyYXkoZSk/ZTpbZV07Zm9yKHZhciBvPTA7bzx0Lmxlbmd0aDtvKyspKGUuaW5kZXhPZih0W29dKT49MHx8bi5pbmRleE9mKHRbb10pPDApJiZyLnB1c2godFtvXSk7cmV0dXJuIHJ9cmV0dXJuIHR9ZnVuY3Rpb24gc3IodCl7dGhpcy5faW5pdCh0KX1mdW5jdGlvbiBjcih0KXt0LnVzZT1mdW5jdGlvbih0KXt2YXIgZT10aGlzLl9pbnN0YWxsZWRQbHVnaW5zfHwodGhpcy5faW5zdGFsbGVkUGx1Z2lucz1bXSk7aWYoZS5pbmRleE9mKHQpPi0xKXJldHVybiB0aGlzO3ZhciBuPWooYXJndW1lbnRzLDEpO3JldHVybiBuLnVuc2hpZnQodGhpcyksImZ1bmN0aW9uIj09PXR5cGVvZiB0Lmluc3RhbGw/dC5pbnN0YWxsLmFwcGx5KHQsbik6ImZ1bmN0aW9uIj09PXR5cGVvZiB0JiZ0LmFwcGx5KG51bGwsbiksZS5wdXNoKHQpLHRoaXN9fWZ1bmN0aW9uIHVyKHQpe3QubWl4aW49ZnVuY3Rpb24odCl7cmV0dXJuIHRoaXMub3B0aW9ucz16dCh0aGlzLm9wdGlvbnMsdCksdGhpc319ZnVuY3Rpb24gZnIodCl7dC5jaWQ9MDt2YXIgZT0xO3QuZXh0ZW5kPWZ1bmN0aW9uKHQpe3Q9dHx8e307dmFyIG49dGhpcyxyPW4uY2lkLG89dC5fQ3Rvcnx8KHQuX0N0b3I9e30pO2lmKG9bcl0pcmV0dXJuIG9bcl07dmFyIGk9dC5uYW1lfHxuLm9wdGlvbnMubmFtZTt2YXIgYT1mdW5jdGlvbih0KXt0aGlzLl9pbml0KHQpfTtyZXR1cm4gYS5wcm90b3R5cGU9T2JqZWN0LmNyZWF0ZShuLnByb3RvdHlwZSksYS5wcm90b3R5cGUuY29uc3RydWN0b3I9YSxhLmNpZD1lKyssYS5vcHRpb25zPXp0KG4ub3B0aW9ucyx0KSxhWyJzdXBlciJdPW4sYS5vcHRpb25zLnByb3BzJiZscihhKSxhLm9wdGlvbnMuY29tcHV0ZWQmJnByKGEpLGEuZXh0ZW5kPW4uZXh0ZW5kLGEubWl4aW49bi5taXhpbixhLnVzZT1uLnVzZSxSLmZvckVhY2goZnVuY3Rpb24odCl7YVt0XT1uW3RdfSksaSYmKGEub3B0aW9ucy5jb21wb25lbnRzW2ldPWEpLGEuc3VwZXJPcHRpb25zPW4ub3B0aW9ucyxhLmV4dGVuZE9wdGlvbnM9dCxhLnNlYWxlZE9wdGlvbnM9RSh7fSxhLm9wdGlvbnMpLG9bcl09YSxhfX1mdW5jdGlvbiBscih0KXt2YXIgZT10Lm9wdGlvbnMucHJvcHM7Zm9yKHZhciBuIGluIGUpdW4odC5wcm90b3R5cGUsIl9wcm9wcyIsbil9ZnVuY3Rpb24gcHIodCl7dmFyIGU9dC5vcHRpb25zLmNvbXB1dGVkO2Zvcih2YXIgbiBpbiBlKW1uKHQucHJvdG90eXBlLG4sZVtuXSl9ZnVuY3Rpb24gZHIodCl7Ui5mb3JFYWNoKGZ1bmN0aW9uKGUpe3RbZV09ZnVuY3Rpb24odCxuKXtyZXR1cm4gbj8oImNvbXBvbmVudCI9PT1lJiZmKG4pJiYobi5uYW1lPW4ubmFtZXx8dCxuPXRoaXMub3B0aW9ucy5fYmFzZS5leHRlbmQobikpLCJkaXJlY3RpdmUiPT09ZSYmImZ1bmN0aW9uIj09PXR5cGVvZiBuJiYobj17YmluZDpuLHVwZGF0ZTpufSksdGhpcy5vcHRpb25zW2UrInMiXVt0XT1uLG4pOnRoaXMub3B0aW9uc1tlKyJzIl1bdF19fSl9ZnVuY3Rpb24gdnIodCl7cmV0dXJuIHQmJih0LkN0b3Iub3B0aW9ucy5uYW1lfHx0LnRhZyl9ZnVuY3Rpb24gaHIodCxlKXtyZXR1cm4gQXJyYXkuaXNBcnJheSh0KT90LmluZGV4T2YoZSk+LTE6InN0cmluZyI9PT10eXBlb2YgdD90LnNwbGl0KCIsIikuaW5kZXhPZihlKT4tMTohIWwodCkmJnQudGVzdChlKX1mdW5jdGlvbiBtcih0LGUpe3ZhciBuPXQuY2FjaGUscj10LmtleXMsbz10Ll92bm9kZTtmb3IodmFyIGkgaW4gbil7dmFyIGE9bltpXTtpZihhKXt2YXIgcz12cihhLmNvbXBvbmVudE9wdGlvbnMpO3MmJiFlKHMpJiZ5cihuLGkscixvKX19fWZ1bmN0aW9uIHlyKHQsZSxuLHIpe3ZhciBvPXRbZV07IW98fHImJm8udGFnPT09ci50YWd8fG8uY29tcG9uZW50SW5zdGFuY2UuJGRlc3Ryb3koKSx0W2VdPW51bGwseShuLGUpfW5yKHNyKSx3bihzciksTWUoc3IpLFVlKHNyKSx0cihzcik7dmFyIF9yPVtTdHJpbmcsUmVnRXhwLEFycmF5XSxncj17bmFtZToia2VlcC1hbGl2ZSIsYWJzdHJhY3Q6ITAscHJvcHM6e2luY2x1ZGU6X3IsZXhjbHVkZTpfcixtYXg6W1N0cmluZyxOdW1iZXJdfSxjcmVhdGVkOmZ1bmN0aW9uKCl7dGhpcy5jYWNoZT1PYmplY3QuY3JlYXRlKG51bGwpLHRoaXMua2V5cz1bXX0sZGVzdHJveWVkOmZ1bmN0aW9uKCl7dmFyIHQ9dGhpcztmb3IodmFyIGUgaW4gdC5jYWNoZSl5cih0LmNhY2hlLGUsdC5rZXlzKX0sbW91bnRlZDpmdW5jdGlvbigpe3ZhciB0PXRoaXM7dGhpcy4kd2F0Y2goImluY2x1ZGUiLGZ1bmN0aW9uKGUpe21yKHQsZnVuY3Rpb24odCl7cmV0dXJuIGhyKGUsdCl9KX0pLHRoaXMuJHdhdGNoKCJleGNsdWRlIixmdW5jdGlvbihlKXttcih0LGZ1bmN0aW9uKHQpe3JldHVybiFocihlLHQpfSl9KX0scmVuZGVyOmZ1bmN0aW9uKCl7dmFyIHQ9dGhpcy4kc2xvdHMuZGVmYXVsdCxlPWplKHQpLG49ZSYmZS5jb21wb25lbnRPcHRpb25zO2lmKG4pe3ZhciByPXZyKG4pLG89dGhpcyxpPW8uaW5jbHVkZSxhPW8uZXhjbHVkZTtpZihpJiYoIXJ8fCFocihpLHIpKXx8YSYmciYmaHIoYSxyKSlyZXR1cm4gZTt2YXIgcz10aGlzLGM9cy5jYWNoZSx1PXMua2V5cyxmPW51bGw9PWUua2V5P24uQ3Rvci5jaWQrKG4udGFnPyI6OiIrbi50YWc6IiIpOmUua2V5O2NbZl0/KGUuY29tcG9uZW50SW5zdGFuY2U9Y1tmXS5jb21wb25lbnRJbnN0YW5jZSx5KHUsZiksdS5wdXNoKGYpKTooY1tmXT1lLHUucHVzaChmKSx0aGlzLm1heCYmdS5sZW5ndGg+cGFyc2VJbnQodGhpcy5tYXgpJiZ5cihjLHVbMF0sdSx0aGlzLl92bm9kZSkpLGUuZGF0YS5rZWVwQWxpdmU9ITB9cmV0dXJuIGV8fHQmJnRbMF19fSxicj17S2VlcEFsaXZlOmdyfTtmdW5jdGlvbiB3cih0KXt2YXIgZT17Z2V0OmZ1bmN0aW9uKCl7cmV0dXJuIEJ9fTtPYmplY3QuZGVmaW5lUHJvcGVydHkodCwiY29uZmlnIixlKSx0LnV0aWw9e3dhcm46ZnQsZXh0ZW5kOkUsbWVyZ2VPcHRpb25zOnp0LGRlZmluZVJlYWN0aXZlOlR0fSx0LnNldD1JdCx0LmRlbGV0ZT1QdCx0Lm5leHRUaWNrPWZlLHQub3B0aW9ucz1PYmplY3QuY3JlYXRlKG51bGwpLFIuZm9yRWFjaChmdW5jdGlvbihlKXt0Lm9wdGlvbnNbZSsicyJdPU9iamVjdC5jcmVhdGUobnVsbCl9KSx0Lm9wdGlvbnMuX2Jhc2U9dCxFKHQub3B0aW9ucy5jb21wb25lbnRzLGJyKSxjcih0KSx1cih0KSxmcih0KSxkcih0KX13cihzciksT2JqZWN0LmRlZmluZVByb3BlcnR5KHNyLnByb3RvdHlwZSwiJGlzU2VydmVyIix7Z2V0Oml0fSksT2JqZWN0LmRlZmluZVByb3BlcnR5KHNyLnByb3RvdHlwZSwiJHNzckNvbnRleHQiLHtnZXQ6ZnVuY3Rpb24oKXtyZXR1cm4gdGhpcy4kdm5vZGUmJnRoaXMuJHZub2RlLnNzckNvbnRleHR9fSksT2JqZWN0LmRlZmluZVByb3BlcnR5KHNyLCJGdW5jdGlvbmFsUmVuZGVyQ29udGV4dCIse3ZhbHVlOkxufSksc3IudmVyc2lvbj0iMi41LjE3Ijt2YXIgeHI9aCgic3R5bGUsY2xhc3MiKSxDcj1oKCJpbnB1dCx0ZXh0YXJlYSxvcHRpb24sc2VsZWN0LHByb2dyZXNzIiksQXI9ZnVuY3Rpb24odCxlLG4pe3JldHVybiJ2YWx1ZSI9PT1uJiZDcih0KSYmImJ1dHRvbiIhPT1lfHwic2VsZWN0ZWQiPT09biYmIm9wdGlvbiI9PT10fHwiY2hlY2tlZCI9PT1uJiYiaW5wdXQiPT09dHx8Im11dGVkIj09PW4mJiJ2aWRlbyI9PT10fSxPcj1oKCJjb250ZW50ZWRpdGFibGUsZHJhZ2dhYmxlLHNwZWxsY2hlY2siKSwkcj1oKCJhbGxvd2Z1bGxzY3JlZW4sYXN5bmMsYXV0b2ZvY3VzLGF1dG9wbGF5LGNoZWNrZWQsY29tcGFjdCxjb250cm9scyxkZWNsYXJlLGRlZmF1bHQsZGVmYXVsdGNoZWNrZWQsZGVmYXVsdG11dGVkLGRlZmF1bHRzZWxlY3RlZCxkZWZlcixkaXNhYmxlZCxlbmFibGVkLGZvcm1ub3ZhbGlkYXRlLGhpZGRlbixpbmRldGVybWluYXRlLGluZXJ0LGlzbWFwLGl0ZW1zY29wZSxsb29wLG11bHRpcGxlLG11dGVkLG5vaHJlZixub3Jlc2l6ZSxub3NoYWRlLG5vdmFsaWRhdGUsbm93cmFwLG9wZW4scGF1c2VvbmV4aXQscmVhZG9ubHkscmVxdWlyZWQscmV2ZXJzZWQsc2NvcGVkLHNlYW1sZXNzLHNlbGVjdGVkLHNvcnRhYmxlLHRyYW5zbGF0ZSx0cnVlc3BlZWQsdHlwZW11c3RtYXRjaCx2aXNpYmxlIiksa3I9Imh0dHA6Ly93d3cudzMub3JnLzE5OTkveGxpbmsiLFNyPWZ1bmN0aW9uKHQpe3JldHVybiI6Ij09PXQuY2hhckF0KDUpJiYieGxpbmsiPT09dC5zbGljZSgwLDUpfSxqcj1mdW5jdGlvbih0KXtyZXR1cm4gU3IodCk/dC5zbGljZSg2LHQubGVuZ3RoKToiIn0sRXI9ZnVuY3Rpb24odCl7cmV0dXJuIG51bGw9PXR8fCExPT09dH07ZnVuY3Rpb24gVHIodCl7dmFyIGU9dC5kYXRhLG49dCxyPXQ7d2hpbGUobyhyLmNvbXBvbmVudEluc3RhbmNlKSlyPXIuY29tcG9uZW50SW5zdGFuY2UuX3Zub2RlLHImJnIuZGF0YSYmKGU9SXIoci5kYXRhLGUpKTt3aGlsZShvKG49bi5wYXJlbnQpKW4mJm4uZGF0YSYmKGU9SXIoZSxuLmRhdGEpKTtyZXR1cm4gUHIoZS5zdGF0aWNDbGFzcyxlLmNsYXNzKX1mdW5jdGlvbiBJcih0LGUpe3JldHVybntzdGF0aWNDbGFzczpNcih0LnN0YXRpY0NsYXNzLGUuc3RhdGljQ2xhc3MpLGNsYXNzOm8odC5jbGFzcyk/W3QuY2xhc3MsZS5jbGFzc106ZS5jbGFzc319ZnVuY3Rpb24gUHIodCxlKXtyZXR1cm4gbyh0KXx8byhlKT9Ncih0LE5yKGUpKToiIn1mdW5jdGlvbiBNcih0LGUpe3JldHVybiB0P2U/dCsiICIrZTp0OmV8fCIifWZ1bmN0aW9uIE5yKHQpe3JldHVybiBBcnJheS5pc0FycmF5KHQpP0RyKHQpOmModCk/THIodCk6InN0cmluZyI9PT10eXBlb2YgdD90OiIifWZ1bmN0aW9uIERyKHQpe2Zvcih2YXIgZSxuPSIiLHI9MCxpPXQubGVuZ3RoO3I8aTtyKyspbyhlPU5yKHRbcl0pKSYmIiIhPT1lJiYobiYmKG4rPSIgIiksbis9ZSk7cmV0dXJuIG59ZnVuY3Rpb24gTHIodCl7dmFyIGU9IiI7Zm9yKHZhciBuIGluIHQpdFtuXSYmKGUmJihlKz0iICIpLGUrPW4pO3JldHVybiBlfXZhciBGcj17c3ZnOiJodHRwOi8vd3d3LnczLm9yZy8yMDAwL3N2ZyIsbWF0aDoiaHR0cDovL3d3dy53My5vcmcvMTk5OC9NYXRoL01hdGhNTCJ9LFJyPWgoImh0bWwsYm9keSxiYXNlLGhlYWQsbGluayxtZXRhLHN0eWxlLHRpdGxlLGFkZHJlc3MsYXJ0aWNsZSxhc2lkZSxmb290ZXIsaGVhZGVyLGgxLGgyLGgzLGg0LGg1LGg2LGhncm91cCxuYXYsc2VjdGlvbixkaXYsZGQsZGwsZHQsZmlnY2FwdGlvbixmaWd1cmUscGljdHVyZSxocixpbWcsbGksbWFpbixvbCxwLHByZSx1bCxhLGIsYWJicixiZGksYmRvLGJyLGNpdGUsY29kZSxkYXRhLGRmbixlbSxpLGtiZCxtYXJrLHEscnAscnQscnRjLHJ1YnkscyxzYW1wLHNtYWxsLHNwYW4sc3Ryb25nLHN1YixzdXAsdGltZSx1LHZhcix3YnIsYXJlYSxhdWRpbyxtYXAsdHJhY2ssdmlkZW8sZW1iZWQsb2JqZWN0LHBhcmFtLHNvdXJjZSxjYW52YXMsc2NyaXB0LG5vc2NyaXB0LGRlbCxpbnMsY2FwdGlvbixjb2wsY29sZ3JvdXAsdGFibGUsdGhlYWQsdGJvZHksdGQsdGgsdHIsYnV0dG9uLGRhdGFsaXN0LGZpZWxkc2V0LGZvcm0saW5wdXQsbGFiZWwsbGVnZW5kLG1ldGVyLG9wdGdyb3VwLG9wdGlvbixvdXRwdXQscHJvZ3Jlc3Msc2VsZWN0LHRleHRhcmVhLGRldGFpbHMsZGlhbG9nLG1lbnUsbWVudWl0ZW0sc3VtbWFyeSxjb250ZW50LGVsZW1lbnQsc2hhZG93LHRlbXBsYXRlLGJsb2NrcXVvdGUsaWZyYW1lLHRmb290IiksVXI9aCgic3ZnLGFuaW1hdGUsY2lyY2xlLGNsaXBwYXRoLGN1cnNvcixkZWZzLGRlc2MsZWxsaXBzZSxmaWx0ZXIsZm9udC1mYWNlLGZvcmVpZ25PYmplY3QsZyxnbHlwaCxpbWFnZSxsaW5lLG1hcmtlcixtYXNrLG1pc3NpbmctZ2x5cGgscGF0aCxwYXR0ZXJuLHBvbHlnb24scG9seWxpbmUscmVjdCxzd2l0Y2gsc3ltYm9sLHRleHQsdGV4dHBhdGgsdHNwYW4sdXNlLHZpZXciLCEwKSxCcj1mdW5jdGlvbih0KXtyZXR1cm4gUnIodCl8fFVyKHQpfTtmdW5jdGlvbiBWcih0KXtyZXR1cm4gVXIodCk/InN2ZyI6Im1hdGgiPT09dD8ibWF0aCI6dm9pZCAwfXZhciBIcj1PYmplY3QuY3JlYXRlKG51bGwpO2Z1bmN0aW9uIHpyKHQpe2lmKCFYKXJldHVybiEwO2lmKEJyKHQpKXJldHVybiExO2lmKHQ9dC50b0xvd2VyQ2FzZSgpLG51bGwhPUhyW3RdKXJldHVybiBIclt0XTt2YXIgZT1kb2N1bWVudC5jcmVhdGVFbGVtZW50KHQpO3JldHVybiB0LmluZGV4T2YoIi0iKT4tMT9Iclt0XT1lLmNvbnN0cnVjdG9yPT09d2luZG93LkhUTUxVbmtub3duRWxlbWVudHx8ZS5jb25zdHJ1Y3Rvcj09PXdpbmRvdy5IVE1MRWxlbWVudDpIclt0XT0vSFRNTFVua25vd25FbGVtZW50Ly50ZXN0KGUudG9TdHJpbmcoKSl9dmFyIFdyPWgoInRleHQsbnVtYmVyLHBhc3N3b3JkLHNlYXJjaCxlbWFpbCx0ZWwsdXJsIik7ZnVuY3Rpb24gS3IodCl7aWYoInN0cmluZyI9PT10eXBlb2YgdCl7dmFyIGU9ZG9jdW1lbnQucXVlcnlTZWxlY3Rvcih0KTtyZXR1cm4gZXx8ZG9jdW1lbnQuY3JlYXRlRWxlbWVudCgiZGl2Iil9cmV0dXJuIHR9ZnVuY3Rpb24gcXIodCxlKXt2YXIgbj1kb2N1bWVudC5jcmVhdGVFbGVtZW50KHQpO3JldHVybiJzZWxlY3QiIT09dD9uOihlLmRhdGEmJmUuZGF0YS5hdHRycyYmdm9pZCAwIT09ZS5kYXRhLmF0dHJzLm11bHRpcGxlJiZuLnNldEF0dHJpYnV0ZSgibXVsdGlwbGUiLCJtdWx0aXBsZSIpLG4pfWZ1bmN0aW9uIFhyKHQsZSl7cmV0dXJuIGRvY3VtZW50LmNyZWF0ZUVsZW1lbnROUyhGclt0XSxlKX1mdW5jdGlvbiBHcih0KXtyZXR1cm4gZG9jdW1lbnQuY3JlYXRlVGV4dE5vZGUodCl9ZnVuY3Rpb24gSnIodCl7cmV0dXJuIGRvY3VtZW50LmNyZWF0ZUNvbW1lbnQodCl9ZnVuY3Rpb24gWnIodCxlLG4pe3QuaW5zZXJ0QmVmb3JlKGUsbil9ZnVuY3Rpb24gUXIodCxlKXt0LnJlbW92ZUNoaWxkKGUpfWZ1bmN0aW9uIFlyKHQsZSl7dC5hcHBlbmRDaGlsZChlKX1mdW5jdGlvbiB0byh0KXtyZXR1cm4gdC5wYXJlbnROb2RlfWZ1bmN0aW9uIGVvKHQpe3JldHVybiB0Lm5leHRTaWJsaW5nfWZ1bmN0aW9uIG5vKHQpe3JldHVybiB0LnRhZ05hbWV9ZnVuY3Rpb24gcm8odCxlKXt0LnRleHRDb250ZW50PWV9ZnVuY3Rpb24gb28odCxlKXt0LnNldEF0dHJpYnV0ZShlLCIiKX12YXIgaW89T2JqZWN0LmZyZWV6ZSh7Y3JlYXRlRWxlbWVudDpxcixjcmVhdGVFbGVtZW50TlM6WHIsY3JlYXRlVGV4dE5vZGU6R3IsY3JlYXRlQ29tbWVudDpKcixpbnNlcnRCZWZvcmU6WnIscmVtb3ZlQ2hpbGQ6UXIsYXBwZW5kQ2hpbGQ6WXIscGFyZW50Tm9kZTp0byxuZXh0U2libGluZzplbyx0YWdOYW1lOm5vLHNldFRleHRDb250ZW50OnJvLHNldFN0eWxlU2NvcGU6b299KSxhbz17Y3JlYXRlOmZ1bmN0aW9uKHQsZSl7c28oZSl9LHVwZGF0ZTpmdW5jdGlvbih0LGUpe3QuZGF0YS5yZWYhPT1lLmRhdGEucmVmJiYoc28odCwhMCksc28oZSkpfSxkZXN0cm95OmZ1bmN0aW9uKHQpe3NvKHQsITApfX07ZnVuY3Rpb24gc28odCxlKXt2YXIgbj10LmRhdGEucmVmO2lmKG8obikpe3ZhciByPXQuY29udGV4dCxpPXQuY29tcG9uZW50SW5zdGFuY2V8fHQuZWxtLGE9ci4kcmVmcztlP0FycmF5LmlzQXJyYXkoYVtuXSk/eShhW25dLGkpOmFbbl09PT1pJiYoYVtuXT12b2lkIDApOnQuZGF0YS5yZWZJbkZvcj9BcnJheS5pc0FycmF5KGFbbl0pP2Fbbl0uaW5kZXhPZihpKTwwJiZhW25dLnB1c2goaSk6YVtuXT1baV06YVtuXT1pfX12YXIgY289bmV3IG10KCIiLHt9LFtdKSx1bz1bImNyZWF0ZSIsImFjdGl2YXRlIiwidXBkYXRlIiwicmVtb3ZlIiwiZGVzdHJveSJdO2Z1bmN0aW9uIGZvKHQsZSl7cmV0dXJuIHQua2V5PT09ZS5rZXkmJih0LnRhZz09PWUudGFnJiZ0LmlzQ29tbWVudD09PWUuaXNDb21tZW50JiZvKHQuZGF0YSk9PT1vKGUuZGF0YSkmJmxvKHQsZSl8fGkodC5pc0FzeW5jUGxhY2Vob2xkZXIpJiZ0LmFzeW5jRmFjdG9yeT09PWUuYXN5bmNGYWN0b3J5JiZyKGUuYXN5bmNGYWN0b3J5LmVycm9yKSl9ZnVuY3Rpb24gbG8odCxlKXtpZigiaW5wdXQiIT09dC50YWcpcmV0dXJuITA7dmFyIG4scj1vKG49dC5kYXRhKSYmbyhuPW4uYXR0cnMpJiZuLnR5cGUsaT1vKG49ZS5kYXRhKSYmbyhuPW4uYXR0cnMpJiZuLnR5cGU7cmV0dXJuIHI9PT1pfHxXcihyKSYmV3IoaSl9ZnVuY3Rpb24gcG8odCxlLG4pe3ZhciByLGksYT17fTtmb3Iocj1lO3I8PW47KytyKWk9dFtyXS5rZXksbyhpKSYmKGFbaV09cik7cmV0dXJuIGF9ZnVuY3Rpb24gdm8odCl7dmFyIGUsbixhPXt9LGM9dC5tb2R1bGVzLHU9dC5ub2RlT3BzO2ZvcihlPTA7ZTx1by5sZW5ndGg7KytlKWZvcihhW3VvW2VdXT1bXSxuPTA7bjxjLmxlbmd0aDsrK24pbyhjW25dW3VvW2VdXSkmJmFbdW9bZV1dLnB1c2goY1tuXVt1b1tlXV0pO2Z1bmN0aW9uIGYodCl7cmV0dXJuIG5ldyBtdCh1LnRhZ05hbWUodCkudG9Mb3dlckNhc2UoKSx7fSxbXSx2b2lkIDAsdCl9ZnVuY3Rpb24gbCh0LGUpe2Z1bmN0aW9uIG4oKXswPT09LS1uLmxpc3RlbmVycyYmcCh0KX1yZXR1cm4gbi5saXN0ZW5lcnM9ZSxufWZ1bmN0aW9uIHAodCl7dmFyIGU9dS5wYXJlbnROb2RlKHQpO28oZSkmJnUucmVtb3ZlQ2hpbGQoZSx0KX1mdW5jdGlvbiBkKHQsZSxuLHIsYSxzLGMpe2lmKG8odC5lbG0pJiZvKHMpJiYodD1zW2NdPWJ0KHQpKSx0LmlzUm9vdEluc2VydD0hYSwhdih0LGUsbixyKSl7dmFyIGY9dC5kYXRhLGw9dC5jaGlsZHJlbixwPXQudGFnO28ocCk/KHQuZWxtPXQubnM/dS5jcmVhdGVFbGVtZW50TlModC5ucyxwKTp1LmNyZWF0ZUVsZW1lbnQocCx0KSx4KHQpLGcodCxsLGUpLG8oZikmJncodCxlKSxfKG4sdC5lbG0scikpOmkodC5pc0NvbW1lbnQpPyh0LmVsbT11LmNyZWF0ZUNvbW1lbnQodC50ZXh0KSxfKG4sdC5lbG0scikpOih0LmVsbT11LmNyZWF0ZVRleHROb2RlKHQudGV4dCksXyhuLHQuZWxtLHIpKX19ZnVuY3Rpb24gdih0LGUsbixyKXt2YXIgYT10LmRhdGE7aWYobyhhKSl7dmFyIHM9byh0LmNvbXBvbmVudEluc3RhbmNlKSYmYS5rZWVwQWxpdmU7aWYobyhhPWEuaG9vaykmJm8oYT1hLmluaXQpJiZhKHQsITEsbixyKSxvKHQuY29tcG9uZW50SW5zdGFuY2UpKXJldHVybiBtKHQsZSksaShzKSYmeSh0LGUsbixyKSwhMH19ZnVuY3Rpb24gbSh0LGUpe28odC5kYXRhLnBlbmRpbmdJbnNlcnQpJiYoZS5wdXNoLmFwcGx5KGUsdC5kYXRhLnBlbmRpbmdJbnNlcnQpLHQuZGF0YS5wZW5kaW5nSW5zZXJ0PW51bGwpLHQuZWxtPXQuY29tcG9uZW50SW5zdGFuY2UuJGVsLGIodCk/KHcodCxlKSx4KHQpKTooc28odCksZS5wdXNoKHQpKX1mdW5jdGlvbiB5KHQsZSxuLHIpe3ZhciBpLHM9dDt3aGlsZShzLmNvbXBvbmVudEluc3RhbmNlKWlmKHM9cy5jb21wb25lbnRJbnN0YW5jZS5fdm5vZGUsbyhpPXMuZGF0YSkmJm8oaT1pLnRyYW5zaXRpb24pKXtmb3IoaT0wO2k8YS5hY3RpdmF0ZS5sZW5ndGg7KytpKWEuYWN0aXZhdGVbaV0oY28scyk7ZS5wdXNoKHMpO2JyZWFrfV8obix0LmVsbSxyKX1mdW5jdGlvbiBfKHQsZSxuKXtvKHQpJiYobyhuKT9uLnBhcmVudE5vZGU9PT10JiZ1Lmluc2VydEJlZm9yZSh0LGUsbik6dS5hcHBlbmRDaGlsZCh0LGUpKX1mdW5jdGlvbiBnKHQsZSxuKXtpZihBcnJheS5pc0FycmF5KGUpKXswO2Zvcih2YXIgcj0wO3I8ZS5sZW5ndGg7KytyKWQoZVtyXSxuLHQuZWxtLG51bGwsITAsZSxyKX1lbHNlIHModC50ZXh0KSYmdS5hcHBlbmRDaGlsZCh0LmVsbSx1LmNyZWF0ZVRleHROb2RlKFN0cmluZyh0LnRleHQpKSl9ZnVuY3Rpb24gYih0KXt3aGlsZSh0LmNvbXBvbmVudEluc3RhbmNlKXQ9dC5jb21wb25lbnRJbnN0YW5jZS5fdm5vZGU7cmV0dXJuIG8odC50YWcpfWZ1bmN0aW9uIHcodCxuKXtmb3IodmFyIHI9MDtyPGEuY3JlYXRlLmxlbmd0aDsrK3IpYS5jcmVhdGVbcl0oY28sdCk7ZT10LmRhdGEuaG9vayxvKGUpJiYobyhlLmNyZWF0ZSkmJmUuY3JlYXRlKGNvLHQpLG8oZS5pbnNlcnQpJiZuLnB1c2godCkpfWZ1bmN0aW9uIHgodCl7dmFyIGU7aWYobyhlPXQuZm5TY29wZUlkKSl1LnNldFN0eWxlU2NvcGUodC5lbG0sZSk7ZWxzZXt2YXIgbj10O3doaWxlKG4pbyhlPW4uY29udGV4dCkmJm8oZT1lLiRvcHRpb25zLl9zY29wZUlkKSYmdS5zZXRTdHlsZVNjb3BlKHQuZWxtLGUpLG49bi5wYXJlbnR9byhlPUZlKSYmZSE9PXQuY29udGV4dCYmZSE9PXQuZm5Db250ZXh0JiZvKGU9ZS4kb3B0aW9ucy5fc2NvcGVJZCkmJnUuc2V0U3R5bGVTY29wZSh0LmVsbSxlKX1mdW5jdGlvbiBDKHQsZSxuLHIsbyxpKXtmb3IoO3I8PW87KytyKWQobltyXSxpLHQsZSwhMSxuLHIpfWZ1bmN0aW9uIEEodCl7dmFyIGUsbixyPXQuZGF0YTtpZihvKHIpKWZvcihvKGU9ci5ob29rKSYmbyhlPWUuZGVzdHJveSkmJmUodCksZT0wO2U8YS5kZXN0cm95Lmxlbmd0aDsrK2UpYS5kZXN0cm95W2VdKHQpO2lmKG8oZT10LmNoaWxkcmVuKSlmb3Iobj0wO248dC5jaGlsZHJlbi5sZW5ndGg7KytuKUEodC5jaGlsZHJlbltuXSl9ZnVuY3Rpb24gTyh0LGUsbixyKXtmb3IoO248PXI7KytuKXt2YXIgaT1lW25dO28oaSkmJihvKGkudGFnKT8oJChpKSxBKGkpKTpwKGkuZWxtKSl9fWZ1bmN0aW9uICQodCxlKXtpZihvKGUpfHxvKHQuZGF0YSkpe3ZhciBuLHI9YS5yZW1vdmUubGVuZ3RoKzE7Zm9yKG8oZSk/ZS5saXN0ZW5lcnMrPXI6ZT1sKHQuZWxtLHIpLG8obj10LmNvbXBvbmVudEluc3RhbmNlKSYmbyhuPW4uX3Zub2RlKSYmbyhuLmRhdGEpJiYkKG4sZSksbj0wO248YS5yZW1vdmUubGVuZ3RoOysrbilhLnJlbW92ZVtuXSh0LGUpO28obj10LmRhdGEuaG9vaykmJm8obj1uLnJlbW92ZSk/bih0LGUpOmUoKX1lbHNlIHAodC5lbG0pfWZ1bmN0aW9uIGsodCxlLG4saSxhKXt2YXIgcyxjLGYsbCxwPTAsdj0wLGg9ZS5sZW5ndGgtMSxtPWVbMF0seT1lW2hdLF89bi5sZW5ndGgtMSxnPW5bMF0sYj1uW19dLHc9IWE7d2hpbGUocDw9aCYmdjw9XylyKG0pP209ZVsrK3BdOnIoeSk/eT1lWy0taF06Zm8obSxnKT8oaihtLGcsaSksbT1lWysrcF0sZz1uWysrdl0pOmZvKHksYik/KGooeSxiLGkpLHk9ZVstLWhdLGI9blstLV9dKTpmbyhtLGIpPyhqKG0sYixpKSx3JiZ1Lmluc2VydEJlZm9yZSh0LG0uZWxtLHUubmV4dFNpYmxpbmcoeS5lbG0pKSxtPWVbKytwXSxiPW5bLS1fXSk6Zm8oeSxnKT8oaih5LGcsaSksdyYmdS5pbnNlcnRCZWZvcmUodCx5LmVsbSxtLmVsbSkseT1lWy0taF0sZz1uWysrdl0pOihyKHMpJiYocz1wbyhlLHAsaCkpLGM9byhnLmtleSk/c1tnLmtleV06UyhnLGUscCxoKSxyKGMpP2QoZyxpLHQsbS5lbG0sITEsbix2KTooZj1lW2NdLGZvKGYsZyk/KGooZixnLGkpLGVbY109dm9pZCAwLHcmJnUuaW5zZXJ0QmVmb3JlKHQsZi5lbG0sbS5lbG0pKTpkKGcsaSx0LG0uZWxtLCExLG4sdikpLGc9blsrK3ZdKTtwPmg/KGw9cihuW18rMV0pP251bGw6bltfKzFdLmVsbSxDKHQsbCxuLHYsXyxpKSk6dj5fJiZPKHQsZSxwLGgpfWZ1bmN0aW9uIFModCxlLG4scil7Zm9yKHZhciBpPW47aTxyO2krKyl7dmFyIGE9ZVtpXTtpZihvKGEpJiZmbyh0LGEpKXJldHVybiBpfX1mdW5jdGlvbiBqKHQsZSxuLHMpe2lmKHQhPT1lKXt2YXIgYz1lLmVsbT10LmVsbTtpZihpKHQuaXNBc3luY1BsYWNlaG9sZGVyKSlvKGUuYXN5bmNGYWN0b3J5LnJlc29sdmVkKT9JKHQuZWxtLGUsbik6ZS5pc0FzeW5jUGxhY2Vob2xkZXI9ITA7ZWxzZSBpZihpKGUuaXNTdGF0aWMpJiZpKHQuaXNTdGF0aWMpJiZlLmtleT09PXQua2V5JiYoaShlLmlzQ2xvbmVkKXx8aShlLmlzT25jZSkpKWUuY29tcG9uZW50SW5zdGFuY2U9dC5jb21wb25lbnRJbnN0YW5jZTtlbHNle3ZhciBmLGw9ZS5kYXRhO28obCkmJm8oZj1sLmhvb2spJiZvKGY9Zi5wcmVwYXRjaCkmJmYodCxlKTt2YXIgcD10LmNoaWxkcmVuLGQ9ZS5jaGlsZHJlbjtpZihvKGwpJiZiKGUpKXtmb3IoZj0wO2Y8YS51cGRhdGUubGVuZ3RoOysrZilhLnVwZGF0ZVtmXSh0LGUpO28oZj1sLmhvb2spJiZvKGY9Zi51cGRhdGUpJiZmKHQsZSl9cihlLnRleHQpP28ocCkmJm8oZCk/cCE9PWQmJmsoYyxwLGQsbixzKTpvKGQpPyhvKHQudGV4dCkmJnUuc2V0VGV4dENvbnRlbnQoYywiIiksQyhjLG51bGwsZCwwLGQubGVuZ3RoLTEsbikpOm8ocCk/TyhjLHAsMCxwLmxlbmd0aC0xKTpvKHQudGV4dCkmJnUuc2V0VGV4dENvbnRlbnQoYywiIik6dC50ZXh0IT09ZS50ZXh0JiZ1LnNldFRleHRDb250ZW50KGMsZS50ZXh0KSxvKGwpJiZvKGY9bC5ob29rKSYmbyhmPWYucG9zdHBhdGNoKSYmZih0LGUpfX19ZnVuY3Rpb24gRSh0LGUsbil7aWYoaShuKSYmbyh0LnBhcmVudCkpdC5wYXJlbnQuZGF0YS5wZW5kaW5nSW5zZXJ0PWU7ZWxzZSBmb3IodmFyIHI9MDtyPGUubGVuZ3RoOysrcillW3JdLmRhdGEuaG9vay5pbnNlcnQoZVtyXSl9dmFyIFQ9aCgiYXR0cnMsY2xhc3Msc3RhdGljQ2xhc3Msc3RhdGljU3R5bGUsa2V5Iik7ZnVuY3Rpb24gSSh0LGUsbixyKXt2YXIgYSxzPWUudGFnLGM9ZS5kYXRhLHU9ZS5jaGlsZHJlbjtpZihyPXJ8fGMmJmMucHJlLGUuZWxtPXQsaShlLmlzQ29tbWVudCkmJm8oZS5hc3luY0ZhY3RvcnkpKXJldHVybiBlLmlzQXN5bmNQbGFjZWhvbGRlcj0hMCwhMDtpZihvKGMpJiYobyhhPWMuaG9vaykmJm8oYT1hLmluaXQpJiZhKGUsITApLG8oYT1lLmNvbXBvbmVudEluc3RhbmNlKSkpcmV0dXJuIG0oZSxuKSwhMDtpZihvKHMpKXtpZihvKHUpKWlmKHQuaGFzQ2hpbGROb2RlcygpKWlmKG8oYT1jKSYmbyhhPWEuZG9tUHJvcHMpJiZvKGE9YS5pbm5lckhUTUwpKXtpZihhIT09dC5pbm5lckhUTUwpcmV0dXJuITF9ZWxzZXtmb3IodmFyIGY9ITAsbD10LmZpcnN0Q2hpbGQscD0wO3A8dS5sZW5ndGg7cCsrKXtpZighbHx8IUkobCx1W3BdLG4scikpe2Y9ITE7YnJlYWt9bD1sLm5leHRTaWJsaW5nfWlmKCFmfHxsKXJldHVybiExfWVsc2UgZyhlLHUsbik7aWYobyhjKSl7dmFyIGQ9ITE7Zm9yKHZhciB2IGluIGMpaWYoIVQodikpe2Q9ITAsdyhlLG4pO2JyZWFrfSFkJiZjWyJjbGFzcyJdJiZwZShjWyJjbGFzcyJdKX19ZWxzZSB0LmRhdGEhPT1lLnRleHQmJih0LmRhdGE9ZS50ZXh0KTtyZXR1cm4hMH1yZXR1cm4gZnVuY3Rpb24odCxlLG4scyxjLGwpe2lmKCFyKGUpKXt2YXIgcD0hMSx2PVtdO2lmKHIodCkpcD0hMCxkKGUsdixjLGwpO2Vsc2V7dmFyIGg9byh0Lm5vZGVUeXBlKTtpZighaCYmZm8odCxlKSlqKHQsZSx2LHMpO2Vsc2V7aWYoaCl7aWYoMT09PXQubm9kZVR5cGUmJnQuaGFzQXR0cmlidXRlKEYpJiYodC5yZW1vdmVBdHRyaWJ1dGUoRiksbj0hMCksaShuKSYmSSh0LGUsdikpcmV0dXJuIEUoZSx2LCEwKSx0O3Q9Zih0KX12YXIgbT10LmVsbSx5PXUucGFyZW50Tm9kZShtKTtpZihkKGUsdixtLl9sZWF2ZUNiP251bGw6eSx1Lm5leHRTaWJsaW5nKG0pKSxvKGUucGFyZW50KSl7dmFyIF89ZS5wYXJlbnQsZz1iKGUpO3doaWxlKF8pe2Zvcih2YXIgdz0wO3c8YS5kZXN0cm95Lmxlbmd0aDsrK3cpYS5kZXN0cm95W3ddKF8pO2lmKF8uZWxtPWUuZWxtLGcpe2Zvcih2YXIgeD0wO3g8YS5jcmVhdGUubGVuZ3RoOysreClhLmNyZWF0ZVt4XShjbyxfKTt2YXIgQz1fLmRhdGEuaG9vay5pbnNlcnQ7aWYoQy5tZXJnZWQpZm9yKHZhciAkPTE7JDxDLmZucy5sZW5ndGg7JCsrKUMuZm5zWyRdKCl9ZWxzZSBzbyhfKTtfPV8ucGFyZW50fX1vKHkpP08oeSxbdF0sMCwwKTpvKHQudGFnKSYmQSh0KX19cmV0dXJuIEUoZSx2LHApLGUuZWxtfW8odCkmJkEodCl9fXZhciBobz17Y3JlYXRlOm1vLHVwZGF0ZTptbyxkZXN0cm95OmZ1bmN0aW9uKHQpe21vKHQsY28pfX07ZnVuY3Rpb24gbW8odCxlKXsodC5kYXRhLmRpcmVjdGl2ZXN8fGUuZGF0YS5kaXJlY3RpdmVzKSYmeW8odCxlKX1mdW5jdGlvbiB5byh0LGUpe3ZhciBuLHIsbyxpPXQ9PT1jbyxhPWU9PT1jbyxzPWdvKHQuZGF0YS5kaXJlY3RpdmVzLHQuY29udGV4dCksYz1nbyhlLmRhdGEuZGlyZWN0aXZlcyxlLmNvbnRleHQpLHU9W10sZj1bXTtmb3IobiBpbiBjKXI9c1tuXSxvPWNbbl0scj8oby5vbGRWYWx1ZT1yLnZhbHVlLHdvKG8sInVwZGF0ZSIsZSx0KSxvLmRlZiYmby5kZWYuY29tcG9uZW50VXBkYXRlZCYmZi5wdXNoKG8pKTood28obywiYmluZCIsZSx0KSxvLmRlZiYmby5kZWYuaW5zZXJ0ZWQmJnUucHVzaChvKSk7aWYodS5sZW5ndGgpe3ZhciBsPWZ1bmN0aW9uKCl7Zm9yKHZhciBuPTA7bjx1Lmxlbmd0aDtuKyspd28odVtuXSwiaW5zZXJ0ZWQiLGUsdCl9O2k/X2UoZSwiaW5zZXJ0IixsKTpsKCl9aWYoZi5sZW5ndGgmJl9lKGUsInBvc3RwYXRjaCIsZnVuY3Rpb24oKXtmb3IodmFyIG49MDtuPGYubGVuZ3RoO24rKyl3byhmW25dLCJjb21wb25lbnRVcGRhdGVkIixlLHQpfSksIWkpZm9yKG4gaW4gcyljW25dfHx3byhzW25dLCJ1bmJpbmQiLHQsdCxhKX12YXIgX289T2JqZWN0LmNyZWF0ZShudWxsKTtmdW5jdGlvbiBnbyh0LGUpe3ZhciBuLHIsbz1PYmplY3QuY3JlYXRlKG51bGwpO2lmKCF0KXJldHVybiBvO2ZvcihuPTA7bjx0Lmxlbmd0aDtuKyspcj10W25dLHIubW9kaWZpZXJzfHwoci5tb2RpZmllcnM9X28pLG9bYm8ocildPXIsci5kZWY9V3QoZS4kb3B0aW9ucywiZGlyZWN0aXZlcyIsci5uYW1lLCEwKTtyZXR1cm4gb31mdW5jdGlvbiBibyh0KXtyZXR1cm4gdC5yYXdOYW1lfHx0Lm5hbWUrIi4iK09iamVjdC5rZXlzKHQubW9kaWZpZXJzfHx7fSkuam9pbigiLiIpfWZ1bmN0aW9uIHdvKHQsZSxuLHIsbyl7dmFyIGk9dC5kZWYmJnQuZGVmW2VdO2lmKGkpdHJ5e2kobi5lbG0sdCxuLHIsbyl9Y2F0Y2gocil7WnQocixuLmNvbnRleHQsImRpcmVjdGl2ZSAiK3QubmFtZSsiICIrZSsiIGhvb2siKX19dmFyIHhvPVthbyxob107ZnVuY3Rpb24gQ28odCxlKXt2YXIgbj1lLmNvbXBvbmVudE9wdGlvbnM7aWYoKCFvKG4pfHwhMSE9PW4uQ3Rvci5vcHRpb25zLmluaGVyaXRBdHRycykmJighcih0LmRhdGEuYXR0cnMpfHwhcihlLmRhdGEuYXR0cnMpKSl7dmFyIGksYSxzLGM9ZS5lbG0sdT10LmRhdGEuYXR0cnN8fHt9LGY9ZS5kYXRhLmF0dHJzfHx7fTtmb3IoaSBpbiBvKGYuX19vYl9fKSYmKGY9ZS5kYXRhLmF0dHJzPUUoe30sZikpLGYpYT1mW2ldLHM9dVtpXSxzIT09YSYmQW8oYyxpLGEpO2ZvcihpIGluKFF8fHR0KSYmZi52YWx1ZSE9PXUudmFsdWUmJkFvKGMsInZhbHVlIixmLnZhbHVlKSx1KXIoZltpXSkmJihTcihpKT9jLnJlbW92ZUF0dHJpYnV0ZU5TKGtyLGpyKGkpKTpPcihpKXx8Yy5yZW1vdmVBdHRyaWJ1dGUoaSkpfX1mdW5jdGlvbiBBbyh0LGUsbil7dC50YWdOYW1lLmluZGV4T2YoIi0iKT4tMT9Pbyh0LGUsbik6JHIoZSk/RXIobik/dC5yZW1vdmVBdHRyaWJ1dGUoZSk6KG49ImFsbG93ZnVsbHNjcmVlbiI9PT1lJiYiRU1CRUQiPT09dC50YWdOYW1lPyJ0cnVlIjplLHQuc2V0QXR0cmlidXRlKGUsbikpOk9yKGUpP3Quc2V0QXR0cmlidXRlKGUsRXIobil8fCJmYWxzZSI9PT1uPyJmYWxzZSI6InRydWUiKTpTcihlKT9FcihuKT90LnJlbW92ZUF0dHJpYnV0ZU5TKGtyLGpyKGUpKTp0LnNldEF0dHJpYnV0ZU5TKGtyLGUsbik6T28odCxlLG4pfWZ1bmN0aW9uIE9vKHQsZSxuKXtpZihFcihuKSl0LnJlbW92ZUF0dHJpYnV0ZShlKTtlbHNle2lmKFEmJiFZJiYiVEVYVEFSRUEiPT09dC50YWdOYW1lJiYicGxhY2Vob2xkZXIiPT09ZSYmIXQuX19pZXBoKXt2YXIgcj1mdW5jdGlvbihlKXtlLnN0b3BJbW1lZGlhdGVQcm9wYWdhdGlvbigpLHQucmVtb3ZlRXZlbnRMaXN0ZW5lcigiaW5wdXQiLHIpfTt0LmFkZEV2ZW50TGlzdGVuZXIoImlucHV0IixyKSx0Ll9faWVwaD0hMH10LnNldEF0dHJpYnV0ZShlLG4pfX12YXIgJG89e2NyZWF0ZTpDbyx1cGRhdGU6Q299O2Z1bmN0aW9uIGtvKHQsZSl7dmFyIG49ZS5lbG0saT1lLmRhdGEsYT10LmRhdGE7aWYoIShyKGkuc3RhdGljQ2xhc3MpJiZyKGkuY2xhc3MpJiYocihhKXx8cihhLnN0YXRpY0NsYXNzKSYmcihhLmNsYXNzKSkpKXt2YXIgcz1UcihlKSxjPW4uX3RyYW5zaXRpb25DbGFzc2VzO28oYykmJihzPU1yKHMsTnIoYykpKSxzIT09bi5fcHJldkNsYXNzJiYobi5zZXRBdHRyaWJ1dGUoImNsYXNzIixzKSxuLl9wcmV2Q2xhc3M9cyl9fXZhciBTbyxqbz17Y3JlYXRlOmtvLHVwZGF0ZTprb30sRW89Il9fciIsVG89Il9fYyI7ZnVuY3Rpb24gSW8odCl7aWYobyh0W0VvXSkpe3ZhciBlPVE/ImNoYW5nZSI6ImlucHV0Ijt0W2VdPVtdLmNvbmNhdCh0W0VvXSx0W2VdfHxbXSksZGVsZXRlIHRbRW9dfW8odFtUb10pJiYodC5jaGFuZ2U9W10uY29uY2F0KHRbVG9dLHQuY2hhbmdlfHxbXSksZGVsZXRlIHRbVG9dKX1mdW5jdGlvbiBQbyh0LGUsbil7dmFyIHI9U287cmV0dXJuIGZ1bmN0aW9uIG8oKXt2YXIgaT10LmFwcGx5KG51bGwsYXJndW1lbnRzKTtudWxsIT09aSYmTm8oZSxvLG4scil9fWZ1bmN0aW9uIE1vKHQsZSxuLHIsbyl7ZT11ZShlKSxuJiYoZT1QbyhlLHQscikpLFNvLmFkZEV2ZW50TGlzdGVuZXIodCxlLHJ0P3tjYXB0dXJlOnIscGFzc2l2ZTpvfTpyKX1mdW5jdGlvbiBObyh0LGUsbixyKXsocnx8U28pLnJlbW92ZUV2ZW50TGlzdGVuZXIodCxlLl93aXRoVGFza3x8ZSxuKX1mdW5jdGlvbiBEbyh0LGUpe2lmKCFyKHQuZGF0YS5vbil8fCFyKGUuZGF0YS5vbikpe3ZhciBuPWUuZGF0YS5vbnx8e30sbz10LmRhdGEub258fHt9O1NvPWUuZWxtLElvKG4pLHllKG4sbyxNbyxObyxlLmNvbnRleHQpLFNvPXZvaWQgMH19dmFyIExvPXtjcmVhdGU6RG8sdXBkYXRlOkRvfTtmdW5jdGlvbiBGbyh0LGUpe2lmKCFyKHQuZGF0YS5kb21Qcm9wcyl8fCFyKGUuZGF0YS5kb21Qcm9wcykpe3ZhciBuLGksYT1lLmVsbSxzPXQuZGF0YS5kb21Qcm9wc3x8e30sYz1lLmRhdGEuZG9tUHJvcHN8fHt9O2ZvcihuIGluIG8oYy5fX29iX18pJiYoYz1lLmRhdGEuZG9tUHJvcHM9RSh7fSxjKSkscylyKGNbbl0pJiYoYVtuXT0iIik7Zm9yKG4gaW4gYyl7aWYoaT1jW25dLCJ0ZXh0Q29udGVudCI9PT1ufHwiaW5uZXJIVE1MIj09PW4pe2lmKGUuY2hpbGRyZW4mJihlLmNoaWxkcmVuLmxlbmd0aD0wKSxpPT09c1tuXSljb250aW51ZTsxPT09YS5jaGlsZE5vZGVzLmxlbmd0aCYmYS5yZW1vdmVDaGlsZChhLmNoaWxkTm9kZXNbMF0pfWlmKCJ2YWx1ZSI9PT1uKXthLl92YWx1ZT1pO3ZhciB1PXIoaSk/IiI6U3RyaW5nKGkpO1JvKGEsdSkmJihhLnZhbHVlPXUpfWVsc2UgYVtuXT1pfX19ZnVuY3Rpb24gUm8odCxlKXtyZXR1cm4hdC5jb21wb3NpbmcmJigiT1BUSU9OIj09PXQudGFnTmFtZXx8VW8odCxlKXx8Qm8odCxlKSl9ZnVuY3Rpb24gVW8odCxlKXt2YXIgbj0hMDt0cnl7bj1kb2N1bWVudC5hY3RpdmVFbGVtZW50IT09dH1jYXRjaCh0KXt9cmV0dXJuIG4mJnQudmFsdWUhPT1lfWZ1bmN0aW9uIEJvKHQsZSl7dmFyIG49dC52YWx1ZSxyPXQuX3ZNb2RpZmllcnM7aWYobyhyKSl7aWYoci5sYXp5KXJldHVybiExO2lmKHIubnVtYmVyKXJldHVybiB2KG4pIT09dihlKTtpZihyLnRyaW0pcmV0dXJuIG4udHJpbSgpIT09ZS50cmltKCl9cmV0dXJuIG4hPT1lfXZhciBWbz17Y3JlYXRlOkZvLHVwZGF0ZTpGb30sSG89YihmdW5jdGlvbih0KXt2YXIgZT17fSxuPS87KD8hW14oXSpcKSkvZyxyPS86KC4rKS87cmV0dXJuIHQuc3BsaXQobikuZm9yRWFjaChmdW5jdGlvbih0KXtpZih0KXt2YXIgbj10LnNwbGl0KHIpO24ubGVuZ3RoPjEmJihlW25bMF0udHJpbSgpXT1uWzFdLnRyaW0oKSl9fSksZX0pO2Z1bmN0aW9uIHpvKHQpe3ZhciBlPVdvKHQuc3R5bGUpO3JldHVybiB0LnN0YXRpY1N0eWxlP0UodC5zdGF0aWNTdHlsZSxlKTplfWZ1bmN0aW9uIFdvKHQpe3JldHVybiBBcnJheS5pc0FycmF5KHQpP1QodCk6InN0cmluZyI9PT10eXBlb2YgdD9Ibyh0KTp0fWZ1bmN0aW9uIEtvKHQsZSl7dmFyIG4scj17fTtpZihlKXt2YXIgbz10O3doaWxlKG8uY29tcG9uZW50SW5zdGFuY2Upbz1vLmNvbXBvbmVudEluc3RhbmNlLl92bm9kZSxvJiZvLmRhdGEmJihuPXpvKG8uZGF0YSkpJiZFKHIsbil9KG49em8odC5kYXRhKSkmJkUocixuKTt2YXIgaT10O3doaWxlKGk9aS5wYXJlbnQpaS5kYXRhJiYobj16byhpLmRhdGEpKSYmRShyLG4pO3JldHVybiByfXZhciBxbyxYbz0vXi0tLyxHbz0vXHMqIWltcG9ydGFudCQvLEpvPWZ1bmN0aW9uKHQsZSxuKXtpZihYby50ZXN0KGUpKXQuc3R5bGUuc2V0UHJvcGVydHkoZSxuKTtlbHNlIGlmKEdvLnRlc3QobikpdC5zdHlsZS5zZXRQcm9wZXJ0eShlLG4ucmVwbGFjZShHbywiIiksImltcG9ydGFudCIpO2Vsc2V7dmFyIHI9UW8oZSk7aWYoQXJyYXkuaXNBcnJheShuKSlmb3IodmFyIG89MCxpPW4ubGVuZ3RoO288aTtvKyspdC5zdHlsZVtyXT1uW29dO2Vsc2UgdC5zdHlsZVtyXT1ufX0sWm89WyJXZWJraXQiLCJNb3oiLCJtcyJdLFFvPWIoZnVuY3Rpb24odCl7aWYocW89cW98fGRvY3VtZW50LmNyZWF0ZUVsZW1lbnQoImRpdiIpLnN0eWxlLHQ9eCh0KSwiZmlsdGVyIiE9PXQmJnQgaW4gcW8pcmV0dXJuIHQ7Zm9yKHZhciBlPXQuY2hhckF0KDApLnRvVXBwZXJDYXNlKCkrdC5zbGljZSgxKSxuPTA7bjxaby5sZW5ndGg7bisrKXt2YXIgcj1ab1tuXStlO2lmKHIgaW4gcW8pcmV0dXJuIHJ9fSk7ZnVuY3Rpb24gWW8odCxlKXt2YXIgbj1lLmRhdGEsaT10LmRhdGE7aWYoIShyKG4uc3RhdGljU3R5bGUpJiZyKG4uc3R5bGUpJiZyKGkuc3RhdGljU3R5bGUpJiZyKGkuc3R5bGUpKSl7dmFyIGEscyxjPWUuZWxtLHU9aS5zdGF0aWNTdHlsZSxmPWkubm9ybWFsaXplZFN0eWxlfHxpLnN0eWxlfHx7fSxsPXV8fGYscD1XbyhlLmRhdGEuc3R5bGUpfHx7fTtlLmRhdGEubm9ybWFsaXplZFN0eWxlPW8ocC5fX29iX18pP0Uoe30scCk6cDt2YXIgZD1LbyhlLCEwKTtmb3IocyBpbiBsKXIoZFtzXSkmJkpvKGMscywiIik7Zm9yKHMgaW4gZClhPWRbc10sYSE9PWxbc10mJkpvKGMscyxudWxsPT1hPyIiOmEpfX12YXIgdGk9e2NyZWF0ZTpZbyx1cGRhdGU6WW99O2Z1bmN0aW9uIGVpKHQsZSl7aWYoZSYmKGU9ZS50cmltKCkpKWlmKHQuY2xhc3NMaXN0KWUuaW5kZXhPZigiICIpPi0xP2Uuc3BsaXQoL1xzKy8pLmZvckVhY2goZnVuY3Rpb24oZSl7cmV0dXJuIHQuY2xhc3NMaXN0LmFkZChlKX0pOnQuY2xhc3NMaXN0LmFkZChlKTtlbHNle3ZhciBuPSIgIisodC5nZXRBdHRyaWJ1dGUoImNsYXNzIil8fCIiKSsiICI7bi5pbmRleE9mKCIgIitlKyIgIik8MCYmdC5zZXRBdHRyaWJ1dGUoImNsYXNzIiwobitlKS50cmltKCkpfX1mdW5jdGlvbiBuaSh0LGUpe2lmKGUmJihlPWUudHJpbSgpKSlpZih0LmNsYXNzTGlzdCllLmluZGV4T2YoIiAiKT4tMT9lLnNwbGl0KC9ccysvKS5mb3JFYWNoKGZ1bmN0aW9uKGUpe3JldHVybiB0LmNsYXNzTGlzdC5yZW1vdmUoZSl9KTp0LmNsYXNzTGlzdC5yZW1vdmUoZSksdC5jbGFzc0xpc3QubGVuZ3RofHx0LnJlbW92ZUF0dHJpYnV0ZSgiY2xhc3MiKTtlbHNle3ZhciBuPSIgIisodC5nZXRBdHRyaWJ1dGUoImNsYXNzIil8fCIiKSsiICIscj0iICIrZSsiICI7d2hpbGUobi5pbmRleE9mKHIpPj0wKW49bi5yZXBsYWNlKHIsIiAiKTtuPW4udHJpbSgpLG4/dC5zZXRBdHRyaWJ1dGUoImNsYXNzIixuKTp0LnJlbW92ZUF0dHJpYnV0ZSgiY2xhc3MiKX19ZnVuY3Rpb24gcmkodCl7aWYodCl7aWYoIm9iamVjdCI9PT10eXBlb2YgdCl7dmFyIGU9e307cmV0dXJuITEhPT10LmNzcyYmRShlLG9pKHQubmFtZXx8InYiKSksRShlLHQpLGV9cmV0dXJuInN0cmluZyI9PT10eXBlb2YgdD9vaSh0KTp2b2lkIDB9fXZhciBvaT1iKGZ1bmN0aW9uKHQpe3JldHVybntlbnRlckNsYXNzOnQrIi1lbnRlciIsZW50ZXJUb0NsYXNzOnQrIi1lbnRlci10byIsZW50ZXJBY3RpdmVDbGFzczp0KyItZW50ZXItYWN0aXZlIixsZWF2ZUNsYXNzOnQrIi1sZWF2ZSIsbGVhdmVUb0NsYXNzOnQrIi1sZWF2ZS10byIsbGVhdmVBY3RpdmVDbGFzczp0KyItbGVhdmUtYWN0aXZlIn19KSxpaT1YJiYhWSxhaT0idHJhbnNpdGlvbiIsc2k9ImFuaW1hdGlvbiIsY2k9InRyYW5zaXRpb24iLHVpPSJ0cmFuc2l0aW9uZW5kIixmaT0iYW5pbWF0aW9uIixsaT0iYW5pbWF0aW9uZW5kIjtpaSYmKHZvaWQgMD09PXdpbmRvdy5vbnRyYW5zaXRpb25lbmQmJnZvaWQgMCE9PXdpbmRvdy5vbndlYmtpdHRyYW5zaXRpb25lbmQmJihjaT0iV2Via2l0VHJhbnNpdGlvbiIsdWk9IndlYmtpdFRyYW5zaXRpb25FbmQiKSx2b2lkIDA9PT13aW5kb3cub25hbmltYXRpb25lbmQmJnZvaWQgMCE9PXdpbmRvdy5vbndlYmtpdGFuaW1hdGlvbmVuZCYmKGZpPSJXZWJraXRBbmltYXRpb24iLGxpPSJ3ZWJraXRBbmltYXRpb25FbmQiKSk7dmFyIHBpPVg/d2luZG93LnJlcXVlc3RBbmltYXRpb25GcmFtZT93aW5kb3cucmVxdWVzdEFuaW1hdGlvbkZyYW1lLmJpbmQod2luZG93KTpzZXRUaW1lb3V0OmZ1bmN0aW9uKHQpe3JldHVybiB0KCl9O2Z1bmN0aW9uIGRpKHQpe3BpKGZ1bmN0aW9uKCl7cGkodCl9KX1mdW5jdGlvbiB2aSh0LGUpe3ZhciBuPXQuX3RyYW5zaXRpb25DbGFzc2VzfHwodC5fdHJhbnNpdGlvbkNsYXNzZXM9W10pO24uaW5kZXhPZihlKTwwJiYobi5wdXNoKGUpLGVpKHQsZSkpfWZ1bmN0aW9uIGhpKHQsZSl7dC5fdHJhbnNpdGlvbkNsYXNzZXMmJnkodC5fdHJhbnNpdGlvbkNsYXNzZXMsZSksbmkodCxlKX1mdW5jdGlvbiBtaSh0LGUsbil7dmFyIHI9X2kodCxlKSxvPXIudHlwZSxpPXIudGltZW91dCxhPXIucHJvcENvdW50O2lmKCFvKXJldHVybiBuKCk7dmFyIHM9bz09PWFpP3VpOmxpLGM9MCx1PWZ1bmN0aW9uKCl7dC5yZW1vdmVFdmVudExpc3RlbmVyKHMsZiksbigpfSxmPWZ1bmN0aW9uKGUpe2UudGFyZ2V0PT09dCYmKytjPj1hJiZ1KCl9O3NldFRpbWVvdXQoZnVuY3Rpb24oKXtjPGEmJnUoKX0saSsxKSx0LmFkZEV2ZW50TGlzdGVuZXIocyxmKX12YXIgeWk9L1xiKHRyYW5zZm9ybXxhbGwpKCx8JCkvO2Z1bmN0aW9uIF9pKHQsZSl7dmFyIG4scj13aW5kb3cuZ2V0Q29tcHV0ZWRTdHlsZSh0KSxvPXJbY2krIkRlbGF5Il0uc3BsaXQoIiwgIiksaT1yW2NpKyJEdXJhdGlvbiJdLnNwbGl0KCIsICIpLGE9Z2kobyxpKSxzPXJbZmkrIkRlbGF5Il0uc3BsaXQoIiwgIiksYz1yW2ZpKyJEdXJhdGlvbiJdLnNwbGl0KCIsICIpLHU9Z2kocyxjKSxmPTAsbD0wO2U9PT1haT9hPjAmJihuPWFpLGY9YSxsPWkubGVuZ3RoKTplPT09c2k/dT4wJiYobj1zaSxmPXUsbD1jLmxlbmd0aCk6KGY9TWF0aC5tYXgoYSx1KSxuPWY+MD9hPnU/YWk6c2k6bnVsbCxsPW4/bj09PWFpP2kubGVuZ3RoOmMubGVuZ3RoOjApO3ZhciBwPW49PT1haSYmeWkudGVzdChyW2NpKyJQcm9wZXJ0eSJdKTtyZXR1cm57dHlwZTpuLHRpbWVvdXQ6Zixwcm9wQ291bnQ6bCxoYXNUcmFuc2Zvcm06cH19ZnVuY3Rpb24gZ2kodCxlKXt3aGlsZSh0Lmxlbmd0aDxlLmxlbmd0aCl0PXQuY29uY2F0KHQpO3JldHVybiBNYXRoLm1heC5hcHBseShudWxsLGUubWFwKGZ1bmN0aW9uKGUsbil7cmV0dXJuIGJpKGUpK2JpKHRbbl0pfSkpfWZ1bmN0aW9uIGJpKHQpe3JldHVybiAxZTMqTnVtYmVyKHQuc2xpY2UoMCwtMSkpfWZ1bmN0aW9uIHdpKHQsZSl7dmFyIG49dC5lbG07byhuLl9sZWF2ZUNiKSYmKG4uX2xlYXZlQ2IuY2FuY2VsbGVkPSEwLG4uX2xlYXZlQ2IoKSk7dmFyIGk9cmkodC5kYXRhLnRyYW5zaXRpb24pO2lmKCFyKGkpJiYhbyhuLl9lbnRlckNiKSYmMT09PW4ubm9kZVR5cGUpe3ZhciBhPWkuY3NzLHM9aS50eXBlLHU9aS5lbnRlckNsYXNzLGY9aS5lbnRlclRvQ2xhc3MsbD1pLmVudGVyQWN0aXZlQ2xhc3MscD1pLmFwcGVhckNsYXNzLGQ9aS5hcHBlYXJUb0NsYXNzLGg9aS5hcHBlYXJBY3RpdmVDbGFzcyxtPWkuYmVmb3JlRW50ZXIseT1pLmVudGVyLF89aS5hZnRlckVudGVyLGc9aS5lbnRlckNhbmNlbGxlZCxiPWkuYmVmb3JlQXBwZWFyLHc9aS5hcHBlYXIseD1pLmFmdGVyQXBwZWFyLEM9aS5hcHBlYXJDYW5jZWxsZWQsQT1pLmR1cmF0aW9uLE89RmUsJD1GZS4kdm5vZGU7d2hpbGUoJCYmJC5wYXJlbnQpJD0kLnBhcmVudCxPPSQuY29udGV4dDt2YXIgaz0hTy5faXNNb3VudGVkfHwhdC5pc1Jvb3RJbnNlcnQ7aWYoIWt8fHd8fCIiPT09dyl7dmFyIFM9ayYmcD9wOnUsaj1rJiZoP2g6bCxFPWsmJmQ/ZDpmLFQ9ayYmYnx8bSxJPWsmJiJmdW5jdGlvbiI9PT10eXBlb2Ygdz93OnksUD1rJiZ4fHxfLE09ayYmQ3x8ZyxOPXYoYyhBKT9BLmVudGVyOkEpOzA7dmFyIEQ9ITEhPT1hJiYhWSxGPUFpKEkpLFI9bi5fZW50ZXJDYj1MKGZ1bmN0aW9uKCl7RCYmKGhpKG4sRSksaGkobixqKSksUi5jYW5jZWxsZWQ/KEQmJmhpKG4sUyksTSYmTShuKSk6UCYmUChuKSxuLl9lbnRlckNiPW51bGx9KTt0LmRhdGEuc2hvd3x8X2UodCwiaW5zZXJ0IixmdW5jdGlvbigpe3ZhciBlPW4ucGFyZW50Tm9kZSxyPWUmJmUuX3BlbmRpbmcmJmUuX3BlbmRpbmdbdC5rZXldO3ImJnIudGFnPT09dC50YWcmJnIuZWxtLl9sZWF2ZUNiJiZyLmVsbS5fbGVhdmVDYigpLEkmJkkobixSKX0pLFQmJlQobiksRCYmKHZpKG4sUyksdmkobixqKSxkaShmdW5jdGlvbigpe2hpKG4sUyksUi5jYW5jZWxsZWR8fCh2aShuLEUpLEZ8fChDaShOKT9zZXRUaW1lb3V0KFIsTik6bWkobixzLFIpKSl9KSksdC5kYXRhLnNob3cmJihlJiZlKCksSSYmSShuLFIpKSxEfHxGfHxSKCl9fX1mdW5jdGlvbiB4aSh0LGUpe3ZhciBuPXQuZWxtO28obi5fZW50ZXJDYikmJihuLl9lbnRlckNiLmNhbmNlbGxlZD0hMCxuLl9lbnRlckNiKCkpO3ZhciBpPXJpKHQuZGF0YS50cmFuc2l0aW9uKTtpZihyKGkpfHwxIT09bi5ub2RlVHlwZSlyZXR1cm4gZSgpO2lmKCFvKG4uX2xlYXZlQ2IpKXt2YXIgYT1pLmNzcyxzPWkudHlwZSx1PWkubGVhdmVDbGFzcyxmPWkubGVhdmVUb0NsYXNzLGw9aS5sZWF2ZUFjdGl2ZUNsYXNzLHA9aS5iZWZvcmVMZWF2ZSxkPWkubGVhdmUsaD1pLmFmdGVyTGVhdmUsbT1pLmxlYXZlQ2FuY2VsbGVkLHk9aS5kZWxheUxlYXZlLF89aS5kdXJhdGlvbixnPSExIT09YSYmIVksYj1BaShkKSx3PXYoYyhfKT9fLmxlYXZlOl8pOzA7dmFyIHg9bi5fbGVhdmVDYj1MKGZ1bmN0aW9uKCl7bi5wYXJlbnROb2RlJiZuLnBhcmVudE5vZGUuX3BlbmRpbmcmJihuLnBhcmVudE5vZGUuX3BlbmRpbmdbdC5rZXldPW51bGwpLGcmJihoaShuLGYpLGhpKG4sbCkpLHguY2FuY2VsbGVkPyhnJiZoaShuLHUpLG0mJm0obikpOihlKCksaCYmaChuKSksbi5fbGVhdmVDYj1udWxsfSk7eT95KEMpOkMoKX1mdW5jdGlvbiBDKCl7eC5jYW5jZWxsZWR8fCh0LmRhdGEuc2hvd3x8KChuLnBhcmVudE5vZGUuX3BlbmRpbmd8fChuLnBhcmVudE5vZGUuX3BlbmRpbmc9e30pKVt0LmtleV09dCkscCYmcChuKSxnJiYodmkobix1KSx2aShuLGwpLGRpKGZ1bmN0aW9uKCl7aGkobix1KSx4LmNhbmNlbGxlZHx8KHZpKG4sZiksYnx8KENpKHcpP3NldFRpbWVvdXQoeCx3KTptaShuLHMseCkpKX0pKSxkJiZkKG4seCksZ3x8Ynx8eCgpKX19ZnVuY3Rpb24gQ2kodCl7cmV0dXJuIm51bWJlciI9PT10eXBlb2YgdCYmIWlzTmFOKHQpfWZ1bmN0aW9uIEFpKHQpe2lmKHIodCkpcmV0dXJuITE7dmFyIGU9dC5mbnM7cmV0dXJuIG8oZSk/QWkoQXJyYXkuaXNBcnJheShlKT9lWzBdOmUpOih0Ll9sZW5ndGh8fHQubGVuZ3RoKT4xfWZ1bmN0aW9uIE9pKHQsZSl7ITAhPT1lLmRhdGEuc2hvdyYmd2koZSl9dmFyICRpPVg/e2NyZWF0ZTpPaSxhY3RpdmF0ZTpPaSxyZW1vdmU6ZnVuY3Rpb24odCxlKXshMCE9PXQuZGF0YS5zaG93P3hpKHQsZSk6ZSgpfX06e30sa2k9WyRvLGpvLExvLFZvLHRpLCRpXSxTaT1raS5jb25jYXQoeG8pLGppPXZvKHtub2RlT3BzOmlvLG1vZHVsZXM6U2l9KTtZJiZkb2N1bWVudC5hZGRFdmVudExpc3RlbmVyKCJzZWxlY3Rpb25jaGFuZ2UiLGZ1bmN0aW9uKCl7dmFyIHQ9ZG9jdW1lbnQuYWN0aXZlRWxlbWVudDt0JiZ0LnZtb2RlbCYmTGkodCwiaW5wdXQiKX0pO3ZhciBFaT17aW5zZXJ0ZWQ6ZnVuY3Rpb24odCxlLG4scil7InNlbGVjdCI9PT1uLnRhZz8oci5lbG0mJiFyLmVsbS5fdk9wdGlvbnM/X2UobiwicG9zdHBhdGNoIixmdW5jdGlvbigpe0VpLmNvbXBvbmVudFVwZGF0ZWQodCxlLG4pfSk6VGkodCxlLG4uY29udGV4dCksdC5fdk9wdGlvbnM9W10ubWFwLmNhbGwodC5vcHRpb25zLE1pKSk6KCJ0ZXh0YXJlYSI9PT1uLnRhZ3x8V3IodC50eXBlKSkmJih0Ll92TW9kaWZpZXJzPWUubW9kaWZpZXJzLGUubW9kaWZpZXJzLmxhenl8fCh0LmFkZEV2ZW50TGlzdGVuZXIoImNvbXBvc2l0aW9uc3RhcnQiLE5pKSx0LmFkZEV2ZW50TGlzdGVuZXIoImNvbXBvc2l0aW9uZW5kIixEaSksdC5hZGRFdmVudExpc3RlbmVyKCJjaGFuZ2UiLERpKSxZJiYodC52bW9kZWw9ITApKSl9LGNvbXBvbmVudFVwZGF0ZWQ6ZnVuY3Rpb24odCxlLG4pe2lmKCJzZWxlY3QiPT09bi50YWcpe1RpKHQsZSxuLmNvbnRleHQpO3ZhciByPXQuX3ZPcHRpb25zLG89dC5fdk9wdGlvbnM9W10ubWFwLmNhbGwodC5vcHRpb25zLE1pKTtpZihvLnNvbWUoZnVuY3Rpb24odCxlKXtyZXR1cm4hTih0LHJbZV0pfSkpe3ZhciBpPXQubXVsdGlwbGU/ZS52YWx1ZS5zb21lKGZ1bmN0aW9uKHQpe3JldHVybiBQaSh0LG8pfSk6ZS52YWx1ZSE9PWUub2xkVmFsdWUmJlBpKGUudmFsdWUsbyk7aSYmTGkodCwiY2hhbmdlIil9fX19O2Z1bmN0aW9uIFRpKHQsZSxuKXtJaSh0LGUsbiksKFF8fHR0KSYmc2V0VGltZW91dChmdW5jdGlvbigpe0lpKHQsZSxuKX0sMCl9ZnVuY3Rpb24gSWkodCxlLG4pe3ZhciByPWUudmFsdWUsbz10Lm11bHRpcGxlO2lmKCFvfHxBcnJheS5pc0FycmF5KHIpKXtmb3IodmFyIGksYSxzPTAsYz10Lm9wdGlvbnMubGVuZ3RoO3M8YztzKyspaWYoYT10Lm9wdGlvbnNbc10sbylpPUQocixNaShhKSk+LTEsYS5zZWxlY3RlZCE9PWkmJihhLnNlbGVjdGVkPWkpO2Vsc2UgaWYoTihNaShhKSxyKSlyZXR1cm4gdm9pZCh0LnNlbGVjdGVkSW5kZXghPT1zJiYodC5zZWxlY3RlZEluZGV4PXMpKTtvfHwodC5zZWxlY3RlZEluZGV4PS0xKX19ZnVuY3Rpb24gUGkodCxlKXtyZXR1cm4gZS5ldmVyeShmdW5jdGlvbihlKXtyZXR1cm4hTihlLHQpfSl9ZnVuY3Rpb24gTWkodCl7cmV0dXJuIl92YWx1ZSJpbiB0P3QuX3ZhbHVlOnQudmFsdWV9ZnVuY3Rpb24gTmkodCl7dC50YXJnZXQuY29tcG9zaW5nPSEwfWZ1bmN0aW9uIERpKHQpe3QudGFyZ2V0LmNvbXBvc2luZyYmKHQudGFyZ2V0LmNvbXBvc2luZz0hMSxMaSh0LnRhcmdldCwiaW5wdXQiKSl9ZnVuY3Rpb24gTGkodCxlKXt2YXIgbj1kb2N1bWVudC5jcmVhdGVFdmVudCgiSFRNTEV2ZW50cyIpO24uaW5pdEV2ZW50KGUsITAsITApLHQuZGlzcGF0Y2hFdmVudChuKX1mdW5jdGlvbiBGaSh0KXtyZXR1cm4hdC5jb21wb25lbnRJbnN0YW5jZXx8dC5kYXRhJiZ0LmRhdGEudHJhbnNpdGlvbj90OkZpKHQuY29tcG9uZW50SW5zdGFuY2UuX3Zub2RlKX12YXIgUmk9e2JpbmQ6ZnVuY3Rpb24odCxlLG4pe3ZhciByPWUudmFsdWU7bj1GaShuKTt2YXIgbz1uLmRhdGEmJm4uZGF0YS50cmFuc2l0aW9uLGk9dC5fX3ZPcmlnaW5hbERpc3BsYXk9Im5vbmUiPT09dC5zdHlsZS5kaXNwbGF5PyIiOnQuc3R5bGUuZGlzcGxheTtyJiZvPyhuLmRhdGEuc2hvdz0hMCx3aShuLGZ1bmN0aW9uKCl7dC5zdHlsZS5kaXNwbGF5PWl9KSk6dC5zdHlsZS5kaXNwbGF5PXI/aToibm9uZSJ9LHVwZGF0ZTpmdW5jdGlvbih0LGUsbil7dmFyIHI9ZS52YWx1ZSxvPWUub2xkVmFsdWU7aWYoIXIhPT0hbyl7bj1GaShuKTt2YXIgaT1uLmRhdGEmJm4uZGF0YS50cmFuc2l0aW9uO2k/KG4uZGF0YS5zaG93PSEwLHI/d2kobixmdW5jdGlvbigpe3Quc3R5bGUuZGlzcGxheT10Ll9fdk9yaWdpbmFsRGlzcGxheX0pOnhpKG4sZnVuY3Rpb24oKXt0LnN0eWxlLmRpc3BsYXk9Im5vbmUifSkpOnQuc3R5bGUuZGlzcGxheT1yP3QuX192T3JpZ2luYWxEaXNwbGF5OiJub25lIn19LHVuYmluZDpmdW5jdGlvbih0LGUsbixyLG8pe298fCh0LnN0eWxlLmRpc3BsYXk9dC5fX3ZPcmlnaW5hbERpc3BsYXkpfX0sVWk9e21vZGVsOkVpLHNob3c6Uml9LEJpPXtuYW1lOlN0cmluZyxhcHBlYXI6Qm9vbGVhbixjc3M6Qm9vbGVhbixtb2RlOlN0cmluZyx0eXBlOlN0cmluZyxlbnRlckNsYXNzOlN0cmluZyxsZWF2ZUNsYXNzOlN0cmluZyxlbnRlclRvQ2xhc3M6U3RyaW5nLGxlYXZlVG9DbGFzczpTdHJpbmcsZW50ZXJBY3RpdmVDbGFzczpTdHJpbmcsbGVhdmVBY3RpdmVDbGFzczpTdHJpbmcsYXBwZWFyQ2xhc3M6U3RyaW5nLGFwcGVhckFjdGl2ZUNsYXNzOlN0cmluZyxhcHBlYXJUb0NsYXNzOlN0cmluZyxkdXJhdGlvbjpbTnVtYmVyLFN0cmluZyxPYmplY3RdfTtmdW5jdGlvbiBWaSh0KXt2YXIgZT10JiZ0LmNvbXBvbmVudE9wdGlvbnM7cmV0dXJuIGUmJmUuQ3Rvci5vcHRpb25zLmFic3RyYWN0P1ZpKGplKGUuY2hpbGRyZW4pKTp0fWZ1bmN0aW9uIEhpKHQpe3ZhciBlPXt9LG49dC4kb3B0aW9ucztmb3IodmFyIHIgaW4gbi5wcm9wc0RhdGEpZVtyXT10W3JdO3ZhciBvPW4uX3BhcmVudExpc3RlbmVycztmb3IodmFyIGkgaW4gbyllW3goaSldPW9baV07cmV0dXJuIGV9ZnVuY3Rpb24gemkodCxlKXtpZigvXGQta2VlcC1hbGl2ZSQvLnRlc3QoZS50YWcpKXJldHVybiB0KCJrZWVwLWFsaXZlIix7cHJvcHM6ZS5jb21wb25lbnRPcHRpb25zLnByb3BzRGF0YX0pfWZ1bmN0aW9uIFdpKHQpe3doaWxlKHQ9dC5wYXJlbnQpaWYodC5kYXRhLnRyYW5zaXRpb24pcmV0dXJuITB9ZnVuY3Rpb24gS2kodCxlKXtyZXR1cm4gZS5rZXk9PT10LmtleSYmZS50YWc9PT10LnRhZ312YXIgcWk9e25hbWU6InRyYW5zaXRpb24iLHByb3BzOkJpLGFic3RyYWN0OiEwLHJlbmRlcjpmdW5jdGlvbih0KXt2YXIgZT10aGlzLG49dGhpcy4kc2xvdHMuZGVmYXVsdDtpZihuJiYobj1uLmZpbHRlcihmdW5jdGlvbih0KXtyZXR1cm4gdC50YWd8fFNlKHQpfSksbi5sZW5ndGgpKXswO3ZhciByPXRoaXMubW9kZTswO3ZhciBvPW5bMF07aWYoV2kodGhpcy4kdm5vZGUpKXJldHVybiBvO3ZhciBpPVZpKG8pO2lmKCFpKXJldHVybiBvO2lmKHRoaXMuX2xlYXZpbmcpcmV0dXJuIHppKHQsbyk7dmFyIGE9Il9fdHJhbnNpdGlvbi0iK3RoaXMuX3VpZCsiLSI7aS5rZXk9bnVsbD09aS5rZXk/aS5pc0NvbW1lbnQ/YSsiY29tbWVudCI6YStpLnRhZzpzKGkua2V5KT8wPT09U3RyaW5nKGkua2V5KS5pbmRleE9mKGEpP2kua2V5OmEraS5rZXk6aS5rZXk7dmFyIGM9KGkuZGF0YXx8KGkuZGF0YT17fSkpLnRyYW5zaXRpb249SGkodGhpcyksdT10aGlzLl92bm9kZSxmPVZpKHUpO2lmKGkuZGF0YS5kaXJlY3RpdmVzJiZpLmRhdGEuZGlyZWN0aXZlcy5zb21lKGZ1bmN0aW9uKHQpe3JldHVybiJzaG93Ij09PXQubmFtZX0pJiYoaS5kYXRhLnNob3c9ITApLGYmJmYuZGF0YSYmIUtpKGksZikmJiFTZShmKSYmKCFmLmNvbXBvbmVudEluc3RhbmNlfHwhZi5jb21wb25lbnRJbnN0YW5jZS5fdm5vZGUuaXNDb21tZW50KSl7dmFyIGw9Zi5kYXRhLnRyYW5zaXRpb249RSh7fSxjKTtpZigib3V0LWluIj09PXIpcmV0dXJuIHRoaXMuX2xlYXZpbmc9ITAsX2UobCwiYWZ0ZXJMZWF2ZSIsZnVuY3Rpb24oKXtlLl9sZWF2aW5nPSExLGUuJGZvcmNlVXBkYXRlKCl9KSx6aSh0LG8pO2lmKCJpbi1vdXQiPT09cil7aWYoU2UoaSkpcmV0dXJuIHU7dmFyIHAsZD1mdW5jdGlvbigpe3AoKX07X2UoYywiYWZ0ZXJFbnRlciIsZCksX2UoYywiZW50ZXJDYW5jZWxsZWQiLGQpLF9lKGwsImRlbGF5TGVhdmUiLGZ1bmN0aW9uKHQpe3A9dH0pfX1yZXR1cm4gb319fSxYaT1FKHt0YWc6U3RyaW5nLG1vdmVDbGFzczpTdHJpbmd9LEJpKTtkZWxldGUgWGkubW9kZTt2YXIgR2k9e3Byb3BzOlhpLHJlbmRlcjpmdW5jdGlvbih0KXtmb3IodmFyIGU9dGhpcy50YWd8fHRoaXMuJHZub2RlLmRhdGEudGFnfHwic3BhbiIsbj1PYmplY3QuY3JlYXRlKG51bGwpLHI9dGhpcy5wcmV2Q2hpbGRyZW49dGhpcy5jaGlsZHJlbixvPXRoaXMuJHNsb3RzLmRlZmF1bHR8fFtdLGk9dGhpcy5jaGlsZHJlbj1bXSxhPUhpKHRoaXMpLHM9MDtzPG8ubGVuZ3RoO3MrKyl7dmFyIGM9b1tzXTtpZihjLnRhZylpZihudWxsIT1jLmtleSYmMCE9PVN0cmluZyhjLmtleSkuaW5kZXhPZigiX192bGlzdCIpKWkucHVzaChjKSxuW2Mua2V5XT1jLChjLmRhdGF8fChjLmRhdGE9e30pKS50cmFuc2l0aW9uPWE7ZWxzZTt9aWYocil7Zm9yKHZhciB1PVtdLGY9W10sbD0wO2w8ci5sZW5ndGg7bCsrKXt2YXIgcD1yW2xdO3AuZGF0YS50cmFuc2l0aW9uPWEscC5kYXRhLnBvcz1wLmVsbS5nZXRCb3VuZGluZ0NsaWVudFJlY3QoKSxuW3Aua2V5XT91LnB1c2gocCk6Zi5wdXNoKHApfXRoaXMua2VwdD10KGUsbnVsbCx1KSx0aGlzLnJlbW92ZWQ9Zn1yZXR1cm4gdChlLG51bGwsaSl9LGJlZm9yZVVwZGF0ZTpmdW5jdGlvbigpe3RoaXMuX19wYXRjaF9fKHRoaXMuX3Zub2RlLHRoaXMua2VwdCwhMSwhMCksdGhpcy5fdm5vZGU9dGhpcy5rZXB0fSx1cGRhdGVkOmZ1bmN0aW9uKCl7dmFyIHQ9dGhpcy5wcmV2Q2hpbGRyZW4sZT10aGlzLm1vdmVDbGFzc3x8KHRoaXMubmFtZXx8InYiKSsiLW1vdmUiO3QubGVuZ3RoJiZ0aGlzLmhhc01vdmUodFswXS5lbG0sZSkmJih0LmZvckVhY2goSmkpLHQuZm9yRWFjaChaaSksdC5mb3JFYWNoKFFpKSx0aGlzLl9yZWZsb3c9ZG9jdW1lbnQuYm9keS5vZmZzZXRIZWlnaHQsdC5mb3JFYWNoKGZ1bmN0aW9uKHQpe2lmKHQuZGF0YS5tb3ZlZCl7dmFyIG49dC5lbG0scj1uLnN0eWxlO3ZpKG4sZSksci50cmFuc2Zvcm09ci5XZWJraXRUcmFuc2Zvcm09ci50cmFuc2l0aW9uRHVyYXRpb249IiIsbi5hZGRFdmVudExpc3RlbmVyKHVpLG4uX21vdmVDYj1mdW5jdGlvbiB0KHIpe3ImJiEvdHJhbnNmb3JtJC8udGVzdChyLnByb3BlcnR5TmFtZSl8fChuLnJlbW92ZUV2ZW50TGlzdGVuZXIodWksdCksbi5fbW92ZUNiPW51bGwsaGkobixlKSl9KX19KSl9LG1ldGhvZHM6e2hhc01vdmU6ZnVuY3Rpb24odCxlKXtpZighaWkpcmV0dXJuITE7aWYodGhpcy5faGFzTW92ZSlyZXR1cm4gdGhpcy5faGFzTW92ZTt2YXIgbj10LmNsb25lTm9kZSgpO3QuX3RyYW5zaXRpb25DbGFzc2VzJiZ0Ll90cmFuc2l0aW9uQ2xhc3Nlcy5mb3JFYWNoKGZ1bmN0aW9uKHQpe25pKG4sdCl9KSxlaShuLGUpLG4uc3R5bGUuZGlzcGxheT0ibm9uZSIsdGhpcy4kZWwuYXBwZW5kQ2hpbGQobik7dmFyIHI9X2kobik7cmV0dXJuIHRoaXMuJGVsLnJlbW92ZUNoaWxkKG4pLHRoaXMuX2hhc01vdmU9ci5oYXNUcmFuc2Zvcm19fX07ZnVuY3Rpb24gSmkodCl7dC5lbG0uX21vdmVDYiYmdC5lbG0uX21vdmVDYigpLHQuZWxtLl9lbnRlckNiJiZ0LmVsbS5fZW50ZXJDYigpfWZ1bmN0aW9uIFppKHQpe3QuZGF0YS5uZXdQb3M9dC5lbG0uZ2V0Qm91bmRpbmdDbGllbnRSZWN0KCl9ZnVuY3Rpb24gUWkodCl7dmFyIGU9dC5kYXRhLnBvcyxuPXQuZGF0YS5uZXdQb3Mscj1lLmxlZnQtbi5sZWZ0LG89ZS50b3Atbi50b3A7aWYocnx8byl7dC5kYXRhLm1vdmVkPSEwO3ZhciBpPXQuZWxtLnN0eWxlO2kudHJhbnNmb3JtPWkuV2Via2l0VHJhbnNmb3JtPSJ0cmFuc2xhdGUoIityKyJweCwiK28rInB4KSIsaS50cmFuc2l0aW9uRHVyYXRpb249IjBzIn19dmFyIFlpPXtUcmFuc2l0aW9uOnFpLFRyYW5zaXRpb25Hcm91cDpHaX07c3IuY29uZmlnLm11c3RVc2VQcm9wPUFyLHNyLmNvbmZpZy5pc1Jlc2VydmVkVGFnPUJyLHNyLmNvbmZpZy5pc1Jlc2VydmVkQXR0cj14cixzci5jb25maWcuZ2V0VGFnTmFtZXNwYWNlPVZyLHNyLmNvbmZpZy5pc1Vua25vd25FbGVtZW50PXpyLEUoc3Iub3B0aW9ucy5kaXJlY3RpdmVzLFVpKSxFKHNyLm9wdGlvbnMuY29tcG9uZW50cyxZaSksc3IucHJvdG90eXBlLl9fcGF0Y2hfXz1YP2ppOkksc3IucHJvdG90eXBlLiRtb3VudD1mdW5jdGlvbih0LGUpe3JldHVybiB0PXQmJlg/S3IodCk6dm9pZCAwLEJlKHRoaXMsdCxlKX0sWCYmc2V0VGltZW91dChmdW5jdGlvbigpe0IuZGV2dG9vbHMmJmF0JiZhdC5lbWl0KCJpbml0Iixzcil9LDApLGVbImEiXT1zcn0pLmNhbGwodGhpcyxuKCJjOGJhIikpfSwiMmI0YyI6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oIjU1MzciKSgid2tzIiksbz1uKCJjYTVhIiksaT1uKCI3NzI2IikuU3ltYm9sLGE9ImZ1bmN0aW9uIj09dHlwZW9mIGkscz10LmV4cG9ydHM9ZnVuY3Rpb24odCl7cmV0dXJuIHJbdF18fChyW3RdPWEmJmlbdF18fChhP2k6bykoIlN5bWJvbC4iK3QpKX07cy5zdG9yZT1yfSwiMmQwMCI6ZnVuY3Rpb24odCxlKXt0LmV4cG9ydHM9ITF9LCIyZDk1IjpmdW5jdGlvbih0LGUpe3ZhciBuPXt9LnRvU3RyaW5nO3QuZXhwb3J0cz1mdW5jdGlvbih0KXtyZXR1cm4gbi5jYWxsKHQpLnNsaWNlKDgsLTEpfX0sIjMxZjQiOmZ1bmN0aW9uKHQsZSl7dC5leHBvcnRzPWZ1bmN0aW9uKHQsZSxuKXt2YXIgcj12b2lkIDA9PT1uO3N3aXRjaChlLmxlbmd0aCl7Y2FzZSAwOnJldHVybiByP3QoKTp0LmNhbGwobik7Y2FzZSAxOnJldHVybiByP3QoZVswXSk6dC5jYWxsKG4sZVswXSk7Y2FzZSAyOnJldHVybiByP3QoZVswXSxlWzFdKTp0LmNhbGwobixlWzBdLGVbMV0pO2Nhc2UgMzpyZXR1cm4gcj90KGVbMF0sZVsxXSxlWzJdKTp0LmNhbGwobixlWzBdLGVbMV0sZVsyXSk7Y2FzZSA0OnJldHVybiByP3QoZVswXSxlWzFdLGVbMl0sZVszXSk6dC5jYWxsKG4sZVswXSxlWzFdLGVbMl0sZVszXSl9cmV0dXJuIHQuYXBwbHkobixlKX19LCIzMmU5IjpmdW5jdGlvbih0LGUsbil7dmFyIHI9bigiODZjYyIpLG89bigiNDYzMCIpO3QuZXhwb3J0cz1uKCI5ZTFlIik/ZnVuY3Rpb24odCxlLG4pe3JldHVybiByLmYodCxlLG8oMSxuKSl9OmZ1bmN0aW9uKHQsZSxuKXtyZXR1cm4gdFtlXT1uLHR9fSwiMzNhNCI6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oIjg0ZjIiKSxvPW4oIjJiNGMiKSgiaXRlcmF0b3IiKSxpPUFycmF5LnByb3RvdHlwZTt0LmV4cG9ydHM9ZnVuY3Rpb24odCl7cmV0dXJuIHZvaWQgMCE9PXQmJihyLkFycmF5PT09dHx8aVtvXT09PXQpfX0sIjM4ZmQiOmZ1bmN0aW9uKHQsZSxuKXt2YXIgcj1uKCI2OWE4Iiksbz1uKCI0YmY4IiksaT1uKCI2MTNiIikoIklFX1BST1RPIiksYT1PYmplY3QucHJvdG90eXBlO3QuZXhwb3J0cz1PYmplY3QuZ2V0UHJvdG90eXBlT2Z8fGZ1bmN0aW9uKHQpe3JldHVybiB0PW8odCkscih0LGkpP3RbaV06ImZ1bmN0aW9uIj09dHlwZW9mIHQuY29uc3RydWN0b3ImJnQgaW5zdGFuY2VvZiB0LmNvbnN0cnVjdG9yP3QuY29uc3RydWN0b3IucHJvdG90eXBlOnQgaW5zdGFuY2VvZiBPYmplY3Q/YTpudWxsfX0sIjQxYTAiOmZ1bmN0aW9uKHQsZSxuKXsidXNlIHN0cmljdCI7dmFyIHI9bigiMmFlYiIpLG89bigiNDYzMCIpLGk9bigiN2YyMCIpLGE9e307bigiMzJlOSIpKGEsbigiMmI0YyIpKCJpdGVyYXRvciIpLGZ1bmN0aW9uKCl7cmV0dXJuIHRoaXN9KSx0LmV4cG9ydHM9ZnVuY3Rpb24odCxlLG4pe3QucHJvdG90eXBlPXIoYSx7bmV4dDpvKDEsbil9KSxpKHQsZSsiIEl0ZXJhdG9yIil9fSw0NTg4OmZ1bmN0aW9uKHQsZSl7dmFyIG49TWF0aC5jZWlsLHI9TWF0aC5mbG9vcjt0LmV4cG9ydHM9ZnVuY3Rpb24odCl7cmV0dXJuIGlzTmFOKHQ9K3QpPzA6KHQ+MD9yOm4pKHQpfX0sNDYzMDpmdW5jdGlvbih0LGUpe3QuZXhwb3J0cz1mdW5jdGlvbih0LGUpe3JldHVybntlbnVtZXJhYmxlOiEoMSZ0KSxjb25maWd1cmFibGU6ISgyJnQpLHdyaXRhYmxlOiEoNCZ0KSx2YWx1ZTplfX19LCI0YTU5IjpmdW5jdGlvbih0LGUsbil7dmFyIHI9bigiOWI0MyIpLG89bigiMWZhOCIpLGk9bigiMzNhNCIpLGE9bigiY2I3YyIpLHM9bigiOWRlZiIpLGM9bigiMjdlZSIpLHU9e30sZj17fTtlPXQuZXhwb3J0cz1mdW5jdGlvbih0LGUsbixsLHApe3ZhciBkLHYsaCxtLHk9cD9mdW5jdGlvbigpe3JldHVybiB0fTpjKHQpLF89cihuLGwsZT8yOjEpLGc9MDtpZigiZnVuY3Rpb24iIT10eXBlb2YgeSl0aHJvdyBUeXBlRXJyb3IodCsiIGlzIG5vdCBpdGVyYWJsZSEiKTtpZihpKHkpKXtmb3IoZD1zKHQubGVuZ3RoKTtkPmc7ZysrKWlmKG09ZT9fKGEodj10W2ddKVswXSx2WzFdKTpfKHRbZ10pLG09PT11fHxtPT09ZilyZXR1cm4gbX1lbHNlIGZvcihoPXkuY2FsbCh0KTshKHY9aC5uZXh0KCkpLmRvbmU7KWlmKG09byhoLF8sdi52YWx1ZSxlKSxtPT09dXx8bT09PWYpcmV0dXJuIG19O2UuQlJFQUs9dSxlLlJFVFVSTj1mfSwiNGJmOCI6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oImJlMTMiKTt0LmV4cG9ydHM9ZnVuY3Rpb24odCl7cmV0dXJuIE9iamVjdChyKHQpKX19LCI1NTFjIjpmdW5jdGlvbih0LGUsbil7InVzZSBzdHJpY3QiO3ZhciByLG8saSxhLHM9bigiMmQwMCIpLGM9bigiNzcyNiIpLHU9bigiOWI0MyIpLGY9bigiMjNjNiIpLGw9bigiNWNhMSIpLHA9bigiZDNmNCIpLGQ9bigiZDhlOCIpLHY9bigiZjYwNSIpLGg9bigiNGE1OSIpLG09bigiZWJkNiIpLHk9bigiMTk5MSIpLnNldCxfPW4oIjgwNzkiKSgpLGc9bigiYTViOCIpLGI9bigiOWM4MCIpLHc9bigiYTI1ZiIpLHg9bigiYmNhYSIpLEM9IlByb21pc2UiLEE9Yy5UeXBlRXJyb3IsTz1jLnByb2Nlc3MsJD1PJiZPLnZlcnNpb25zLGs9JCYmJC52OHx8IiIsUz1jW0NdLGo9InByb2Nlc3MiPT1mKE8pLEU9ZnVuY3Rpb24oKXt9LFQ9bz1nLmYsST0hIWZ1bmN0aW9uKCl7dHJ5e3ZhciB0PVMucmVzb2x2ZSgxKSxlPSh0LmNvbnN0cnVjdG9yPXt9KVtuKCIyYjRjIikoInNwZWNpZXMiKV09ZnVuY3Rpb24odCl7dChFLEUpfTtyZXR1cm4oanx8ImZ1bmN0aW9uIj09dHlwZW9mIFByb21pc2VSZWplY3Rpb25FdmVudCkmJnQudGhlbihFKWluc3RhbmNlb2YgZSYmMCE9PWsuaW5kZXhPZigiNi42IikmJi0xPT09dy5pbmRleE9mKCJDaHJvbWUvNjYiKX1jYXRjaCh0KXt9fSgpLFA9ZnVuY3Rpb24odCl7dmFyIGU7cmV0dXJuISghcCh0KXx8ImZ1bmN0aW9uIiE9dHlwZW9mKGU9dC50aGVuKSkmJmV9LE09ZnVuY3Rpb24odCxlKXtpZighdC5fbil7dC5fbj0hMDt2YXIgbj10Ll9jO18oZnVuY3Rpb24oKXt2YXIgcj10Ll92LG89MT09dC5fcyxpPTAsYT1mdW5jdGlvbihlKXt2YXIgbixpLGEscz1vP2Uub2s6ZS5mYWlsLGM9ZS5yZXNvbHZlLHU9ZS5yZWplY3QsZj1lLmRvbWFpbjt0cnl7cz8ob3x8KDI9PXQuX2gmJkwodCksdC5faD0xKSwhMD09PXM/bj1yOihmJiZmLmVudGVyKCksbj1zKHIpLGYmJihmLmV4aXQoKSxhPSEwKSksbj09PWUucHJvbWlzZT91KEEoIlByb21pc2UtY2hhaW4gY3ljbGUiKSk6KGk9UChuKSk/aS5jYWxsKG4sYyx1KTpjKG4pKTp1KHIpfWNhdGNoKHQpe2YmJiFhJiZmLmV4aXQoKSx1KHQpfX07d2hpbGUobi5sZW5ndGg+aSlhKG5baSsrXSk7dC5fYz1bXSx0Ll9uPSExLGUmJiF0Ll9oJiZOKHQpfSl9fSxOPWZ1bmN0aW9uKHQpe3kuY2FsbChjLGZ1bmN0aW9uKCl7dmFyIGUsbixyLG89dC5fdixpPUQodCk7aWYoaSYmKGU9YihmdW5jdGlvbigpe2o/Ty5lbWl0KCJ1bmhhbmRsZWRSZWplY3Rpb24iLG8sdCk6KG49Yy5vbnVuaGFuZGxlZHJlamVjdGlvbik/bih7cHJvbWlzZTp0LHJlYXNvbjpvfSk6KHI9Yy5jb25zb2xlKSYmci5lcnJvciYmci5lcnJvcigiVW5oYW5kbGVkIHByb21pc2UgcmVqZWN0aW9uIixvKX0pLHQuX2g9anx8RCh0KT8yOjEpLHQuX2E9dm9pZCAwLGkmJmUuZSl0aHJvdyBlLnZ9KX0sRD1mdW5jdGlvbih0KXtyZXR1cm4gMSE9PXQuX2gmJjA9PT0odC5fYXx8dC5fYykubGVuZ3RofSxMPWZ1bmN0aW9uKHQpe3kuY2FsbChjLGZ1bmN0aW9uKCl7dmFyIGU7aj9PLmVtaXQoInJlamVjdGlvbkhhbmRsZWQiLHQpOihlPWMub25yZWplY3Rpb25oYW5kbGVkKSYmZSh7cHJvbWlzZTp0LHJlYXNvbjp0Ll92fSl9KX0sRj1mdW5jdGlvbih0KXt2YXIgZT10aGlzO2UuX2R8fChlLl9kPSEwLGU9ZS5fd3x8ZSxlLl92PXQsZS5fcz0yLGUuX2F8fChlLl9hPWUuX2Muc2xpY2UoKSksTShlLCEwKSl9LFI9ZnVuY3Rpb24odCl7dmFyIGUsbj10aGlzO2lmKCFuLl9kKXtuLl9kPSEwLG49bi5fd3x8bjt0cnl7aWYobj09PXQpdGhyb3cgQSgiUHJvbWlzZSBjYW4ndCBiZSByZXNvbHZlZCBpdHNlbGYiKTsoZT1QKHQpKT9fKGZ1bmN0aW9uKCl7dmFyIHI9e193Om4sX2Q6ITF9O3RyeXtlLmNhbGwodCx1KFIsciwxKSx1KEYsciwxKSl9Y2F0Y2godCl7Ri5jYWxsKHIsdCl9fSk6KG4uX3Y9dCxuLl9zPTEsTShuLCExKSl9Y2F0Y2godCl7Ri5jYWxsKHtfdzpuLF9kOiExfSx0KX19fTtJfHwoUz1mdW5jdGlvbih0KXt2KHRoaXMsUyxDLCJfaCIpLGQodCksci5jYWxsKHRoaXMpO3RyeXt0KHUoUix0aGlzLDEpLHUoRix0aGlzLDEpKX1jYXRjaCh0KXtGLmNhbGwodGhpcyx0KX19LHI9ZnVuY3Rpb24odCl7dGhpcy5fYz1bXSx0aGlzLl9hPXZvaWQgMCx0aGlzLl9zPTAsdGhpcy5fZD0hMSx0aGlzLl92PXZvaWQgMCx0aGlzLl9oPTAsdGhpcy5fbj0hMX0sci5wcm90b3R5cGU9bigiZGNiYyIpKFMucHJvdG90eXBlLHt0aGVuOmZ1bmN0aW9uKHQsZSl7dmFyIG49VChtKHRoaXMsUykpO3JldHVybiBuLm9rPSJmdW5jdGlvbiIhPXR5cGVvZiB0fHx0LG4uZmFpbD0iZnVuY3Rpb24iPT10eXBlb2YgZSYmZSxuLmRvbWFpbj1qP08uZG9tYWluOnZvaWQgMCx0aGlzLl9jLnB1c2gobiksdGhpcy5fYSYmdGhpcy5fYS5wdXNoKG4pLHRoaXMuX3MmJk0odGhpcywhMSksbi5wcm9taXNlfSxjYXRjaDpmdW5jdGlvbih0KXtyZXR1cm4gdGhpcy50aGVuKHZvaWQgMCx0KX19KSxpPWZ1bmN0aW9uKCl7dmFyIHQ9bmV3IHI7dGhpcy5wcm9taXNlPXQsdGhpcy5yZXNvbHZlPXUoUix0LDEpLHRoaXMucmVqZWN0PXUoRix0LDEpfSxnLmY9VD1mdW5jdGlvbih0KXtyZXR1cm4gdD09PVN8fHQ9PT1hP25ldyBpKHQpOm8odCl9KSxsKGwuRytsLlcrbC5GKiFJLHtQcm9taXNlOlN9KSxuKCI3ZjIwIikoUyxDKSxuKCI3YTU2IikoQyksYT1uKCI4Mzc4IilbQ10sbChsLlMrbC5GKiFJLEMse3JlamVjdDpmdW5jdGlvbih0KXt2YXIgZT1UKHRoaXMpLG49ZS5yZWplY3Q7cmV0dXJuIG4odCksZS5wcm9taXNlfX0pLGwobC5TK2wuRiooc3x8IUkpLEMse3Jlc29sdmU6ZnVuY3Rpb24odCl7cmV0dXJuIHgocyYmdGhpcz09PWE/Uzp0aGlzLHQpfX0pLGwobC5TK2wuRiohKEkmJm4oIjVjYzUiKShmdW5jdGlvbih0KXtTLmFsbCh0KVsiY2F0Y2giXShFKX0pKSxDLHthbGw6ZnVuY3Rpb24odCl7dmFyIGU9dGhpcyxuPVQoZSkscj1uLnJlc29sdmUsbz1uLnJlamVjdCxpPWIoZnVuY3Rpb24oKXt2YXIgbj1bXSxpPTAsYT0xO2godCwhMSxmdW5jdGlvbih0KXt2YXIgcz1pKyssYz0hMTtuLnB1c2godm9pZCAwKSxhKyssZS5yZXNvbHZlKHQpLnRoZW4oZnVuY3Rpb24odCl7Y3x8KGM9ITAsbltzXT10LC0tYXx8cihuKSl9LG8pfSksLS1hfHxyKG4pfSk7cmV0dXJuIGkuZSYmbyhpLnYpLG4ucHJvbWlzZX0scmFjZTpmdW5jdGlvbih0KXt2YXIgZT10aGlzLG49VChlKSxyPW4ucmVqZWN0LG89YihmdW5jdGlvbigpe2godCwhMSxmdW5jdGlvbih0KXtlLnJlc29sdmUodCkudGhlbihuLnJlc29sdmUscil9KX0pO3JldHVybiBvLmUmJnIoby52KSxuLnByb21pc2V9fSl9LDU1Mzc6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oIjgzNzgiKSxvPW4oIjc3MjYiKSxpPSJfX2NvcmUtanNfc2hhcmVkX18iLGE9b1tpXXx8KG9baV09e30pOyh0LmV4cG9ydHM9ZnVuY3Rpb24odCxlKXtyZXR1cm4gYVt0XXx8KGFbdF09dm9pZCAwIT09ZT9lOnt9KX0pKCJ2ZXJzaW9ucyIsW10pLnB1c2goe3ZlcnNpb246ci52ZXJzaW9uLG1vZGU6bigiMmQwMCIpPyJwdXJlIjoiZ2xvYmFsIixjb3B5cmlnaHQ6IsKpIDIwMTggRGVuaXMgUHVzaGthcmV2ICh6bG9pcm9jay5ydSkifSl9LCI1Y2ExIjpmdW5jdGlvbih0LGUsbil7dmFyIHI9bigiNzcyNiIpLG89bigiODM3OCIpLGk9bigiMzJlOSIpLGE9bigiMmFiYSIpLHM9bigiOWI0MyIpLGM9InByb3RvdHlwZSIsdT1mdW5jdGlvbih0LGUsbil7dmFyIGYsbCxwLGQsdj10JnUuRixoPXQmdS5HLG09dCZ1LlMseT10JnUuUCxfPXQmdS5CLGc9aD9yOm0/cltlXXx8KHJbZV09e30pOihyW2VdfHx7fSlbY10sYj1oP286b1tlXXx8KG9bZV09e30pLHc9YltjXXx8KGJbY109e30pO2ZvcihmIGluIGgmJihuPWUpLG4pbD0hdiYmZyYmdm9pZCAwIT09Z1tmXSxwPShsP2c6bilbZl0sZD1fJiZsP3MocCxyKTp5JiYiZnVuY3Rpb24iPT10eXBlb2YgcD9zKEZ1bmN0aW9uLmNhbGwscCk6cCxnJiZhKGcsZixwLHQmdS5VKSxiW2ZdIT1wJiZpKGIsZixkKSx5JiZ3W2ZdIT1wJiYod1tmXT1wKX07ci5jb3JlPW8sdS5GPTEsdS5HPTIsdS5TPTQsdS5QPTgsdS5CPTE2LHUuVz0zMix1LlU9NjQsdS5SPTEyOCx0LmV4cG9ydHM9dX0sIjVjYzUiOmZ1bmN0aW9uKHQsZSxuKXt2YXIgcj1uKCIyYjRjIikoIml0ZXJhdG9yIiksbz0hMTt0cnl7dmFyIGk9WzddW3JdKCk7aVsicmV0dXJuIl09ZnVuY3Rpb24oKXtvPSEwfSxBcnJheS5mcm9tKGksZnVuY3Rpb24oKXt0aHJvdyAyfSl9Y2F0Y2godCl7fXQuZXhwb3J0cz1mdW5jdGlvbih0LGUpe2lmKCFlJiYhbylyZXR1cm4hMTt2YXIgbj0hMTt0cnl7dmFyIGk9WzddLGE9aVtyXSgpO2EubmV4dD1mdW5jdGlvbigpe3JldHVybntkb25lOm49ITB9fSxpW3JdPWZ1bmN0aW9uKCl7cmV0dXJuIGF9LHQoaSl9Y2F0Y2godCl7fXJldHVybiBufX0sIjYxM2IiOmZ1bmN0aW9uKHQsZSxuKXt2YXIgcj1uKCI1NTM3IikoImtleXMiKSxvPW4oImNhNWEiKTt0LmV4cG9ydHM9ZnVuY3Rpb24odCl7cmV0dXJuIHJbdF18fChyW3RdPW8odCkpfX0sIjYyNmEiOmZ1bmN0aW9uKHQsZSxuKXt2YXIgcj1uKCIyZDk1Iik7dC5leHBvcnRzPU9iamVjdCgieiIpLnByb3BlcnR5SXNFbnVtZXJhYmxlKDApP09iamVjdDpmdW5jdGlvbih0KXtyZXR1cm4iU3RyaW5nIj09cih0KT90LnNwbGl0KCIiKTpPYmplY3QodCl9fSw2ODIxOmZ1bmN0aW9uKHQsZSxuKXt2YXIgcj1uKCI2MjZhIiksbz1uKCJiZTEzIik7dC5leHBvcnRzPWZ1bmN0aW9uKHQpe3JldHVybiByKG8odCkpfX0sIjY5YTgiOmZ1bmN0aW9uKHQsZSl7dmFyIG49e30uaGFzT3duUHJvcGVydHk7dC5leHBvcnRzPWZ1bmN0aW9uKHQsZSl7cmV0dXJuIG4uY2FsbCh0LGUpfX0sIjZhOTkiOmZ1bmN0aW9uKHQsZSxuKXt2YXIgcj1uKCJkM2Y0Iik7dC5leHBvcnRzPWZ1bmN0aW9uKHQsZSl7aWYoIXIodCkpcmV0dXJuIHQ7dmFyIG4sbztpZihlJiYiZnVuY3Rpb24iPT10eXBlb2Yobj10LnRvU3RyaW5nKSYmIXIobz1uLmNhbGwodCkpKXJldHVybiBvO2lmKCJmdW5jdGlvbiI9PXR5cGVvZihuPXQudmFsdWVPZikmJiFyKG89bi5jYWxsKHQpKSlyZXR1cm4gbztpZighZSYmImZ1bmN0aW9uIj09dHlwZW9mKG49dC50b1N0cmluZykmJiFyKG89bi5jYWxsKHQpKSlyZXR1cm4gbzt0aHJvdyBUeXBlRXJyb3IoIkNhbid0IGNvbnZlcnQgb2JqZWN0IHRvIHByaW1pdGl2ZSB2YWx1ZSIpfX0sNzcyNjpmdW5jdGlvbih0LGUpe3ZhciBuPXQuZXhwb3J0cz0idW5kZWZpbmVkIiE9dHlwZW9mIHdpbmRvdyYmd2luZG93Lk1hdGg9PU1hdGg/d2luZG93OiJ1bmRlZmluZWQiIT10eXBlb2Ygc2VsZiYmc2VsZi5NYXRoPT1NYXRoP3NlbGY6RnVuY3Rpb24oInJldHVybiB0aGlzIikoKTsibnVtYmVyIj09dHlwZW9mIF9fZyYmKF9fZz1uKX0sIjc3ZjEiOmZ1bmN0aW9uKHQsZSxuKXt2YXIgcj1uKCI0NTg4Iiksbz1NYXRoLm1heCxpPU1hdGgubWluO3QuZXhwb3J0cz1mdW5jdGlvbih0LGUpe3JldHVybiB0PXIodCksdDwwP28odCtlLDApOmkodCxlKX19LCI3OWU1IjpmdW5jdGlvbih0LGUpe3QuZXhwb3J0cz1mdW5jdGlvbih0KXt0cnl7cmV0dXJuISF0KCl9Y2F0Y2godCl7cmV0dXJuITB9fX0sIjdhNTYiOmZ1bmN0aW9uKHQsZSxuKXsidXNlIHN0cmljdCI7dmFyIHI9bigiNzcyNiIpLG89bigiODZjYyIpLGk9bigiOWUxZSIpLGE9bigiMmI0YyIpKCJzcGVjaWVzIik7dC5leHBvcnRzPWZ1bmN0aW9uKHQpe3ZhciBlPXJbdF07aSYmZSYmIWVbYV0mJm8uZihlLGEse2NvbmZpZ3VyYWJsZTohMCxnZXQ6ZnVuY3Rpb24oKXtyZXR1cm4gdGhpc319KX19LCI3ZjIwIjpmdW5jdGlvbih0LGUsbil7dmFyIHI9bigiODZjYyIpLmYsbz1uKCI2OWE4IiksaT1uKCIyYjRjIikoInRvU3RyaW5nVGFnIik7dC5leHBvcnRzPWZ1bmN0aW9uKHQsZSxuKXt0JiYhbyh0PW4/dDp0LnByb3RvdHlwZSxpKSYmcih0LGkse2NvbmZpZ3VyYWJsZTohMCx2YWx1ZTplfSl9fSwiN2Y3ZiI6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oIjg2Y2MiKS5mLG89RnVuY3Rpb24ucHJvdG90eXBlLGk9L15ccypmdW5jdGlvbiAoW14gKF0qKS8sYT0ibmFtZSI7YSBpbiBvfHxuKCI5ZTFlIikmJnIobyxhLHtjb25maWd1cmFibGU6ITAsZ2V0OmZ1bmN0aW9uKCl7dHJ5e3JldHVybigiIit0aGlzKS5tYXRjaChpKVsxXX1jYXRjaCh0KXtyZXR1cm4iIn19fSl9LDgwNzk6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oIjc3MjYiKSxvPW4oIjE5OTEiKS5zZXQsaT1yLk11dGF0aW9uT2JzZXJ2ZXJ8fHIuV2ViS2l0TXV0YXRpb25PYnNlcnZlcixhPXIucHJvY2VzcyxzPXIuUHJvbWlzZSxjPSJwcm9jZXNzIj09bigiMmQ5NSIpKGEpO3QuZXhwb3J0cz1mdW5jdGlvbigpe3ZhciB0LGUsbix1PWZ1bmN0aW9uKCl7dmFyIHIsbztjJiYocj1hLmRvbWFpbikmJnIuZXhpdCgpO3doaWxlKHQpe289dC5mbix0PXQubmV4dDt0cnl7bygpfWNhdGNoKHIpe3Rocm93IHQ/bigpOmU9dm9pZCAwLHJ9fWU9dm9pZCAwLHImJnIuZW50ZXIoKX07aWYoYyluPWZ1bmN0aW9uKCl7YS5uZXh0VGljayh1KX07ZWxzZSBpZighaXx8ci5uYXZpZ2F0b3ImJnIubmF2aWdhdG9yLnN0YW5kYWxvbmUpaWYocyYmcy5yZXNvbHZlKXt2YXIgZj1zLnJlc29sdmUodm9pZCAwKTtuPWZ1bmN0aW9uKCl7Zi50aGVuKHUpfX1lbHNlIG49ZnVuY3Rpb24oKXtvLmNhbGwocix1KX07ZWxzZXt2YXIgbD0hMCxwPWRvY3VtZW50LmNyZWF0ZVRleHROb2RlKCIiKTtuZXcgaSh1KS5vYnNlcnZlKHAse2NoYXJhY3RlckRhdGE6ITB9KSxuPWZ1bmN0aW9uKCl7cC5kYXRhPWw9IWx9fXJldHVybiBmdW5jdGlvbihyKXt2YXIgbz17Zm46cixuZXh0OnZvaWQgMH07ZSYmKGUubmV4dD1vKSx0fHwodD1vLG4oKSksZT1vfX19LDgzNzg6ZnVuY3Rpb24odCxlKXt2YXIgbj10LmV4cG9ydHM9e3ZlcnNpb246IjIuNS43In07Im51bWJlciI9PXR5cGVvZiBfX2UmJihfX2U9bil9LCI4NGYyIjpmdW5jdGlvbih0LGUpe3QuZXhwb3J0cz17fX0sIjg2Y2MiOmZ1bmN0aW9uKHQsZSxuKXt2YXIgcj1uKCJjYjdjIiksbz1uKCJjNjlhIiksaT1uKCI2YTk5IiksYT1PYmplY3QuZGVmaW5lUHJvcGVydHk7ZS5mPW4oIjllMWUiKT9PYmplY3QuZGVmaW5lUHJvcGVydHk6ZnVuY3Rpb24odCxlLG4pe2lmKHIodCksZT1pKGUsITApLHIobiksbyl0cnl7cmV0dXJuIGEodCxlLG4pfWNhdGNoKHQpe31pZigiZ2V0ImluIG58fCJzZXQiaW4gbil0aHJvdyBUeXBlRXJyb3IoIkFjY2Vzc29ycyBub3Qgc3VwcG9ydGVkISIpO3JldHVybiJ2YWx1ZSJpbiBuJiYodFtlXT1uLnZhbHVlKSx0fX0sIjliNDMiOmZ1bmN0aW9uKHQsZSxuKXt2YXIgcj1uKCJkOGU4Iik7dC5leHBvcnRzPWZ1bmN0aW9uKHQsZSxuKXtpZihyKHQpLHZvaWQgMD09PWUpcmV0dXJuIHQ7c3dpdGNoKG4pe2Nhc2UgMTpyZXR1cm4gZnVuY3Rpb24obil7cmV0dXJuIHQuY2FsbChlLG4pfTtjYXNlIDI6cmV0dXJuIGZ1bmN0aW9uKG4scil7cmV0dXJuIHQuY2FsbChlLG4scil9O2Nhc2UgMzpyZXR1cm4gZnVuY3Rpb24obixyLG8pe3JldHVybiB0LmNhbGwoZSxuLHIsbyl9fXJldHVybiBmdW5jdGlvbigpe3JldHVybiB0LmFwcGx5KGUsYXJndW1lbnRzKX19fSwiOWM2YyI6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oIjJiNGMiKSgidW5zY29wYWJsZXMiKSxvPUFycmF5LnByb3RvdHlwZTt2b2lkIDA9PW9bcl0mJm4oIjMyZTkiKShvLHIse30pLHQuZXhwb3J0cz1mdW5jdGlvbih0KXtvW3JdW3RdPSEwfX0sIjljODAiOmZ1bmN0aW9uKHQsZSl7dC5leHBvcnRzPWZ1bmN0aW9uKHQpe3RyeXtyZXR1cm57ZTohMSx2OnQoKX19Y2F0Y2godCl7cmV0dXJue2U6ITAsdjp0fX19fSwiOWRlZiI6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oIjQ1ODgiKSxvPU1hdGgubWluO3QuZXhwb3J0cz1mdW5jdGlvbih0KXtyZXR1cm4gdD4wP28ocih0KSw5MDA3MTk5MjU0NzQwOTkxKTowfX0sIjllMWUiOmZ1bmN0aW9uKHQsZSxuKXt0LmV4cG9ydHM9IW4oIjc5ZTUiKShmdW5jdGlvbigpe3JldHVybiA3IT1PYmplY3QuZGVmaW5lUHJvcGVydHkoe30sImEiLHtnZXQ6ZnVuY3Rpb24oKXtyZXR1cm4gN319KS5hfSl9LGEyNWY6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oIjc3MjYiKSxvPXIubmF2aWdhdG9yO3QuZXhwb3J0cz1vJiZvLnVzZXJBZ2VudHx8IiJ9LGE1Yjg6ZnVuY3Rpb24odCxlLG4peyJ1c2Ugc3RyaWN0Ijt2YXIgcj1uKCJkOGU4Iik7ZnVuY3Rpb24gbyh0KXt2YXIgZSxuO3RoaXMucHJvbWlzZT1uZXcgdChmdW5jdGlvbih0LHIpe2lmKHZvaWQgMCE9PWV8fHZvaWQgMCE9PW4pdGhyb3cgVHlwZUVycm9yKCJCYWQgUHJvbWlzZSBjb25zdHJ1Y3RvciIpO2U9dCxuPXJ9KSx0aGlzLnJlc29sdmU9cihlKSx0aGlzLnJlamVjdD1yKG4pfXQuZXhwb3J0cy5mPWZ1bmN0aW9uKHQpe3JldHVybiBuZXcgbyh0KX19LGJjYWE6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oImNiN2MiKSxvPW4oImQzZjQiKSxpPW4oImE1YjgiKTt0LmV4cG9ydHM9ZnVuY3Rpb24odCxlKXtpZihyKHQpLG8oZSkmJmUuY29uc3RydWN0b3I9PT10KXJldHVybiBlO3ZhciBuPWkuZih0KSxhPW4ucmVzb2x2ZTtyZXR1cm4gYShlKSxuLnByb21pc2V9fSxiZTEzOmZ1bmN0aW9uKHQsZSl7dC5leHBvcnRzPWZ1bmN0aW9uKHQpe2lmKHZvaWQgMD09dCl0aHJvdyBUeXBlRXJyb3IoIkNhbid0IGNhbGwgbWV0aG9kIG9uICAiK3QpO3JldHVybiB0fX0sYzM2NjpmdW5jdGlvbih0LGUsbil7dmFyIHI9bigiNjgyMSIpLG89bigiOWRlZiIpLGk9bigiNzdmMSIpO3QuZXhwb3J0cz1mdW5jdGlvbih0KXtyZXR1cm4gZnVuY3Rpb24oZSxuLGEpe3ZhciBzLGM9cihlKSx1PW8oYy5sZW5ndGgpLGY9aShhLHUpO2lmKHQmJm4hPW4pe3doaWxlKHU+ZilpZihzPWNbZisrXSxzIT1zKXJldHVybiEwfWVsc2UgZm9yKDt1PmY7ZisrKWlmKCh0fHxmIGluIGMpJiZjW2ZdPT09bilyZXR1cm4gdHx8Znx8MDtyZXR1cm4hdCYmLTF9fX0sYzY5YTpmdW5jdGlvbih0LGUsbil7dC5leHBvcnRzPSFuKCI5ZTFlIikmJiFuKCI3OWU1IikoZnVuY3Rpb24oKXtyZXR1cm4gNyE9T2JqZWN0LmRlZmluZVByb3BlcnR5KG4oIjIzMGUiKSgiZGl2IiksImEiLHtnZXQ6ZnVuY3Rpb24oKXtyZXR1cm4gN319KS5hfSl9LGM4YmE6ZnVuY3Rpb24odCxlKXt2YXIgbjtuPWZ1bmN0aW9uKCl7cmV0dXJuIHRoaXN9KCk7dHJ5e249bnx8RnVuY3Rpb24oInJldHVybiB0aGlzIikoKXx8KDAsZXZhbCkoInRoaXMiKX1jYXRjaCh0KXsib2JqZWN0Ij09PXR5cGVvZiB3aW5kb3cmJihuPXdpbmRvdyl9dC5leHBvcnRzPW59LGNhNWE6ZnVuY3Rpb24odCxlKXt2YXIgbj0wLHI9TWF0aC5yYW5kb20oKTt0LmV4cG9ydHM9ZnVuY3Rpb24odCl7cmV0dXJuIlN5bWJvbCgiLmNvbmNhdCh2b2lkIDA9PT10PyIiOnQsIilfIiwoKytuK3IpLnRvU3RyaW5nKDM2KSl9fSxjYWRmOmZ1bmN0aW9uKHQsZSxuKXsidXNlIHN0cmljdCI7dmFyIHI9bigiOWM2YyIpLG89bigiZDUzYiIpLGk9bigiODRmMiIpLGE9bigiNjgyMSIpO3QuZXhwb3J0cz1uKCIwMWY5IikoQXJyYXksIkFycmF5IixmdW5jdGlvbih0LGUpe3RoaXMuX3Q9YSh0KSx0aGlzLl9pPTAsdGhpcy5faz1lfSxmdW5jdGlvbigpe3ZhciB0PXRoaXMuX3QsZT10aGlzLl9rLG49dGhpcy5faSsrO3JldHVybiF0fHxuPj10Lmxlbmd0aD8odGhpcy5fdD12b2lkIDAsbygxKSk6bygwLCJrZXlzIj09ZT9uOiJ2YWx1ZXMiPT1lP3Rbbl06W24sdFtuXV0pfSwidmFsdWVzIiksaS5Bcmd1bWVudHM9aS5BcnJheSxyKCJrZXlzIikscigidmFsdWVzIikscigiZW50cmllcyIpfSxjYjdjOmZ1bmN0aW9uKHQsZSxuKXt2YXIgcj1uKCJkM2Y0Iik7dC5leHBvcnRzPWZ1bmN0aW9uKHQpe2lmKCFyKHQpKXRocm93IFR5cGVFcnJvcih0KyIgaXMgbm90IGFuIG9iamVjdCEiKTtyZXR1cm4gdH19LGNlMTA6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oIjY5YTgiKSxvPW4oIjY4MjEiKSxpPW4oImMzNjYiKSghMSksYT1uKCI2MTNiIikoIklFX1BST1RPIik7dC5leHBvcnRzPWZ1bmN0aW9uKHQsZSl7dmFyIG4scz1vKHQpLGM9MCx1PVtdO2ZvcihuIGluIHMpbiE9YSYmcihzLG4pJiZ1LnB1c2gobik7d2hpbGUoZS5sZW5ndGg+YylyKHMsbj1lW2MrK10pJiYofmkodSxuKXx8dS5wdXNoKG4pKTtyZXR1cm4gdX19LGQzZjQ6ZnVuY3Rpb24odCxlKXt0LmV4cG9ydHM9ZnVuY3Rpb24odCl7cmV0dXJuIm9iamVjdCI9PT10eXBlb2YgdD9udWxsIT09dDoiZnVuY3Rpb24iPT09dHlwZW9mIHR9fSxkNTNiOmZ1bmN0aW9uKHQsZSl7dC5leHBvcnRzPWZ1bmN0aW9uKHQsZSl7cmV0dXJue3ZhbHVlOmUsZG9uZTohIXR9fX0sZDhlODpmdW5jdGlvbih0LGUpe3QuZXhwb3J0cz1mdW5jdGlvbih0KXtpZigiZnVuY3Rpb24iIT10eXBlb2YgdCl0aHJvdyBUeXBlRXJyb3IodCsiIGlzIG5vdCBhIGZ1bmN0aW9uISIpO3JldHVybiB0fX0sZGNiYzpmdW5jdGlvbih0LGUsbil7dmFyIHI9bigiMmFiYSIpO3QuZXhwb3J0cz1mdW5jdGlvbih0LGUsbil7Zm9yKHZhciBvIGluIGUpcih0LG8sZVtvXSxuKTtyZXR1cm4gdH19LGUxMWU6ZnVuY3Rpb24odCxlKXt0LmV4cG9ydHM9ImNvbnN0cnVjdG9yLGhhc093blByb3BlcnR5LGlzUHJvdG90eXBlT2YscHJvcGVydHlJc0VudW1lcmFibGUsdG9Mb2NhbGVTdHJpbmcsdG9TdHJpbmcsdmFsdWVPZiIuc3BsaXQoIiwiKX0sZWJkNjpmdW5jdGlvbih0LGUsbil7dmFyIHI9bigiY2I3YyIpLG89bigiZDhlOCIpLGk9bigiMmI0YyIpKCJzcGVjaWVzIik7dC5leHBvcnRzPWZ1bmN0aW9uKHQsZSl7dmFyIG4sYT1yKHQpLmNvbnN0cnVjdG9yO3JldHVybiB2b2lkIDA9PT1hfHx2b2lkIDA9PShuPXIoYSlbaV0pP2U6byhuKX19LGY2MDU6ZnVuY3Rpb24odCxlKXt0LmV4cG9ydHM9ZnVuY3Rpb24odCxlLG4scil7aWYoISh0IGluc3RhbmNlb2YgZSl8fHZvaWQgMCE9PXImJnIgaW4gdCl0aHJvdyBUeXBlRXJyb3IobisiOiBpbmNvcnJlY3QgaW52b2NhdGlvbiEiKTtyZXR1cm4gdH19LGZhYjI6ZnVuY3Rpb24odCxlLG4pe3ZhciByPW4oIjc3MjYiKS5kb2N1bWVudDt0LmV4cG9ydHM9ciYmci5kb2N1bWVudEVsZW1lbnR9fV0pOwovLyMgc291cmNlTWFwcGluZ1VSTD1jaHVuay12ZW5kb3JzLjY3MTFiZWVjLmpzLm1hcA==\"")
 	packr.PackJSONBytes("frontend/dist", "js/chunk-vendors.6711beec.js.map", "\"eyJ2ZXJzaW9uIjozLCJzb3VyY2VzIjpbIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19pdGVyLWRlZmluZS5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL2VzNy5wcm9taXNlLmZpbmFsbHkuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fb2JqZWN0LWtleXMuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fb2JqZWN0LWRwcy5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL190YXNrLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX2l0ZXItY2FsbC5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19kb20tY3JlYXRlLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX2NsYXNzb2YuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9jb3JlLmdldC1pdGVyYXRvci1tZXRob2QuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL3Z1ZS1sb2FkZXIvbGliL3J1bnRpbWUvY29tcG9uZW50Tm9ybWFsaXplci5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19yZWRlZmluZS5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19vYmplY3QtY3JlYXRlLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy92dWUvZGlzdC92dWUucnVudGltZS5lc20uanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fd2tzLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX2xpYnJhcnkuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fY29mLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX2ludm9rZS5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19oaWRlLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX2lzLWFycmF5LWl0ZXIuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fb2JqZWN0LWdwby5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19pdGVyLWNyZWF0ZS5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL190by1pbnRlZ2VyLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX3Byb3BlcnR5LWRlc2MuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fZm9yLW9mLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX3RvLW9iamVjdC5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL2VzNi5wcm9taXNlLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX3NoYXJlZC5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19leHBvcnQuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9faXRlci1kZXRlY3QuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fc2hhcmVkLWtleS5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19pb2JqZWN0LmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX3RvLWlvYmplY3QuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9faGFzLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX3RvLXByaW1pdGl2ZS5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19nbG9iYWwuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fdG8tYWJzb2x1dGUtaW5kZXguanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fZmFpbHMuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fc2V0LXNwZWNpZXMuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fc2V0LXRvLXN0cmluZy10YWcuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9lczYuZnVuY3Rpb24ubmFtZS5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19taWNyb3Rhc2suanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fY29yZS5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19pdGVyYXRvcnMuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fb2JqZWN0LWRwLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX2N0eC5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19hZGQtdG8tdW5zY29wYWJsZXMuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fcGVyZm9ybS5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL190by1sZW5ndGguanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fZGVzY3JpcHRvcnMuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fdXNlci1hZ2VudC5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19uZXctcHJvbWlzZS1jYXBhYmlsaXR5LmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX3Byb21pc2UtcmVzb2x2ZS5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19kZWZpbmVkLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX2FycmF5LWluY2x1ZGVzLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX2llOC1kb20tZGVmaW5lLmpzIiwid2VicGFjazovLy8od2VicGFjaykvYnVpbGRpbi9nbG9iYWwuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fdWlkLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvZXM2LmFycmF5Lml0ZXJhdG9yLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX2FuLW9iamVjdC5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19vYmplY3Qta2V5cy1pbnRlcm5hbC5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19pcy1vYmplY3QuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9faXRlci1zdGVwLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX2EtZnVuY3Rpb24uanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fcmVkZWZpbmUtYWxsLmpzIiwid2VicGFjazovLy8uL25vZGVfbW9kdWxlcy9jb3JlLWpzL21vZHVsZXMvX2VudW0tYnVnLWtleXMuanMiLCJ3ZWJwYWNrOi8vLy4vbm9kZV9tb2R1bGVzL2NvcmUtanMvbW9kdWxlcy9fc3BlY2llcy1jb25zdHJ1Y3Rvci5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19hbi1pbnN0YW5jZS5qcyIsIndlYnBhY2s6Ly8vLi9ub2RlX21vZHVsZXMvY29yZS1qcy9tb2R1bGVzL19odG1sLmpzIl0sIm5hbWVzIjpbIkxJQlJBUlkiLCJfX3dlYnBhY2tfcmVxdWlyZV9fIiwiJGV4cG9ydCIsInJlZGVmaW5lIiwiaGlkZSIsIkl0ZXJhdG9ycyIsIiRpdGVyQ3JlYXRlIiwic2V0VG9TdHJpbmdUYWciLCJnZXRQcm90b3R5cGVPZiIsIklURVJBVE9SIiwiQlVHR1kiLCJrZXlzIiwiRkZfSVRFUkFUT1IiLCJLRVlTIiwiVkFMVUVTIiwicmV0dXJuVGhpcyIsInRoaXMiLCJtb2R1bGUiLCJleHBvcnRzIiwiQmFzZSIsIk5BTUUiLCJDb25zdHJ1Y3RvciIsIm5leHQiLCJERUZBVUxUIiwiSVNfU0VUIiwiRk9SQ0VEIiwibWV0aG9kcyIsImtleSIsIkl0ZXJhdG9yUHJvdG90eXBlIiwiZ2V0TWV0aG9kIiwia2luZCIsInByb3RvIiwiVEFHIiwiREVGX1ZBTFVFUyIsIlZBTFVFU19CVUciLCJwcm90b3R5cGUiLCIkbmF0aXZlIiwiJGRlZmF1bHQiLCIkZW50cmllcyIsInVuZGVmaW5lZCIsIiRhbnlOYXRpdmUiLCJlbnRyaWVzIiwiY2FsbCIsIk9iamVjdCIsIm5hbWUiLCJ2YWx1ZXMiLCJQIiwiRiIsImNvcmUiLCJnbG9iYWwiLCJzcGVjaWVzQ29uc3RydWN0b3IiLCJwcm9taXNlUmVzb2x2ZSIsIlIiLCJmaW5hbGx5Iiwib25GaW5hbGx5IiwiQyIsIlByb21pc2UiLCJpc0Z1bmN0aW9uIiwidGhlbiIsIngiLCJlIiwiJGtleXMiLCJlbnVtQnVnS2V5cyIsIk8iLCJkUCIsImFuT2JqZWN0IiwiZ2V0S2V5cyIsImRlZmluZVByb3BlcnRpZXMiLCJQcm9wZXJ0aWVzIiwibGVuZ3RoIiwiaSIsImYiLCJkZWZlciIsImNoYW5uZWwiLCJwb3J0IiwiY3R4IiwiaW52b2tlIiwiaHRtbCIsImNlbCIsInByb2Nlc3MiLCJzZXRUYXNrIiwic2V0SW1tZWRpYXRlIiwiY2xlYXJUYXNrIiwiY2xlYXJJbW1lZGlhdGUiLCJNZXNzYWdlQ2hhbm5lbCIsIkRpc3BhdGNoIiwiY291bnRlciIsInF1ZXVlIiwiT05SRUFEWVNUQVRFQ0hBTkdFIiwicnVuIiwiaWQiLCJoYXNPd25Qcm9wZXJ0eSIsImZuIiwibGlzdGVuZXIiLCJldmVudCIsImRhdGEiLCJhcmdzIiwiYXJndW1lbnRzIiwicHVzaCIsIkZ1bmN0aW9uIiwibmV4dFRpY2siLCJub3ciLCJwb3J0MiIsInBvcnQxIiwib25tZXNzYWdlIiwicG9zdE1lc3NhZ2UiLCJhZGRFdmVudExpc3RlbmVyIiwiaW1wb3J0U2NyaXB0cyIsImFwcGVuZENoaWxkIiwicmVtb3ZlQ2hpbGQiLCJzZXRUaW1lb3V0Iiwic2V0IiwiY2xlYXIiLCJpdGVyYXRvciIsInZhbHVlIiwicmV0IiwiaXNPYmplY3QiLCJkb2N1bWVudCIsImlzIiwiY3JlYXRlRWxlbWVudCIsIml0IiwiY29mIiwiQVJHIiwidHJ5R2V0IiwiVCIsIkIiLCJjYWxsZWUiLCJjbGFzc29mIiwiZ2V0SXRlcmF0b3JNZXRob2QiLCJub3JtYWxpemVDb21wb25lbnQiLCJzY3JpcHRFeHBvcnRzIiwicmVuZGVyIiwic3RhdGljUmVuZGVyRm5zIiwiZnVuY3Rpb25hbFRlbXBsYXRlIiwiaW5qZWN0U3R5bGVzIiwic2NvcGVJZCIsIm1vZHVsZUlkZW50aWZpZXIiLCJzaGFkb3dNb2RlIiwiaG9vayIsIm9wdGlvbnMiLCJfY29tcGlsZWQiLCJmdW5jdGlvbmFsIiwiX3Njb3BlSWQiLCJjb250ZXh0IiwiJHZub2RlIiwic3NyQ29udGV4dCIsInBhcmVudCIsIl9fVlVFX1NTUl9DT05URVhUX18iLCJfcmVnaXN0ZXJlZENvbXBvbmVudHMiLCJhZGQiLCJfc3NyUmVnaXN0ZXIiLCIkcm9vdCIsIiRvcHRpb25zIiwic2hhZG93Um9vdCIsIl9pbmplY3RTdHlsZXMiLCJvcmlnaW5hbFJlbmRlciIsImgiLCJleGlzdGluZyIsImJlZm9yZUNyZWF0ZSIsImNvbmNhdCIsImQiLCJfX3dlYnBhY2tfZXhwb3J0c19fIiwiaGFzIiwiU1JDIiwiVE9fU1RSSU5HIiwiJHRvU3RyaW5nIiwiVFBMIiwic3BsaXQiLCJpbnNwZWN0U291cmNlIiwidmFsIiwic2FmZSIsImpvaW4iLCJTdHJpbmciLCJkUHMiLCJJRV9QUk9UTyIsIkVtcHR5IiwiUFJPVE9UWVBFIiwiY3JlYXRlRGljdCIsImlmcmFtZURvY3VtZW50IiwiaWZyYW1lIiwibHQiLCJndCIsInN0eWxlIiwiZGlzcGxheSIsInNyYyIsImNvbnRlbnRXaW5kb3ciLCJvcGVuIiwid3JpdGUiLCJjbG9zZSIsImNyZWF0ZSIsInJlc3VsdCIsImVtcHR5T2JqZWN0IiwiZnJlZXplIiwiaXNVbmRlZiIsInYiLCJpc0RlZiIsImlzVHJ1ZSIsImlzRmFsc2UiLCJpc1ByaW1pdGl2ZSIsIm9iaiIsIl90b1N0cmluZyIsInRvU3RyaW5nIiwiaXNQbGFpbk9iamVjdCIsImlzUmVnRXhwIiwiaXNWYWxpZEFycmF5SW5kZXgiLCJuIiwicGFyc2VGbG9hdCIsIk1hdGgiLCJmbG9vciIsImlzRmluaXRlIiwiSlNPTiIsInN0cmluZ2lmeSIsInRvTnVtYmVyIiwiaXNOYU4iLCJtYWtlTWFwIiwic3RyIiwiZXhwZWN0c0xvd2VyQ2FzZSIsIm1hcCIsImxpc3QiLCJ0b0xvd2VyQ2FzZSIsImlzUmVzZXJ2ZWRBdHRyaWJ1dGUiLCJyZW1vdmUiLCJhcnIiLCJpdGVtIiwiaW5kZXgiLCJpbmRleE9mIiwic3BsaWNlIiwiaGFzT3duIiwiY2FjaGVkIiwiY2FjaGUiLCJoaXQiLCJjYW1lbGl6ZVJFIiwiY2FtZWxpemUiLCJyZXBsYWNlIiwiXyIsImMiLCJ0b1VwcGVyQ2FzZSIsImNhcGl0YWxpemUiLCJjaGFyQXQiLCJzbGljZSIsImh5cGhlbmF0ZVJFIiwiaHlwaGVuYXRlIiwicG9seWZpbGxCaW5kIiwiYm91bmRGbiIsImEiLCJsIiwiYXBwbHkiLCJfbGVuZ3RoIiwibmF0aXZlQmluZCIsImJpbmQiLCJ0b0FycmF5Iiwic3RhcnQiLCJBcnJheSIsImV4dGVuZCIsInRvIiwiX2Zyb20iLCJ0b09iamVjdCIsInJlcyIsIm5vb3AiLCJiIiwibm8iLCJpZGVudGl0eSIsImxvb3NlRXF1YWwiLCJpc09iamVjdEEiLCJpc09iamVjdEIiLCJpc0FycmF5QSIsImlzQXJyYXkiLCJpc0FycmF5QiIsImV2ZXJ5Iiwia2V5c0EiLCJrZXlzQiIsImxvb3NlSW5kZXhPZiIsIm9uY2UiLCJjYWxsZWQiLCJTU1JfQVRUUiIsIkFTU0VUX1RZUEVTIiwiTElGRUNZQ0xFX0hPT0tTIiwiY29uZmlnIiwib3B0aW9uTWVyZ2VTdHJhdGVnaWVzIiwic2lsZW50IiwicHJvZHVjdGlvblRpcCIsImRldnRvb2xzIiwicGVyZm9ybWFuY2UiLCJlcnJvckhhbmRsZXIiLCJ3YXJuSGFuZGxlciIsImlnbm9yZWRFbGVtZW50cyIsImtleUNvZGVzIiwiaXNSZXNlcnZlZFRhZyIsImlzUmVzZXJ2ZWRBdHRyIiwiaXNVbmtub3duRWxlbWVudCIsImdldFRhZ05hbWVzcGFjZSIsInBhcnNlUGxhdGZvcm1UYWdOYW1lIiwibXVzdFVzZVByb3AiLCJfbGlmZWN5Y2xlSG9va3MiLCJpc1Jlc2VydmVkIiwiY2hhckNvZGVBdCIsImRlZiIsImVudW1lcmFibGUiLCJkZWZpbmVQcm9wZXJ0eSIsIndyaXRhYmxlIiwiY29uZmlndXJhYmxlIiwiYmFpbFJFIiwicGFyc2VQYXRoIiwicGF0aCIsInRlc3QiLCJzZWdtZW50cyIsIl9pc1NlcnZlciIsImhhc1Byb3RvIiwiaW5Ccm93c2VyIiwid2luZG93IiwiaW5XZWV4IiwiV1hFbnZpcm9ubWVudCIsInBsYXRmb3JtIiwid2VleFBsYXRmb3JtIiwiVUEiLCJuYXZpZ2F0b3IiLCJ1c2VyQWdlbnQiLCJpc0lFIiwiaXNJRTkiLCJpc0VkZ2UiLCJpc0lPUyIsIm5hdGl2ZVdhdGNoIiwid2F0Y2giLCJzdXBwb3J0c1Bhc3NpdmUiLCJvcHRzIiwiZ2V0IiwiaXNTZXJ2ZXJSZW5kZXJpbmciLCJlbnYiLCJWVUVfRU5WIiwiX19WVUVfREVWVE9PTFNfR0xPQkFMX0hPT0tfXyIsImlzTmF0aXZlIiwiQ3RvciIsIl9TZXQiLCJoYXNTeW1ib2wiLCJTeW1ib2wiLCJSZWZsZWN0Iiwib3duS2V5cyIsIlNldCIsIndhcm4iLCJ1aWQiLCJEZXAiLCJzdWJzIiwiYWRkU3ViIiwic3ViIiwicmVtb3ZlU3ViIiwiZGVwZW5kIiwidGFyZ2V0IiwiYWRkRGVwIiwibm90aWZ5IiwidXBkYXRlIiwidGFyZ2V0U3RhY2siLCJwdXNoVGFyZ2V0IiwiX3RhcmdldCIsInBvcFRhcmdldCIsInBvcCIsIlZOb2RlIiwidGFnIiwiY2hpbGRyZW4iLCJ0ZXh0IiwiZWxtIiwiY29tcG9uZW50T3B0aW9ucyIsImFzeW5jRmFjdG9yeSIsIm5zIiwiZm5Db250ZXh0IiwiZm5PcHRpb25zIiwiZm5TY29wZUlkIiwiY29tcG9uZW50SW5zdGFuY2UiLCJyYXciLCJpc1N0YXRpYyIsImlzUm9vdEluc2VydCIsImlzQ29tbWVudCIsImlzQ2xvbmVkIiwiaXNPbmNlIiwiYXN5bmNNZXRhIiwiaXNBc3luY1BsYWNlaG9sZGVyIiwicHJvdG90eXBlQWNjZXNzb3JzIiwiY2hpbGQiLCJjcmVhdGVFbXB0eVZOb2RlIiwibm9kZSIsImNyZWF0ZVRleHRWTm9kZSIsImNsb25lVk5vZGUiLCJ2bm9kZSIsImNsb25lZCIsImFycmF5UHJvdG8iLCJhcnJheU1ldGhvZHMiLCJtZXRob2RzVG9QYXRjaCIsImZvckVhY2giLCJtZXRob2QiLCJvcmlnaW5hbCIsImxlbiIsImluc2VydGVkIiwib2IiLCJfX29iX18iLCJvYnNlcnZlQXJyYXkiLCJkZXAiLCJhcnJheUtleXMiLCJnZXRPd25Qcm9wZXJ0eU5hbWVzIiwic2hvdWxkT2JzZXJ2ZSIsInRvZ2dsZU9ic2VydmluZyIsIk9ic2VydmVyIiwidm1Db3VudCIsImF1Z21lbnQiLCJwcm90b0F1Z21lbnQiLCJjb3B5QXVnbWVudCIsIndhbGsiLCJfX3Byb3RvX18iLCJvYnNlcnZlIiwiYXNSb290RGF0YSIsImlzRXh0ZW5zaWJsZSIsIl9pc1Z1ZSIsImRlZmluZVJlYWN0aXZlIiwiY3VzdG9tU2V0dGVyIiwic2hhbGxvdyIsInByb3BlcnR5IiwiZ2V0T3duUHJvcGVydHlEZXNjcmlwdG9yIiwiZ2V0dGVyIiwic2V0dGVyIiwiY2hpbGRPYiIsImRlcGVuZEFycmF5IiwibmV3VmFsIiwibWF4IiwiZGVsIiwiaXRlbXMiLCJzdHJhdHMiLCJtZXJnZURhdGEiLCJmcm9tIiwidG9WYWwiLCJmcm9tVmFsIiwibWVyZ2VEYXRhT3JGbiIsInBhcmVudFZhbCIsImNoaWxkVmFsIiwidm0iLCJpbnN0YW5jZURhdGEiLCJkZWZhdWx0RGF0YSIsIm1lcmdlSG9vayIsIm1lcmdlQXNzZXRzIiwidHlwZSIsImtleSQxIiwicHJvcHMiLCJpbmplY3QiLCJjb21wdXRlZCIsInByb3ZpZGUiLCJkZWZhdWx0U3RyYXQiLCJub3JtYWxpemVQcm9wcyIsIm5vcm1hbGl6ZUluamVjdCIsIm5vcm1hbGl6ZWQiLCJub3JtYWxpemVEaXJlY3RpdmVzIiwiZGlycyIsImRpcmVjdGl2ZXMiLCJtZXJnZU9wdGlvbnMiLCJleHRlbmRzRnJvbSIsImV4dGVuZHMiLCJtaXhpbnMiLCJtZXJnZUZpZWxkIiwic3RyYXQiLCJyZXNvbHZlQXNzZXQiLCJ3YXJuTWlzc2luZyIsImFzc2V0cyIsImNhbWVsaXplZElkIiwiUGFzY2FsQ2FzZUlkIiwidmFsaWRhdGVQcm9wIiwicHJvcE9wdGlvbnMiLCJwcm9wc0RhdGEiLCJwcm9wIiwiYWJzZW50IiwiYm9vbGVhbkluZGV4IiwiZ2V0VHlwZUluZGV4IiwiQm9vbGVhbiIsInN0cmluZ0luZGV4IiwiZ2V0UHJvcERlZmF1bHRWYWx1ZSIsInByZXZTaG91bGRPYnNlcnZlIiwiZGVmYXVsdCIsIl9wcm9wcyIsImdldFR5cGUiLCJtYXRjaCIsImlzU2FtZVR5cGUiLCJleHBlY3RlZFR5cGVzIiwiaGFuZGxlRXJyb3IiLCJlcnIiLCJpbmZvIiwiY3VyIiwiJHBhcmVudCIsImhvb2tzIiwiZXJyb3JDYXB0dXJlZCIsImNhcHR1cmUiLCJnbG9iYWxIYW5kbGVFcnJvciIsImxvZ0Vycm9yIiwiY29uc29sZSIsImVycm9yIiwibWljcm9UaW1lckZ1bmMiLCJtYWNyb1RpbWVyRnVuYyIsImNhbGxiYWNrcyIsInBlbmRpbmciLCJmbHVzaENhbGxiYWNrcyIsImNvcGllcyIsInVzZU1hY3JvVGFzayIsInAiLCJyZXNvbHZlIiwid2l0aE1hY3JvVGFzayIsIl93aXRoVGFzayIsImNiIiwiX3Jlc29sdmUiLCJzZWVuT2JqZWN0cyIsInRyYXZlcnNlIiwiX3RyYXZlcnNlIiwic2VlbiIsImlzQSIsImlzRnJvemVuIiwiZGVwSWQiLCJub3JtYWxpemVFdmVudCIsInBhc3NpdmUiLCJvbmNlJCQxIiwiY3JlYXRlRm5JbnZva2VyIiwiZm5zIiwiaW52b2tlciIsImFyZ3VtZW50cyQxIiwidXBkYXRlTGlzdGVuZXJzIiwib24iLCJvbGRPbiIsInJlbW92ZSQkMSIsIm9sZCIsInBhcmFtcyIsIm1lcmdlVk5vZGVIb29rIiwiaG9va0tleSIsIm9sZEhvb2siLCJ3cmFwcGVkSG9vayIsIm1lcmdlZCIsImV4dHJhY3RQcm9wc0Zyb21WTm9kZURhdGEiLCJhdHRycyIsImFsdEtleSIsImNoZWNrUHJvcCIsImhhc2giLCJwcmVzZXJ2ZSIsInNpbXBsZU5vcm1hbGl6ZUNoaWxkcmVuIiwibm9ybWFsaXplQ2hpbGRyZW4iLCJub3JtYWxpemVBcnJheUNoaWxkcmVuIiwiaXNUZXh0Tm9kZSIsIm5lc3RlZEluZGV4IiwibGFzdEluZGV4IiwibGFzdCIsInNoaWZ0IiwiX2lzVkxpc3QiLCJlbnN1cmVDdG9yIiwiY29tcCIsImJhc2UiLCJfX2VzTW9kdWxlIiwidG9TdHJpbmdUYWciLCJjcmVhdGVBc3luY1BsYWNlaG9sZGVyIiwiZmFjdG9yeSIsInJlc29sdmVBc3luY0NvbXBvbmVudCIsImJhc2VDdG9yIiwiZXJyb3JDb21wIiwicmVzb2x2ZWQiLCJsb2FkaW5nIiwibG9hZGluZ0NvbXAiLCJjb250ZXh0cyIsInN5bmMiLCJmb3JjZVJlbmRlciIsIiRmb3JjZVVwZGF0ZSIsInJlamVjdCIsInJlYXNvbiIsImNvbXBvbmVudCIsImRlbGF5IiwidGltZW91dCIsImdldEZpcnN0Q29tcG9uZW50Q2hpbGQiLCJpbml0RXZlbnRzIiwiX2V2ZW50cyIsIl9oYXNIb29rRXZlbnQiLCJsaXN0ZW5lcnMiLCJfcGFyZW50TGlzdGVuZXJzIiwidXBkYXRlQ29tcG9uZW50TGlzdGVuZXJzIiwiJG9uY2UiLCIkb24iLCJyZW1vdmUkMSIsIiRvZmYiLCJvbGRMaXN0ZW5lcnMiLCJldmVudHNNaXhpbiIsIlZ1ZSIsImhvb2tSRSIsInRoaXMkMSIsImNicyIsImkkMSIsIiRlbWl0IiwicmVzb2x2ZVNsb3RzIiwic2xvdHMiLCJzbG90IiwibmFtZSQxIiwiaXNXaGl0ZXNwYWNlIiwicmVzb2x2ZVNjb3BlZFNsb3RzIiwiYWN0aXZlSW5zdGFuY2UiLCJpbml0TGlmZWN5Y2xlIiwiYWJzdHJhY3QiLCIkY2hpbGRyZW4iLCIkcmVmcyIsIl93YXRjaGVyIiwiX2luYWN0aXZlIiwiX2RpcmVjdEluYWN0aXZlIiwiX2lzTW91bnRlZCIsIl9pc0Rlc3Ryb3llZCIsIl9pc0JlaW5nRGVzdHJveWVkIiwibGlmZWN5Y2xlTWl4aW4iLCJfdXBkYXRlIiwiaHlkcmF0aW5nIiwiY2FsbEhvb2siLCJwcmV2RWwiLCIkZWwiLCJwcmV2Vm5vZGUiLCJfdm5vZGUiLCJwcmV2QWN0aXZlSW5zdGFuY2UiLCJfX3BhdGNoX18iLCJfcGFyZW50RWxtIiwiX3JlZkVsbSIsIl9fdnVlX18iLCIkZGVzdHJveSIsInRlYXJkb3duIiwiX3dhdGNoZXJzIiwiX2RhdGEiLCJtb3VudENvbXBvbmVudCIsImVsIiwidXBkYXRlQ29tcG9uZW50IiwiX3JlbmRlciIsIldhdGNoZXIiLCJ1cGRhdGVDaGlsZENvbXBvbmVudCIsInBhcmVudFZub2RlIiwicmVuZGVyQ2hpbGRyZW4iLCJoYXNDaGlsZHJlbiIsIl9yZW5kZXJDaGlsZHJlbiIsInNjb3BlZFNsb3RzIiwiJHNjb3BlZFNsb3RzIiwiX3BhcmVudFZub2RlIiwiJGF0dHJzIiwiJGxpc3RlbmVycyIsInByb3BLZXlzIiwiX3Byb3BLZXlzIiwiJHNsb3RzIiwiaXNJbkluYWN0aXZlVHJlZSIsImFjdGl2YXRlQ2hpbGRDb21wb25lbnQiLCJkaXJlY3QiLCJkZWFjdGl2YXRlQ2hpbGRDb21wb25lbnQiLCJoYW5kbGVycyIsImoiLCJhY3RpdmF0ZWRDaGlsZHJlbiIsIndhaXRpbmciLCJmbHVzaGluZyIsInJlc2V0U2NoZWR1bGVyU3RhdGUiLCJmbHVzaFNjaGVkdWxlclF1ZXVlIiwid2F0Y2hlciIsInNvcnQiLCJhY3RpdmF0ZWRRdWV1ZSIsInVwZGF0ZWRRdWV1ZSIsImNhbGxBY3RpdmF0ZWRIb29rcyIsImNhbGxVcGRhdGVkSG9va3MiLCJlbWl0IiwicXVldWVBY3RpdmF0ZWRDb21wb25lbnQiLCJxdWV1ZVdhdGNoZXIiLCJ1aWQkMSIsImV4cE9yRm4iLCJpc1JlbmRlcldhdGNoZXIiLCJkZWVwIiwidXNlciIsImxhenkiLCJhY3RpdmUiLCJkaXJ0eSIsImRlcHMiLCJuZXdEZXBzIiwiZGVwSWRzIiwibmV3RGVwSWRzIiwiZXhwcmVzc2lvbiIsImNsZWFudXBEZXBzIiwidG1wIiwib2xkVmFsdWUiLCJldmFsdWF0ZSIsInNoYXJlZFByb3BlcnR5RGVmaW5pdGlvbiIsInByb3h5Iiwic291cmNlS2V5IiwiaW5pdFN0YXRlIiwiaW5pdFByb3BzIiwiaW5pdE1ldGhvZHMiLCJpbml0RGF0YSIsImluaXRDb21wdXRlZCIsImluaXRXYXRjaCIsInByb3BzT3B0aW9ucyIsImlzUm9vdCIsImxvb3AiLCJnZXREYXRhIiwiY29tcHV0ZWRXYXRjaGVyT3B0aW9ucyIsIndhdGNoZXJzIiwiX2NvbXB1dGVkV2F0Y2hlcnMiLCJpc1NTUiIsInVzZXJEZWYiLCJkZWZpbmVDb21wdXRlZCIsInNob3VsZENhY2hlIiwiY3JlYXRlQ29tcHV0ZWRHZXR0ZXIiLCJoYW5kbGVyIiwiY3JlYXRlV2F0Y2hlciIsIiR3YXRjaCIsInN0YXRlTWl4aW4iLCJkYXRhRGVmIiwicHJvcHNEZWYiLCIkc2V0IiwiJGRlbGV0ZSIsImltbWVkaWF0ZSIsImluaXRQcm92aWRlIiwiX3Byb3ZpZGVkIiwiaW5pdEluamVjdGlvbnMiLCJyZXNvbHZlSW5qZWN0IiwiZmlsdGVyIiwicHJvdmlkZUtleSIsInNvdXJjZSIsInByb3ZpZGVEZWZhdWx0IiwicmVuZGVyTGlzdCIsInJlbmRlclNsb3QiLCJmYWxsYmFjayIsImJpbmRPYmplY3QiLCJub2RlcyIsInNjb3BlZFNsb3RGbiIsInNsb3ROb2RlcyIsIl9yZW5kZXJlZCIsIiRjcmVhdGVFbGVtZW50IiwicmVzb2x2ZUZpbHRlciIsImlzS2V5Tm90TWF0Y2giLCJleHBlY3QiLCJhY3R1YWwiLCJjaGVja0tleUNvZGVzIiwiZXZlbnRLZXlDb2RlIiwiYnVpbHRJbktleUNvZGUiLCJldmVudEtleU5hbWUiLCJidWlsdEluS2V5TmFtZSIsIm1hcHBlZEtleUNvZGUiLCJiaW5kT2JqZWN0UHJvcHMiLCJhc1Byb3AiLCJpc1N5bmMiLCJkb21Qcm9wcyIsIiRldmVudCIsInJlbmRlclN0YXRpYyIsImlzSW5Gb3IiLCJfc3RhdGljVHJlZXMiLCJ0cmVlIiwiX3JlbmRlclByb3h5IiwibWFya1N0YXRpYyIsIm1hcmtPbmNlIiwibWFya1N0YXRpY05vZGUiLCJiaW5kT2JqZWN0TGlzdGVuZXJzIiwib3VycyIsImluc3RhbGxSZW5kZXJIZWxwZXJzIiwiX28iLCJfbiIsIl9zIiwiX2wiLCJfdCIsIl9xIiwiX2kiLCJfbSIsIl9mIiwiX2siLCJfYiIsIl92IiwiX2UiLCJfdSIsIl9nIiwiRnVuY3Rpb25hbFJlbmRlckNvbnRleHQiLCJjb250ZXh0Vm0iLCJfb3JpZ2luYWwiLCJpc0NvbXBpbGVkIiwibmVlZE5vcm1hbGl6YXRpb24iLCJpbmplY3Rpb25zIiwiX2MiLCJjcmVhdGVGdW5jdGlvbmFsQ29tcG9uZW50IiwibWVyZ2VQcm9wcyIsInJlbmRlckNvbnRleHQiLCJjbG9uZUFuZE1hcmtGdW5jdGlvbmFsUmVzdWx0Iiwidm5vZGVzIiwiY2xvbmUiLCJjb21wb25lbnRWTm9kZUhvb2tzIiwiaW5pdCIsInBhcmVudEVsbSIsInJlZkVsbSIsImtlZXBBbGl2ZSIsIm1vdW50ZWROb2RlIiwicHJlcGF0Y2giLCJjcmVhdGVDb21wb25lbnRJbnN0YW5jZUZvclZub2RlIiwiJG1vdW50Iiwib2xkVm5vZGUiLCJpbnNlcnQiLCJkZXN0cm95IiwiaG9va3NUb01lcmdlIiwiY3JlYXRlQ29tcG9uZW50IiwiX2Jhc2UiLCJjaWQiLCJyZXNvbHZlQ29uc3RydWN0b3JPcHRpb25zIiwibW9kZWwiLCJ0cmFuc2Zvcm1Nb2RlbCIsIm5hdGl2ZU9uIiwiaW5zdGFsbENvbXBvbmVudEhvb2tzIiwiX2lzQ29tcG9uZW50IiwiaW5saW5lVGVtcGxhdGUiLCJjYWxsYmFjayIsIlNJTVBMRV9OT1JNQUxJWkUiLCJBTFdBWVNfTk9STUFMSVpFIiwibm9ybWFsaXphdGlvblR5cGUiLCJhbHdheXNOb3JtYWxpemUiLCJfY3JlYXRlRWxlbWVudCIsImFwcGx5TlMiLCJyZWdpc3RlckRlZXBCaW5kaW5ncyIsImZvcmNlIiwiY2xhc3MiLCJpbml0UmVuZGVyIiwicGFyZW50RGF0YSIsInJlbmRlck1peGluIiwiJG5leHRUaWNrIiwicmVmIiwidWlkJDMiLCJpbml0TWl4aW4iLCJfaW5pdCIsIl91aWQiLCJpbml0SW50ZXJuYWxDb21wb25lbnQiLCJjb25zdHJ1Y3RvciIsIl9zZWxmIiwidm5vZGVDb21wb25lbnRPcHRpb25zIiwiX2NvbXBvbmVudFRhZyIsInN1cGVyIiwic3VwZXJPcHRpb25zIiwiY2FjaGVkU3VwZXJPcHRpb25zIiwibW9kaWZpZWRPcHRpb25zIiwicmVzb2x2ZU1vZGlmaWVkT3B0aW9ucyIsImV4dGVuZE9wdGlvbnMiLCJjb21wb25lbnRzIiwibW9kaWZpZWQiLCJsYXRlc3QiLCJleHRlbmRlZCIsInNlYWxlZCIsInNlYWxlZE9wdGlvbnMiLCJkZWR1cGUiLCJpbml0VXNlIiwidXNlIiwicGx1Z2luIiwiaW5zdGFsbGVkUGx1Z2lucyIsIl9pbnN0YWxsZWRQbHVnaW5zIiwidW5zaGlmdCIsImluc3RhbGwiLCJpbml0TWl4aW4kMSIsIm1peGluIiwiaW5pdEV4dGVuZCIsIlN1cGVyIiwiU3VwZXJJZCIsImNhY2hlZEN0b3JzIiwiX0N0b3IiLCJTdWIiLCJpbml0UHJvcHMkMSIsImluaXRDb21wdXRlZCQxIiwiQ29tcCIsImluaXRBc3NldFJlZ2lzdGVycyIsImRlZmluaXRpb24iLCJnZXRDb21wb25lbnROYW1lIiwibWF0Y2hlcyIsInBhdHRlcm4iLCJwcnVuZUNhY2hlIiwia2VlcEFsaXZlSW5zdGFuY2UiLCJjYWNoZWROb2RlIiwicHJ1bmVDYWNoZUVudHJ5IiwiY3VycmVudCIsImNhY2hlZCQkMSIsInBhdHRlcm5UeXBlcyIsIlJlZ0V4cCIsIktlZXBBbGl2ZSIsImluY2x1ZGUiLCJleGNsdWRlIiwiTnVtYmVyIiwiY3JlYXRlZCIsImRlc3Ryb3llZCIsIm1vdW50ZWQiLCJyZWYkMSIsInBhcnNlSW50IiwiYnVpbHRJbkNvbXBvbmVudHMiLCJpbml0R2xvYmFsQVBJIiwiY29uZmlnRGVmIiwidXRpbCIsImRlbGV0ZSIsInZlcnNpb24iLCJhY2NlcHRWYWx1ZSIsImF0dHIiLCJpc0VudW1lcmF0ZWRBdHRyIiwiaXNCb29sZWFuQXR0ciIsInhsaW5rTlMiLCJpc1hsaW5rIiwiZ2V0WGxpbmtQcm9wIiwiaXNGYWxzeUF0dHJWYWx1ZSIsImdlbkNsYXNzRm9yVm5vZGUiLCJwYXJlbnROb2RlIiwiY2hpbGROb2RlIiwibWVyZ2VDbGFzc0RhdGEiLCJyZW5kZXJDbGFzcyIsInN0YXRpY0NsYXNzIiwiZHluYW1pY0NsYXNzIiwic3RyaW5naWZ5Q2xhc3MiLCJzdHJpbmdpZnlBcnJheSIsInN0cmluZ2lmeU9iamVjdCIsInN0cmluZ2lmaWVkIiwibmFtZXNwYWNlTWFwIiwic3ZnIiwibWF0aCIsImlzSFRNTFRhZyIsImlzU1ZHIiwidW5rbm93bkVsZW1lbnRDYWNoZSIsIkhUTUxVbmtub3duRWxlbWVudCIsIkhUTUxFbGVtZW50IiwiaXNUZXh0SW5wdXRUeXBlIiwicXVlcnkiLCJzZWxlY3RlZCIsInF1ZXJ5U2VsZWN0b3IiLCJjcmVhdGVFbGVtZW50JDEiLCJ0YWdOYW1lIiwibXVsdGlwbGUiLCJzZXRBdHRyaWJ1dGUiLCJjcmVhdGVFbGVtZW50TlMiLCJuYW1lc3BhY2UiLCJjcmVhdGVUZXh0Tm9kZSIsImNyZWF0ZUNvbW1lbnQiLCJpbnNlcnRCZWZvcmUiLCJuZXdOb2RlIiwicmVmZXJlbmNlTm9kZSIsIm5leHRTaWJsaW5nIiwic2V0VGV4dENvbnRlbnQiLCJ0ZXh0Q29udGVudCIsInNldFN0eWxlU2NvcGUiLCJub2RlT3BzIiwicmVnaXN0ZXJSZWYiLCJpc1JlbW92YWwiLCJyZWZzIiwicmVmSW5Gb3IiLCJlbXB0eU5vZGUiLCJzYW1lVm5vZGUiLCJzYW1lSW5wdXRUeXBlIiwidHlwZUEiLCJ0eXBlQiIsImNyZWF0ZUtleVRvT2xkSWR4IiwiYmVnaW5JZHgiLCJlbmRJZHgiLCJjcmVhdGVQYXRjaEZ1bmN0aW9uIiwiYmFja2VuZCIsIm1vZHVsZXMiLCJlbXB0eU5vZGVBdCIsImNyZWF0ZVJtQ2IiLCJjaGlsZEVsbSIsInJlbW92ZU5vZGUiLCJjcmVhdGVFbG0iLCJpbnNlcnRlZFZub2RlUXVldWUiLCJuZXN0ZWQiLCJvd25lckFycmF5Iiwic2V0U2NvcGUiLCJjcmVhdGVDaGlsZHJlbiIsImludm9rZUNyZWF0ZUhvb2tzIiwiaXNSZWFjdGl2YXRlZCIsImluaXRDb21wb25lbnQiLCJyZWFjdGl2YXRlQ29tcG9uZW50IiwicGVuZGluZ0luc2VydCIsImlzUGF0Y2hhYmxlIiwiaW5uZXJOb2RlIiwidHJhbnNpdGlvbiIsImFjdGl2YXRlIiwicmVmJCQxIiwiYW5jZXN0b3IiLCJhZGRWbm9kZXMiLCJzdGFydElkeCIsImludm9rZURlc3Ryb3lIb29rIiwicmVtb3ZlVm5vZGVzIiwiY2giLCJyZW1vdmVBbmRJbnZva2VSZW1vdmVIb29rIiwicm0iLCJ1cGRhdGVDaGlsZHJlbiIsIm9sZENoIiwibmV3Q2giLCJyZW1vdmVPbmx5Iiwib2xkS2V5VG9JZHgiLCJpZHhJbk9sZCIsInZub2RlVG9Nb3ZlIiwib2xkU3RhcnRJZHgiLCJuZXdTdGFydElkeCIsIm9sZEVuZElkeCIsIm9sZFN0YXJ0Vm5vZGUiLCJvbGRFbmRWbm9kZSIsIm5ld0VuZElkeCIsIm5ld1N0YXJ0Vm5vZGUiLCJuZXdFbmRWbm9kZSIsImNhbk1vdmUiLCJwYXRjaFZub2RlIiwiZmluZElkeEluT2xkIiwiZW5kIiwiaHlkcmF0ZSIsInBvc3RwYXRjaCIsImludm9rZUluc2VydEhvb2siLCJpbml0aWFsIiwiaXNSZW5kZXJlZE1vZHVsZSIsImluVlByZSIsInByZSIsImhhc0NoaWxkTm9kZXMiLCJpbm5lckhUTUwiLCJjaGlsZHJlbk1hdGNoIiwiZmlyc3RDaGlsZCIsImZ1bGxJbnZva2UiLCJpc0luaXRpYWxQYXRjaCIsImlzUmVhbEVsZW1lbnQiLCJub2RlVHlwZSIsImhhc0F0dHJpYnV0ZSIsInJlbW92ZUF0dHJpYnV0ZSIsIm9sZEVsbSIsInBhcmVudEVsbSQxIiwiX2xlYXZlQ2IiLCJwYXRjaGFibGUiLCJpJDIiLCJ1cGRhdGVEaXJlY3RpdmVzIiwib2xkRGlyIiwiZGlyIiwiaXNDcmVhdGUiLCJpc0Rlc3Ryb3kiLCJvbGREaXJzIiwibm9ybWFsaXplRGlyZWN0aXZlcyQxIiwibmV3RGlycyIsImRpcnNXaXRoSW5zZXJ0IiwiZGlyc1dpdGhQb3N0cGF0Y2giLCJjYWxsSG9vayQxIiwiY29tcG9uZW50VXBkYXRlZCIsImNhbGxJbnNlcnQiLCJlbXB0eU1vZGlmaWVycyIsIm1vZGlmaWVycyIsImdldFJhd0Rpck5hbWUiLCJyYXdOYW1lIiwiYmFzZU1vZHVsZXMiLCJ1cGRhdGVBdHRycyIsImluaGVyaXRBdHRycyIsIm9sZEF0dHJzIiwic2V0QXR0ciIsInJlbW92ZUF0dHJpYnV0ZU5TIiwiYmFzZVNldEF0dHIiLCJzZXRBdHRyaWJ1dGVOUyIsIl9faWVwaCIsImJsb2NrZXIiLCJzdG9wSW1tZWRpYXRlUHJvcGFnYXRpb24iLCJyZW1vdmVFdmVudExpc3RlbmVyIiwidXBkYXRlQ2xhc3MiLCJvbGREYXRhIiwiY2xzIiwidHJhbnNpdGlvbkNsYXNzIiwiX3RyYW5zaXRpb25DbGFzc2VzIiwiX3ByZXZDbGFzcyIsInRhcmdldCQxIiwia2xhc3MiLCJSQU5HRV9UT0tFTiIsIkNIRUNLQk9YX1JBRElPX1RPS0VOIiwibm9ybWFsaXplRXZlbnRzIiwiY2hhbmdlIiwiY3JlYXRlT25jZUhhbmRsZXIiLCJvbmNlSGFuZGxlciIsInJlbW92ZSQyIiwiYWRkJDEiLCJ1cGRhdGVET01MaXN0ZW5lcnMiLCJldmVudHMiLCJ1cGRhdGVET01Qcm9wcyIsIm9sZFByb3BzIiwiY2hpbGROb2RlcyIsIl92YWx1ZSIsInN0ckN1ciIsInNob3VsZFVwZGF0ZVZhbHVlIiwiY2hlY2tWYWwiLCJjb21wb3NpbmciLCJpc05vdEluRm9jdXNBbmREaXJ0eSIsImlzRGlydHlXaXRoTW9kaWZpZXJzIiwibm90SW5Gb2N1cyIsImFjdGl2ZUVsZW1lbnQiLCJfdk1vZGlmaWVycyIsIm51bWJlciIsInRyaW0iLCJwYXJzZVN0eWxlVGV4dCIsImNzc1RleHQiLCJsaXN0RGVsaW1pdGVyIiwicHJvcGVydHlEZWxpbWl0ZXIiLCJub3JtYWxpemVTdHlsZURhdGEiLCJub3JtYWxpemVTdHlsZUJpbmRpbmciLCJzdGF0aWNTdHlsZSIsImJpbmRpbmdTdHlsZSIsImdldFN0eWxlIiwiY2hlY2tDaGlsZCIsInN0eWxlRGF0YSIsImVtcHR5U3R5bGUiLCJjc3NWYXJSRSIsImltcG9ydGFudFJFIiwic2V0UHJvcCIsInNldFByb3BlcnR5Iiwibm9ybWFsaXplZE5hbWUiLCJub3JtYWxpemUiLCJ2ZW5kb3JOYW1lcyIsImNhcE5hbWUiLCJ1cGRhdGVTdHlsZSIsIm9sZFN0YXRpY1N0eWxlIiwib2xkU3R5bGVCaW5kaW5nIiwibm9ybWFsaXplZFN0eWxlIiwib2xkU3R5bGUiLCJuZXdTdHlsZSIsImFkZENsYXNzIiwiY2xhc3NMaXN0IiwiZ2V0QXR0cmlidXRlIiwicmVtb3ZlQ2xhc3MiLCJ0YXIiLCJyZXNvbHZlVHJhbnNpdGlvbiIsImNzcyIsImF1dG9Dc3NUcmFuc2l0aW9uIiwiZW50ZXJDbGFzcyIsImVudGVyVG9DbGFzcyIsImVudGVyQWN0aXZlQ2xhc3MiLCJsZWF2ZUNsYXNzIiwibGVhdmVUb0NsYXNzIiwibGVhdmVBY3RpdmVDbGFzcyIsImhhc1RyYW5zaXRpb24iLCJUUkFOU0lUSU9OIiwiQU5JTUFUSU9OIiwidHJhbnNpdGlvblByb3AiLCJ0cmFuc2l0aW9uRW5kRXZlbnQiLCJhbmltYXRpb25Qcm9wIiwiYW5pbWF0aW9uRW5kRXZlbnQiLCJvbnRyYW5zaXRpb25lbmQiLCJvbndlYmtpdHRyYW5zaXRpb25lbmQiLCJvbmFuaW1hdGlvbmVuZCIsIm9ud2Via2l0YW5pbWF0aW9uZW5kIiwicmFmIiwicmVxdWVzdEFuaW1hdGlvbkZyYW1lIiwibmV4dEZyYW1lIiwiYWRkVHJhbnNpdGlvbkNsYXNzIiwidHJhbnNpdGlvbkNsYXNzZXMiLCJyZW1vdmVUcmFuc2l0aW9uQ2xhc3MiLCJ3aGVuVHJhbnNpdGlvbkVuZHMiLCJleHBlY3RlZFR5cGUiLCJnZXRUcmFuc2l0aW9uSW5mbyIsInByb3BDb3VudCIsImVuZGVkIiwib25FbmQiLCJ0cmFuc2Zvcm1SRSIsInN0eWxlcyIsImdldENvbXB1dGVkU3R5bGUiLCJ0cmFuc2l0aW9uRGVsYXlzIiwidHJhbnNpdGlvbkR1cmF0aW9ucyIsInRyYW5zaXRpb25UaW1lb3V0IiwiZ2V0VGltZW91dCIsImFuaW1hdGlvbkRlbGF5cyIsImFuaW1hdGlvbkR1cmF0aW9ucyIsImFuaW1hdGlvblRpbWVvdXQiLCJoYXNUcmFuc2Zvcm0iLCJkZWxheXMiLCJkdXJhdGlvbnMiLCJ0b01zIiwicyIsImVudGVyIiwidG9nZ2xlRGlzcGxheSIsImNhbmNlbGxlZCIsIl9lbnRlckNiIiwiYXBwZWFyQ2xhc3MiLCJhcHBlYXJUb0NsYXNzIiwiYXBwZWFyQWN0aXZlQ2xhc3MiLCJiZWZvcmVFbnRlciIsImFmdGVyRW50ZXIiLCJlbnRlckNhbmNlbGxlZCIsImJlZm9yZUFwcGVhciIsImFwcGVhciIsImFmdGVyQXBwZWFyIiwiYXBwZWFyQ2FuY2VsbGVkIiwiZHVyYXRpb24iLCJ0cmFuc2l0aW9uTm9kZSIsImlzQXBwZWFyIiwic3RhcnRDbGFzcyIsImFjdGl2ZUNsYXNzIiwidG9DbGFzcyIsImJlZm9yZUVudGVySG9vayIsImVudGVySG9vayIsImFmdGVyRW50ZXJIb29rIiwiZW50ZXJDYW5jZWxsZWRIb29rIiwiZXhwbGljaXRFbnRlckR1cmF0aW9uIiwiZXhwZWN0c0NTUyIsInVzZXJXYW50c0NvbnRyb2wiLCJnZXRIb29rQXJndW1lbnRzTGVuZ3RoIiwic2hvdyIsInBlbmRpbmdOb2RlIiwiX3BlbmRpbmciLCJpc1ZhbGlkRHVyYXRpb24iLCJsZWF2ZSIsImJlZm9yZUxlYXZlIiwiYWZ0ZXJMZWF2ZSIsImxlYXZlQ2FuY2VsbGVkIiwiZGVsYXlMZWF2ZSIsImV4cGxpY2l0TGVhdmVEdXJhdGlvbiIsInBlcmZvcm1MZWF2ZSIsImludm9rZXJGbnMiLCJfZW50ZXIiLCJwbGF0Zm9ybU1vZHVsZXMiLCJwYXRjaCIsInZtb2RlbCIsInRyaWdnZXIiLCJkaXJlY3RpdmUiLCJiaW5kaW5nIiwiX3ZPcHRpb25zIiwic2V0U2VsZWN0ZWQiLCJnZXRWYWx1ZSIsIm9uQ29tcG9zaXRpb25TdGFydCIsIm9uQ29tcG9zaXRpb25FbmQiLCJwcmV2T3B0aW9ucyIsImN1ck9wdGlvbnMiLCJzb21lIiwibyIsIm5lZWRSZXNldCIsImhhc05vTWF0Y2hpbmdPcHRpb24iLCJhY3R1YWxseVNldFNlbGVjdGVkIiwiaXNNdWx0aXBsZSIsIm9wdGlvbiIsInNlbGVjdGVkSW5kZXgiLCJjcmVhdGVFdmVudCIsImluaXRFdmVudCIsImRpc3BhdGNoRXZlbnQiLCJsb2NhdGVOb2RlIiwidHJhbnNpdGlvbiQkMSIsIm9yaWdpbmFsRGlzcGxheSIsIl9fdk9yaWdpbmFsRGlzcGxheSIsInVuYmluZCIsInBsYXRmb3JtRGlyZWN0aXZlcyIsInRyYW5zaXRpb25Qcm9wcyIsIm1vZGUiLCJnZXRSZWFsQ2hpbGQiLCJjb21wT3B0aW9ucyIsImV4dHJhY3RUcmFuc2l0aW9uRGF0YSIsInBsYWNlaG9sZGVyIiwicmF3Q2hpbGQiLCJoYXNQYXJlbnRUcmFuc2l0aW9uIiwiaXNTYW1lQ2hpbGQiLCJvbGRDaGlsZCIsIlRyYW5zaXRpb24iLCJfbGVhdmluZyIsIm9sZFJhd0NoaWxkIiwiZGVsYXllZExlYXZlIiwibW92ZUNsYXNzIiwiVHJhbnNpdGlvbkdyb3VwIiwicHJldkNoaWxkcmVuIiwicmF3Q2hpbGRyZW4iLCJ0cmFuc2l0aW9uRGF0YSIsImtlcHQiLCJyZW1vdmVkIiwiYyQxIiwicG9zIiwiZ2V0Qm91bmRpbmdDbGllbnRSZWN0IiwiYmVmb3JlVXBkYXRlIiwidXBkYXRlZCIsImhhc01vdmUiLCJjYWxsUGVuZGluZ0NicyIsInJlY29yZFBvc2l0aW9uIiwiYXBwbHlUcmFuc2xhdGlvbiIsIl9yZWZsb3ciLCJib2R5Iiwib2Zmc2V0SGVpZ2h0IiwibW92ZWQiLCJ0cmFuc2Zvcm0iLCJXZWJraXRUcmFuc2Zvcm0iLCJ0cmFuc2l0aW9uRHVyYXRpb24iLCJfbW92ZUNiIiwicHJvcGVydHlOYW1lIiwiX2hhc01vdmUiLCJjbG9uZU5vZGUiLCJuZXdQb3MiLCJvbGRQb3MiLCJkeCIsImxlZnQiLCJkeSIsInRvcCIsInBsYXRmb3JtQ29tcG9uZW50cyIsInN0b3JlIiwiVVNFX1NZTUJPTCIsIiRleHBvcnRzIiwidGhhdCIsInVuIiwiY3JlYXRlRGVzYyIsIm9iamVjdCIsIkFycmF5UHJvdG8iLCJPYmplY3RQcm90byIsImRlc2NyaXB0b3IiLCJjZWlsIiwiYml0bWFwIiwiaXNBcnJheUl0ZXIiLCJ0b0xlbmd0aCIsImdldEl0ZXJGbiIsIkJSRUFLIiwiUkVUVVJOIiwiaXRlcmFibGUiLCJzdGVwIiwiaXRlckZuIiwiVHlwZUVycm9yIiwiZG9uZSIsImRlZmluZWQiLCJJbnRlcm5hbCIsIm5ld0dlbmVyaWNQcm9taXNlQ2FwYWJpbGl0eSIsIk93blByb21pc2VDYXBhYmlsaXR5IiwiV3JhcHBlciIsImFGdW5jdGlvbiIsImFuSW5zdGFuY2UiLCJmb3JPZiIsInRhc2siLCJtaWNyb3Rhc2siLCJuZXdQcm9taXNlQ2FwYWJpbGl0eU1vZHVsZSIsInBlcmZvcm0iLCJQUk9NSVNFIiwidmVyc2lvbnMiLCJ2OCIsIiRQcm9taXNlIiwiaXNOb2RlIiwiZW1wdHkiLCJuZXdQcm9taXNlQ2FwYWJpbGl0eSIsIlVTRV9OQVRJVkUiLCJwcm9taXNlIiwiRmFrZVByb21pc2UiLCJleGVjIiwiUHJvbWlzZVJlamVjdGlvbkV2ZW50IiwiaXNUaGVuYWJsZSIsImlzUmVqZWN0IiwiY2hhaW4iLCJvayIsInJlYWN0aW9uIiwiZXhpdGVkIiwiZmFpbCIsImRvbWFpbiIsIl9oIiwib25IYW5kbGVVbmhhbmRsZWQiLCJleGl0Iiwib25VbmhhbmRsZWQiLCJ1bmhhbmRsZWQiLCJpc1VuaGFuZGxlZCIsIm9udW5oYW5kbGVkcmVqZWN0aW9uIiwiX2EiLCJvbnJlamVjdGlvbmhhbmRsZWQiLCIkcmVqZWN0IiwiX2QiLCJfdyIsIiRyZXNvbHZlIiwid3JhcHBlciIsImV4ZWN1dG9yIiwib25GdWxmaWxsZWQiLCJvblJlamVjdGVkIiwiY2F0Y2giLCJHIiwiVyIsIlMiLCJyIiwiY2FwYWJpbGl0eSIsIiQkcmVqZWN0IiwiaXRlciIsImFsbCIsInJlbWFpbmluZyIsIiRpbmRleCIsImFscmVhZHlDYWxsZWQiLCJyYWNlIiwiU0hBUkVEIiwiY29weXJpZ2h0Iiwib3duIiwib3V0IiwiZXhwIiwiSVNfRk9SQ0VEIiwiSVNfR0xPQkFMIiwiSVNfU1RBVElDIiwiSVNfUFJPVE8iLCJJU19CSU5EIiwiZXhwUHJvdG8iLCJVIiwiU0FGRV9DTE9TSU5HIiwicml0ZXIiLCJza2lwQ2xvc2luZyIsInNoYXJlZCIsInByb3BlcnR5SXNFbnVtZXJhYmxlIiwiSU9iamVjdCIsInZhbHVlT2YiLCJzZWxmIiwiX19nIiwidG9JbnRlZ2VyIiwibWluIiwiREVTQ1JJUFRPUlMiLCJTUEVDSUVTIiwiS0VZIiwic3RhdCIsIkZQcm90byIsIm5hbWVSRSIsIm1hY3JvdGFzayIsIk11dGF0aW9uT2JzZXJ2ZXIiLCJXZWJLaXRNdXRhdGlvbk9ic2VydmVyIiwiaGVhZCIsImZsdXNoIiwic3RhbmRhbG9uZSIsInRvZ2dsZSIsImNoYXJhY3RlckRhdGEiLCJfX2UiLCJJRThfRE9NX0RFRklORSIsInRvUHJpbWl0aXZlIiwiQXR0cmlidXRlcyIsIlVOU0NPUEFCTEVTIiwiUHJvbWlzZUNhcGFiaWxpdHkiLCIkJHJlc29sdmUiLCJwcm9taXNlQ2FwYWJpbGl0eSIsInRvSU9iamVjdCIsInRvQWJzb2x1dGVJbmRleCIsIklTX0lOQ0xVREVTIiwiJHRoaXMiLCJmcm9tSW5kZXgiLCJnIiwiZXZhbCIsInB4IiwicmFuZG9tIiwiYWRkVG9VbnNjb3BhYmxlcyIsIml0ZXJhdGVkIiwiQXJndW1lbnRzIiwiYXJyYXlJbmRleE9mIiwibmFtZXMiLCJEIiwiZm9yYmlkZGVuRmllbGQiLCJkb2N1bWVudEVsZW1lbnQiXSwibWFwcGluZ3MiOiJpSEFDQSxJQUFBQSxFQUFBQyxFQUFBLFFBQ0FDLEVBQUFELEVBQUEsUUFDQUUsRUFBQUYsRUFBQSxRQUNBRyxFQUFBSCxFQUFBLFFBQ0FJLEVBQUFKLEVBQUEsUUFDQUssRUFBQUwsRUFBQSxRQUNBTSxFQUFBTixFQUFBLFFBQ0FPLEVBQUFQLEVBQUEsUUFDQVEsRUFBQVIsRUFBQSxPQUFBQSxDQUFBLFlBQ0FTLE9BQUFDLE1BQUEsV0FBQUEsUUFDQUMsRUFBQSxhQUNBQyxFQUFBLE9BQ0FDLEVBQUEsU0FFQUMsRUFBQSxXQUE4QixPQUFBQyxNQUU5QkMsRUFBQUMsUUFBQSxTQUFBQyxFQUFBQyxFQUFBQyxFQUFBQyxFQUFBQyxFQUFBQyxFQUFBQyxHQUNBbkIsRUFBQWUsRUFBQUQsRUFBQUUsR0FDQSxJQWVBSSxFQUFBQyxFQUFBQyxFQWZBQyxFQUFBLFNBQUFDLEdBQ0EsSUFBQXBCLEdBQUFvQixLQUFBQyxFQUFBLE9BQUFBLEVBQUFELEdBQ0EsT0FBQUEsR0FDQSxLQUFBakIsRUFBQSxrQkFBeUMsV0FBQVEsRUFBQUwsS0FBQWMsSUFDekMsS0FBQWhCLEVBQUEsa0JBQTZDLFdBQUFPLEVBQUFMLEtBQUFjLElBQ3hDLGtCQUE0QixXQUFBVCxFQUFBTCxLQUFBYyxLQUVqQ0UsRUFBQVosRUFBQSxZQUNBYSxFQUFBVixHQUFBVCxFQUNBb0IsR0FBQSxFQUNBSCxFQUFBWixFQUFBZ0IsVUFDQUMsRUFBQUwsRUFBQXRCLElBQUFzQixFQUFBbkIsSUFBQVcsR0FBQVEsRUFBQVIsR0FDQWMsRUFBQUQsR0FBQVAsRUFBQU4sR0FDQWUsRUFBQWYsRUFBQVUsRUFBQUosRUFBQSxXQUFBUSxPQUFBRSxFQUNBQyxFQUFBLFNBQUFwQixHQUFBVyxFQUFBVSxTQUFBTCxFQXdCQSxHQXJCQUksSUFDQVosRUFBQXBCLEVBQUFnQyxFQUFBRSxLQUFBLElBQUF2QixJQUNBUyxJQUFBZSxPQUFBUixXQUFBUCxFQUFBTixPQUVBZixFQUFBcUIsRUFBQUksR0FBQSxHQUVBaEMsR0FBQSxtQkFBQTRCLEVBQUFuQixJQUFBTCxFQUFBd0IsRUFBQW5CLEVBQUFNLEtBSUFrQixHQUFBRyxLQUFBUSxPQUFBOUIsSUFDQW9CLEdBQUEsRUFDQUcsRUFBQSxXQUFrQyxPQUFBRCxFQUFBTSxLQUFBMUIsUUFHbENoQixJQUFBeUIsSUFBQWYsSUFBQXdCLEdBQUFILEVBQUF0QixJQUNBTCxFQUFBMkIsRUFBQXRCLEVBQUE0QixHQUdBaEMsRUFBQWUsR0FBQWlCLEVBQ0FoQyxFQUFBMkIsR0FBQWpCLEVBQ0FRLEVBTUEsR0FMQUcsR0FDQW1CLE9BQUFaLEVBQUFJLEVBQUFSLEVBQUFmLEdBQ0FILEtBQUFhLEVBQUFhLEVBQUFSLEVBQUFoQixHQUNBNEIsUUFBQUgsR0FFQWIsRUFBQSxJQUFBRSxLQUFBRCxFQUNBQyxLQUFBSSxHQUFBNUIsRUFBQTRCLEVBQUFKLEVBQUFELEVBQUFDLFNBQ0t6QixJQUFBNEMsRUFBQTVDLEVBQUE2QyxHQUFBckMsR0FBQXdCLEdBQUFkLEVBQUFNLEdBRUwsT0FBQUEsd0NDakVBLElBQUF4QixFQUFBRCxFQUFBLFFBQ0ErQyxFQUFBL0MsRUFBQSxRQUNBZ0QsRUFBQWhELEVBQUEsUUFDQWlELEVBQUFqRCxFQUFBLFFBQ0FrRCxFQUFBbEQsRUFBQSxRQUVBQyxJQUFBNEMsRUFBQTVDLEVBQUFrRCxFQUFBLFdBQTJDQyxRQUFBLFNBQUFDLEdBQzNDLElBQUFDLEVBQUFMLEVBQUFsQyxLQUFBZ0MsRUFBQVEsU0FBQVAsRUFBQU8sU0FDQUMsRUFBQSxtQkFBQUgsRUFDQSxPQUFBdEMsS0FBQTBDLEtBQ0FELEVBQUEsU0FBQUUsR0FDQSxPQUFBUixFQUFBSSxFQUFBRCxLQUFBSSxLQUFBLFdBQThELE9BQUFDLEtBQ3pETCxFQUNMRyxFQUFBLFNBQUFHLEdBQ0EsT0FBQVQsRUFBQUksRUFBQUQsS0FBQUksS0FBQSxXQUE4RCxNQUFBRSxLQUN6RE4sOEJDaEJMLElBQUFPLEVBQUE1RCxFQUFBLFFBQ0E2RCxFQUFBN0QsRUFBQSxRQUVBZ0IsRUFBQUMsUUFBQXlCLE9BQUFoQyxNQUFBLFNBQUFvRCxHQUNBLE9BQUFGLEVBQUFFLEVBQUFELDBCQ0xBLElBQUFFLEVBQUEvRCxFQUFBLFFBQ0FnRSxFQUFBaEUsRUFBQSxRQUNBaUUsRUFBQWpFLEVBQUEsUUFFQWdCLEVBQUFDLFFBQUFqQixFQUFBLFFBQUEwQyxPQUFBd0IsaUJBQUEsU0FBQUosRUFBQUssR0FDQUgsRUFBQUYsR0FDQSxJQUdBakIsRUFIQW5DLEVBQUF1RCxFQUFBRSxHQUNBQyxFQUFBMUQsRUFBQTBELE9BQ0FDLEVBQUEsRUFFQSxNQUFBRCxFQUFBQyxFQUFBTixFQUFBTyxFQUFBUixFQUFBakIsRUFBQW5DLEVBQUEyRCxLQUFBRixFQUFBdEIsSUFDQSxPQUFBaUIseUJDWEEsSUFhQVMsRUFBQUMsRUFBQUMsRUFiQUMsRUFBQTFFLEVBQUEsUUFDQTJFLEVBQUEzRSxFQUFBLFFBQ0E0RSxFQUFBNUUsRUFBQSxRQUNBNkUsRUFBQTdFLEVBQUEsUUFDQWdELEVBQUFoRCxFQUFBLFFBQ0E4RSxFQUFBOUIsRUFBQThCLFFBQ0FDLEVBQUEvQixFQUFBZ0MsYUFDQUMsRUFBQWpDLEVBQUFrQyxlQUNBQyxFQUFBbkMsRUFBQW1DLGVBQ0FDLEVBQUFwQyxFQUFBb0MsU0FDQUMsRUFBQSxFQUNBQyxLQUNBQyxFQUFBLHFCQUVBQyxFQUFBLFdBQ0EsSUFBQUMsR0FBQTFFLEtBRUEsR0FBQXVFLEVBQUFJLGVBQUFELEdBQUEsQ0FDQSxJQUFBRSxFQUFBTCxFQUFBRyxVQUNBSCxFQUFBRyxHQUNBRSxNQUdBQyxFQUFBLFNBQUFDLEdBQ0FMLEVBQUEvQyxLQUFBb0QsRUFBQUMsT0FHQWYsR0FBQUUsSUFDQUYsRUFBQSxTQUFBWSxHQUNBLElBQUFJLEtBQ0ExQixFQUFBLEVBQ0EsTUFBQTJCLFVBQUE1QixPQUFBQyxFQUFBMEIsRUFBQUUsS0FBQUQsVUFBQTNCLE1BTUEsT0FMQWlCLElBQUFELEdBQUEsV0FFQVYsRUFBQSxtQkFBQWdCLElBQUFPLFNBQUFQLEdBQUFJLElBRUF4QixFQUFBYyxHQUNBQSxHQUVBSixFQUFBLFNBQUFRLFVBQ0FILEVBQUFHLElBR0EsV0FBQXpGLEVBQUEsT0FBQUEsQ0FBQThFLEdBQ0FQLEVBQUEsU0FBQWtCLEdBQ0FYLEVBQUFxQixTQUFBekIsRUFBQWMsRUFBQUMsRUFBQSxLQUdHTCxLQUFBZ0IsSUFDSDdCLEVBQUEsU0FBQWtCLEdBQ0FMLEVBQUFnQixJQUFBMUIsRUFBQWMsRUFBQUMsRUFBQSxLQUdHTixHQUNIWCxFQUFBLElBQUFXLEVBQ0FWLEVBQUFELEVBQUE2QixNQUNBN0IsRUFBQThCLE1BQUFDLFVBQUFYLEVBQ0FyQixFQUFBRyxFQUFBRCxFQUFBK0IsWUFBQS9CLEVBQUEsSUFHR3pCLEVBQUF5RCxrQkFBQSxtQkFBQUQsY0FBQXhELEVBQUEwRCxlQUNIbkMsRUFBQSxTQUFBa0IsR0FDQXpDLEVBQUF3RCxZQUFBZixFQUFBLFNBRUF6QyxFQUFBeUQsaUJBQUEsVUFBQWIsR0FBQSxJQUdBckIsRUFER2dCLEtBQUFWLEVBQUEsVUFDSCxTQUFBWSxHQUNBYixFQUFBK0IsWUFBQTlCLEVBQUEsV0FBQVUsR0FBQSxXQUNBWCxFQUFBZ0MsWUFBQTdGLE1BQ0F5RSxFQUFBL0MsS0FBQWdELEtBS0EsU0FBQUEsR0FDQW9CLFdBQUFuQyxFQUFBYyxFQUFBQyxFQUFBLFFBSUF6RSxFQUFBQyxTQUNBNkYsSUFBQS9CLEVBQ0FnQyxNQUFBOUIsMkJDakZBLElBQUFqQixFQUFBaEUsRUFBQSxRQUNBZ0IsRUFBQUMsUUFBQSxTQUFBK0YsRUFBQXJCLEVBQUFzQixFQUFBekUsR0FDQSxJQUNBLE9BQUFBLEVBQUFtRCxFQUFBM0IsRUFBQWlELEdBQUEsR0FBQUEsRUFBQSxJQUFBdEIsRUFBQXNCLEdBRUcsTUFBQXRELEdBQ0gsSUFBQXVELEVBQUFGLEVBQUEsVUFFQSxXQURBMUUsSUFBQTRFLEdBQUFsRCxFQUFBa0QsRUFBQXpFLEtBQUF1RSxJQUNBckQsNEJDVEEsSUFBQXdELEVBQUFuSCxFQUFBLFFBQ0FvSCxFQUFBcEgsRUFBQSxRQUFBb0gsU0FFQUMsRUFBQUYsRUFBQUMsSUFBQUQsRUFBQUMsRUFBQUUsZUFDQXRHLEVBQUFDLFFBQUEsU0FBQXNHLEdBQ0EsT0FBQUYsRUFBQUQsRUFBQUUsY0FBQUMsK0JDSkEsSUFBQUMsRUFBQXhILEVBQUEsUUFDQStCLEVBQUEvQixFQUFBLE9BQUFBLENBQUEsZUFFQXlILEVBQStDLGFBQS9DRCxFQUFBLFdBQTJCLE9BQUF4QixVQUEzQixJQUdBMEIsRUFBQSxTQUFBSCxFQUFBN0YsR0FDQSxJQUNBLE9BQUE2RixFQUFBN0YsR0FDRyxNQUFBaUMsTUFHSDNDLEVBQUFDLFFBQUEsU0FBQXNHLEdBQ0EsSUFBQXpELEVBQUE2RCxFQUFBQyxFQUNBLFlBQUF0RixJQUFBaUYsRUFBQSxtQkFBQUEsRUFBQSxPQUVBLGlCQUFBSSxFQUFBRCxFQUFBNUQsRUFBQXBCLE9BQUE2RSxHQUFBeEYsSUFBQTRGLEVBRUFGLEVBQUFELEVBQUExRCxHQUVBLFdBQUE4RCxFQUFBSixFQUFBMUQsS0FBQSxtQkFBQUEsRUFBQStELE9BQUEsWUFBQUQsMkJDckJBLElBQUFFLEVBQUE5SCxFQUFBLFFBQ0FRLEVBQUFSLEVBQUEsT0FBQUEsQ0FBQSxZQUNBSSxFQUFBSixFQUFBLFFBQ0FnQixFQUFBQyxRQUFBakIsRUFBQSxRQUFBK0gsa0JBQUEsU0FBQVIsR0FDQSxRQUFBakYsR0FBQWlGLEVBQUEsT0FBQUEsRUFBQS9HLElBQ0ErRyxFQUFBLGVBQ0FuSCxFQUFBMEgsRUFBQVAsd0NDQUEsU0FBQVMsRUFDQUMsRUFDQUMsRUFDQUMsRUFDQUMsRUFDQUMsRUFDQUMsRUFDQUMsRUFDQUMsR0FHQSxJQXFCQUMsRUFyQkFDLEVBQUEsb0JBQUFULEVBQ0FBLEVBQUFTLFFBQ0FULEVBaURBLEdBOUNBQyxJQUNBUSxFQUFBUixTQUNBUSxFQUFBUCxrQkFDQU8sRUFBQUMsV0FBQSxHQUlBUCxJQUNBTSxFQUFBRSxZQUFBLEdBSUFOLElBQ0FJLEVBQUFHLFNBQUEsVUFBQVAsR0FJQUMsR0FDQUUsRUFBQSxTQUFBSyxHQUVBQSxFQUNBQSxHQUNBL0gsS0FBQWdJLFFBQUFoSSxLQUFBZ0ksT0FBQUMsWUFDQWpJLEtBQUFrSSxRQUFBbEksS0FBQWtJLE9BQUFGLFFBQUFoSSxLQUFBa0ksT0FBQUYsT0FBQUMsV0FFQUYsR0FBQSxxQkFBQUksc0JBQ0FKLEVBQUFJLHFCQUdBYixHQUNBQSxFQUFBNUYsS0FBQTFCLEtBQUErSCxHQUdBQSxLQUFBSyx1QkFDQUwsRUFBQUssc0JBQUFDLElBQUFiLElBS0FHLEVBQUFXLGFBQUFaLEdBQ0dKLElBQ0hJLEVBQUFELEVBQ0EsV0FBcUJILEVBQUE1RixLQUFBMUIsVUFBQXVJLE1BQUFDLFNBQUFDLGFBQ3JCbkIsR0FHQUksRUFDQSxHQUFBQyxFQUFBRSxXQUFBLENBR0FGLEVBQUFlLGNBQUFoQixFQUVBLElBQUFpQixFQUFBaEIsRUFBQVIsT0FDQVEsRUFBQVIsT0FBQSxTQUFBeUIsRUFBQWIsR0FFQSxPQURBTCxFQUFBaEcsS0FBQXFHLEdBQ0FZLEVBQUFDLEVBQUFiLFFBRUssQ0FFTCxJQUFBYyxFQUFBbEIsRUFBQW1CLGFBQ0FuQixFQUFBbUIsYUFBQUQsS0FDQUUsT0FBQUYsRUFBQW5CLElBQ0FBLEdBSUEsT0FDQXhILFFBQUFnSCxFQUNBUyxXQTFGQTFJLEVBQUErSixFQUFBQyxFQUFBLHNCQUFBaEMsNEJDQUEsSUFBQWhGLEVBQUFoRCxFQUFBLFFBQ0FHLEVBQUFILEVBQUEsUUFDQWlLLEVBQUFqSyxFQUFBLFFBQ0FrSyxFQUFBbEssRUFBQSxPQUFBQSxDQUFBLE9BQ0FtSyxFQUFBLFdBQ0FDLEVBQUFsRSxTQUFBaUUsR0FDQUUsR0FBQSxHQUFBRCxHQUFBRSxNQUFBSCxHQUVBbkssRUFBQSxRQUFBdUssY0FBQSxTQUFBaEQsR0FDQSxPQUFBNkMsRUFBQTNILEtBQUE4RSxLQUdBdkcsRUFBQUMsUUFBQSxTQUFBNkMsRUFBQXBDLEVBQUE4SSxFQUFBQyxHQUNBLElBQUFqSCxFQUFBLG1CQUFBZ0gsRUFDQWhILElBQUF5RyxFQUFBTyxFQUFBLFNBQUFySyxFQUFBcUssRUFBQSxPQUFBOUksSUFDQW9DLEVBQUFwQyxLQUFBOEksSUFDQWhILElBQUF5RyxFQUFBTyxFQUFBTixJQUFBL0osRUFBQXFLLEVBQUFOLEVBQUFwRyxFQUFBcEMsR0FBQSxHQUFBb0MsRUFBQXBDLEdBQUEySSxFQUFBSyxLQUFBQyxPQUFBakosTUFDQW9DLElBQUFkLEVBQ0FjLEVBQUFwQyxHQUFBOEksRUFDR0MsRUFHQTNHLEVBQUFwQyxHQUNIb0MsRUFBQXBDLEdBQUE4SSxFQUVBckssRUFBQTJELEVBQUFwQyxFQUFBOEksV0FMQTFHLEVBQUFwQyxHQUNBdkIsRUFBQTJELEVBQUFwQyxFQUFBOEksT0FPQ3RFLFNBQUFoRSxVQUFBaUksRUFBQSxXQUNELHlCQUFBcEosV0FBQW1KLElBQUFFLEVBQUEzSCxLQUFBMUIsZ0NDNUJBLElBQUFpRCxFQUFBaEUsRUFBQSxRQUNBNEssRUFBQTVLLEVBQUEsUUFDQTZELEVBQUE3RCxFQUFBLFFBQ0E2SyxFQUFBN0ssRUFBQSxPQUFBQSxDQUFBLFlBQ0E4SyxFQUFBLGFBQ0FDLEVBQUEsWUFHQUMsRUFBQSxXQUVBLElBSUFDLEVBSkFDLEVBQUFsTCxFQUFBLE9BQUFBLENBQUEsVUFDQXFFLEVBQUFSLEVBQUFPLE9BQ0ErRyxFQUFBLElBQ0FDLEVBQUEsSUFFQUYsRUFBQUcsTUFBQUMsUUFBQSxPQUNBdEwsRUFBQSxRQUFBMkcsWUFBQXVFLEdBQ0FBLEVBQUFLLElBQUEsY0FHQU4sRUFBQUMsRUFBQU0sY0FBQXBFLFNBQ0E2RCxFQUFBUSxPQUNBUixFQUFBUyxNQUFBUCxFQUFBLFNBQUFDLEVBQUEsb0JBQUFELEVBQUEsVUFBQUMsR0FDQUgsRUFBQVUsUUFDQVgsRUFBQUMsRUFBQW5JLEVBQ0EsTUFBQXVCLFdBQUEyRyxFQUFBRCxHQUFBbEgsRUFBQVEsSUFDQSxPQUFBMkcsS0FHQWhLLEVBQUFDLFFBQUF5QixPQUFBa0osUUFBQSxTQUFBOUgsRUFBQUssR0FDQSxJQUFBMEgsRUFRQSxPQVBBLE9BQUEvSCxHQUNBZ0gsRUFBQUMsR0FBQS9HLEVBQUFGLEdBQ0ErSCxFQUFBLElBQUFmLEVBQ0FBLEVBQUFDLEdBQUEsS0FFQWMsRUFBQWhCLEdBQUEvRyxHQUNHK0gsRUFBQWIsU0FDSDFJLElBQUE2QixFQUFBMEgsRUFBQWpCLEVBQUFpQixFQUFBMUgsMENDdkNBLFNBQUFuQjs7Ozs7O0FBT0EsSUFBQThJLEVBQUFwSixPQUFBcUosV0FJQSxTQUFBQyxFQUFBQyxHQUNBLFlBQUEzSixJQUFBMkosR0FBQSxPQUFBQSxFQUdBLFNBQUFDLEVBQUFELEdBQ0EsWUFBQTNKLElBQUEySixHQUFBLE9BQUFBLEVBR0EsU0FBQUUsRUFBQUYsR0FDQSxXQUFBQSxFQUdBLFNBQUFHLEVBQUFILEdBQ0EsV0FBQUEsRUFNQSxTQUFBSSxFQUFBcEYsR0FDQSxNQUNBLGtCQUFBQSxHQUNBLGtCQUFBQSxHQUVBLGtCQUFBQSxHQUNBLG1CQUFBQSxFQVNBLFNBQUFFLEVBQUFtRixHQUNBLGNBQUFBLEdBQUEsa0JBQUFBLEVBTUEsSUFBQUMsRUFBQTdKLE9BQUFSLFVBQUFzSyxTQVVBLFNBQUFDLEVBQUFILEdBQ0EsMEJBQUFDLEVBQUE5SixLQUFBNkosR0FHQSxTQUFBSSxFQUFBVCxHQUNBLDBCQUFBTSxFQUFBOUosS0FBQXdKLEdBTUEsU0FBQVUsRUFBQW5DLEdBQ0EsSUFBQW9DLEVBQUFDLFdBQUFsQyxPQUFBSCxJQUNBLE9BQUFvQyxHQUFBLEdBQUFFLEtBQUFDLE1BQUFILFFBQUFJLFNBQUF4QyxHQU1BLFNBQUFnQyxFQUFBaEMsR0FDQSxhQUFBQSxFQUNBLEdBQ0Esa0JBQUFBLEVBQ0F5QyxLQUFBQyxVQUFBMUMsRUFBQSxRQUNBRyxPQUFBSCxHQU9BLFNBQUEyQyxFQUFBM0MsR0FDQSxJQUFBb0MsRUFBQUMsV0FBQXJDLEdBQ0EsT0FBQTRDLE1BQUFSLEdBQUFwQyxFQUFBb0MsRUFPQSxTQUFBUyxFQUNBQyxFQUNBQyxHQUlBLElBRkEsSUFBQUMsRUFBQTlLLE9BQUFrSixPQUFBLE1BQ0E2QixFQUFBSCxFQUFBaEQsTUFBQSxLQUNBakcsRUFBQSxFQUFpQkEsRUFBQW9KLEVBQUFySixPQUFpQkMsSUFDbENtSixFQUFBQyxFQUFBcEosS0FBQSxFQUVBLE9BQUFrSixFQUNBLFNBQUEvQyxHQUFzQixPQUFBZ0QsRUFBQWhELEVBQUFrRCxnQkFDdEIsU0FBQWxELEdBQXNCLE9BQUFnRCxFQUFBaEQsSUFNdEI2QyxFQUFBLHlCQUtBTSxFQUFBTixFQUFBLDhCQUtBLFNBQUFPLEVBQUFDLEVBQUFDLEdBQ0EsR0FBQUQsRUFBQXpKLE9BQUEsQ0FDQSxJQUFBMkosRUFBQUYsRUFBQUcsUUFBQUYsR0FDQSxHQUFBQyxHQUFBLEVBQ0EsT0FBQUYsRUFBQUksT0FBQUYsRUFBQSxJQVFBLElBQUFySSxFQUFBaEQsT0FBQVIsVUFBQXdELGVBQ0EsU0FBQXdJLEVBQUE1QixFQUFBNUssR0FDQSxPQUFBZ0UsRUFBQWpELEtBQUE2SixFQUFBNUssR0FNQSxTQUFBeU0sRUFBQXhJLEdBQ0EsSUFBQXlJLEVBQUExTCxPQUFBa0osT0FBQSxNQUNBLGdCQUFBMEIsR0FDQSxJQUFBZSxFQUFBRCxFQUFBZCxHQUNBLE9BQUFlLElBQUFELEVBQUFkLEdBQUEzSCxFQUFBMkgsS0FPQSxJQUFBZ0IsRUFBQSxTQUNBQyxFQUFBSixFQUFBLFNBQUFiLEdBQ0EsT0FBQUEsRUFBQWtCLFFBQUFGLEVBQUEsU0FBQUcsRUFBQUMsR0FBa0QsT0FBQUEsSUFBQUMsY0FBQSxPQU1sREMsRUFBQVQsRUFBQSxTQUFBYixHQUNBLE9BQUFBLEVBQUF1QixPQUFBLEdBQUFGLGNBQUFyQixFQUFBd0IsTUFBQSxLQU1BQyxFQUFBLGFBQ0FDLEVBQUFiLEVBQUEsU0FBQWIsR0FDQSxPQUFBQSxFQUFBa0IsUUFBQU8sRUFBQSxPQUFBckIsZ0JBWUEsU0FBQXVCLEVBQUF0SixFQUFBakIsR0FDQSxTQUFBd0ssRUFBQUMsR0FDQSxJQUFBQyxFQUFBcEosVUFBQTVCLE9BQ0EsT0FBQWdMLEVBQ0FBLEVBQUEsRUFDQXpKLEVBQUEwSixNQUFBM0ssRUFBQXNCLFdBQ0FMLEVBQUFsRCxLQUFBaUMsRUFBQXlLLEdBQ0F4SixFQUFBbEQsS0FBQWlDLEdBSUEsT0FEQXdLLEVBQUFJLFFBQUEzSixFQUFBdkIsT0FDQThLLEVBR0EsU0FBQUssRUFBQTVKLEVBQUFqQixHQUNBLE9BQUFpQixFQUFBNkosS0FBQTlLLEdBR0EsSUFBQThLLEVBQUF0SixTQUFBaEUsVUFBQXNOLEtBQ0FELEVBQ0FOLEVBS0EsU0FBQVEsRUFBQWhDLEVBQUFpQyxHQUNBQSxLQUFBLEVBQ0EsSUFBQXJMLEVBQUFvSixFQUFBckosT0FBQXNMLEVBQ0F4SSxFQUFBLElBQUF5SSxNQUFBdEwsR0FDQSxNQUFBQSxJQUNBNkMsRUFBQTdDLEdBQUFvSixFQUFBcEosRUFBQXFMLEdBRUEsT0FBQXhJLEVBTUEsU0FBQTBJLEVBQUFDLEVBQUFDLEdBQ0EsUUFBQXBPLEtBQUFvTyxFQUNBRCxFQUFBbk8sR0FBQW9PLEVBQUFwTyxHQUVBLE9BQUFtTyxFQU1BLFNBQUFFLEVBQUFsQyxHQUVBLElBREEsSUFBQW1DLEtBQ0EzTCxFQUFBLEVBQWlCQSxFQUFBd0osRUFBQXpKLE9BQWdCQyxJQUNqQ3dKLEVBQUF4SixJQUNBdUwsRUFBQUksRUFBQW5DLEVBQUF4SixJQUdBLE9BQUEyTCxFQVFBLFNBQUFDLEVBQUFkLEVBQUFlLEVBQUF4QixJQUtBLElBQUF5QixFQUFBLFNBQUFoQixFQUFBZSxFQUFBeEIsR0FBNkIsVUFLN0IwQixFQUFBLFNBQUEzQixHQUE2QixPQUFBQSxHQVc3QixTQUFBNEIsRUFBQWxCLEVBQUFlLEdBQ0EsR0FBQWYsSUFBQWUsRUFBZ0IsU0FDaEIsSUFBQUksRUFBQW5KLEVBQUFnSSxHQUNBb0IsRUFBQXBKLEVBQUErSSxHQUNBLElBQUFJLElBQUFDLEVBc0JHLE9BQUFELElBQUFDLEdBQ0g1RixPQUFBd0UsS0FBQXhFLE9BQUF1RixHQXRCQSxJQUNBLElBQUFNLEVBQUFiLE1BQUFjLFFBQUF0QixHQUNBdUIsRUFBQWYsTUFBQWMsUUFBQVAsR0FDQSxHQUFBTSxHQUFBRSxFQUNBLE9BQUF2QixFQUFBL0ssU0FBQThMLEVBQUE5TCxRQUFBK0ssRUFBQXdCLE1BQUEsU0FBQWhOLEVBQUFVLEdBQ0EsT0FBQWdNLEVBQUExTSxFQUFBdU0sRUFBQTdMLE1BRU8sR0FBQW1NLEdBQUFFLEVBUVAsU0FQQSxJQUFBRSxFQUFBbE8sT0FBQWhDLEtBQUF5TyxHQUNBMEIsRUFBQW5PLE9BQUFoQyxLQUFBd1AsR0FDQSxPQUFBVSxFQUFBeE0sU0FBQXlNLEVBQUF6TSxRQUFBd00sRUFBQUQsTUFBQSxTQUFBalAsR0FDQSxPQUFBMk8sRUFBQWxCLEVBQUF6TixHQUFBd08sRUFBQXhPLE1BTUssTUFBQWlDLEdBRUwsVUFTQSxTQUFBbU4sRUFBQWpELEVBQUFyRCxHQUNBLFFBQUFuRyxFQUFBLEVBQWlCQSxFQUFBd0osRUFBQXpKLE9BQWdCQyxJQUNqQyxHQUFBZ00sRUFBQXhDLEVBQUF4SixHQUFBbUcsR0FBa0MsT0FBQW5HLEVBRWxDLFNBTUEsU0FBQTBNLEVBQUFwTCxHQUNBLElBQUFxTCxHQUFBLEVBQ0Esa0JBQ0FBLElBQ0FBLEdBQUEsRUFDQXJMLEVBQUEwSixNQUFBdE8sS0FBQWlGLGFBS0EsSUFBQWlMLEVBQUEsdUJBRUFDLEdBQ0EsWUFDQSxZQUNBLFVBR0FDLEdBQ0EsZUFDQSxVQUNBLGNBQ0EsVUFDQSxlQUNBLFVBQ0EsZ0JBQ0EsWUFDQSxZQUNBLGNBQ0EsaUJBS0FDLEdBS0FDLHNCQUFBM08sT0FBQWtKLE9BQUEsTUFLQTBGLFFBQUEsRUFLQUMsZUFBQSxFQUtBQyxVQUFBLEVBS0FDLGFBQUEsRUFLQUMsYUFBQSxLQUtBQyxZQUFBLEtBS0FDLG1CQU1BQyxTQUFBblAsT0FBQWtKLE9BQUEsTUFNQWtHLGNBQUEzQixFQU1BNEIsZUFBQTVCLEVBTUE2QixpQkFBQTdCLEVBS0E4QixnQkFBQWhDLEVBS0FpQyxxQkFBQTlCLEVBTUErQixZQUFBaEMsRUFLQWlDLGdCQUFBakIsR0FRQSxTQUFBa0IsRUFBQS9FLEdBQ0EsSUFBQW9CLEdBQUFwQixFQUFBLElBQUFnRixXQUFBLEdBQ0EsWUFBQTVELEdBQUEsS0FBQUEsRUFNQSxTQUFBNkQsRUFBQWpHLEVBQUE1SyxFQUFBOEksRUFBQWdJLEdBQ0E5UCxPQUFBK1AsZUFBQW5HLEVBQUE1SyxHQUNBdUYsTUFBQXVELEVBQ0FnSSxlQUNBRSxVQUFBLEVBQ0FDLGNBQUEsSUFPQSxJQUFBQyxFQUFBLFVBQ0EsU0FBQUMsRUFBQUMsR0FDQSxJQUFBRixFQUFBRyxLQUFBRCxHQUFBLENBR0EsSUFBQUUsRUFBQUYsRUFBQXhJLE1BQUEsS0FDQSxnQkFBQWdDLEdBQ0EsUUFBQWpJLEVBQUEsRUFBbUJBLEVBQUEyTyxFQUFBNU8sT0FBcUJDLElBQUEsQ0FDeEMsSUFBQWlJLEVBQWlCLE9BQ2pCQSxJQUFBMEcsRUFBQTNPLElBRUEsT0FBQWlJLElBT0EsSUFpQ0EyRyxFQWpDQUMsRUFBQSxnQkFHQUMsRUFBQSxxQkFBQUMsT0FDQUMsRUFBQSxxQkFBQUMsK0JBQUFDLFNBQ0FDLEVBQUFILEdBQUFDLGNBQUFDLFNBQUE3RixjQUNBK0YsRUFBQU4sR0FBQUMsT0FBQU0sVUFBQUMsVUFBQWpHLGNBQ0FrRyxFQUFBSCxHQUFBLGVBQUFWLEtBQUFVLEdBQ0FJLEVBQUFKLEtBQUF6RixRQUFBLGNBQ0E4RixHQUFBTCxLQUFBekYsUUFBQSxXQUVBK0YsSUFEQU4sS0FBQXpGLFFBQUEsV0FDQXlGLEdBQUEsdUJBQUFWLEtBQUFVLElBQUEsUUFBQUQsR0FJQVEsSUFIQVAsR0FBQSxjQUFBVixLQUFBVSxNQUdxQlEsT0FFckJDLElBQUEsRUFDQSxHQUFBZixFQUNBLElBQ0EsSUFBQWdCLE1BQ0F6UixPQUFBK1AsZUFBQTBCLEdBQUEsV0FDQUMsSUFBQSxXQUVBRixJQUFBLEtBR0FkLE9BQUEzTSxpQkFBQSxvQkFBQTBOLElBQ0csTUFBQXhRLElBTUgsSUFBQTBRLEdBQUEsV0FXQSxZQVZBL1IsSUFBQTJRLElBT0FBLEdBTEFFLElBQUFFLEdBQUEscUJBQUFyUSxHQUdBLFdBQUFBLEVBQUEsV0FBQXNSLElBQUFDLFNBS0F0QixHQUlBekIsR0FBQTJCLEdBQUFDLE9BQUFvQiw2QkFHQSxTQUFBQyxHQUFBQyxHQUNBLDBCQUFBQSxHQUFBLGNBQUEzQixLQUFBMkIsRUFBQWxJLFlBR0EsSUFJQW1JLEdBSkFDLEdBQ0EscUJBQUFDLFFBQUFKLEdBQUFJLFNBQ0EscUJBQUFDLFNBQUFMLEdBQUFLLFFBQUFDLFNBTUFKLEdBRkEscUJBQUFLLEtBQUFQLEdBQUFPLEtBRUFBLElBR0EsV0FDQSxTQUFBQSxJQUNBalUsS0FBQStGLElBQUFwRSxPQUFBa0osT0FBQSxNQVlBLE9BVkFvSixFQUFBOVMsVUFBQStILElBQUEsU0FBQXZJLEdBQ0EsV0FBQVgsS0FBQStGLElBQUFwRixJQUVBc1QsRUFBQTlTLFVBQUFrSCxJQUFBLFNBQUExSCxHQUNBWCxLQUFBK0YsSUFBQXBGLElBQUEsR0FFQXNULEVBQUE5UyxVQUFBNkUsTUFBQSxXQUNBaEcsS0FBQStGLElBQUFwRSxPQUFBa0osT0FBQSxPQUdBb0osRUFkQSxHQW9CQSxJQUFBQyxHQUFBaEYsRUErRkFpRixHQUFBLEVBTUFDLEdBQUEsV0FDQXBVLEtBQUEwRSxHQUFBeVAsS0FDQW5VLEtBQUFxVSxTQUdBRCxHQUFBalQsVUFBQW1ULE9BQUEsU0FBQUMsR0FDQXZVLEtBQUFxVSxLQUFBblAsS0FBQXFQLElBR0FILEdBQUFqVCxVQUFBcVQsVUFBQSxTQUFBRCxHQUNBMUgsRUFBQTdNLEtBQUFxVSxLQUFBRSxJQUdBSCxHQUFBalQsVUFBQXNULE9BQUEsV0FDQUwsR0FBQU0sUUFDQU4sR0FBQU0sT0FBQUMsT0FBQTNVLE9BSUFvVSxHQUFBalQsVUFBQXlULE9BQUEsV0FHQSxJQURBLElBQUFQLEVBQUFyVSxLQUFBcVUsS0FBQXRHLFFBQ0F6SyxFQUFBLEVBQUErSyxFQUFBZ0csRUFBQWhSLE9BQWtDQyxFQUFBK0ssRUFBTy9LLElBQ3pDK1EsRUFBQS9RLEdBQUF1UixVQU9BVCxHQUFBTSxPQUFBLEtBQ0EsSUFBQUksTUFFQSxTQUFBQyxHQUFBQyxHQUNBWixHQUFBTSxRQUFtQkksR0FBQTVQLEtBQUFrUCxHQUFBTSxRQUNuQk4sR0FBQU0sT0FBQU0sRUFHQSxTQUFBQyxLQUNBYixHQUFBTSxPQUFBSSxHQUFBSSxNQUtBLElBQUFDLEdBQUEsU0FDQUMsRUFDQXJRLEVBQ0FzUSxFQUNBQyxFQUNBQyxFQUNBeE4sRUFDQXlOLEVBQ0FDLEdBRUF6VixLQUFBb1YsTUFDQXBWLEtBQUErRSxPQUNBL0UsS0FBQXFWLFdBQ0FyVixLQUFBc1YsT0FDQXRWLEtBQUF1VixNQUNBdlYsS0FBQTBWLFFBQUFuVSxFQUNBdkIsS0FBQStILFVBQ0EvSCxLQUFBMlYsZUFBQXBVLEVBQ0F2QixLQUFBNFYsZUFBQXJVLEVBQ0F2QixLQUFBNlYsZUFBQXRVLEVBQ0F2QixLQUFBVyxJQUFBb0UsS0FBQXBFLElBQ0FYLEtBQUF3VixtQkFDQXhWLEtBQUE4Vix1QkFBQXZVLEVBQ0F2QixLQUFBa0ksWUFBQTNHLEVBQ0F2QixLQUFBK1YsS0FBQSxFQUNBL1YsS0FBQWdXLFVBQUEsRUFDQWhXLEtBQUFpVyxjQUFBLEVBQ0FqVyxLQUFBa1csV0FBQSxFQUNBbFcsS0FBQW1XLFVBQUEsRUFDQW5XLEtBQUFvVyxRQUFBLEVBQ0FwVyxLQUFBeVYsZUFDQXpWLEtBQUFxVyxlQUFBOVUsRUFDQXZCLEtBQUFzVyxvQkFBQSxHQUdBQyxJQUEwQkMsT0FBUzVFLGNBQUEsSUFJbkMyRSxHQUFBQyxNQUFBbkQsSUFBQSxXQUNBLE9BQUFyVCxLQUFBOFYsbUJBR0FuVSxPQUFBd0IsaUJBQUFnUyxHQUFBaFUsVUFBQW9WLElBRUEsSUFBQUUsR0FBQSxTQUFBbkIsUUFDQSxJQUFBQSxNQUFBLElBRUEsSUFBQW9CLEVBQUEsSUFBQXZCLEdBR0EsT0FGQXVCLEVBQUFwQixPQUNBb0IsRUFBQVIsV0FBQSxFQUNBUSxHQUdBLFNBQUFDLEdBQUFsTixHQUNBLFdBQUEwTCxRQUFBNVQsZ0JBQUFxSSxPQUFBSCxJQU9BLFNBQUFtTixHQUFBQyxHQUNBLElBQUFDLEVBQUEsSUFBQTNCLEdBQ0EwQixFQUFBekIsSUFDQXlCLEVBQUE5UixLQUNBOFIsRUFBQXhCLFNBQ0F3QixFQUFBdkIsS0FDQXVCLEVBQUF0QixJQUNBc0IsRUFBQTlPLFFBQ0E4TyxFQUFBckIsaUJBQ0FxQixFQUFBcEIsY0FVQSxPQVJBcUIsRUFBQXBCLEdBQUFtQixFQUFBbkIsR0FDQW9CLEVBQUFkLFNBQUFhLEVBQUFiLFNBQ0FjLEVBQUFuVyxJQUFBa1csRUFBQWxXLElBQ0FtVyxFQUFBWixVQUFBVyxFQUFBWCxVQUNBWSxFQUFBbkIsVUFBQWtCLEVBQUFsQixVQUNBbUIsRUFBQWxCLFVBQUFpQixFQUFBakIsVUFDQWtCLEVBQUFqQixVQUFBZ0IsRUFBQWhCLFVBQ0FpQixFQUFBWCxVQUFBLEVBQ0FXLEVBUUEsSUFBQUMsR0FBQW5JLE1BQUF6TixVQUNBNlYsR0FBQXJWLE9BQUFrSixPQUFBa00sSUFFQUUsSUFDQSxPQUNBLE1BQ0EsUUFDQSxVQUNBLFNBQ0EsT0FDQSxXQU1BQSxHQUFBQyxRQUFBLFNBQUFDLEdBRUEsSUFBQUMsRUFBQUwsR0FBQUksR0FDQTNGLEVBQUF3RixHQUFBRyxFQUFBLFdBQ0EsSUFBQW5TLEtBQUFxUyxFQUFBcFMsVUFBQTVCLE9BQ0EsTUFBQWdVLElBQUFyUyxFQUFBcVMsR0FBQXBTLFVBQUFvUyxHQUVBLElBRUFDLEVBRkF4TSxFQUFBc00sRUFBQTlJLE1BQUF0TyxLQUFBZ0YsR0FDQXVTLEVBQUF2WCxLQUFBd1gsT0FFQSxPQUFBTCxHQUNBLFdBQ0EsY0FDQUcsRUFBQXRTLEVBQ0EsTUFDQSxhQUNBc1MsRUFBQXRTLEVBQUErSSxNQUFBLEdBQ0EsTUFLQSxPQUhBdUosR0FBbUJDLEVBQUFFLGFBQUFILEdBRW5CQyxFQUFBRyxJQUFBOUMsU0FDQTlKLE1BTUEsSUFBQTZNLEdBQUFoVyxPQUFBaVcsb0JBQUFaLElBTUFhLElBQUEsRUFFQSxTQUFBQyxHQUFBNVIsR0FDQTJSLEdBQUEzUixFQVNBLElBQUE2UixHQUFBLFNBQUE3UixHQUtBLEdBSkFsRyxLQUFBa0csUUFDQWxHLEtBQUEwWCxJQUFBLElBQUF0RCxHQUNBcFUsS0FBQWdZLFFBQUEsRUFDQXhHLEVBQUF0TCxFQUFBLFNBQUFsRyxNQUNBNE8sTUFBQWMsUUFBQXhKLEdBQUEsQ0FDQSxJQUFBK1IsRUFBQTlGLEVBQ0ErRixHQUNBQyxHQUNBRixFQUFBL1IsRUFBQThRLEdBQUFXLElBQ0EzWCxLQUFBeVgsYUFBQXZSLFFBRUFsRyxLQUFBb1ksS0FBQWxTLElBK0JBLFNBQUFnUyxHQUFBeEQsRUFBQWxLLEVBQUE3SyxHQUVBK1UsRUFBQTJELFVBQUE3TixFQVNBLFNBQUEyTixHQUFBekQsRUFBQWxLLEVBQUE3SyxHQUNBLFFBQUEyRCxFQUFBLEVBQUErSyxFQUFBMU8sRUFBQTBELE9BQWtDQyxFQUFBK0ssRUFBTy9LLElBQUEsQ0FDekMsSUFBQTNDLEVBQUFoQixFQUFBMkQsR0FDQWtPLEVBQUFrRCxFQUFBL1QsRUFBQTZKLEVBQUE3SixLQVNBLFNBQUEyWCxHQUFBcFMsRUFBQXFTLEdBSUEsSUFBQWhCLEVBSEEsR0FBQW5SLEVBQUFGLG1CQUFBaVAsSUFrQkEsT0FkQWhJLEVBQUFqSCxFQUFBLFdBQUFBLEVBQUFzUixrQkFBQU8sR0FDQVIsRUFBQXJSLEVBQUFzUixPQUVBSyxLQUNBdkUsT0FDQTFFLE1BQUFjLFFBQUF4SixJQUFBd0YsRUFBQXhGLEtBQ0F2RSxPQUFBNlcsYUFBQXRTLEtBQ0FBLEVBQUF1UyxTQUVBbEIsRUFBQSxJQUFBUSxHQUFBN1IsSUFFQXFTLEdBQUFoQixHQUNBQSxFQUFBUyxVQUVBVCxFQU1BLFNBQUFtQixHQUNBbk4sRUFDQTVLLEVBQ0E4SSxFQUNBa1AsRUFDQUMsR0FFQSxJQUFBbEIsRUFBQSxJQUFBdEQsR0FFQXlFLEVBQUFsWCxPQUFBbVgseUJBQUF2TixFQUFBNUssR0FDQSxJQUFBa1ksSUFBQSxJQUFBQSxFQUFBakgsYUFBQSxDQUtBLElBQUFtSCxFQUFBRixLQUFBeEYsSUFDQTBGLEdBQUEsSUFBQTlULFVBQUE1QixTQUNBb0csRUFBQThCLEVBQUE1SyxJQUVBLElBQUFxWSxFQUFBSCxLQUFBOVMsSUFFQWtULEdBQUFMLEdBQUFOLEdBQUE3TyxHQUNBOUgsT0FBQStQLGVBQUFuRyxFQUFBNUssR0FDQThRLFlBQUEsRUFDQUcsY0FBQSxFQUNBeUIsSUFBQSxXQUNBLElBQUFuTixFQUFBNlMsSUFBQXJYLEtBQUE2SixHQUFBOUIsRUFVQSxPQVRBMkssR0FBQU0sU0FDQWdELEVBQUFqRCxTQUNBd0UsSUFDQUEsRUFBQXZCLElBQUFqRCxTQUNBN0YsTUFBQWMsUUFBQXhKLElBQ0FnVCxHQUFBaFQsS0FJQUEsR0FFQUgsSUFBQSxTQUFBb1QsR0FDQSxJQUFBalQsRUFBQTZTLElBQUFyWCxLQUFBNkosR0FBQTlCLEVBRUEwUCxJQUFBalQsR0FBQWlULE9BQUFqVCxRQU9BOFMsRUFDQUEsRUFBQXRYLEtBQUE2SixFQUFBNE4sR0FFQTFQLEVBQUEwUCxFQUVBRixHQUFBTCxHQUFBTixHQUFBYSxHQUNBekIsRUFBQTlDLGNBVUEsU0FBQTdPLEdBQUEyTyxFQUFBL1QsRUFBQThJLEdBTUEsR0FBQW1GLE1BQUFjLFFBQUFnRixJQUFBOUksRUFBQWpMLEdBR0EsT0FGQStULEVBQUFyUixPQUFBMEksS0FBQXFOLElBQUExRSxFQUFBclIsT0FBQTFDLEdBQ0ErVCxFQUFBeEgsT0FBQXZNLEVBQUEsRUFBQThJLEdBQ0FBLEVBRUEsR0FBQTlJLEtBQUErVCxLQUFBL1QsS0FBQWdCLE9BQUFSLFdBRUEsT0FEQXVULEVBQUEvVCxHQUFBOEksRUFDQUEsRUFFQSxJQUFBOE4sRUFBQSxFQUFBQyxPQUNBLE9BQUE5QyxFQUFBK0QsUUFBQWxCLEtBQUFTLFFBS0F2TyxFQUVBOE4sR0FJQW1CLEdBQUFuQixFQUFBclIsTUFBQXZGLEVBQUE4SSxHQUNBOE4sRUFBQUcsSUFBQTlDLFNBQ0FuTCxJQUxBaUwsRUFBQS9ULEdBQUE4SSxFQUNBQSxHQVVBLFNBQUE0UCxHQUFBM0UsRUFBQS9ULEdBTUEsR0FBQWlPLE1BQUFjLFFBQUFnRixJQUFBOUksRUFBQWpMLEdBQ0ErVCxFQUFBeEgsT0FBQXZNLEVBQUEsT0FEQSxDQUlBLElBQUE0VyxFQUFBLEVBQUFDLE9BQ0E5QyxFQUFBK0QsUUFBQWxCLEtBQUFTLFNBT0E3SyxFQUFBdUgsRUFBQS9ULFlBR0ErVCxFQUFBL1QsR0FDQTRXLEdBR0FBLEVBQUFHLElBQUE5QyxXQU9BLFNBQUFzRSxHQUFBaFQsR0FDQSxRQUFBdEQsT0FBQSxFQUFBVSxFQUFBLEVBQUErSyxFQUFBbkksRUFBQTdDLE9BQWlEQyxFQUFBK0ssRUFBTy9LLElBQ3hEVixFQUFBc0QsRUFBQTVDLEdBQ0FWLEtBQUE0VSxRQUFBNVUsRUFBQTRVLE9BQUFFLElBQUFqRCxTQUNBN0YsTUFBQWMsUUFBQTlNLElBQ0FzVyxHQUFBdFcsR0E5TUFtVixHQUFBNVcsVUFBQWlYLEtBQUEsU0FBQTdNLEdBRUEsSUFEQSxJQUFBNUwsRUFBQWdDLE9BQUFoQyxLQUFBNEwsR0FDQWpJLEVBQUEsRUFBaUJBLEVBQUEzRCxFQUFBMEQsT0FBaUJDLElBQ2xDb1YsR0FBQW5OLEVBQUE1TCxFQUFBMkQsS0FPQXlVLEdBQUE1VyxVQUFBc1csYUFBQSxTQUFBNkIsR0FDQSxRQUFBaFcsRUFBQSxFQUFBK0ssRUFBQWlMLEVBQUFqVyxPQUFtQ0MsRUFBQStLLEVBQU8vSyxJQUMxQ2dWLEdBQUFnQixFQUFBaFcsS0E4TUEsSUFBQWlXLEdBQUFsSixFQUFBQyxzQkFvQkEsU0FBQWtKLEdBQUExSyxFQUFBMkssR0FDQSxJQUFBQSxFQUFjLE9BQUEzSyxFQUdkLElBRkEsSUFBQW5PLEVBQUErWSxFQUFBQyxFQUNBaGEsRUFBQWdDLE9BQUFoQyxLQUFBOFosR0FDQW5XLEVBQUEsRUFBaUJBLEVBQUEzRCxFQUFBMEQsT0FBaUJDLElBQ2xDM0MsRUFBQWhCLEVBQUEyRCxHQUNBb1csRUFBQTVLLEVBQUFuTyxHQUNBZ1osRUFBQUYsRUFBQTlZLEdBQ0F3TSxFQUFBMkIsRUFBQW5PLEdBRUsrSyxFQUFBZ08sSUFBQWhPLEVBQUFpTyxJQUNMSCxHQUFBRSxFQUFBQyxHQUZBNVQsR0FBQStJLEVBQUFuTyxFQUFBZ1osR0FLQSxPQUFBN0ssRUFNQSxTQUFBOEssR0FDQUMsRUFDQUMsRUFDQUMsR0FFQSxPQUFBQSxFQW9CQSxXQUVBLElBQUFDLEVBQUEsb0JBQUFGLEVBQ0FBLEVBQUFwWSxLQUFBcVksS0FDQUQsRUFDQUcsRUFBQSxvQkFBQUosRUFDQUEsRUFBQW5ZLEtBQUFxWSxLQUNBRixFQUNBLE9BQUFHLEVBQ0FSLEdBQUFRLEVBQUFDLEdBRUFBLEdBN0JBSCxFQUdBRCxFQVFBLFdBQ0EsT0FBQUwsR0FDQSxvQkFBQU0sSUFBQXBZLEtBQUExQixXQUFBOFosRUFDQSxvQkFBQUQsSUFBQW5ZLEtBQUExQixXQUFBNlosSUFWQUMsRUFIQUQsRUEyREEsU0FBQUssR0FDQUwsRUFDQUMsR0FFQSxPQUFBQSxFQUNBRCxFQUNBQSxFQUFBOVEsT0FBQStRLEdBQ0FsTCxNQUFBYyxRQUFBb0ssR0FDQUEsR0FDQUEsR0FDQUQsRUFjQSxTQUFBTSxHQUNBTixFQUNBQyxFQUNBQyxFQUNBcFosR0FFQSxJQUFBc08sRUFBQXROLE9BQUFrSixPQUFBZ1AsR0FBQSxNQUNBLE9BQUFDLEVBRUFqTCxFQUFBSSxFQUFBNkssR0FFQTdLLEVBNURBc0ssR0FBQXhVLEtBQUEsU0FDQThVLEVBQ0FDLEVBQ0FDLEdBRUEsT0FBQUEsRUFjQUgsR0FBQUMsRUFBQUMsRUFBQUMsR0FiQUQsR0FBQSxvQkFBQUEsRUFRQUQsRUFFQUQsR0FBQUMsRUFBQUMsSUFzQkExSixFQUFBOEcsUUFBQSxTQUFBeFAsR0FDQTZSLEdBQUE3UixHQUFBd1MsS0F5QkEvSixFQUFBK0csUUFBQSxTQUFBa0QsR0FDQWIsR0FBQWEsRUFBQSxLQUFBRCxLQVNBWixHQUFBckcsTUFBQSxTQUNBMkcsRUFDQUMsRUFDQUMsRUFDQXBaLEdBTUEsR0FIQWtaLElBQUE1RyxLQUFrQzRHLE9BQUF0WSxHQUNsQ3VZLElBQUE3RyxLQUFpQzZHLE9BQUF2WSxJQUVqQ3VZLEVBQWtCLE9BQUFuWSxPQUFBa0osT0FBQWdQLEdBQUEsTUFJbEIsSUFBQUEsRUFBbUIsT0FBQUMsRUFDbkIsSUFBQTNULEtBRUEsUUFBQWtVLEtBREF4TCxFQUFBMUksRUFBQTBULEdBQ0FDLEVBQUEsQ0FDQSxJQUFBNVIsRUFBQS9CLEVBQUFrVSxHQUNBN0QsRUFBQXNELEVBQUFPLEdBQ0FuUyxJQUFBMEcsTUFBQWMsUUFBQXhILEtBQ0FBLE9BRUEvQixFQUFBa1UsR0FBQW5TLEVBQ0FBLEVBQUFhLE9BQUF5TixHQUNBNUgsTUFBQWMsUUFBQThHLFNBRUEsT0FBQXJRLEdBTUFvVCxHQUFBZSxNQUNBZixHQUFBN1ksUUFDQTZZLEdBQUFnQixPQUNBaEIsR0FBQWlCLFNBQUEsU0FDQVgsRUFDQUMsRUFDQUMsRUFDQXBaLEdBS0EsSUFBQWtaLEVBQW1CLE9BQUFDLEVBQ25CLElBQUEzVCxFQUFBeEUsT0FBQWtKLE9BQUEsTUFHQSxPQUZBZ0UsRUFBQTFJLEVBQUEwVCxHQUNBQyxHQUFpQmpMLEVBQUExSSxFQUFBMlQsR0FDakIzVCxHQUVBb1QsR0FBQWtCLFFBQUFiLEdBS0EsSUFBQWMsR0FBQSxTQUFBYixFQUFBQyxHQUNBLFlBQUF2WSxJQUFBdVksRUFDQUQsRUFDQUMsR0FnQ0EsU0FBQWEsR0FBQWhULEVBQUFvUyxHQUNBLElBQUFPLEVBQUEzUyxFQUFBMlMsTUFDQSxHQUFBQSxFQUFBLENBQ0EsSUFDQWhYLEVBQUFtRyxFQUFBN0gsRUFEQXFOLEtBRUEsR0FBQUwsTUFBQWMsUUFBQTRLLEdBQUEsQ0FDQWhYLEVBQUFnWCxFQUFBalgsT0FDQSxNQUFBQyxJQUNBbUcsRUFBQTZRLEVBQUFoWCxHQUNBLGtCQUFBbUcsSUFDQTdILEVBQUE0TCxFQUFBL0QsR0FDQXdGLEVBQUFyTixJQUFxQndZLEtBQUEsWUFLbEIsR0FBQTFPLEVBQUE0TyxHQUNILFFBQUEzWixLQUFBMlosRUFDQTdRLEVBQUE2USxFQUFBM1osR0FDQWlCLEVBQUE0TCxFQUFBN00sR0FDQXNPLEVBQUFyTixHQUFBOEosRUFBQWpDLEdBQ0FBLEdBQ1cyUSxLQUFBM1EsUUFFUixFQU9IOUIsRUFBQTJTLE1BQUFyTCxHQU1BLFNBQUEyTCxHQUFBalQsRUFBQW9TLEdBQ0EsSUFBQVEsRUFBQTVTLEVBQUE0UyxPQUNBLEdBQUFBLEVBQUEsQ0FDQSxJQUFBTSxFQUFBbFQsRUFBQTRTLFVBQ0EsR0FBQTNMLE1BQUFjLFFBQUE2SyxHQUNBLFFBQUFqWCxFQUFBLEVBQW1CQSxFQUFBaVgsRUFBQWxYLE9BQW1CQyxJQUN0Q3VYLEVBQUFOLEVBQUFqWCxLQUErQm1XLEtBQUFjLEVBQUFqWCxTQUU1QixHQUFBb0ksRUFBQTZPLEdBQ0gsUUFBQTVaLEtBQUE0WixFQUFBLENBQ0EsSUFBQTlRLEVBQUE4USxFQUFBNVosR0FDQWthLEVBQUFsYSxHQUFBK0ssRUFBQWpDLEdBQ0FvRixHQUFrQjRLLEtBQUE5WSxHQUFZOEksSUFDbkJnUSxLQUFBaFEsUUFFUixHQVlILFNBQUFxUixHQUFBblQsR0FDQSxJQUFBb1QsRUFBQXBULEVBQUFxVCxXQUNBLEdBQUFELEVBQ0EsUUFBQXBhLEtBQUFvYSxFQUFBLENBQ0EsSUFBQXZKLEVBQUF1SixFQUFBcGEsR0FDQSxvQkFBQTZRLElBQ0F1SixFQUFBcGEsSUFBcUI4TixLQUFBK0MsRUFBQXFELE9BQUFyRCxLQW9CckIsU0FBQXlKLEdBQ0EvUyxFQUNBc08sRUFDQXVELEdBTUEsb0JBQUF2RCxJQUNBQSxJQUFBN08sU0FHQWdULEdBQUFuRSxFQUFBdUQsR0FDQWEsR0FBQXBFLEVBQUF1RCxHQUNBZSxHQUFBdEUsR0FDQSxJQUFBMEUsRUFBQTFFLEVBQUEyRSxRQUlBLEdBSEFELElBQ0FoVCxFQUFBK1MsR0FBQS9TLEVBQUFnVCxFQUFBbkIsSUFFQXZELEVBQUE0RSxPQUNBLFFBQUE5WCxFQUFBLEVBQUErSyxFQUFBbUksRUFBQTRFLE9BQUEvWCxPQUE0Q0MsRUFBQStLLEVBQU8vSyxJQUNuRDRFLEVBQUErUyxHQUFBL1MsRUFBQXNPLEVBQUE0RSxPQUFBOVgsR0FBQXlXLEdBR0EsSUFDQXBaLEVBREFnSCxLQUVBLElBQUFoSCxLQUFBdUgsRUFDQW1ULEVBQUExYSxHQUVBLElBQUFBLEtBQUE2VixFQUNBckosRUFBQWpGLEVBQUF2SCxJQUNBMGEsRUFBQTFhLEdBR0EsU0FBQTBhLEVBQUExYSxHQUNBLElBQUEyYSxFQUFBL0IsR0FBQTVZLElBQUErWixHQUNBL1MsRUFBQWhILEdBQUEyYSxFQUFBcFQsRUFBQXZILEdBQUE2VixFQUFBN1YsR0FBQW9aLEVBQUFwWixHQUVBLE9BQUFnSCxFQVFBLFNBQUE0VCxHQUNBNVQsRUFDQXlTLEVBQ0ExVixFQUNBOFcsR0FHQSxxQkFBQTlXLEVBQUEsQ0FHQSxJQUFBK1csRUFBQTlULEVBQUF5UyxHQUVBLEdBQUFqTixFQUFBc08sRUFBQS9XLEdBQTJCLE9BQUErVyxFQUFBL1csR0FDM0IsSUFBQWdYLEVBQUFsTyxFQUFBOUksR0FDQSxHQUFBeUksRUFBQXNPLEVBQUFDLEdBQW9DLE9BQUFELEVBQUFDLEdBQ3BDLElBQUFDLEVBQUE5TixFQUFBNk4sR0FDQSxHQUFBdk8sRUFBQXNPLEVBQUFFLEdBQXFDLE9BQUFGLEVBQUFFLEdBRXJDLElBQUExTSxFQUFBd00sRUFBQS9XLElBQUErVyxFQUFBQyxJQUFBRCxFQUFBRSxHQU9BLE9BQUExTSxHQUtBLFNBQUEyTSxHQUNBamIsRUFDQWtiLEVBQ0FDLEVBQ0EvQixHQUVBLElBQUFnQyxFQUFBRixFQUFBbGIsR0FDQXFiLEdBQUE3TyxFQUFBMk8sRUFBQW5iLEdBQ0F1RixFQUFBNFYsRUFBQW5iLEdBRUFzYixFQUFBQyxHQUFBQyxRQUFBSixFQUFBM0IsTUFDQSxHQUFBNkIsR0FBQSxFQUNBLEdBQUFELElBQUE3TyxFQUFBNE8sRUFBQSxXQUNBN1YsR0FBQSxPQUNLLFFBQUFBLE9BQUErSCxFQUFBdE4sR0FBQSxDQUdMLElBQUF5YixFQUFBRixHQUFBdFMsT0FBQW1TLEVBQUEzQixPQUNBZ0MsRUFBQSxHQUFBSCxFQUFBRyxLQUNBbFcsR0FBQSxHQUtBLFFBQUEzRSxJQUFBMkUsRUFBQSxDQUNBQSxFQUFBbVcsR0FBQXRDLEVBQUFnQyxFQUFBcGIsR0FHQSxJQUFBMmIsRUFBQXpFLEdBQ0FDLElBQUEsR0FDQVEsR0FBQXBTLEdBQ0E0UixHQUFBd0UsR0FTQSxPQUFBcFcsRUFNQSxTQUFBbVcsR0FBQXRDLEVBQUFnQyxFQUFBcGIsR0FFQSxHQUFBd00sRUFBQTRPLEVBQUEsWUFHQSxJQUFBdkssRUFBQXVLLEVBQUFRLFFBWUEsT0FBQXhDLEtBQUF2UixTQUFBc1QsZ0JBQ0F2YSxJQUFBd1ksRUFBQXZSLFNBQUFzVCxVQUFBbmIsU0FDQVksSUFBQXdZLEVBQUF5QyxPQUFBN2IsR0FFQW9aLEVBQUF5QyxPQUFBN2IsR0FJQSxvQkFBQTZRLEdBQUEsYUFBQWlMLEdBQUFWLEVBQUEzQixNQUNBNUksRUFBQTlQLEtBQUFxWSxHQUNBdkksR0FzRkEsU0FBQWlMLEdBQUE3WCxHQUNBLElBQUE4WCxFQUFBOVgsS0FBQTZHLFdBQUFpUixNQUFBLHNCQUNBLE9BQUFBLElBQUEsTUFHQSxTQUFBQyxHQUFBdk8sRUFBQWUsR0FDQSxPQUFBc04sR0FBQXJPLEtBQUFxTyxHQUFBdE4sR0FHQSxTQUFBK00sR0FBQTlCLEVBQUF3QyxHQUNBLElBQUFoTyxNQUFBYyxRQUFBa04sR0FDQSxPQUFBRCxHQUFBQyxFQUFBeEMsR0FBQSxLQUVBLFFBQUE5VyxFQUFBLEVBQUErVCxFQUFBdUYsRUFBQXZaLE9BQTZDQyxFQUFBK1QsRUFBUy9ULElBQ3RELEdBQUFxWixHQUFBQyxFQUFBdFosR0FBQThXLEdBQ0EsT0FBQTlXLEVBR0EsU0FLQSxTQUFBdVosR0FBQUMsRUFBQS9DLEVBQUFnRCxHQUNBLEdBQUFoRCxFQUFBLENBQ0EsSUFBQWlELEVBQUFqRCxFQUNBLE1BQUFpRCxJQUFBQyxRQUFBLENBQ0EsSUFBQUMsRUFBQUYsRUFBQXhVLFNBQUEyVSxjQUNBLEdBQUFELEVBQ0EsUUFBQTVaLEVBQUEsRUFBdUJBLEVBQUE0WixFQUFBN1osT0FBa0JDLElBQ3pDLElBQ0EsSUFBQThaLEdBQUEsSUFBQUYsRUFBQTVaLEdBQUE1QixLQUFBc2IsRUFBQUYsRUFBQS9DLEVBQUFnRCxHQUNBLEdBQUFLLEVBQTBCLE9BQ2YsTUFBQXhhLEdBQ1h5YSxHQUFBemEsRUFBQW9hLEVBQUEsd0JBTUFLLEdBQUFQLEVBQUEvQyxFQUFBZ0QsR0FHQSxTQUFBTSxHQUFBUCxFQUFBL0MsRUFBQWdELEdBQ0EsR0FBQTFNLEVBQUFNLGFBQ0EsSUFDQSxPQUFBTixFQUFBTSxhQUFBalAsS0FBQSxLQUFBb2IsRUFBQS9DLEVBQUFnRCxHQUNLLE1BQUFuYSxHQUNMMGEsR0FBQTFhLEVBQUEsNEJBR0EwYSxHQUFBUixFQUFBL0MsRUFBQWdELEdBR0EsU0FBQU8sR0FBQVIsRUFBQS9DLEVBQUFnRCxHQUtBLElBQUEzSyxJQUFBRSxHQUFBLHFCQUFBaUwsUUFHQSxNQUFBVCxFQUZBUyxRQUFBQyxNQUFBVixHQVNBLElBb0JBVyxHQUNBQyxHQXJCQUMsTUFDQUMsSUFBQSxFQUVBLFNBQUFDLEtBQ0FELElBQUEsRUFDQSxJQUFBRSxFQUFBSCxHQUFBNVAsTUFBQSxHQUNBNFAsR0FBQXRhLE9BQUEsRUFDQSxRQUFBQyxFQUFBLEVBQWlCQSxFQUFBd2EsRUFBQXphLE9BQW1CQyxJQUNwQ3dhLEVBQUF4YSxLQWNBLElBQUF5YSxJQUFBLEVBT0Esd0JBQUE5WixjQUFBeVAsR0FBQXpQLGNBQ0F5WixHQUFBLFdBQ0F6WixhQUFBNFosVUFFQyx3QkFBQXpaLGlCQUNEc1AsR0FBQXRQLGlCQUVBLHVDQUFBQSxlQUFBcUgsV0FVQWlTLEdBQUEsV0FDQTVYLFdBQUErWCxHQUFBLFFBVkEsQ0FDQSxJQUFBcGEsR0FBQSxJQUFBVyxlQUNBVixHQUFBRCxHQUFBNkIsTUFDQTdCLEdBQUE4QixNQUFBQyxVQUFBcVksR0FDQUgsR0FBQSxXQUNBaGEsR0FBQStCLFlBQUEsSUFXQSx3QkFBQWpELFNBQUFrUixHQUFBbFIsU0FBQSxDQUNBLElBQUF3YixHQUFBeGIsUUFBQXliLFVBQ0FSLEdBQUEsV0FDQU8sR0FBQXRiLEtBQUFtYixJQU1BN0ssSUFBZ0JsTixXQUFBb0osU0FJaEJ1TyxHQUFBQyxHQU9BLFNBQUFRLEdBQUF0WixHQUNBLE9BQUFBLEVBQUF1WixZQUFBdlosRUFBQXVaLFVBQUEsV0FDQUosSUFBQSxFQUNBLElBQUE5TyxFQUFBckssRUFBQTBKLE1BQUEsS0FBQXJKLFdBRUEsT0FEQThZLElBQUEsRUFDQTlPLElBSUEsU0FBQTdKLEdBQUFnWixFQUFBemEsR0FDQSxJQUFBMGEsRUFxQkEsR0FwQkFWLEdBQUF6WSxLQUFBLFdBQ0EsR0FBQWtaLEVBQ0EsSUFDQUEsRUFBQTFjLEtBQUFpQyxHQUNPLE1BQUFmLEdBQ1BpYSxHQUFBamEsRUFBQWUsRUFBQSxpQkFFSzBhLEdBQ0xBLEVBQUExYSxLQUdBaWEsS0FDQUEsSUFBQSxFQUNBRyxHQUNBTCxLQUVBRCxPQUlBVyxHQUFBLHFCQUFBNWIsUUFDQSxXQUFBQSxRQUFBLFNBQUF5YixHQUNBSSxFQUFBSixJQW9GQSxJQUFBSyxHQUFBLElBQUExSyxHQU9BLFNBQUEySyxHQUFBOVUsR0FDQStVLEdBQUEvVSxFQUFBNlUsSUFDQUEsR0FBQXRZLFFBR0EsU0FBQXdZLEdBQUEvVSxFQUFBZ1YsR0FDQSxJQUFBbmIsRUFBQTNELEVBQ0ErZSxFQUFBOVAsTUFBQWMsUUFBQWpHLEdBQ0EsTUFBQWlWLElBQUF0WSxFQUFBcUQsSUFBQTlILE9BQUFnZCxTQUFBbFYsaUJBQUEwTCxJQUFBLENBR0EsR0FBQTFMLEVBQUErTixPQUFBLENBQ0EsSUFBQW9ILEVBQUFuVixFQUFBK04sT0FBQUUsSUFBQWhULEdBQ0EsR0FBQStaLEVBQUF2VixJQUFBMFYsR0FDQSxPQUVBSCxFQUFBcFcsSUFBQXVXLEdBRUEsR0FBQUYsRUFBQSxDQUNBcGIsRUFBQW1HLEVBQUFwRyxPQUNBLE1BQUFDLElBQWlCa2IsR0FBQS9VLEVBQUFuRyxHQUFBbWIsT0FDZCxDQUNIOWUsRUFBQWdDLE9BQUFoQyxLQUFBOEosR0FDQW5HLEVBQUEzRCxFQUFBMEQsT0FDQSxNQUFBQyxJQUFpQmtiLEdBQUEvVSxFQUFBOUosRUFBQTJELElBQUFtYixLQTZCakIsSUFzYUEvSixHQXRhQW1LLEdBQUF6UixFQUFBLFNBQUF4TCxHQUNBLElBQUFrZCxFQUFBLE1BQUFsZCxFQUFBa00sT0FBQSxHQUNBbE0sRUFBQWtkLEVBQUFsZCxFQUFBbU0sTUFBQSxHQUFBbk0sRUFDQSxJQUFBbWQsRUFBQSxNQUFBbmQsRUFBQWtNLE9BQUEsR0FDQWxNLEVBQUFtZCxFQUFBbmQsRUFBQW1NLE1BQUEsR0FBQW5NLEVBQ0EsSUFBQXdiLEVBQUEsTUFBQXhiLEVBQUFrTSxPQUFBLEdBRUEsT0FEQWxNLEVBQUF3YixFQUFBeGIsRUFBQW1NLE1BQUEsR0FBQW5NLEdBRUFBLE9BQ0FvTyxLQUFBK08sRUFDQTNCLFVBQ0EwQixhQUlBLFNBQUFFLEdBQUFDLEdBQ0EsU0FBQUMsSUFDQSxJQUFBQyxFQUFBbGEsVUFFQWdhLEVBQUFDLEVBQUFELElBQ0EsSUFBQXJRLE1BQUFjLFFBQUF1UCxHQU9BLE9BQUFBLEVBQUEzUSxNQUFBLEtBQUFySixXQUxBLElBREEsSUFBQTZSLEVBQUFtSSxFQUFBbFIsUUFDQXpLLEVBQUEsRUFBcUJBLEVBQUF3VCxFQUFBelQsT0FBbUJDLElBQ3hDd1QsRUFBQXhULEdBQUFnTCxNQUFBLEtBQUE2USxHQVFBLE9BREFELEVBQUFELE1BQ0FDLEVBR0EsU0FBQUUsR0FDQUMsRUFDQUMsRUFDQWpYLEVBQ0FrWCxFQUNBeEYsR0FFQSxJQUFBblksRUFBQW9iLEVBQUF3QyxFQUFBMWEsRUFDQSxJQUFBbEQsS0FBQXlkLEVBQ0FyQyxFQUFBcUMsRUFBQXpkLEdBQ0E0ZCxFQUFBRixFQUFBMWQsR0FDQWtELEVBQUErWixHQUFBamQsR0FFQXFKLEVBQUErUixLQUtLL1IsRUFBQXVVLElBQ0x2VSxFQUFBK1IsRUFBQWlDLE9BQ0FqQyxFQUFBcUMsRUFBQXpkLEdBQUFvZCxHQUFBaEMsSUFFQTNVLEVBQUF2RCxFQUFBbEQsS0FBQW9iLEVBQUFsWSxFQUFBa0wsS0FBQWxMLEVBQUFzWSxRQUFBdFksRUFBQWdhLFFBQUFoYSxFQUFBMmEsU0FDS3pDLElBQUF3QyxJQUNMQSxFQUFBUCxJQUFBakMsRUFDQXFDLEVBQUF6ZCxHQUFBNGQsSUFHQSxJQUFBNWQsS0FBQTBkLEVBQ0FyVSxFQUFBb1UsRUFBQXpkLE1BQ0FrRCxFQUFBK1osR0FBQWpkLEdBQ0EyZCxFQUFBemEsRUFBQWxELEtBQUEwZCxFQUFBMWQsR0FBQWtELEVBQUFzWSxVQU9BLFNBQUFzQyxHQUFBbE8sRUFBQW1PLEVBQUFqWSxHQUlBLElBQUF3WCxFQUhBMU4sYUFBQTJELEtBQ0EzRCxJQUFBek0sS0FBQTJDLE9BQUE4SixFQUFBek0sS0FBQTJDLFVBR0EsSUFBQWtZLEVBQUFwTyxFQUFBbU8sR0FFQSxTQUFBRSxJQUNBblksRUFBQTRHLE1BQUF0TyxLQUFBaUYsV0FHQTRILEVBQUFxUyxFQUFBRCxJQUFBWSxHQUdBNVUsRUFBQTJVLEdBRUFWLEVBQUFGLElBQUFhLElBR0ExVSxFQUFBeVUsRUFBQVgsTUFBQTdULEVBQUF3VSxFQUFBRSxTQUVBWixFQUFBVSxFQUNBVixFQUFBRCxJQUFBL1osS0FBQTJhLElBR0FYLEVBQUFGLElBQUFZLEVBQUFDLElBSUFYLEVBQUFZLFFBQUEsRUFDQXRPLEVBQUFtTyxHQUFBVCxFQUtBLFNBQUFhLEdBQ0FoYixFQUNBNE8sRUFDQXlCLEdBS0EsSUFBQXlHLEVBQUFsSSxFQUFBaE0sUUFBQTJTLE1BQ0EsSUFBQXJQLEVBQUE0USxHQUFBLENBR0EsSUFBQTVNLEtBQ0ErUSxFQUFBamIsRUFBQWliLE1BQ0ExRixFQUFBdlYsRUFBQXVWLE1BQ0EsR0FBQW5QLEVBQUE2VSxJQUFBN1UsRUFBQW1QLEdBQ0EsUUFBQTNaLEtBQUFrYixFQUFBLENBQ0EsSUFBQW9FLEVBQUFoUyxFQUFBdE4sR0FpQkF1ZixHQUFBalIsRUFBQXFMLEVBQUEzWixFQUFBc2YsR0FBQSxJQUNBQyxHQUFBalIsRUFBQStRLEVBQUFyZixFQUFBc2YsR0FBQSxHQUdBLE9BQUFoUixHQUdBLFNBQUFpUixHQUNBalIsRUFDQWtSLEVBQ0F4ZixFQUNBc2YsRUFDQUcsR0FFQSxHQUFBalYsRUFBQWdWLEdBQUEsQ0FDQSxHQUFBaFQsRUFBQWdULEVBQUF4ZixHQUtBLE9BSkFzTyxFQUFBdE8sR0FBQXdmLEVBQUF4ZixHQUNBeWYsVUFDQUQsRUFBQXhmLElBRUEsRUFDSyxHQUFBd00sRUFBQWdULEVBQUFGLEdBS0wsT0FKQWhSLEVBQUF0TyxHQUFBd2YsRUFBQUYsR0FDQUcsVUFDQUQsRUFBQUYsSUFFQSxFQUdBLFNBaUJBLFNBQUFJLEdBQUFoTCxHQUNBLFFBQUEvUixFQUFBLEVBQWlCQSxFQUFBK1IsRUFBQWhTLE9BQXFCQyxJQUN0QyxHQUFBc0wsTUFBQWMsUUFBQTJGLEVBQUEvUixJQUNBLE9BQUFzTCxNQUFBek4sVUFBQTRILE9BQUF1RixTQUFBK0csR0FHQSxPQUFBQSxFQU9BLFNBQUFpTCxHQUFBakwsR0FDQSxPQUFBL0osRUFBQStKLElBQ0FzQixHQUFBdEIsSUFDQXpHLE1BQUFjLFFBQUEyRixHQUNBa0wsR0FBQWxMLFFBQ0E5VCxFQUdBLFNBQUFpZixHQUFBOUosR0FDQSxPQUFBdkwsRUFBQXVMLElBQUF2TCxFQUFBdUwsRUFBQXBCLE9BQUFqSyxFQUFBcUwsRUFBQVIsV0FHQSxTQUFBcUssR0FBQWxMLEVBQUFvTCxHQUNBLElBQ0FuZCxFQUFBcUssRUFBQStTLEVBQUFDLEVBREExUixLQUVBLElBQUEzTCxFQUFBLEVBQWFBLEVBQUErUixFQUFBaFMsT0FBcUJDLElBQ2xDcUssRUFBQTBILEVBQUEvUixHQUNBMkgsRUFBQTBDLElBQUEsbUJBQUFBLElBQ0ErUyxFQUFBelIsRUFBQTVMLE9BQUEsRUFDQXNkLEVBQUExUixFQUFBeVIsR0FFQTlSLE1BQUFjLFFBQUEvQixHQUNBQSxFQUFBdEssT0FBQSxJQUNBc0ssRUFBQTRTLEdBQUE1UyxHQUFBOFMsR0FBQSxRQUFBbmQsR0FFQWtkLEdBQUE3UyxFQUFBLEtBQUE2UyxHQUFBRyxLQUNBMVIsRUFBQXlSLEdBQUEvSixHQUFBZ0ssRUFBQXJMLEtBQUEzSCxFQUFBLEdBQUEySCxNQUNBM0gsRUFBQWlULFNBRUEzUixFQUFBL0osS0FBQW9KLE1BQUFXLEVBQUF0QixJQUVLckMsRUFBQXFDLEdBQ0w2UyxHQUFBRyxHQUlBMVIsRUFBQXlSLEdBQUEvSixHQUFBZ0ssRUFBQXJMLEtBQUEzSCxHQUNPLEtBQUFBLEdBRVBzQixFQUFBL0osS0FBQXlSLEdBQUFoSixJQUdBNlMsR0FBQTdTLElBQUE2UyxHQUFBRyxHQUVBMVIsRUFBQXlSLEdBQUEvSixHQUFBZ0ssRUFBQXJMLEtBQUEzSCxFQUFBMkgsT0FHQWxLLEVBQUFpSyxFQUFBd0wsV0FDQTFWLEVBQUF3QyxFQUFBeUgsTUFDQW5LLEVBQUEwQyxFQUFBaE4sTUFDQXdLLEVBQUFzVixLQUNBOVMsRUFBQWhOLElBQUEsVUFBQThmLEVBQUEsSUFBQW5kLEVBQUEsTUFFQTJMLEVBQUEvSixLQUFBeUksS0FJQSxPQUFBc0IsRUFLQSxTQUFBNlIsR0FBQUMsRUFBQUMsR0FPQSxPQUxBRCxFQUFBRSxZQUNBcE4sSUFBQSxXQUFBa04sRUFBQWpOLE9BQUFvTixnQkFFQUgsSUFBQXhFLFNBRUFuVyxFQUFBMmEsR0FDQUMsRUFBQW5TLE9BQUFrUyxHQUNBQSxFQUdBLFNBQUFJLEdBQ0FDLEVBQ0FyYyxFQUNBZ0QsRUFDQXNOLEVBQ0FELEdBRUEsSUFBQXNCLEVBQUFELEtBR0EsT0FGQUMsRUFBQWpCLGFBQUEyTCxFQUNBMUssRUFBQUwsV0FBb0J0UixPQUFBZ0QsVUFBQXNOLFdBQUFELE9BQ3BCc0IsRUFHQSxTQUFBMkssR0FDQUQsRUFDQUUsRUFDQXZaLEdBRUEsR0FBQXFELEVBQUFnVyxFQUFBNUQsUUFBQXJTLEVBQUFpVyxFQUFBRyxXQUNBLE9BQUFILEVBQUFHLFVBR0EsR0FBQXBXLEVBQUFpVyxFQUFBSSxVQUNBLE9BQUFKLEVBQUFJLFNBR0EsR0FBQXBXLEVBQUFnVyxFQUFBSyxVQUFBdFcsRUFBQWlXLEVBQUFNLGFBQ0EsT0FBQU4sRUFBQU0sWUFHQSxJQUFBdlcsRUFBQWlXLEVBQUFPLFVBR0csQ0FDSCxJQUFBQSxFQUFBUCxFQUFBTyxVQUFBNVosR0FDQTZaLEdBQUEsRUFFQUMsRUFBQSxXQUNBLFFBQUF2ZSxFQUFBLEVBQUErSyxFQUFBc1QsRUFBQXRlLE9BQTBDQyxFQUFBK0ssRUFBTy9LLElBQ2pEcWUsRUFBQXJlLEdBQUF3ZSxnQkFJQTdELEVBQUFqTyxFQUFBLFNBQUFmLEdBRUFtUyxFQUFBSSxTQUFBVixHQUFBN1IsRUFBQXFTLEdBR0FNLEdBQ0FDLE1BSUFFLEVBQUEvUixFQUFBLFNBQUFnUyxHQUtBN1csRUFBQWlXLEVBQUFHLGFBQ0FILEVBQUE1RCxPQUFBLEVBQ0FxRSxPQUlBNVMsRUFBQW1TLEVBQUFuRCxFQUFBOEQsR0E2Q0EsT0EzQ0EzYixFQUFBNkksS0FDQSxvQkFBQUEsRUFBQXZNLEtBRUF1SSxFQUFBbVcsRUFBQUksV0FDQXZTLEVBQUF2TSxLQUFBdWIsRUFBQThELEdBRU81VyxFQUFBOEQsRUFBQWdULFlBQUEsb0JBQUFoVCxFQUFBZ1QsVUFBQXZmLE9BQ1B1TSxFQUFBZ1QsVUFBQXZmLEtBQUF1YixFQUFBOEQsR0FFQTVXLEVBQUE4RCxFQUFBdU8sU0FDQTRELEVBQUFHLFVBQUFULEdBQUE3UixFQUFBdU8sTUFBQThELElBR0FuVyxFQUFBOEQsRUFBQXdTLFdBQ0FMLEVBQUFNLFlBQUFaLEdBQUE3UixFQUFBd1MsUUFBQUgsR0FDQSxJQUFBclMsRUFBQWlULE1BQ0FkLEVBQUFLLFNBQUEsRUFFQTNiLFdBQUEsV0FDQW1GLEVBQUFtVyxFQUFBSSxXQUFBdlcsRUFBQW1XLEVBQUE1RCxTQUNBNEQsRUFBQUssU0FBQSxFQUNBSSxNQUVhNVMsRUFBQWlULE9BQUEsTUFJYi9XLEVBQUE4RCxFQUFBa1QsVUFDQXJjLFdBQUEsV0FDQW1GLEVBQUFtVyxFQUFBSSxXQUNBTyxFQUdBLE9BR1c5UyxFQUFBa1QsV0FLWFAsR0FBQSxFQUVBUixFQUFBSyxRQUNBTCxFQUFBTSxZQUNBTixFQUFBSSxTQS9FQUosRUFBQU8sU0FBQXpjLEtBQUE2QyxHQXFGQSxTQUFBdU8sR0FBQUksR0FDQSxPQUFBQSxFQUFBUixXQUFBUSxFQUFBakIsYUFLQSxTQUFBMk0sR0FBQS9NLEdBQ0EsR0FBQXpHLE1BQUFjLFFBQUEyRixHQUNBLFFBQUEvUixFQUFBLEVBQW1CQSxFQUFBK1IsRUFBQWhTLE9BQXFCQyxJQUFBLENBQ3hDLElBQUFxSyxFQUFBMEgsRUFBQS9SLEdBQ0EsR0FBQTZILEVBQUF3QyxLQUFBeEMsRUFBQXdDLEVBQUE2SCxtQkFBQWMsR0FBQTNJLElBQ0EsT0FBQUEsR0FVQSxTQUFBMFUsR0FBQXRJLEdBQ0FBLEVBQUF1SSxRQUFBM2dCLE9BQUFrSixPQUFBLE1BQ0FrUCxFQUFBd0ksZUFBQSxFQUVBLElBQUFDLEVBQUF6SSxFQUFBdlIsU0FBQWlhLGlCQUNBRCxHQUNBRSxHQUFBM0ksRUFBQXlJLEdBTUEsU0FBQW5hLEdBQUF2RCxFQUFBRixFQUFBb0wsR0FDQUEsRUFDQTBFLEdBQUFpTyxNQUFBN2QsRUFBQUYsR0FFQThQLEdBQUFrTyxJQUFBOWQsRUFBQUYsR0FJQSxTQUFBaWUsR0FBQS9kLEVBQUFGLEdBQ0E4UCxHQUFBb08sS0FBQWhlLEVBQUFGLEdBR0EsU0FBQThkLEdBQ0EzSSxFQUNBeUksRUFDQU8sR0FFQXJPLEdBQUFxRixFQUNBcUYsR0FBQW9ELEVBQUFPLE1BQStDMWEsR0FBQXdhLEdBQUE5SSxHQUMvQ3JGLFFBQUFuVCxFQUdBLFNBQUF5aEIsR0FBQUMsR0FDQSxJQUFBQyxFQUFBLFNBQ0FELEVBQUE5aEIsVUFBQXloQixJQUFBLFNBQUE5ZCxFQUFBRixHQUNBLElBQUF1ZSxFQUFBbmpCLEtBRUErWixFQUFBL1osS0FDQSxHQUFBNE8sTUFBQWMsUUFBQTVLLEdBQ0EsUUFBQXhCLEVBQUEsRUFBQStLLEVBQUF2SixFQUFBekIsT0FBdUNDLEVBQUErSyxFQUFPL0ssSUFDOUM2ZixFQUFBUCxJQUFBOWQsRUFBQXhCLEdBQUFzQixRQUdBbVYsRUFBQXVJLFFBQUF4ZCxLQUFBaVYsRUFBQXVJLFFBQUF4ZCxRQUFBSSxLQUFBTixHQUdBc2UsRUFBQWxSLEtBQUFsTixLQUNBaVYsRUFBQXdJLGVBQUEsR0FHQSxPQUFBeEksR0FHQWtKLEVBQUE5aEIsVUFBQXdoQixNQUFBLFNBQUE3ZCxFQUFBRixHQUNBLElBQUFtVixFQUFBL1osS0FDQSxTQUFBcWYsSUFDQXRGLEVBQUErSSxLQUFBaGUsRUFBQXVhLEdBQ0F6YSxFQUFBMEosTUFBQXlMLEVBQUE5VSxXQUlBLE9BRkFvYSxFQUFBemEsS0FDQW1WLEVBQUE2SSxJQUFBOWQsRUFBQXVhLEdBQ0F0RixHQUdBa0osRUFBQTloQixVQUFBMmhCLEtBQUEsU0FBQWhlLEVBQUFGLEdBQ0EsSUFBQXVlLEVBQUFuakIsS0FFQStaLEVBQUEvWixLQUVBLElBQUFpRixVQUFBNUIsT0FFQSxPQURBMFcsRUFBQXVJLFFBQUEzZ0IsT0FBQWtKLE9BQUEsTUFDQWtQLEVBR0EsR0FBQW5MLE1BQUFjLFFBQUE1SyxHQUFBLENBQ0EsUUFBQXhCLEVBQUEsRUFBQStLLEVBQUF2SixFQUFBekIsT0FBdUNDLEVBQUErSyxFQUFPL0ssSUFDOUM2ZixFQUFBTCxLQUFBaGUsRUFBQXhCLEdBQUFzQixHQUVBLE9BQUFtVixFQUdBLElBQUFxSixFQUFBckosRUFBQXVJLFFBQUF4ZCxHQUNBLElBQUFzZSxFQUNBLE9BQUFySixFQUVBLElBQUFuVixFQUVBLE9BREFtVixFQUFBdUksUUFBQXhkLEdBQUEsS0FDQWlWLEVBRUEsR0FBQW5WLEVBQUEsQ0FFQSxJQUFBd1osRUFDQWlGLEVBQUFELEVBQUEvZixPQUNBLE1BQUFnZ0IsSUFFQSxHQURBakYsRUFBQWdGLEVBQUFDLEdBQ0FqRixJQUFBeFosR0FBQXdaLEVBQUF4WixPQUFBLENBQ0F3ZSxFQUFBbFcsT0FBQW1XLEVBQUEsR0FDQSxPQUlBLE9BQUF0SixHQUdBa0osRUFBQTloQixVQUFBbWlCLE1BQUEsU0FBQXhlLEdBQ0EsSUFBQWlWLEVBQUEvWixLQWFBb2pCLEVBQUFySixFQUFBdUksUUFBQXhkLEdBQ0EsR0FBQXNlLEVBQUEsQ0FDQUEsSUFBQS9mLE9BQUEsRUFBQXFMLEVBQUEwVSxLQUVBLElBREEsSUFBQXBlLEVBQUEwSixFQUFBekosVUFBQSxHQUNBM0IsRUFBQSxFQUFBK0ssRUFBQStVLEVBQUEvZixPQUFxQ0MsRUFBQStLLEVBQU8vSyxJQUM1QyxJQUNBOGYsRUFBQTlmLEdBQUFnTCxNQUFBeUwsRUFBQS9VLEdBQ1MsTUFBQXBDLEdBQ1RpYSxHQUFBamEsRUFBQW1YLEVBQUEsc0JBQUFqVixFQUFBLE1BSUEsT0FBQWlWLEdBV0EsU0FBQXdKLEdBQ0FsTyxFQUNBdE4sR0FFQSxJQUFBeWIsS0FDQSxJQUFBbk8sRUFDQSxPQUFBbU8sRUFFQSxRQUFBbGdCLEVBQUEsRUFBQStLLEVBQUFnSCxFQUFBaFMsT0FBc0NDLEVBQUErSyxFQUFPL0ssSUFBQSxDQUM3QyxJQUFBa1QsRUFBQW5CLEVBQUEvUixHQUNBeUIsRUFBQXlSLEVBQUF6UixLQU9BLEdBTEFBLEtBQUFpYixPQUFBamIsRUFBQWliLE1BQUF5RCxhQUNBMWUsRUFBQWliLE1BQUF5RCxLQUlBak4sRUFBQXpPLGFBQUF5TyxFQUFBYixZQUFBNU4sSUFDQWhELEdBQUEsTUFBQUEsRUFBQTBlLE1BVUFELEVBQUFqSCxVQUFBaUgsRUFBQWpILGFBQUFyWCxLQUFBc1IsT0FUQSxDQUNBLElBQUE1VSxFQUFBbUQsRUFBQTBlLEtBQ0FBLEVBQUFELEVBQUE1aEIsS0FBQTRoQixFQUFBNWhCLE9BQ0EsYUFBQTRVLEVBQUFwQixJQUNBcU8sRUFBQXZlLEtBQUFvSixNQUFBbVYsRUFBQWpOLEVBQUFuQixjQUVBb08sRUFBQXZlLEtBQUFzUixJQU9BLFFBQUFrTixLQUFBRixFQUNBQSxFQUFBRSxHQUFBOVQsTUFBQStULFlBQ0FILEVBQUFFLEdBR0EsT0FBQUYsRUFHQSxTQUFBRyxHQUFBak4sR0FDQSxPQUFBQSxFQUFBUixZQUFBUSxFQUFBakIsY0FBQSxNQUFBaUIsRUFBQXBCLEtBR0EsU0FBQXNPLEdBQ0EzRSxFQUNBaFEsR0FFQUEsUUFDQSxRQUFBM0wsRUFBQSxFQUFpQkEsRUFBQTJiLEVBQUE1YixPQUFnQkMsSUFDakNzTCxNQUFBYyxRQUFBdVAsRUFBQTNiLElBQ0FzZ0IsR0FBQTNFLEVBQUEzYixHQUFBMkwsR0FFQUEsRUFBQWdRLEVBQUEzYixHQUFBM0MsS0FBQXNlLEVBQUEzYixHQUFBc0IsR0FHQSxPQUFBcUssRUFLQSxJQUFBNFUsR0FBQSxLQUdBLFNBQUFDLEdBQUEvSixHQUNBLElBQUFwUyxFQUFBb1MsRUFBQXZSLFNBR0FOLEVBQUFQLEVBQUFPLE9BQ0EsR0FBQUEsSUFBQVAsRUFBQW9jLFNBQUEsQ0FDQSxNQUFBN2IsRUFBQU0sU0FBQXViLFVBQUE3YixFQUFBK1UsUUFDQS9VLElBQUErVSxRQUVBL1UsRUFBQThiLFVBQUE5ZSxLQUFBNlUsR0FHQUEsRUFBQWtELFFBQUEvVSxFQUNBNlIsRUFBQXhSLE1BQUFMLElBQUFLLE1BQUF3UixFQUVBQSxFQUFBaUssYUFDQWpLLEVBQUFrSyxTQUVBbEssRUFBQW1LLFNBQUEsS0FDQW5LLEVBQUFvSyxVQUFBLEtBQ0FwSyxFQUFBcUssaUJBQUEsRUFDQXJLLEVBQUFzSyxZQUFBLEVBQ0F0SyxFQUFBdUssY0FBQSxFQUNBdkssRUFBQXdLLG1CQUFBLEVBR0EsU0FBQUMsR0FBQXZCLEdBQ0FBLEVBQUE5aEIsVUFBQXNqQixRQUFBLFNBQUE1TixFQUFBNk4sR0FDQSxJQUFBM0ssRUFBQS9aLEtBQ0ErWixFQUFBc0ssWUFDQU0sR0FBQTVLLEVBQUEsZ0JBRUEsSUFBQTZLLEVBQUE3SyxFQUFBOEssSUFDQUMsRUFBQS9LLEVBQUFnTCxPQUNBQyxFQUFBbkIsR0FDQUEsR0FBQTlKLEVBQ0FBLEVBQUFnTCxPQUFBbE8sRUFHQWlPLEVBWUEvSyxFQUFBOEssSUFBQTlLLEVBQUFrTCxVQUFBSCxFQUFBak8sSUFWQWtELEVBQUE4SyxJQUFBOUssRUFBQWtMLFVBQ0FsTCxFQUFBOEssSUFBQWhPLEVBQUE2TixHQUFBLEVBQ0EzSyxFQUFBdlIsU0FBQTBjLFdBQ0FuTCxFQUFBdlIsU0FBQTJjLFNBSUFwTCxFQUFBdlIsU0FBQTBjLFdBQUFuTCxFQUFBdlIsU0FBQTJjLFFBQUEsTUFLQXRCLEdBQUFtQixFQUVBSixJQUNBQSxFQUFBUSxRQUFBLE1BRUFyTCxFQUFBOEssTUFDQTlLLEVBQUE4SyxJQUFBTyxRQUFBckwsR0FHQUEsRUFBQS9SLFFBQUErUixFQUFBa0QsU0FBQWxELEVBQUEvUixTQUFBK1IsRUFBQWtELFFBQUE4SCxTQUNBaEwsRUFBQWtELFFBQUE0SCxJQUFBOUssRUFBQThLLE1BTUE1QixFQUFBOWhCLFVBQUEyZ0IsYUFBQSxXQUNBLElBQUEvSCxFQUFBL1osS0FDQStaLEVBQUFtSyxVQUNBbkssRUFBQW1LLFNBQUFyUCxVQUlBb08sRUFBQTloQixVQUFBa2tCLFNBQUEsV0FDQSxJQUFBdEwsRUFBQS9aLEtBQ0EsSUFBQStaLEVBQUF3SyxrQkFBQSxDQUdBSSxHQUFBNUssRUFBQSxpQkFDQUEsRUFBQXdLLG1CQUFBLEVBRUEsSUFBQXJjLEVBQUE2UixFQUFBa0QsU0FDQS9VLEtBQUFxYyxtQkFBQXhLLEVBQUF2UixTQUFBdWIsVUFDQWxYLEVBQUEzRSxFQUFBOGIsVUFBQWpLLEdBR0FBLEVBQUFtSyxVQUNBbkssRUFBQW1LLFNBQUFvQixXQUVBLElBQUFoaUIsRUFBQXlXLEVBQUF3TCxVQUFBbGlCLE9BQ0EsTUFBQUMsSUFDQXlXLEVBQUF3TCxVQUFBamlCLEdBQUFnaUIsV0FJQXZMLEVBQUF5TCxNQUFBaE8sUUFDQXVDLEVBQUF5TCxNQUFBaE8sT0FBQVEsVUFHQStCLEVBQUF1SyxjQUFBLEVBRUF2SyxFQUFBa0wsVUFBQWxMLEVBQUFnTCxPQUFBLE1BRUFKLEdBQUE1SyxFQUFBLGFBRUFBLEVBQUErSSxPQUVBL0ksRUFBQThLLE1BQ0E5SyxFQUFBOEssSUFBQU8sUUFBQSxNQUdBckwsRUFBQS9SLFNBQ0ErUixFQUFBL1IsT0FBQUUsT0FBQSxRQUtBLFNBQUF1ZCxHQUNBMUwsRUFDQTJMLEVBQ0FoQixHQXlCQSxJQUFBaUIsRUFxQ0EsT0E1REE1TCxFQUFBOEssSUFBQWEsRUFDQTNMLEVBQUF2UixTQUFBckIsU0FDQTRTLEVBQUF2UixTQUFBckIsT0FBQXNQLElBbUJBa08sR0FBQTVLLEVBQUEsZUFzQkE0TCxFQUFBLFdBQ0E1TCxFQUFBMEssUUFBQTFLLEVBQUE2TCxVQUFBbEIsSUFPQSxJQUFBbUIsR0FBQTlMLEVBQUE0TCxFQUFBelcsRUFBQSxTQUNBd1YsR0FBQSxFQUlBLE1BQUEzSyxFQUFBL1IsU0FDQStSLEVBQUFzSyxZQUFBLEVBQ0FNLEdBQUE1SyxFQUFBLFlBRUFBLEVBR0EsU0FBQStMLEdBQ0EvTCxFQUNBK0IsRUFDQTBHLEVBQ0F1RCxFQUNBQyxHQVFBLElBQUFDLEtBQ0FELEdBQ0FqTSxFQUFBdlIsU0FBQTBkLGlCQUNBSCxFQUFBaGhCLEtBQUFvaEIsYUFDQXBNLEVBQUFxTSxlQUFBcmIsR0FrQkEsR0FmQWdQLEVBQUF2UixTQUFBNmQsYUFBQU4sRUFDQWhNLEVBQUEvUixPQUFBK2QsRUFFQWhNLEVBQUFnTCxTQUNBaEwsRUFBQWdMLE9BQUE3YyxPQUFBNmQsR0FFQWhNLEVBQUF2UixTQUFBMGQsZ0JBQUFGLEVBS0FqTSxFQUFBdU0sT0FBQVAsRUFBQWhoQixLQUFBaWIsT0FBQWpWLEVBQ0FnUCxFQUFBd00sV0FBQS9ELEdBQUF6WCxFQUdBK1EsR0FBQS9CLEVBQUF2UixTQUFBOFIsTUFBQSxDQUNBeEMsSUFBQSxHQUdBLElBRkEsSUFBQXdDLEVBQUFQLEVBQUF5QyxPQUNBZ0ssRUFBQXpNLEVBQUF2UixTQUFBaWUsY0FDQW5qQixFQUFBLEVBQW1CQSxFQUFBa2pCLEVBQUFuakIsT0FBcUJDLElBQUEsQ0FDeEMsSUFBQTNDLEVBQUE2bEIsRUFBQWxqQixHQUNBdVksRUFBQTlCLEVBQUF2UixTQUFBOFIsTUFDQUEsRUFBQTNaLEdBQUFpYixHQUFBamIsRUFBQWtiLEVBQUFDLEVBQUEvQixHQUVBakMsSUFBQSxHQUVBaUMsRUFBQXZSLFNBQUFzVCxZQUlBMEcsS0FBQXpYLEVBQ0EsSUFBQWdZLEVBQUFoSixFQUFBdlIsU0FBQWlhLGlCQUNBMUksRUFBQXZSLFNBQUFpYSxpQkFBQUQsRUFDQUUsR0FBQTNJLEVBQUF5SSxFQUFBTyxHQUdBa0QsSUFDQWxNLEVBQUEyTSxPQUFBbkQsR0FBQXlDLEVBQUFELEVBQUFoZSxTQUNBZ1MsRUFBQStILGdCQVFBLFNBQUE2RSxHQUFBNU0sR0FDQSxNQUFBQSxRQUFBa0QsU0FDQSxHQUFBbEQsRUFBQW9LLFVBQXVCLFNBRXZCLFNBR0EsU0FBQXlDLEdBQUE3TSxFQUFBOE0sR0FDQSxHQUFBQSxHQUVBLEdBREE5TSxFQUFBcUssaUJBQUEsRUFDQXVDLEdBQUE1TSxHQUNBLFlBRUcsR0FBQUEsRUFBQXFLLGdCQUNILE9BRUEsR0FBQXJLLEVBQUFvSyxXQUFBLE9BQUFwSyxFQUFBb0ssVUFBQSxDQUNBcEssRUFBQW9LLFdBQUEsRUFDQSxRQUFBN2dCLEVBQUEsRUFBbUJBLEVBQUF5VyxFQUFBaUssVUFBQTNnQixPQUF5QkMsSUFDNUNzakIsR0FBQTdNLEVBQUFpSyxVQUFBMWdCLElBRUFxaEIsR0FBQTVLLEVBQUEsY0FJQSxTQUFBK00sR0FBQS9NLEVBQUE4TSxHQUNBLEtBQUFBLElBQ0E5TSxFQUFBcUssaUJBQUEsR0FDQXVDLEdBQUE1TSxPQUlBQSxFQUFBb0ssVUFBQSxDQUNBcEssRUFBQW9LLFdBQUEsRUFDQSxRQUFBN2dCLEVBQUEsRUFBbUJBLEVBQUF5VyxFQUFBaUssVUFBQTNnQixPQUF5QkMsSUFDNUN3akIsR0FBQS9NLEVBQUFpSyxVQUFBMWdCLElBRUFxaEIsR0FBQTVLLEVBQUEsZ0JBSUEsU0FBQTRLLEdBQUE1SyxFQUFBclMsR0FFQXFOLEtBQ0EsSUFBQWdTLEVBQUFoTixFQUFBdlIsU0FBQWQsR0FDQSxHQUFBcWYsRUFDQSxRQUFBempCLEVBQUEsRUFBQTBqQixFQUFBRCxFQUFBMWpCLE9BQXdDQyxFQUFBMGpCLEVBQU8xakIsSUFDL0MsSUFDQXlqQixFQUFBempCLEdBQUE1QixLQUFBcVksR0FDTyxNQUFBblgsR0FDUGlhLEdBQUFqYSxFQUFBbVgsRUFBQXJTLEVBQUEsU0FJQXFTLEVBQUF3SSxlQUNBeEksRUFBQXVKLE1BQUEsUUFBQTViLEdBRUF1TixLQU1BLElBRUExUSxNQUNBMGlCLE1BQ0EvZCxNQUVBZ2UsSUFBQSxFQUNBQyxJQUFBLEVBQ0FuYSxHQUFBLEVBS0EsU0FBQW9hLEtBQ0FwYSxHQUFBekksR0FBQWxCLE9BQUE0akIsR0FBQTVqQixPQUFBLEVBQ0E2RixNQUlBZ2UsR0FBQUMsSUFBQSxFQU1BLFNBQUFFLEtBRUEsSUFBQUMsRUFBQTVpQixFQWNBLElBZkF5aUIsSUFBQSxFQVdBNWlCLEdBQUFnakIsS0FBQSxTQUFBblosRUFBQWUsR0FBOEIsT0FBQWYsRUFBQTFKLEdBQUF5SyxFQUFBekssS0FJOUJzSSxHQUFBLEVBQWlCQSxHQUFBekksR0FBQWxCLE9BQXNCMkosS0FDdkNzYSxFQUFBL2lCLEdBQUF5SSxJQUNBdEksRUFBQTRpQixFQUFBNWlCLEdBQ0F3RSxHQUFBeEUsR0FBQSxLQUNBNGlCLEVBQUE3aUIsTUFtQkEsSUFBQStpQixFQUFBUCxHQUFBbFosUUFDQTBaLEVBQUFsakIsR0FBQXdKLFFBRUFxWixLQUdBTSxHQUFBRixHQUNBRyxHQUFBRixHQUlBaFgsSUFBQUosRUFBQUksVUFDQUEsR0FBQW1YLEtBQUEsU0FJQSxTQUFBRCxHQUFBcGpCLEdBQ0EsSUFBQWpCLEVBQUFpQixFQUFBbEIsT0FDQSxNQUFBQyxJQUFBLENBQ0EsSUFBQWdrQixFQUFBL2lCLEVBQUFqQixHQUNBeVcsRUFBQXVOLEVBQUF2TixHQUNBQSxFQUFBbUssV0FBQW9ELEdBQUF2TixFQUFBc0ssWUFDQU0sR0FBQTVLLEVBQUEsWUFTQSxTQUFBOE4sR0FBQTlOLEdBR0FBLEVBQUFvSyxXQUFBLEVBQ0E4QyxHQUFBL2hCLEtBQUE2VSxHQUdBLFNBQUEyTixHQUFBbmpCLEdBQ0EsUUFBQWpCLEVBQUEsRUFBaUJBLEVBQUFpQixFQUFBbEIsT0FBa0JDLElBQ25DaUIsRUFBQWpCLEdBQUE2Z0IsV0FBQSxFQUNBeUMsR0FBQXJpQixFQUFBakIsSUFBQSxHQVNBLFNBQUF3a0IsR0FBQVIsR0FDQSxJQUFBNWlCLEVBQUE0aUIsRUFBQTVpQixHQUNBLFNBQUF3RSxHQUFBeEUsR0FBQSxDQUVBLEdBREF3RSxHQUFBeEUsSUFBQSxFQUNBeWlCLEdBRUssQ0FHTCxJQUFBN2pCLEVBQUFpQixHQUFBbEIsT0FBQSxFQUNBLE1BQUFDLEVBQUEwSixJQUFBekksR0FBQWpCLEdBQUFvQixHQUFBNGlCLEVBQUE1aUIsR0FDQXBCLElBRUFpQixHQUFBMkksT0FBQTVKLEVBQUEsSUFBQWdrQixRQVJBL2lCLEdBQUFXLEtBQUFvaUIsR0FXQUosS0FDQUEsSUFBQSxFQUNBOWhCLEdBQUFpaUIsTUFPQSxJQUFBVSxHQUFBLEVBT0FsQyxHQUFBLFNBQ0E5TCxFQUNBaU8sRUFDQTVKLEVBQ0F6VyxFQUNBc2dCLEdBRUFqb0IsS0FBQStaLEtBQ0FrTyxJQUNBbE8sRUFBQW1LLFNBQUFsa0IsTUFFQStaLEVBQUF3TCxVQUFBcmdCLEtBQUFsRixNQUVBMkgsR0FDQTNILEtBQUFrb0IsT0FBQXZnQixFQUFBdWdCLEtBQ0Fsb0IsS0FBQW1vQixPQUFBeGdCLEVBQUF3Z0IsS0FDQW5vQixLQUFBb29CLE9BQUF6Z0IsRUFBQXlnQixLQUNBcG9CLEtBQUE0aEIsT0FBQWphLEVBQUFpYSxNQUVBNWhCLEtBQUFrb0IsS0FBQWxvQixLQUFBbW9CLEtBQUFub0IsS0FBQW9vQixLQUFBcG9CLEtBQUE0aEIsTUFBQSxFQUVBNWhCLEtBQUFvZSxLQUNBcGUsS0FBQTBFLEtBQUFxakIsR0FDQS9uQixLQUFBcW9CLFFBQUEsRUFDQXJvQixLQUFBc29CLE1BQUF0b0IsS0FBQW9vQixLQUNBcG9CLEtBQUF1b0IsUUFDQXZvQixLQUFBd29CLFdBQ0F4b0IsS0FBQXlvQixPQUFBLElBQUE3VSxHQUNBNVQsS0FBQTBvQixVQUFBLElBQUE5VSxHQUNBNVQsS0FBQTJvQixXQUVBLEdBRUEsb0JBQUFYLEVBQ0Fob0IsS0FBQStZLE9BQUFpUCxHQUVBaG9CLEtBQUErWSxPQUFBakgsRUFBQWtXLEdBQ0Fob0IsS0FBQStZLFNBQ0EvWSxLQUFBK1ksT0FBQSxlQVNBL1ksS0FBQWtHLE1BQUFsRyxLQUFBb29CLFVBQ0E3bUIsRUFDQXZCLEtBQUFxVCxPQU1Bd1MsR0FBQTFrQixVQUFBa1MsSUFBQSxXQUVBLElBQUFuTixFQURBNk8sR0FBQS9VLE1BRUEsSUFBQStaLEVBQUEvWixLQUFBK1osR0FDQSxJQUNBN1QsRUFBQWxHLEtBQUErWSxPQUFBclgsS0FBQXFZLEtBQ0csTUFBQW5YLEdBQ0gsSUFBQTVDLEtBQUFtb0IsS0FHQSxNQUFBdmxCLEVBRkFpYSxHQUFBamEsRUFBQW1YLEVBQUEsdUJBQUEvWixLQUFBLGdCQUlHLFFBR0hBLEtBQUFrb0IsTUFDQTNKLEdBQUFyWSxHQUVBK08sS0FDQWpWLEtBQUE0b0IsY0FFQSxPQUFBMWlCLEdBTUEyZixHQUFBMWtCLFVBQUF3VCxPQUFBLFNBQUErQyxHQUNBLElBQUFoVCxFQUFBZ1QsRUFBQWhULEdBQ0ExRSxLQUFBMG9CLFVBQUF4ZixJQUFBeEUsS0FDQTFFLEtBQUEwb0IsVUFBQXJnQixJQUFBM0QsR0FDQTFFLEtBQUF3b0IsUUFBQXRqQixLQUFBd1MsR0FDQTFYLEtBQUF5b0IsT0FBQXZmLElBQUF4RSxJQUNBZ1QsRUFBQXBELE9BQUF0VSxRQVFBNmxCLEdBQUExa0IsVUFBQXluQixZQUFBLFdBQ0EsSUFBQXpGLEVBQUFuakIsS0FFQXNELEVBQUF0RCxLQUFBdW9CLEtBQUFsbEIsT0FDQSxNQUFBQyxJQUFBLENBQ0EsSUFBQW9VLEVBQUF5TCxFQUFBb0YsS0FBQWpsQixHQUNBNmYsRUFBQXVGLFVBQUF4ZixJQUFBd08sRUFBQWhULEtBQ0FnVCxFQUFBbEQsVUFBQTJPLEdBR0EsSUFBQTBGLEVBQUE3b0IsS0FBQXlvQixPQUNBem9CLEtBQUF5b0IsT0FBQXpvQixLQUFBMG9CLFVBQ0Exb0IsS0FBQTBvQixVQUFBRyxFQUNBN29CLEtBQUEwb0IsVUFBQTFpQixRQUNBNmlCLEVBQUE3b0IsS0FBQXVvQixLQUNBdm9CLEtBQUF1b0IsS0FBQXZvQixLQUFBd29CLFFBQ0F4b0IsS0FBQXdvQixRQUFBSyxFQUNBN29CLEtBQUF3b0IsUUFBQW5sQixPQUFBLEdBT0F3aUIsR0FBQTFrQixVQUFBMFQsT0FBQSxXQUVBN1UsS0FBQW9vQixLQUNBcG9CLEtBQUFzb0IsT0FBQSxFQUNHdG9CLEtBQUE0aEIsS0FDSDVoQixLQUFBeUUsTUFFQXFqQixHQUFBOW5CLE9BUUE2bEIsR0FBQTFrQixVQUFBc0QsSUFBQSxXQUNBLEdBQUF6RSxLQUFBcW9CLE9BQUEsQ0FDQSxJQUFBbmlCLEVBQUFsRyxLQUFBcVQsTUFDQSxHQUNBbk4sSUFBQWxHLEtBQUFrRyxPQUlBRSxFQUFBRixJQUNBbEcsS0FBQWtvQixLQUNBLENBRUEsSUFBQVksRUFBQTlvQixLQUFBa0csTUFFQSxHQURBbEcsS0FBQWtHLFFBQ0FsRyxLQUFBbW9CLEtBQ0EsSUFDQW5vQixLQUFBb2UsR0FBQTFjLEtBQUExQixLQUFBK1osR0FBQTdULEVBQUE0aUIsR0FDUyxNQUFBbG1CLEdBQ1RpYSxHQUFBamEsRUFBQTVDLEtBQUErWixHQUFBLHlCQUFBL1osS0FBQSxxQkFHQUEsS0FBQW9lLEdBQUExYyxLQUFBMUIsS0FBQStaLEdBQUE3VCxFQUFBNGlCLE1BVUFqRCxHQUFBMWtCLFVBQUE0bkIsU0FBQSxXQUNBL29CLEtBQUFrRyxNQUFBbEcsS0FBQXFULE1BQ0FyVCxLQUFBc29CLE9BQUEsR0FNQXpDLEdBQUExa0IsVUFBQXNULE9BQUEsV0FDQSxJQUFBME8sRUFBQW5qQixLQUVBc0QsRUFBQXRELEtBQUF1b0IsS0FBQWxsQixPQUNBLE1BQUFDLElBQ0E2ZixFQUFBb0YsS0FBQWpsQixHQUFBbVIsVUFPQW9SLEdBQUExa0IsVUFBQW1rQixTQUFBLFdBQ0EsSUFBQW5DLEVBQUFuakIsS0FFQSxHQUFBQSxLQUFBcW9CLE9BQUEsQ0FJQXJvQixLQUFBK1osR0FBQXdLLG1CQUNBMVgsRUFBQTdNLEtBQUErWixHQUFBd0wsVUFBQXZsQixNQUVBLElBQUFzRCxFQUFBdEQsS0FBQXVvQixLQUFBbGxCLE9BQ0EsTUFBQUMsSUFDQTZmLEVBQUFvRixLQUFBamxCLEdBQUFrUixVQUFBMk8sR0FFQW5qQixLQUFBcW9CLFFBQUEsSUFNQSxJQUFBVyxJQUNBdlgsWUFBQSxFQUNBRyxjQUFBLEVBQ0F5QixJQUFBbkUsRUFDQW5KLElBQUFtSixHQUdBLFNBQUErWixHQUFBdlUsRUFBQXdVLEVBQUF2b0IsR0FDQXFvQixHQUFBM1YsSUFBQSxXQUNBLE9BQUFyVCxLQUFBa3BCLEdBQUF2b0IsSUFFQXFvQixHQUFBampCLElBQUEsU0FBQTBELEdBQ0F6SixLQUFBa3BCLEdBQUF2b0IsR0FBQThJLEdBRUE5SCxPQUFBK1AsZUFBQWdELEVBQUEvVCxFQUFBcW9CLElBR0EsU0FBQUcsR0FBQXBQLEdBQ0FBLEVBQUF3TCxhQUNBLElBQUFuUyxFQUFBMkcsRUFBQXZSLFNBQ0E0SyxFQUFBa0gsT0FBbUI4TyxHQUFBclAsRUFBQTNHLEVBQUFrSCxPQUNuQmxILEVBQUExUyxTQUFxQjJvQixHQUFBdFAsRUFBQTNHLEVBQUExUyxTQUNyQjBTLEVBQUFyTyxLQUNBdWtCLEdBQUF2UCxHQUVBekIsR0FBQXlCLEVBQUF5TCxVQUF5QixHQUV6QnBTLEVBQUFvSCxVQUFzQitPLEdBQUF4UCxFQUFBM0csRUFBQW9ILFVBQ3RCcEgsRUFBQUYsT0FBQUUsRUFBQUYsUUFBQUQsSUFDQXVXLEdBQUF6UCxFQUFBM0csRUFBQUYsT0FJQSxTQUFBa1csR0FBQXJQLEVBQUEwUCxHQUNBLElBQUEzTixFQUFBL0IsRUFBQXZSLFNBQUFzVCxjQUNBeEIsRUFBQVAsRUFBQXlDLFVBR0E3YyxFQUFBb2EsRUFBQXZSLFNBQUFpZSxhQUNBaUQsR0FBQTNQLEVBQUFrRCxRQUVBeU0sR0FDQTVSLElBQUEsR0FFQSxJQUFBNlIsRUFBQSxTQUFBaHBCLEdBQ0FoQixFQUFBdUYsS0FBQXZFLEdBQ0EsSUFBQXVGLEVBQUEwVixHQUFBamIsRUFBQThvQixFQUFBM04sRUFBQS9CLEdBdUJBckIsR0FBQTRCLEVBQUEzWixFQUFBdUYsR0FLQXZGLEtBQUFvWixHQUNBa1AsR0FBQWxQLEVBQUEsU0FBQXBaLElBSUEsUUFBQUEsS0FBQThvQixFQUFBRSxFQUFBaHBCLEdBQ0FtWCxJQUFBLEdBR0EsU0FBQXdSLEdBQUF2UCxHQUNBLElBQUFoVixFQUFBZ1YsRUFBQXZSLFNBQUF6RCxLQUNBQSxFQUFBZ1YsRUFBQXlMLE1BQUEsb0JBQUF6Z0IsRUFDQTZrQixHQUFBN2tCLEVBQUFnVixHQUNBaFYsTUFDQTJHLEVBQUEzRyxLQUNBQSxNQVFBLElBQUFwRixFQUFBZ0MsT0FBQWhDLEtBQUFvRixHQUNBdVYsRUFBQVAsRUFBQXZSLFNBQUE4UixNQUVBaFgsR0FEQXlXLEVBQUF2UixTQUFBOUgsUUFDQWYsRUFBQTBELFFBQ0EsTUFBQUMsSUFBQSxDQUNBLElBQUEzQyxFQUFBaEIsRUFBQTJELEdBQ0EsRUFRQWdYLEdBQUFuTixFQUFBbU4sRUFBQTNaLElBTUsyUSxFQUFBM1EsSUFDTHNvQixHQUFBbFAsRUFBQSxRQUFBcFosR0FJQTJYLEdBQUF2VCxHQUFBLEdBR0EsU0FBQTZrQixHQUFBN2tCLEVBQUFnVixHQUVBaEYsS0FDQSxJQUNBLE9BQUFoUSxFQUFBckQsS0FBQXFZLEtBQ0csTUFBQW5YLEdBRUgsT0FEQWlhLEdBQUFqYSxFQUFBbVgsRUFBQSxhQUVHLFFBQ0g5RSxNQUlBLElBQUE0VSxJQUE4QnpCLE1BQUEsR0FFOUIsU0FBQW1CLEdBQUF4UCxFQUFBUyxHQUVBLElBQUFzUCxFQUFBL1AsRUFBQWdRLGtCQUFBcG9CLE9BQUFrSixPQUFBLE1BRUFtZixFQUFBMVcsS0FFQSxRQUFBM1MsS0FBQTZaLEVBQUEsQ0FDQSxJQUFBeVAsRUFBQXpQLEVBQUE3WixHQUNBb1ksRUFBQSxvQkFBQWtSLE1BQUE1VyxJQUNBLEVBT0EyVyxJQUVBRixFQUFBbnBCLEdBQUEsSUFBQWtsQixHQUNBOUwsRUFDQWhCLEdBQUE3SixFQUNBQSxFQUNBMmEsS0FPQWxwQixLQUFBb1osR0FDQW1RLEdBQUFuUSxFQUFBcFosRUFBQXNwQixJQVdBLFNBQUFDLEdBQ0F4VixFQUNBL1QsRUFDQXNwQixHQUVBLElBQUFFLEdBQUE3VyxLQUNBLG9CQUFBMlcsR0FDQWpCLEdBQUEzVixJQUFBOFcsRUFDQUMsR0FBQXpwQixHQUNBc3BCLEVBQ0FqQixHQUFBampCLElBQUFtSixJQUVBOFosR0FBQTNWLElBQUE0VyxFQUFBNVcsSUFDQThXLElBQUEsSUFBQUYsRUFBQTVjLE1BQ0ErYyxHQUFBenBCLEdBQ0FzcEIsRUFBQTVXLElBQ0FuRSxFQUNBOFosR0FBQWpqQixJQUFBa2tCLEVBQUFsa0IsSUFDQWtrQixFQUFBbGtCLElBQ0FtSixHQVdBdk4sT0FBQStQLGVBQUFnRCxFQUFBL1QsRUFBQXFvQixJQUdBLFNBQUFvQixHQUFBenBCLEdBQ0Esa0JBQ0EsSUFBQTJtQixFQUFBdG5CLEtBQUErcEIsbUJBQUEvcEIsS0FBQStwQixrQkFBQXBwQixHQUNBLEdBQUEybUIsRUFPQSxPQU5BQSxFQUFBZ0IsT0FDQWhCLEVBQUF5QixXQUVBM1UsR0FBQU0sUUFDQTRTLEVBQUE3UyxTQUVBNlMsRUFBQXBoQixPQUtBLFNBQUFtakIsR0FBQXRQLEVBQUFyWixHQUNBcVosRUFBQXZSLFNBQUE4UixNQUNBLFFBQUEzWixLQUFBRCxFQXNCQXFaLEVBQUFwWixHQUFBLE1BQUFELEVBQUFDLEdBQUF1TyxFQUFBVCxFQUFBL04sRUFBQUMsR0FBQW9aLEdBSUEsU0FBQXlQLEdBQUF6UCxFQUFBN0csR0FDQSxRQUFBdlMsS0FBQXVTLEVBQUEsQ0FDQSxJQUFBbVgsRUFBQW5YLEVBQUF2UyxHQUNBLEdBQUFpTyxNQUFBYyxRQUFBMmEsR0FDQSxRQUFBL21CLEVBQUEsRUFBcUJBLEVBQUErbUIsRUFBQWhuQixPQUFvQkMsSUFDekNnbkIsR0FBQXZRLEVBQUFwWixFQUFBMHBCLEVBQUEvbUIsU0FHQWduQixHQUFBdlEsRUFBQXBaLEVBQUEwcEIsSUFLQSxTQUFBQyxHQUNBdlEsRUFDQWlPLEVBQ0FxQyxFQUNBMWlCLEdBU0EsT0FQQStELEVBQUEyZSxLQUNBMWlCLEVBQUEwaUIsRUFDQUEsYUFFQSxrQkFBQUEsSUFDQUEsRUFBQXRRLEVBQUFzUSxJQUVBdFEsRUFBQXdRLE9BQUF2QyxFQUFBcUMsRUFBQTFpQixHQUdBLFNBQUE2aUIsR0FBQXZILEdBSUEsSUFBQXdILEdBQ0FwWCxJQUFBLFdBQTZCLE9BQUFyVCxLQUFBd2xCLFFBQzdCa0YsR0FDQXJYLElBQUEsV0FBOEIsT0FBQXJULEtBQUF3YyxTQWE5QjdhLE9BQUErUCxlQUFBdVIsRUFBQTloQixVQUFBLFFBQUFzcEIsR0FDQTlvQixPQUFBK1AsZUFBQXVSLEVBQUE5aEIsVUFBQSxTQUFBdXBCLEdBRUF6SCxFQUFBOWhCLFVBQUF3cEIsS0FBQTVrQixHQUNBa2QsRUFBQTloQixVQUFBeXBCLFFBQUF2UixHQUVBNEosRUFBQTloQixVQUFBb3BCLE9BQUEsU0FDQXZDLEVBQ0E1SixFQUNBelcsR0FFQSxJQUFBb1MsRUFBQS9aLEtBQ0EsR0FBQTBMLEVBQUEwUyxHQUNBLE9BQUFrTSxHQUFBdlEsRUFBQWlPLEVBQUE1SixFQUFBelcsR0FFQUEsUUFDQUEsRUFBQXdnQixNQUFBLEVBQ0EsSUFBQWIsRUFBQSxJQUFBekIsR0FBQTlMLEVBQUFpTyxFQUFBNUosRUFBQXpXLEdBSUEsT0FIQUEsRUFBQWtqQixXQUNBek0sRUFBQTFjLEtBQUFxWSxFQUFBdU4sRUFBQXBoQixPQUVBLFdBQ0FvaEIsRUFBQWhDLGFBT0EsU0FBQXdGLEdBQUEvUSxHQUNBLElBQUFVLEVBQUFWLEVBQUF2UixTQUFBaVMsUUFDQUEsSUFDQVYsRUFBQWdSLFVBQUEsb0JBQUF0USxFQUNBQSxFQUFBL1ksS0FBQXFZLEdBQ0FVLEdBSUEsU0FBQXVRLEdBQUFqUixHQUNBLElBQUFqUCxFQUFBbWdCLEdBQUFsUixFQUFBdlIsU0FBQStSLE9BQUFSLEdBQ0FqUCxJQUNBZ04sSUFBQSxHQUNBblcsT0FBQWhDLEtBQUFtTCxHQUFBb00sUUFBQSxTQUFBdlcsR0FZQStYLEdBQUFxQixFQUFBcFosRUFBQW1LLEVBQUFuSyxNQUdBbVgsSUFBQSxJQUlBLFNBQUFtVCxHQUFBMVEsRUFBQVIsR0FDQSxHQUFBUSxFQUFBLENBVUEsSUFSQSxJQUFBelAsRUFBQW5KLE9BQUFrSixPQUFBLE1BQ0FsTCxFQUFBa1UsR0FDQUUsUUFBQUMsUUFBQXVHLEdBQUEyUSxPQUFBLFNBQUF2cUIsR0FFQSxPQUFBZ0IsT0FBQW1YLHlCQUFBeUIsRUFBQTVaLEdBQUE4USxhQUVBOVAsT0FBQWhDLEtBQUE0YSxHQUVBalgsRUFBQSxFQUFtQkEsRUFBQTNELEVBQUEwRCxPQUFpQkMsSUFBQSxDQUNwQyxJQUFBM0MsRUFBQWhCLEVBQUEyRCxHQUNBNm5CLEVBQUE1USxFQUFBNVosR0FBQThZLEtBQ0EyUixFQUFBclIsRUFDQSxNQUFBcVIsRUFBQSxDQUNBLEdBQUFBLEVBQUFMLFdBQUE1ZCxFQUFBaWUsRUFBQUwsVUFBQUksR0FBQSxDQUNBcmdCLEVBQUFuSyxHQUFBeXFCLEVBQUFMLFVBQUFJLEdBQ0EsTUFFQUMsSUFBQW5PLFFBRUEsSUFBQW1PLEVBQ0EsZUFBQTdRLEVBQUE1WixHQUFBLENBQ0EsSUFBQTBxQixFQUFBOVEsRUFBQTVaLEdBQUE0YixRQUNBelIsRUFBQW5LLEdBQUEsb0JBQUEwcUIsRUFDQUEsRUFBQTNwQixLQUFBcVksR0FDQXNSLE9BQ1MsRUFLVCxPQUFBdmdCLEdBU0EsU0FBQXdnQixHQUNBN2hCLEVBQ0F0QyxHQUVBLElBQUFoQixFQUFBN0MsRUFBQStLLEVBQUExTyxFQUFBZ0IsRUFDQSxHQUFBaU8sTUFBQWMsUUFBQWpHLElBQUEsa0JBQUFBLEVBRUEsSUFEQXRELEVBQUEsSUFBQXlJLE1BQUFuRixFQUFBcEcsUUFDQUMsRUFBQSxFQUFBK0ssRUFBQTVFLEVBQUFwRyxPQUErQkMsRUFBQStLLEVBQU8vSyxJQUN0QzZDLEVBQUE3QyxHQUFBNkQsRUFBQXNDLEVBQUFuRyxXQUVHLHFCQUFBbUcsRUFFSCxJQURBdEQsRUFBQSxJQUFBeUksTUFBQW5GLEdBQ0FuRyxFQUFBLEVBQWVBLEVBQUFtRyxFQUFTbkcsSUFDeEI2QyxFQUFBN0MsR0FBQTZELEVBQUE3RCxFQUFBLEVBQUFBLFFBRUcsR0FBQThDLEVBQUFxRCxHQUdILElBRkE5SixFQUFBZ0MsT0FBQWhDLEtBQUE4SixHQUNBdEQsRUFBQSxJQUFBeUksTUFBQWpQLEVBQUEwRCxRQUNBQyxFQUFBLEVBQUErSyxFQUFBMU8sRUFBQTBELE9BQWdDQyxFQUFBK0ssRUFBTy9LLElBQ3ZDM0MsRUFBQWhCLEVBQUEyRCxHQUNBNkMsRUFBQTdDLEdBQUE2RCxFQUFBc0MsRUFBQTlJLEtBQUEyQyxHQU1BLE9BSEE2SCxFQUFBaEYsS0FDQSxFQUFBMGEsVUFBQSxHQUVBMWEsRUFRQSxTQUFBb2xCLEdBQ0EzcEIsRUFDQTRwQixFQUNBbFIsRUFDQW1SLEdBRUEsSUFDQUMsRUFEQUMsRUFBQTNyQixLQUFBb21CLGFBQUF4a0IsR0FFQSxHQUFBK3BCLEVBQ0FyUixRQUNBbVIsSUFPQW5SLEVBQUF6TCxPQUE4QjRjLEdBQUFuUixJQUU5Qm9SLEVBQUFDLEVBQUFyUixJQUFBa1IsTUFDRyxDQUNILElBQUFJLEVBQUE1ckIsS0FBQTBtQixPQUFBOWtCLEdBRUFncUIsSUFRQUEsRUFBQUMsV0FBQSxHQUVBSCxFQUFBRSxHQUFBSixFQUdBLElBQUE5VyxFQUFBNEYsS0FBQW1KLEtBQ0EsT0FBQS9PLEVBQ0ExVSxLQUFBOHJCLGVBQUEsWUFBNENySSxLQUFBL08sR0FBZWdYLEdBRTNEQSxFQVNBLFNBQUFLLEdBQUFybkIsR0FDQSxPQUFBNlcsR0FBQXZiLEtBQUF3SSxTQUFBLFVBQUE5RCxHQUFBLElBQUEySyxFQUtBLFNBQUEyYyxHQUFBQyxFQUFBQyxHQUNBLE9BQUF0ZCxNQUFBYyxRQUFBdWMsSUFDQSxJQUFBQSxFQUFBaGYsUUFBQWlmLEdBRUFELElBQUFDLEVBU0EsU0FBQUMsR0FDQUMsRUFDQXpyQixFQUNBMHJCLEVBQ0FDLEVBQ0FDLEdBRUEsSUFBQUMsRUFBQW5jLEVBQUFTLFNBQUFuUSxJQUFBMHJCLEVBQ0EsT0FBQUUsR0FBQUQsSUFBQWpjLEVBQUFTLFNBQUFuUSxHQUNBcXJCLEdBQUFPLEVBQUFELEdBQ0dFLEVBQ0hSLEdBQUFRLEVBQUFKLEdBQ0dFLEVBQ0hyZSxFQUFBcWUsS0FBQTNyQixPQURHLEVBVUgsU0FBQThyQixHQUNBMW5CLEVBQ0FxUSxFQUNBbFAsRUFDQXdtQixFQUNBQyxHQUVBLEdBQUF6bUIsRUFDQSxHQUFBRSxFQUFBRixHQUtLLENBSUwsSUFBQWlhLEVBSEF2UixNQUFBYyxRQUFBeEosS0FDQUEsRUFBQThJLEVBQUE5SSxJQUdBLElBQUF5akIsRUFBQSxTQUFBaHBCLEdBQ0EsR0FDQSxVQUFBQSxHQUNBLFVBQUFBLEdBQ0FpTSxFQUFBak0sR0FFQXdmLEVBQUFwYixNQUNTLENBQ1QsSUFBQXFWLEVBQUFyVixFQUFBaWIsT0FBQWpiLEVBQUFpYixNQUFBNUYsS0FDQStGLEVBQUF1TSxHQUFBcmMsRUFBQWUsWUFBQWdFLEVBQUFnRixFQUFBelosR0FDQW9FLEVBQUE2bkIsV0FBQTduQixFQUFBNm5CLGFBQ0E3bkIsRUFBQWliLFFBQUFqYixFQUFBaWIsVUFFQSxLQUFBcmYsS0FBQXdmLEtBQ0FBLEVBQUF4ZixHQUFBdUYsRUFBQXZGLEdBRUFnc0IsR0FBQSxDQUNBLElBQUF0TixFQUFBdGEsRUFBQXNhLEtBQUF0YSxFQUFBc2EsT0FDQUEsRUFBQSxVQUFBMWUsR0FBQSxTQUFBa3NCLEdBQ0EzbUIsRUFBQXZGLEdBQUFrc0IsS0FNQSxRQUFBbHNCLEtBQUF1RixFQUFBeWpCLEVBQUFocEIsUUFHQSxPQUFBb0UsRUFRQSxTQUFBK25CLEdBQ0E5ZixFQUNBK2YsR0FFQSxJQUFBM2YsRUFBQXBOLEtBQUFndEIsZUFBQWh0QixLQUFBZ3RCLGlCQUNBQyxFQUFBN2YsRUFBQUosR0FHQSxPQUFBaWdCLElBQUFGLEVBQ0FFLEdBR0FBLEVBQUE3ZixFQUFBSixHQUFBaE4sS0FBQXdJLFNBQUFwQixnQkFBQTRGLEdBQUF0TCxLQUNBMUIsS0FBQWt0QixhQUNBLEtBQ0FsdEIsTUFFQW10QixHQUFBRixFQUFBLGFBQUFqZ0IsR0FBQSxHQUNBaWdCLEdBT0EsU0FBQUcsR0FDQUgsRUFDQWpnQixFQUNBck0sR0FHQSxPQURBd3NCLEdBQUFGLEVBQUEsV0FBQWpnQixHQUFBck0sRUFBQSxJQUFBQSxFQUFBLFFBQ0Fzc0IsRUFHQSxTQUFBRSxHQUNBRixFQUNBdHNCLEVBQ0F5VixHQUVBLEdBQUF4SCxNQUFBYyxRQUFBdWQsR0FDQSxRQUFBM3BCLEVBQUEsRUFBbUJBLEVBQUEycEIsRUFBQTVwQixPQUFpQkMsSUFDcEMycEIsRUFBQTNwQixJQUFBLGtCQUFBMnBCLEVBQUEzcEIsSUFDQStwQixHQUFBSixFQUFBM3BCLEdBQUEzQyxFQUFBLElBQUEyQyxFQUFBOFMsUUFJQWlYLEdBQUFKLEVBQUF0c0IsRUFBQXlWLEdBSUEsU0FBQWlYLEdBQUEzVyxFQUFBL1YsRUFBQXlWLEdBQ0FNLEVBQUFWLFVBQUEsRUFDQVUsRUFBQS9WLE1BQ0ErVixFQUFBTixTQUtBLFNBQUFrWCxHQUFBdm9CLEVBQUFtQixHQUNBLEdBQUFBLEVBQ0EsR0FBQXdGLEVBQUF4RixHQUtLLENBQ0wsSUFBQW1aLEVBQUF0YSxFQUFBc2EsR0FBQXRhLEVBQUFzYSxHQUFBeFEsS0FBNEM5SixFQUFBc2EsT0FDNUMsUUFBQTFlLEtBQUF1RixFQUFBLENBQ0EsSUFBQTJDLEVBQUF3VyxFQUFBMWUsR0FDQTRzQixFQUFBcm5CLEVBQUF2RixHQUNBMGUsRUFBQTFlLEdBQUFrSSxLQUFBRSxPQUFBRixFQUFBMGtCLFdBSUEsT0FBQXhvQixFQUtBLFNBQUF5b0IsR0FBQTlZLEdBQ0FBLEVBQUErWSxHQUFBTCxHQUNBMVksRUFBQWdaLEdBQUF0aEIsRUFDQXNJLEVBQUFpWixHQUFBbGlCLEVBQ0FpSixFQUFBa1osR0FBQXRDLEdBQ0E1VyxFQUFBbVosR0FBQXRDLEdBQ0E3VyxFQUFBb1osR0FBQXhlLEVBQ0FvRixFQUFBcVosR0FBQWhlLEVBQ0EyRSxFQUFBc1osR0FBQWxCLEdBQ0FwWSxFQUFBdVosR0FBQWxDLEdBQ0FyWCxFQUFBd1osR0FBQS9CLEdBQ0F6WCxFQUFBeVosR0FBQTFCLEdBQ0EvWCxFQUFBMFosR0FBQXpYLEdBQ0FqQyxFQUFBMlosR0FBQTVYLEdBQ0EvQixFQUFBNFosR0FBQTFLLEdBQ0FsUCxFQUFBNlosR0FBQWpCLEdBS0EsU0FBQWtCLEdBQ0F6cEIsRUFDQXVWLEVBQ0FqRixFQUNBbk4sRUFDQXlMLEdBRUEsSUFHQThhLEVBSEE5bUIsRUFBQWdNLEVBQUFoTSxRQUlBd0YsRUFBQWpGLEVBQUEsU0FDQXVtQixFQUFBOXNCLE9BQUFrSixPQUFBM0MsR0FFQXVtQixFQUFBQyxVQUFBeG1CLElBS0F1bUIsRUFBQXZtQixFQUVBQSxJQUFBd21CLFdBRUEsSUFBQUMsRUFBQXZqQixFQUFBekQsRUFBQUMsV0FDQWduQixHQUFBRCxFQUVBM3VCLEtBQUErRSxPQUNBL0UsS0FBQXNhLFFBQ0F0YSxLQUFBcVYsV0FDQXJWLEtBQUFrSSxTQUNBbEksS0FBQXdpQixVQUFBemQsRUFBQXNhLElBQUF0VSxFQUNBL0ssS0FBQTZ1QixXQUFBNUQsR0FBQXRqQixFQUFBNFMsT0FBQXJTLEdBQ0FsSSxLQUFBd2pCLE1BQUEsV0FBNEIsT0FBQUQsR0FBQWxPLEVBQUFuTixJQUc1QnltQixJQUVBM3VCLEtBQUF3SSxTQUFBYixFQUVBM0gsS0FBQTBtQixPQUFBMW1CLEtBQUF3akIsUUFDQXhqQixLQUFBb21CLGFBQUFyaEIsRUFBQW9oQixhQUFBcGIsR0FHQXBELEVBQUFHLFNBQ0E5SCxLQUFBOHVCLEdBQUEsU0FBQTFnQixFQUFBZSxFQUFBeEIsRUFBQTNFLEdBQ0EsSUFBQTZOLEVBQUF0USxHQUFBa29CLEVBQUFyZ0IsRUFBQWUsRUFBQXhCLEVBQUEzRSxFQUFBNGxCLEdBS0EsT0FKQS9YLElBQUFqSSxNQUFBYyxRQUFBbUgsS0FDQUEsRUFBQWhCLFVBQUFsTyxFQUFBRyxTQUNBK08sRUFBQWxCLFVBQUF6TixHQUVBMk8sR0FHQTdXLEtBQUE4dUIsR0FBQSxTQUFBMWdCLEVBQUFlLEVBQUF4QixFQUFBM0UsR0FBcUMsT0FBQXpDLEdBQUFrb0IsRUFBQXJnQixFQUFBZSxFQUFBeEIsRUFBQTNFLEVBQUE0bEIsSUFNckMsU0FBQUcsR0FDQXBiLEVBQ0FtSSxFQUNBL1csRUFDQTBwQixFQUNBcFosR0FFQSxJQUFBMU4sRUFBQWdNLEVBQUFoTSxRQUNBMlMsS0FDQXVCLEVBQUFsVSxFQUFBMlMsTUFDQSxHQUFBblAsRUFBQTBRLEdBQ0EsUUFBQWxiLEtBQUFrYixFQUNBdkIsRUFBQTNaLEdBQUFpYixHQUFBamIsRUFBQWtiLEVBQUFDLEdBQUEvUSxRQUdBSSxFQUFBcEcsRUFBQWliLFFBQTRCZ1AsR0FBQTFVLEVBQUF2VixFQUFBaWIsT0FDNUI3VSxFQUFBcEcsRUFBQXVWLFFBQTRCMFUsR0FBQTFVLEVBQUF2VixFQUFBdVYsT0FHNUIsSUFBQTJVLEVBQUEsSUFBQVQsR0FDQXpwQixFQUNBdVYsRUFDQWpGLEVBQ0FvWixFQUNBOWEsR0FHQWtELEVBQUFsUCxFQUFBUixPQUFBekYsS0FBQSxLQUFBdXRCLEVBQUFILEdBQUFHLEdBRUEsR0FBQXBZLGFBQUExQixHQUNBLE9BQUErWixHQUFBclksRUFBQTlSLEVBQUFrcUIsRUFBQS9tQixPQUFBUCxHQUNHLEdBQUFpSCxNQUFBYyxRQUFBbUgsR0FBQSxDQUdILElBRkEsSUFBQXNZLEVBQUE3TyxHQUFBekosT0FDQTVILEVBQUEsSUFBQUwsTUFBQXVnQixFQUFBOXJCLFFBQ0FDLEVBQUEsRUFBbUJBLEVBQUE2ckIsRUFBQTlyQixPQUFtQkMsSUFDdEMyTCxFQUFBM0wsR0FBQTRyQixHQUFBQyxFQUFBN3JCLEdBQUF5QixFQUFBa3FCLEVBQUEvbUIsT0FBQVAsR0FFQSxPQUFBc0gsR0FJQSxTQUFBaWdCLEdBQUFyWSxFQUFBOVIsRUFBQTBwQixFQUFBOW1CLEdBSUEsSUFBQXluQixFQUFBeFksR0FBQUMsR0FNQSxPQUxBdVksRUFBQXpaLFVBQUE4WSxFQUNBVyxFQUFBeFosVUFBQWpPLEVBQ0E1QyxFQUFBMGUsUUFDQTJMLEVBQUFycUIsT0FBQXFxQixFQUFBcnFCLFVBQW1DMGUsS0FBQTFlLEVBQUEwZSxNQUVuQzJMLEVBR0EsU0FBQUosR0FBQWxnQixFQUFBMkssR0FDQSxRQUFBOVksS0FBQThZLEVBQ0EzSyxFQUFBdEIsRUFBQTdNLElBQUE4WSxFQUFBOVksR0ExREE2c0IsR0FBQWdCLEdBQUFydEIsV0FvRkEsSUFBQWt1QixJQUNBQyxLQUFBLFNBQ0F6WSxFQUNBNk4sRUFDQTZLLEVBQ0FDLEdBRUEsR0FDQTNZLEVBQUFmLG9CQUNBZSxFQUFBZixrQkFBQXdPLGNBQ0F6TixFQUFBOVIsS0FBQTBxQixVQUNBLENBRUEsSUFBQUMsRUFBQTdZLEVBQ0F3WSxHQUFBTSxTQUFBRCxTQUNLLENBQ0wsSUFBQWxaLEVBQUFLLEVBQUFmLGtCQUFBOFosR0FDQS9ZLEVBQ0FnTixHQUNBMEwsRUFDQUMsR0FFQWhaLEVBQUFxWixPQUFBbkwsRUFBQTdOLEVBQUF0QixTQUFBaFUsRUFBQW1qQixLQUlBaUwsU0FBQSxTQUFBRyxFQUFBalosR0FDQSxJQUFBbFAsRUFBQWtQLEVBQUFyQixpQkFDQWdCLEVBQUFLLEVBQUFmLGtCQUFBZ2EsRUFBQWhhLGtCQUNBZ1EsR0FDQXRQLEVBQ0E3TyxFQUFBbVUsVUFDQW5VLEVBQUE2YSxVQUNBM0wsRUFDQWxQLEVBQUEwTixXQUlBMGEsT0FBQSxTQUFBbFosR0FDQSxJQUFBOU8sRUFBQThPLEVBQUE5TyxRQUNBK04sRUFBQWUsRUFBQWYsa0JBQ0FBLEVBQUF1TyxhQUNBdk8sRUFBQXVPLFlBQUEsRUFDQU0sR0FBQTdPLEVBQUEsWUFFQWUsRUFBQTlSLEtBQUEwcUIsWUFDQTFuQixFQUFBc2MsV0FNQXdELEdBQUEvUixHQUVBOFEsR0FBQTlRLEdBQUEsS0FLQWthLFFBQUEsU0FBQW5aLEdBQ0EsSUFBQWYsRUFBQWUsRUFBQWYsa0JBQ0FBLEVBQUF3TyxlQUNBek4sRUFBQTlSLEtBQUEwcUIsVUFHQTNJLEdBQUFoUixHQUFBLEdBRkFBLEVBQUF1UCxjQVFBNEssR0FBQXR1QixPQUFBaEMsS0FBQTB2QixJQUVBLFNBQUFhLEdBQ0F2YyxFQUNBNU8sRUFDQWdELEVBQ0FzTixFQUNBRCxHQUVBLElBQUFuSyxFQUFBMEksR0FBQSxDQUlBLElBQUEyTixFQUFBdlosRUFBQVMsU0FBQTJuQixNQVNBLEdBTkEvcEIsRUFBQXVOLEtBQ0FBLEVBQUEyTixFQUFBelMsT0FBQThFLElBS0Esb0JBQUFBLEVBQUEsQ0FRQSxJQUFBOEIsRUFDQSxHQUFBeEssRUFBQTBJLEVBQUF5YyxPQUNBM2EsRUFBQTlCLEVBQ0FBLEVBQUEwTixHQUFBNUwsRUFBQTZMLEVBQUF2WixRQUNBeEcsSUFBQW9TLEdBSUEsT0FBQXdOLEdBQ0ExTCxFQUNBMVEsRUFDQWdELEVBQ0FzTixFQUNBRCxHQUtBclEsUUFJQXNyQixHQUFBMWMsR0FHQXhJLEVBQUFwRyxFQUFBdXJCLFFBQ0FDLEdBQUE1YyxFQUFBaE0sUUFBQTVDLEdBSUEsSUFBQStXLEVBQUFpRSxHQUFBaGIsRUFBQTRPLEVBQUF5QixHQUdBLEdBQUFoSyxFQUFBdUksRUFBQWhNLFFBQUFFLFlBQ0EsT0FBQWtuQixHQUFBcGIsRUFBQW1JLEVBQUEvVyxFQUFBZ0QsRUFBQXNOLEdBS0EsSUFBQW1OLEVBQUF6ZCxFQUFBc2EsR0FLQSxHQUZBdGEsRUFBQXNhLEdBQUF0YSxFQUFBeXJCLFNBRUFwbEIsRUFBQXVJLEVBQUFoTSxRQUFBb2MsVUFBQSxDQUtBLElBQUFOLEVBQUExZSxFQUFBMGUsS0FDQTFlLEtBQ0EwZSxJQUNBMWUsRUFBQTBlLFFBS0FnTixHQUFBMXJCLEdBR0EsSUFBQW5ELEVBQUErUixFQUFBaE0sUUFBQS9GLE1BQUF3VCxFQUNBeUIsRUFBQSxJQUFBMUIsR0FDQSxpQkFBQXhCLEVBQUEsS0FBQS9SLEVBQUEsSUFBQUEsRUFBQSxJQUNBbUQsT0FBQXhELGdCQUFBd0csR0FDSzRMLE9BQUFtSSxZQUFBMEcsWUFBQXBOLE1BQUFDLFlBQ0xJLEdBT0EsT0FBQW9CLElBR0EsU0FBQStZLEdBQ0EvWSxFQUNBM08sRUFDQXFuQixFQUNBQyxHQUVBLElBQUE3bkIsR0FDQStvQixjQUFBLEVBQ0F4b0IsU0FDQW1lLGFBQUF4UCxFQUNBcU8sV0FBQXFLLEdBQUEsS0FDQXBLLFFBQUFxSyxHQUFBLE1BR0FtQixFQUFBOVosRUFBQTlSLEtBQUE0ckIsZUFLQSxPQUpBeGxCLEVBQUF3bEIsS0FDQWhwQixFQUFBUixPQUFBd3BCLEVBQUF4cEIsT0FDQVEsRUFBQVAsZ0JBQUF1cEIsRUFBQXZwQixpQkFFQSxJQUFBeVAsRUFBQXJCLGlCQUFBN0IsS0FBQWhNLEdBR0EsU0FBQThvQixHQUFBMXJCLEdBRUEsSUFEQSxJQUFBbVksRUFBQW5ZLEVBQUEyQyxPQUFBM0MsRUFBQTJDLFNBQ0FwRSxFQUFBLEVBQWlCQSxFQUFBMnNCLEdBQUE1c0IsT0FBeUJDLElBQUEsQ0FDMUMsSUFBQTNDLEVBQUFzdkIsR0FBQTNzQixHQUNBNFosRUFBQXZjLEdBQUEwdUIsR0FBQTF1QixJQU1BLFNBQUE0dkIsR0FBQTVvQixFQUFBNUMsR0FDQSxJQUFBZ1gsRUFBQXBVLEVBQUEyb0IsT0FBQTNvQixFQUFBMm9CLE1BQUF2VSxNQUFBLFFBQ0FqWCxFQUFBNkMsRUFBQTJvQixPQUFBM29CLEVBQUEyb0IsTUFBQXhyQixPQUFBLFNBQWdFQyxFQUFBdVYsUUFBQXZWLEVBQUF1VixXQUErQnlCLEdBQUFoWCxFQUFBdXJCLE1BQUFwcUIsTUFDL0YsSUFBQW1aLEVBQUF0YSxFQUFBc2EsS0FBQXRhLEVBQUFzYSxPQUNBbFUsRUFBQWtVLEVBQUF2YSxJQUNBdWEsRUFBQXZhLElBQUFDLEVBQUF1ckIsTUFBQU0sVUFBQTduQixPQUFBc1csRUFBQXZhLElBRUF1YSxFQUFBdmEsR0FBQUMsRUFBQXVyQixNQUFBTSxTQU1BLElBQUFDLEdBQUEsRUFDQUMsR0FBQSxFQUlBLFNBQUF2cUIsR0FDQXdCLEVBQ0FxTixFQUNBclEsRUFDQXNRLEVBQ0EwYixFQUNBQyxHQVVBLE9BUkFwaUIsTUFBQWMsUUFBQTNLLElBQUF1RyxFQUFBdkcsTUFDQWdzQixFQUFBMWIsRUFDQUEsRUFBQXRRLEVBQ0FBLE9BQUF4RCxHQUVBNkosRUFBQTRsQixLQUNBRCxFQUFBRCxJQUVBRyxHQUFBbHBCLEVBQUFxTixFQUFBclEsRUFBQXNRLEVBQUEwYixHQUdBLFNBQUFFLEdBQ0FscEIsRUFDQXFOLEVBQ0FyUSxFQUNBc1EsRUFDQTBiLEdBRUEsR0FBQTVsQixFQUFBcEcsSUFBQW9HLEVBQUEsRUFBQXFNLFFBTUEsT0FBQWYsS0FNQSxHQUhBdEwsRUFBQXBHLElBQUFvRyxFQUFBcEcsRUFBQXVCLE1BQ0E4TyxFQUFBclEsRUFBQXVCLEtBRUE4TyxFQUVBLE9BQUFxQixLQTJCQSxJQUFBSSxFQUFBbkIsRUFFQS9CLEdBZEEvRSxNQUFBYyxRQUFBMkYsSUFDQSxvQkFBQUEsRUFBQSxLQUVBdFEsUUFDQUEsRUFBQW9oQixhQUF3QjVKLFFBQUFsSCxFQUFBLElBQ3hCQSxFQUFBaFMsT0FBQSxHQUVBMHRCLElBQUFELEdBQ0F6YixFQUFBaUwsR0FBQWpMLEdBQ0cwYixJQUFBRixLQUNIeGIsRUFBQWdMLEdBQUFoTCxJQUdBLGtCQUFBRCxJQUVBTSxFQUFBM04sRUFBQUMsUUFBQUQsRUFBQUMsT0FBQTBOLElBQUFyRixFQUFBYSxnQkFBQWtFLEdBR0F5QixFQUZBeEcsRUFBQVUsY0FBQXFFLEdBRUEsSUFBQUQsR0FDQTlFLEVBQUFjLHFCQUFBaUUsR0FBQXJRLEVBQUFzUSxPQUNBOVQsU0FBQXdHLEdBRUtvRCxFQUFBd0ksRUFBQTRILEdBQUF4VCxFQUFBUyxTQUFBLGFBQUE0TSxJQUVMOGEsR0FBQXZjLEVBQUE1TyxFQUFBZ0QsRUFBQXNOLEVBQUFELEdBS0EsSUFBQUQsR0FDQUMsRUFBQXJRLEVBQUFzUSxPQUNBOVQsU0FBQXdHLElBS0E4TyxFQUFBcVosR0FBQTlhLEVBQUFyUSxFQUFBZ0QsRUFBQXNOLEdBRUEsT0FBQXpHLE1BQUFjLFFBQUFtSCxHQUNBQSxFQUNHMUwsRUFBQTBMLElBQ0gxTCxFQUFBdUssSUFBb0J3YixHQUFBcmEsRUFBQW5CLEdBQ3BCdkssRUFBQXBHLElBQXNCb3NCLEdBQUFwc0IsR0FDdEI4UixHQUVBSixLQUlBLFNBQUF5YSxHQUFBcmEsRUFBQW5CLEVBQUEwYixHQU9BLEdBTkF2YSxFQUFBbkIsS0FDQSxrQkFBQW1CLEVBQUF6QixNQUVBTSxPQUFBblUsRUFDQTZ2QixHQUFBLEdBRUFqbUIsRUFBQTBMLEVBQUF4QixVQUNBLFFBQUEvUixFQUFBLEVBQUErSyxFQUFBd0ksRUFBQXhCLFNBQUFoUyxPQUE4Q0MsRUFBQStLLEVBQU8vSyxJQUFBLENBQ3JELElBQUFrVCxFQUFBSyxFQUFBeEIsU0FBQS9SLEdBQ0E2SCxFQUFBcUwsRUFBQXBCLE9BQ0FuSyxFQUFBdUwsRUFBQWQsS0FBQXRLLEVBQUFnbUIsSUFBQSxRQUFBNWEsRUFBQXBCLE1BQ0E4YixHQUFBMWEsRUFBQWQsRUFBQTBiLElBU0EsU0FBQUQsR0FBQXBzQixHQUNBcUIsRUFBQXJCLEVBQUF1RixRQUNBaVUsR0FBQXhaLEVBQUF1RixPQUVBbEUsRUFBQXJCLEVBQUFzc0IsUUFDQTlTLEdBQUF4WixFQUFBc3NCLE9BTUEsU0FBQUMsR0FBQXZYLEdBQ0FBLEVBQUFnTCxPQUFBLEtBQ0FoTCxFQUFBaVQsYUFBQSxLQUNBLElBQUFybEIsRUFBQW9TLEVBQUF2UixTQUNBdWQsRUFBQWhNLEVBQUEvUixPQUFBTCxFQUFBMGUsYUFDQTRJLEVBQUFsSixLQUFBaGUsUUFDQWdTLEVBQUEyTSxPQUFBbkQsR0FBQTViLEVBQUF1ZSxnQkFBQStJLEdBQ0FsVixFQUFBcU0sYUFBQXJiLEVBS0FnUCxFQUFBK1UsR0FBQSxTQUFBMWdCLEVBQUFlLEVBQUF4QixFQUFBM0UsR0FBaUMsT0FBQXpDLEdBQUF3VCxFQUFBM0wsRUFBQWUsRUFBQXhCLEVBQUEzRSxHQUFBLElBR2pDK1EsRUFBQStSLGVBQUEsU0FBQTFkLEVBQUFlLEVBQUF4QixFQUFBM0UsR0FBNkMsT0FBQXpDLEdBQUF3VCxFQUFBM0wsRUFBQWUsRUFBQXhCLEVBQUEzRSxHQUFBLElBSTdDLElBQUF1b0IsRUFBQXhMLEtBQUFoaEIsS0FXQTJULEdBQUFxQixFQUFBLFNBQUF3WCxLQUFBdlIsT0FBQWpWLEVBQUEsU0FDQTJOLEdBQUFxQixFQUFBLGFBQUFwUyxFQUFBOGEsa0JBQUExWCxFQUFBLFNBSUEsU0FBQXltQixHQUFBdk8sR0FFQXVLLEdBQUF2SyxFQUFBOWhCLFdBRUE4aEIsRUFBQTloQixVQUFBc3dCLFVBQUEsU0FBQTdzQixHQUNBLE9BQUFRLEdBQUFSLEVBQUE1RSxPQUdBaWpCLEVBQUE5aEIsVUFBQXlrQixRQUFBLFdBQ0EsSUFxQkEvTyxFQXJCQWtELEVBQUEvWixLQUNBMHhCLEVBQUEzWCxFQUFBdlIsU0FDQXJCLEVBQUF1cUIsRUFBQXZxQixPQUNBa2YsRUFBQXFMLEVBQUFyTCxhQVVBQSxJQUNBdE0sRUFBQXFNLGFBQUFDLEVBQUF0aEIsS0FBQW9oQixhQUFBcGIsR0FLQWdQLEVBQUEvUixPQUFBcWUsRUFHQSxJQUNBeFAsRUFBQTFQLEVBQUF6RixLQUFBcVksRUFBQW1ULGFBQUFuVCxFQUFBK1IsZ0JBQ0ssTUFBQWxwQixHQUNMaWEsR0FBQWphLEVBQUFtWCxFQUFBLFVBZ0JBbEQsRUFBQWtELEVBQUFnTCxPQWdCQSxPQVpBbE8sYUFBQTFCLEtBUUEwQixFQUFBSixNQUdBSSxFQUFBM08sT0FBQW1lLEVBQ0F4UCxHQU1BLElBQUE4YSxHQUFBLEVBRUEsU0FBQUMsR0FBQTNPLEdBQ0FBLEVBQUE5aEIsVUFBQTB3QixNQUFBLFNBQUFscUIsR0FDQSxJQUFBb1MsRUFBQS9aLEtBRUErWixFQUFBK1gsS0FBQUgsS0FXQTVYLEVBQUF0QixRQUFBLEVBRUE5USxLQUFBK29CLGFBSUFxQixHQUFBaFksRUFBQXBTLEdBRUFvUyxFQUFBdlIsU0FBQXlTLEdBQ0FvVixHQUFBdFcsRUFBQWlZLGFBQ0FycUIsTUFDQW9TLEdBT0FBLEVBQUFtVCxhQUFBblQsRUFHQUEsRUFBQWtZLE1BQUFsWSxFQUNBK0osR0FBQS9KLEdBQ0FzSSxHQUFBdEksR0FDQXVYLEdBQUF2WCxHQUNBNEssR0FBQTVLLEVBQUEsZ0JBQ0FpUixHQUFBalIsR0FDQW9QLEdBQUFwUCxHQUNBK1EsR0FBQS9RLEdBQ0E0SyxHQUFBNUssRUFBQSxXQVNBQSxFQUFBdlIsU0FBQWtkLElBQ0EzTCxFQUFBOFYsT0FBQTlWLEVBQUF2UixTQUFBa2QsS0FLQSxTQUFBcU0sR0FBQWhZLEVBQUFwUyxHQUNBLElBQUF5TCxFQUFBMkcsRUFBQXZSLFNBQUE3RyxPQUFBa0osT0FBQWtQLEVBQUFpWSxZQUFBcnFCLFNBRUFvZSxFQUFBcGUsRUFBQTBlLGFBQ0FqVCxFQUFBbEwsT0FBQVAsRUFBQU8sT0FDQWtMLEVBQUFpVCxhQUFBTixFQUNBM1MsRUFBQThSLFdBQUF2ZCxFQUFBdWQsV0FDQTlSLEVBQUErUixRQUFBeGQsRUFBQXdkLFFBRUEsSUFBQStNLEVBQUFuTSxFQUFBdlEsaUJBQ0FwQyxFQUFBMEksVUFBQW9XLEVBQUFwVyxVQUNBMUksRUFBQXFQLGlCQUFBeVAsRUFBQTFQLFVBQ0FwUCxFQUFBOFMsZ0JBQUFnTSxFQUFBN2MsU0FDQWpDLEVBQUErZSxjQUFBRCxFQUFBOWMsSUFFQXpOLEVBQUFSLFNBQ0FpTSxFQUFBak0sT0FBQVEsRUFBQVIsT0FDQWlNLEVBQUFoTSxnQkFBQU8sRUFBQVAsaUJBSUEsU0FBQWlwQixHQUFBMWMsR0FDQSxJQUFBaE0sRUFBQWdNLEVBQUFoTSxRQUNBLEdBQUFnTSxFQUFBeWUsTUFBQSxDQUNBLElBQUFDLEVBQUFoQyxHQUFBMWMsRUFBQXllLE9BQ0FFLEVBQUEzZSxFQUFBMGUsYUFDQSxHQUFBQSxJQUFBQyxFQUFBLENBR0EzZSxFQUFBMGUsZUFFQSxJQUFBRSxFQUFBQyxHQUFBN2UsR0FFQTRlLEdBQ0ExakIsRUFBQThFLEVBQUE4ZSxjQUFBRixHQUVBNXFCLEVBQUFnTSxFQUFBaE0sUUFBQXNULEdBQUFvWCxFQUFBMWUsRUFBQThlLGVBQ0E5cUIsRUFBQS9GLE9BQ0ErRixFQUFBK3FCLFdBQUEvcUIsRUFBQS9GLE1BQUErUixJQUlBLE9BQUFoTSxFQUdBLFNBQUE2cUIsR0FBQTdlLEdBQ0EsSUFBQWdmLEVBQ0FDLEVBQUFqZixFQUFBaE0sUUFDQWtyQixFQUFBbGYsRUFBQThlLGNBQ0FLLEVBQUFuZixFQUFBb2YsY0FDQSxRQUFBcHlCLEtBQUFpeUIsRUFDQUEsRUFBQWp5QixLQUFBbXlCLEVBQUFueUIsS0FDQWd5QixJQUFzQkEsTUFDdEJBLEVBQUFoeUIsR0FBQXF5QixHQUFBSixFQUFBanlCLEdBQUFreUIsRUFBQWx5QixHQUFBbXlCLEVBQUFueUIsS0FHQSxPQUFBZ3lCLEVBR0EsU0FBQUssR0FBQUosRUFBQUMsRUFBQUMsR0FHQSxHQUFBbGtCLE1BQUFjLFFBQUFrakIsR0FBQSxDQUNBLElBQUEzakIsS0FDQTZqQixFQUFBbGtCLE1BQUFjLFFBQUFvakIsU0FDQUQsRUFBQWprQixNQUFBYyxRQUFBbWpCLFNBQ0EsUUFBQXZ2QixFQUFBLEVBQW1CQSxFQUFBc3ZCLEVBQUF2dkIsT0FBbUJDLEtBRXRDdXZCLEVBQUE1bEIsUUFBQTJsQixFQUFBdHZCLEtBQUEsR0FBQXd2QixFQUFBN2xCLFFBQUEybEIsRUFBQXR2QixJQUFBLElBQ0EyTCxFQUFBL0osS0FBQTB0QixFQUFBdHZCLElBR0EsT0FBQTJMLEVBRUEsT0FBQTJqQixFQUlBLFNBQUEzUCxHQUFBdGIsR0FNQTNILEtBQUE2eEIsTUFBQWxxQixHQVdBLFNBQUFzckIsR0FBQWhRLEdBQ0FBLEVBQUFpUSxJQUFBLFNBQUFDLEdBQ0EsSUFBQUMsRUFBQXB6QixLQUFBcXpCLG9CQUFBcnpCLEtBQUFxekIsc0JBQ0EsR0FBQUQsRUFBQW5tQixRQUFBa21CLElBQUEsRUFDQSxPQUFBbnpCLEtBSUEsSUFBQWdGLEVBQUEwSixFQUFBekosVUFBQSxHQVFBLE9BUEFELEVBQUFzdUIsUUFBQXR6QixNQUNBLG9CQUFBbXpCLEVBQUFJLFFBQ0FKLEVBQUFJLFFBQUFqbEIsTUFBQTZrQixFQUFBbnVCLEdBQ0ssb0JBQUFtdUIsR0FDTEEsRUFBQTdrQixNQUFBLEtBQUF0SixHQUVBb3VCLEVBQUFsdUIsS0FBQWl1QixHQUNBbnpCLE1BTUEsU0FBQXd6QixHQUFBdlEsR0FDQUEsRUFBQXdRLE1BQUEsU0FBQUEsR0FFQSxPQURBenpCLEtBQUEySCxRQUFBc1QsR0FBQWpiLEtBQUEySCxRQUFBOHJCLEdBQ0F6ekIsTUFNQSxTQUFBMHpCLEdBQUF6USxHQU1BQSxFQUFBbU4sSUFBQSxFQUNBLElBQUFBLEVBQUEsRUFLQW5OLEVBQUFwVSxPQUFBLFNBQUE0akIsR0FDQUEsUUFDQSxJQUFBa0IsRUFBQTN6QixLQUNBNHpCLEVBQUFELEVBQUF2RCxJQUNBeUQsRUFBQXBCLEVBQUFxQixRQUFBckIsRUFBQXFCLFVBQ0EsR0FBQUQsRUFBQUQsR0FDQSxPQUFBQyxFQUFBRCxHQUdBLElBQUFoeUIsRUFBQTZ3QixFQUFBN3dCLE1BQUEreEIsRUFBQWhzQixRQUFBL0YsS0FLQSxJQUFBbXlCLEVBQUEsU0FBQXBzQixHQUNBM0gsS0FBQTZ4QixNQUFBbHFCLElBNkNBLE9BM0NBb3NCLEVBQUE1eUIsVUFBQVEsT0FBQWtKLE9BQUE4b0IsRUFBQXh5QixXQUNBNHlCLEVBQUE1eUIsVUFBQTZ3QixZQUFBK0IsRUFDQUEsRUFBQTNELFFBQ0EyRCxFQUFBcHNCLFFBQUFzVCxHQUNBMFksRUFBQWhzQixRQUNBOHFCLEdBRUFzQixFQUFBLFNBQUFKLEVBS0FJLEVBQUFwc0IsUUFBQTJTLE9BQ0EwWixHQUFBRCxHQUVBQSxFQUFBcHNCLFFBQUE2UyxVQUNBeVosR0FBQUYsR0FJQUEsRUFBQWxsQixPQUFBOGtCLEVBQUE5a0IsT0FDQWtsQixFQUFBTixNQUFBRSxFQUFBRixNQUNBTSxFQUFBYixJQUFBUyxFQUFBVCxJQUlBL2lCLEVBQUErRyxRQUFBLFNBQUFrRCxHQUNBMlosRUFBQTNaLEdBQUF1WixFQUFBdlosS0FHQXhZLElBQ0FteUIsRUFBQXBzQixRQUFBK3FCLFdBQUE5d0IsR0FBQW15QixHQU1BQSxFQUFBMUIsYUFBQXNCLEVBQUFoc0IsUUFDQW9zQixFQUFBdEIsZ0JBQ0FzQixFQUFBaEIsY0FBQWxrQixLQUFpQ2tsQixFQUFBcHNCLFNBR2pDa3NCLEVBQUFELEdBQUFHLEVBQ0FBLEdBSUEsU0FBQUMsR0FBQUUsR0FDQSxJQUFBNVosRUFBQTRaLEVBQUF2c0IsUUFBQTJTLE1BQ0EsUUFBQTNaLEtBQUEyWixFQUNBMk8sR0FBQWlMLEVBQUEveUIsVUFBQSxTQUFBUixHQUlBLFNBQUFzekIsR0FBQUMsR0FDQSxJQUFBMVosRUFBQTBaLEVBQUF2c0IsUUFBQTZTLFNBQ0EsUUFBQTdaLEtBQUE2WixFQUNBMFAsR0FBQWdLLEVBQUEveUIsVUFBQVIsRUFBQTZaLEVBQUE3WixJQU1BLFNBQUF3ekIsR0FBQWxSLEdBSUE5UyxFQUFBK0csUUFBQSxTQUFBa0QsR0FDQTZJLEVBQUE3SSxHQUFBLFNBQ0ExVixFQUNBMHZCLEdBRUEsT0FBQUEsR0FPQSxjQUFBaGEsR0FBQTFPLEVBQUEwb0IsS0FDQUEsRUFBQXh5QixLQUFBd3lCLEVBQUF4eUIsTUFBQThDLEVBQ0EwdkIsRUFBQXAwQixLQUFBMkgsUUFBQXdvQixNQUFBdGhCLE9BQUF1bEIsSUFFQSxjQUFBaGEsR0FBQSxvQkFBQWdhLElBQ0FBLEdBQXdCM2xCLEtBQUEybEIsRUFBQXZmLE9BQUF1ZixJQUV4QnAwQixLQUFBMkgsUUFBQXlTLEVBQUEsS0FBQTFWLEdBQUEwdkIsRUFDQUEsR0FkQXAwQixLQUFBMkgsUUFBQXlTLEVBQUEsS0FBQTFWLE1Bc0JBLFNBQUEydkIsR0FBQWpoQixHQUNBLE9BQUFBLE1BQUFPLEtBQUFoTSxRQUFBL0YsTUFBQXdSLEVBQUFnQyxLQUdBLFNBQUFrZixHQUFBQyxFQUFBM3lCLEdBQ0EsT0FBQWdOLE1BQUFjLFFBQUE2a0IsR0FDQUEsRUFBQXRuQixRQUFBckwsSUFBQSxFQUNHLGtCQUFBMnlCLEVBQ0hBLEVBQUFockIsTUFBQSxLQUFBMEQsUUFBQXJMLElBQUEsSUFDRytKLEVBQUE0b0IsSUFDSEEsRUFBQXZpQixLQUFBcFEsR0FNQSxTQUFBNHlCLEdBQUFDLEVBQUF2SixHQUNBLElBQUE3ZCxFQUFBb25CLEVBQUFwbkIsTUFDQTFOLEVBQUE4MEIsRUFBQTkwQixLQUNBb2xCLEVBQUEwUCxFQUFBMVAsT0FDQSxRQUFBcGtCLEtBQUEwTSxFQUFBLENBQ0EsSUFBQXFuQixFQUFBcm5CLEVBQUExTSxHQUNBLEdBQUErekIsRUFBQSxDQUNBLElBQUE5eUIsRUFBQXl5QixHQUFBSyxFQUFBbGYsa0JBQ0E1VCxJQUFBc3BCLEVBQUF0cEIsSUFDQSt5QixHQUFBdG5CLEVBQUExTSxFQUFBaEIsRUFBQW9sQixLQU1BLFNBQUE0UCxHQUNBdG5CLEVBQ0ExTSxFQUNBaEIsRUFDQWkxQixHQUVBLElBQUFDLEVBQUF4bkIsRUFBQTFNLElBQ0FrMEIsR0FBQUQsR0FBQUMsRUFBQXpmLE1BQUF3ZixFQUFBeGYsS0FDQXlmLEVBQUEvZSxrQkFBQXVQLFdBRUFoWSxFQUFBMU0sR0FBQSxLQUNBa00sRUFBQWxOLEVBQUFnQixHQTdNQWl4QixHQUFBM08sSUFDQXVILEdBQUF2SCxJQUNBRCxHQUFBQyxJQUNBdUIsR0FBQXZCLElBQ0F1TyxHQUFBdk8sSUE0TUEsSUFBQTZSLElBQUFsckIsT0FBQW1yQixPQUFBbm1CLE9BRUFvbUIsSUFDQXB6QixLQUFBLGFBQ0FtaUIsVUFBQSxFQUVBekosT0FDQTJhLFFBQUFILEdBQ0FJLFFBQUFKLEdBQ0ExYixLQUFBeFAsT0FBQXVyQixTQUdBQyxRQUFBLFdBQ0FwMUIsS0FBQXFOLE1BQUExTCxPQUFBa0osT0FBQSxNQUNBN0ssS0FBQUwsU0FHQTAxQixVQUFBLFdBQ0EsSUFBQWxTLEVBQUFuakIsS0FFQSxRQUFBVyxLQUFBd2lCLEVBQUE5VixNQUNBc25CLEdBQUF4UixFQUFBOVYsTUFBQTFNLEVBQUF3aUIsRUFBQXhqQixPQUlBMjFCLFFBQUEsV0FDQSxJQUFBblMsRUFBQW5qQixLQUVBQSxLQUFBdXFCLE9BQUEsbUJBQUE5Z0IsR0FDQStxQixHQUFBclIsRUFBQSxTQUFBdmhCLEdBQTBDLE9BQUEweUIsR0FBQTdxQixFQUFBN0gsT0FFMUM1QixLQUFBdXFCLE9BQUEsbUJBQUE5Z0IsR0FDQStxQixHQUFBclIsRUFBQSxTQUFBdmhCLEdBQTBDLE9BQUEweUIsR0FBQTdxQixFQUFBN0gsUUFJMUN1RixPQUFBLFdBQ0EsSUFBQXNjLEVBQUF6akIsS0FBQTBtQixPQUFBbkssUUFDQTFGLEVBQUF1TCxHQUFBcUIsR0FDQWpPLEVBQUFxQixLQUFBckIsaUJBQ0EsR0FBQUEsRUFBQSxDQUVBLElBQUE1VCxFQUFBeXlCLEdBQUE3ZSxHQUNBa2MsRUFBQTF4QixLQUNBaTFCLEVBQUF2RCxFQUFBdUQsUUFDQUMsRUFBQXhELEVBQUF3RCxRQUNBLEdBRUFELEtBQUFyekIsSUFBQTB5QixHQUFBVyxFQUFBcnpCLEtBRUFzekIsR0FBQXR6QixHQUFBMHlCLEdBQUFZLEVBQUF0ekIsR0FFQSxPQUFBaVYsRUFHQSxJQUFBMGUsRUFBQXYxQixLQUNBcU4sRUFBQWtvQixFQUFBbG9CLE1BQ0ExTixFQUFBNDFCLEVBQUE1MUIsS0FDQWdCLEVBQUEsTUFBQWtXLEVBQUFsVyxJQUdBNlUsRUFBQTdCLEtBQUF5YyxLQUFBNWEsRUFBQUosSUFBQSxLQUFBSSxFQUFBLFFBQ0FxQixFQUFBbFcsSUFDQTBNLEVBQUExTSxJQUNBa1csRUFBQWYsa0JBQUF6SSxFQUFBMU0sR0FBQW1WLGtCQUVBakosRUFBQWxOLEVBQUFnQixHQUNBaEIsRUFBQXVGLEtBQUF2RSxLQUVBME0sRUFBQTFNLEdBQUFrVyxFQUNBbFgsRUFBQXVGLEtBQUF2RSxHQUVBWCxLQUFBb1osS0FBQXpaLEVBQUEwRCxPQUFBbXlCLFNBQUF4MUIsS0FBQW9aLE1BQ0F1YixHQUFBdG5CLEVBQUExTixFQUFBLEdBQUFBLEVBQUFLLEtBQUEra0IsU0FJQWxPLEVBQUE5UixLQUFBMHFCLFdBQUEsRUFFQSxPQUFBNVksR0FBQTRNLEtBQUEsS0FJQWdTLElBQ0FULGNBS0EsU0FBQVUsR0FBQXpTLEdBRUEsSUFBQTBTLEdBQ0F0aUIsSUFBQSxXQUErQixPQUFBaEQsSUFRL0IxTyxPQUFBK1AsZUFBQXVSLEVBQUEsU0FBQTBTLEdBS0ExUyxFQUFBMlMsTUFDQTFoQixRQUNBckYsU0FDQW9NLGdCQUNBdkMsbUJBR0F1SyxFQUFBbGQsT0FDQWtkLEVBQUE0UyxPQUFBeGMsR0FDQTRKLEVBQUE3ZCxZQUVBNmQsRUFBQXRiLFFBQUFoRyxPQUFBa0osT0FBQSxNQUNBc0YsRUFBQStHLFFBQUEsU0FBQWtELEdBQ0E2SSxFQUFBdGIsUUFBQXlTLEVBQUEsS0FBQXpZLE9BQUFrSixPQUFBLFFBS0FvWSxFQUFBdGIsUUFBQXdvQixNQUFBbE4sRUFFQXBVLEVBQUFvVSxFQUFBdGIsUUFBQStxQixXQUFBK0MsSUFFQXhDLEdBQUFoUSxHQUNBdVEsR0FBQXZRLEdBQ0F5USxHQUFBelEsR0FDQWtSLEdBQUFsUixHQUdBeVMsR0FBQXpTLElBRUF0aEIsT0FBQStQLGVBQUF1UixHQUFBOWhCLFVBQUEsYUFDQWtTLElBQUFDLEtBR0EzUixPQUFBK1AsZUFBQXVSLEdBQUE5aEIsVUFBQSxlQUNBa1MsSUFBQSxXQUVBLE9BQUFyVCxLQUFBZ0ksUUFBQWhJLEtBQUFnSSxPQUFBQyxjQUtBdEcsT0FBQStQLGVBQUF1UixHQUFBLDJCQUNBL2MsTUFBQXNvQixLQUdBdkwsR0FBQTZTLFFBQUEsU0FNQSxJQUFBOWtCLEdBQUExRSxFQUFBLGVBR0F5cEIsR0FBQXpwQixFQUFBLHlDQUNBOEUsR0FBQSxTQUFBZ0UsRUFBQWdGLEVBQUE0YixHQUNBLE1BQ0EsVUFBQUEsR0FBQUQsR0FBQTNnQixJQUFBLFdBQUFnRixHQUNBLGFBQUE0YixHQUFBLFdBQUE1Z0IsR0FDQSxZQUFBNGdCLEdBQUEsVUFBQTVnQixHQUNBLFVBQUE0Z0IsR0FBQSxVQUFBNWdCLEdBSUE2Z0IsR0FBQTNwQixFQUFBLHdDQUVBNHBCLEdBQUE1cEIsRUFDQSx3WUFRQTZwQixHQUFBLCtCQUVBQyxHQUFBLFNBQUF4MEIsR0FDQSxZQUFBQSxFQUFBa00sT0FBQSxjQUFBbE0sRUFBQW1NLE1BQUEsTUFHQXNvQixHQUFBLFNBQUF6MEIsR0FDQSxPQUFBdzBCLEdBQUF4MEIsS0FBQW1NLE1BQUEsRUFBQW5NLEVBQUF5QixRQUFBLElBR0FpekIsR0FBQSxTQUFBN3NCLEdBQ0EsYUFBQUEsSUFBQSxJQUFBQSxHQUtBLFNBQUE4c0IsR0FBQTFmLEdBQ0EsSUFBQTlSLEVBQUE4UixFQUFBOVIsS0FDQXl4QixFQUFBM2YsRUFDQTRmLEVBQUE1ZixFQUNBLE1BQUExTCxFQUFBc3JCLEVBQUEzZ0IsbUJBQ0EyZ0IsSUFBQTNnQixrQkFBQWlQLE9BQ0EwUixLQUFBMXhCLE9BQ0FBLEVBQUEyeEIsR0FBQUQsRUFBQTF4QixTQUdBLE1BQUFvRyxFQUFBcXJCLElBQUF0dUIsUUFDQXN1QixLQUFBenhCLE9BQ0FBLEVBQUEyeEIsR0FBQTN4QixFQUFBeXhCLEVBQUF6eEIsT0FHQSxPQUFBNHhCLEdBQUE1eEIsRUFBQTZ4QixZQUFBN3hCLEVBQUFzc0IsT0FHQSxTQUFBcUYsR0FBQWxnQixFQUFBdE8sR0FDQSxPQUNBMHVCLFlBQUE3dEIsR0FBQXlOLEVBQUFvZ0IsWUFBQTF1QixFQUFBMHVCLGFBQ0F2RixNQUFBbG1CLEVBQUFxTCxFQUFBNmEsUUFDQTdhLEVBQUE2YSxNQUFBbnBCLEVBQUFtcEIsT0FDQW5wQixFQUFBbXBCLE9BSUEsU0FBQXNGLEdBQ0FDLEVBQ0FDLEdBRUEsT0FBQTFyQixFQUFBeXJCLElBQUF6ckIsRUFBQTByQixHQUNBOXRCLEdBQUE2dEIsRUFBQUUsR0FBQUQsSUFHQSxHQUdBLFNBQUE5dEIsR0FBQXFGLEVBQUFlLEdBQ0EsT0FBQWYsRUFBQWUsRUFBQWYsRUFBQSxJQUFBZSxFQUFBZixFQUFBZSxHQUFBLEdBR0EsU0FBQTJuQixHQUFBNXdCLEdBQ0EsT0FBQTBJLE1BQUFjLFFBQUF4SixHQUNBNndCLEdBQUE3d0IsR0FFQUUsRUFBQUYsR0FDQTh3QixHQUFBOXdCLEdBRUEsa0JBQUFBLEVBQ0FBLEVBR0EsR0FHQSxTQUFBNndCLEdBQUE3d0IsR0FHQSxJQUZBLElBQ0Erd0IsRUFEQWhvQixFQUFBLEdBRUEzTCxFQUFBLEVBQUErSyxFQUFBbkksRUFBQTdDLE9BQW1DQyxFQUFBK0ssRUFBTy9LLElBQzFDNkgsRUFBQThyQixFQUFBSCxHQUFBNXdCLEVBQUE1QyxNQUFBLEtBQUEyekIsSUFDQWhvQixJQUFnQkEsR0FBQSxLQUNoQkEsR0FBQWdvQixHQUdBLE9BQUFob0IsRUFHQSxTQUFBK25CLEdBQUE5d0IsR0FDQSxJQUFBK0ksRUFBQSxHQUNBLFFBQUF0TyxLQUFBdUYsRUFDQUEsRUFBQXZGLEtBQ0FzTyxJQUFnQkEsR0FBQSxLQUNoQkEsR0FBQXRPLEdBR0EsT0FBQXNPLEVBS0EsSUFBQWlvQixJQUNBQyxJQUFBLDZCQUNBQyxLQUFBLHNDQUdBQyxHQUFBL3FCLEVBQ0Esc25CQWVBZ3JCLEdBQUFockIsRUFDQSxrTkFHQSxHQUtBeUUsR0FBQSxTQUFBcUUsR0FDQSxPQUFBaWlCLEdBQUFqaUIsSUFBQWtpQixHQUFBbGlCLElBR0EsU0FBQWxFLEdBQUFrRSxHQUNBLE9BQUFraUIsR0FBQWxpQixHQUNBLE1BSUEsU0FBQUEsRUFDQSxZQURBLEVBS0EsSUFBQW1pQixHQUFBNTFCLE9BQUFrSixPQUFBLE1BQ0EsU0FBQW9HLEdBQUFtRSxHQUVBLElBQUFoRCxFQUNBLFNBRUEsR0FBQXJCLEdBQUFxRSxHQUNBLFNBSUEsR0FGQUEsSUFBQXpJLGNBRUEsTUFBQTRxQixHQUFBbmlCLEdBQ0EsT0FBQW1pQixHQUFBbmlCLEdBRUEsSUFBQXNRLEVBQUFyZixTQUFBRSxjQUFBNk8sR0FDQSxPQUFBQSxFQUFBbkksUUFBQSxRQUVBc3FCLEdBQUFuaUIsR0FDQXNRLEVBQUFzTSxjQUFBM2YsT0FBQW1sQixvQkFDQTlSLEVBQUFzTSxjQUFBM2YsT0FBQW9sQixZQUdBRixHQUFBbmlCLEdBQUEscUJBQUFwRCxLQUFBMFQsRUFBQWphLFlBSUEsSUFBQWlzQixHQUFBcHJCLEVBQUEsNkNBT0EsU0FBQXFyQixHQUFBalMsR0FDQSxxQkFBQUEsRUFBQSxDQUNBLElBQUFrUyxFQUFBdnhCLFNBQUF3eEIsY0FBQW5TLEdBQ0EsT0FBQWtTLEdBSUF2eEIsU0FBQUUsY0FBQSxPQUlBLE9BQUFtZixFQU1BLFNBQUFvUyxHQUFBQyxFQUFBbGhCLEdBQ0EsSUFBQXRCLEVBQUFsUCxTQUFBRSxjQUFBd3hCLEdBQ0EsaUJBQUFBLEVBQ0F4aUIsR0FHQXNCLEVBQUE5UixNQUFBOFIsRUFBQTlSLEtBQUFpYixZQUFBemUsSUFBQXNWLEVBQUE5UixLQUFBaWIsTUFBQWdZLFVBQ0F6aUIsRUFBQTBpQixhQUFBLHVCQUVBMWlCLEdBR0EsU0FBQTJpQixHQUFBQyxFQUFBSixHQUNBLE9BQUExeEIsU0FBQTZ4QixnQkFBQWhCLEdBQUFpQixHQUFBSixHQUdBLFNBQUFLLEdBQUE5aUIsR0FDQSxPQUFBalAsU0FBQSt4QixlQUFBOWlCLEdBR0EsU0FBQStpQixHQUFBL2lCLEdBQ0EsT0FBQWpQLFNBQUFneUIsY0FBQS9pQixHQUdBLFNBQUFnakIsR0FBQTlCLEVBQUErQixFQUFBQyxHQUNBaEMsRUFBQThCLGFBQUFDLEVBQUFDLEdBR0EsU0FBQTN5QixHQUFBNlEsRUFBQUYsR0FDQUUsRUFBQTdRLFlBQUEyUSxHQUdBLFNBQUE1USxHQUFBOFEsRUFBQUYsR0FDQUUsRUFBQTlRLFlBQUE0USxHQUdBLFNBQUFnZ0IsR0FBQTlmLEdBQ0EsT0FBQUEsRUFBQThmLFdBR0EsU0FBQWlDLEdBQUEvaEIsR0FDQSxPQUFBQSxFQUFBK2hCLFlBR0EsU0FBQVYsR0FBQXJoQixHQUNBLE9BQUFBLEVBQUFxaEIsUUFHQSxTQUFBVyxHQUFBaGlCLEVBQUFwQixHQUNBb0IsRUFBQWlpQixZQUFBcmpCLEVBR0EsU0FBQXNqQixHQUFBbGlCLEVBQUFuUCxHQUNBbVAsRUFBQXVoQixhQUFBMXdCLEVBQUEsSUFJQSxJQUFBc3hCLEdBQUFsM0IsT0FBQXFKLFFBQ0F6RSxjQUFBdXhCLEdBQ0FJLG1CQUNBRSxrQkFDQUMsaUJBQ0FDLGdCQUNBenlCLGVBQ0FELGVBQ0E0d0IsY0FDQWlDLGVBQ0FWLFdBQ0FXLGtCQUNBRSxtQkFLQWxILElBQ0E3bUIsT0FBQSxTQUFBNkMsRUFBQW1KLEdBQ0FpaUIsR0FBQWppQixJQUVBaEMsT0FBQSxTQUFBaWIsRUFBQWpaLEdBQ0FpWixFQUFBL3FCLEtBQUEyc0IsTUFBQTdhLEVBQUE5UixLQUFBMnNCLE1BQ0FvSCxHQUFBaEosR0FBQSxHQUNBZ0osR0FBQWppQixLQUdBbVosUUFBQSxTQUFBblosR0FDQWlpQixHQUFBamlCLEdBQUEsS0FJQSxTQUFBaWlCLEdBQUFqaUIsRUFBQWtpQixHQUNBLElBQUFwNEIsRUFBQWtXLEVBQUE5UixLQUFBMnNCLElBQ0EsR0FBQXZtQixFQUFBeEssR0FBQSxDQUVBLElBQUFvWixFQUFBbEQsRUFBQTlPLFFBQ0EycEIsRUFBQTdhLEVBQUFmLG1CQUFBZSxFQUFBdEIsSUFDQXlqQixFQUFBamYsRUFBQWtLLE1BQ0E4VSxFQUNBbnFCLE1BQUFjLFFBQUFzcEIsRUFBQXI0QixJQUNBa00sRUFBQW1zQixFQUFBcjRCLEdBQUErd0IsR0FDS3NILEVBQUFyNEIsS0FBQSt3QixJQUNMc0gsRUFBQXI0QixRQUFBWSxHQUdBc1YsRUFBQTlSLEtBQUFrMEIsU0FDQXJxQixNQUFBYyxRQUFBc3BCLEVBQUFyNEIsSUFFT3E0QixFQUFBcjRCLEdBQUFzTSxRQUFBeWtCLEdBQUEsR0FFUHNILEVBQUFyNEIsR0FBQXVFLEtBQUF3c0IsR0FIQXNILEVBQUFyNEIsSUFBQSt3QixHQU1Bc0gsRUFBQXI0QixHQUFBK3dCLEdBaUJBLElBQUF3SCxHQUFBLElBQUEvakIsR0FBQSxVQUVBK0gsSUFBQSxpREFFQSxTQUFBaWMsR0FBQS9xQixFQUFBZSxHQUNBLE9BQ0FmLEVBQUF6TixNQUFBd08sRUFBQXhPLE1BRUF5TixFQUFBZ0gsTUFBQWpHLEVBQUFpRyxLQUNBaEgsRUFBQThILFlBQUEvRyxFQUFBK0csV0FDQS9LLEVBQUFpRCxFQUFBckosUUFBQW9HLEVBQUFnRSxFQUFBcEssT0FDQXEwQixHQUFBaHJCLEVBQUFlLElBRUEvRCxFQUFBZ0QsRUFBQWtJLHFCQUNBbEksRUFBQXFILGVBQUF0RyxFQUFBc0csY0FDQXhLLEVBQUFrRSxFQUFBc0csYUFBQStILFFBTUEsU0FBQTRiLEdBQUFockIsRUFBQWUsR0FDQSxhQUFBZixFQUFBZ0gsSUFBMEIsU0FDMUIsSUFBQTlSLEVBQ0ErMUIsRUFBQWx1QixFQUFBN0gsRUFBQThLLEVBQUFySixPQUFBb0csRUFBQTdILElBQUEwYyxRQUFBMWMsRUFBQThXLEtBQ0FrZixFQUFBbnVCLEVBQUE3SCxFQUFBNkwsRUFBQXBLLE9BQUFvRyxFQUFBN0gsSUFBQTBjLFFBQUExYyxFQUFBOFcsS0FDQSxPQUFBaWYsSUFBQUMsR0FBQTVCLEdBQUEyQixJQUFBM0IsR0FBQTRCLEdBR0EsU0FBQUMsR0FBQWxrQixFQUFBbWtCLEVBQUFDLEdBQ0EsSUFBQW4yQixFQUFBM0MsRUFDQThMLEtBQ0EsSUFBQW5KLEVBQUFrMkIsRUFBb0JsMkIsR0FBQW0yQixJQUFhbjJCLEVBQ2pDM0MsRUFBQTBVLEVBQUEvUixHQUFBM0MsSUFDQXdLLEVBQUF4SyxLQUFxQjhMLEVBQUE5TCxHQUFBMkMsR0FFckIsT0FBQW1KLEVBR0EsU0FBQWl0QixHQUFBQyxHQUNBLElBQUFyMkIsRUFBQTBqQixFQUNBNUQsS0FFQXdXLEVBQUFELEVBQUFDLFFBQ0FmLEVBQUFjLEVBQUFkLFFBRUEsSUFBQXYxQixFQUFBLEVBQWFBLEVBQUE0WixHQUFBN1osU0FBa0JDLEVBRS9CLElBREE4ZixFQUFBbEcsR0FBQTVaLE9BQ0EwakIsRUFBQSxFQUFlQSxFQUFBNFMsRUFBQXYyQixTQUFvQjJqQixFQUNuQzdiLEVBQUF5dUIsRUFBQTVTLEdBQUE5SixHQUFBNVosTUFDQThmLEVBQUFsRyxHQUFBNVosSUFBQTRCLEtBQUEwMEIsRUFBQTVTLEdBQUE5SixHQUFBNVosS0FLQSxTQUFBdTJCLEVBQUF0a0IsR0FDQSxXQUFBSixHQUFBMGpCLEVBQUFkLFFBQUF4aUIsR0FBQTVJLHlCQUEyRHBMLEVBQUFnVSxHQUczRCxTQUFBdWtCLEVBQUFDLEVBQUF2WCxHQUNBLFNBQUEzVixJQUNBLE1BQUFBLEVBQUEyVixXQUNBd1gsRUFBQUQsR0FJQSxPQURBbHRCLEVBQUEyVixZQUNBM1YsRUFHQSxTQUFBbXRCLEVBQUF0VSxHQUNBLElBQUF4ZCxFQUFBMndCLEVBQUFyQyxXQUFBOVEsR0FFQXZhLEVBQUFqRCxJQUNBMndCLEVBQUFoekIsWUFBQXFDLEVBQUF3ZCxHQXNCQSxTQUFBdVUsRUFDQXBqQixFQUNBcWpCLEVBQ0EzSyxFQUNBQyxFQUNBMkssRUFDQUMsRUFDQXB0QixHQVlBLEdBVkE3QixFQUFBMEwsRUFBQXRCLE1BQUFwSyxFQUFBaXZCLEtBTUF2akIsRUFBQXVqQixFQUFBcHRCLEdBQUE0SixHQUFBQyxJQUdBQSxFQUFBWixjQUFBa2tCLEdBQ0FqSyxFQUFBclosRUFBQXFqQixFQUFBM0ssRUFBQUMsR0FBQSxDQUlBLElBQUF6cUIsRUFBQThSLEVBQUE5UixLQUNBc1EsRUFBQXdCLEVBQUF4QixTQUNBRCxFQUFBeUIsRUFBQXpCLElBQ0FqSyxFQUFBaUssSUFlQXlCLEVBQUF0QixJQUFBc0IsRUFBQW5CLEdBQ0FtakIsRUFBQVgsZ0JBQUFyaEIsRUFBQW5CLEdBQUFOLEdBQ0F5akIsRUFBQXR5QixjQUFBNk8sRUFBQXlCLEdBQ0F3akIsRUFBQXhqQixHQUlBeWpCLEVBQUF6akIsRUFBQXhCLEVBQUE2a0IsR0FDQS91QixFQUFBcEcsSUFDQXcxQixFQUFBMWpCLEVBQUFxakIsR0FFQW5LLEVBQUFSLEVBQUExWSxFQUFBdEIsSUFBQWlhLElBTUtwa0IsRUFBQXlMLEVBQUFYLFlBQ0xXLEVBQUF0QixJQUFBc2pCLEVBQUFSLGNBQUF4aEIsRUFBQXZCLE1BQ0F5YSxFQUFBUixFQUFBMVksRUFBQXRCLElBQUFpYSxLQUVBM1ksRUFBQXRCLElBQUFzakIsRUFBQVQsZUFBQXZoQixFQUFBdkIsTUFDQXlhLEVBQUFSLEVBQUExWSxFQUFBdEIsSUFBQWlhLEtBSUEsU0FBQVUsRUFBQXJaLEVBQUFxakIsRUFBQTNLLEVBQUFDLEdBQ0EsSUFBQWxzQixFQUFBdVQsRUFBQTlSLEtBQ0EsR0FBQW9HLEVBQUE3SCxHQUFBLENBQ0EsSUFBQWszQixFQUFBcnZCLEVBQUEwTCxFQUFBZixvQkFBQXhTLEVBQUFtc0IsVUFRQSxHQVBBdGtCLEVBQUE3SCxJQUFBb0UsT0FBQXlELEVBQUE3SCxJQUFBZ3NCLE9BQ0Foc0IsRUFBQXVULEdBQUEsRUFBQTBZLEVBQUFDLEdBTUFya0IsRUFBQTBMLEVBQUFmLG1CQUtBLE9BSkEya0IsRUFBQTVqQixFQUFBcWpCLEdBQ0E5dUIsRUFBQW92QixJQUNBRSxFQUFBN2pCLEVBQUFxakIsRUFBQTNLLEVBQUFDLElBRUEsR0FLQSxTQUFBaUwsRUFBQTVqQixFQUFBcWpCLEdBQ0EvdUIsRUFBQTBMLEVBQUE5UixLQUFBNDFCLGlCQUNBVCxFQUFBaDFCLEtBQUFvSixNQUFBNHJCLEVBQUFyakIsRUFBQTlSLEtBQUE0MUIsZUFDQTlqQixFQUFBOVIsS0FBQTQxQixjQUFBLE1BRUE5akIsRUFBQXRCLElBQUFzQixFQUFBZixrQkFBQStPLElBQ0ErVixFQUFBL2pCLElBQ0EwakIsRUFBQTFqQixFQUFBcWpCLEdBQ0FHLEVBQUF4akIsS0FJQWlpQixHQUFBamlCLEdBRUFxakIsRUFBQWgxQixLQUFBMlIsSUFJQSxTQUFBNmpCLEVBQUE3akIsRUFBQXFqQixFQUFBM0ssRUFBQUMsR0FDQSxJQUFBbHNCLEVBS0F1M0IsRUFBQWhrQixFQUNBLE1BQUFna0IsRUFBQS9rQixrQkFFQSxHQURBK2tCLElBQUEva0Isa0JBQUFpUCxPQUNBNVosRUFBQTdILEVBQUF1M0IsRUFBQTkxQixPQUFBb0csRUFBQTdILElBQUF3M0IsWUFBQSxDQUNBLElBQUF4M0IsRUFBQSxFQUFtQkEsRUFBQThmLEVBQUEyWCxTQUFBMTNCLFNBQXlCQyxFQUM1QzhmLEVBQUEyWCxTQUFBejNCLEdBQUE0MUIsR0FBQTJCLEdBRUFYLEVBQUFoMUIsS0FBQTIxQixHQUNBLE1BS0E5SyxFQUFBUixFQUFBMVksRUFBQXRCLElBQUFpYSxHQUdBLFNBQUFPLEVBQUE3bkIsRUFBQXFOLEVBQUF5bEIsR0FDQTd2QixFQUFBakQsS0FDQWlELEVBQUE2dkIsR0FDQUEsRUFBQXhFLGFBQUF0dUIsR0FDQTJ3QixFQUFBUCxhQUFBcHdCLEVBQUFxTixFQUFBeWxCLEdBR0FuQyxFQUFBanpCLFlBQUFzQyxFQUFBcU4sSUFLQSxTQUFBK2tCLEVBQUF6akIsRUFBQXhCLEVBQUE2a0IsR0FDQSxHQUFBdHJCLE1BQUFjLFFBQUEyRixHQUFBLENBQ0EsRUFHQSxRQUFBL1IsRUFBQSxFQUFxQkEsRUFBQStSLEVBQUFoUyxTQUFxQkMsRUFDMUMyMkIsRUFBQTVrQixFQUFBL1IsR0FBQTQyQixFQUFBcmpCLEVBQUF0QixJQUFBLFFBQUFGLEVBQUEvUixRQUVLZ0ksRUFBQXVMLEVBQUF2QixPQUNMdWpCLEVBQUFqekIsWUFBQWlSLEVBQUF0QixJQUFBc2pCLEVBQUFULGVBQUF4dUIsT0FBQWlOLEVBQUF2QixRQUlBLFNBQUFzbEIsRUFBQS9qQixHQUNBLE1BQUFBLEVBQUFmLGtCQUNBZSxJQUFBZixrQkFBQWlQLE9BRUEsT0FBQTVaLEVBQUEwTCxFQUFBekIsS0FHQSxTQUFBbWxCLEVBQUExakIsRUFBQXFqQixHQUNBLFFBQUE3VyxFQUFBLEVBQXFCQSxFQUFBRCxFQUFBdlksT0FBQXhILFNBQXlCZ2dCLEVBQzlDRCxFQUFBdlksT0FBQXdZLEdBQUE2VixHQUFBcmlCLEdBRUF2VCxFQUFBdVQsRUFBQTlSLEtBQUEyQyxLQUNBeUQsRUFBQTdILEtBQ0E2SCxFQUFBN0gsRUFBQXVILFNBQTRCdkgsRUFBQXVILE9BQUFxdUIsR0FBQXJpQixHQUM1QjFMLEVBQUE3SCxFQUFBeXNCLFNBQTRCbUssRUFBQWgxQixLQUFBMlIsSUFPNUIsU0FBQXdqQixFQUFBeGpCLEdBQ0EsSUFBQXZULEVBQ0EsR0FBQTZILEVBQUE3SCxFQUFBdVQsRUFBQWhCLFdBQ0FnakIsRUFBQUQsY0FBQS9oQixFQUFBdEIsSUFBQWpTLE9BQ0ssQ0FDTCxJQUFBMjNCLEVBQUFwa0IsRUFDQSxNQUFBb2tCLEVBQ0E5dkIsRUFBQTdILEVBQUEyM0IsRUFBQWx6QixVQUFBb0QsRUFBQTdILElBQUFrRixTQUFBVixXQUNBK3dCLEVBQUFELGNBQUEvaEIsRUFBQXRCLElBQUFqUyxHQUVBMjNCLElBQUEveUIsT0FJQWlELEVBQUE3SCxFQUFBdWdCLEtBQ0F2Z0IsSUFBQXVULEVBQUE5TyxTQUNBekUsSUFBQXVULEVBQUFsQixXQUNBeEssRUFBQTdILElBQUFrRixTQUFBVixXQUVBK3dCLEVBQUFELGNBQUEvaEIsRUFBQXRCLElBQUFqUyxHQUlBLFNBQUE0M0IsRUFBQTNMLEVBQUFDLEVBQUFMLEVBQUFnTSxFQUFBMUIsRUFBQVMsR0FDQSxLQUFVaUIsR0FBQTFCLElBQW9CMEIsRUFDOUJsQixFQUFBOUssRUFBQWdNLEdBQUFqQixFQUFBM0ssRUFBQUMsR0FBQSxFQUFBTCxFQUFBZ00sR0FJQSxTQUFBQyxFQUFBdmtCLEdBQ0EsSUFBQXZULEVBQUEwakIsRUFDQWppQixFQUFBOFIsRUFBQTlSLEtBQ0EsR0FBQW9HLEVBQUFwRyxHQUVBLElBREFvRyxFQUFBN0gsRUFBQXlCLEVBQUEyQyxPQUFBeUQsRUFBQTdILElBQUEwc0IsVUFBeUQxc0IsRUFBQXVULEdBQ3pEdlQsRUFBQSxFQUFpQkEsRUFBQThmLEVBQUE0TSxRQUFBM3NCLFNBQXdCQyxFQUFPOGYsRUFBQTRNLFFBQUExc0IsR0FBQXVULEdBRWhELEdBQUExTCxFQUFBN0gsRUFBQXVULEVBQUF4QixVQUNBLElBQUEyUixFQUFBLEVBQWlCQSxFQUFBblEsRUFBQXhCLFNBQUFoUyxTQUEyQjJqQixFQUM1Q29VLEVBQUF2a0IsRUFBQXhCLFNBQUEyUixJQUtBLFNBQUFxVSxFQUFBOUwsRUFBQUosRUFBQWdNLEVBQUExQixHQUNBLEtBQVUwQixHQUFBMUIsSUFBb0IwQixFQUFBLENBQzlCLElBQUFHLEVBQUFuTSxFQUFBZ00sR0FDQWh3QixFQUFBbXdCLEtBQ0Fud0IsRUFBQW13QixFQUFBbG1CLE1BQ0FtbUIsRUFBQUQsR0FDQUYsRUFBQUUsSUFFQXRCLEVBQUFzQixFQUFBL2xCLE9BTUEsU0FBQWdtQixFQUFBMWtCLEVBQUEya0IsR0FDQSxHQUFBcndCLEVBQUFxd0IsSUFBQXJ3QixFQUFBMEwsRUFBQTlSLE1BQUEsQ0FDQSxJQUFBekIsRUFDQWtmLEVBQUFZLEVBQUF2VyxPQUFBeEosT0FBQSxFQWFBLElBWkE4SCxFQUFBcXdCLEdBR0FBLEVBQUFoWixhQUdBZ1osRUFBQTFCLEVBQUFqakIsRUFBQXRCLElBQUFpTixHQUdBclgsRUFBQTdILEVBQUF1VCxFQUFBZixvQkFBQTNLLEVBQUE3SCxJQUFBeWhCLFNBQUE1WixFQUFBN0gsRUFBQXlCLE9BQ0F3MkIsRUFBQWo0QixFQUFBazRCLEdBRUFsNEIsRUFBQSxFQUFpQkEsRUFBQThmLEVBQUF2VyxPQUFBeEosU0FBdUJDLEVBQ3hDOGYsRUFBQXZXLE9BQUF2SixHQUFBdVQsRUFBQTJrQixHQUVBcndCLEVBQUE3SCxFQUFBdVQsRUFBQTlSLEtBQUEyQyxPQUFBeUQsRUFBQTdILElBQUF1SixRQUNBdkosRUFBQXVULEVBQUEya0IsR0FFQUEsU0FHQXhCLEVBQUFuakIsRUFBQXRCLEtBSUEsU0FBQWttQixFQUFBbE0sRUFBQW1NLEVBQUFDLEVBQUF6QixFQUFBMEIsR0FDQSxJQVFBQyxFQUFBQyxFQUFBQyxFQUFBdk0sRUFSQXdNLEVBQUEsRUFDQUMsRUFBQSxFQUNBQyxFQUFBUixFQUFBcjRCLE9BQUEsRUFDQTg0QixFQUFBVCxFQUFBLEdBQ0FVLEVBQUFWLEVBQUFRLEdBQ0FHLEVBQUFWLEVBQUF0NEIsT0FBQSxFQUNBaTVCLEVBQUFYLEVBQUEsR0FDQVksRUFBQVosRUFBQVUsR0FNQUcsR0FBQVosRUFNQSxNQUFBSSxHQUFBRSxHQUFBRCxHQUFBSSxFQUNBcHhCLEVBQUFreEIsR0FDQUEsRUFBQVQsSUFBQU0sR0FDTy93QixFQUFBbXhCLEdBQ1BBLEVBQUFWLElBQUFRLEdBQ08vQyxHQUFBZ0QsRUFBQUcsSUFDUEcsRUFBQU4sRUFBQUcsRUFBQXBDLEdBQ0FpQyxFQUFBVCxJQUFBTSxHQUNBTSxFQUFBWCxJQUFBTSxJQUNPOUMsR0FBQWlELEVBQUFHLElBQ1BFLEVBQUFMLEVBQUFHLEVBQUFyQyxHQUNBa0MsRUFBQVYsSUFBQVEsR0FDQUssRUFBQVosSUFBQVUsSUFDT2xELEdBQUFnRCxFQUFBSSxJQUNQRSxFQUFBTixFQUFBSSxFQUFBckMsR0FDQXNDLEdBQUEzRCxFQUFBUCxhQUFBL0ksRUFBQTRNLEVBQUE1bUIsSUFBQXNqQixFQUFBSixZQUFBMkQsRUFBQTdtQixNQUNBNG1CLEVBQUFULElBQUFNLEdBQ0FPLEVBQUFaLElBQUFVLElBQ09sRCxHQUFBaUQsRUFBQUUsSUFDUEcsRUFBQUwsRUFBQUUsRUFBQXBDLEdBQ0FzQyxHQUFBM0QsRUFBQVAsYUFBQS9JLEVBQUE2TSxFQUFBN21CLElBQUE0bUIsRUFBQTVtQixLQUNBNm1CLEVBQUFWLElBQUFRLEdBQ0FJLEVBQUFYLElBQUFNLEtBRUFoeEIsRUFBQTR3QixLQUFtQ0EsRUFBQXRDLEdBQUFtQyxFQUFBTSxFQUFBRSxJQUNuQ0osRUFBQTN3QixFQUFBbXhCLEVBQUEzN0IsS0FDQWs3QixFQUFBUyxFQUFBMzdCLEtBQ0ErN0IsRUFBQUosRUFBQVosRUFBQU0sRUFBQUUsR0FDQWp4QixFQUFBNndCLEdBQ0E3QixFQUFBcUMsRUFBQXBDLEVBQUEzSyxFQUFBNE0sRUFBQTVtQixLQUFBLEVBQUFvbUIsRUFBQU0sSUFFQUYsRUFBQUwsRUFBQUksR0FDQTNDLEdBQUE0QyxFQUFBTyxJQUNBRyxFQUFBVixFQUFBTyxFQUFBcEMsR0FDQXdCLEVBQUFJLFFBQUF2NkIsRUFDQWk3QixHQUFBM0QsRUFBQVAsYUFBQS9JLEVBQUF3TSxFQUFBeG1CLElBQUE0bUIsRUFBQTVtQixNQUdBMGtCLEVBQUFxQyxFQUFBcEMsRUFBQTNLLEVBQUE0TSxFQUFBNW1CLEtBQUEsRUFBQW9tQixFQUFBTSxJQUdBSyxFQUFBWCxJQUFBTSxJQUdBRCxFQUFBRSxHQUNBMU0sRUFBQXZrQixFQUFBMHdCLEVBQUFVLEVBQUEsU0FBQVYsRUFBQVUsRUFBQSxHQUFBOW1CLElBQ0EybEIsRUFBQTNMLEVBQUFDLEVBQUFtTSxFQUFBTSxFQUFBSSxFQUFBbkMsSUFDSytCLEVBQUFJLEdBQ0xoQixFQUFBOUwsRUFBQW1NLEVBQUFNLEVBQUFFLEdBc0JBLFNBQUFRLEVBQUFobUIsRUFBQWdsQixFQUFBL3NCLEVBQUFndUIsR0FDQSxRQUFBcjVCLEVBQUFxTCxFQUF1QnJMLEVBQUFxNUIsRUFBU3I1QixJQUFBLENBQ2hDLElBQUFxSyxFQUFBK3RCLEVBQUFwNEIsR0FDQSxHQUFBNkgsRUFBQXdDLElBQUF3ckIsR0FBQXppQixFQUFBL0ksR0FBMkMsT0FBQXJLLEdBSTNDLFNBQUFtNUIsRUFBQTNNLEVBQUFqWixFQUFBcWpCLEVBQUEwQixHQUNBLEdBQUE5TCxJQUFBalosRUFBQSxDQUlBLElBQUF0QixFQUFBc0IsRUFBQXRCLElBQUF1YSxFQUFBdmEsSUFFQSxHQUFBbkssRUFBQTBrQixFQUFBeFosb0JBQ0FuTCxFQUFBMEwsRUFBQXBCLGFBQUErTCxVQUNBb2IsRUFBQTlNLEVBQUF2YSxJQUFBc0IsRUFBQXFqQixHQUVBcmpCLEVBQUFQLG9CQUFBLE9BU0EsR0FBQWxMLEVBQUF5TCxFQUFBYixXQUNBNUssRUFBQTBrQixFQUFBOVosV0FDQWEsRUFBQWxXLE1BQUFtdkIsRUFBQW52QixNQUNBeUssRUFBQXlMLEVBQUFWLFdBQUEvSyxFQUFBeUwsRUFBQVQsU0FFQVMsRUFBQWYsa0JBQUFnYSxFQUFBaGEsc0JBTEEsQ0FTQSxJQUFBeFMsRUFDQXlCLEVBQUE4UixFQUFBOVIsS0FDQW9HLEVBQUFwRyxJQUFBb0csRUFBQTdILEVBQUF5QixFQUFBMkMsT0FBQXlELEVBQUE3SCxJQUFBcXNCLFdBQ0Fyc0IsRUFBQXdzQixFQUFBalosR0FHQSxJQUFBNmtCLEVBQUE1TCxFQUFBemEsU0FDQWltQixFQUFBemtCLEVBQUF4QixTQUNBLEdBQUFsSyxFQUFBcEcsSUFBQTYxQixFQUFBL2pCLEdBQUEsQ0FDQSxJQUFBdlQsRUFBQSxFQUFpQkEsRUFBQThmLEVBQUF2TyxPQUFBeFIsU0FBdUJDLEVBQU84ZixFQUFBdk8sT0FBQXZSLEdBQUF3c0IsRUFBQWpaLEdBQy9DMUwsRUFBQTdILEVBQUF5QixFQUFBMkMsT0FBQXlELEVBQUE3SCxJQUFBdVIsU0FBd0R2UixFQUFBd3NCLEVBQUFqWixHQUV4RDVMLEVBQUE0TCxFQUFBdkIsTUFDQW5LLEVBQUF1d0IsSUFBQXZ3QixFQUFBbXdCLEdBQ0FJLElBQUFKLEdBQTJCRyxFQUFBbG1CLEVBQUFtbUIsRUFBQUosRUFBQXBCLEVBQUEwQixHQUNwQnp3QixFQUFBbXdCLElBQ1Bud0IsRUFBQTJrQixFQUFBeGEsT0FBbUN1akIsRUFBQUgsZUFBQW5qQixFQUFBLElBQ25DMmxCLEVBQUEzbEIsRUFBQSxLQUFBK2xCLEVBQUEsRUFBQUEsRUFBQWo0QixPQUFBLEVBQUE2MkIsSUFDTy91QixFQUFBdXdCLEdBQ1BMLEVBQUE5bEIsRUFBQW1tQixFQUFBLEVBQUFBLEVBQUFyNEIsT0FBQSxHQUNPOEgsRUFBQTJrQixFQUFBeGEsT0FDUHVqQixFQUFBSCxlQUFBbmpCLEVBQUEsSUFFS3VhLEVBQUF4YSxPQUFBdUIsRUFBQXZCLE1BQ0x1akIsRUFBQUgsZUFBQW5qQixFQUFBc0IsRUFBQXZCLE1BRUFuSyxFQUFBcEcsSUFDQW9HLEVBQUE3SCxFQUFBeUIsRUFBQTJDLE9BQUF5RCxFQUFBN0gsSUFBQXU1QixZQUEyRHY1QixFQUFBd3NCLEVBQUFqWixLQUkzRCxTQUFBaW1CLEVBQUFqbUIsRUFBQXRTLEVBQUF3NEIsR0FHQSxHQUFBM3hCLEVBQUEyeEIsSUFBQTV4QixFQUFBMEwsRUFBQTNPLFFBQ0EyTyxFQUFBM08sT0FBQW5ELEtBQUE0MUIsY0FBQXAyQixPQUVBLFFBQUFqQixFQUFBLEVBQXFCQSxFQUFBaUIsRUFBQWxCLFNBQWtCQyxFQUN2Q2lCLEVBQUFqQixHQUFBeUIsS0FBQTJDLEtBQUFxb0IsT0FBQXhyQixFQUFBakIsSUFLQSxJQUtBMDVCLEVBQUExd0IsRUFBQSwyQ0FHQSxTQUFBc3dCLEVBQUFybkIsRUFBQXNCLEVBQUFxakIsRUFBQStDLEdBQ0EsSUFBQTM1QixFQUNBOFIsRUFBQXlCLEVBQUF6QixJQUNBclEsRUFBQThSLEVBQUE5UixLQUNBc1EsRUFBQXdCLEVBQUF4QixTQUlBLEdBSEE0bkIsS0FBQWw0QixLQUFBbTRCLElBQ0FybUIsRUFBQXRCLE1BRUFuSyxFQUFBeUwsRUFBQVgsWUFBQS9LLEVBQUEwTCxFQUFBcEIsY0FFQSxPQURBb0IsRUFBQVAsb0JBQUEsR0FDQSxFQVFBLEdBQUFuTCxFQUFBcEcsS0FDQW9HLEVBQUE3SCxFQUFBeUIsRUFBQTJDLE9BQUF5RCxFQUFBN0gsSUFBQWdzQixPQUFzRGhzQixFQUFBdVQsR0FBQSxHQUN0RDFMLEVBQUE3SCxFQUFBdVQsRUFBQWYsb0JBR0EsT0FEQTJrQixFQUFBNWpCLEVBQUFxakIsSUFDQSxFQUdBLEdBQUEvdUIsRUFBQWlLLEdBQUEsQ0FDQSxHQUFBakssRUFBQWtLLEdBRUEsR0FBQUUsRUFBQTRuQixnQkFJQSxHQUFBaHlCLEVBQUE3SCxFQUFBeUIsSUFBQW9HLEVBQUE3SCxJQUFBc3BCLFdBQUF6aEIsRUFBQTdILElBQUE4NUIsWUFDQSxHQUFBOTVCLElBQUFpUyxFQUFBNm5CLFVBV0EsYUFFVyxDQUlYLElBRkEsSUFBQUMsR0FBQSxFQUNBNUcsRUFBQWxoQixFQUFBK25CLFdBQ0FqYSxFQUFBLEVBQTZCQSxFQUFBaE8sRUFBQWhTLE9BQXVCZ2dCLElBQUEsQ0FDcEQsSUFBQW9ULElBQUFtRyxFQUFBbkcsRUFBQXBoQixFQUFBZ08sR0FBQTZXLEVBQUErQyxHQUFBLENBQ0FJLEdBQUEsRUFDQSxNQUVBNUcsSUFBQWdDLFlBSUEsSUFBQTRFLEdBQUE1RyxFQVVBLGNBeENBNkQsRUFBQXpqQixFQUFBeEIsRUFBQTZrQixHQTZDQSxHQUFBL3VCLEVBQUFwRyxHQUFBLENBQ0EsSUFBQXc0QixHQUFBLEVBQ0EsUUFBQTU4QixLQUFBb0UsRUFDQSxJQUFBaTRCLEVBQUFyOEIsR0FBQSxDQUNBNDhCLEdBQUEsRUFDQWhELEVBQUExakIsRUFBQXFqQixHQUNBLE9BR0FxRCxHQUFBeDRCLEVBQUEsVUFFQXdaLEdBQUF4WixFQUFBLGdCQUdLd1EsRUFBQXhRLE9BQUE4UixFQUFBdkIsT0FDTEMsRUFBQXhRLEtBQUE4UixFQUFBdkIsTUFFQSxTQWNBLGdCQUFBd2EsRUFBQWpaLEVBQUE2TixFQUFBa1gsRUFBQXJNLEVBQUFDLEdBQ0EsSUFBQXZrQixFQUFBNEwsR0FBQSxDQUtBLElBQUEybUIsR0FBQSxFQUNBdEQsS0FFQSxHQUFBanZCLEVBQUE2a0IsR0FFQTBOLEdBQUEsRUFDQXZELEVBQUFwakIsRUFBQXFqQixFQUFBM0ssRUFBQUMsT0FDSyxDQUNMLElBQUFpTyxFQUFBdHlCLEVBQUEya0IsRUFBQTROLFVBQ0EsSUFBQUQsR0FBQXRFLEdBQUFySixFQUFBalosR0FFQTRsQixFQUFBM00sRUFBQWpaLEVBQUFxakIsRUFBQTBCLE9BQ08sQ0FDUCxHQUFBNkIsRUFBQSxDQVFBLEdBSkEsSUFBQTNOLEVBQUE0TixVQUFBNU4sRUFBQTZOLGFBQUF6dEIsS0FDQTRmLEVBQUE4TixnQkFBQTF0QixHQUNBd1UsR0FBQSxHQUVBdFosRUFBQXNaLElBQ0FrWSxFQUFBOU0sRUFBQWpaLEVBQUFxakIsR0FFQSxPQURBNEMsRUFBQWptQixFQUFBcWpCLEdBQUEsR0FDQXBLLEVBYUFBLEVBQUErSixFQUFBL0osR0FJQSxJQUFBK04sRUFBQS9OLEVBQUF2YSxJQUNBdW9CLEVBQUFqRixFQUFBckMsV0FBQXFILEdBY0EsR0FYQTVELEVBQ0FwakIsRUFDQXFqQixFQUlBMkQsRUFBQUUsU0FBQSxLQUFBRCxFQUNBakYsRUFBQUosWUFBQW9GLElBSUExeUIsRUFBQTBMLEVBQUEzTyxRQUFBLENBQ0EsSUFBQSt5QixFQUFBcGtCLEVBQUEzTyxPQUNBODFCLEVBQUFwRCxFQUFBL2pCLEdBQ0EsTUFBQW9rQixFQUFBLENBQ0EsUUFBQTMzQixFQUFBLEVBQTJCQSxFQUFBOGYsRUFBQTRNLFFBQUEzc0IsU0FBd0JDLEVBQ25EOGYsRUFBQTRNLFFBQUExc0IsR0FBQTIzQixHQUdBLEdBREFBLEVBQUExbEIsSUFBQXNCLEVBQUF0QixJQUNBeW9CLEVBQUEsQ0FDQSxRQUFBM2EsRUFBQSxFQUErQkEsRUFBQUQsRUFBQXZZLE9BQUF4SCxTQUF5QmdnQixFQUN4REQsRUFBQXZZLE9BQUF3WSxHQUFBNlYsR0FBQStCLEdBS0EsSUFBQWxMLEVBQUFrTCxFQUFBbDJCLEtBQUEyQyxLQUFBcW9CLE9BQ0EsR0FBQUEsRUFBQWpRLE9BRUEsUUFBQW1lLEVBQUEsRUFBaUNBLEVBQUFsTyxFQUFBOVEsSUFBQTViLE9BQXlCNDZCLElBQzFEbE8sRUFBQTlRLElBQUFnZixVQUlBbkYsR0FBQW1DLEdBRUFBLElBQUEveUIsUUFLQWlELEVBQUEyeUIsR0FDQXpDLEVBQUF5QyxHQUFBaE8sR0FBQSxLQUNTM2tCLEVBQUEya0IsRUFBQTFhLE1BQ1RnbUIsRUFBQXRMLElBTUEsT0FEQWdOLEVBQUFqbUIsRUFBQXFqQixFQUFBc0QsR0FDQTNtQixFQUFBdEIsSUFuR0FwSyxFQUFBMmtCLElBQTRCc0wsRUFBQXRMLElBeUc1QixJQUFBOVUsSUFDQW5RLE9BQUFxekIsR0FDQXJwQixPQUFBcXBCLEdBQ0FsTyxRQUFBLFNBQUFuWixHQUNBcW5CLEdBQUFybkIsRUFBQXFpQixNQUlBLFNBQUFnRixHQUFBcE8sRUFBQWpaLElBQ0FpWixFQUFBL3FCLEtBQUFpVyxZQUFBbkUsRUFBQTlSLEtBQUFpVyxhQUNBeUosR0FBQXFMLEVBQUFqWixHQUlBLFNBQUE0TixHQUFBcUwsRUFBQWpaLEdBQ0EsSUFRQWxXLEVBQUF3OUIsRUFBQUMsRUFSQUMsRUFBQXZPLElBQUFvSixHQUNBb0YsRUFBQXpuQixJQUFBcWlCLEdBQ0FxRixFQUFBQyxHQUFBMU8sRUFBQS9xQixLQUFBaVcsV0FBQThVLEVBQUEvbkIsU0FDQTAyQixFQUFBRCxHQUFBM25CLEVBQUE5UixLQUFBaVcsV0FBQW5FLEVBQUE5TyxTQUVBMjJCLEtBQ0FDLEtBR0EsSUFBQWgrQixLQUFBODlCLEVBQ0FOLEVBQUFJLEVBQUE1OUIsR0FDQXk5QixFQUFBSyxFQUFBOTlCLEdBQ0F3OUIsR0FRQUMsRUFBQXRWLFNBQUFxVixFQUFBajRCLE1BQ0EwNEIsR0FBQVIsRUFBQSxTQUFBdm5CLEVBQUFpWixHQUNBc08sRUFBQTVzQixLQUFBNHNCLEVBQUE1c0IsSUFBQXF0QixrQkFDQUYsRUFBQXo1QixLQUFBazVCLEtBVEFRLEdBQUFSLEVBQUEsT0FBQXZuQixFQUFBaVosR0FDQXNPLEVBQUE1c0IsS0FBQTRzQixFQUFBNXNCLElBQUE4RixVQUNBb25CLEVBQUF4NUIsS0FBQWs1QixJQVlBLEdBQUFNLEVBQUFyN0IsT0FBQSxDQUNBLElBQUF5N0IsRUFBQSxXQUNBLFFBQUF4N0IsRUFBQSxFQUFxQkEsRUFBQW83QixFQUFBcjdCLE9BQTJCQyxJQUNoRHM3QixHQUFBRixFQUFBcDdCLEdBQUEsV0FBQXVULEVBQUFpWixJQUdBdU8sRUFDQTNlLEdBQUE3SSxFQUFBLFNBQUFpb0IsR0FFQUEsSUFZQSxHQVJBSCxFQUFBdDdCLFFBQ0FxYyxHQUFBN0ksRUFBQSx1QkFDQSxRQUFBdlQsRUFBQSxFQUFxQkEsRUFBQXE3QixFQUFBdDdCLE9BQThCQyxJQUNuRHM3QixHQUFBRCxFQUFBcjdCLEdBQUEsbUJBQUF1VCxFQUFBaVosTUFLQXVPLEVBQ0EsSUFBQTE5QixLQUFBNDlCLEVBQ0FFLEVBQUE5OUIsSUFFQWkrQixHQUFBTCxFQUFBNTlCLEdBQUEsU0FBQW12QixJQUFBd08sR0FNQSxJQUFBUyxHQUFBcDlCLE9BQUFrSixPQUFBLE1BRUEsU0FBQTJ6QixHQUNBempCLEVBQ0FoQixHQUVBLElBS0F6VyxFQUFBODZCLEVBTEFudkIsRUFBQXROLE9BQUFrSixPQUFBLE1BQ0EsSUFBQWtRLEVBRUEsT0FBQTlMLEVBR0EsSUFBQTNMLEVBQUEsRUFBYUEsRUFBQXlYLEVBQUExWCxPQUFpQkMsSUFDOUI4NkIsRUFBQXJqQixFQUFBelgsR0FDQTg2QixFQUFBWSxZQUVBWixFQUFBWSxVQUFBRCxJQUVBOXZCLEVBQUFnd0IsR0FBQWIsTUFDQUEsRUFBQTVzQixJQUFBK0osR0FBQXhCLEVBQUF2UixTQUFBLGFBQUE0MUIsRUFBQXg4QixNQUFBLEdBR0EsT0FBQXFOLEVBR0EsU0FBQWd3QixHQUFBYixHQUNBLE9BQUFBLEVBQUFjLFNBQUFkLEVBQUEsU0FBQXo4QixPQUFBaEMsS0FBQXkrQixFQUFBWSxlQUE0RXIxQixLQUFBLEtBRzVFLFNBQUFpMUIsR0FBQVIsRUFBQTEyQixFQUFBbVAsRUFBQWlaLEVBQUF3TyxHQUNBLElBQUExNUIsRUFBQXc1QixFQUFBNXNCLEtBQUE0c0IsRUFBQTVzQixJQUFBOUosR0FDQSxHQUFBOUMsRUFDQSxJQUNBQSxFQUFBaVMsRUFBQXRCLElBQUE2b0IsRUFBQXZuQixFQUFBaVosRUFBQXdPLEdBQ0ssTUFBQTE3QixHQUNMaWEsR0FBQWphLEVBQUFpVSxFQUFBOU8sUUFBQSxhQUFBcTJCLEVBQUEsU0FBQTEyQixFQUFBLFVBS0EsSUFBQXkzQixJQUNBek4sR0FDQTFXLElBS0EsU0FBQW9rQixHQUFBdFAsRUFBQWpaLEdBQ0EsSUFBQXpELEVBQUF5RCxFQUFBckIsaUJBQ0EsS0FBQXJLLEVBQUFpSSxLQUFBLElBQUFBLEVBQUFPLEtBQUFoTSxRQUFBMDNCLGlCQUdBcDBCLEVBQUE2a0IsRUFBQS9xQixLQUFBaWIsU0FBQS9VLEVBQUE0TCxFQUFBOVIsS0FBQWliLFFBQUEsQ0FHQSxJQUFBcmYsRUFBQXFjLEVBQUF3QyxFQUNBakssRUFBQXNCLEVBQUF0QixJQUNBK3BCLEVBQUF4UCxFQUFBL3FCLEtBQUFpYixVQUNBQSxFQUFBbkosRUFBQTlSLEtBQUFpYixVQU1BLElBQUFyZixLQUpBd0ssRUFBQTZVLEVBQUF4SSxVQUNBd0ksRUFBQW5KLEVBQUE5UixLQUFBaWIsTUFBQW5SLEtBQXdDbVIsSUFHeENBLEVBQ0FoRCxFQUFBZ0QsRUFBQXJmLEdBQ0E2ZSxFQUFBOGYsRUFBQTMrQixHQUNBNmUsSUFBQXhDLEdBQ0F1aUIsR0FBQWhxQixFQUFBNVUsRUFBQXFjLEdBU0EsSUFBQXJjLEtBSEFrUyxHQUFBRSxLQUFBaU4sRUFBQTlaLFFBQUFvNUIsRUFBQXA1QixPQUNBcTVCLEdBQUFocUIsRUFBQSxRQUFBeUssRUFBQTlaLE9BRUFvNUIsRUFDQXIwQixFQUFBK1UsRUFBQXJmLE1BQ0F5MUIsR0FBQXoxQixHQUNBNFUsRUFBQWlxQixrQkFBQXJKLEdBQUFFLEdBQUExMUIsSUFDT3MxQixHQUFBdDFCLElBQ1A0VSxFQUFBcW9CLGdCQUFBajlCLEtBTUEsU0FBQTQrQixHQUFBN1osRUFBQS9rQixFQUFBdUYsR0FDQXdmLEVBQUFxUyxRQUFBOXFCLFFBQUEsUUFDQXd5QixHQUFBL1osRUFBQS9rQixFQUFBdUYsR0FDR2d3QixHQUFBdjFCLEdBR0gyMUIsR0FBQXB3QixHQUNBd2YsRUFBQWtZLGdCQUFBajlCLElBSUF1RixFQUFBLG9CQUFBdkYsR0FBQSxVQUFBK2tCLEVBQUFxUyxRQUNBLE9BQ0FwM0IsRUFDQStrQixFQUFBdVMsYUFBQXQzQixFQUFBdUYsSUFFRyt2QixHQUFBdDFCLEdBQ0gra0IsRUFBQXVTLGFBQUF0M0IsRUFBQTIxQixHQUFBcHdCLElBQUEsVUFBQUEsRUFBQSxnQkFDR2t3QixHQUFBejFCLEdBQ0gyMUIsR0FBQXB3QixHQUNBd2YsRUFBQThaLGtCQUFBckosR0FBQUUsR0FBQTExQixJQUVBK2tCLEVBQUFnYSxlQUFBdkosR0FBQXgxQixFQUFBdUYsR0FHQXU1QixHQUFBL1osRUFBQS9rQixFQUFBdUYsR0FJQSxTQUFBdTVCLEdBQUEvWixFQUFBL2tCLEVBQUF1RixHQUNBLEdBQUFvd0IsR0FBQXB3QixHQUNBd2YsRUFBQWtZLGdCQUFBajlCLE9BQ0csQ0FLSCxHQUNBa1MsSUFBQUMsR0FDQSxhQUFBNFMsRUFBQXFTLFNBQ0EsZ0JBQUFwM0IsSUFBQStrQixFQUFBaWEsT0FDQSxDQUNBLElBQUFDLEVBQUEsU0FBQWg5QixHQUNBQSxFQUFBaTlCLDJCQUNBbmEsRUFBQW9hLG9CQUFBLFFBQUFGLElBRUFsYSxFQUFBaGdCLGlCQUFBLFFBQUFrNkIsR0FFQWxhLEVBQUFpYSxRQUFBLEVBRUFqYSxFQUFBdVMsYUFBQXQzQixFQUFBdUYsSUFJQSxJQUFBOFosSUFDQW5WLE9BQUF1MEIsR0FDQXZxQixPQUFBdXFCLElBS0EsU0FBQVcsR0FBQWpRLEVBQUFqWixHQUNBLElBQUE2TyxFQUFBN08sRUFBQXRCLElBQ0F4USxFQUFBOFIsRUFBQTlSLEtBQ0FpN0IsRUFBQWxRLEVBQUEvcUIsS0FDQSxLQUNBa0csRUFBQWxHLEVBQUE2eEIsY0FDQTNyQixFQUFBbEcsRUFBQXNzQixTQUNBcG1CLEVBQUErMEIsSUFDQS8wQixFQUFBKzBCLEVBQUFwSixjQUNBM3JCLEVBQUErMEIsRUFBQTNPLFNBTEEsQ0FZQSxJQUFBNE8sRUFBQTFKLEdBQUExZixHQUdBcXBCLEVBQUF4YSxFQUFBeWEsbUJBQ0FoMUIsRUFBQSswQixLQUNBRCxFQUFBbDNCLEdBQUFrM0IsRUFBQW5KLEdBQUFvSixLQUlBRCxJQUFBdmEsRUFBQTBhLGFBQ0ExYSxFQUFBdVMsYUFBQSxRQUFBZ0ksR0FDQXZhLEVBQUEwYSxXQUFBSCxJQUlBLElBd0VBSSxHQXhFQUMsSUFDQXoxQixPQUFBazFCLEdBQ0FsckIsT0FBQWtyQixJQTRDQVEsR0FBQSxNQUNBQyxHQUFBLE1BUUEsU0FBQUMsR0FBQXBoQixHQUVBLEdBQUFsVSxFQUFBa1UsRUFBQWtoQixLQUFBLENBRUEsSUFBQXo3QixFQUFBK04sRUFBQSxpQkFDQXdNLEVBQUF2YSxNQUFBaUUsT0FBQXNXLEVBQUFraEIsSUFBQWxoQixFQUFBdmEsZUFDQXVhLEVBQUFraEIsSUFLQXAxQixFQUFBa1UsRUFBQW1oQixPQUNBbmhCLEVBQUFxaEIsVUFBQTMzQixPQUFBc1csRUFBQW1oQixJQUFBbmhCLEVBQUFxaEIsbUJBQ0FyaEIsRUFBQW1oQixLQU1BLFNBQUFHLEdBQUF0VyxFQUFBdmxCLEVBQUFzWSxHQUNBLElBQUFwSSxFQUFBcXJCLEdBQ0EsZ0JBQUFPLElBQ0EsSUFBQTN4QixFQUFBb2IsRUFBQS9iLE1BQUEsS0FBQXJKLFdBQ0EsT0FBQWdLLEdBQ0E0eEIsR0FBQS83QixFQUFBODdCLEVBQUF4akIsRUFBQXBJLElBS0EsU0FBQThyQixHQUNBaDhCLEVBQ0F1bEIsRUFDQXRMLEVBQ0EzQixFQUNBMEIsR0FFQXVMLEVBQUFuTSxHQUFBbU0sR0FDQXRMLElBQWdCc0wsRUFBQXNXLEdBQUF0VyxFQUFBdmxCLEVBQUFzWSxJQUNoQmlqQixHQUFBMzZCLGlCQUNBWixFQUNBdWxCLEVBQ0FsWCxJQUNTaUssVUFBQTBCLFdBQ1QxQixHQUlBLFNBQUF5akIsR0FDQS83QixFQUNBdWxCLEVBQ0FqTixFQUNBcEksSUFFQUEsR0FBQXFyQixJQUFBUCxvQkFDQWg3QixFQUNBdWxCLEVBQUFsTSxXQUFBa00sRUFDQWpOLEdBSUEsU0FBQTJqQixHQUFBalIsRUFBQWpaLEdBQ0EsSUFBQTVMLEVBQUE2a0IsRUFBQS9xQixLQUFBc2EsTUFBQXBVLEVBQUE0TCxFQUFBOVIsS0FBQXNhLElBQUEsQ0FHQSxJQUFBQSxFQUFBeEksRUFBQTlSLEtBQUFzYSxPQUNBQyxFQUFBd1EsRUFBQS9xQixLQUFBc2EsT0FDQWdoQixHQUFBeHBCLEVBQUF0QixJQUNBa3JCLEdBQUFwaEIsR0FDQUQsR0FBQUMsRUFBQUMsRUFBQXdoQixHQUFBRCxHQUFBaHFCLEVBQUE5TyxTQUNBczRCLFFBQUE5K0IsR0FHQSxJQUFBeS9CLElBQ0FuMkIsT0FBQWsyQixHQUNBbHNCLE9BQUFrc0IsSUFLQSxTQUFBRSxHQUFBblIsRUFBQWpaLEdBQ0EsSUFBQTVMLEVBQUE2a0IsRUFBQS9xQixLQUFBNm5CLFlBQUEzaEIsRUFBQTRMLEVBQUE5UixLQUFBNm5CLFVBQUEsQ0FHQSxJQUFBanNCLEVBQUFxYyxFQUNBekgsRUFBQXNCLEVBQUF0QixJQUNBMnJCLEVBQUFwUixFQUFBL3FCLEtBQUE2bkIsYUFDQXRTLEVBQUF6RCxFQUFBOVIsS0FBQTZuQixhQU1BLElBQUFqc0IsS0FKQXdLLEVBQUFtUCxFQUFBOUMsVUFDQThDLEVBQUF6RCxFQUFBOVIsS0FBQTZuQixTQUFBL2QsS0FBMkN5TCxJQUczQzRtQixFQUNBajJCLEVBQUFxUCxFQUFBM1osTUFDQTRVLEVBQUE1VSxHQUFBLElBR0EsSUFBQUEsS0FBQTJaLEVBQUEsQ0FLQSxHQUpBMEMsRUFBQTFDLEVBQUEzWixHQUlBLGdCQUFBQSxHQUFBLGNBQUFBLEVBQUEsQ0FFQSxHQURBa1csRUFBQXhCLFdBQTJCd0IsRUFBQXhCLFNBQUFoUyxPQUFBLEdBQzNCMlosSUFBQWtrQixFQUFBdmdDLEdBQWtDLFNBR2xDLElBQUE0VSxFQUFBNHJCLFdBQUE5OUIsUUFDQWtTLEVBQUExUCxZQUFBMFAsRUFBQTRyQixXQUFBLElBSUEsYUFBQXhnQyxFQUFBLENBR0E0VSxFQUFBNnJCLE9BQUFwa0IsRUFFQSxJQUFBcWtCLEVBQUFwMkIsRUFBQStSLEdBQUEsR0FBQXBULE9BQUFvVCxHQUNBc2tCLEdBQUEvckIsRUFBQThyQixLQUNBOXJCLEVBQUFyUCxNQUFBbTdCLFFBR0E5ckIsRUFBQTVVLEdBQUFxYyxJQVFBLFNBQUFza0IsR0FBQS9yQixFQUFBZ3NCLEdBQ0EsT0FBQWhzQixFQUFBaXNCLFlBQ0EsV0FBQWpzQixFQUFBd2lCLFNBQ0EwSixHQUFBbHNCLEVBQUFnc0IsSUFDQUcsR0FBQW5zQixFQUFBZ3NCLElBSUEsU0FBQUUsR0FBQWxzQixFQUFBZ3NCLEdBR0EsSUFBQUksR0FBQSxFQUdBLElBQU9BLEVBQUF0N0IsU0FBQXU3QixnQkFBQXJzQixFQUErQyxNQUFBM1MsSUFDdEQsT0FBQSsrQixHQUFBcHNCLEVBQUFyUCxRQUFBcTdCLEVBR0EsU0FBQUcsR0FBQW5zQixFQUFBNEQsR0FDQSxJQUFBalQsRUFBQXFQLEVBQUFyUCxNQUNBODRCLEVBQUF6cEIsRUFBQXNzQixZQUNBLEdBQUExMkIsRUFBQTZ6QixHQUFBLENBQ0EsR0FBQUEsRUFBQTVXLEtBRUEsU0FFQSxHQUFBNFcsRUFBQThDLE9BQ0EsT0FBQTExQixFQUFBbEcsS0FBQWtHLEVBQUErTSxHQUVBLEdBQUE2bEIsRUFBQStDLEtBQ0EsT0FBQTc3QixFQUFBNjdCLFNBQUE1b0IsRUFBQTRvQixPQUdBLE9BQUE3N0IsSUFBQWlULEVBR0EsSUFBQXlULElBQ0EvaEIsT0FBQW8yQixHQUNBcHNCLE9BQUFvc0IsSUFLQWUsR0FBQTUwQixFQUFBLFNBQUE2MEIsR0FDQSxJQUFBaHpCLEtBQ0FpekIsRUFBQSxnQkFDQUMsRUFBQSxRQU9BLE9BTkFGLEVBQUExNEIsTUFBQTI0QixHQUFBaHJCLFFBQUEsU0FBQW5LLEdBQ0EsR0FBQUEsRUFBQSxDQUNBLElBQUE4YixFQUFBOWIsRUFBQXhELE1BQUE0NEIsR0FDQXRaLEVBQUF4bEIsT0FBQSxJQUFBNEwsRUFBQTRaLEVBQUEsR0FBQWtaLFFBQUFsWixFQUFBLEdBQUFrWixXQUdBOXlCLElBSUEsU0FBQW16QixHQUFBcjlCLEdBQ0EsSUFBQXVGLEVBQUErM0IsR0FBQXQ5QixFQUFBdUYsT0FHQSxPQUFBdkYsRUFBQXU5QixZQUNBenpCLEVBQUE5SixFQUFBdTlCLFlBQUFoNEIsR0FDQUEsRUFJQSxTQUFBKzNCLEdBQUFFLEdBQ0EsT0FBQTN6QixNQUFBYyxRQUFBNnlCLEdBQ0F2ekIsRUFBQXV6QixHQUVBLGtCQUFBQSxFQUNBUCxHQUFBTyxHQUVBQSxFQU9BLFNBQUFDLEdBQUEzckIsRUFBQTRyQixHQUNBLElBQ0FDLEVBREF6ekIsS0FHQSxHQUFBd3pCLEVBQUEsQ0FDQSxJQUFBaE0sRUFBQTVmLEVBQ0EsTUFBQTRmLEVBQUEzZ0Isa0JBQ0EyZ0IsSUFBQTNnQixrQkFBQWlQLE9BRUEwUixLQUFBMXhCLE9BQ0EyOUIsRUFBQU4sR0FBQTNMLEVBQUExeEIsUUFFQThKLEVBQUFJLEVBQUF5ekIsSUFLQUEsRUFBQU4sR0FBQXZyQixFQUFBOVIsUUFDQThKLEVBQUFJLEVBQUF5ekIsR0FHQSxJQUFBbE0sRUFBQTNmLEVBQ0EsTUFBQTJmLElBQUF0dUIsT0FDQXN1QixFQUFBenhCLE9BQUEyOUIsRUFBQU4sR0FBQTVMLEVBQUF6eEIsUUFDQThKLEVBQUFJLEVBQUF5ekIsR0FHQSxPQUFBenpCLEVBS0EsSUF5QkEwekIsR0F6QkFDLEdBQUEsTUFDQUMsR0FBQSxpQkFDQUMsR0FBQSxTQUFBcGQsRUFBQTlqQixFQUFBNkgsR0FFQSxHQUFBbTVCLEdBQUE1d0IsS0FBQXBRLEdBQ0E4akIsRUFBQXBiLE1BQUF5NEIsWUFBQW5oQyxFQUFBNkgsUUFDRyxHQUFBbzVCLEdBQUE3d0IsS0FBQXZJLEdBQ0hpYyxFQUFBcGIsTUFBQXk0QixZQUFBbmhDLEVBQUE2SCxFQUFBZ0UsUUFBQW8xQixHQUFBLHFCQUNHLENBQ0gsSUFBQUcsRUFBQUMsR0FBQXJoQyxHQUNBLEdBQUFnTixNQUFBYyxRQUFBakcsR0FJQSxRQUFBbkcsRUFBQSxFQUFBK1QsRUFBQTVOLEVBQUFwRyxPQUF1Q0MsRUFBQStULEVBQVMvVCxJQUNoRG9pQixFQUFBcGIsTUFBQTA0QixHQUFBdjVCLEVBQUFuRyxRQUdBb2lCLEVBQUFwYixNQUFBMDRCLEdBQUF2NUIsSUFLQXk1QixJQUFBLHFCQUdBRCxHQUFBNzFCLEVBQUEsU0FBQTJPLEdBR0EsR0FGQTRtQixPQUFBdDhCLFNBQUFFLGNBQUEsT0FBQStELE1BQ0F5UixFQUFBdk8sRUFBQXVPLEdBQ0EsV0FBQUEsUUFBQTRtQixHQUNBLE9BQUE1bUIsRUFHQSxJQURBLElBQUFvbkIsRUFBQXBuQixFQUFBak8sT0FBQSxHQUFBRixjQUFBbU8sRUFBQWhPLE1BQUEsR0FDQXpLLEVBQUEsRUFBaUJBLEVBQUE0L0IsR0FBQTcvQixPQUF3QkMsSUFBQSxDQUN6QyxJQUFBMUIsRUFBQXNoQyxHQUFBNS9CLEdBQUE2L0IsRUFDQSxHQUFBdmhDLEtBQUErZ0MsR0FDQSxPQUFBL2dDLEtBS0EsU0FBQXdoQyxHQUFBdFQsRUFBQWpaLEdBQ0EsSUFBQTlSLEVBQUE4UixFQUFBOVIsS0FDQWk3QixFQUFBbFEsRUFBQS9xQixLQUVBLEtBQUFrRyxFQUFBbEcsRUFBQXU5QixjQUFBcjNCLEVBQUFsRyxFQUFBdUYsUUFDQVcsRUFBQSswQixFQUFBc0MsY0FBQXIzQixFQUFBKzBCLEVBQUExMUIsUUFEQSxDQU1BLElBQUEwUyxFQUFBcGIsRUFDQThqQixFQUFBN08sRUFBQXRCLElBQ0E4dEIsRUFBQXJELEVBQUFzQyxZQUNBZ0IsRUFBQXRELEVBQUF1RCxpQkFBQXZELEVBQUExMUIsVUFHQWs1QixFQUFBSCxHQUFBQyxFQUVBaDVCLEVBQUErM0IsR0FBQXhyQixFQUFBOVIsS0FBQXVGLFdBS0F1TSxFQUFBOVIsS0FBQXcrQixnQkFBQXA0QixFQUFBYixFQUFBa04sUUFDQTNJLEtBQWV2RSxHQUNmQSxFQUVBLElBQUFtNUIsRUFBQWpCLEdBQUEzckIsR0FBQSxHQUVBLElBQUFqVixLQUFBNGhDLEVBQ0F2NEIsRUFBQXc0QixFQUFBN2hDLEtBQ0FraEMsR0FBQXBkLEVBQUE5akIsRUFBQSxJQUdBLElBQUFBLEtBQUE2aEMsRUFDQXptQixFQUFBeW1CLEVBQUE3aEMsR0FDQW9iLElBQUF3bUIsRUFBQTVoQyxJQUVBa2hDLEdBQUFwZCxFQUFBOWpCLEVBQUEsTUFBQW9iLEVBQUEsR0FBQUEsSUFLQSxJQUFBMVMsSUFDQU8sT0FBQXU0QixHQUNBdnVCLE9BQUF1dUIsSUFTQSxTQUFBTSxHQUFBaGUsRUFBQXVhLEdBRUEsR0FBQUEsUUFBQThCLFFBS0EsR0FBQXJjLEVBQUFpZSxVQUNBMUQsRUFBQWh6QixRQUFBLFFBQ0FnekIsRUFBQTEyQixNQUFBLE9BQUEyTixRQUFBLFNBQUF2SixHQUE2QyxPQUFBK1gsRUFBQWllLFVBQUF0N0IsSUFBQXNGLEtBRTdDK1gsRUFBQWllLFVBQUF0N0IsSUFBQTQzQixPQUVHLENBQ0gsSUFBQWpqQixFQUFBLEtBQUEwSSxFQUFBa2UsYUFBQSxrQkFDQTVtQixFQUFBL1AsUUFBQSxJQUFBZ3pCLEVBQUEsUUFDQXZhLEVBQUF1UyxhQUFBLFNBQUFqYixFQUFBaWpCLEdBQUE4QixTQVNBLFNBQUE4QixHQUFBbmUsRUFBQXVhLEdBRUEsR0FBQUEsUUFBQThCLFFBS0EsR0FBQXJjLEVBQUFpZSxVQUNBMUQsRUFBQWh6QixRQUFBLFFBQ0FnekIsRUFBQTEyQixNQUFBLE9BQUEyTixRQUFBLFNBQUF2SixHQUE2QyxPQUFBK1gsRUFBQWllLFVBQUE5MkIsT0FBQWMsS0FFN0MrWCxFQUFBaWUsVUFBQTkyQixPQUFBb3pCLEdBRUF2YSxFQUFBaWUsVUFBQXRnQyxRQUNBcWlCLEVBQUFrWSxnQkFBQSxhQUVHLENBQ0gsSUFBQTVnQixFQUFBLEtBQUEwSSxFQUFBa2UsYUFBQSxrQkFDQUUsRUFBQSxJQUFBN0QsRUFBQSxJQUNBLE1BQUFqakIsRUFBQS9QLFFBQUE2MkIsSUFBQSxFQUNBOW1CLElBQUF2UCxRQUFBcTJCLEVBQUEsS0FFQTltQixJQUFBK2tCLE9BQ0Eva0IsRUFDQTBJLEVBQUF1UyxhQUFBLFFBQUFqYixHQUVBMEksRUFBQWtZLGdCQUFBLFVBT0EsU0FBQW1HLEdBQUF2eUIsR0FDQSxHQUFBQSxFQUFBLENBSUEscUJBQUFBLEVBQUEsQ0FDQSxJQUFBdkMsS0FLQSxPQUpBLElBQUF1QyxFQUFBd3lCLEtBQ0FuMUIsRUFBQUksRUFBQWcxQixHQUFBenlCLEVBQUE1UCxNQUFBLE1BRUFpTixFQUFBSSxFQUFBdUMsR0FDQXZDLEVBQ0csd0JBQUF1QyxFQUNIeXlCLEdBQUF6eUIsUUFERyxHQUtILElBQUF5eUIsR0FBQTcyQixFQUFBLFNBQUF4TCxHQUNBLE9BQ0FzaUMsV0FBQXRpQyxFQUFBLFNBQ0F1aUMsYUFBQXZpQyxFQUFBLFlBQ0F3aUMsaUJBQUF4aUMsRUFBQSxnQkFDQXlpQyxXQUFBemlDLEVBQUEsU0FDQTBpQyxhQUFBMWlDLEVBQUEsWUFDQTJpQyxpQkFBQTNpQyxFQUFBLG1CQUlBNGlDLEdBQUFweUIsSUFBQVUsRUFDQTJ4QixHQUFBLGFBQ0FDLEdBQUEsWUFHQUMsR0FBQSxhQUNBQyxHQUFBLGdCQUNBQyxHQUFBLFlBQ0FDLEdBQUEsZUFDQU4sVUFFQWpqQyxJQUFBOFEsT0FBQTB5QixzQkFDQXhqQyxJQUFBOFEsT0FBQTJ5Qix3QkFFQUwsR0FBQSxtQkFDQUMsR0FBQSw0QkFFQXJqQyxJQUFBOFEsT0FBQTR5QixxQkFDQTFqQyxJQUFBOFEsT0FBQTZ5Qix1QkFFQUwsR0FBQSxrQkFDQUMsR0FBQSx1QkFLQSxJQUFBSyxHQUFBL3lCLEVBQ0FDLE9BQUEreUIsc0JBQ0EveUIsT0FBQSt5QixzQkFBQTMyQixLQUFBNEQsUUFDQXZNLFdBQ0EsU0FBQWxCLEdBQThDLE9BQUFBLEtBRTlDLFNBQUF5Z0MsR0FBQXpnQyxHQUNBdWdDLEdBQUEsV0FDQUEsR0FBQXZnQyxLQUlBLFNBQUEwZ0MsR0FBQTVmLEVBQUF1YSxHQUNBLElBQUFzRixFQUFBN2YsRUFBQXlhLHFCQUFBemEsRUFBQXlhLHVCQUNBb0YsRUFBQXQ0QixRQUFBZ3pCLEdBQUEsSUFDQXNGLEVBQUFyZ0MsS0FBQSs2QixHQUNBeUQsR0FBQWhlLEVBQUF1YSxJQUlBLFNBQUF1RixHQUFBOWYsRUFBQXVhLEdBQ0F2YSxFQUFBeWEsb0JBQ0F0ekIsRUFBQTZZLEVBQUF5YSxtQkFBQUYsR0FFQTRELEdBQUFuZSxFQUFBdWEsR0FHQSxTQUFBd0YsR0FDQS9mLEVBQ0FnZ0IsRUFDQXRuQixHQUVBLElBQUFzVCxFQUFBaVUsR0FBQWpnQixFQUFBZ2dCLEdBQ0F0ckIsRUFBQXNYLEVBQUF0WCxLQUNBK0gsRUFBQXVQLEVBQUF2UCxRQUNBeWpCLEVBQUFsVSxFQUFBa1UsVUFDQSxJQUFBeHJCLEVBQWMsT0FBQWdFLElBQ2QsSUFBQXRaLEVBQUFzVixJQUFBcXFCLEdBQUFHLEdBQUFFLEdBQ0FlLEVBQUEsRUFDQWxKLEVBQUEsV0FDQWpYLEVBQUFvYSxvQkFBQWg3QixFQUFBZ2hDLEdBQ0ExbkIsS0FFQTBuQixFQUFBLFNBQUFsakMsR0FDQUEsRUFBQThSLFNBQUFnUixLQUNBbWdCLEdBQUFELEdBQ0FqSixLQUlBNzJCLFdBQUEsV0FDQSsvQixFQUFBRCxHQUNBakosS0FFR3hhLEVBQUEsR0FDSHVELEVBQUFoZ0IsaUJBQUFaLEVBQUFnaEMsR0FHQSxJQUFBQyxHQUFBLHlCQUVBLFNBQUFKLEdBQUFqZ0IsRUFBQWdnQixHQUNBLElBUUF0ckIsRUFSQTRyQixFQUFBM3pCLE9BQUE0ekIsaUJBQUF2Z0IsR0FDQXdnQixFQUFBRixFQUFBckIsR0FBQSxTQUFBcDdCLE1BQUEsTUFDQTQ4QixFQUFBSCxFQUFBckIsR0FBQSxZQUFBcDdCLE1BQUEsTUFDQTY4QixFQUFBQyxHQUFBSCxFQUFBQyxHQUNBRyxFQUFBTixFQUFBbkIsR0FBQSxTQUFBdDdCLE1BQUEsTUFDQWc5QixFQUFBUCxFQUFBbkIsR0FBQSxZQUFBdDdCLE1BQUEsTUFDQWk5QixFQUFBSCxHQUFBQyxFQUFBQyxHQUdBcGtCLEVBQUEsRUFDQXlqQixFQUFBLEVBRUFGLElBQUFqQixHQUNBMkIsRUFBQSxJQUNBaHNCLEVBQUFxcUIsR0FDQXRpQixFQUFBaWtCLEVBQ0FSLEVBQUFPLEVBQUE5aUMsUUFFR3FpQyxJQUFBaEIsR0FDSDhCLEVBQUEsSUFDQXBzQixFQUFBc3FCLEdBQ0F2aUIsRUFBQXFrQixFQUNBWixFQUFBVyxFQUFBbGpDLFNBR0E4ZSxFQUFBcFcsS0FBQXFOLElBQUFndEIsRUFBQUksR0FDQXBzQixFQUFBK0gsRUFBQSxFQUNBaWtCLEVBQUFJLEVBQ0EvQixHQUNBQyxHQUNBLEtBQ0FrQixFQUFBeHJCLEVBQ0FBLElBQUFxcUIsR0FDQTBCLEVBQUE5aUMsT0FDQWtqQyxFQUFBbGpDLE9BQ0EsR0FFQSxJQUFBb2pDLEVBQ0Fyc0IsSUFBQXFxQixJQUNBc0IsR0FBQS96QixLQUFBZzBCLEVBQUFyQixHQUFBLGFBQ0EsT0FDQXZxQixPQUNBK0gsVUFDQXlqQixZQUNBYSxnQkFJQSxTQUFBSixHQUFBSyxFQUFBQyxHQUVBLE1BQUFELEVBQUFyakMsT0FBQXNqQyxFQUFBdGpDLE9BQ0FxakMsSUFBQTM5QixPQUFBMjlCLEdBR0EsT0FBQTM2QixLQUFBcU4sSUFBQTlLLE1BQUEsS0FBQXE0QixFQUFBbDZCLElBQUEsU0FBQXpELEVBQUExRixHQUNBLE9BQUFzakMsR0FBQTU5QixHQUFBNDlCLEdBQUFGLEVBQUFwakMsT0FJQSxTQUFBc2pDLEdBQUFDLEdBQ0EsV0FBQTFSLE9BQUEwUixFQUFBOTRCLE1BQUEsT0FLQSxTQUFBKzRCLEdBQUFqd0IsRUFBQWt3QixHQUNBLElBQUFyaEIsRUFBQTdPLEVBQUF0QixJQUdBcEssRUFBQXVhLEVBQUFxWSxZQUNBclksRUFBQXFZLFNBQUFpSixXQUFBLEVBQ0F0aEIsRUFBQXFZLFlBR0EsSUFBQWg1QixFQUFBZy9CLEdBQUFsdEIsRUFBQTlSLEtBQUErMUIsWUFDQSxJQUFBN3ZCLEVBQUFsRyxLQUtBb0csRUFBQXVhLEVBQUF1aEIsV0FBQSxJQUFBdmhCLEVBQUFnWSxTQUFBLENBSUEsSUFBQXNHLEVBQUFqL0IsRUFBQWkvQixJQUNBNXBCLEVBQUFyVixFQUFBcVYsS0FDQThwQixFQUFBbi9CLEVBQUFtL0IsV0FDQUMsRUFBQXAvQixFQUFBby9CLGFBQ0FDLEVBQUFyL0IsRUFBQXEvQixpQkFDQThDLEVBQUFuaUMsRUFBQW1pQyxZQUNBQyxFQUFBcGlDLEVBQUFvaUMsY0FDQUMsRUFBQXJpQyxFQUFBcWlDLGtCQUNBQyxFQUFBdGlDLEVBQUFzaUMsWUFDQVAsRUFBQS9oQyxFQUFBK2hDLE1BQ0FRLEVBQUF2aUMsRUFBQXVpQyxXQUNBQyxFQUFBeGlDLEVBQUF3aUMsZUFDQUMsRUFBQXppQyxFQUFBeWlDLGFBQ0FDLEVBQUExaUMsRUFBQTBpQyxPQUNBQyxFQUFBM2lDLEVBQUEyaUMsWUFDQUMsRUFBQTVpQyxFQUFBNGlDLGdCQUNBQyxFQUFBN2lDLEVBQUE2aUMsU0FNQTcvQixFQUFBOGIsR0FDQWdrQixFQUFBaGtCLEdBQUE3YixPQUNBLE1BQUE2L0IsS0FBQTMvQixPQUNBMi9CLElBQUEzL0IsT0FDQUgsRUFBQTgvQixFQUFBOS9CLFFBR0EsSUFBQSsvQixHQUFBLy9CLEVBQUFzYyxhQUFBeE4sRUFBQVosYUFFQSxJQUFBNnhCLEdBQUFMLEdBQUEsS0FBQUEsRUFBQSxDQUlBLElBQUFNLEVBQUFELEdBQUFaLEVBQ0FBLEVBQ0FoRCxFQUNBOEQsRUFBQUYsR0FBQVYsRUFDQUEsRUFDQWhELEVBQ0E2RCxFQUFBSCxHQUFBWCxFQUNBQSxFQUNBaEQsRUFFQStELEVBQUFKLEdBQ0FOLEdBQ0FILEVBQ0FjLEVBQUFMLEdBQ0Esb0JBQUFMLElBQ0FYLEVBQ0FzQixFQUFBTixHQUNBSixHQUNBSixFQUNBZSxFQUFBUCxHQUNBSCxHQUNBSixFQUVBZSxFQUFBbDhCLEVBQ0FoRyxFQUFBd2hDLEdBQ0FBLEVBQUFkLE1BQ0FjLEdBR0EsRUFJQSxJQUFBVyxHQUFBLElBQUF2RSxJQUFBbHhCLEVBQ0EwMUIsRUFBQUMsR0FBQU4sR0FFQS9wQixFQUFBc0gsRUFBQXVoQixTQUFBajNCLEVBQUEsV0FDQXU0QixJQUNBL0MsR0FBQTlmLEVBQUF1aUIsR0FDQXpDLEdBQUE5ZixFQUFBc2lCLElBRUE1cEIsRUFBQTRvQixXQUNBdUIsR0FDQS9DLEdBQUE5ZixFQUFBcWlCLEdBRUFNLEtBQUEzaUIsSUFFQTBpQixLQUFBMWlCLEdBRUFBLEVBQUF1aEIsU0FBQSxPQUdBcHdCLEVBQUE5UixLQUFBMmpDLE1BRUFocEIsR0FBQTdJLEVBQUEsb0JBQ0EsSUFBQTNPLEVBQUF3ZCxFQUFBOFEsV0FDQW1TLEVBQUF6Z0MsS0FBQTBnQyxVQUFBMWdDLEVBQUEwZ0MsU0FBQS94QixFQUFBbFcsS0FDQWdvQyxHQUNBQSxFQUFBdnpCLE1BQUF5QixFQUFBekIsS0FDQXV6QixFQUFBcHpCLElBQUF3b0IsVUFFQTRLLEVBQUFwekIsSUFBQXdvQixXQUVBb0ssS0FBQXppQixFQUFBdEgsS0FLQThwQixLQUFBeGlCLEdBQ0E2aUIsSUFDQWpELEdBQUE1ZixFQUFBcWlCLEdBQ0F6QyxHQUFBNWYsRUFBQXNpQixHQUNBM0MsR0FBQSxXQUNBRyxHQUFBOWYsRUFBQXFpQixHQUNBM3BCLEVBQUE0b0IsWUFDQTFCLEdBQUE1ZixFQUFBdWlCLEdBQ0FPLElBQ0FLLEdBQUFQLEdBQ0F4aUMsV0FBQXNZLEVBQUFrcUIsR0FFQTdDLEdBQUEvZixFQUFBdEwsRUFBQWdFLFFBT0F2SCxFQUFBOVIsS0FBQTJqQyxPQUNBM0IsT0FDQW9CLEtBQUF6aUIsRUFBQXRILElBR0FtcUIsR0FBQUMsR0FDQXBxQixNQUlBLFNBQUEwcUIsR0FBQWp5QixFQUFBMmtCLEdBQ0EsSUFBQTlWLEVBQUE3TyxFQUFBdEIsSUFHQXBLLEVBQUF1YSxFQUFBdWhCLFlBQ0F2aEIsRUFBQXVoQixTQUFBRCxXQUFBLEVBQ0F0aEIsRUFBQXVoQixZQUdBLElBQUFsaUMsRUFBQWcvQixHQUFBbHRCLEVBQUE5UixLQUFBKzFCLFlBQ0EsR0FBQTd2QixFQUFBbEcsSUFBQSxJQUFBMmdCLEVBQUFnWSxTQUNBLE9BQUFsQyxJQUlBLElBQUFyd0IsRUFBQXVhLEVBQUFxWSxVQUFBLENBSUEsSUFBQWlHLEVBQUFqL0IsRUFBQWkvQixJQUNBNXBCLEVBQUFyVixFQUFBcVYsS0FDQWlxQixFQUFBdC9CLEVBQUFzL0IsV0FDQUMsRUFBQXYvQixFQUFBdS9CLGFBQ0FDLEVBQUF4L0IsRUFBQXcvQixpQkFDQXdFLEVBQUFoa0MsRUFBQWdrQyxZQUNBRCxFQUFBL2pDLEVBQUErakMsTUFDQUUsRUFBQWprQyxFQUFBaWtDLFdBQ0FDLEVBQUFsa0MsRUFBQWtrQyxlQUNBQyxFQUFBbmtDLEVBQUFta0MsV0FDQXRCLEVBQUE3aUMsRUFBQTZpQyxTQUVBVyxHQUFBLElBQUF2RSxJQUFBbHhCLEVBQ0EwMUIsRUFBQUMsR0FBQUssR0FFQUssRUFBQS84QixFQUNBaEcsRUFBQXdoQyxHQUNBQSxFQUFBa0IsTUFDQWxCLEdBR0EsRUFJQSxJQUFBeHBCLEVBQUFzSCxFQUFBcVksU0FBQS90QixFQUFBLFdBQ0EwVixFQUFBOFEsWUFBQTlRLEVBQUE4USxXQUFBb1MsV0FDQWxqQixFQUFBOFEsV0FBQW9TLFNBQUEveEIsRUFBQWxXLEtBQUEsTUFFQTRuQyxJQUNBL0MsR0FBQTlmLEVBQUE0ZSxHQUNBa0IsR0FBQTlmLEVBQUE2ZSxJQUVBbm1CLEVBQUE0b0IsV0FDQXVCLEdBQ0EvQyxHQUFBOWYsRUFBQTJlLEdBRUE0RSxLQUFBdmpCLEtBRUE4VixJQUNBd04sS0FBQXRqQixJQUVBQSxFQUFBcVksU0FBQSxPQUdBbUwsRUFDQUEsRUFBQUUsR0FFQUEsSUFHQSxTQUFBQSxJQUVBaHJCLEVBQUE0b0IsWUFJQW53QixFQUFBOVIsS0FBQTJqQyxRQUNBaGpCLEVBQUE4USxXQUFBb1MsV0FBQWxqQixFQUFBOFEsV0FBQW9TLGNBQTZEL3hCLEVBQUEsS0FBQUEsR0FFN0RreUIsS0FBQXJqQixHQUNBNmlCLElBQ0FqRCxHQUFBNWYsRUFBQTJlLEdBQ0FpQixHQUFBNWYsRUFBQTZlLEdBQ0FjLEdBQUEsV0FDQUcsR0FBQTlmLEVBQUEyZSxHQUNBam1CLEVBQUE0b0IsWUFDQTFCLEdBQUE1ZixFQUFBNGUsR0FDQWtFLElBQ0FLLEdBQUFNLEdBQ0FyakMsV0FBQXNZLEVBQUErcUIsR0FFQTFELEdBQUEvZixFQUFBdEwsRUFBQWdFLFFBTUEwcUIsS0FBQXBqQixFQUFBdEgsR0FDQW1xQixHQUFBQyxHQUNBcHFCLE1Bc0JBLFNBQUF5cUIsR0FBQXAvQixHQUNBLHdCQUFBQSxJQUFBNEMsTUFBQTVDLEdBU0EsU0FBQWcvQixHQUFBN2pDLEdBQ0EsR0FBQXFHLEVBQUFyRyxHQUNBLFNBRUEsSUFBQXlrQyxFQUFBemtDLEVBQUFxYSxJQUNBLE9BQUE5VCxFQUFBaytCLEdBRUFaLEdBQ0E3NUIsTUFBQWMsUUFBQTI1QixHQUNBQSxFQUFBLEdBQ0FBLElBR0F6a0MsRUFBQTJKLFNBQUEzSixFQUFBdkIsUUFBQSxFQUlBLFNBQUFpbUMsR0FBQTU3QixFQUFBbUosSUFDQSxJQUFBQSxFQUFBOVIsS0FBQTJqQyxNQUNBNUIsR0FBQWp3QixHQUlBLElBQUFpa0IsR0FBQTFvQixHQUNBdkgsT0FBQXkrQixHQUNBdk8sU0FBQXVPLEdBQ0F6OEIsT0FBQSxTQUFBZ0ssRUFBQTJrQixJQUVBLElBQUEza0IsRUFBQTlSLEtBQUEyakMsS0FDQUksR0FBQWp5QixFQUFBMmtCLEdBRUFBLFNBS0ErTixJQUNBdnBCLEdBQ0FzZ0IsR0FDQVUsR0FDQXBVLEdBQ0F0aUIsR0FDQXd3QixJQU9BbEIsR0FBQTJQLEdBQUF4Z0MsT0FBQW8yQixJQUVBcUssR0FBQTlQLElBQWlDYixXQUFBZSxhQVFqQzltQixHQUVBek0sU0FBQVgsaUJBQUEsNkJBQ0EsSUFBQWdnQixFQUFBcmYsU0FBQXU3QixjQUNBbGMsS0FBQStqQixRQUNBQyxHQUFBaGtCLEVBQUEsV0FLQSxJQUFBaWtCLElBQ0FyeUIsU0FBQSxTQUFBb08sRUFBQWtrQixFQUFBL3lCLEVBQUFpWixHQUNBLFdBQUFqWixFQUFBekIsS0FFQTBhLEVBQUF2YSxNQUFBdWEsRUFBQXZhLElBQUFzMEIsVUFDQW5xQixHQUFBN0ksRUFBQSx1QkFDQTh5QixHQUFBOUssaUJBQUFuWixFQUFBa2tCLEVBQUEveUIsS0FHQWl6QixHQUFBcGtCLEVBQUFra0IsRUFBQS95QixFQUFBOU8sU0FFQTJkLEVBQUFta0IsYUFBQXA5QixJQUFBL0ssS0FBQWdrQixFQUFBL2QsUUFBQW9pQyxNQUNLLGFBQUFsekIsRUFBQXpCLEtBQUFzaUIsR0FBQWhTLEVBQUF0TCxTQUNMc0wsRUFBQW1jLFlBQUErSCxFQUFBNUssVUFDQTRLLEVBQUE1SyxVQUFBNVcsT0FDQTFDLEVBQUFoZ0IsaUJBQUEsbUJBQUFza0MsSUFDQXRrQixFQUFBaGdCLGlCQUFBLGlCQUFBdWtDLElBS0F2a0IsRUFBQWhnQixpQkFBQSxTQUFBdWtDLElBRUFuM0IsSUFDQTRTLEVBQUErakIsUUFBQSxNQU1BNUssaUJBQUEsU0FBQW5aLEVBQUFra0IsRUFBQS95QixHQUNBLGNBQUFBLEVBQUF6QixJQUFBLENBQ0EwMEIsR0FBQXBrQixFQUFBa2tCLEVBQUEveUIsRUFBQTlPLFNBS0EsSUFBQW1pQyxFQUFBeGtCLEVBQUFta0IsVUFDQU0sRUFBQXprQixFQUFBbWtCLGFBQUFwOUIsSUFBQS9LLEtBQUFna0IsRUFBQS9kLFFBQUFvaUMsSUFDQSxHQUFBSSxFQUFBQyxLQUFBLFNBQUFDLEVBQUEvbUMsR0FBMkMsT0FBQWdNLEVBQUErNkIsRUFBQUgsRUFBQTVtQyxNQUF5QyxDQUdwRixJQUFBZ25DLEVBQUE1a0IsRUFBQXNTLFNBQ0E0UixFQUFBMWpDLE1BQUFra0MsS0FBQSxTQUFBbC9CLEdBQTZDLE9BQUFxL0IsR0FBQXIvQixFQUFBaS9CLEtBQzdDUCxFQUFBMWpDLFFBQUEwakMsRUFBQTlnQixVQUFBeWhCLEdBQUFYLEVBQUExakMsTUFBQWlrQyxHQUNBRyxHQUNBWixHQUFBaGtCLEVBQUEsY0FPQSxTQUFBb2tCLEdBQUFwa0IsRUFBQWtrQixFQUFBN3ZCLEdBQ0F5d0IsR0FBQTlrQixFQUFBa2tCLEVBQUE3dkIsSUFFQWxILEdBQUFFLEtBQ0FqTixXQUFBLFdBQ0Ewa0MsR0FBQTlrQixFQUFBa2tCLEVBQUE3dkIsSUFDSyxHQUlMLFNBQUF5d0IsR0FBQTlrQixFQUFBa2tCLEVBQUE3dkIsR0FDQSxJQUFBN1QsRUFBQTBqQyxFQUFBMWpDLE1BQ0F1a0MsRUFBQS9rQixFQUFBc1MsU0FDQSxJQUFBeVMsR0FBQTc3QixNQUFBYyxRQUFBeEosR0FBQSxDQVNBLElBREEsSUFBQTB4QixFQUFBOFMsRUFDQXBuQyxFQUFBLEVBQUErSyxFQUFBcVgsRUFBQS9kLFFBQUF0RSxPQUF3Q0MsRUFBQStLLEVBQU8vSyxJQUUvQyxHQURBb25DLEVBQUFobEIsRUFBQS9kLFFBQUFyRSxHQUNBbW5DLEVBQ0E3UyxFQUFBN25CLEVBQUE3SixFQUFBNmpDLEdBQUFXLEtBQUEsRUFDQUEsRUFBQTlTLGVBQ0E4UyxFQUFBOVMsaUJBR0EsR0FBQXRvQixFQUFBeTZCLEdBQUFXLEdBQUF4a0MsR0FJQSxZQUhBd2YsRUFBQWlsQixnQkFBQXJuQyxJQUNBb2lCLEVBQUFpbEIsY0FBQXJuQyxJQU1BbW5DLElBQ0Eva0IsRUFBQWlsQixlQUFBLElBSUEsU0FBQUosR0FBQXJrQyxFQUFBeUIsR0FDQSxPQUFBQSxFQUFBaUksTUFBQSxTQUFBeTZCLEdBQXFDLE9BQUEvNkIsRUFBQSs2QixFQUFBbmtDLEtBR3JDLFNBQUE2akMsR0FBQVcsR0FDQSxpQkFBQUEsRUFDQUEsRUFBQXRKLE9BQ0FzSixFQUFBeGtDLE1BR0EsU0FBQThqQyxHQUFBcG5DLEdBQ0FBLEVBQUE4UixPQUFBOHNCLFdBQUEsRUFHQSxTQUFBeUksR0FBQXJuQyxHQUVBQSxFQUFBOFIsT0FBQThzQixZQUNBNStCLEVBQUE4UixPQUFBOHNCLFdBQUEsRUFDQWtJLEdBQUE5bUMsRUFBQThSLE9BQUEsVUFHQSxTQUFBZzFCLEdBQUFoa0IsRUFBQXRMLEdBQ0EsSUFBQXhYLEVBQUF5RCxTQUFBdWtDLFlBQUEsY0FDQWhvQyxFQUFBaW9DLFVBQUF6d0IsR0FBQSxNQUNBc0wsRUFBQW9sQixjQUFBbG9DLEdBTUEsU0FBQW1vQyxHQUFBbDBCLEdBQ0EsT0FBQUEsRUFBQWYsbUJBQUFlLEVBQUE5UixNQUFBOFIsRUFBQTlSLEtBQUErMUIsV0FFQWprQixFQURBazBCLEdBQUFsMEIsRUFBQWYsa0JBQUFpUCxRQUlBLElBQUEyakIsSUFDQWo2QixLQUFBLFNBQUFpWCxFQUFBZ00sRUFBQTdhLEdBQ0EsSUFBQTNRLEVBQUF3ckIsRUFBQXhyQixNQUVBMlEsRUFBQWswQixHQUFBbDBCLEdBQ0EsSUFBQW0wQixFQUFBbjBCLEVBQUE5UixNQUFBOFIsRUFBQTlSLEtBQUErMUIsV0FDQW1RLEVBQUF2bEIsRUFBQXdsQixtQkFDQSxTQUFBeGxCLEVBQUFwYixNQUFBQyxRQUFBLEdBQUFtYixFQUFBcGIsTUFBQUMsUUFDQXJFLEdBQUE4a0MsR0FDQW4wQixFQUFBOVIsS0FBQTJqQyxNQUFBLEVBQ0E1QixHQUFBandCLEVBQUEsV0FDQTZPLEVBQUFwYixNQUFBQyxRQUFBMGdDLEtBR0F2bEIsRUFBQXBiLE1BQUFDLFFBQUFyRSxFQUFBK2tDLEVBQUEsUUFJQXAyQixPQUFBLFNBQUE2USxFQUFBZ00sRUFBQTdhLEdBQ0EsSUFBQTNRLEVBQUF3ckIsRUFBQXhyQixNQUNBNGlCLEVBQUE0SSxFQUFBNUksU0FHQSxJQUFBNWlCLEtBQUE0aUIsRUFBQSxDQUNBalMsRUFBQWswQixHQUFBbDBCLEdBQ0EsSUFBQW0wQixFQUFBbjBCLEVBQUE5UixNQUFBOFIsRUFBQTlSLEtBQUErMUIsV0FDQWtRLEdBQ0FuMEIsRUFBQTlSLEtBQUEyakMsTUFBQSxFQUNBeGlDLEVBQ0E0Z0MsR0FBQWp3QixFQUFBLFdBQ0E2TyxFQUFBcGIsTUFBQUMsUUFBQW1iLEVBQUF3bEIscUJBR0FwQyxHQUFBanlCLEVBQUEsV0FDQTZPLEVBQUFwYixNQUFBQyxRQUFBLFVBSUFtYixFQUFBcGIsTUFBQUMsUUFBQXJFLEVBQUF3ZixFQUFBd2xCLG1CQUFBLFNBSUFDLE9BQUEsU0FDQXpsQixFQUNBa2tCLEVBQ0EveUIsRUFDQWlaLEVBQ0F3TyxHQUVBQSxJQUNBNVksRUFBQXBiLE1BQUFDLFFBQUFtYixFQUFBd2xCLHNCQUtBRSxJQUNBOWEsTUFBQXFaLEdBQ0FqQixTQVFBMkMsSUFDQXpwQyxLQUFBZ0ksT0FDQTY5QixPQUFBdHJCLFFBQ0E2bkIsSUFBQTduQixRQUNBbXZCLEtBQUExaEMsT0FDQXdRLEtBQUF4USxPQUNBczZCLFdBQUF0NkIsT0FDQXk2QixXQUFBejZCLE9BQ0F1NkIsYUFBQXY2QixPQUNBMDZCLGFBQUExNkIsT0FDQXc2QixpQkFBQXg2QixPQUNBMjZCLGlCQUFBMzZCLE9BQ0FzOUIsWUFBQXQ5QixPQUNBdzlCLGtCQUFBeDlCLE9BQ0F1OUIsY0FBQXY5QixPQUNBZytCLFVBQUF6UyxPQUFBdnJCLE9BQUFqSSxTQUtBLFNBQUE0cEMsR0FBQTEwQixHQUNBLElBQUEyMEIsRUFBQTMwQixLQUFBckIsaUJBQ0EsT0FBQWcyQixLQUFBNzNCLEtBQUFoTSxRQUFBb2MsU0FDQXduQixHQUFBbnBCLEdBQUFvcEIsRUFBQW4yQixXQUVBd0IsRUFJQSxTQUFBNDBCLEdBQUExcUIsR0FDQSxJQUFBaGMsS0FDQTRDLEVBQUFvWixFQUFBdlksU0FFQSxRQUFBN0gsS0FBQWdILEVBQUFtVSxVQUNBL1csRUFBQXBFLEdBQUFvZ0IsRUFBQXBnQixHQUlBLElBQUE2aEIsRUFBQTdhLEVBQUE4YSxpQkFDQSxRQUFBcEksS0FBQW1JLEVBQ0F6ZCxFQUFBeUksRUFBQTZNLElBQUFtSSxFQUFBbkksR0FFQSxPQUFBdFYsRUFHQSxTQUFBMm1DLEdBQUE5aUMsRUFBQStpQyxHQUNBLG9CQUFBMzVCLEtBQUEyNUIsRUFBQXYyQixLQUNBLE9BQUF4TSxFQUFBLGNBQ0EwUixNQUFBcXhCLEVBQUFuMkIsaUJBQUFzRyxZQUtBLFNBQUE4dkIsR0FBQS8wQixHQUNBLE1BQUFBLElBQUEzTyxPQUNBLEdBQUEyTyxFQUFBOVIsS0FBQSsxQixXQUNBLFNBS0EsU0FBQStRLEdBQUFyMUIsRUFBQXMxQixHQUNBLE9BQUFBLEVBQUFuckMsTUFBQTZWLEVBQUE3VixLQUFBbXJDLEVBQUExMkIsTUFBQW9CLEVBQUFwQixJQUdBLElBQUEyMkIsSUFDQW5xQyxLQUFBLGFBQ0EwWSxNQUFBK3dCLEdBQ0F0bkIsVUFBQSxFQUVBNWMsT0FBQSxTQUFBeUIsR0FDQSxJQUFBdWEsRUFBQW5qQixLQUVBcVYsRUFBQXJWLEtBQUEwbUIsT0FBQW5LLFFBQ0EsR0FBQWxILElBS0FBLElBQUE2VixPQUFBLFNBQUF2ZCxHQUE2QyxPQUFBQSxFQUFBeUgsS0FBQWtCLEdBQUEzSSxLQUU3QzBILEVBQUFoUyxRQUFBLENBS0EsRUFRQSxJQUFBaW9DLEVBQUF0ckMsS0FBQXNyQyxLQUdBLEVBU0EsSUFBQUssRUFBQXQyQixFQUFBLEdBSUEsR0FBQXUyQixHQUFBNXJDLEtBQUFnSSxRQUNBLE9BQUEyakMsRUFLQSxJQUFBbjFCLEVBQUErMEIsR0FBQUksR0FFQSxJQUFBbjFCLEVBQ0EsT0FBQW0xQixFQUdBLEdBQUEzckMsS0FBQWdzQyxTQUNBLE9BQUFOLEdBQUE5aUMsRUFBQStpQyxHQU1BLElBQUFqbkMsRUFBQSxnQkFBQTFFLEtBQUEsU0FDQXdXLEVBQUE3VixJQUFBLE1BQUE2VixFQUFBN1YsSUFDQTZWLEVBQUFOLFVBQ0F4UixFQUFBLFVBQ0FBLEVBQUE4UixFQUFBcEIsSUFDQTlKLEVBQUFrTCxFQUFBN1YsS0FDQSxJQUFBaUosT0FBQTRNLEVBQUE3VixLQUFBc00sUUFBQXZJLEdBQUE4UixFQUFBN1YsSUFBQStELEVBQUE4UixFQUFBN1YsSUFDQTZWLEVBQUE3VixJQUVBLElBQUFvRSxHQUFBeVIsRUFBQXpSLE9BQUF5UixFQUFBelIsVUFBOEMrMUIsV0FBQTJRLEdBQUF6ckMsTUFDOUNpc0MsRUFBQWpzQyxLQUFBK2tCLE9BQ0ErbUIsRUFBQVAsR0FBQVUsR0FRQSxHQUpBejFCLEVBQUF6UixLQUFBaVcsWUFBQXhFLEVBQUF6UixLQUFBaVcsV0FBQW92QixLQUFBLFNBQUFwaEMsR0FBMEUsZUFBQUEsRUFBQXBILFNBQzFFNFUsRUFBQXpSLEtBQUEyakMsTUFBQSxHQUlBb0QsR0FDQUEsRUFBQS9tQyxPQUNBOG1DLEdBQUFyMUIsRUFBQXMxQixLQUNBeDFCLEdBQUF3MUIsTUFFQUEsRUFBQWgyQixvQkFBQWcyQixFQUFBaDJCLGtCQUFBaVAsT0FBQTdPLFdBQ0EsQ0FHQSxJQUFBOHBCLEVBQUE4TCxFQUFBL21DLEtBQUErMUIsV0FBQWpzQixLQUF3RDlKLEdBRXhELGNBQUF1bUMsRUFPQSxPQUxBdHJDLEtBQUFnc0MsVUFBQSxFQUNBdHNCLEdBQUFzZ0IsRUFBQSx3QkFDQTdjLEVBQUE2b0IsVUFBQSxFQUNBN29CLEVBQUFyQixpQkFFQTRwQixHQUFBOWlDLEVBQUEraUMsR0FDTyxjQUFBTCxFQUFBLENBQ1AsR0FBQWgxQixHQUFBRSxHQUNBLE9BQUF5MUIsRUFFQSxJQUFBQyxFQUNBOUMsRUFBQSxXQUF3QzhDLEtBQ3hDeHNCLEdBQUEzYSxFQUFBLGFBQUFxa0MsR0FDQTFwQixHQUFBM2EsRUFBQSxpQkFBQXFrQyxHQUNBMXBCLEdBQUFzZ0IsRUFBQSxzQkFBQThJLEdBQWdFb0QsRUFBQXBELEtBSWhFLE9BQUE2QyxLQWlCQXJ4QixHQUFBekwsR0FDQXVHLElBQUF4TCxPQUNBdWlDLFVBQUF2aUMsUUFDQ3loQyxXQUVEL3dCLEdBQUFneEIsS0FFQSxJQUFBYyxJQUNBOXhCLFNBRUFuVCxPQUFBLFNBQUF5QixHQVFBLElBUEEsSUFBQXdNLEVBQUFwVixLQUFBb1YsS0FBQXBWLEtBQUFnSSxPQUFBakQsS0FBQXFRLEtBQUEsT0FDQTNJLEVBQUE5SyxPQUFBa0osT0FBQSxNQUNBd2hDLEVBQUFyc0MsS0FBQXFzQyxhQUFBcnNDLEtBQUFxVixTQUNBaTNCLEVBQUF0c0MsS0FBQTBtQixPQUFBbkssWUFDQWxILEVBQUFyVixLQUFBcVYsWUFDQWszQixFQUFBZCxHQUFBenJDLE1BRUFzRCxFQUFBLEVBQW1CQSxFQUFBZ3BDLEVBQUFqcEMsT0FBd0JDLElBQUEsQ0FDM0MsSUFBQXFLLEVBQUEyK0IsRUFBQWhwQyxHQUNBLEdBQUFxSyxFQUFBeUgsSUFDQSxTQUFBekgsRUFBQWhOLEtBQUEsSUFBQWlKLE9BQUErRCxFQUFBaE4sS0FBQXNNLFFBQUEsV0FDQW9JLEVBQUFuUSxLQUFBeUksR0FDQWxCLEVBQUFrQixFQUFBaE4sS0FBQWdOLEdBQ1dBLEVBQUE1SSxPQUFBNEksRUFBQTVJLFVBQXVCKzFCLFdBQUF5UixRQVNsQyxHQUFBRixFQUFBLENBR0EsSUFGQSxJQUFBRyxLQUNBQyxLQUNBcHBCLEVBQUEsRUFBdUJBLEVBQUFncEIsRUFBQWhwQyxPQUEyQmdnQixJQUFBLENBQ2xELElBQUFxcEIsRUFBQUwsRUFBQWhwQixHQUNBcXBCLEVBQUEzbkMsS0FBQSsxQixXQUFBeVIsRUFDQUcsRUFBQTNuQyxLQUFBNG5DLElBQUFELEVBQUFuM0IsSUFBQXEzQix3QkFDQW5nQyxFQUFBaWdDLEVBQUEvckMsS0FDQTZyQyxFQUFBdG5DLEtBQUF3bkMsR0FFQUQsRUFBQXZuQyxLQUFBd25DLEdBR0Exc0MsS0FBQXdzQyxLQUFBNWpDLEVBQUF3TSxFQUFBLEtBQUFvM0IsR0FDQXhzQyxLQUFBeXNDLFVBR0EsT0FBQTdqQyxFQUFBd00sRUFBQSxLQUFBQyxJQUdBdzNCLGFBQUEsV0FFQTdzQyxLQUFBaWxCLFVBQ0FqbEIsS0FBQStrQixPQUNBL2tCLEtBQUF3c0MsTUFDQSxHQUNBLEdBRUF4c0MsS0FBQStrQixPQUFBL2tCLEtBQUF3c0MsTUFHQU0sUUFBQSxXQUNBLElBQUF6M0IsRUFBQXJWLEtBQUFxc0MsYUFDQUYsRUFBQW5zQyxLQUFBbXNDLFlBQUFuc0MsS0FBQTRCLE1BQUEsYUFDQXlULEVBQUFoUyxRQUFBckQsS0FBQStzQyxRQUFBMTNCLEVBQUEsR0FBQUUsSUFBQTQyQixLQU1BOTJCLEVBQUE2QixRQUFBODFCLElBQ0EzM0IsRUFBQTZCLFFBQUErMUIsSUFDQTUzQixFQUFBNkIsUUFBQWcyQixJQUtBbHRDLEtBQUFtdEMsUUFBQTltQyxTQUFBK21DLEtBQUFDLGFBRUFoNEIsRUFBQTZCLFFBQUEsU0FBQXZKLEdBQ0EsR0FBQUEsRUFBQTVJLEtBQUF1b0MsTUFBQSxDQUNBLElBQUE1bkIsRUFBQS9YLEVBQUE0SCxJQUNBc3hCLEVBQUFuaEIsRUFBQXBiLE1BQ0FnN0IsR0FBQTVmLEVBQUF5bUIsR0FDQXRGLEVBQUEwRyxVQUFBMUcsRUFBQTJHLGdCQUFBM0csRUFBQTRHLG1CQUFBLEdBQ0EvbkIsRUFBQWhnQixpQkFBQWsvQixHQUFBbGYsRUFBQWdvQixRQUFBLFNBQUF0dkIsRUFBQXhiLEdBQ0FBLElBQUEsYUFBQW9QLEtBQUFwUCxFQUFBK3FDLGdCQUNBam9CLEVBQUFvYSxvQkFBQThFLEdBQUF4bUIsR0FDQXNILEVBQUFnb0IsUUFBQSxLQUNBbEksR0FBQTlmLEVBQUF5bUIsV0FPQXpyQyxTQUNBcXNDLFFBQUEsU0FBQXJuQixFQUFBeW1CLEdBRUEsSUFBQTNILEdBQ0EsU0FHQSxHQUFBeGtDLEtBQUE0dEMsU0FDQSxPQUFBNXRDLEtBQUE0dEMsU0FPQSxJQUFBeGUsRUFBQTFKLEVBQUFtb0IsWUFDQW5vQixFQUFBeWEsb0JBQ0F6YSxFQUFBeWEsbUJBQUFqcEIsUUFBQSxTQUFBK29CLEdBQXNENEQsR0FBQXpVLEVBQUE2USxLQUV0RHlELEdBQUF0VSxFQUFBK2MsR0FDQS9jLEVBQUE5a0IsTUFBQUMsUUFBQSxPQUNBdkssS0FBQTZrQixJQUFBamYsWUFBQXdwQixHQUNBLElBQUFyUyxFQUFBNG9CLEdBQUF2VyxHQUVBLE9BREFwdkIsS0FBQTZrQixJQUFBaGYsWUFBQXVwQixHQUNBcHZCLEtBQUE0dEMsU0FBQTd3QixFQUFBMHBCLGdCQUtBLFNBQUF1RyxHQUFBci9CLEdBRUFBLEVBQUE0SCxJQUFBbTRCLFNBQ0EvL0IsRUFBQTRILElBQUFtNEIsVUFHQS8vQixFQUFBNEgsSUFBQTB4QixVQUNBdDVCLEVBQUE0SCxJQUFBMHhCLFdBSUEsU0FBQWdHLEdBQUF0L0IsR0FDQUEsRUFBQTVJLEtBQUErb0MsT0FBQW5nQyxFQUFBNEgsSUFBQXEzQix3QkFHQSxTQUFBTSxHQUFBdi9CLEdBQ0EsSUFBQW9nQyxFQUFBcGdDLEVBQUE1SSxLQUFBNG5DLElBQ0FtQixFQUFBbmdDLEVBQUE1SSxLQUFBK29DLE9BQ0FFLEVBQUFELEVBQUFFLEtBQUFILEVBQUFHLEtBQ0FDLEVBQUFILEVBQUFJLElBQUFMLEVBQUFLLElBQ0EsR0FBQUgsR0FBQUUsRUFBQSxDQUNBdmdDLEVBQUE1SSxLQUFBdW9DLE9BQUEsRUFDQSxJQUFBekcsRUFBQWw1QixFQUFBNEgsSUFBQWpMLE1BQ0F1OEIsRUFBQTBHLFVBQUExRyxFQUFBMkcsZ0JBQUEsYUFBQVEsRUFBQSxNQUFBRSxFQUFBLE1BQ0FySCxFQUFBNEcsbUJBQUEsTUFJQSxJQUFBVyxJQUNBckMsY0FDQUssb0JBTUFucEIsR0FBQTVTLE9BQUFlLGVBQ0E2UixHQUFBNVMsT0FBQVUsaUJBQ0FrUyxHQUFBNVMsT0FBQVcsa0JBQ0FpUyxHQUFBNVMsT0FBQWEsbUJBQ0ErUixHQUFBNVMsT0FBQVksb0JBR0FwQyxFQUFBb1UsR0FBQXRiLFFBQUFxVCxXQUFBb3dCLElBQ0F2OEIsRUFBQW9VLEdBQUF0YixRQUFBK3FCLFdBQUEwYixJQUdBbnJCLEdBQUE5aEIsVUFBQThqQixVQUFBN1MsRUFBQW8zQixHQUFBdDZCLEVBR0ErVCxHQUFBOWhCLFVBQUEwdUIsT0FBQSxTQUNBbkssRUFDQWhCLEdBR0EsT0FEQWdCLEtBQUF0VCxFQUFBdWxCLEdBQUFqUyxRQUFBbmtCLEVBQ0Fra0IsR0FBQXpsQixLQUFBMGxCLEVBQUFoQixJQUtBdFMsR0FDQXRNLFdBQUEsV0FDQXVLLEVBQUFJLFVBQ0FBLElBQ0FBLEdBQUFtWCxLQUFBLE9BQUEzRSxLQXVCRyxHQUtIaGEsRUFBQSx1REMvMVBBLElBQUFvbEMsRUFBQXB2QyxFQUFBLE9BQUFBLENBQUEsT0FDQWtWLEVBQUFsVixFQUFBLFFBQ0E2VSxFQUFBN1UsRUFBQSxRQUFBNlUsT0FDQXc2QixFQUFBLG1CQUFBeDZCLEVBRUF5NkIsRUFBQXR1QyxFQUFBQyxRQUFBLFNBQUEwQixHQUNBLE9BQUF5c0MsRUFBQXpzQyxLQUFBeXNDLEVBQUF6c0MsR0FDQTBzQyxHQUFBeDZCLEVBQUFsUyxLQUFBMHNDLEVBQUF4NkIsRUFBQUssR0FBQSxVQUFBdlMsS0FHQTJzQyxFQUFBRiw4QkNWQXB1QyxFQUFBQyxTQUFBLHdCQ0FBLElBQUF1TCxLQUFpQkEsU0FFakJ4TCxFQUFBQyxRQUFBLFNBQUFzRyxHQUNBLE9BQUFpRixFQUFBL0osS0FBQThFLEdBQUF1SCxNQUFBLDZCQ0ZBOU4sRUFBQUMsUUFBQSxTQUFBMEUsRUFBQUksRUFBQXdwQyxHQUNBLElBQUFDLE9BQUFsdEMsSUFBQWl0QyxFQUNBLE9BQUF4cEMsRUFBQTNCLFFBQ0EsY0FBQW9yQyxFQUFBN3BDLElBQ0FBLEVBQUFsRCxLQUFBOHNDLEdBQ0EsY0FBQUMsRUFBQTdwQyxFQUFBSSxFQUFBLElBQ0FKLEVBQUFsRCxLQUFBOHNDLEVBQUF4cEMsRUFBQSxJQUNBLGNBQUF5cEMsRUFBQTdwQyxFQUFBSSxFQUFBLEdBQUFBLEVBQUEsSUFDQUosRUFBQWxELEtBQUE4c0MsRUFBQXhwQyxFQUFBLEdBQUFBLEVBQUEsSUFDQSxjQUFBeXBDLEVBQUE3cEMsRUFBQUksRUFBQSxHQUFBQSxFQUFBLEdBQUFBLEVBQUEsSUFDQUosRUFBQWxELEtBQUE4c0MsRUFBQXhwQyxFQUFBLEdBQUFBLEVBQUEsR0FBQUEsRUFBQSxJQUNBLGNBQUF5cEMsRUFBQTdwQyxFQUFBSSxFQUFBLEdBQUFBLEVBQUEsR0FBQUEsRUFBQSxHQUFBQSxFQUFBLElBQ0FKLEVBQUFsRCxLQUFBOHNDLEVBQUF4cEMsRUFBQSxHQUFBQSxFQUFBLEdBQUFBLEVBQUEsR0FBQUEsRUFBQSxJQUNHLE9BQUFKLEVBQUEwSixNQUFBa2dDLEVBQUF4cEMsNEJDZEgsSUFBQWhDLEVBQUEvRCxFQUFBLFFBQ0F5dkMsRUFBQXp2QyxFQUFBLFFBQ0FnQixFQUFBQyxRQUFBakIsRUFBQSxpQkFBQTB2QyxFQUFBaHVDLEVBQUF1RixHQUNBLE9BQUFsRCxFQUFBTyxFQUFBb3JDLEVBQUFodUMsRUFBQSt0QyxFQUFBLEVBQUF4b0MsS0FDQyxTQUFBeW9DLEVBQUFodUMsRUFBQXVGLEdBRUQsT0FEQXlvQyxFQUFBaHVDLEdBQUF1RixFQUNBeW9DLDJCQ0xBLElBQUF0dkMsRUFBQUosRUFBQSxRQUNBUSxFQUFBUixFQUFBLE9BQUFBLENBQUEsWUFDQTJ2QyxFQUFBaGdDLE1BQUF6TixVQUVBbEIsRUFBQUMsUUFBQSxTQUFBc0csR0FDQSxZQUFBakYsSUFBQWlGLElBQUFuSCxFQUFBdVAsUUFBQXBJLEdBQUFvb0MsRUFBQW52QyxLQUFBK0csNEJDTEEsSUFBQTBDLEVBQUFqSyxFQUFBLFFBQ0ErUCxFQUFBL1AsRUFBQSxRQUNBNkssRUFBQTdLLEVBQUEsT0FBQUEsQ0FBQSxZQUNBNHZDLEVBQUFsdEMsT0FBQVIsVUFFQWxCLEVBQUFDLFFBQUF5QixPQUFBbkMsZ0JBQUEsU0FBQXVELEdBRUEsT0FEQUEsRUFBQWlNLEVBQUFqTSxHQUNBbUcsRUFBQW5HLEVBQUErRyxHQUFBL0csRUFBQStHLEdBQ0EsbUJBQUEvRyxFQUFBaXZCLGFBQUFqdkIsZUFBQWl2QixZQUNBanZCLEVBQUFpdkIsWUFBQTd3QixVQUNHNEIsYUFBQXBCLE9BQUFrdEMsRUFBQSwyQ0NWSCxJQUFBaGtDLEVBQUE1TCxFQUFBLFFBQ0E2dkMsRUFBQTd2QyxFQUFBLFFBQ0FNLEVBQUFOLEVBQUEsUUFDQTJCLEtBR0EzQixFQUFBLE9BQUFBLENBQUEyQixFQUFBM0IsRUFBQSxPQUFBQSxDQUFBLHVCQUFrRixPQUFBZSxPQUVsRkMsRUFBQUMsUUFBQSxTQUFBRyxFQUFBRCxFQUFBRSxHQUNBRCxFQUFBYyxVQUFBMEosRUFBQWpLLEdBQXFETixLQUFBd3VDLEVBQUEsRUFBQXh1QyxLQUNyRGYsRUFBQWMsRUFBQUQsRUFBQSxrQ0NWQSxJQUFBMnVDLEVBQUFoakMsS0FBQWdqQyxLQUNBL2lDLEVBQUFELEtBQUFDLE1BQ0EvTCxFQUFBQyxRQUFBLFNBQUFzRyxHQUNBLE9BQUE2RixNQUFBN0YsTUFBQSxHQUFBQSxFQUFBLEVBQUF3RixFQUFBK2lDLEdBQUF2b0Msd0JDSkF2RyxFQUFBQyxRQUFBLFNBQUE4dUMsRUFBQTlvQyxHQUNBLE9BQ0F1TCxhQUFBLEVBQUF1OUIsR0FDQXA5QixlQUFBLEVBQUFvOUIsR0FDQXI5QixXQUFBLEVBQUFxOUIsR0FDQTlvQyxrQ0NMQSxJQUFBdkMsRUFBQTFFLEVBQUEsUUFDQXlDLEVBQUF6QyxFQUFBLFFBQ0Fnd0MsRUFBQWh3QyxFQUFBLFFBQ0FnRSxFQUFBaEUsRUFBQSxRQUNBaXdDLEVBQUFqd0MsRUFBQSxRQUNBa3dDLEVBQUFsd0MsRUFBQSxRQUNBbXdDLEtBQ0FDLEtBQ0FudkMsRUFBQUQsRUFBQUMsUUFBQSxTQUFBb3ZDLEVBQUE3dEMsRUFBQW1ELEVBQUE0cEMsRUFBQS91QyxHQUNBLElBR0E0RCxFQUFBa3NDLEVBQUF0cEMsRUFBQTZFLEVBSEEwa0MsRUFBQS92QyxFQUFBLFdBQXVDLE9BQUE2dkMsR0FBbUJILEVBQUFHLEdBQzFEL3JDLEVBQUFJLEVBQUFpQixFQUFBNHBDLEVBQUEvc0MsRUFBQSxLQUNBdUwsRUFBQSxFQUVBLHNCQUFBd2lDLEVBQUEsTUFBQUMsVUFBQUgsRUFBQSxxQkFFQSxHQUFBTCxFQUFBTyxJQUFBLElBQUFuc0MsRUFBQTZyQyxFQUFBSSxFQUFBanNDLFFBQW1FQSxFQUFBMkosRUFBZ0JBLElBRW5GLEdBREFsQyxFQUFBckosRUFBQThCLEVBQUFOLEVBQUFzc0MsRUFBQUQsRUFBQXRpQyxJQUFBLEdBQUF1aUMsRUFBQSxJQUFBaHNDLEVBQUErckMsRUFBQXRpQyxJQUNBbEMsSUFBQXNrQyxHQUFBdGtDLElBQUF1a0MsRUFBQSxPQUFBdmtDLE9BQ0csSUFBQTdFLEVBQUF1cEMsRUFBQTl0QyxLQUFBNHRDLEtBQTRDQyxFQUFBdHBDLEVBQUEzRixRQUFBb3ZDLE1BRS9DLEdBREE1a0MsRUFBQXBKLEVBQUF1RSxFQUFBMUMsRUFBQWdzQyxFQUFBcnBDLE1BQUF6RSxHQUNBcUosSUFBQXNrQyxHQUFBdGtDLElBQUF1a0MsRUFBQSxPQUFBdmtDLEdBR0E1SyxFQUFBa3ZDLFFBQ0FsdkMsRUFBQW12QyxpQ0N2QkEsSUFBQU0sRUFBQTF3QyxFQUFBLFFBQ0FnQixFQUFBQyxRQUFBLFNBQUFzRyxHQUNBLE9BQUE3RSxPQUFBZ3VDLEVBQUFucEMsMENDRkEsSUF3QkFvcEMsRUFBQUMsRUFBQUMsRUFBQUMsRUF4QkEvd0MsRUFBQUMsRUFBQSxRQUNBZ0QsRUFBQWhELEVBQUEsUUFDQTBFLEVBQUExRSxFQUFBLFFBQ0E4SCxFQUFBOUgsRUFBQSxRQUNBQyxFQUFBRCxFQUFBLFFBQ0FtSCxFQUFBbkgsRUFBQSxRQUNBK3dDLEVBQUEvd0MsRUFBQSxRQUNBZ3hDLEVBQUFoeEMsRUFBQSxRQUNBaXhDLEVBQUFqeEMsRUFBQSxRQUNBaUQsRUFBQWpELEVBQUEsUUFDQWt4QyxFQUFBbHhDLEVBQUEsUUFBQThHLElBQ0FxcUMsRUFBQW54QyxFQUFBLE9BQUFBLEdBQ0FveEMsRUFBQXB4QyxFQUFBLFFBQ0FxeEMsRUFBQXJ4QyxFQUFBLFFBQ0EyVCxFQUFBM1QsRUFBQSxRQUNBa0QsRUFBQWxELEVBQUEsUUFDQXN4QyxFQUFBLFVBQ0FkLEVBQUF4dEMsRUFBQXd0QyxVQUNBMXJDLEVBQUE5QixFQUFBOEIsUUFDQXlzQyxFQUFBenNDLEtBQUF5c0MsU0FDQUMsRUFBQUQsS0FBQUMsSUFBQSxHQUNBQyxFQUFBenVDLEVBQUFzdUMsR0FDQUksRUFBQSxXQUFBNXBDLEVBQUFoRCxHQUNBNnNDLEVBQUEsYUFFQUMsRUFBQWhCLEVBQUFRLEVBQUE5c0MsRUFFQXV0QyxJQUFBLFdBQ0EsSUFFQSxJQUFBQyxFQUFBTCxFQUFBenlCLFFBQUEsR0FDQSt5QixHQUFBRCxFQUFBL2UsZ0JBQStDL3lCLEVBQUEsT0FBQUEsQ0FBQSxxQkFBQWd5QyxHQUMvQ0EsRUFBQUwsTUFHQSxPQUFBRCxHQUFBLG1CQUFBTyx3QkFDQUgsRUFBQXJ1QyxLQUFBa3VDLGFBQUFJLEdBSUEsSUFBQVAsRUFBQXhqQyxRQUFBLFNBQ0EsSUFBQTJGLEVBQUEzRixRQUFBLGFBQ0csTUFBQXJLLEtBZkgsR0FtQkF1dUMsRUFBQSxTQUFBM3FDLEdBQ0EsSUFBQTlELEVBQ0EsU0FBQTBELEVBQUFJLElBQUEsbUJBQUE5RCxFQUFBOEQsRUFBQTlELFdBRUFrUyxFQUFBLFNBQUFtOEIsRUFBQUssR0FDQSxJQUFBTCxFQUFBcmpCLEdBQUEsQ0FDQXFqQixFQUFBcmpCLElBQUEsRUFDQSxJQUFBMmpCLEVBQUFOLEVBQUFqaUIsR0FDQXNoQixFQUFBLFdBQ0EsSUFBQWxxQyxFQUFBNnFDLEVBQUEzaUIsR0FDQWtqQixFQUFBLEdBQUFQLEVBQUFwakIsR0FDQXJxQixFQUFBLEVBQ0FtQixFQUFBLFNBQUE4c0MsR0FDQSxJQUlBem1DLEVBQUFwSSxFQUFBOHVDLEVBSkFubkIsRUFBQWluQixFQUFBQyxFQUFBRCxHQUFBQyxFQUFBRSxLQUNBeHpCLEVBQUFzekIsRUFBQXR6QixRQUNBOEQsRUFBQXd2QixFQUFBeHZCLE9BQ0EydkIsRUFBQUgsRUFBQUcsT0FFQSxJQUNBcm5CLEdBQ0FpbkIsSUFDQSxHQUFBUCxFQUFBWSxJQUFBQyxFQUFBYixHQUNBQSxFQUFBWSxHQUFBLElBRUEsSUFBQXRuQixFQUFBdmYsRUFBQTVFLEdBRUF3ckMsS0FBQTVLLFFBQ0FoOEIsRUFBQXVmLEVBQUFua0IsR0FDQXdyQyxJQUNBQSxFQUFBRyxPQUNBTCxHQUFBLElBR0ExbUMsSUFBQXltQyxFQUFBUixRQUNBaHZCLEVBQUEwdEIsRUFBQSx5QkFDVy9zQyxFQUFBeXVDLEVBQUFybUMsSUFDWHBJLEVBQUFoQixLQUFBb0osRUFBQW1ULEVBQUE4RCxHQUNXOUQsRUFBQW5ULElBQ0ZpWCxFQUFBN2IsR0FDRixNQUFBdEQsR0FDUDh1QyxJQUFBRixHQUFBRSxFQUFBRyxPQUNBOXZCLEVBQUFuZixLQUdBLE1BQUF5dUMsRUFBQWh1QyxPQUFBQyxFQUFBbUIsRUFBQTRzQyxFQUFBL3RDLE1BQ0F5dEMsRUFBQWppQixNQUNBaWlCLEVBQUFyakIsSUFBQSxFQUNBMGpCLElBQUFMLEVBQUFZLElBQUFHLEVBQUFmLE9BR0FlLEVBQUEsU0FBQWYsR0FDQVosRUFBQXp1QyxLQUFBTyxFQUFBLFdBQ0EsSUFFQTZJLEVBQUF1ZixFQUFBOU0sRUFGQXJYLEVBQUE2cUMsRUFBQTNpQixHQUNBMmpCLEVBQUFDLEVBQUFqQixHQWVBLEdBYkFnQixJQUNBam5DLEVBQUF3bEMsRUFBQSxXQUNBSyxFQUNBNXNDLEVBQUE2akIsS0FBQSxxQkFBQTFoQixFQUFBNnFDLElBQ1MxbUIsRUFBQXBvQixFQUFBZ3dDLHNCQUNUNW5CLEdBQW1CMG1CLFVBQUEvdUIsT0FBQTliLEtBQ1ZxWCxFQUFBdGIsRUFBQXNiLFlBQUFDLE9BQ1RELEVBQUFDLE1BQUEsOEJBQUF0WCxLQUlBNnFDLEVBQUFZLEdBQUFoQixHQUFBcUIsRUFBQWpCLEdBQUEsS0FDS0EsRUFBQW1CLFFBQUEzd0MsRUFDTHd3QyxHQUFBam5DLEVBQUFsSSxFQUFBLE1BQUFrSSxFQUFBSSxLQUdBOG1DLEVBQUEsU0FBQWpCLEdBQ0EsV0FBQUEsRUFBQVksSUFBQSxLQUFBWixFQUFBbUIsSUFBQW5CLEVBQUFqaUIsSUFBQXpyQixRQUVBdXVDLEVBQUEsU0FBQWIsR0FDQVosRUFBQXp1QyxLQUFBTyxFQUFBLFdBQ0EsSUFBQW9vQixFQUNBc21CLEVBQ0E1c0MsRUFBQTZqQixLQUFBLG1CQUFBbXBCLElBQ0sxbUIsRUFBQXBvQixFQUFBa3dDLHFCQUNMOW5CLEdBQWUwbUIsVUFBQS91QixPQUFBK3VCLEVBQUEzaUIsUUFJZmdrQixFQUFBLFNBQUFsc0MsR0FDQSxJQUFBNnFDLEVBQUEvd0MsS0FDQSt3QyxFQUFBc0IsS0FDQXRCLEVBQUFzQixJQUFBLEVBQ0F0QixJQUFBdUIsSUFBQXZCLEVBQ0FBLEVBQUEzaUIsR0FBQWxvQixFQUNBNnFDLEVBQUFwakIsR0FBQSxFQUNBb2pCLEVBQUFtQixLQUFBbkIsRUFBQW1CLEdBQUFuQixFQUFBamlCLEdBQUEvZ0IsU0FDQTZHLEVBQUFtOEIsR0FBQSxLQUVBd0IsRUFBQSxTQUFBcnNDLEdBQ0EsSUFDQXhELEVBREFxdUMsRUFBQS93QyxLQUVBLElBQUErd0MsRUFBQXNCLEdBQUEsQ0FDQXRCLEVBQUFzQixJQUFBLEVBQ0F0QixJQUFBdUIsSUFBQXZCLEVBQ0EsSUFDQSxHQUFBQSxJQUFBN3FDLEVBQUEsTUFBQXVwQyxFQUFBLHFDQUNBL3NDLEVBQUF5dUMsRUFBQWpyQyxJQUNBa3FDLEVBQUEsV0FDQSxJQUFBb0MsR0FBdUJGLEdBQUF2QixFQUFBc0IsSUFBQSxHQUN2QixJQUNBM3ZDLEVBQUFoQixLQUFBd0UsRUFBQXZDLEVBQUE0dUMsRUFBQUMsRUFBQSxHQUFBN3VDLEVBQUF5dUMsRUFBQUksRUFBQSxJQUNTLE1BQUE1dkMsR0FDVHd2QyxFQUFBMXdDLEtBQUE4d0MsRUFBQTV2QyxPQUlBbXVDLEVBQUEzaUIsR0FBQWxvQixFQUNBNnFDLEVBQUFwakIsR0FBQSxFQUNBL1ksRUFBQW04QixHQUFBLElBRUcsTUFBQW51QyxHQUNId3ZDLEVBQUExd0MsTUFBa0I0d0MsR0FBQXZCLEVBQUFzQixJQUFBLEdBQXlCenZDLE1BSzNDa3VDLElBRUFKLEVBQUEsU0FBQStCLEdBQ0F4QyxFQUFBandDLEtBQUEwd0MsRUFBQUgsRUFBQSxNQUNBUCxFQUFBeUMsR0FDQTdDLEVBQUFsdUMsS0FBQTFCLE1BQ0EsSUFDQXl5QyxFQUFBOXVDLEVBQUE0dUMsRUFBQXZ5QyxLQUFBLEdBQUEyRCxFQUFBeXVDLEVBQUFweUMsS0FBQSxJQUNLLE1BQUE4YyxHQUNMczFCLEVBQUExd0MsS0FBQTFCLEtBQUE4YyxLQUlBOHlCLEVBQUEsU0FBQTZDLEdBQ0F6eUMsS0FBQTh1QixNQUNBOXVCLEtBQUFreUMsUUFBQTN3QyxFQUNBdkIsS0FBQTJ0QixHQUFBLEVBQ0EzdEIsS0FBQXF5QyxJQUFBLEVBQ0FyeUMsS0FBQW91QixRQUFBN3NCLEVBQ0F2QixLQUFBMnhDLEdBQUEsRUFDQTN4QyxLQUFBMHRCLElBQUEsR0FFQWtpQixFQUFBenVDLFVBQUFsQyxFQUFBLE9BQUFBLENBQUF5eEMsRUFBQXZ2QyxXQUVBdUIsS0FBQSxTQUFBZ3dDLEVBQUFDLEdBQ0EsSUFBQXBCLEVBQUFWLEVBQUEzdUMsRUFBQWxDLEtBQUEwd0MsSUFPQSxPQU5BYSxFQUFBRCxHQUFBLG1CQUFBb0IsS0FDQW5CLEVBQUFFLEtBQUEsbUJBQUFrQixLQUNBcEIsRUFBQUcsT0FBQWYsRUFBQTVzQyxFQUFBMnRDLFlBQUFud0MsRUFDQXZCLEtBQUE4dUIsR0FBQTVwQixLQUFBcXNDLEdBQ0F2eEMsS0FBQWt5QyxJQUFBbHlDLEtBQUFreUMsR0FBQWh0QyxLQUFBcXNDLEdBQ0F2eEMsS0FBQTJ0QixJQUFBL1ksRUFBQTVVLE1BQUEsR0FDQXV4QyxFQUFBUixTQUdBNkIsTUFBQSxTQUFBRCxHQUNBLE9BQUEzeUMsS0FBQTBDLFVBQUFuQixFQUFBb3hDLE1BR0E3QyxFQUFBLFdBQ0EsSUFBQWlCLEVBQUEsSUFBQW5CLEVBQ0E1dkMsS0FBQSt3QyxVQUNBL3dDLEtBQUFpZSxRQUFBdGEsRUFBQTR1QyxFQUFBeEIsRUFBQSxHQUNBL3dDLEtBQUEraEIsT0FBQXBlLEVBQUF5dUMsRUFBQXJCLEVBQUEsSUFFQVYsRUFBQTlzQyxFQUFBc3RDLEVBQUEsU0FBQXR1QyxHQUNBLE9BQUFBLElBQUFtdUMsR0FBQW51QyxJQUFBd3RDLEVBQ0EsSUFBQUQsRUFBQXZ0QyxHQUNBc3RDLEVBQUF0dEMsS0FJQXJELElBQUEyekMsRUFBQTN6QyxFQUFBNHpDLEVBQUE1ekMsRUFBQTZDLEdBQUErdUMsR0FBMER0dUMsUUFBQWt1QyxJQUMxRHp4QyxFQUFBLE9BQUFBLENBQUF5eEMsRUFBQUgsR0FDQXR4QyxFQUFBLE9BQUFBLENBQUFzeEMsR0FDQVIsRUFBQTl3QyxFQUFBLFFBQUFzeEMsR0FHQXJ4QyxJQUFBNnpDLEVBQUE3ekMsRUFBQTZDLEdBQUErdUMsRUFBQVAsR0FFQXh1QixPQUFBLFNBQUFpeEIsR0FDQSxJQUFBQyxFQUFBcEMsRUFBQTd3QyxNQUNBa3pDLEVBQUFELEVBQUFseEIsT0FFQSxPQURBbXhCLEVBQUFGLEdBQ0FDLEVBQUFsQyxXQUdBN3hDLElBQUE2ekMsRUFBQTd6QyxFQUFBNkMsR0FBQS9DLElBQUE4eEMsR0FBQVAsR0FFQXR5QixRQUFBLFNBQUF0YixHQUNBLE9BQUFSLEVBQUFuRCxHQUFBZ0IsT0FBQSt2QyxFQUFBVyxFQUFBMXdDLEtBQUEyQyxNQUdBekQsSUFBQTZ6QyxFQUFBN3pDLEVBQUE2QyxJQUFBK3VDLEdBQUE3eEMsRUFBQSxPQUFBQSxDQUFBLFNBQUFrMEMsR0FDQXpDLEVBQUEwQyxJQUFBRCxHQUFBLFNBQUF2QyxNQUNDTCxHQUVENkMsSUFBQSxTQUFBOUQsR0FDQSxJQUFBL3NDLEVBQUF2QyxLQUNBaXpDLEVBQUFwQyxFQUFBdHVDLEdBQ0EwYixFQUFBZzFCLEVBQUFoMUIsUUFDQThELEVBQUFreEIsRUFBQWx4QixPQUNBalgsRUFBQXdsQyxFQUFBLFdBQ0EsSUFBQXp1QyxLQUNBbUwsRUFBQSxFQUNBcW1DLEVBQUEsRUFDQW5ELEVBQUFaLEdBQUEsV0FBQXlCLEdBQ0EsSUFBQXVDLEVBQUF0bUMsSUFDQXVtQyxHQUFBLEVBQ0ExeEMsRUFBQXFELFVBQUEzRCxHQUNBOHhDLElBQ0E5d0MsRUFBQTBiLFFBQUE4eUIsR0FBQXJ1QyxLQUFBLFNBQUF3RCxHQUNBcXRDLElBQ0FBLEdBQUEsRUFDQTF4QyxFQUFBeXhDLEdBQUFwdEMsSUFDQW10QyxHQUFBcDFCLEVBQUFwYyxLQUNTa2dCLE9BRVRzeEIsR0FBQXAxQixFQUFBcGMsS0FHQSxPQURBaUosRUFBQWxJLEdBQUFtZixFQUFBalgsRUFBQUksR0FDQStuQyxFQUFBbEMsU0FHQXlDLEtBQUEsU0FBQWxFLEdBQ0EsSUFBQS9zQyxFQUFBdkMsS0FDQWl6QyxFQUFBcEMsRUFBQXR1QyxHQUNBd2YsRUFBQWt4QixFQUFBbHhCLE9BQ0FqWCxFQUFBd2xDLEVBQUEsV0FDQUosRUFBQVosR0FBQSxXQUFBeUIsR0FDQXh1QyxFQUFBMGIsUUFBQTh5QixHQUFBcnVDLEtBQUF1d0MsRUFBQWgxQixRQUFBOEQsT0FJQSxPQURBalgsRUFBQWxJLEdBQUFtZixFQUFBalgsRUFBQUksR0FDQStuQyxFQUFBbEMsaUNDM1JBLElBQUEvdUMsRUFBQS9DLEVBQUEsUUFDQWdELEVBQUFoRCxFQUFBLFFBQ0F3MEMsRUFBQSxxQkFDQXBGLEVBQUFwc0MsRUFBQXd4QyxLQUFBeHhDLEVBQUF3eEMsUUFFQXh6QyxFQUFBQyxRQUFBLFNBQUFTLEVBQUF1RixHQUNBLE9BQUFtb0MsRUFBQTF0QyxLQUFBMHRDLEVBQUExdEMsUUFBQVksSUFBQTJFLFVBQ0MsZUFBQWhCLE1BQ0Q0d0IsUUFBQTl6QixFQUFBOHpCLFFBQ0F3VixLQUFBcnNDLEVBQUEsd0JBQ0F5MEMsVUFBQSxpRUNWQSxJQUFBenhDLEVBQUFoRCxFQUFBLFFBQ0ErQyxFQUFBL0MsRUFBQSxRQUNBRyxFQUFBSCxFQUFBLFFBQ0FFLEVBQUFGLEVBQUEsUUFDQTBFLEVBQUExRSxFQUFBLFFBQ0ErSyxFQUFBLFlBRUE5SyxFQUFBLFNBQUFrYixFQUFBeFksRUFBQXdwQixHQUNBLElBUUF6cUIsRUFBQWd6QyxFQUFBQyxFQUFBQyxFQVJBQyxFQUFBMTVCLEVBQUFsYixFQUFBNkMsRUFDQWd5QyxFQUFBMzVCLEVBQUFsYixFQUFBMnpDLEVBQ0FtQixFQUFBNTVCLEVBQUFsYixFQUFBNnpDLEVBQ0FrQixFQUFBNzVCLEVBQUFsYixFQUFBNEMsRUFDQW95QyxFQUFBOTVCLEVBQUFsYixFQUFBMkgsRUFDQTZOLEVBQUFxL0IsRUFBQTl4QyxFQUFBK3hDLEVBQUEveEMsRUFBQUwsS0FBQUssRUFBQUwsUUFBa0ZLLEVBQUFMLFFBQXVCb0ksR0FDekc5SixFQUFBNnpDLEVBQUEveEMsSUFBQUosS0FBQUksRUFBQUosT0FDQXV5QyxFQUFBajBDLEVBQUE4SixLQUFBOUosRUFBQThKLE9BR0EsSUFBQXJKLEtBREFvekMsSUFBQTNvQixFQUFBeHBCLEdBQ0F3cEIsRUFFQXVvQixHQUFBRyxHQUFBcC9CLFFBQUFuVCxJQUFBbVQsRUFBQS9ULEdBRUFpekMsR0FBQUQsRUFBQWovQixFQUFBMFcsR0FBQXpxQixHQUVBa3pDLEVBQUFLLEdBQUFQLEVBQUFod0MsRUFBQWl3QyxFQUFBM3hDLEdBQUFneUMsR0FBQSxtQkFBQUwsRUFBQWp3QyxFQUFBd0IsU0FBQXpELEtBQUFreUMsS0FFQWwvQixHQUFBdlYsRUFBQXVWLEVBQUEvVCxFQUFBaXpDLEVBQUF4NUIsRUFBQWxiLEVBQUFrMUMsR0FFQWwwQyxFQUFBUyxJQUFBaXpDLEdBQUF4MEMsRUFBQWMsRUFBQVMsRUFBQWt6QyxHQUNBSSxHQUFBRSxFQUFBeHpDLElBQUFpekMsSUFBQU8sRUFBQXh6QyxHQUFBaXpDLElBR0EzeEMsRUFBQUQsT0FFQTlDLEVBQUE2QyxFQUFBLEVBQ0E3QyxFQUFBMnpDLEVBQUEsRUFDQTN6QyxFQUFBNnpDLEVBQUEsRUFDQTd6QyxFQUFBNEMsRUFBQSxFQUNBNUMsRUFBQTJILEVBQUEsR0FDQTNILEVBQUE0ekMsRUFBQSxHQUNBNXpDLEVBQUFrMUMsRUFBQSxHQUNBbDFDLEVBQUFrRCxFQUFBLElBQ0FuQyxFQUFBQyxRQUFBaEIsMEJDMUNBLElBQUFPLEVBQUFSLEVBQUEsT0FBQUEsQ0FBQSxZQUNBbzFDLEdBQUEsRUFFQSxJQUNBLElBQUFDLEdBQUEsR0FBQTcwQyxLQUNBNjBDLEVBQUEscUJBQWlDRCxHQUFBLEdBRWpDemxDLE1BQUE2SyxLQUFBNjZCLEVBQUEsV0FBaUMsVUFDaEMsTUFBQTF4QyxJQUVEM0MsRUFBQUMsUUFBQSxTQUFBK3dDLEVBQUFzRCxHQUNBLElBQUFBLElBQUFGLEVBQUEsU0FDQSxJQUFBM3FDLEdBQUEsRUFDQSxJQUNBLElBQUFvRCxHQUFBLEdBQ0FxbUMsRUFBQXJtQyxFQUFBck4sS0FDQTB6QyxFQUFBN3lDLEtBQUEsV0FBNkIsT0FBU292QyxLQUFBaG1DLEdBQUEsSUFDdENvRCxFQUFBck4sR0FBQSxXQUFpQyxPQUFBMHpDLEdBQ2pDbEMsRUFBQW5rQyxHQUNHLE1BQUFsSyxJQUNILE9BQUE4RywyQkNwQkEsSUFBQThxQyxFQUFBdjFDLEVBQUEsT0FBQUEsQ0FBQSxRQUNBa1YsRUFBQWxWLEVBQUEsUUFDQWdCLEVBQUFDLFFBQUEsU0FBQVMsR0FDQSxPQUFBNnpDLEVBQUE3ekMsS0FBQTZ6QyxFQUFBN3pDLEdBQUF3VCxFQUFBeFQsNkJDRkEsSUFBQThGLEVBQUF4SCxFQUFBLFFBRUFnQixFQUFBQyxRQUFBeUIsT0FBQSxLQUFBOHlDLHFCQUFBLEdBQUE5eUMsT0FBQSxTQUFBNkUsR0FDQSxnQkFBQUMsRUFBQUQsS0FBQStDLE1BQUEsSUFBQTVILE9BQUE2RSwwQkNIQSxJQUFBa3VDLEVBQUF6MUMsRUFBQSxRQUNBMHdDLEVBQUExd0MsRUFBQSxRQUNBZ0IsRUFBQUMsUUFBQSxTQUFBc0csR0FDQSxPQUFBa3VDLEVBQUEvRSxFQUFBbnBDLDJCQ0pBLElBQUE3QixLQUF1QkEsZUFDdkIxRSxFQUFBQyxRQUFBLFNBQUFzRyxFQUFBN0YsR0FDQSxPQUFBZ0UsRUFBQWpELEtBQUE4RSxFQUFBN0YsNEJDREEsSUFBQXlGLEVBQUFuSCxFQUFBLFFBR0FnQixFQUFBQyxRQUFBLFNBQUFzRyxFQUFBdXNDLEdBQ0EsSUFBQTNzQyxFQUFBSSxHQUFBLE9BQUFBLEVBQ0EsSUFBQTVCLEVBQUE2RSxFQUNBLEdBQUFzcEMsR0FBQSxtQkFBQW51QyxFQUFBNEIsRUFBQWlGLFlBQUFyRixFQUFBcUQsRUFBQTdFLEVBQUFsRCxLQUFBOEUsSUFBQSxPQUFBaUQsRUFDQSxzQkFBQTdFLEVBQUE0QixFQUFBbXVDLFdBQUF2dUMsRUFBQXFELEVBQUE3RSxFQUFBbEQsS0FBQThFLElBQUEsT0FBQWlELEVBQ0EsSUFBQXNwQyxHQUFBLG1CQUFBbnVDLEVBQUE0QixFQUFBaUYsWUFBQXJGLEVBQUFxRCxFQUFBN0UsRUFBQWxELEtBQUE4RSxJQUFBLE9BQUFpRCxFQUNBLE1BQUFnbUMsVUFBQSxnRUNUQSxJQUFBeHRDLEVBQUFoQyxFQUFBQyxRQUFBLG9CQUFBbVMsZUFBQXRHLFdBQ0FzRyxPQUFBLG9CQUFBdWlDLFdBQUE3b0MsV0FBQTZvQyxLQUVBenZDLFNBQUEsY0FBQUEsR0FDQSxpQkFBQTB2QyxVQUFBNXlDLDJCQ0xBLElBQUE2eUMsRUFBQTcxQyxFQUFBLFFBQ0FtYSxFQUFBck4sS0FBQXFOLElBQ0EyN0IsRUFBQWhwQyxLQUFBZ3BDLElBQ0E5MEMsRUFBQUMsUUFBQSxTQUFBOE0sRUFBQTNKLEdBRUEsT0FEQTJKLEVBQUE4bkMsRUFBQTluQyxHQUNBQSxFQUFBLEVBQUFvTSxFQUFBcE0sRUFBQTNKLEVBQUEsR0FBQTB4QyxFQUFBL25DLEVBQUEzSiwwQkNMQXBELEVBQUFDLFFBQUEsU0FBQSt3QyxHQUNBLElBQ0EsUUFBQUEsSUFDRyxNQUFBcnVDLEdBQ0gsZ0RDSEEsSUFBQVgsRUFBQWhELEVBQUEsUUFDQStELEVBQUEvRCxFQUFBLFFBQ0ErMUMsRUFBQS8xQyxFQUFBLFFBQ0FnMkMsRUFBQWgyQyxFQUFBLE9BQUFBLENBQUEsV0FFQWdCLEVBQUFDLFFBQUEsU0FBQWcxQyxHQUNBLElBQUEzeUMsRUFBQU4sRUFBQWl6QyxHQUNBRixHQUFBenlDLE1BQUEweUMsSUFBQWp5QyxFQUFBTyxFQUFBaEIsRUFBQTB5QyxHQUNBcmpDLGNBQUEsRUFDQXlCLElBQUEsV0FBc0IsT0FBQXJULGlDQ1Z0QixJQUFBd1IsRUFBQXZTLEVBQUEsUUFBQXNFLEVBQ0EyRixFQUFBakssRUFBQSxRQUNBK0IsRUFBQS9CLEVBQUEsT0FBQUEsQ0FBQSxlQUVBZ0IsRUFBQUMsUUFBQSxTQUFBc0csRUFBQTRPLEVBQUErL0IsR0FDQTN1QyxJQUFBMEMsRUFBQTFDLEVBQUEydUMsRUFBQTN1QyxJQUFBckYsVUFBQUgsSUFBQXdRLEVBQUFoTCxFQUFBeEYsR0FBb0U0USxjQUFBLEVBQUExTCxNQUFBa1AsNkJDTHBFLElBQUFwUyxFQUFBL0QsRUFBQSxRQUFBc0UsRUFDQTZ4QyxFQUFBandDLFNBQUFoRSxVQUNBazBDLEVBQUEsd0JBQ0FqMUMsRUFBQSxPQUdBQSxLQUFBZzFDLEdBQUFuMkMsRUFBQSxTQUFBK0QsRUFBQW95QyxFQUFBaDFDLEdBQ0F3UixjQUFBLEVBQ0F5QixJQUFBLFdBQ0EsSUFDQSxVQUFBclQsTUFBQTBjLE1BQUEyNEIsR0FBQSxHQUNLLE1BQUF6eUMsR0FDTCxtQ0NaQSxJQUFBWCxFQUFBaEQsRUFBQSxRQUNBcTJDLEVBQUFyMkMsRUFBQSxRQUFBOEcsSUFDQWdTLEVBQUE5VixFQUFBc3pDLGtCQUFBdHpDLEVBQUF1ekMsdUJBQ0F6eEMsRUFBQTlCLEVBQUE4QixRQUNBdkIsRUFBQVAsRUFBQU8sUUFDQW11QyxFQUFBLFdBQUExeEMsRUFBQSxPQUFBQSxDQUFBOEUsR0FFQTlELEVBQUFDLFFBQUEsV0FDQSxJQUFBdTFDLEVBQUE5MEIsRUFBQS9MLEVBRUE4Z0MsRUFBQSxXQUNBLElBQUF4dEMsRUFBQXRELEVBQ0ErckMsSUFBQXpvQyxFQUFBbkUsRUFBQTJ0QyxTQUFBeHBDLEVBQUEycEMsT0FDQSxNQUFBNEQsRUFBQSxDQUNBN3dDLEVBQUE2d0MsRUFBQTd3QyxHQUNBNndDLElBQUFuMUMsS0FDQSxJQUNBc0UsSUFDTyxNQUFBaEMsR0FHUCxNQUZBNnlDLEVBQUE3Z0MsSUFDQStMLE9BQUFwZixFQUNBcUIsR0FFSytkLE9BQUFwZixFQUNMMkcsS0FBQTQrQixTQUlBLEdBQUE2SixFQUNBLzdCLEVBQUEsV0FDQTdRLEVBQUFxQixTQUFBc3dDLFNBR0csSUFBQTM5QixHQUFBOVYsRUFBQTBRLFdBQUExUSxFQUFBMFEsVUFBQWdqQyxXQVFBLEdBQUFuekMsS0FBQXliLFFBQUEsQ0FFSCxJQUFBOHlCLEVBQUF2dUMsRUFBQXliLGFBQUExYyxHQUNBcVQsRUFBQSxXQUNBbThCLEVBQUFydUMsS0FBQWd6QyxTQVNBOWdDLEVBQUEsV0FFQTBnQyxFQUFBNXpDLEtBQUFPLEVBQUF5ekMsUUF2QkcsQ0FDSCxJQUFBRSxHQUFBLEVBQ0FsL0IsRUFBQXJRLFNBQUEreEIsZUFBQSxJQUNBLElBQUFyZ0IsRUFBQTI5QixHQUFBcDlCLFFBQUE1QixHQUF1Q20vQixlQUFBLElBQ3ZDamhDLEVBQUEsV0FDQThCLEVBQUEzUixLQUFBNndDLE1Bc0JBLGdCQUFBaHhDLEdBQ0EsSUFBQXVyQyxHQUFnQnZyQyxLQUFBdEUsVUFBQWlCLEdBQ2hCb2YsTUFBQXJnQixLQUFBNnZDLEdBQ0FzRixJQUNBQSxFQUFBdEYsRUFDQXY3QixLQUNLK0wsRUFBQXd2Qix3QkNsRUwsSUFBQW51QyxFQUFBL0IsRUFBQUMsU0FBNkI0MUIsUUFBQSxTQUM3QixpQkFBQWdnQixVQUFBOXpDLHlCQ0RBL0IsRUFBQUMsbUNDQUEsSUFBQStDLEVBQUFoRSxFQUFBLFFBQ0E4MkMsRUFBQTkyQyxFQUFBLFFBQ0ErMkMsRUFBQS8yQyxFQUFBLFFBQ0ErRCxFQUFBckIsT0FBQStQLGVBRUF4UixFQUFBcUQsRUFBQXRFLEVBQUEsUUFBQTBDLE9BQUErUCxlQUFBLFNBQUEzTyxFQUFBakIsRUFBQW0wQyxHQUlBLEdBSEFoekMsRUFBQUYsR0FDQWpCLEVBQUFrMEMsRUFBQWwwQyxHQUFBLEdBQ0FtQixFQUFBZ3pDLEdBQ0FGLEVBQUEsSUFDQSxPQUFBL3lDLEVBQUFELEVBQUFqQixFQUFBbTBDLEdBQ0csTUFBQXJ6QyxJQUNILFdBQUFxekMsR0FBQSxRQUFBQSxFQUFBLE1BQUF4RyxVQUFBLDRCQUVBLE1BREEsVUFBQXdHLElBQUFsekMsRUFBQWpCLEdBQUFtMEMsRUFBQS92QyxPQUNBbkQsMkJDYkEsSUFBQWl0QyxFQUFBL3dDLEVBQUEsUUFDQWdCLEVBQUFDLFFBQUEsU0FBQTBFLEVBQUE0cEMsRUFBQW5yQyxHQUVBLEdBREEyc0MsRUFBQXByQyxRQUNBckQsSUFBQWl0QyxFQUFBLE9BQUE1cEMsRUFDQSxPQUFBdkIsR0FDQSx1QkFBQStLLEdBQ0EsT0FBQXhKLEVBQUFsRCxLQUFBOHNDLEVBQUFwZ0MsSUFFQSx1QkFBQUEsRUFBQWUsR0FDQSxPQUFBdkssRUFBQWxELEtBQUE4c0MsRUFBQXBnQyxFQUFBZSxJQUVBLHVCQUFBZixFQUFBZSxFQUFBeEIsR0FDQSxPQUFBL0ksRUFBQWxELEtBQUE4c0MsRUFBQXBnQyxFQUFBZSxFQUFBeEIsSUFHQSxrQkFDQSxPQUFBL0ksRUFBQTBKLE1BQUFrZ0MsRUFBQXZwQyxxQ0NoQkEsSUFBQWl4QyxFQUFBajNDLEVBQUEsT0FBQUEsQ0FBQSxlQUNBMnZDLEVBQUFoZ0MsTUFBQXpOLGVBQ0FJLEdBQUFxdEMsRUFBQXNILElBQUFqM0MsRUFBQSxPQUFBQSxDQUFBMnZDLEVBQUFzSCxNQUNBajJDLEVBQUFDLFFBQUEsU0FBQVMsR0FDQWl1QyxFQUFBc0gsR0FBQXYxQyxJQUFBLHlCQ0xBVixFQUFBQyxRQUFBLFNBQUErd0MsR0FDQSxJQUNBLE9BQVlydUMsR0FBQSxFQUFBc0ksRUFBQStsQyxLQUNULE1BQUFydUMsR0FDSCxPQUFZQSxHQUFBLEVBQUFzSSxFQUFBdEksNkJDSFosSUFBQWt5QyxFQUFBNzFDLEVBQUEsUUFDQTgxQyxFQUFBaHBDLEtBQUFncEMsSUFDQTkwQyxFQUFBQyxRQUFBLFNBQUFzRyxHQUNBLE9BQUFBLEVBQUEsRUFBQXV1QyxFQUFBRCxFQUFBdHVDLEdBQUEsNkNDSEF2RyxFQUFBQyxTQUFBakIsRUFBQSxPQUFBQSxDQUFBLFdBQ0EsT0FBMEUsR0FBMUUwQyxPQUFBK1Asa0JBQWlDLEtBQVEyQixJQUFBLFdBQW1CLFlBQWNqRiwwQkNGMUUsSUFBQW5NLEVBQUFoRCxFQUFBLFFBQ0EwVCxFQUFBMVEsRUFBQTBRLFVBRUExUyxFQUFBQyxRQUFBeVMsS0FBQUMsV0FBQSxzQ0NEQSxJQUFBbzlCLEVBQUEvd0MsRUFBQSxRQUVBLFNBQUFrM0MsRUFBQTV6QyxHQUNBLElBQUEwYixFQUFBOEQsRUFDQS9oQixLQUFBK3dDLFFBQUEsSUFBQXh1QyxFQUFBLFNBQUE2ekMsRUFBQWxELEdBQ0EsUUFBQTN4QyxJQUFBMGMsUUFBQTFjLElBQUF3Z0IsRUFBQSxNQUFBMHRCLFVBQUEsMkJBQ0F4eEIsRUFBQW00QixFQUNBcjBCLEVBQUFteEIsSUFFQWx6QyxLQUFBaWUsUUFBQSt4QixFQUFBL3hCLEdBQ0FqZSxLQUFBK2hCLE9BQUFpdUIsRUFBQWp1QixHQUdBOWhCLEVBQUFDLFFBQUFxRCxFQUFBLFNBQUFoQixHQUNBLFdBQUE0ekMsRUFBQTV6QywwQkNoQkEsSUFBQVUsRUFBQWhFLEVBQUEsUUFDQW1ILEVBQUFuSCxFQUFBLFFBQ0E0eEMsRUFBQTV4QyxFQUFBLFFBRUFnQixFQUFBQyxRQUFBLFNBQUFxQyxFQUFBSSxHQUVBLEdBREFNLEVBQUFWLEdBQ0E2RCxFQUFBekQsTUFBQXF2QixjQUFBenZCLEVBQUEsT0FBQUksRUFDQSxJQUFBMHpDLEVBQUF4RixFQUFBdHRDLEVBQUFoQixHQUNBMGIsRUFBQW80QixFQUFBcDRCLFFBRUEsT0FEQUEsRUFBQXRiLEdBQ0EwekMsRUFBQXRGLDZCQ1RBOXdDLEVBQUFDLFFBQUEsU0FBQXNHLEdBQ0EsUUFBQWpGLEdBQUFpRixFQUFBLE1BQUFpcEMsVUFBQSx5QkFBQWpwQyxHQUNBLE9BQUFBLHlCQ0RBLElBQUE4dkMsRUFBQXIzQyxFQUFBLFFBQ0Fpd0MsRUFBQWp3QyxFQUFBLFFBQ0FzM0MsRUFBQXQzQyxFQUFBLFFBQ0FnQixFQUFBQyxRQUFBLFNBQUFzMkMsR0FDQSxnQkFBQUMsRUFBQS93QixFQUFBZ3hCLEdBQ0EsSUFHQXh3QyxFQUhBbkQsRUFBQXV6QyxFQUFBRyxHQUNBcHpDLEVBQUE2ckMsRUFBQW5zQyxFQUFBTSxRQUNBMkosRUFBQXVwQyxFQUFBRyxFQUFBcnpDLEdBSUEsR0FBQW16QyxHQUFBOXdCLE1BQUEsTUFBQXJpQixFQUFBMkosRUFHQSxHQUZBOUcsRUFBQW5ELEVBQUFpSyxLQUVBOUcsS0FBQSxjQUVLLEtBQVk3QyxFQUFBMkosRUFBZUEsSUFBQSxJQUFBd3BDLEdBQUF4cEMsS0FBQWpLLElBQ2hDQSxFQUFBaUssS0FBQTBZLEVBQUEsT0FBQTh3QixHQUFBeHBDLEdBQUEsRUFDSyxPQUFBd3BDLElBQUEsMEJDcEJMdjJDLEVBQUFDLFNBQUFqQixFQUFBLFVBQUFBLEVBQUEsT0FBQUEsQ0FBQSxXQUNBLE9BQXVHLEdBQXZHMEMsT0FBQStQLGVBQUF6UyxFQUFBLE9BQUFBLENBQUEsWUFBc0VvVSxJQUFBLFdBQW1CLFlBQWNqRix3QkNEdkcsSUFBQXVvQyxFQUdBQSxFQUFBLFdBQ0EsT0FBQTMyQyxLQURBLEdBSUEsSUFFQTIyQyxLQUFBeHhDLFNBQUEsY0FBQUEsS0FBQSxFQUFBeXhDLE1BQUEsUUFDQyxNQUFBaDBDLEdBRUQsa0JBQUF5UCxTQUFBc2tDLEVBQUF0a0MsUUFPQXBTLEVBQUFDLFFBQUF5MkMsc0JDbkJBLElBQUFqeUMsRUFBQSxFQUNBbXlDLEVBQUE5cUMsS0FBQStxQyxTQUNBNzJDLEVBQUFDLFFBQUEsU0FBQVMsR0FDQSxnQkFBQW9JLFlBQUF4SCxJQUFBWixFQUFBLEdBQUFBLEVBQUEsUUFBQStELEVBQUFteUMsR0FBQXByQyxTQUFBLHlDQ0ZBLElBQUFzckMsRUFBQTkzQyxFQUFBLFFBQ0Fzd0MsRUFBQXR3QyxFQUFBLFFBQ0FJLEVBQUFKLEVBQUEsUUFDQXEzQyxFQUFBcjNDLEVBQUEsUUFNQWdCLEVBQUFDLFFBQUFqQixFQUFBLE9BQUFBLENBQUEyUCxNQUFBLGlCQUFBb29DLEVBQUFsMkMsR0FDQWQsS0FBQTZ0QixHQUFBeW9CLEVBQUFVLEdBQ0FoM0MsS0FBQSt0QixHQUFBLEVBQ0EvdEIsS0FBQWt1QixHQUFBcHRCLEdBRUMsV0FDRCxJQUFBaUMsRUFBQS9DLEtBQUE2dEIsR0FDQS9zQixFQUFBZCxLQUFBa3VCLEdBQ0FsaEIsRUFBQWhOLEtBQUErdEIsS0FDQSxPQUFBaHJCLEdBQUFpSyxHQUFBakssRUFBQU0sUUFDQXJELEtBQUE2dEIsUUFBQXRzQixFQUNBZ3VDLEVBQUEsSUFFQUEsRUFBQSxVQUFBenVDLEVBQUFrTSxFQUNBLFVBQUFsTSxFQUFBaUMsRUFBQWlLLElBQ0FBLEVBQUFqSyxFQUFBaUssTUFDQyxVQUdEM04sRUFBQTQzQyxVQUFBNTNDLEVBQUF1UCxNQUVBbW9DLEVBQUEsUUFDQUEsRUFBQSxVQUNBQSxFQUFBLGlDQ2pDQSxJQUFBM3dDLEVBQUFuSCxFQUFBLFFBQ0FnQixFQUFBQyxRQUFBLFNBQUFzRyxHQUNBLElBQUFKLEVBQUFJLEdBQUEsTUFBQWlwQyxVQUFBanBDLEVBQUEsc0JBQ0EsT0FBQUEseUJDSEEsSUFBQTBDLEVBQUFqSyxFQUFBLFFBQ0FxM0MsRUFBQXIzQyxFQUFBLFFBQ0FpNEMsRUFBQWo0QyxFQUFBLE9BQUFBLEVBQUEsR0FDQTZLLEVBQUE3SyxFQUFBLE9BQUFBLENBQUEsWUFFQWdCLEVBQUFDLFFBQUEsU0FBQXl1QyxFQUFBd0ksR0FDQSxJQUdBeDJDLEVBSEFvQyxFQUFBdXpDLEVBQUEzSCxHQUNBcnJDLEVBQUEsRUFDQXdILEtBRUEsSUFBQW5LLEtBQUFvQyxFQUFBcEMsR0FBQW1KLEdBQUFaLEVBQUFuRyxFQUFBcEMsSUFBQW1LLEVBQUE1RixLQUFBdkUsR0FFQSxNQUFBdzJDLEVBQUE5ekMsT0FBQUMsRUFBQTRGLEVBQUFuRyxFQUFBcEMsRUFBQXcyQyxFQUFBN3pDLFNBQ0E0ekMsRUFBQXBzQyxFQUFBbkssSUFBQW1LLEVBQUE1RixLQUFBdkUsSUFFQSxPQUFBbUssdUJDZkE3SyxFQUFBQyxRQUFBLFNBQUFzRyxHQUNBLHdCQUFBQSxFQUFBLE9BQUFBLEVBQUEsb0JBQUFBLHVCQ0RBdkcsRUFBQUMsUUFBQSxTQUFBd3ZDLEVBQUF4cEMsR0FDQSxPQUFVQSxRQUFBd3BDLCtCQ0RWenZDLEVBQUFDLFFBQUEsU0FBQXNHLEdBQ0Esc0JBQUFBLEVBQUEsTUFBQWlwQyxVQUFBanBDLEVBQUEsdUJBQ0EsT0FBQUEseUJDRkEsSUFBQXJILEVBQUFGLEVBQUEsUUFDQWdCLEVBQUFDLFFBQUEsU0FBQXdVLEVBQUFsSyxFQUFBZCxHQUNBLFFBQUEvSSxLQUFBNkosRUFBQXJMLEVBQUF1VixFQUFBL1QsRUFBQTZKLEVBQUE3SixHQUFBK0ksR0FDQSxPQUFBZ0wsdUJDRkF6VSxFQUFBQyxRQUFBLGdHQUVBcUosTUFBQSwyQkNGQSxJQUFBdEcsRUFBQWhFLEVBQUEsUUFDQSt3QyxFQUFBL3dDLEVBQUEsUUFDQWcyQyxFQUFBaDJDLEVBQUEsT0FBQUEsQ0FBQSxXQUNBZ0IsRUFBQUMsUUFBQSxTQUFBNkMsRUFBQXEwQyxHQUNBLElBQ0FyRSxFQURBeHdDLEVBQUFVLEVBQUFGLEdBQUFpdkIsWUFFQSxZQUFBendCLElBQUFnQixRQUFBaEIsSUFBQXd4QyxFQUFBOXZDLEVBQUFWLEdBQUEweUMsSUFBQW1DLEVBQUFwSCxFQUFBK0Msd0JDUEE5eUMsRUFBQUMsUUFBQSxTQUFBc0csRUFBQW5HLEVBQUF1QixFQUFBeTFDLEdBQ0EsS0FBQTd3QyxhQUFBbkcsU0FBQWtCLElBQUE4MUMsUUFBQTd3QyxFQUNBLE1BQUFpcEMsVUFBQTd0QyxFQUFBLDJCQUNHLE9BQUE0RSx5QkNISCxJQUFBSCxFQUFBcEgsRUFBQSxRQUFBb0gsU0FDQXBHLEVBQUFDLFFBQUFtRyxLQUFBaXhDIiwiZmlsZSI6ImpzL2NodW5rLXZlbmRvcnMuNjcxMWJlZWMuanMiLCJzb3VyY2VzQ29udGVudCI6WyIndXNlIHN0cmljdCc7XG52YXIgTElCUkFSWSA9IHJlcXVpcmUoJy4vX2xpYnJhcnknKTtcbnZhciAkZXhwb3J0ID0gcmVxdWlyZSgnLi9fZXhwb3J0Jyk7XG52YXIgcmVkZWZpbmUgPSByZXF1aXJlKCcuL19yZWRlZmluZScpO1xudmFyIGhpZGUgPSByZXF1aXJlKCcuL19oaWRlJyk7XG52YXIgSXRlcmF0b3JzID0gcmVxdWlyZSgnLi9faXRlcmF0b3JzJyk7XG52YXIgJGl0ZXJDcmVhdGUgPSByZXF1aXJlKCcuL19pdGVyLWNyZWF0ZScpO1xudmFyIHNldFRvU3RyaW5nVGFnID0gcmVxdWlyZSgnLi9fc2V0LXRvLXN0cmluZy10YWcnKTtcbnZhciBnZXRQcm90b3R5cGVPZiA9IHJlcXVpcmUoJy4vX29iamVjdC1ncG8nKTtcbnZhciBJVEVSQVRPUiA9IHJlcXVpcmUoJy4vX3drcycpKCdpdGVyYXRvcicpO1xudmFyIEJVR0dZID0gIShbXS5rZXlzICYmICduZXh0JyBpbiBbXS5rZXlzKCkpOyAvLyBTYWZhcmkgaGFzIGJ1Z2d5IGl0ZXJhdG9ycyB3L28gYG5leHRgXG52YXIgRkZfSVRFUkFUT1IgPSAnQEBpdGVyYXRvcic7XG52YXIgS0VZUyA9ICdrZXlzJztcbnZhciBWQUxVRVMgPSAndmFsdWVzJztcblxudmFyIHJldHVyblRoaXMgPSBmdW5jdGlvbiAoKSB7IHJldHVybiB0aGlzOyB9O1xuXG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChCYXNlLCBOQU1FLCBDb25zdHJ1Y3RvciwgbmV4dCwgREVGQVVMVCwgSVNfU0VULCBGT1JDRUQpIHtcbiAgJGl0ZXJDcmVhdGUoQ29uc3RydWN0b3IsIE5BTUUsIG5leHQpO1xuICB2YXIgZ2V0TWV0aG9kID0gZnVuY3Rpb24gKGtpbmQpIHtcbiAgICBpZiAoIUJVR0dZICYmIGtpbmQgaW4gcHJvdG8pIHJldHVybiBwcm90b1traW5kXTtcbiAgICBzd2l0Y2ggKGtpbmQpIHtcbiAgICAgIGNhc2UgS0VZUzogcmV0dXJuIGZ1bmN0aW9uIGtleXMoKSB7IHJldHVybiBuZXcgQ29uc3RydWN0b3IodGhpcywga2luZCk7IH07XG4gICAgICBjYXNlIFZBTFVFUzogcmV0dXJuIGZ1bmN0aW9uIHZhbHVlcygpIHsgcmV0dXJuIG5ldyBDb25zdHJ1Y3Rvcih0aGlzLCBraW5kKTsgfTtcbiAgICB9IHJldHVybiBmdW5jdGlvbiBlbnRyaWVzKCkgeyByZXR1cm4gbmV3IENvbnN0cnVjdG9yKHRoaXMsIGtpbmQpOyB9O1xuICB9O1xuICB2YXIgVEFHID0gTkFNRSArICcgSXRlcmF0b3InO1xuICB2YXIgREVGX1ZBTFVFUyA9IERFRkFVTFQgPT0gVkFMVUVTO1xuICB2YXIgVkFMVUVTX0JVRyA9IGZhbHNlO1xuICB2YXIgcHJvdG8gPSBCYXNlLnByb3RvdHlwZTtcbiAgdmFyICRuYXRpdmUgPSBwcm90b1tJVEVSQVRPUl0gfHwgcHJvdG9bRkZfSVRFUkFUT1JdIHx8IERFRkFVTFQgJiYgcHJvdG9bREVGQVVMVF07XG4gIHZhciAkZGVmYXVsdCA9ICRuYXRpdmUgfHwgZ2V0TWV0aG9kKERFRkFVTFQpO1xuICB2YXIgJGVudHJpZXMgPSBERUZBVUxUID8gIURFRl9WQUxVRVMgPyAkZGVmYXVsdCA6IGdldE1ldGhvZCgnZW50cmllcycpIDogdW5kZWZpbmVkO1xuICB2YXIgJGFueU5hdGl2ZSA9IE5BTUUgPT0gJ0FycmF5JyA/IHByb3RvLmVudHJpZXMgfHwgJG5hdGl2ZSA6ICRuYXRpdmU7XG4gIHZhciBtZXRob2RzLCBrZXksIEl0ZXJhdG9yUHJvdG90eXBlO1xuICAvLyBGaXggbmF0aXZlXG4gIGlmICgkYW55TmF0aXZlKSB7XG4gICAgSXRlcmF0b3JQcm90b3R5cGUgPSBnZXRQcm90b3R5cGVPZigkYW55TmF0aXZlLmNhbGwobmV3IEJhc2UoKSkpO1xuICAgIGlmIChJdGVyYXRvclByb3RvdHlwZSAhPT0gT2JqZWN0LnByb3RvdHlwZSAmJiBJdGVyYXRvclByb3RvdHlwZS5uZXh0KSB7XG4gICAgICAvLyBTZXQgQEB0b1N0cmluZ1RhZyB0byBuYXRpdmUgaXRlcmF0b3JzXG4gICAgICBzZXRUb1N0cmluZ1RhZyhJdGVyYXRvclByb3RvdHlwZSwgVEFHLCB0cnVlKTtcbiAgICAgIC8vIGZpeCBmb3Igc29tZSBvbGQgZW5naW5lc1xuICAgICAgaWYgKCFMSUJSQVJZICYmIHR5cGVvZiBJdGVyYXRvclByb3RvdHlwZVtJVEVSQVRPUl0gIT0gJ2Z1bmN0aW9uJykgaGlkZShJdGVyYXRvclByb3RvdHlwZSwgSVRFUkFUT1IsIHJldHVyblRoaXMpO1xuICAgIH1cbiAgfVxuICAvLyBmaXggQXJyYXkje3ZhbHVlcywgQEBpdGVyYXRvcn0ubmFtZSBpbiBWOCAvIEZGXG4gIGlmIChERUZfVkFMVUVTICYmICRuYXRpdmUgJiYgJG5hdGl2ZS5uYW1lICE9PSBWQUxVRVMpIHtcbiAgICBWQUxVRVNfQlVHID0gdHJ1ZTtcbiAgICAkZGVmYXVsdCA9IGZ1bmN0aW9uIHZhbHVlcygpIHsgcmV0dXJuICRuYXRpdmUuY2FsbCh0aGlzKTsgfTtcbiAgfVxuICAvLyBEZWZpbmUgaXRlcmF0b3JcbiAgaWYgKCghTElCUkFSWSB8fCBGT1JDRUQpICYmIChCVUdHWSB8fCBWQUxVRVNfQlVHIHx8ICFwcm90b1tJVEVSQVRPUl0pKSB7XG4gICAgaGlkZShwcm90bywgSVRFUkFUT1IsICRkZWZhdWx0KTtcbiAgfVxuICAvLyBQbHVnIGZvciBsaWJyYXJ5XG4gIEl0ZXJhdG9yc1tOQU1FXSA9ICRkZWZhdWx0O1xuICBJdGVyYXRvcnNbVEFHXSA9IHJldHVyblRoaXM7XG4gIGlmIChERUZBVUxUKSB7XG4gICAgbWV0aG9kcyA9IHtcbiAgICAgIHZhbHVlczogREVGX1ZBTFVFUyA/ICRkZWZhdWx0IDogZ2V0TWV0aG9kKFZBTFVFUyksXG4gICAgICBrZXlzOiBJU19TRVQgPyAkZGVmYXVsdCA6IGdldE1ldGhvZChLRVlTKSxcbiAgICAgIGVudHJpZXM6ICRlbnRyaWVzXG4gICAgfTtcbiAgICBpZiAoRk9SQ0VEKSBmb3IgKGtleSBpbiBtZXRob2RzKSB7XG4gICAgICBpZiAoIShrZXkgaW4gcHJvdG8pKSByZWRlZmluZShwcm90bywga2V5LCBtZXRob2RzW2tleV0pO1xuICAgIH0gZWxzZSAkZXhwb3J0KCRleHBvcnQuUCArICRleHBvcnQuRiAqIChCVUdHWSB8fCBWQUxVRVNfQlVHKSwgTkFNRSwgbWV0aG9kcyk7XG4gIH1cbiAgcmV0dXJuIG1ldGhvZHM7XG59O1xuIiwiLy8gaHR0cHM6Ly9naXRodWIuY29tL3RjMzkvcHJvcG9zYWwtcHJvbWlzZS1maW5hbGx5XG4ndXNlIHN0cmljdCc7XG52YXIgJGV4cG9ydCA9IHJlcXVpcmUoJy4vX2V4cG9ydCcpO1xudmFyIGNvcmUgPSByZXF1aXJlKCcuL19jb3JlJyk7XG52YXIgZ2xvYmFsID0gcmVxdWlyZSgnLi9fZ2xvYmFsJyk7XG52YXIgc3BlY2llc0NvbnN0cnVjdG9yID0gcmVxdWlyZSgnLi9fc3BlY2llcy1jb25zdHJ1Y3RvcicpO1xudmFyIHByb21pc2VSZXNvbHZlID0gcmVxdWlyZSgnLi9fcHJvbWlzZS1yZXNvbHZlJyk7XG5cbiRleHBvcnQoJGV4cG9ydC5QICsgJGV4cG9ydC5SLCAnUHJvbWlzZScsIHsgJ2ZpbmFsbHknOiBmdW5jdGlvbiAob25GaW5hbGx5KSB7XG4gIHZhciBDID0gc3BlY2llc0NvbnN0cnVjdG9yKHRoaXMsIGNvcmUuUHJvbWlzZSB8fCBnbG9iYWwuUHJvbWlzZSk7XG4gIHZhciBpc0Z1bmN0aW9uID0gdHlwZW9mIG9uRmluYWxseSA9PSAnZnVuY3Rpb24nO1xuICByZXR1cm4gdGhpcy50aGVuKFxuICAgIGlzRnVuY3Rpb24gPyBmdW5jdGlvbiAoeCkge1xuICAgICAgcmV0dXJuIHByb21pc2VSZXNvbHZlKEMsIG9uRmluYWxseSgpKS50aGVuKGZ1bmN0aW9uICgpIHsgcmV0dXJuIHg7IH0pO1xuICAgIH0gOiBvbkZpbmFsbHksXG4gICAgaXNGdW5jdGlvbiA/IGZ1bmN0aW9uIChlKSB7XG4gICAgICByZXR1cm4gcHJvbWlzZVJlc29sdmUoQywgb25GaW5hbGx5KCkpLnRoZW4oZnVuY3Rpb24gKCkgeyB0aHJvdyBlOyB9KTtcbiAgICB9IDogb25GaW5hbGx5XG4gICk7XG59IH0pO1xuIiwiLy8gMTkuMS4yLjE0IC8gMTUuMi4zLjE0IE9iamVjdC5rZXlzKE8pXG52YXIgJGtleXMgPSByZXF1aXJlKCcuL19vYmplY3Qta2V5cy1pbnRlcm5hbCcpO1xudmFyIGVudW1CdWdLZXlzID0gcmVxdWlyZSgnLi9fZW51bS1idWcta2V5cycpO1xuXG5tb2R1bGUuZXhwb3J0cyA9IE9iamVjdC5rZXlzIHx8IGZ1bmN0aW9uIGtleXMoTykge1xuICByZXR1cm4gJGtleXMoTywgZW51bUJ1Z0tleXMpO1xufTtcbiIsInZhciBkUCA9IHJlcXVpcmUoJy4vX29iamVjdC1kcCcpO1xudmFyIGFuT2JqZWN0ID0gcmVxdWlyZSgnLi9fYW4tb2JqZWN0Jyk7XG52YXIgZ2V0S2V5cyA9IHJlcXVpcmUoJy4vX29iamVjdC1rZXlzJyk7XG5cbm1vZHVsZS5leHBvcnRzID0gcmVxdWlyZSgnLi9fZGVzY3JpcHRvcnMnKSA/IE9iamVjdC5kZWZpbmVQcm9wZXJ0aWVzIDogZnVuY3Rpb24gZGVmaW5lUHJvcGVydGllcyhPLCBQcm9wZXJ0aWVzKSB7XG4gIGFuT2JqZWN0KE8pO1xuICB2YXIga2V5cyA9IGdldEtleXMoUHJvcGVydGllcyk7XG4gIHZhciBsZW5ndGggPSBrZXlzLmxlbmd0aDtcbiAgdmFyIGkgPSAwO1xuICB2YXIgUDtcbiAgd2hpbGUgKGxlbmd0aCA+IGkpIGRQLmYoTywgUCA9IGtleXNbaSsrXSwgUHJvcGVydGllc1tQXSk7XG4gIHJldHVybiBPO1xufTtcbiIsInZhciBjdHggPSByZXF1aXJlKCcuL19jdHgnKTtcbnZhciBpbnZva2UgPSByZXF1aXJlKCcuL19pbnZva2UnKTtcbnZhciBodG1sID0gcmVxdWlyZSgnLi9faHRtbCcpO1xudmFyIGNlbCA9IHJlcXVpcmUoJy4vX2RvbS1jcmVhdGUnKTtcbnZhciBnbG9iYWwgPSByZXF1aXJlKCcuL19nbG9iYWwnKTtcbnZhciBwcm9jZXNzID0gZ2xvYmFsLnByb2Nlc3M7XG52YXIgc2V0VGFzayA9IGdsb2JhbC5zZXRJbW1lZGlhdGU7XG52YXIgY2xlYXJUYXNrID0gZ2xvYmFsLmNsZWFySW1tZWRpYXRlO1xudmFyIE1lc3NhZ2VDaGFubmVsID0gZ2xvYmFsLk1lc3NhZ2VDaGFubmVsO1xudmFyIERpc3BhdGNoID0gZ2xvYmFsLkRpc3BhdGNoO1xudmFyIGNvdW50ZXIgPSAwO1xudmFyIHF1ZXVlID0ge307XG52YXIgT05SRUFEWVNUQVRFQ0hBTkdFID0gJ29ucmVhZHlzdGF0ZWNoYW5nZSc7XG52YXIgZGVmZXIsIGNoYW5uZWwsIHBvcnQ7XG52YXIgcnVuID0gZnVuY3Rpb24gKCkge1xuICB2YXIgaWQgPSArdGhpcztcbiAgLy8gZXNsaW50LWRpc2FibGUtbmV4dC1saW5lIG5vLXByb3RvdHlwZS1idWlsdGluc1xuICBpZiAocXVldWUuaGFzT3duUHJvcGVydHkoaWQpKSB7XG4gICAgdmFyIGZuID0gcXVldWVbaWRdO1xuICAgIGRlbGV0ZSBxdWV1ZVtpZF07XG4gICAgZm4oKTtcbiAgfVxufTtcbnZhciBsaXN0ZW5lciA9IGZ1bmN0aW9uIChldmVudCkge1xuICBydW4uY2FsbChldmVudC5kYXRhKTtcbn07XG4vLyBOb2RlLmpzIDAuOSsgJiBJRTEwKyBoYXMgc2V0SW1tZWRpYXRlLCBvdGhlcndpc2U6XG5pZiAoIXNldFRhc2sgfHwgIWNsZWFyVGFzaykge1xuICBzZXRUYXNrID0gZnVuY3Rpb24gc2V0SW1tZWRpYXRlKGZuKSB7XG4gICAgdmFyIGFyZ3MgPSBbXTtcbiAgICB2YXIgaSA9IDE7XG4gICAgd2hpbGUgKGFyZ3VtZW50cy5sZW5ndGggPiBpKSBhcmdzLnB1c2goYXJndW1lbnRzW2krK10pO1xuICAgIHF1ZXVlWysrY291bnRlcl0gPSBmdW5jdGlvbiAoKSB7XG4gICAgICAvLyBlc2xpbnQtZGlzYWJsZS1uZXh0LWxpbmUgbm8tbmV3LWZ1bmNcbiAgICAgIGludm9rZSh0eXBlb2YgZm4gPT0gJ2Z1bmN0aW9uJyA/IGZuIDogRnVuY3Rpb24oZm4pLCBhcmdzKTtcbiAgICB9O1xuICAgIGRlZmVyKGNvdW50ZXIpO1xuICAgIHJldHVybiBjb3VudGVyO1xuICB9O1xuICBjbGVhclRhc2sgPSBmdW5jdGlvbiBjbGVhckltbWVkaWF0ZShpZCkge1xuICAgIGRlbGV0ZSBxdWV1ZVtpZF07XG4gIH07XG4gIC8vIE5vZGUuanMgMC44LVxuICBpZiAocmVxdWlyZSgnLi9fY29mJykocHJvY2VzcykgPT0gJ3Byb2Nlc3MnKSB7XG4gICAgZGVmZXIgPSBmdW5jdGlvbiAoaWQpIHtcbiAgICAgIHByb2Nlc3MubmV4dFRpY2soY3R4KHJ1biwgaWQsIDEpKTtcbiAgICB9O1xuICAvLyBTcGhlcmUgKEpTIGdhbWUgZW5naW5lKSBEaXNwYXRjaCBBUElcbiAgfSBlbHNlIGlmIChEaXNwYXRjaCAmJiBEaXNwYXRjaC5ub3cpIHtcbiAgICBkZWZlciA9IGZ1bmN0aW9uIChpZCkge1xuICAgICAgRGlzcGF0Y2gubm93KGN0eChydW4sIGlkLCAxKSk7XG4gICAgfTtcbiAgLy8gQnJvd3NlcnMgd2l0aCBNZXNzYWdlQ2hhbm5lbCwgaW5jbHVkZXMgV2ViV29ya2Vyc1xuICB9IGVsc2UgaWYgKE1lc3NhZ2VDaGFubmVsKSB7XG4gICAgY2hhbm5lbCA9IG5ldyBNZXNzYWdlQ2hhbm5lbCgpO1xuICAgIHBvcnQgPSBjaGFubmVsLnBvcnQyO1xuICAgIGNoYW5uZWwucG9ydDEub25tZXNzYWdlID0gbGlzdGVuZXI7XG4gICAgZGVmZXIgPSBjdHgocG9ydC5wb3N0TWVzc2FnZSwgcG9ydCwgMSk7XG4gIC8vIEJyb3dzZXJzIHdpdGggcG9zdE1lc3NhZ2UsIHNraXAgV2ViV29ya2Vyc1xuICAvLyBJRTggaGFzIHBvc3RNZXNzYWdlLCBidXQgaXQncyBzeW5jICYgdHlwZW9mIGl0cyBwb3N0TWVzc2FnZSBpcyAnb2JqZWN0J1xuICB9IGVsc2UgaWYgKGdsb2JhbC5hZGRFdmVudExpc3RlbmVyICYmIHR5cGVvZiBwb3N0TWVzc2FnZSA9PSAnZnVuY3Rpb24nICYmICFnbG9iYWwuaW1wb3J0U2NyaXB0cykge1xuICAgIGRlZmVyID0gZnVuY3Rpb24gKGlkKSB7XG4gICAgICBnbG9iYWwucG9zdE1lc3NhZ2UoaWQgKyAnJywgJyonKTtcbiAgICB9O1xuICAgIGdsb2JhbC5hZGRFdmVudExpc3RlbmVyKCdtZXNzYWdlJywgbGlzdGVuZXIsIGZhbHNlKTtcbiAgLy8gSUU4LVxuICB9IGVsc2UgaWYgKE9OUkVBRFlTVEFURUNIQU5HRSBpbiBjZWwoJ3NjcmlwdCcpKSB7XG4gICAgZGVmZXIgPSBmdW5jdGlvbiAoaWQpIHtcbiAgICAgIGh0bWwuYXBwZW5kQ2hpbGQoY2VsKCdzY3JpcHQnKSlbT05SRUFEWVNUQVRFQ0hBTkdFXSA9IGZ1bmN0aW9uICgpIHtcbiAgICAgICAgaHRtbC5yZW1vdmVDaGlsZCh0aGlzKTtcbiAgICAgICAgcnVuLmNhbGwoaWQpO1xuICAgICAgfTtcbiAgICB9O1xuICAvLyBSZXN0IG9sZCBicm93c2Vyc1xuICB9IGVsc2Uge1xuICAgIGRlZmVyID0gZnVuY3Rpb24gKGlkKSB7XG4gICAgICBzZXRUaW1lb3V0KGN0eChydW4sIGlkLCAxKSwgMCk7XG4gICAgfTtcbiAgfVxufVxubW9kdWxlLmV4cG9ydHMgPSB7XG4gIHNldDogc2V0VGFzayxcbiAgY2xlYXI6IGNsZWFyVGFza1xufTtcbiIsIi8vIGNhbGwgc29tZXRoaW5nIG9uIGl0ZXJhdG9yIHN0ZXAgd2l0aCBzYWZlIGNsb3Npbmcgb24gZXJyb3JcbnZhciBhbk9iamVjdCA9IHJlcXVpcmUoJy4vX2FuLW9iamVjdCcpO1xubW9kdWxlLmV4cG9ydHMgPSBmdW5jdGlvbiAoaXRlcmF0b3IsIGZuLCB2YWx1ZSwgZW50cmllcykge1xuICB0cnkge1xuICAgIHJldHVybiBlbnRyaWVzID8gZm4oYW5PYmplY3QodmFsdWUpWzBdLCB2YWx1ZVsxXSkgOiBmbih2YWx1ZSk7XG4gIC8vIDcuNC42IEl0ZXJhdG9yQ2xvc2UoaXRlcmF0b3IsIGNvbXBsZXRpb24pXG4gIH0gY2F0Y2ggKGUpIHtcbiAgICB2YXIgcmV0ID0gaXRlcmF0b3JbJ3JldHVybiddO1xuICAgIGlmIChyZXQgIT09IHVuZGVmaW5lZCkgYW5PYmplY3QocmV0LmNhbGwoaXRlcmF0b3IpKTtcbiAgICB0aHJvdyBlO1xuICB9XG59O1xuIiwidmFyIGlzT2JqZWN0ID0gcmVxdWlyZSgnLi9faXMtb2JqZWN0Jyk7XG52YXIgZG9jdW1lbnQgPSByZXF1aXJlKCcuL19nbG9iYWwnKS5kb2N1bWVudDtcbi8vIHR5cGVvZiBkb2N1bWVudC5jcmVhdGVFbGVtZW50IGlzICdvYmplY3QnIGluIG9sZCBJRVxudmFyIGlzID0gaXNPYmplY3QoZG9jdW1lbnQpICYmIGlzT2JqZWN0KGRvY3VtZW50LmNyZWF0ZUVsZW1lbnQpO1xubW9kdWxlLmV4cG9ydHMgPSBmdW5jdGlvbiAoaXQpIHtcbiAgcmV0dXJuIGlzID8gZG9jdW1lbnQuY3JlYXRlRWxlbWVudChpdCkgOiB7fTtcbn07XG4iLCIvLyBnZXR0aW5nIHRhZyBmcm9tIDE5LjEuMy42IE9iamVjdC5wcm90b3R5cGUudG9TdHJpbmcoKVxudmFyIGNvZiA9IHJlcXVpcmUoJy4vX2NvZicpO1xudmFyIFRBRyA9IHJlcXVpcmUoJy4vX3drcycpKCd0b1N0cmluZ1RhZycpO1xuLy8gRVMzIHdyb25nIGhlcmVcbnZhciBBUkcgPSBjb2YoZnVuY3Rpb24gKCkgeyByZXR1cm4gYXJndW1lbnRzOyB9KCkpID09ICdBcmd1bWVudHMnO1xuXG4vLyBmYWxsYmFjayBmb3IgSUUxMSBTY3JpcHQgQWNjZXNzIERlbmllZCBlcnJvclxudmFyIHRyeUdldCA9IGZ1bmN0aW9uIChpdCwga2V5KSB7XG4gIHRyeSB7XG4gICAgcmV0dXJuIGl0W2tleV07XG4gIH0gY2F0Y2ggKGUpIHsgLyogZW1wdHkgKi8gfVxufTtcblxubW9kdWxlLmV4cG9ydHMgPSBmdW5jdGlvbiAoaXQpIHtcbiAgdmFyIE8sIFQsIEI7XG4gIHJldHVybiBpdCA9PT0gdW5kZWZpbmVkID8gJ1VuZGVmaW5lZCcgOiBpdCA9PT0gbnVsbCA/ICdOdWxsJ1xuICAgIC8vIEBAdG9TdHJpbmdUYWcgY2FzZVxuICAgIDogdHlwZW9mIChUID0gdHJ5R2V0KE8gPSBPYmplY3QoaXQpLCBUQUcpKSA9PSAnc3RyaW5nJyA/IFRcbiAgICAvLyBidWlsdGluVGFnIGNhc2VcbiAgICA6IEFSRyA/IGNvZihPKVxuICAgIC8vIEVTMyBhcmd1bWVudHMgZmFsbGJhY2tcbiAgICA6IChCID0gY29mKE8pKSA9PSAnT2JqZWN0JyAmJiB0eXBlb2YgTy5jYWxsZWUgPT0gJ2Z1bmN0aW9uJyA/ICdBcmd1bWVudHMnIDogQjtcbn07XG4iLCJ2YXIgY2xhc3NvZiA9IHJlcXVpcmUoJy4vX2NsYXNzb2YnKTtcbnZhciBJVEVSQVRPUiA9IHJlcXVpcmUoJy4vX3drcycpKCdpdGVyYXRvcicpO1xudmFyIEl0ZXJhdG9ycyA9IHJlcXVpcmUoJy4vX2l0ZXJhdG9ycycpO1xubW9kdWxlLmV4cG9ydHMgPSByZXF1aXJlKCcuL19jb3JlJykuZ2V0SXRlcmF0b3JNZXRob2QgPSBmdW5jdGlvbiAoaXQpIHtcbiAgaWYgKGl0ICE9IHVuZGVmaW5lZCkgcmV0dXJuIGl0W0lURVJBVE9SXVxuICAgIHx8IGl0WydAQGl0ZXJhdG9yJ11cbiAgICB8fCBJdGVyYXRvcnNbY2xhc3NvZihpdCldO1xufTtcbiIsIi8qIGdsb2JhbHMgX19WVUVfU1NSX0NPTlRFWFRfXyAqL1xuXG4vLyBJTVBPUlRBTlQ6IERvIE5PVCB1c2UgRVMyMDE1IGZlYXR1cmVzIGluIHRoaXMgZmlsZSAoZXhjZXB0IGZvciBtb2R1bGVzKS5cbi8vIFRoaXMgbW9kdWxlIGlzIGEgcnVudGltZSB1dGlsaXR5IGZvciBjbGVhbmVyIGNvbXBvbmVudCBtb2R1bGUgb3V0cHV0IGFuZCB3aWxsXG4vLyBiZSBpbmNsdWRlZCBpbiB0aGUgZmluYWwgd2VicGFjayB1c2VyIGJ1bmRsZS5cblxuZXhwb3J0IGRlZmF1bHQgZnVuY3Rpb24gbm9ybWFsaXplQ29tcG9uZW50IChcbiAgc2NyaXB0RXhwb3J0cyxcbiAgcmVuZGVyLFxuICBzdGF0aWNSZW5kZXJGbnMsXG4gIGZ1bmN0aW9uYWxUZW1wbGF0ZSxcbiAgaW5qZWN0U3R5bGVzLFxuICBzY29wZUlkLFxuICBtb2R1bGVJZGVudGlmaWVyLCAvKiBzZXJ2ZXIgb25seSAqL1xuICBzaGFkb3dNb2RlIC8qIHZ1ZS1jbGkgb25seSAqL1xuKSB7XG4gIC8vIFZ1ZS5leHRlbmQgY29uc3RydWN0b3IgZXhwb3J0IGludGVyb3BcbiAgdmFyIG9wdGlvbnMgPSB0eXBlb2Ygc2NyaXB0RXhwb3J0cyA9PT0gJ2Z1bmN0aW9uJ1xuICAgID8gc2NyaXB0RXhwb3J0cy5vcHRpb25zXG4gICAgOiBzY3JpcHRFeHBvcnRzXG5cbiAgLy8gcmVuZGVyIGZ1bmN0aW9uc1xuICBpZiAocmVuZGVyKSB7XG4gICAgb3B0aW9ucy5yZW5kZXIgPSByZW5kZXJcbiAgICBvcHRpb25zLnN0YXRpY1JlbmRlckZucyA9IHN0YXRpY1JlbmRlckZuc1xuICAgIG9wdGlvbnMuX2NvbXBpbGVkID0gdHJ1ZVxuICB9XG5cbiAgLy8gZnVuY3Rpb25hbCB0ZW1wbGF0ZVxuICBpZiAoZnVuY3Rpb25hbFRlbXBsYXRlKSB7XG4gICAgb3B0aW9ucy5mdW5jdGlvbmFsID0gdHJ1ZVxuICB9XG5cbiAgLy8gc2NvcGVkSWRcbiAgaWYgKHNjb3BlSWQpIHtcbiAgICBvcHRpb25zLl9zY29wZUlkID0gJ2RhdGEtdi0nICsgc2NvcGVJZFxuICB9XG5cbiAgdmFyIGhvb2tcbiAgaWYgKG1vZHVsZUlkZW50aWZpZXIpIHsgLy8gc2VydmVyIGJ1aWxkXG4gICAgaG9vayA9IGZ1bmN0aW9uIChjb250ZXh0KSB7XG4gICAgICAvLyAyLjMgaW5qZWN0aW9uXG4gICAgICBjb250ZXh0ID1cbiAgICAgICAgY29udGV4dCB8fCAvLyBjYWNoZWQgY2FsbFxuICAgICAgICAodGhpcy4kdm5vZGUgJiYgdGhpcy4kdm5vZGUuc3NyQ29udGV4dCkgfHwgLy8gc3RhdGVmdWxcbiAgICAgICAgKHRoaXMucGFyZW50ICYmIHRoaXMucGFyZW50LiR2bm9kZSAmJiB0aGlzLnBhcmVudC4kdm5vZGUuc3NyQ29udGV4dCkgLy8gZnVuY3Rpb25hbFxuICAgICAgLy8gMi4yIHdpdGggcnVuSW5OZXdDb250ZXh0OiB0cnVlXG4gICAgICBpZiAoIWNvbnRleHQgJiYgdHlwZW9mIF9fVlVFX1NTUl9DT05URVhUX18gIT09ICd1bmRlZmluZWQnKSB7XG4gICAgICAgIGNvbnRleHQgPSBfX1ZVRV9TU1JfQ09OVEVYVF9fXG4gICAgICB9XG4gICAgICAvLyBpbmplY3QgY29tcG9uZW50IHN0eWxlc1xuICAgICAgaWYgKGluamVjdFN0eWxlcykge1xuICAgICAgICBpbmplY3RTdHlsZXMuY2FsbCh0aGlzLCBjb250ZXh0KVxuICAgICAgfVxuICAgICAgLy8gcmVnaXN0ZXIgY29tcG9uZW50IG1vZHVsZSBpZGVudGlmaWVyIGZvciBhc3luYyBjaHVuayBpbmZlcnJlbmNlXG4gICAgICBpZiAoY29udGV4dCAmJiBjb250ZXh0Ll9yZWdpc3RlcmVkQ29tcG9uZW50cykge1xuICAgICAgICBjb250ZXh0Ll9yZWdpc3RlcmVkQ29tcG9uZW50cy5hZGQobW9kdWxlSWRlbnRpZmllcilcbiAgICAgIH1cbiAgICB9XG4gICAgLy8gdXNlZCBieSBzc3IgaW4gY2FzZSBjb21wb25lbnQgaXMgY2FjaGVkIGFuZCBiZWZvcmVDcmVhdGVcbiAgICAvLyBuZXZlciBnZXRzIGNhbGxlZFxuICAgIG9wdGlvbnMuX3NzclJlZ2lzdGVyID0gaG9va1xuICB9IGVsc2UgaWYgKGluamVjdFN0eWxlcykge1xuICAgIGhvb2sgPSBzaGFkb3dNb2RlXG4gICAgICA/IGZ1bmN0aW9uICgpIHsgaW5qZWN0U3R5bGVzLmNhbGwodGhpcywgdGhpcy4kcm9vdC4kb3B0aW9ucy5zaGFkb3dSb290KSB9XG4gICAgICA6IGluamVjdFN0eWxlc1xuICB9XG5cbiAgaWYgKGhvb2spIHtcbiAgICBpZiAob3B0aW9ucy5mdW5jdGlvbmFsKSB7XG4gICAgICAvLyBmb3IgdGVtcGxhdGUtb25seSBob3QtcmVsb2FkIGJlY2F1c2UgaW4gdGhhdCBjYXNlIHRoZSByZW5kZXIgZm4gZG9lc24ndFxuICAgICAgLy8gZ28gdGhyb3VnaCB0aGUgbm9ybWFsaXplclxuICAgICAgb3B0aW9ucy5faW5qZWN0U3R5bGVzID0gaG9va1xuICAgICAgLy8gcmVnaXN0ZXIgZm9yIGZ1bmN0aW9hbCBjb21wb25lbnQgaW4gdnVlIGZpbGVcbiAgICAgIHZhciBvcmlnaW5hbFJlbmRlciA9IG9wdGlvbnMucmVuZGVyXG4gICAgICBvcHRpb25zLnJlbmRlciA9IGZ1bmN0aW9uIHJlbmRlcldpdGhTdHlsZUluamVjdGlvbiAoaCwgY29udGV4dCkge1xuICAgICAgICBob29rLmNhbGwoY29udGV4dClcbiAgICAgICAgcmV0dXJuIG9yaWdpbmFsUmVuZGVyKGgsIGNvbnRleHQpXG4gICAgICB9XG4gICAgfSBlbHNlIHtcbiAgICAgIC8vIGluamVjdCBjb21wb25lbnQgcmVnaXN0cmF0aW9uIGFzIGJlZm9yZUNyZWF0ZSBob29rXG4gICAgICB2YXIgZXhpc3RpbmcgPSBvcHRpb25zLmJlZm9yZUNyZWF0ZVxuICAgICAgb3B0aW9ucy5iZWZvcmVDcmVhdGUgPSBleGlzdGluZ1xuICAgICAgICA/IFtdLmNvbmNhdChleGlzdGluZywgaG9vaylcbiAgICAgICAgOiBbaG9va11cbiAgICB9XG4gIH1cblxuICByZXR1cm4ge1xuICAgIGV4cG9ydHM6IHNjcmlwdEV4cG9ydHMsXG4gICAgb3B0aW9uczogb3B0aW9uc1xuICB9XG59XG4iLCJ2YXIgZ2xvYmFsID0gcmVxdWlyZSgnLi9fZ2xvYmFsJyk7XG52YXIgaGlkZSA9IHJlcXVpcmUoJy4vX2hpZGUnKTtcbnZhciBoYXMgPSByZXF1aXJlKCcuL19oYXMnKTtcbnZhciBTUkMgPSByZXF1aXJlKCcuL191aWQnKSgnc3JjJyk7XG52YXIgVE9fU1RSSU5HID0gJ3RvU3RyaW5nJztcbnZhciAkdG9TdHJpbmcgPSBGdW5jdGlvbltUT19TVFJJTkddO1xudmFyIFRQTCA9ICgnJyArICR0b1N0cmluZykuc3BsaXQoVE9fU1RSSU5HKTtcblxucmVxdWlyZSgnLi9fY29yZScpLmluc3BlY3RTb3VyY2UgPSBmdW5jdGlvbiAoaXQpIHtcbiAgcmV0dXJuICR0b1N0cmluZy5jYWxsKGl0KTtcbn07XG5cbihtb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChPLCBrZXksIHZhbCwgc2FmZSkge1xuICB2YXIgaXNGdW5jdGlvbiA9IHR5cGVvZiB2YWwgPT0gJ2Z1bmN0aW9uJztcbiAgaWYgKGlzRnVuY3Rpb24pIGhhcyh2YWwsICduYW1lJykgfHwgaGlkZSh2YWwsICduYW1lJywga2V5KTtcbiAgaWYgKE9ba2V5XSA9PT0gdmFsKSByZXR1cm47XG4gIGlmIChpc0Z1bmN0aW9uKSBoYXModmFsLCBTUkMpIHx8IGhpZGUodmFsLCBTUkMsIE9ba2V5XSA/ICcnICsgT1trZXldIDogVFBMLmpvaW4oU3RyaW5nKGtleSkpKTtcbiAgaWYgKE8gPT09IGdsb2JhbCkge1xuICAgIE9ba2V5XSA9IHZhbDtcbiAgfSBlbHNlIGlmICghc2FmZSkge1xuICAgIGRlbGV0ZSBPW2tleV07XG4gICAgaGlkZShPLCBrZXksIHZhbCk7XG4gIH0gZWxzZSBpZiAoT1trZXldKSB7XG4gICAgT1trZXldID0gdmFsO1xuICB9IGVsc2Uge1xuICAgIGhpZGUoTywga2V5LCB2YWwpO1xuICB9XG4vLyBhZGQgZmFrZSBGdW5jdGlvbiN0b1N0cmluZyBmb3IgY29ycmVjdCB3b3JrIHdyYXBwZWQgbWV0aG9kcyAvIGNvbnN0cnVjdG9ycyB3aXRoIG1ldGhvZHMgbGlrZSBMb0Rhc2ggaXNOYXRpdmVcbn0pKEZ1bmN0aW9uLnByb3RvdHlwZSwgVE9fU1RSSU5HLCBmdW5jdGlvbiB0b1N0cmluZygpIHtcbiAgcmV0dXJuIHR5cGVvZiB0aGlzID09ICdmdW5jdGlvbicgJiYgdGhpc1tTUkNdIHx8ICR0b1N0cmluZy5jYWxsKHRoaXMpO1xufSk7XG4iLCIvLyAxOS4xLjIuMiAvIDE1LjIuMy41IE9iamVjdC5jcmVhdGUoTyBbLCBQcm9wZXJ0aWVzXSlcbnZhciBhbk9iamVjdCA9IHJlcXVpcmUoJy4vX2FuLW9iamVjdCcpO1xudmFyIGRQcyA9IHJlcXVpcmUoJy4vX29iamVjdC1kcHMnKTtcbnZhciBlbnVtQnVnS2V5cyA9IHJlcXVpcmUoJy4vX2VudW0tYnVnLWtleXMnKTtcbnZhciBJRV9QUk9UTyA9IHJlcXVpcmUoJy4vX3NoYXJlZC1rZXknKSgnSUVfUFJPVE8nKTtcbnZhciBFbXB0eSA9IGZ1bmN0aW9uICgpIHsgLyogZW1wdHkgKi8gfTtcbnZhciBQUk9UT1RZUEUgPSAncHJvdG90eXBlJztcblxuLy8gQ3JlYXRlIG9iamVjdCB3aXRoIGZha2UgYG51bGxgIHByb3RvdHlwZTogdXNlIGlmcmFtZSBPYmplY3Qgd2l0aCBjbGVhcmVkIHByb3RvdHlwZVxudmFyIGNyZWF0ZURpY3QgPSBmdW5jdGlvbiAoKSB7XG4gIC8vIFRocmFzaCwgd2FzdGUgYW5kIHNvZG9teTogSUUgR0MgYnVnXG4gIHZhciBpZnJhbWUgPSByZXF1aXJlKCcuL19kb20tY3JlYXRlJykoJ2lmcmFtZScpO1xuICB2YXIgaSA9IGVudW1CdWdLZXlzLmxlbmd0aDtcbiAgdmFyIGx0ID0gJzwnO1xuICB2YXIgZ3QgPSAnPic7XG4gIHZhciBpZnJhbWVEb2N1bWVudDtcbiAgaWZyYW1lLnN0eWxlLmRpc3BsYXkgPSAnbm9uZSc7XG4gIHJlcXVpcmUoJy4vX2h0bWwnKS5hcHBlbmRDaGlsZChpZnJhbWUpO1xuICBpZnJhbWUuc3JjID0gJ2phdmFzY3JpcHQ6JzsgLy8gZXNsaW50LWRpc2FibGUtbGluZSBuby1zY3JpcHQtdXJsXG4gIC8vIGNyZWF0ZURpY3QgPSBpZnJhbWUuY29udGVudFdpbmRvdy5PYmplY3Q7XG4gIC8vIGh0bWwucmVtb3ZlQ2hpbGQoaWZyYW1lKTtcbiAgaWZyYW1lRG9jdW1lbnQgPSBpZnJhbWUuY29udGVudFdpbmRvdy5kb2N1bWVudDtcbiAgaWZyYW1lRG9jdW1lbnQub3BlbigpO1xuICBpZnJhbWVEb2N1bWVudC53cml0ZShsdCArICdzY3JpcHQnICsgZ3QgKyAnZG9jdW1lbnQuRj1PYmplY3QnICsgbHQgKyAnL3NjcmlwdCcgKyBndCk7XG4gIGlmcmFtZURvY3VtZW50LmNsb3NlKCk7XG4gIGNyZWF0ZURpY3QgPSBpZnJhbWVEb2N1bWVudC5GO1xuICB3aGlsZSAoaS0tKSBkZWxldGUgY3JlYXRlRGljdFtQUk9UT1RZUEVdW2VudW1CdWdLZXlzW2ldXTtcbiAgcmV0dXJuIGNyZWF0ZURpY3QoKTtcbn07XG5cbm1vZHVsZS5leHBvcnRzID0gT2JqZWN0LmNyZWF0ZSB8fCBmdW5jdGlvbiBjcmVhdGUoTywgUHJvcGVydGllcykge1xuICB2YXIgcmVzdWx0O1xuICBpZiAoTyAhPT0gbnVsbCkge1xuICAgIEVtcHR5W1BST1RPVFlQRV0gPSBhbk9iamVjdChPKTtcbiAgICByZXN1bHQgPSBuZXcgRW1wdHkoKTtcbiAgICBFbXB0eVtQUk9UT1RZUEVdID0gbnVsbDtcbiAgICAvLyBhZGQgXCJfX3Byb3RvX19cIiBmb3IgT2JqZWN0LmdldFByb3RvdHlwZU9mIHBvbHlmaWxsXG4gICAgcmVzdWx0W0lFX1BST1RPXSA9IE87XG4gIH0gZWxzZSByZXN1bHQgPSBjcmVhdGVEaWN0KCk7XG4gIHJldHVybiBQcm9wZXJ0aWVzID09PSB1bmRlZmluZWQgPyByZXN1bHQgOiBkUHMocmVzdWx0LCBQcm9wZXJ0aWVzKTtcbn07XG4iLCIvKiFcbiAqIFZ1ZS5qcyB2Mi41LjE3XG4gKiAoYykgMjAxNC0yMDE4IEV2YW4gWW91XG4gKiBSZWxlYXNlZCB1bmRlciB0aGUgTUlUIExpY2Vuc2UuXG4gKi9cbi8qICAqL1xuXG52YXIgZW1wdHlPYmplY3QgPSBPYmplY3QuZnJlZXplKHt9KTtcblxuLy8gdGhlc2UgaGVscGVycyBwcm9kdWNlcyBiZXR0ZXIgdm0gY29kZSBpbiBKUyBlbmdpbmVzIGR1ZSB0byB0aGVpclxuLy8gZXhwbGljaXRuZXNzIGFuZCBmdW5jdGlvbiBpbmxpbmluZ1xuZnVuY3Rpb24gaXNVbmRlZiAodikge1xuICByZXR1cm4gdiA9PT0gdW5kZWZpbmVkIHx8IHYgPT09IG51bGxcbn1cblxuZnVuY3Rpb24gaXNEZWYgKHYpIHtcbiAgcmV0dXJuIHYgIT09IHVuZGVmaW5lZCAmJiB2ICE9PSBudWxsXG59XG5cbmZ1bmN0aW9uIGlzVHJ1ZSAodikge1xuICByZXR1cm4gdiA9PT0gdHJ1ZVxufVxuXG5mdW5jdGlvbiBpc0ZhbHNlICh2KSB7XG4gIHJldHVybiB2ID09PSBmYWxzZVxufVxuXG4vKipcbiAqIENoZWNrIGlmIHZhbHVlIGlzIHByaW1pdGl2ZVxuICovXG5mdW5jdGlvbiBpc1ByaW1pdGl2ZSAodmFsdWUpIHtcbiAgcmV0dXJuIChcbiAgICB0eXBlb2YgdmFsdWUgPT09ICdzdHJpbmcnIHx8XG4gICAgdHlwZW9mIHZhbHVlID09PSAnbnVtYmVyJyB8fFxuICAgIC8vICRmbG93LWRpc2FibGUtbGluZVxuICAgIHR5cGVvZiB2YWx1ZSA9PT0gJ3N5bWJvbCcgfHxcbiAgICB0eXBlb2YgdmFsdWUgPT09ICdib29sZWFuJ1xuICApXG59XG5cbi8qKlxuICogUXVpY2sgb2JqZWN0IGNoZWNrIC0gdGhpcyBpcyBwcmltYXJpbHkgdXNlZCB0byB0ZWxsXG4gKiBPYmplY3RzIGZyb20gcHJpbWl0aXZlIHZhbHVlcyB3aGVuIHdlIGtub3cgdGhlIHZhbHVlXG4gKiBpcyBhIEpTT04tY29tcGxpYW50IHR5cGUuXG4gKi9cbmZ1bmN0aW9uIGlzT2JqZWN0IChvYmopIHtcbiAgcmV0dXJuIG9iaiAhPT0gbnVsbCAmJiB0eXBlb2Ygb2JqID09PSAnb2JqZWN0J1xufVxuXG4vKipcbiAqIEdldCB0aGUgcmF3IHR5cGUgc3RyaW5nIG9mIGEgdmFsdWUgZS5nLiBbb2JqZWN0IE9iamVjdF1cbiAqL1xudmFyIF90b1N0cmluZyA9IE9iamVjdC5wcm90b3R5cGUudG9TdHJpbmc7XG5cbmZ1bmN0aW9uIHRvUmF3VHlwZSAodmFsdWUpIHtcbiAgcmV0dXJuIF90b1N0cmluZy5jYWxsKHZhbHVlKS5zbGljZSg4LCAtMSlcbn1cblxuLyoqXG4gKiBTdHJpY3Qgb2JqZWN0IHR5cGUgY2hlY2suIE9ubHkgcmV0dXJucyB0cnVlXG4gKiBmb3IgcGxhaW4gSmF2YVNjcmlwdCBvYmplY3RzLlxuICovXG5mdW5jdGlvbiBpc1BsYWluT2JqZWN0IChvYmopIHtcbiAgcmV0dXJuIF90b1N0cmluZy5jYWxsKG9iaikgPT09ICdbb2JqZWN0IE9iamVjdF0nXG59XG5cbmZ1bmN0aW9uIGlzUmVnRXhwICh2KSB7XG4gIHJldHVybiBfdG9TdHJpbmcuY2FsbCh2KSA9PT0gJ1tvYmplY3QgUmVnRXhwXSdcbn1cblxuLyoqXG4gKiBDaGVjayBpZiB2YWwgaXMgYSB2YWxpZCBhcnJheSBpbmRleC5cbiAqL1xuZnVuY3Rpb24gaXNWYWxpZEFycmF5SW5kZXggKHZhbCkge1xuICB2YXIgbiA9IHBhcnNlRmxvYXQoU3RyaW5nKHZhbCkpO1xuICByZXR1cm4gbiA+PSAwICYmIE1hdGguZmxvb3IobikgPT09IG4gJiYgaXNGaW5pdGUodmFsKVxufVxuXG4vKipcbiAqIENvbnZlcnQgYSB2YWx1ZSB0byBhIHN0cmluZyB0aGF0IGlzIGFjdHVhbGx5IHJlbmRlcmVkLlxuICovXG5mdW5jdGlvbiB0b1N0cmluZyAodmFsKSB7XG4gIHJldHVybiB2YWwgPT0gbnVsbFxuICAgID8gJydcbiAgICA6IHR5cGVvZiB2YWwgPT09ICdvYmplY3QnXG4gICAgICA/IEpTT04uc3RyaW5naWZ5KHZhbCwgbnVsbCwgMilcbiAgICAgIDogU3RyaW5nKHZhbClcbn1cblxuLyoqXG4gKiBDb252ZXJ0IGEgaW5wdXQgdmFsdWUgdG8gYSBudW1iZXIgZm9yIHBlcnNpc3RlbmNlLlxuICogSWYgdGhlIGNvbnZlcnNpb24gZmFpbHMsIHJldHVybiBvcmlnaW5hbCBzdHJpbmcuXG4gKi9cbmZ1bmN0aW9uIHRvTnVtYmVyICh2YWwpIHtcbiAgdmFyIG4gPSBwYXJzZUZsb2F0KHZhbCk7XG4gIHJldHVybiBpc05hTihuKSA/IHZhbCA6IG5cbn1cblxuLyoqXG4gKiBNYWtlIGEgbWFwIGFuZCByZXR1cm4gYSBmdW5jdGlvbiBmb3IgY2hlY2tpbmcgaWYgYSBrZXlcbiAqIGlzIGluIHRoYXQgbWFwLlxuICovXG5mdW5jdGlvbiBtYWtlTWFwIChcbiAgc3RyLFxuICBleHBlY3RzTG93ZXJDYXNlXG4pIHtcbiAgdmFyIG1hcCA9IE9iamVjdC5jcmVhdGUobnVsbCk7XG4gIHZhciBsaXN0ID0gc3RyLnNwbGl0KCcsJyk7XG4gIGZvciAodmFyIGkgPSAwOyBpIDwgbGlzdC5sZW5ndGg7IGkrKykge1xuICAgIG1hcFtsaXN0W2ldXSA9IHRydWU7XG4gIH1cbiAgcmV0dXJuIGV4cGVjdHNMb3dlckNhc2VcbiAgICA/IGZ1bmN0aW9uICh2YWwpIHsgcmV0dXJuIG1hcFt2YWwudG9Mb3dlckNhc2UoKV07IH1cbiAgICA6IGZ1bmN0aW9uICh2YWwpIHsgcmV0dXJuIG1hcFt2YWxdOyB9XG59XG5cbi8qKlxuICogQ2hlY2sgaWYgYSB0YWcgaXMgYSBidWlsdC1pbiB0YWcuXG4gKi9cbnZhciBpc0J1aWx0SW5UYWcgPSBtYWtlTWFwKCdzbG90LGNvbXBvbmVudCcsIHRydWUpO1xuXG4vKipcbiAqIENoZWNrIGlmIGEgYXR0cmlidXRlIGlzIGEgcmVzZXJ2ZWQgYXR0cmlidXRlLlxuICovXG52YXIgaXNSZXNlcnZlZEF0dHJpYnV0ZSA9IG1ha2VNYXAoJ2tleSxyZWYsc2xvdCxzbG90LXNjb3BlLGlzJyk7XG5cbi8qKlxuICogUmVtb3ZlIGFuIGl0ZW0gZnJvbSBhbiBhcnJheVxuICovXG5mdW5jdGlvbiByZW1vdmUgKGFyciwgaXRlbSkge1xuICBpZiAoYXJyLmxlbmd0aCkge1xuICAgIHZhciBpbmRleCA9IGFyci5pbmRleE9mKGl0ZW0pO1xuICAgIGlmIChpbmRleCA+IC0xKSB7XG4gICAgICByZXR1cm4gYXJyLnNwbGljZShpbmRleCwgMSlcbiAgICB9XG4gIH1cbn1cblxuLyoqXG4gKiBDaGVjayB3aGV0aGVyIHRoZSBvYmplY3QgaGFzIHRoZSBwcm9wZXJ0eS5cbiAqL1xudmFyIGhhc093blByb3BlcnR5ID0gT2JqZWN0LnByb3RvdHlwZS5oYXNPd25Qcm9wZXJ0eTtcbmZ1bmN0aW9uIGhhc093biAob2JqLCBrZXkpIHtcbiAgcmV0dXJuIGhhc093blByb3BlcnR5LmNhbGwob2JqLCBrZXkpXG59XG5cbi8qKlxuICogQ3JlYXRlIGEgY2FjaGVkIHZlcnNpb24gb2YgYSBwdXJlIGZ1bmN0aW9uLlxuICovXG5mdW5jdGlvbiBjYWNoZWQgKGZuKSB7XG4gIHZhciBjYWNoZSA9IE9iamVjdC5jcmVhdGUobnVsbCk7XG4gIHJldHVybiAoZnVuY3Rpb24gY2FjaGVkRm4gKHN0cikge1xuICAgIHZhciBoaXQgPSBjYWNoZVtzdHJdO1xuICAgIHJldHVybiBoaXQgfHwgKGNhY2hlW3N0cl0gPSBmbihzdHIpKVxuICB9KVxufVxuXG4vKipcbiAqIENhbWVsaXplIGEgaHlwaGVuLWRlbGltaXRlZCBzdHJpbmcuXG4gKi9cbnZhciBjYW1lbGl6ZVJFID0gLy0oXFx3KS9nO1xudmFyIGNhbWVsaXplID0gY2FjaGVkKGZ1bmN0aW9uIChzdHIpIHtcbiAgcmV0dXJuIHN0ci5yZXBsYWNlKGNhbWVsaXplUkUsIGZ1bmN0aW9uIChfLCBjKSB7IHJldHVybiBjID8gYy50b1VwcGVyQ2FzZSgpIDogJyc7IH0pXG59KTtcblxuLyoqXG4gKiBDYXBpdGFsaXplIGEgc3RyaW5nLlxuICovXG52YXIgY2FwaXRhbGl6ZSA9IGNhY2hlZChmdW5jdGlvbiAoc3RyKSB7XG4gIHJldHVybiBzdHIuY2hhckF0KDApLnRvVXBwZXJDYXNlKCkgKyBzdHIuc2xpY2UoMSlcbn0pO1xuXG4vKipcbiAqIEh5cGhlbmF0ZSBhIGNhbWVsQ2FzZSBzdHJpbmcuXG4gKi9cbnZhciBoeXBoZW5hdGVSRSA9IC9cXEIoW0EtWl0pL2c7XG52YXIgaHlwaGVuYXRlID0gY2FjaGVkKGZ1bmN0aW9uIChzdHIpIHtcbiAgcmV0dXJuIHN0ci5yZXBsYWNlKGh5cGhlbmF0ZVJFLCAnLSQxJykudG9Mb3dlckNhc2UoKVxufSk7XG5cbi8qKlxuICogU2ltcGxlIGJpbmQgcG9seWZpbGwgZm9yIGVudmlyb25tZW50cyB0aGF0IGRvIG5vdCBzdXBwb3J0IGl0Li4uIGUuZy5cbiAqIFBoYW50b21KUyAxLnguIFRlY2huaWNhbGx5IHdlIGRvbid0IG5lZWQgdGhpcyBhbnltb3JlIHNpbmNlIG5hdGl2ZSBiaW5kIGlzXG4gKiBub3cgbW9yZSBwZXJmb3JtYW50IGluIG1vc3QgYnJvd3NlcnMsIGJ1dCByZW1vdmluZyBpdCB3b3VsZCBiZSBicmVha2luZyBmb3JcbiAqIGNvZGUgdGhhdCB3YXMgYWJsZSB0byBydW4gaW4gUGhhbnRvbUpTIDEueCwgc28gdGhpcyBtdXN0IGJlIGtlcHQgZm9yXG4gKiBiYWNrd2FyZHMgY29tcGF0aWJpbGl0eS5cbiAqL1xuXG4vKiBpc3RhbmJ1bCBpZ25vcmUgbmV4dCAqL1xuZnVuY3Rpb24gcG9seWZpbGxCaW5kIChmbiwgY3R4KSB7XG4gIGZ1bmN0aW9uIGJvdW5kRm4gKGEpIHtcbiAgICB2YXIgbCA9IGFyZ3VtZW50cy5sZW5ndGg7XG4gICAgcmV0dXJuIGxcbiAgICAgID8gbCA+IDFcbiAgICAgICAgPyBmbi5hcHBseShjdHgsIGFyZ3VtZW50cylcbiAgICAgICAgOiBmbi5jYWxsKGN0eCwgYSlcbiAgICAgIDogZm4uY2FsbChjdHgpXG4gIH1cblxuICBib3VuZEZuLl9sZW5ndGggPSBmbi5sZW5ndGg7XG4gIHJldHVybiBib3VuZEZuXG59XG5cbmZ1bmN0aW9uIG5hdGl2ZUJpbmQgKGZuLCBjdHgpIHtcbiAgcmV0dXJuIGZuLmJpbmQoY3R4KVxufVxuXG52YXIgYmluZCA9IEZ1bmN0aW9uLnByb3RvdHlwZS5iaW5kXG4gID8gbmF0aXZlQmluZFxuICA6IHBvbHlmaWxsQmluZDtcblxuLyoqXG4gKiBDb252ZXJ0IGFuIEFycmF5LWxpa2Ugb2JqZWN0IHRvIGEgcmVhbCBBcnJheS5cbiAqL1xuZnVuY3Rpb24gdG9BcnJheSAobGlzdCwgc3RhcnQpIHtcbiAgc3RhcnQgPSBzdGFydCB8fCAwO1xuICB2YXIgaSA9IGxpc3QubGVuZ3RoIC0gc3RhcnQ7XG4gIHZhciByZXQgPSBuZXcgQXJyYXkoaSk7XG4gIHdoaWxlIChpLS0pIHtcbiAgICByZXRbaV0gPSBsaXN0W2kgKyBzdGFydF07XG4gIH1cbiAgcmV0dXJuIHJldFxufVxuXG4vKipcbiAqIE1peCBwcm9wZXJ0aWVzIGludG8gdGFyZ2V0IG9iamVjdC5cbiAqL1xuZnVuY3Rpb24gZXh0ZW5kICh0bywgX2Zyb20pIHtcbiAgZm9yICh2YXIga2V5IGluIF9mcm9tKSB7XG4gICAgdG9ba2V5XSA9IF9mcm9tW2tleV07XG4gIH1cbiAgcmV0dXJuIHRvXG59XG5cbi8qKlxuICogTWVyZ2UgYW4gQXJyYXkgb2YgT2JqZWN0cyBpbnRvIGEgc2luZ2xlIE9iamVjdC5cbiAqL1xuZnVuY3Rpb24gdG9PYmplY3QgKGFycikge1xuICB2YXIgcmVzID0ge307XG4gIGZvciAodmFyIGkgPSAwOyBpIDwgYXJyLmxlbmd0aDsgaSsrKSB7XG4gICAgaWYgKGFycltpXSkge1xuICAgICAgZXh0ZW5kKHJlcywgYXJyW2ldKTtcbiAgICB9XG4gIH1cbiAgcmV0dXJuIHJlc1xufVxuXG4vKipcbiAqIFBlcmZvcm0gbm8gb3BlcmF0aW9uLlxuICogU3R1YmJpbmcgYXJncyB0byBtYWtlIEZsb3cgaGFwcHkgd2l0aG91dCBsZWF2aW5nIHVzZWxlc3MgdHJhbnNwaWxlZCBjb2RlXG4gKiB3aXRoIC4uLnJlc3QgKGh0dHBzOi8vZmxvdy5vcmcvYmxvZy8yMDE3LzA1LzA3L1N0cmljdC1GdW5jdGlvbi1DYWxsLUFyaXR5LylcbiAqL1xuZnVuY3Rpb24gbm9vcCAoYSwgYiwgYykge31cblxuLyoqXG4gKiBBbHdheXMgcmV0dXJuIGZhbHNlLlxuICovXG52YXIgbm8gPSBmdW5jdGlvbiAoYSwgYiwgYykgeyByZXR1cm4gZmFsc2U7IH07XG5cbi8qKlxuICogUmV0dXJuIHNhbWUgdmFsdWVcbiAqL1xudmFyIGlkZW50aXR5ID0gZnVuY3Rpb24gKF8pIHsgcmV0dXJuIF87IH07XG5cbi8qKlxuICogR2VuZXJhdGUgYSBzdGF0aWMga2V5cyBzdHJpbmcgZnJvbSBjb21waWxlciBtb2R1bGVzLlxuICovXG5cblxuLyoqXG4gKiBDaGVjayBpZiB0d28gdmFsdWVzIGFyZSBsb29zZWx5IGVxdWFsIC0gdGhhdCBpcyxcbiAqIGlmIHRoZXkgYXJlIHBsYWluIG9iamVjdHMsIGRvIHRoZXkgaGF2ZSB0aGUgc2FtZSBzaGFwZT9cbiAqL1xuZnVuY3Rpb24gbG9vc2VFcXVhbCAoYSwgYikge1xuICBpZiAoYSA9PT0gYikgeyByZXR1cm4gdHJ1ZSB9XG4gIHZhciBpc09iamVjdEEgPSBpc09iamVjdChhKTtcbiAgdmFyIGlzT2JqZWN0QiA9IGlzT2JqZWN0KGIpO1xuICBpZiAoaXNPYmplY3RBICYmIGlzT2JqZWN0Qikge1xuICAgIHRyeSB7XG4gICAgICB2YXIgaXNBcnJheUEgPSBBcnJheS5pc0FycmF5KGEpO1xuICAgICAgdmFyIGlzQXJyYXlCID0gQXJyYXkuaXNBcnJheShiKTtcbiAgICAgIGlmIChpc0FycmF5QSAmJiBpc0FycmF5Qikge1xuICAgICAgICByZXR1cm4gYS5sZW5ndGggPT09IGIubGVuZ3RoICYmIGEuZXZlcnkoZnVuY3Rpb24gKGUsIGkpIHtcbiAgICAgICAgICByZXR1cm4gbG9vc2VFcXVhbChlLCBiW2ldKVxuICAgICAgICB9KVxuICAgICAgfSBlbHNlIGlmICghaXNBcnJheUEgJiYgIWlzQXJyYXlCKSB7XG4gICAgICAgIHZhciBrZXlzQSA9IE9iamVjdC5rZXlzKGEpO1xuICAgICAgICB2YXIga2V5c0IgPSBPYmplY3Qua2V5cyhiKTtcbiAgICAgICAgcmV0dXJuIGtleXNBLmxlbmd0aCA9PT0ga2V5c0IubGVuZ3RoICYmIGtleXNBLmV2ZXJ5KGZ1bmN0aW9uIChrZXkpIHtcbiAgICAgICAgICByZXR1cm4gbG9vc2VFcXVhbChhW2tleV0sIGJba2V5XSlcbiAgICAgICAgfSlcbiAgICAgIH0gZWxzZSB7XG4gICAgICAgIC8qIGlzdGFuYnVsIGlnbm9yZSBuZXh0ICovXG4gICAgICAgIHJldHVybiBmYWxzZVxuICAgICAgfVxuICAgIH0gY2F0Y2ggKGUpIHtcbiAgICAgIC8qIGlzdGFuYnVsIGlnbm9yZSBuZXh0ICovXG4gICAgICByZXR1cm4gZmFsc2VcbiAgICB9XG4gIH0gZWxzZSBpZiAoIWlzT2JqZWN0QSAmJiAhaXNPYmplY3RCKSB7XG4gICAgcmV0dXJuIFN0cmluZyhhKSA9PT0gU3RyaW5nKGIpXG4gIH0gZWxzZSB7XG4gICAgcmV0dXJuIGZhbHNlXG4gIH1cbn1cblxuZnVuY3Rpb24gbG9vc2VJbmRleE9mIChhcnIsIHZhbCkge1xuICBmb3IgKHZhciBpID0gMDsgaSA8IGFyci5sZW5ndGg7IGkrKykge1xuICAgIGlmIChsb29zZUVxdWFsKGFycltpXSwgdmFsKSkgeyByZXR1cm4gaSB9XG4gIH1cbiAgcmV0dXJuIC0xXG59XG5cbi8qKlxuICogRW5zdXJlIGEgZnVuY3Rpb24gaXMgY2FsbGVkIG9ubHkgb25jZS5cbiAqL1xuZnVuY3Rpb24gb25jZSAoZm4pIHtcbiAgdmFyIGNhbGxlZCA9IGZhbHNlO1xuICByZXR1cm4gZnVuY3Rpb24gKCkge1xuICAgIGlmICghY2FsbGVkKSB7XG4gICAgICBjYWxsZWQgPSB0cnVlO1xuICAgICAgZm4uYXBwbHkodGhpcywgYXJndW1lbnRzKTtcbiAgICB9XG4gIH1cbn1cblxudmFyIFNTUl9BVFRSID0gJ2RhdGEtc2VydmVyLXJlbmRlcmVkJztcblxudmFyIEFTU0VUX1RZUEVTID0gW1xuICAnY29tcG9uZW50JyxcbiAgJ2RpcmVjdGl2ZScsXG4gICdmaWx0ZXInXG5dO1xuXG52YXIgTElGRUNZQ0xFX0hPT0tTID0gW1xuICAnYmVmb3JlQ3JlYXRlJyxcbiAgJ2NyZWF0ZWQnLFxuICAnYmVmb3JlTW91bnQnLFxuICAnbW91bnRlZCcsXG4gICdiZWZvcmVVcGRhdGUnLFxuICAndXBkYXRlZCcsXG4gICdiZWZvcmVEZXN0cm95JyxcbiAgJ2Rlc3Ryb3llZCcsXG4gICdhY3RpdmF0ZWQnLFxuICAnZGVhY3RpdmF0ZWQnLFxuICAnZXJyb3JDYXB0dXJlZCdcbl07XG5cbi8qICAqL1xuXG52YXIgY29uZmlnID0gKHtcbiAgLyoqXG4gICAqIE9wdGlvbiBtZXJnZSBzdHJhdGVnaWVzICh1c2VkIGluIGNvcmUvdXRpbC9vcHRpb25zKVxuICAgKi9cbiAgLy8gJGZsb3ctZGlzYWJsZS1saW5lXG4gIG9wdGlvbk1lcmdlU3RyYXRlZ2llczogT2JqZWN0LmNyZWF0ZShudWxsKSxcblxuICAvKipcbiAgICogV2hldGhlciB0byBzdXBwcmVzcyB3YXJuaW5ncy5cbiAgICovXG4gIHNpbGVudDogZmFsc2UsXG5cbiAgLyoqXG4gICAqIFNob3cgcHJvZHVjdGlvbiBtb2RlIHRpcCBtZXNzYWdlIG9uIGJvb3Q/XG4gICAqL1xuICBwcm9kdWN0aW9uVGlwOiBwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nLFxuXG4gIC8qKlxuICAgKiBXaGV0aGVyIHRvIGVuYWJsZSBkZXZ0b29sc1xuICAgKi9cbiAgZGV2dG9vbHM6IHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicsXG5cbiAgLyoqXG4gICAqIFdoZXRoZXIgdG8gcmVjb3JkIHBlcmZcbiAgICovXG4gIHBlcmZvcm1hbmNlOiBmYWxzZSxcblxuICAvKipcbiAgICogRXJyb3IgaGFuZGxlciBmb3Igd2F0Y2hlciBlcnJvcnNcbiAgICovXG4gIGVycm9ySGFuZGxlcjogbnVsbCxcblxuICAvKipcbiAgICogV2FybiBoYW5kbGVyIGZvciB3YXRjaGVyIHdhcm5zXG4gICAqL1xuICB3YXJuSGFuZGxlcjogbnVsbCxcblxuICAvKipcbiAgICogSWdub3JlIGNlcnRhaW4gY3VzdG9tIGVsZW1lbnRzXG4gICAqL1xuICBpZ25vcmVkRWxlbWVudHM6IFtdLFxuXG4gIC8qKlxuICAgKiBDdXN0b20gdXNlciBrZXkgYWxpYXNlcyBmb3Igdi1vblxuICAgKi9cbiAgLy8gJGZsb3ctZGlzYWJsZS1saW5lXG4gIGtleUNvZGVzOiBPYmplY3QuY3JlYXRlKG51bGwpLFxuXG4gIC8qKlxuICAgKiBDaGVjayBpZiBhIHRhZyBpcyByZXNlcnZlZCBzbyB0aGF0IGl0IGNhbm5vdCBiZSByZWdpc3RlcmVkIGFzIGFcbiAgICogY29tcG9uZW50LiBUaGlzIGlzIHBsYXRmb3JtLWRlcGVuZGVudCBhbmQgbWF5IGJlIG92ZXJ3cml0dGVuLlxuICAgKi9cbiAgaXNSZXNlcnZlZFRhZzogbm8sXG5cbiAgLyoqXG4gICAqIENoZWNrIGlmIGFuIGF0dHJpYnV0ZSBpcyByZXNlcnZlZCBzbyB0aGF0IGl0IGNhbm5vdCBiZSB1c2VkIGFzIGEgY29tcG9uZW50XG4gICAqIHByb3AuIFRoaXMgaXMgcGxhdGZvcm0tZGVwZW5kZW50IGFuZCBtYXkgYmUgb3ZlcndyaXR0ZW4uXG4gICAqL1xuICBpc1Jlc2VydmVkQXR0cjogbm8sXG5cbiAgLyoqXG4gICAqIENoZWNrIGlmIGEgdGFnIGlzIGFuIHVua25vd24gZWxlbWVudC5cbiAgICogUGxhdGZvcm0tZGVwZW5kZW50LlxuICAgKi9cbiAgaXNVbmtub3duRWxlbWVudDogbm8sXG5cbiAgLyoqXG4gICAqIEdldCB0aGUgbmFtZXNwYWNlIG9mIGFuIGVsZW1lbnRcbiAgICovXG4gIGdldFRhZ05hbWVzcGFjZTogbm9vcCxcblxuICAvKipcbiAgICogUGFyc2UgdGhlIHJlYWwgdGFnIG5hbWUgZm9yIHRoZSBzcGVjaWZpYyBwbGF0Zm9ybS5cbiAgICovXG4gIHBhcnNlUGxhdGZvcm1UYWdOYW1lOiBpZGVudGl0eSxcblxuICAvKipcbiAgICogQ2hlY2sgaWYgYW4gYXR0cmlidXRlIG11c3QgYmUgYm91bmQgdXNpbmcgcHJvcGVydHksIGUuZy4gdmFsdWVcbiAgICogUGxhdGZvcm0tZGVwZW5kZW50LlxuICAgKi9cbiAgbXVzdFVzZVByb3A6IG5vLFxuXG4gIC8qKlxuICAgKiBFeHBvc2VkIGZvciBsZWdhY3kgcmVhc29uc1xuICAgKi9cbiAgX2xpZmVjeWNsZUhvb2tzOiBMSUZFQ1lDTEVfSE9PS1Ncbn0pXG5cbi8qICAqL1xuXG4vKipcbiAqIENoZWNrIGlmIGEgc3RyaW5nIHN0YXJ0cyB3aXRoICQgb3IgX1xuICovXG5mdW5jdGlvbiBpc1Jlc2VydmVkIChzdHIpIHtcbiAgdmFyIGMgPSAoc3RyICsgJycpLmNoYXJDb2RlQXQoMCk7XG4gIHJldHVybiBjID09PSAweDI0IHx8IGMgPT09IDB4NUZcbn1cblxuLyoqXG4gKiBEZWZpbmUgYSBwcm9wZXJ0eS5cbiAqL1xuZnVuY3Rpb24gZGVmIChvYmosIGtleSwgdmFsLCBlbnVtZXJhYmxlKSB7XG4gIE9iamVjdC5kZWZpbmVQcm9wZXJ0eShvYmosIGtleSwge1xuICAgIHZhbHVlOiB2YWwsXG4gICAgZW51bWVyYWJsZTogISFlbnVtZXJhYmxlLFxuICAgIHdyaXRhYmxlOiB0cnVlLFxuICAgIGNvbmZpZ3VyYWJsZTogdHJ1ZVxuICB9KTtcbn1cblxuLyoqXG4gKiBQYXJzZSBzaW1wbGUgcGF0aC5cbiAqL1xudmFyIGJhaWxSRSA9IC9bXlxcdy4kXS87XG5mdW5jdGlvbiBwYXJzZVBhdGggKHBhdGgpIHtcbiAgaWYgKGJhaWxSRS50ZXN0KHBhdGgpKSB7XG4gICAgcmV0dXJuXG4gIH1cbiAgdmFyIHNlZ21lbnRzID0gcGF0aC5zcGxpdCgnLicpO1xuICByZXR1cm4gZnVuY3Rpb24gKG9iaikge1xuICAgIGZvciAodmFyIGkgPSAwOyBpIDwgc2VnbWVudHMubGVuZ3RoOyBpKyspIHtcbiAgICAgIGlmICghb2JqKSB7IHJldHVybiB9XG4gICAgICBvYmogPSBvYmpbc2VnbWVudHNbaV1dO1xuICAgIH1cbiAgICByZXR1cm4gb2JqXG4gIH1cbn1cblxuLyogICovXG5cbi8vIGNhbiB3ZSB1c2UgX19wcm90b19fP1xudmFyIGhhc1Byb3RvID0gJ19fcHJvdG9fXycgaW4ge307XG5cbi8vIEJyb3dzZXIgZW52aXJvbm1lbnQgc25pZmZpbmdcbnZhciBpbkJyb3dzZXIgPSB0eXBlb2Ygd2luZG93ICE9PSAndW5kZWZpbmVkJztcbnZhciBpbldlZXggPSB0eXBlb2YgV1hFbnZpcm9ubWVudCAhPT0gJ3VuZGVmaW5lZCcgJiYgISFXWEVudmlyb25tZW50LnBsYXRmb3JtO1xudmFyIHdlZXhQbGF0Zm9ybSA9IGluV2VleCAmJiBXWEVudmlyb25tZW50LnBsYXRmb3JtLnRvTG93ZXJDYXNlKCk7XG52YXIgVUEgPSBpbkJyb3dzZXIgJiYgd2luZG93Lm5hdmlnYXRvci51c2VyQWdlbnQudG9Mb3dlckNhc2UoKTtcbnZhciBpc0lFID0gVUEgJiYgL21zaWV8dHJpZGVudC8udGVzdChVQSk7XG52YXIgaXNJRTkgPSBVQSAmJiBVQS5pbmRleE9mKCdtc2llIDkuMCcpID4gMDtcbnZhciBpc0VkZ2UgPSBVQSAmJiBVQS5pbmRleE9mKCdlZGdlLycpID4gMDtcbnZhciBpc0FuZHJvaWQgPSAoVUEgJiYgVUEuaW5kZXhPZignYW5kcm9pZCcpID4gMCkgfHwgKHdlZXhQbGF0Zm9ybSA9PT0gJ2FuZHJvaWQnKTtcbnZhciBpc0lPUyA9IChVQSAmJiAvaXBob25lfGlwYWR8aXBvZHxpb3MvLnRlc3QoVUEpKSB8fCAod2VleFBsYXRmb3JtID09PSAnaW9zJyk7XG52YXIgaXNDaHJvbWUgPSBVQSAmJiAvY2hyb21lXFwvXFxkKy8udGVzdChVQSkgJiYgIWlzRWRnZTtcblxuLy8gRmlyZWZveCBoYXMgYSBcIndhdGNoXCIgZnVuY3Rpb24gb24gT2JqZWN0LnByb3RvdHlwZS4uLlxudmFyIG5hdGl2ZVdhdGNoID0gKHt9KS53YXRjaDtcblxudmFyIHN1cHBvcnRzUGFzc2l2ZSA9IGZhbHNlO1xuaWYgKGluQnJvd3Nlcikge1xuICB0cnkge1xuICAgIHZhciBvcHRzID0ge307XG4gICAgT2JqZWN0LmRlZmluZVByb3BlcnR5KG9wdHMsICdwYXNzaXZlJywgKHtcbiAgICAgIGdldDogZnVuY3Rpb24gZ2V0ICgpIHtcbiAgICAgICAgLyogaXN0YW5idWwgaWdub3JlIG5leHQgKi9cbiAgICAgICAgc3VwcG9ydHNQYXNzaXZlID0gdHJ1ZTtcbiAgICAgIH1cbiAgICB9KSk7IC8vIGh0dHBzOi8vZ2l0aHViLmNvbS9mYWNlYm9vay9mbG93L2lzc3Vlcy8yODVcbiAgICB3aW5kb3cuYWRkRXZlbnRMaXN0ZW5lcigndGVzdC1wYXNzaXZlJywgbnVsbCwgb3B0cyk7XG4gIH0gY2F0Y2ggKGUpIHt9XG59XG5cbi8vIHRoaXMgbmVlZHMgdG8gYmUgbGF6eS1ldmFsZWQgYmVjYXVzZSB2dWUgbWF5IGJlIHJlcXVpcmVkIGJlZm9yZVxuLy8gdnVlLXNlcnZlci1yZW5kZXJlciBjYW4gc2V0IFZVRV9FTlZcbnZhciBfaXNTZXJ2ZXI7XG52YXIgaXNTZXJ2ZXJSZW5kZXJpbmcgPSBmdW5jdGlvbiAoKSB7XG4gIGlmIChfaXNTZXJ2ZXIgPT09IHVuZGVmaW5lZCkge1xuICAgIC8qIGlzdGFuYnVsIGlnbm9yZSBpZiAqL1xuICAgIGlmICghaW5Ccm93c2VyICYmICFpbldlZXggJiYgdHlwZW9mIGdsb2JhbCAhPT0gJ3VuZGVmaW5lZCcpIHtcbiAgICAgIC8vIGRldGVjdCBwcmVzZW5jZSBvZiB2dWUtc2VydmVyLXJlbmRlcmVyIGFuZCBhdm9pZFxuICAgICAgLy8gV2VicGFjayBzaGltbWluZyB0aGUgcHJvY2Vzc1xuICAgICAgX2lzU2VydmVyID0gZ2xvYmFsWydwcm9jZXNzJ10uZW52LlZVRV9FTlYgPT09ICdzZXJ2ZXInO1xuICAgIH0gZWxzZSB7XG4gICAgICBfaXNTZXJ2ZXIgPSBmYWxzZTtcbiAgICB9XG4gIH1cbiAgcmV0dXJuIF9pc1NlcnZlclxufTtcblxuLy8gZGV0ZWN0IGRldnRvb2xzXG52YXIgZGV2dG9vbHMgPSBpbkJyb3dzZXIgJiYgd2luZG93Ll9fVlVFX0RFVlRPT0xTX0dMT0JBTF9IT09LX187XG5cbi8qIGlzdGFuYnVsIGlnbm9yZSBuZXh0ICovXG5mdW5jdGlvbiBpc05hdGl2ZSAoQ3Rvcikge1xuICByZXR1cm4gdHlwZW9mIEN0b3IgPT09ICdmdW5jdGlvbicgJiYgL25hdGl2ZSBjb2RlLy50ZXN0KEN0b3IudG9TdHJpbmcoKSlcbn1cblxudmFyIGhhc1N5bWJvbCA9XG4gIHR5cGVvZiBTeW1ib2wgIT09ICd1bmRlZmluZWQnICYmIGlzTmF0aXZlKFN5bWJvbCkgJiZcbiAgdHlwZW9mIFJlZmxlY3QgIT09ICd1bmRlZmluZWQnICYmIGlzTmF0aXZlKFJlZmxlY3Qub3duS2V5cyk7XG5cbnZhciBfU2V0O1xuLyogaXN0YW5idWwgaWdub3JlIGlmICovIC8vICRmbG93LWRpc2FibGUtbGluZVxuaWYgKHR5cGVvZiBTZXQgIT09ICd1bmRlZmluZWQnICYmIGlzTmF0aXZlKFNldCkpIHtcbiAgLy8gdXNlIG5hdGl2ZSBTZXQgd2hlbiBhdmFpbGFibGUuXG4gIF9TZXQgPSBTZXQ7XG59IGVsc2Uge1xuICAvLyBhIG5vbi1zdGFuZGFyZCBTZXQgcG9seWZpbGwgdGhhdCBvbmx5IHdvcmtzIHdpdGggcHJpbWl0aXZlIGtleXMuXG4gIF9TZXQgPSAoZnVuY3Rpb24gKCkge1xuICAgIGZ1bmN0aW9uIFNldCAoKSB7XG4gICAgICB0aGlzLnNldCA9IE9iamVjdC5jcmVhdGUobnVsbCk7XG4gICAgfVxuICAgIFNldC5wcm90b3R5cGUuaGFzID0gZnVuY3Rpb24gaGFzIChrZXkpIHtcbiAgICAgIHJldHVybiB0aGlzLnNldFtrZXldID09PSB0cnVlXG4gICAgfTtcbiAgICBTZXQucHJvdG90eXBlLmFkZCA9IGZ1bmN0aW9uIGFkZCAoa2V5KSB7XG4gICAgICB0aGlzLnNldFtrZXldID0gdHJ1ZTtcbiAgICB9O1xuICAgIFNldC5wcm90b3R5cGUuY2xlYXIgPSBmdW5jdGlvbiBjbGVhciAoKSB7XG4gICAgICB0aGlzLnNldCA9IE9iamVjdC5jcmVhdGUobnVsbCk7XG4gICAgfTtcblxuICAgIHJldHVybiBTZXQ7XG4gIH0oKSk7XG59XG5cbi8qICAqL1xuXG52YXIgd2FybiA9IG5vb3A7XG52YXIgdGlwID0gbm9vcDtcbnZhciBnZW5lcmF0ZUNvbXBvbmVudFRyYWNlID0gKG5vb3ApOyAvLyB3b3JrIGFyb3VuZCBmbG93IGNoZWNrXG52YXIgZm9ybWF0Q29tcG9uZW50TmFtZSA9IChub29wKTtcblxuaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicpIHtcbiAgdmFyIGhhc0NvbnNvbGUgPSB0eXBlb2YgY29uc29sZSAhPT0gJ3VuZGVmaW5lZCc7XG4gIHZhciBjbGFzc2lmeVJFID0gLyg/Ol58Wy1fXSkoXFx3KS9nO1xuICB2YXIgY2xhc3NpZnkgPSBmdW5jdGlvbiAoc3RyKSB7IHJldHVybiBzdHJcbiAgICAucmVwbGFjZShjbGFzc2lmeVJFLCBmdW5jdGlvbiAoYykgeyByZXR1cm4gYy50b1VwcGVyQ2FzZSgpOyB9KVxuICAgIC5yZXBsYWNlKC9bLV9dL2csICcnKTsgfTtcblxuICB3YXJuID0gZnVuY3Rpb24gKG1zZywgdm0pIHtcbiAgICB2YXIgdHJhY2UgPSB2bSA/IGdlbmVyYXRlQ29tcG9uZW50VHJhY2Uodm0pIDogJyc7XG5cbiAgICBpZiAoY29uZmlnLndhcm5IYW5kbGVyKSB7XG4gICAgICBjb25maWcud2FybkhhbmRsZXIuY2FsbChudWxsLCBtc2csIHZtLCB0cmFjZSk7XG4gICAgfSBlbHNlIGlmIChoYXNDb25zb2xlICYmICghY29uZmlnLnNpbGVudCkpIHtcbiAgICAgIGNvbnNvbGUuZXJyb3IoKFwiW1Z1ZSB3YXJuXTogXCIgKyBtc2cgKyB0cmFjZSkpO1xuICAgIH1cbiAgfTtcblxuICB0aXAgPSBmdW5jdGlvbiAobXNnLCB2bSkge1xuICAgIGlmIChoYXNDb25zb2xlICYmICghY29uZmlnLnNpbGVudCkpIHtcbiAgICAgIGNvbnNvbGUud2FybihcIltWdWUgdGlwXTogXCIgKyBtc2cgKyAoXG4gICAgICAgIHZtID8gZ2VuZXJhdGVDb21wb25lbnRUcmFjZSh2bSkgOiAnJ1xuICAgICAgKSk7XG4gICAgfVxuICB9O1xuXG4gIGZvcm1hdENvbXBvbmVudE5hbWUgPSBmdW5jdGlvbiAodm0sIGluY2x1ZGVGaWxlKSB7XG4gICAgaWYgKHZtLiRyb290ID09PSB2bSkge1xuICAgICAgcmV0dXJuICc8Um9vdD4nXG4gICAgfVxuICAgIHZhciBvcHRpb25zID0gdHlwZW9mIHZtID09PSAnZnVuY3Rpb24nICYmIHZtLmNpZCAhPSBudWxsXG4gICAgICA/IHZtLm9wdGlvbnNcbiAgICAgIDogdm0uX2lzVnVlXG4gICAgICAgID8gdm0uJG9wdGlvbnMgfHwgdm0uY29uc3RydWN0b3Iub3B0aW9uc1xuICAgICAgICA6IHZtIHx8IHt9O1xuICAgIHZhciBuYW1lID0gb3B0aW9ucy5uYW1lIHx8IG9wdGlvbnMuX2NvbXBvbmVudFRhZztcbiAgICB2YXIgZmlsZSA9IG9wdGlvbnMuX19maWxlO1xuICAgIGlmICghbmFtZSAmJiBmaWxlKSB7XG4gICAgICB2YXIgbWF0Y2ggPSBmaWxlLm1hdGNoKC8oW14vXFxcXF0rKVxcLnZ1ZSQvKTtcbiAgICAgIG5hbWUgPSBtYXRjaCAmJiBtYXRjaFsxXTtcbiAgICB9XG5cbiAgICByZXR1cm4gKFxuICAgICAgKG5hbWUgPyAoXCI8XCIgKyAoY2xhc3NpZnkobmFtZSkpICsgXCI+XCIpIDogXCI8QW5vbnltb3VzPlwiKSArXG4gICAgICAoZmlsZSAmJiBpbmNsdWRlRmlsZSAhPT0gZmFsc2UgPyAoXCIgYXQgXCIgKyBmaWxlKSA6ICcnKVxuICAgIClcbiAgfTtcblxuICB2YXIgcmVwZWF0ID0gZnVuY3Rpb24gKHN0ciwgbikge1xuICAgIHZhciByZXMgPSAnJztcbiAgICB3aGlsZSAobikge1xuICAgICAgaWYgKG4gJSAyID09PSAxKSB7IHJlcyArPSBzdHI7IH1cbiAgICAgIGlmIChuID4gMSkgeyBzdHIgKz0gc3RyOyB9XG4gICAgICBuID4+PSAxO1xuICAgIH1cbiAgICByZXR1cm4gcmVzXG4gIH07XG5cbiAgZ2VuZXJhdGVDb21wb25lbnRUcmFjZSA9IGZ1bmN0aW9uICh2bSkge1xuICAgIGlmICh2bS5faXNWdWUgJiYgdm0uJHBhcmVudCkge1xuICAgICAgdmFyIHRyZWUgPSBbXTtcbiAgICAgIHZhciBjdXJyZW50UmVjdXJzaXZlU2VxdWVuY2UgPSAwO1xuICAgICAgd2hpbGUgKHZtKSB7XG4gICAgICAgIGlmICh0cmVlLmxlbmd0aCA+IDApIHtcbiAgICAgICAgICB2YXIgbGFzdCA9IHRyZWVbdHJlZS5sZW5ndGggLSAxXTtcbiAgICAgICAgICBpZiAobGFzdC5jb25zdHJ1Y3RvciA9PT0gdm0uY29uc3RydWN0b3IpIHtcbiAgICAgICAgICAgIGN1cnJlbnRSZWN1cnNpdmVTZXF1ZW5jZSsrO1xuICAgICAgICAgICAgdm0gPSB2bS4kcGFyZW50O1xuICAgICAgICAgICAgY29udGludWVcbiAgICAgICAgICB9IGVsc2UgaWYgKGN1cnJlbnRSZWN1cnNpdmVTZXF1ZW5jZSA+IDApIHtcbiAgICAgICAgICAgIHRyZWVbdHJlZS5sZW5ndGggLSAxXSA9IFtsYXN0LCBjdXJyZW50UmVjdXJzaXZlU2VxdWVuY2VdO1xuICAgICAgICAgICAgY3VycmVudFJlY3Vyc2l2ZVNlcXVlbmNlID0gMDtcbiAgICAgICAgICB9XG4gICAgICAgIH1cbiAgICAgICAgdHJlZS5wdXNoKHZtKTtcbiAgICAgICAgdm0gPSB2bS4kcGFyZW50O1xuICAgICAgfVxuICAgICAgcmV0dXJuICdcXG5cXG5mb3VuZCBpblxcblxcbicgKyB0cmVlXG4gICAgICAgIC5tYXAoZnVuY3Rpb24gKHZtLCBpKSB7IHJldHVybiAoXCJcIiArIChpID09PSAwID8gJy0tLT4gJyA6IHJlcGVhdCgnICcsIDUgKyBpICogMikpICsgKEFycmF5LmlzQXJyYXkodm0pXG4gICAgICAgICAgICA/ICgoZm9ybWF0Q29tcG9uZW50TmFtZSh2bVswXSkpICsgXCIuLi4gKFwiICsgKHZtWzFdKSArIFwiIHJlY3Vyc2l2ZSBjYWxscylcIilcbiAgICAgICAgICAgIDogZm9ybWF0Q29tcG9uZW50TmFtZSh2bSkpKTsgfSlcbiAgICAgICAgLmpvaW4oJ1xcbicpXG4gICAgfSBlbHNlIHtcbiAgICAgIHJldHVybiAoXCJcXG5cXG4oZm91bmQgaW4gXCIgKyAoZm9ybWF0Q29tcG9uZW50TmFtZSh2bSkpICsgXCIpXCIpXG4gICAgfVxuICB9O1xufVxuXG4vKiAgKi9cblxuXG52YXIgdWlkID0gMDtcblxuLyoqXG4gKiBBIGRlcCBpcyBhbiBvYnNlcnZhYmxlIHRoYXQgY2FuIGhhdmUgbXVsdGlwbGVcbiAqIGRpcmVjdGl2ZXMgc3Vic2NyaWJpbmcgdG8gaXQuXG4gKi9cbnZhciBEZXAgPSBmdW5jdGlvbiBEZXAgKCkge1xuICB0aGlzLmlkID0gdWlkKys7XG4gIHRoaXMuc3VicyA9IFtdO1xufTtcblxuRGVwLnByb3RvdHlwZS5hZGRTdWIgPSBmdW5jdGlvbiBhZGRTdWIgKHN1Yikge1xuICB0aGlzLnN1YnMucHVzaChzdWIpO1xufTtcblxuRGVwLnByb3RvdHlwZS5yZW1vdmVTdWIgPSBmdW5jdGlvbiByZW1vdmVTdWIgKHN1Yikge1xuICByZW1vdmUodGhpcy5zdWJzLCBzdWIpO1xufTtcblxuRGVwLnByb3RvdHlwZS5kZXBlbmQgPSBmdW5jdGlvbiBkZXBlbmQgKCkge1xuICBpZiAoRGVwLnRhcmdldCkge1xuICAgIERlcC50YXJnZXQuYWRkRGVwKHRoaXMpO1xuICB9XG59O1xuXG5EZXAucHJvdG90eXBlLm5vdGlmeSA9IGZ1bmN0aW9uIG5vdGlmeSAoKSB7XG4gIC8vIHN0YWJpbGl6ZSB0aGUgc3Vic2NyaWJlciBsaXN0IGZpcnN0XG4gIHZhciBzdWJzID0gdGhpcy5zdWJzLnNsaWNlKCk7XG4gIGZvciAodmFyIGkgPSAwLCBsID0gc3Vicy5sZW5ndGg7IGkgPCBsOyBpKyspIHtcbiAgICBzdWJzW2ldLnVwZGF0ZSgpO1xuICB9XG59O1xuXG4vLyB0aGUgY3VycmVudCB0YXJnZXQgd2F0Y2hlciBiZWluZyBldmFsdWF0ZWQuXG4vLyB0aGlzIGlzIGdsb2JhbGx5IHVuaXF1ZSBiZWNhdXNlIHRoZXJlIGNvdWxkIGJlIG9ubHkgb25lXG4vLyB3YXRjaGVyIGJlaW5nIGV2YWx1YXRlZCBhdCBhbnkgdGltZS5cbkRlcC50YXJnZXQgPSBudWxsO1xudmFyIHRhcmdldFN0YWNrID0gW107XG5cbmZ1bmN0aW9uIHB1c2hUYXJnZXQgKF90YXJnZXQpIHtcbiAgaWYgKERlcC50YXJnZXQpIHsgdGFyZ2V0U3RhY2sucHVzaChEZXAudGFyZ2V0KTsgfVxuICBEZXAudGFyZ2V0ID0gX3RhcmdldDtcbn1cblxuZnVuY3Rpb24gcG9wVGFyZ2V0ICgpIHtcbiAgRGVwLnRhcmdldCA9IHRhcmdldFN0YWNrLnBvcCgpO1xufVxuXG4vKiAgKi9cblxudmFyIFZOb2RlID0gZnVuY3Rpb24gVk5vZGUgKFxuICB0YWcsXG4gIGRhdGEsXG4gIGNoaWxkcmVuLFxuICB0ZXh0LFxuICBlbG0sXG4gIGNvbnRleHQsXG4gIGNvbXBvbmVudE9wdGlvbnMsXG4gIGFzeW5jRmFjdG9yeVxuKSB7XG4gIHRoaXMudGFnID0gdGFnO1xuICB0aGlzLmRhdGEgPSBkYXRhO1xuICB0aGlzLmNoaWxkcmVuID0gY2hpbGRyZW47XG4gIHRoaXMudGV4dCA9IHRleHQ7XG4gIHRoaXMuZWxtID0gZWxtO1xuICB0aGlzLm5zID0gdW5kZWZpbmVkO1xuICB0aGlzLmNvbnRleHQgPSBjb250ZXh0O1xuICB0aGlzLmZuQ29udGV4dCA9IHVuZGVmaW5lZDtcbiAgdGhpcy5mbk9wdGlvbnMgPSB1bmRlZmluZWQ7XG4gIHRoaXMuZm5TY29wZUlkID0gdW5kZWZpbmVkO1xuICB0aGlzLmtleSA9IGRhdGEgJiYgZGF0YS5rZXk7XG4gIHRoaXMuY29tcG9uZW50T3B0aW9ucyA9IGNvbXBvbmVudE9wdGlvbnM7XG4gIHRoaXMuY29tcG9uZW50SW5zdGFuY2UgPSB1bmRlZmluZWQ7XG4gIHRoaXMucGFyZW50ID0gdW5kZWZpbmVkO1xuICB0aGlzLnJhdyA9IGZhbHNlO1xuICB0aGlzLmlzU3RhdGljID0gZmFsc2U7XG4gIHRoaXMuaXNSb290SW5zZXJ0ID0gdHJ1ZTtcbiAgdGhpcy5pc0NvbW1lbnQgPSBmYWxzZTtcbiAgdGhpcy5pc0Nsb25lZCA9IGZhbHNlO1xuICB0aGlzLmlzT25jZSA9IGZhbHNlO1xuICB0aGlzLmFzeW5jRmFjdG9yeSA9IGFzeW5jRmFjdG9yeTtcbiAgdGhpcy5hc3luY01ldGEgPSB1bmRlZmluZWQ7XG4gIHRoaXMuaXNBc3luY1BsYWNlaG9sZGVyID0gZmFsc2U7XG59O1xuXG52YXIgcHJvdG90eXBlQWNjZXNzb3JzID0geyBjaGlsZDogeyBjb25maWd1cmFibGU6IHRydWUgfSB9O1xuXG4vLyBERVBSRUNBVEVEOiBhbGlhcyBmb3IgY29tcG9uZW50SW5zdGFuY2UgZm9yIGJhY2t3YXJkcyBjb21wYXQuXG4vKiBpc3RhbmJ1bCBpZ25vcmUgbmV4dCAqL1xucHJvdG90eXBlQWNjZXNzb3JzLmNoaWxkLmdldCA9IGZ1bmN0aW9uICgpIHtcbiAgcmV0dXJuIHRoaXMuY29tcG9uZW50SW5zdGFuY2Vcbn07XG5cbk9iamVjdC5kZWZpbmVQcm9wZXJ0aWVzKCBWTm9kZS5wcm90b3R5cGUsIHByb3RvdHlwZUFjY2Vzc29ycyApO1xuXG52YXIgY3JlYXRlRW1wdHlWTm9kZSA9IGZ1bmN0aW9uICh0ZXh0KSB7XG4gIGlmICggdGV4dCA9PT0gdm9pZCAwICkgdGV4dCA9ICcnO1xuXG4gIHZhciBub2RlID0gbmV3IFZOb2RlKCk7XG4gIG5vZGUudGV4dCA9IHRleHQ7XG4gIG5vZGUuaXNDb21tZW50ID0gdHJ1ZTtcbiAgcmV0dXJuIG5vZGVcbn07XG5cbmZ1bmN0aW9uIGNyZWF0ZVRleHRWTm9kZSAodmFsKSB7XG4gIHJldHVybiBuZXcgVk5vZGUodW5kZWZpbmVkLCB1bmRlZmluZWQsIHVuZGVmaW5lZCwgU3RyaW5nKHZhbCkpXG59XG5cbi8vIG9wdGltaXplZCBzaGFsbG93IGNsb25lXG4vLyB1c2VkIGZvciBzdGF0aWMgbm9kZXMgYW5kIHNsb3Qgbm9kZXMgYmVjYXVzZSB0aGV5IG1heSBiZSByZXVzZWQgYWNyb3NzXG4vLyBtdWx0aXBsZSByZW5kZXJzLCBjbG9uaW5nIHRoZW0gYXZvaWRzIGVycm9ycyB3aGVuIERPTSBtYW5pcHVsYXRpb25zIHJlbHlcbi8vIG9uIHRoZWlyIGVsbSByZWZlcmVuY2UuXG5mdW5jdGlvbiBjbG9uZVZOb2RlICh2bm9kZSkge1xuICB2YXIgY2xvbmVkID0gbmV3IFZOb2RlKFxuICAgIHZub2RlLnRhZyxcbiAgICB2bm9kZS5kYXRhLFxuICAgIHZub2RlLmNoaWxkcmVuLFxuICAgIHZub2RlLnRleHQsXG4gICAgdm5vZGUuZWxtLFxuICAgIHZub2RlLmNvbnRleHQsXG4gICAgdm5vZGUuY29tcG9uZW50T3B0aW9ucyxcbiAgICB2bm9kZS5hc3luY0ZhY3RvcnlcbiAgKTtcbiAgY2xvbmVkLm5zID0gdm5vZGUubnM7XG4gIGNsb25lZC5pc1N0YXRpYyA9IHZub2RlLmlzU3RhdGljO1xuICBjbG9uZWQua2V5ID0gdm5vZGUua2V5O1xuICBjbG9uZWQuaXNDb21tZW50ID0gdm5vZGUuaXNDb21tZW50O1xuICBjbG9uZWQuZm5Db250ZXh0ID0gdm5vZGUuZm5Db250ZXh0O1xuICBjbG9uZWQuZm5PcHRpb25zID0gdm5vZGUuZm5PcHRpb25zO1xuICBjbG9uZWQuZm5TY29wZUlkID0gdm5vZGUuZm5TY29wZUlkO1xuICBjbG9uZWQuaXNDbG9uZWQgPSB0cnVlO1xuICByZXR1cm4gY2xvbmVkXG59XG5cbi8qXG4gKiBub3QgdHlwZSBjaGVja2luZyB0aGlzIGZpbGUgYmVjYXVzZSBmbG93IGRvZXNuJ3QgcGxheSB3ZWxsIHdpdGhcbiAqIGR5bmFtaWNhbGx5IGFjY2Vzc2luZyBtZXRob2RzIG9uIEFycmF5IHByb3RvdHlwZVxuICovXG5cbnZhciBhcnJheVByb3RvID0gQXJyYXkucHJvdG90eXBlO1xudmFyIGFycmF5TWV0aG9kcyA9IE9iamVjdC5jcmVhdGUoYXJyYXlQcm90byk7XG5cbnZhciBtZXRob2RzVG9QYXRjaCA9IFtcbiAgJ3B1c2gnLFxuICAncG9wJyxcbiAgJ3NoaWZ0JyxcbiAgJ3Vuc2hpZnQnLFxuICAnc3BsaWNlJyxcbiAgJ3NvcnQnLFxuICAncmV2ZXJzZSdcbl07XG5cbi8qKlxuICogSW50ZXJjZXB0IG11dGF0aW5nIG1ldGhvZHMgYW5kIGVtaXQgZXZlbnRzXG4gKi9cbm1ldGhvZHNUb1BhdGNoLmZvckVhY2goZnVuY3Rpb24gKG1ldGhvZCkge1xuICAvLyBjYWNoZSBvcmlnaW5hbCBtZXRob2RcbiAgdmFyIG9yaWdpbmFsID0gYXJyYXlQcm90b1ttZXRob2RdO1xuICBkZWYoYXJyYXlNZXRob2RzLCBtZXRob2QsIGZ1bmN0aW9uIG11dGF0b3IgKCkge1xuICAgIHZhciBhcmdzID0gW10sIGxlbiA9IGFyZ3VtZW50cy5sZW5ndGg7XG4gICAgd2hpbGUgKCBsZW4tLSApIGFyZ3NbIGxlbiBdID0gYXJndW1lbnRzWyBsZW4gXTtcblxuICAgIHZhciByZXN1bHQgPSBvcmlnaW5hbC5hcHBseSh0aGlzLCBhcmdzKTtcbiAgICB2YXIgb2IgPSB0aGlzLl9fb2JfXztcbiAgICB2YXIgaW5zZXJ0ZWQ7XG4gICAgc3dpdGNoIChtZXRob2QpIHtcbiAgICAgIGNhc2UgJ3B1c2gnOlxuICAgICAgY2FzZSAndW5zaGlmdCc6XG4gICAgICAgIGluc2VydGVkID0gYXJncztcbiAgICAgICAgYnJlYWtcbiAgICAgIGNhc2UgJ3NwbGljZSc6XG4gICAgICAgIGluc2VydGVkID0gYXJncy5zbGljZSgyKTtcbiAgICAgICAgYnJlYWtcbiAgICB9XG4gICAgaWYgKGluc2VydGVkKSB7IG9iLm9ic2VydmVBcnJheShpbnNlcnRlZCk7IH1cbiAgICAvLyBub3RpZnkgY2hhbmdlXG4gICAgb2IuZGVwLm5vdGlmeSgpO1xuICAgIHJldHVybiByZXN1bHRcbiAgfSk7XG59KTtcblxuLyogICovXG5cbnZhciBhcnJheUtleXMgPSBPYmplY3QuZ2V0T3duUHJvcGVydHlOYW1lcyhhcnJheU1ldGhvZHMpO1xuXG4vKipcbiAqIEluIHNvbWUgY2FzZXMgd2UgbWF5IHdhbnQgdG8gZGlzYWJsZSBvYnNlcnZhdGlvbiBpbnNpZGUgYSBjb21wb25lbnQnc1xuICogdXBkYXRlIGNvbXB1dGF0aW9uLlxuICovXG52YXIgc2hvdWxkT2JzZXJ2ZSA9IHRydWU7XG5cbmZ1bmN0aW9uIHRvZ2dsZU9ic2VydmluZyAodmFsdWUpIHtcbiAgc2hvdWxkT2JzZXJ2ZSA9IHZhbHVlO1xufVxuXG4vKipcbiAqIE9ic2VydmVyIGNsYXNzIHRoYXQgaXMgYXR0YWNoZWQgdG8gZWFjaCBvYnNlcnZlZFxuICogb2JqZWN0LiBPbmNlIGF0dGFjaGVkLCB0aGUgb2JzZXJ2ZXIgY29udmVydHMgdGhlIHRhcmdldFxuICogb2JqZWN0J3MgcHJvcGVydHkga2V5cyBpbnRvIGdldHRlci9zZXR0ZXJzIHRoYXRcbiAqIGNvbGxlY3QgZGVwZW5kZW5jaWVzIGFuZCBkaXNwYXRjaCB1cGRhdGVzLlxuICovXG52YXIgT2JzZXJ2ZXIgPSBmdW5jdGlvbiBPYnNlcnZlciAodmFsdWUpIHtcbiAgdGhpcy52YWx1ZSA9IHZhbHVlO1xuICB0aGlzLmRlcCA9IG5ldyBEZXAoKTtcbiAgdGhpcy52bUNvdW50ID0gMDtcbiAgZGVmKHZhbHVlLCAnX19vYl9fJywgdGhpcyk7XG4gIGlmIChBcnJheS5pc0FycmF5KHZhbHVlKSkge1xuICAgIHZhciBhdWdtZW50ID0gaGFzUHJvdG9cbiAgICAgID8gcHJvdG9BdWdtZW50XG4gICAgICA6IGNvcHlBdWdtZW50O1xuICAgIGF1Z21lbnQodmFsdWUsIGFycmF5TWV0aG9kcywgYXJyYXlLZXlzKTtcbiAgICB0aGlzLm9ic2VydmVBcnJheSh2YWx1ZSk7XG4gIH0gZWxzZSB7XG4gICAgdGhpcy53YWxrKHZhbHVlKTtcbiAgfVxufTtcblxuLyoqXG4gKiBXYWxrIHRocm91Z2ggZWFjaCBwcm9wZXJ0eSBhbmQgY29udmVydCB0aGVtIGludG9cbiAqIGdldHRlci9zZXR0ZXJzLiBUaGlzIG1ldGhvZCBzaG91bGQgb25seSBiZSBjYWxsZWQgd2hlblxuICogdmFsdWUgdHlwZSBpcyBPYmplY3QuXG4gKi9cbk9ic2VydmVyLnByb3RvdHlwZS53YWxrID0gZnVuY3Rpb24gd2FsayAob2JqKSB7XG4gIHZhciBrZXlzID0gT2JqZWN0LmtleXMob2JqKTtcbiAgZm9yICh2YXIgaSA9IDA7IGkgPCBrZXlzLmxlbmd0aDsgaSsrKSB7XG4gICAgZGVmaW5lUmVhY3RpdmUob2JqLCBrZXlzW2ldKTtcbiAgfVxufTtcblxuLyoqXG4gKiBPYnNlcnZlIGEgbGlzdCBvZiBBcnJheSBpdGVtcy5cbiAqL1xuT2JzZXJ2ZXIucHJvdG90eXBlLm9ic2VydmVBcnJheSA9IGZ1bmN0aW9uIG9ic2VydmVBcnJheSAoaXRlbXMpIHtcbiAgZm9yICh2YXIgaSA9IDAsIGwgPSBpdGVtcy5sZW5ndGg7IGkgPCBsOyBpKyspIHtcbiAgICBvYnNlcnZlKGl0ZW1zW2ldKTtcbiAgfVxufTtcblxuLy8gaGVscGVyc1xuXG4vKipcbiAqIEF1Z21lbnQgYW4gdGFyZ2V0IE9iamVjdCBvciBBcnJheSBieSBpbnRlcmNlcHRpbmdcbiAqIHRoZSBwcm90b3R5cGUgY2hhaW4gdXNpbmcgX19wcm90b19fXG4gKi9cbmZ1bmN0aW9uIHByb3RvQXVnbWVudCAodGFyZ2V0LCBzcmMsIGtleXMpIHtcbiAgLyogZXNsaW50LWRpc2FibGUgbm8tcHJvdG8gKi9cbiAgdGFyZ2V0Ll9fcHJvdG9fXyA9IHNyYztcbiAgLyogZXNsaW50LWVuYWJsZSBuby1wcm90byAqL1xufVxuXG4vKipcbiAqIEF1Z21lbnQgYW4gdGFyZ2V0IE9iamVjdCBvciBBcnJheSBieSBkZWZpbmluZ1xuICogaGlkZGVuIHByb3BlcnRpZXMuXG4gKi9cbi8qIGlzdGFuYnVsIGlnbm9yZSBuZXh0ICovXG5mdW5jdGlvbiBjb3B5QXVnbWVudCAodGFyZ2V0LCBzcmMsIGtleXMpIHtcbiAgZm9yICh2YXIgaSA9IDAsIGwgPSBrZXlzLmxlbmd0aDsgaSA8IGw7IGkrKykge1xuICAgIHZhciBrZXkgPSBrZXlzW2ldO1xuICAgIGRlZih0YXJnZXQsIGtleSwgc3JjW2tleV0pO1xuICB9XG59XG5cbi8qKlxuICogQXR0ZW1wdCB0byBjcmVhdGUgYW4gb2JzZXJ2ZXIgaW5zdGFuY2UgZm9yIGEgdmFsdWUsXG4gKiByZXR1cm5zIHRoZSBuZXcgb2JzZXJ2ZXIgaWYgc3VjY2Vzc2Z1bGx5IG9ic2VydmVkLFxuICogb3IgdGhlIGV4aXN0aW5nIG9ic2VydmVyIGlmIHRoZSB2YWx1ZSBhbHJlYWR5IGhhcyBvbmUuXG4gKi9cbmZ1bmN0aW9uIG9ic2VydmUgKHZhbHVlLCBhc1Jvb3REYXRhKSB7XG4gIGlmICghaXNPYmplY3QodmFsdWUpIHx8IHZhbHVlIGluc3RhbmNlb2YgVk5vZGUpIHtcbiAgICByZXR1cm5cbiAgfVxuICB2YXIgb2I7XG4gIGlmIChoYXNPd24odmFsdWUsICdfX29iX18nKSAmJiB2YWx1ZS5fX29iX18gaW5zdGFuY2VvZiBPYnNlcnZlcikge1xuICAgIG9iID0gdmFsdWUuX19vYl9fO1xuICB9IGVsc2UgaWYgKFxuICAgIHNob3VsZE9ic2VydmUgJiZcbiAgICAhaXNTZXJ2ZXJSZW5kZXJpbmcoKSAmJlxuICAgIChBcnJheS5pc0FycmF5KHZhbHVlKSB8fCBpc1BsYWluT2JqZWN0KHZhbHVlKSkgJiZcbiAgICBPYmplY3QuaXNFeHRlbnNpYmxlKHZhbHVlKSAmJlxuICAgICF2YWx1ZS5faXNWdWVcbiAgKSB7XG4gICAgb2IgPSBuZXcgT2JzZXJ2ZXIodmFsdWUpO1xuICB9XG4gIGlmIChhc1Jvb3REYXRhICYmIG9iKSB7XG4gICAgb2Iudm1Db3VudCsrO1xuICB9XG4gIHJldHVybiBvYlxufVxuXG4vKipcbiAqIERlZmluZSBhIHJlYWN0aXZlIHByb3BlcnR5IG9uIGFuIE9iamVjdC5cbiAqL1xuZnVuY3Rpb24gZGVmaW5lUmVhY3RpdmUgKFxuICBvYmosXG4gIGtleSxcbiAgdmFsLFxuICBjdXN0b21TZXR0ZXIsXG4gIHNoYWxsb3dcbikge1xuICB2YXIgZGVwID0gbmV3IERlcCgpO1xuXG4gIHZhciBwcm9wZXJ0eSA9IE9iamVjdC5nZXRPd25Qcm9wZXJ0eURlc2NyaXB0b3Iob2JqLCBrZXkpO1xuICBpZiAocHJvcGVydHkgJiYgcHJvcGVydHkuY29uZmlndXJhYmxlID09PSBmYWxzZSkge1xuICAgIHJldHVyblxuICB9XG5cbiAgLy8gY2F0ZXIgZm9yIHByZS1kZWZpbmVkIGdldHRlci9zZXR0ZXJzXG4gIHZhciBnZXR0ZXIgPSBwcm9wZXJ0eSAmJiBwcm9wZXJ0eS5nZXQ7XG4gIGlmICghZ2V0dGVyICYmIGFyZ3VtZW50cy5sZW5ndGggPT09IDIpIHtcbiAgICB2YWwgPSBvYmpba2V5XTtcbiAgfVxuICB2YXIgc2V0dGVyID0gcHJvcGVydHkgJiYgcHJvcGVydHkuc2V0O1xuXG4gIHZhciBjaGlsZE9iID0gIXNoYWxsb3cgJiYgb2JzZXJ2ZSh2YWwpO1xuICBPYmplY3QuZGVmaW5lUHJvcGVydHkob2JqLCBrZXksIHtcbiAgICBlbnVtZXJhYmxlOiB0cnVlLFxuICAgIGNvbmZpZ3VyYWJsZTogdHJ1ZSxcbiAgICBnZXQ6IGZ1bmN0aW9uIHJlYWN0aXZlR2V0dGVyICgpIHtcbiAgICAgIHZhciB2YWx1ZSA9IGdldHRlciA/IGdldHRlci5jYWxsKG9iaikgOiB2YWw7XG4gICAgICBpZiAoRGVwLnRhcmdldCkge1xuICAgICAgICBkZXAuZGVwZW5kKCk7XG4gICAgICAgIGlmIChjaGlsZE9iKSB7XG4gICAgICAgICAgY2hpbGRPYi5kZXAuZGVwZW5kKCk7XG4gICAgICAgICAgaWYgKEFycmF5LmlzQXJyYXkodmFsdWUpKSB7XG4gICAgICAgICAgICBkZXBlbmRBcnJheSh2YWx1ZSk7XG4gICAgICAgICAgfVxuICAgICAgICB9XG4gICAgICB9XG4gICAgICByZXR1cm4gdmFsdWVcbiAgICB9LFxuICAgIHNldDogZnVuY3Rpb24gcmVhY3RpdmVTZXR0ZXIgKG5ld1ZhbCkge1xuICAgICAgdmFyIHZhbHVlID0gZ2V0dGVyID8gZ2V0dGVyLmNhbGwob2JqKSA6IHZhbDtcbiAgICAgIC8qIGVzbGludC1kaXNhYmxlIG5vLXNlbGYtY29tcGFyZSAqL1xuICAgICAgaWYgKG5ld1ZhbCA9PT0gdmFsdWUgfHwgKG5ld1ZhbCAhPT0gbmV3VmFsICYmIHZhbHVlICE9PSB2YWx1ZSkpIHtcbiAgICAgICAgcmV0dXJuXG4gICAgICB9XG4gICAgICAvKiBlc2xpbnQtZW5hYmxlIG5vLXNlbGYtY29tcGFyZSAqL1xuICAgICAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiYgY3VzdG9tU2V0dGVyKSB7XG4gICAgICAgIGN1c3RvbVNldHRlcigpO1xuICAgICAgfVxuICAgICAgaWYgKHNldHRlcikge1xuICAgICAgICBzZXR0ZXIuY2FsbChvYmosIG5ld1ZhbCk7XG4gICAgICB9IGVsc2Uge1xuICAgICAgICB2YWwgPSBuZXdWYWw7XG4gICAgICB9XG4gICAgICBjaGlsZE9iID0gIXNoYWxsb3cgJiYgb2JzZXJ2ZShuZXdWYWwpO1xuICAgICAgZGVwLm5vdGlmeSgpO1xuICAgIH1cbiAgfSk7XG59XG5cbi8qKlxuICogU2V0IGEgcHJvcGVydHkgb24gYW4gb2JqZWN0LiBBZGRzIHRoZSBuZXcgcHJvcGVydHkgYW5kXG4gKiB0cmlnZ2VycyBjaGFuZ2Ugbm90aWZpY2F0aW9uIGlmIHRoZSBwcm9wZXJ0eSBkb2Vzbid0XG4gKiBhbHJlYWR5IGV4aXN0LlxuICovXG5mdW5jdGlvbiBzZXQgKHRhcmdldCwga2V5LCB2YWwpIHtcbiAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiZcbiAgICAoaXNVbmRlZih0YXJnZXQpIHx8IGlzUHJpbWl0aXZlKHRhcmdldCkpXG4gICkge1xuICAgIHdhcm4oKFwiQ2Fubm90IHNldCByZWFjdGl2ZSBwcm9wZXJ0eSBvbiB1bmRlZmluZWQsIG51bGwsIG9yIHByaW1pdGl2ZSB2YWx1ZTogXCIgKyAoKHRhcmdldCkpKSk7XG4gIH1cbiAgaWYgKEFycmF5LmlzQXJyYXkodGFyZ2V0KSAmJiBpc1ZhbGlkQXJyYXlJbmRleChrZXkpKSB7XG4gICAgdGFyZ2V0Lmxlbmd0aCA9IE1hdGgubWF4KHRhcmdldC5sZW5ndGgsIGtleSk7XG4gICAgdGFyZ2V0LnNwbGljZShrZXksIDEsIHZhbCk7XG4gICAgcmV0dXJuIHZhbFxuICB9XG4gIGlmIChrZXkgaW4gdGFyZ2V0ICYmICEoa2V5IGluIE9iamVjdC5wcm90b3R5cGUpKSB7XG4gICAgdGFyZ2V0W2tleV0gPSB2YWw7XG4gICAgcmV0dXJuIHZhbFxuICB9XG4gIHZhciBvYiA9ICh0YXJnZXQpLl9fb2JfXztcbiAgaWYgKHRhcmdldC5faXNWdWUgfHwgKG9iICYmIG9iLnZtQ291bnQpKSB7XG4gICAgcHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJiB3YXJuKFxuICAgICAgJ0F2b2lkIGFkZGluZyByZWFjdGl2ZSBwcm9wZXJ0aWVzIHRvIGEgVnVlIGluc3RhbmNlIG9yIGl0cyByb290ICRkYXRhICcgK1xuICAgICAgJ2F0IHJ1bnRpbWUgLSBkZWNsYXJlIGl0IHVwZnJvbnQgaW4gdGhlIGRhdGEgb3B0aW9uLidcbiAgICApO1xuICAgIHJldHVybiB2YWxcbiAgfVxuICBpZiAoIW9iKSB7XG4gICAgdGFyZ2V0W2tleV0gPSB2YWw7XG4gICAgcmV0dXJuIHZhbFxuICB9XG4gIGRlZmluZVJlYWN0aXZlKG9iLnZhbHVlLCBrZXksIHZhbCk7XG4gIG9iLmRlcC5ub3RpZnkoKTtcbiAgcmV0dXJuIHZhbFxufVxuXG4vKipcbiAqIERlbGV0ZSBhIHByb3BlcnR5IGFuZCB0cmlnZ2VyIGNoYW5nZSBpZiBuZWNlc3NhcnkuXG4gKi9cbmZ1bmN0aW9uIGRlbCAodGFyZ2V0LCBrZXkpIHtcbiAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiZcbiAgICAoaXNVbmRlZih0YXJnZXQpIHx8IGlzUHJpbWl0aXZlKHRhcmdldCkpXG4gICkge1xuICAgIHdhcm4oKFwiQ2Fubm90IGRlbGV0ZSByZWFjdGl2ZSBwcm9wZXJ0eSBvbiB1bmRlZmluZWQsIG51bGwsIG9yIHByaW1pdGl2ZSB2YWx1ZTogXCIgKyAoKHRhcmdldCkpKSk7XG4gIH1cbiAgaWYgKEFycmF5LmlzQXJyYXkodGFyZ2V0KSAmJiBpc1ZhbGlkQXJyYXlJbmRleChrZXkpKSB7XG4gICAgdGFyZ2V0LnNwbGljZShrZXksIDEpO1xuICAgIHJldHVyblxuICB9XG4gIHZhciBvYiA9ICh0YXJnZXQpLl9fb2JfXztcbiAgaWYgKHRhcmdldC5faXNWdWUgfHwgKG9iICYmIG9iLnZtQ291bnQpKSB7XG4gICAgcHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJiB3YXJuKFxuICAgICAgJ0F2b2lkIGRlbGV0aW5nIHByb3BlcnRpZXMgb24gYSBWdWUgaW5zdGFuY2Ugb3IgaXRzIHJvb3QgJGRhdGEgJyArXG4gICAgICAnLSBqdXN0IHNldCBpdCB0byBudWxsLidcbiAgICApO1xuICAgIHJldHVyblxuICB9XG4gIGlmICghaGFzT3duKHRhcmdldCwga2V5KSkge1xuICAgIHJldHVyblxuICB9XG4gIGRlbGV0ZSB0YXJnZXRba2V5XTtcbiAgaWYgKCFvYikge1xuICAgIHJldHVyblxuICB9XG4gIG9iLmRlcC5ub3RpZnkoKTtcbn1cblxuLyoqXG4gKiBDb2xsZWN0IGRlcGVuZGVuY2llcyBvbiBhcnJheSBlbGVtZW50cyB3aGVuIHRoZSBhcnJheSBpcyB0b3VjaGVkLCBzaW5jZVxuICogd2UgY2Fubm90IGludGVyY2VwdCBhcnJheSBlbGVtZW50IGFjY2VzcyBsaWtlIHByb3BlcnR5IGdldHRlcnMuXG4gKi9cbmZ1bmN0aW9uIGRlcGVuZEFycmF5ICh2YWx1ZSkge1xuICBmb3IgKHZhciBlID0gKHZvaWQgMCksIGkgPSAwLCBsID0gdmFsdWUubGVuZ3RoOyBpIDwgbDsgaSsrKSB7XG4gICAgZSA9IHZhbHVlW2ldO1xuICAgIGUgJiYgZS5fX29iX18gJiYgZS5fX29iX18uZGVwLmRlcGVuZCgpO1xuICAgIGlmIChBcnJheS5pc0FycmF5KGUpKSB7XG4gICAgICBkZXBlbmRBcnJheShlKTtcbiAgICB9XG4gIH1cbn1cblxuLyogICovXG5cbi8qKlxuICogT3B0aW9uIG92ZXJ3cml0aW5nIHN0cmF0ZWdpZXMgYXJlIGZ1bmN0aW9ucyB0aGF0IGhhbmRsZVxuICogaG93IHRvIG1lcmdlIGEgcGFyZW50IG9wdGlvbiB2YWx1ZSBhbmQgYSBjaGlsZCBvcHRpb25cbiAqIHZhbHVlIGludG8gdGhlIGZpbmFsIHZhbHVlLlxuICovXG52YXIgc3RyYXRzID0gY29uZmlnLm9wdGlvbk1lcmdlU3RyYXRlZ2llcztcblxuLyoqXG4gKiBPcHRpb25zIHdpdGggcmVzdHJpY3Rpb25zXG4gKi9cbmlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nKSB7XG4gIHN0cmF0cy5lbCA9IHN0cmF0cy5wcm9wc0RhdGEgPSBmdW5jdGlvbiAocGFyZW50LCBjaGlsZCwgdm0sIGtleSkge1xuICAgIGlmICghdm0pIHtcbiAgICAgIHdhcm4oXG4gICAgICAgIFwib3B0aW9uIFxcXCJcIiArIGtleSArIFwiXFxcIiBjYW4gb25seSBiZSB1c2VkIGR1cmluZyBpbnN0YW5jZSBcIiArXG4gICAgICAgICdjcmVhdGlvbiB3aXRoIHRoZSBgbmV3YCBrZXl3b3JkLidcbiAgICAgICk7XG4gICAgfVxuICAgIHJldHVybiBkZWZhdWx0U3RyYXQocGFyZW50LCBjaGlsZClcbiAgfTtcbn1cblxuLyoqXG4gKiBIZWxwZXIgdGhhdCByZWN1cnNpdmVseSBtZXJnZXMgdHdvIGRhdGEgb2JqZWN0cyB0b2dldGhlci5cbiAqL1xuZnVuY3Rpb24gbWVyZ2VEYXRhICh0bywgZnJvbSkge1xuICBpZiAoIWZyb20pIHsgcmV0dXJuIHRvIH1cbiAgdmFyIGtleSwgdG9WYWwsIGZyb21WYWw7XG4gIHZhciBrZXlzID0gT2JqZWN0LmtleXMoZnJvbSk7XG4gIGZvciAodmFyIGkgPSAwOyBpIDwga2V5cy5sZW5ndGg7IGkrKykge1xuICAgIGtleSA9IGtleXNbaV07XG4gICAgdG9WYWwgPSB0b1trZXldO1xuICAgIGZyb21WYWwgPSBmcm9tW2tleV07XG4gICAgaWYgKCFoYXNPd24odG8sIGtleSkpIHtcbiAgICAgIHNldCh0bywga2V5LCBmcm9tVmFsKTtcbiAgICB9IGVsc2UgaWYgKGlzUGxhaW5PYmplY3QodG9WYWwpICYmIGlzUGxhaW5PYmplY3QoZnJvbVZhbCkpIHtcbiAgICAgIG1lcmdlRGF0YSh0b1ZhbCwgZnJvbVZhbCk7XG4gICAgfVxuICB9XG4gIHJldHVybiB0b1xufVxuXG4vKipcbiAqIERhdGFcbiAqL1xuZnVuY3Rpb24gbWVyZ2VEYXRhT3JGbiAoXG4gIHBhcmVudFZhbCxcbiAgY2hpbGRWYWwsXG4gIHZtXG4pIHtcbiAgaWYgKCF2bSkge1xuICAgIC8vIGluIGEgVnVlLmV4dGVuZCBtZXJnZSwgYm90aCBzaG91bGQgYmUgZnVuY3Rpb25zXG4gICAgaWYgKCFjaGlsZFZhbCkge1xuICAgICAgcmV0dXJuIHBhcmVudFZhbFxuICAgIH1cbiAgICBpZiAoIXBhcmVudFZhbCkge1xuICAgICAgcmV0dXJuIGNoaWxkVmFsXG4gICAgfVxuICAgIC8vIHdoZW4gcGFyZW50VmFsICYgY2hpbGRWYWwgYXJlIGJvdGggcHJlc2VudCxcbiAgICAvLyB3ZSBuZWVkIHRvIHJldHVybiBhIGZ1bmN0aW9uIHRoYXQgcmV0dXJucyB0aGVcbiAgICAvLyBtZXJnZWQgcmVzdWx0IG9mIGJvdGggZnVuY3Rpb25zLi4uIG5vIG5lZWQgdG9cbiAgICAvLyBjaGVjayBpZiBwYXJlbnRWYWwgaXMgYSBmdW5jdGlvbiBoZXJlIGJlY2F1c2VcbiAgICAvLyBpdCBoYXMgdG8gYmUgYSBmdW5jdGlvbiB0byBwYXNzIHByZXZpb3VzIG1lcmdlcy5cbiAgICByZXR1cm4gZnVuY3Rpb24gbWVyZ2VkRGF0YUZuICgpIHtcbiAgICAgIHJldHVybiBtZXJnZURhdGEoXG4gICAgICAgIHR5cGVvZiBjaGlsZFZhbCA9PT0gJ2Z1bmN0aW9uJyA/IGNoaWxkVmFsLmNhbGwodGhpcywgdGhpcykgOiBjaGlsZFZhbCxcbiAgICAgICAgdHlwZW9mIHBhcmVudFZhbCA9PT0gJ2Z1bmN0aW9uJyA/IHBhcmVudFZhbC5jYWxsKHRoaXMsIHRoaXMpIDogcGFyZW50VmFsXG4gICAgICApXG4gICAgfVxuICB9IGVsc2Uge1xuICAgIHJldHVybiBmdW5jdGlvbiBtZXJnZWRJbnN0YW5jZURhdGFGbiAoKSB7XG4gICAgICAvLyBpbnN0YW5jZSBtZXJnZVxuICAgICAgdmFyIGluc3RhbmNlRGF0YSA9IHR5cGVvZiBjaGlsZFZhbCA9PT0gJ2Z1bmN0aW9uJ1xuICAgICAgICA/IGNoaWxkVmFsLmNhbGwodm0sIHZtKVxuICAgICAgICA6IGNoaWxkVmFsO1xuICAgICAgdmFyIGRlZmF1bHREYXRhID0gdHlwZW9mIHBhcmVudFZhbCA9PT0gJ2Z1bmN0aW9uJ1xuICAgICAgICA/IHBhcmVudFZhbC5jYWxsKHZtLCB2bSlcbiAgICAgICAgOiBwYXJlbnRWYWw7XG4gICAgICBpZiAoaW5zdGFuY2VEYXRhKSB7XG4gICAgICAgIHJldHVybiBtZXJnZURhdGEoaW5zdGFuY2VEYXRhLCBkZWZhdWx0RGF0YSlcbiAgICAgIH0gZWxzZSB7XG4gICAgICAgIHJldHVybiBkZWZhdWx0RGF0YVxuICAgICAgfVxuICAgIH1cbiAgfVxufVxuXG5zdHJhdHMuZGF0YSA9IGZ1bmN0aW9uIChcbiAgcGFyZW50VmFsLFxuICBjaGlsZFZhbCxcbiAgdm1cbikge1xuICBpZiAoIXZtKSB7XG4gICAgaWYgKGNoaWxkVmFsICYmIHR5cGVvZiBjaGlsZFZhbCAhPT0gJ2Z1bmN0aW9uJykge1xuICAgICAgcHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJiB3YXJuKFxuICAgICAgICAnVGhlIFwiZGF0YVwiIG9wdGlvbiBzaG91bGQgYmUgYSBmdW5jdGlvbiAnICtcbiAgICAgICAgJ3RoYXQgcmV0dXJucyBhIHBlci1pbnN0YW5jZSB2YWx1ZSBpbiBjb21wb25lbnQgJyArXG4gICAgICAgICdkZWZpbml0aW9ucy4nLFxuICAgICAgICB2bVxuICAgICAgKTtcblxuICAgICAgcmV0dXJuIHBhcmVudFZhbFxuICAgIH1cbiAgICByZXR1cm4gbWVyZ2VEYXRhT3JGbihwYXJlbnRWYWwsIGNoaWxkVmFsKVxuICB9XG5cbiAgcmV0dXJuIG1lcmdlRGF0YU9yRm4ocGFyZW50VmFsLCBjaGlsZFZhbCwgdm0pXG59O1xuXG4vKipcbiAqIEhvb2tzIGFuZCBwcm9wcyBhcmUgbWVyZ2VkIGFzIGFycmF5cy5cbiAqL1xuZnVuY3Rpb24gbWVyZ2VIb29rIChcbiAgcGFyZW50VmFsLFxuICBjaGlsZFZhbFxuKSB7XG4gIHJldHVybiBjaGlsZFZhbFxuICAgID8gcGFyZW50VmFsXG4gICAgICA/IHBhcmVudFZhbC5jb25jYXQoY2hpbGRWYWwpXG4gICAgICA6IEFycmF5LmlzQXJyYXkoY2hpbGRWYWwpXG4gICAgICAgID8gY2hpbGRWYWxcbiAgICAgICAgOiBbY2hpbGRWYWxdXG4gICAgOiBwYXJlbnRWYWxcbn1cblxuTElGRUNZQ0xFX0hPT0tTLmZvckVhY2goZnVuY3Rpb24gKGhvb2spIHtcbiAgc3RyYXRzW2hvb2tdID0gbWVyZ2VIb29rO1xufSk7XG5cbi8qKlxuICogQXNzZXRzXG4gKlxuICogV2hlbiBhIHZtIGlzIHByZXNlbnQgKGluc3RhbmNlIGNyZWF0aW9uKSwgd2UgbmVlZCB0byBkb1xuICogYSB0aHJlZS13YXkgbWVyZ2UgYmV0d2VlbiBjb25zdHJ1Y3RvciBvcHRpb25zLCBpbnN0YW5jZVxuICogb3B0aW9ucyBhbmQgcGFyZW50IG9wdGlvbnMuXG4gKi9cbmZ1bmN0aW9uIG1lcmdlQXNzZXRzIChcbiAgcGFyZW50VmFsLFxuICBjaGlsZFZhbCxcbiAgdm0sXG4gIGtleVxuKSB7XG4gIHZhciByZXMgPSBPYmplY3QuY3JlYXRlKHBhcmVudFZhbCB8fCBudWxsKTtcbiAgaWYgKGNoaWxkVmFsKSB7XG4gICAgcHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJiBhc3NlcnRPYmplY3RUeXBlKGtleSwgY2hpbGRWYWwsIHZtKTtcbiAgICByZXR1cm4gZXh0ZW5kKHJlcywgY2hpbGRWYWwpXG4gIH0gZWxzZSB7XG4gICAgcmV0dXJuIHJlc1xuICB9XG59XG5cbkFTU0VUX1RZUEVTLmZvckVhY2goZnVuY3Rpb24gKHR5cGUpIHtcbiAgc3RyYXRzW3R5cGUgKyAncyddID0gbWVyZ2VBc3NldHM7XG59KTtcblxuLyoqXG4gKiBXYXRjaGVycy5cbiAqXG4gKiBXYXRjaGVycyBoYXNoZXMgc2hvdWxkIG5vdCBvdmVyd3JpdGUgb25lXG4gKiBhbm90aGVyLCBzbyB3ZSBtZXJnZSB0aGVtIGFzIGFycmF5cy5cbiAqL1xuc3RyYXRzLndhdGNoID0gZnVuY3Rpb24gKFxuICBwYXJlbnRWYWwsXG4gIGNoaWxkVmFsLFxuICB2bSxcbiAga2V5XG4pIHtcbiAgLy8gd29yayBhcm91bmQgRmlyZWZveCdzIE9iamVjdC5wcm90b3R5cGUud2F0Y2guLi5cbiAgaWYgKHBhcmVudFZhbCA9PT0gbmF0aXZlV2F0Y2gpIHsgcGFyZW50VmFsID0gdW5kZWZpbmVkOyB9XG4gIGlmIChjaGlsZFZhbCA9PT0gbmF0aXZlV2F0Y2gpIHsgY2hpbGRWYWwgPSB1bmRlZmluZWQ7IH1cbiAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gIGlmICghY2hpbGRWYWwpIHsgcmV0dXJuIE9iamVjdC5jcmVhdGUocGFyZW50VmFsIHx8IG51bGwpIH1cbiAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicpIHtcbiAgICBhc3NlcnRPYmplY3RUeXBlKGtleSwgY2hpbGRWYWwsIHZtKTtcbiAgfVxuICBpZiAoIXBhcmVudFZhbCkgeyByZXR1cm4gY2hpbGRWYWwgfVxuICB2YXIgcmV0ID0ge307XG4gIGV4dGVuZChyZXQsIHBhcmVudFZhbCk7XG4gIGZvciAodmFyIGtleSQxIGluIGNoaWxkVmFsKSB7XG4gICAgdmFyIHBhcmVudCA9IHJldFtrZXkkMV07XG4gICAgdmFyIGNoaWxkID0gY2hpbGRWYWxba2V5JDFdO1xuICAgIGlmIChwYXJlbnQgJiYgIUFycmF5LmlzQXJyYXkocGFyZW50KSkge1xuICAgICAgcGFyZW50ID0gW3BhcmVudF07XG4gICAgfVxuICAgIHJldFtrZXkkMV0gPSBwYXJlbnRcbiAgICAgID8gcGFyZW50LmNvbmNhdChjaGlsZClcbiAgICAgIDogQXJyYXkuaXNBcnJheShjaGlsZCkgPyBjaGlsZCA6IFtjaGlsZF07XG4gIH1cbiAgcmV0dXJuIHJldFxufTtcblxuLyoqXG4gKiBPdGhlciBvYmplY3QgaGFzaGVzLlxuICovXG5zdHJhdHMucHJvcHMgPVxuc3RyYXRzLm1ldGhvZHMgPVxuc3RyYXRzLmluamVjdCA9XG5zdHJhdHMuY29tcHV0ZWQgPSBmdW5jdGlvbiAoXG4gIHBhcmVudFZhbCxcbiAgY2hpbGRWYWwsXG4gIHZtLFxuICBrZXlcbikge1xuICBpZiAoY2hpbGRWYWwgJiYgcHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgIGFzc2VydE9iamVjdFR5cGUoa2V5LCBjaGlsZFZhbCwgdm0pO1xuICB9XG4gIGlmICghcGFyZW50VmFsKSB7IHJldHVybiBjaGlsZFZhbCB9XG4gIHZhciByZXQgPSBPYmplY3QuY3JlYXRlKG51bGwpO1xuICBleHRlbmQocmV0LCBwYXJlbnRWYWwpO1xuICBpZiAoY2hpbGRWYWwpIHsgZXh0ZW5kKHJldCwgY2hpbGRWYWwpOyB9XG4gIHJldHVybiByZXRcbn07XG5zdHJhdHMucHJvdmlkZSA9IG1lcmdlRGF0YU9yRm47XG5cbi8qKlxuICogRGVmYXVsdCBzdHJhdGVneS5cbiAqL1xudmFyIGRlZmF1bHRTdHJhdCA9IGZ1bmN0aW9uIChwYXJlbnRWYWwsIGNoaWxkVmFsKSB7XG4gIHJldHVybiBjaGlsZFZhbCA9PT0gdW5kZWZpbmVkXG4gICAgPyBwYXJlbnRWYWxcbiAgICA6IGNoaWxkVmFsXG59O1xuXG4vKipcbiAqIFZhbGlkYXRlIGNvbXBvbmVudCBuYW1lc1xuICovXG5mdW5jdGlvbiBjaGVja0NvbXBvbmVudHMgKG9wdGlvbnMpIHtcbiAgZm9yICh2YXIga2V5IGluIG9wdGlvbnMuY29tcG9uZW50cykge1xuICAgIHZhbGlkYXRlQ29tcG9uZW50TmFtZShrZXkpO1xuICB9XG59XG5cbmZ1bmN0aW9uIHZhbGlkYXRlQ29tcG9uZW50TmFtZSAobmFtZSkge1xuICBpZiAoIS9eW2EtekEtWl1bXFx3LV0qJC8udGVzdChuYW1lKSkge1xuICAgIHdhcm4oXG4gICAgICAnSW52YWxpZCBjb21wb25lbnQgbmFtZTogXCInICsgbmFtZSArICdcIi4gQ29tcG9uZW50IG5hbWVzICcgK1xuICAgICAgJ2NhbiBvbmx5IGNvbnRhaW4gYWxwaGFudW1lcmljIGNoYXJhY3RlcnMgYW5kIHRoZSBoeXBoZW4sICcgK1xuICAgICAgJ2FuZCBtdXN0IHN0YXJ0IHdpdGggYSBsZXR0ZXIuJ1xuICAgICk7XG4gIH1cbiAgaWYgKGlzQnVpbHRJblRhZyhuYW1lKSB8fCBjb25maWcuaXNSZXNlcnZlZFRhZyhuYW1lKSkge1xuICAgIHdhcm4oXG4gICAgICAnRG8gbm90IHVzZSBidWlsdC1pbiBvciByZXNlcnZlZCBIVE1MIGVsZW1lbnRzIGFzIGNvbXBvbmVudCAnICtcbiAgICAgICdpZDogJyArIG5hbWVcbiAgICApO1xuICB9XG59XG5cbi8qKlxuICogRW5zdXJlIGFsbCBwcm9wcyBvcHRpb24gc3ludGF4IGFyZSBub3JtYWxpemVkIGludG8gdGhlXG4gKiBPYmplY3QtYmFzZWQgZm9ybWF0LlxuICovXG5mdW5jdGlvbiBub3JtYWxpemVQcm9wcyAob3B0aW9ucywgdm0pIHtcbiAgdmFyIHByb3BzID0gb3B0aW9ucy5wcm9wcztcbiAgaWYgKCFwcm9wcykgeyByZXR1cm4gfVxuICB2YXIgcmVzID0ge307XG4gIHZhciBpLCB2YWwsIG5hbWU7XG4gIGlmIChBcnJheS5pc0FycmF5KHByb3BzKSkge1xuICAgIGkgPSBwcm9wcy5sZW5ndGg7XG4gICAgd2hpbGUgKGktLSkge1xuICAgICAgdmFsID0gcHJvcHNbaV07XG4gICAgICBpZiAodHlwZW9mIHZhbCA9PT0gJ3N0cmluZycpIHtcbiAgICAgICAgbmFtZSA9IGNhbWVsaXplKHZhbCk7XG4gICAgICAgIHJlc1tuYW1lXSA9IHsgdHlwZTogbnVsbCB9O1xuICAgICAgfSBlbHNlIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nKSB7XG4gICAgICAgIHdhcm4oJ3Byb3BzIG11c3QgYmUgc3RyaW5ncyB3aGVuIHVzaW5nIGFycmF5IHN5bnRheC4nKTtcbiAgICAgIH1cbiAgICB9XG4gIH0gZWxzZSBpZiAoaXNQbGFpbk9iamVjdChwcm9wcykpIHtcbiAgICBmb3IgKHZhciBrZXkgaW4gcHJvcHMpIHtcbiAgICAgIHZhbCA9IHByb3BzW2tleV07XG4gICAgICBuYW1lID0gY2FtZWxpemUoa2V5KTtcbiAgICAgIHJlc1tuYW1lXSA9IGlzUGxhaW5PYmplY3QodmFsKVxuICAgICAgICA/IHZhbFxuICAgICAgICA6IHsgdHlwZTogdmFsIH07XG4gICAgfVxuICB9IGVsc2UgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicpIHtcbiAgICB3YXJuKFxuICAgICAgXCJJbnZhbGlkIHZhbHVlIGZvciBvcHRpb24gXFxcInByb3BzXFxcIjogZXhwZWN0ZWQgYW4gQXJyYXkgb3IgYW4gT2JqZWN0LCBcIiArXG4gICAgICBcImJ1dCBnb3QgXCIgKyAodG9SYXdUeXBlKHByb3BzKSkgKyBcIi5cIixcbiAgICAgIHZtXG4gICAgKTtcbiAgfVxuICBvcHRpb25zLnByb3BzID0gcmVzO1xufVxuXG4vKipcbiAqIE5vcm1hbGl6ZSBhbGwgaW5qZWN0aW9ucyBpbnRvIE9iamVjdC1iYXNlZCBmb3JtYXRcbiAqL1xuZnVuY3Rpb24gbm9ybWFsaXplSW5qZWN0IChvcHRpb25zLCB2bSkge1xuICB2YXIgaW5qZWN0ID0gb3B0aW9ucy5pbmplY3Q7XG4gIGlmICghaW5qZWN0KSB7IHJldHVybiB9XG4gIHZhciBub3JtYWxpemVkID0gb3B0aW9ucy5pbmplY3QgPSB7fTtcbiAgaWYgKEFycmF5LmlzQXJyYXkoaW5qZWN0KSkge1xuICAgIGZvciAodmFyIGkgPSAwOyBpIDwgaW5qZWN0Lmxlbmd0aDsgaSsrKSB7XG4gICAgICBub3JtYWxpemVkW2luamVjdFtpXV0gPSB7IGZyb206IGluamVjdFtpXSB9O1xuICAgIH1cbiAgfSBlbHNlIGlmIChpc1BsYWluT2JqZWN0KGluamVjdCkpIHtcbiAgICBmb3IgKHZhciBrZXkgaW4gaW5qZWN0KSB7XG4gICAgICB2YXIgdmFsID0gaW5qZWN0W2tleV07XG4gICAgICBub3JtYWxpemVkW2tleV0gPSBpc1BsYWluT2JqZWN0KHZhbClcbiAgICAgICAgPyBleHRlbmQoeyBmcm9tOiBrZXkgfSwgdmFsKVxuICAgICAgICA6IHsgZnJvbTogdmFsIH07XG4gICAgfVxuICB9IGVsc2UgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicpIHtcbiAgICB3YXJuKFxuICAgICAgXCJJbnZhbGlkIHZhbHVlIGZvciBvcHRpb24gXFxcImluamVjdFxcXCI6IGV4cGVjdGVkIGFuIEFycmF5IG9yIGFuIE9iamVjdCwgXCIgK1xuICAgICAgXCJidXQgZ290IFwiICsgKHRvUmF3VHlwZShpbmplY3QpKSArIFwiLlwiLFxuICAgICAgdm1cbiAgICApO1xuICB9XG59XG5cbi8qKlxuICogTm9ybWFsaXplIHJhdyBmdW5jdGlvbiBkaXJlY3RpdmVzIGludG8gb2JqZWN0IGZvcm1hdC5cbiAqL1xuZnVuY3Rpb24gbm9ybWFsaXplRGlyZWN0aXZlcyAob3B0aW9ucykge1xuICB2YXIgZGlycyA9IG9wdGlvbnMuZGlyZWN0aXZlcztcbiAgaWYgKGRpcnMpIHtcbiAgICBmb3IgKHZhciBrZXkgaW4gZGlycykge1xuICAgICAgdmFyIGRlZiA9IGRpcnNba2V5XTtcbiAgICAgIGlmICh0eXBlb2YgZGVmID09PSAnZnVuY3Rpb24nKSB7XG4gICAgICAgIGRpcnNba2V5XSA9IHsgYmluZDogZGVmLCB1cGRhdGU6IGRlZiB9O1xuICAgICAgfVxuICAgIH1cbiAgfVxufVxuXG5mdW5jdGlvbiBhc3NlcnRPYmplY3RUeXBlIChuYW1lLCB2YWx1ZSwgdm0pIHtcbiAgaWYgKCFpc1BsYWluT2JqZWN0KHZhbHVlKSkge1xuICAgIHdhcm4oXG4gICAgICBcIkludmFsaWQgdmFsdWUgZm9yIG9wdGlvbiBcXFwiXCIgKyBuYW1lICsgXCJcXFwiOiBleHBlY3RlZCBhbiBPYmplY3QsIFwiICtcbiAgICAgIFwiYnV0IGdvdCBcIiArICh0b1Jhd1R5cGUodmFsdWUpKSArIFwiLlwiLFxuICAgICAgdm1cbiAgICApO1xuICB9XG59XG5cbi8qKlxuICogTWVyZ2UgdHdvIG9wdGlvbiBvYmplY3RzIGludG8gYSBuZXcgb25lLlxuICogQ29yZSB1dGlsaXR5IHVzZWQgaW4gYm90aCBpbnN0YW50aWF0aW9uIGFuZCBpbmhlcml0YW5jZS5cbiAqL1xuZnVuY3Rpb24gbWVyZ2VPcHRpb25zIChcbiAgcGFyZW50LFxuICBjaGlsZCxcbiAgdm1cbikge1xuICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgIGNoZWNrQ29tcG9uZW50cyhjaGlsZCk7XG4gIH1cblxuICBpZiAodHlwZW9mIGNoaWxkID09PSAnZnVuY3Rpb24nKSB7XG4gICAgY2hpbGQgPSBjaGlsZC5vcHRpb25zO1xuICB9XG5cbiAgbm9ybWFsaXplUHJvcHMoY2hpbGQsIHZtKTtcbiAgbm9ybWFsaXplSW5qZWN0KGNoaWxkLCB2bSk7XG4gIG5vcm1hbGl6ZURpcmVjdGl2ZXMoY2hpbGQpO1xuICB2YXIgZXh0ZW5kc0Zyb20gPSBjaGlsZC5leHRlbmRzO1xuICBpZiAoZXh0ZW5kc0Zyb20pIHtcbiAgICBwYXJlbnQgPSBtZXJnZU9wdGlvbnMocGFyZW50LCBleHRlbmRzRnJvbSwgdm0pO1xuICB9XG4gIGlmIChjaGlsZC5taXhpbnMpIHtcbiAgICBmb3IgKHZhciBpID0gMCwgbCA9IGNoaWxkLm1peGlucy5sZW5ndGg7IGkgPCBsOyBpKyspIHtcbiAgICAgIHBhcmVudCA9IG1lcmdlT3B0aW9ucyhwYXJlbnQsIGNoaWxkLm1peGluc1tpXSwgdm0pO1xuICAgIH1cbiAgfVxuICB2YXIgb3B0aW9ucyA9IHt9O1xuICB2YXIga2V5O1xuICBmb3IgKGtleSBpbiBwYXJlbnQpIHtcbiAgICBtZXJnZUZpZWxkKGtleSk7XG4gIH1cbiAgZm9yIChrZXkgaW4gY2hpbGQpIHtcbiAgICBpZiAoIWhhc093bihwYXJlbnQsIGtleSkpIHtcbiAgICAgIG1lcmdlRmllbGQoa2V5KTtcbiAgICB9XG4gIH1cbiAgZnVuY3Rpb24gbWVyZ2VGaWVsZCAoa2V5KSB7XG4gICAgdmFyIHN0cmF0ID0gc3RyYXRzW2tleV0gfHwgZGVmYXVsdFN0cmF0O1xuICAgIG9wdGlvbnNba2V5XSA9IHN0cmF0KHBhcmVudFtrZXldLCBjaGlsZFtrZXldLCB2bSwga2V5KTtcbiAgfVxuICByZXR1cm4gb3B0aW9uc1xufVxuXG4vKipcbiAqIFJlc29sdmUgYW4gYXNzZXQuXG4gKiBUaGlzIGZ1bmN0aW9uIGlzIHVzZWQgYmVjYXVzZSBjaGlsZCBpbnN0YW5jZXMgbmVlZCBhY2Nlc3NcbiAqIHRvIGFzc2V0cyBkZWZpbmVkIGluIGl0cyBhbmNlc3RvciBjaGFpbi5cbiAqL1xuZnVuY3Rpb24gcmVzb2x2ZUFzc2V0IChcbiAgb3B0aW9ucyxcbiAgdHlwZSxcbiAgaWQsXG4gIHdhcm5NaXNzaW5nXG4pIHtcbiAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gIGlmICh0eXBlb2YgaWQgIT09ICdzdHJpbmcnKSB7XG4gICAgcmV0dXJuXG4gIH1cbiAgdmFyIGFzc2V0cyA9IG9wdGlvbnNbdHlwZV07XG4gIC8vIGNoZWNrIGxvY2FsIHJlZ2lzdHJhdGlvbiB2YXJpYXRpb25zIGZpcnN0XG4gIGlmIChoYXNPd24oYXNzZXRzLCBpZCkpIHsgcmV0dXJuIGFzc2V0c1tpZF0gfVxuICB2YXIgY2FtZWxpemVkSWQgPSBjYW1lbGl6ZShpZCk7XG4gIGlmIChoYXNPd24oYXNzZXRzLCBjYW1lbGl6ZWRJZCkpIHsgcmV0dXJuIGFzc2V0c1tjYW1lbGl6ZWRJZF0gfVxuICB2YXIgUGFzY2FsQ2FzZUlkID0gY2FwaXRhbGl6ZShjYW1lbGl6ZWRJZCk7XG4gIGlmIChoYXNPd24oYXNzZXRzLCBQYXNjYWxDYXNlSWQpKSB7IHJldHVybiBhc3NldHNbUGFzY2FsQ2FzZUlkXSB9XG4gIC8vIGZhbGxiYWNrIHRvIHByb3RvdHlwZSBjaGFpblxuICB2YXIgcmVzID0gYXNzZXRzW2lkXSB8fCBhc3NldHNbY2FtZWxpemVkSWRdIHx8IGFzc2V0c1tQYXNjYWxDYXNlSWRdO1xuICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJiB3YXJuTWlzc2luZyAmJiAhcmVzKSB7XG4gICAgd2FybihcbiAgICAgICdGYWlsZWQgdG8gcmVzb2x2ZSAnICsgdHlwZS5zbGljZSgwLCAtMSkgKyAnOiAnICsgaWQsXG4gICAgICBvcHRpb25zXG4gICAgKTtcbiAgfVxuICByZXR1cm4gcmVzXG59XG5cbi8qICAqL1xuXG5mdW5jdGlvbiB2YWxpZGF0ZVByb3AgKFxuICBrZXksXG4gIHByb3BPcHRpb25zLFxuICBwcm9wc0RhdGEsXG4gIHZtXG4pIHtcbiAgdmFyIHByb3AgPSBwcm9wT3B0aW9uc1trZXldO1xuICB2YXIgYWJzZW50ID0gIWhhc093bihwcm9wc0RhdGEsIGtleSk7XG4gIHZhciB2YWx1ZSA9IHByb3BzRGF0YVtrZXldO1xuICAvLyBib29sZWFuIGNhc3RpbmdcbiAgdmFyIGJvb2xlYW5JbmRleCA9IGdldFR5cGVJbmRleChCb29sZWFuLCBwcm9wLnR5cGUpO1xuICBpZiAoYm9vbGVhbkluZGV4ID4gLTEpIHtcbiAgICBpZiAoYWJzZW50ICYmICFoYXNPd24ocHJvcCwgJ2RlZmF1bHQnKSkge1xuICAgICAgdmFsdWUgPSBmYWxzZTtcbiAgICB9IGVsc2UgaWYgKHZhbHVlID09PSAnJyB8fCB2YWx1ZSA9PT0gaHlwaGVuYXRlKGtleSkpIHtcbiAgICAgIC8vIG9ubHkgY2FzdCBlbXB0eSBzdHJpbmcgLyBzYW1lIG5hbWUgdG8gYm9vbGVhbiBpZlxuICAgICAgLy8gYm9vbGVhbiBoYXMgaGlnaGVyIHByaW9yaXR5XG4gICAgICB2YXIgc3RyaW5nSW5kZXggPSBnZXRUeXBlSW5kZXgoU3RyaW5nLCBwcm9wLnR5cGUpO1xuICAgICAgaWYgKHN0cmluZ0luZGV4IDwgMCB8fCBib29sZWFuSW5kZXggPCBzdHJpbmdJbmRleCkge1xuICAgICAgICB2YWx1ZSA9IHRydWU7XG4gICAgICB9XG4gICAgfVxuICB9XG4gIC8vIGNoZWNrIGRlZmF1bHQgdmFsdWVcbiAgaWYgKHZhbHVlID09PSB1bmRlZmluZWQpIHtcbiAgICB2YWx1ZSA9IGdldFByb3BEZWZhdWx0VmFsdWUodm0sIHByb3AsIGtleSk7XG4gICAgLy8gc2luY2UgdGhlIGRlZmF1bHQgdmFsdWUgaXMgYSBmcmVzaCBjb3B5LFxuICAgIC8vIG1ha2Ugc3VyZSB0byBvYnNlcnZlIGl0LlxuICAgIHZhciBwcmV2U2hvdWxkT2JzZXJ2ZSA9IHNob3VsZE9ic2VydmU7XG4gICAgdG9nZ2xlT2JzZXJ2aW5nKHRydWUpO1xuICAgIG9ic2VydmUodmFsdWUpO1xuICAgIHRvZ2dsZU9ic2VydmluZyhwcmV2U2hvdWxkT2JzZXJ2ZSk7XG4gIH1cbiAgaWYgKFxuICAgIHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiZcbiAgICAvLyBza2lwIHZhbGlkYXRpb24gZm9yIHdlZXggcmVjeWNsZS1saXN0IGNoaWxkIGNvbXBvbmVudCBwcm9wc1xuICAgICEoZmFsc2UgJiYgaXNPYmplY3QodmFsdWUpICYmICgnQGJpbmRpbmcnIGluIHZhbHVlKSlcbiAgKSB7XG4gICAgYXNzZXJ0UHJvcChwcm9wLCBrZXksIHZhbHVlLCB2bSwgYWJzZW50KTtcbiAgfVxuICByZXR1cm4gdmFsdWVcbn1cblxuLyoqXG4gKiBHZXQgdGhlIGRlZmF1bHQgdmFsdWUgb2YgYSBwcm9wLlxuICovXG5mdW5jdGlvbiBnZXRQcm9wRGVmYXVsdFZhbHVlICh2bSwgcHJvcCwga2V5KSB7XG4gIC8vIG5vIGRlZmF1bHQsIHJldHVybiB1bmRlZmluZWRcbiAgaWYgKCFoYXNPd24ocHJvcCwgJ2RlZmF1bHQnKSkge1xuICAgIHJldHVybiB1bmRlZmluZWRcbiAgfVxuICB2YXIgZGVmID0gcHJvcC5kZWZhdWx0O1xuICAvLyB3YXJuIGFnYWluc3Qgbm9uLWZhY3RvcnkgZGVmYXVsdHMgZm9yIE9iamVjdCAmIEFycmF5XG4gIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nICYmIGlzT2JqZWN0KGRlZikpIHtcbiAgICB3YXJuKFxuICAgICAgJ0ludmFsaWQgZGVmYXVsdCB2YWx1ZSBmb3IgcHJvcCBcIicgKyBrZXkgKyAnXCI6ICcgK1xuICAgICAgJ1Byb3BzIHdpdGggdHlwZSBPYmplY3QvQXJyYXkgbXVzdCB1c2UgYSBmYWN0b3J5IGZ1bmN0aW9uICcgK1xuICAgICAgJ3RvIHJldHVybiB0aGUgZGVmYXVsdCB2YWx1ZS4nLFxuICAgICAgdm1cbiAgICApO1xuICB9XG4gIC8vIHRoZSByYXcgcHJvcCB2YWx1ZSB3YXMgYWxzbyB1bmRlZmluZWQgZnJvbSBwcmV2aW91cyByZW5kZXIsXG4gIC8vIHJldHVybiBwcmV2aW91cyBkZWZhdWx0IHZhbHVlIHRvIGF2b2lkIHVubmVjZXNzYXJ5IHdhdGNoZXIgdHJpZ2dlclxuICBpZiAodm0gJiYgdm0uJG9wdGlvbnMucHJvcHNEYXRhICYmXG4gICAgdm0uJG9wdGlvbnMucHJvcHNEYXRhW2tleV0gPT09IHVuZGVmaW5lZCAmJlxuICAgIHZtLl9wcm9wc1trZXldICE9PSB1bmRlZmluZWRcbiAgKSB7XG4gICAgcmV0dXJuIHZtLl9wcm9wc1trZXldXG4gIH1cbiAgLy8gY2FsbCBmYWN0b3J5IGZ1bmN0aW9uIGZvciBub24tRnVuY3Rpb24gdHlwZXNcbiAgLy8gYSB2YWx1ZSBpcyBGdW5jdGlvbiBpZiBpdHMgcHJvdG90eXBlIGlzIGZ1bmN0aW9uIGV2ZW4gYWNyb3NzIGRpZmZlcmVudCBleGVjdXRpb24gY29udGV4dFxuICByZXR1cm4gdHlwZW9mIGRlZiA9PT0gJ2Z1bmN0aW9uJyAmJiBnZXRUeXBlKHByb3AudHlwZSkgIT09ICdGdW5jdGlvbidcbiAgICA/IGRlZi5jYWxsKHZtKVxuICAgIDogZGVmXG59XG5cbi8qKlxuICogQXNzZXJ0IHdoZXRoZXIgYSBwcm9wIGlzIHZhbGlkLlxuICovXG5mdW5jdGlvbiBhc3NlcnRQcm9wIChcbiAgcHJvcCxcbiAgbmFtZSxcbiAgdmFsdWUsXG4gIHZtLFxuICBhYnNlbnRcbikge1xuICBpZiAocHJvcC5yZXF1aXJlZCAmJiBhYnNlbnQpIHtcbiAgICB3YXJuKFxuICAgICAgJ01pc3NpbmcgcmVxdWlyZWQgcHJvcDogXCInICsgbmFtZSArICdcIicsXG4gICAgICB2bVxuICAgICk7XG4gICAgcmV0dXJuXG4gIH1cbiAgaWYgKHZhbHVlID09IG51bGwgJiYgIXByb3AucmVxdWlyZWQpIHtcbiAgICByZXR1cm5cbiAgfVxuICB2YXIgdHlwZSA9IHByb3AudHlwZTtcbiAgdmFyIHZhbGlkID0gIXR5cGUgfHwgdHlwZSA9PT0gdHJ1ZTtcbiAgdmFyIGV4cGVjdGVkVHlwZXMgPSBbXTtcbiAgaWYgKHR5cGUpIHtcbiAgICBpZiAoIUFycmF5LmlzQXJyYXkodHlwZSkpIHtcbiAgICAgIHR5cGUgPSBbdHlwZV07XG4gICAgfVxuICAgIGZvciAodmFyIGkgPSAwOyBpIDwgdHlwZS5sZW5ndGggJiYgIXZhbGlkOyBpKyspIHtcbiAgICAgIHZhciBhc3NlcnRlZFR5cGUgPSBhc3NlcnRUeXBlKHZhbHVlLCB0eXBlW2ldKTtcbiAgICAgIGV4cGVjdGVkVHlwZXMucHVzaChhc3NlcnRlZFR5cGUuZXhwZWN0ZWRUeXBlIHx8ICcnKTtcbiAgICAgIHZhbGlkID0gYXNzZXJ0ZWRUeXBlLnZhbGlkO1xuICAgIH1cbiAgfVxuICBpZiAoIXZhbGlkKSB7XG4gICAgd2FybihcbiAgICAgIFwiSW52YWxpZCBwcm9wOiB0eXBlIGNoZWNrIGZhaWxlZCBmb3IgcHJvcCBcXFwiXCIgKyBuYW1lICsgXCJcXFwiLlwiICtcbiAgICAgIFwiIEV4cGVjdGVkIFwiICsgKGV4cGVjdGVkVHlwZXMubWFwKGNhcGl0YWxpemUpLmpvaW4oJywgJykpICtcbiAgICAgIFwiLCBnb3QgXCIgKyAodG9SYXdUeXBlKHZhbHVlKSkgKyBcIi5cIixcbiAgICAgIHZtXG4gICAgKTtcbiAgICByZXR1cm5cbiAgfVxuICB2YXIgdmFsaWRhdG9yID0gcHJvcC52YWxpZGF0b3I7XG4gIGlmICh2YWxpZGF0b3IpIHtcbiAgICBpZiAoIXZhbGlkYXRvcih2YWx1ZSkpIHtcbiAgICAgIHdhcm4oXG4gICAgICAgICdJbnZhbGlkIHByb3A6IGN1c3RvbSB2YWxpZGF0b3IgY2hlY2sgZmFpbGVkIGZvciBwcm9wIFwiJyArIG5hbWUgKyAnXCIuJyxcbiAgICAgICAgdm1cbiAgICAgICk7XG4gICAgfVxuICB9XG59XG5cbnZhciBzaW1wbGVDaGVja1JFID0gL14oU3RyaW5nfE51bWJlcnxCb29sZWFufEZ1bmN0aW9ufFN5bWJvbCkkLztcblxuZnVuY3Rpb24gYXNzZXJ0VHlwZSAodmFsdWUsIHR5cGUpIHtcbiAgdmFyIHZhbGlkO1xuICB2YXIgZXhwZWN0ZWRUeXBlID0gZ2V0VHlwZSh0eXBlKTtcbiAgaWYgKHNpbXBsZUNoZWNrUkUudGVzdChleHBlY3RlZFR5cGUpKSB7XG4gICAgdmFyIHQgPSB0eXBlb2YgdmFsdWU7XG4gICAgdmFsaWQgPSB0ID09PSBleHBlY3RlZFR5cGUudG9Mb3dlckNhc2UoKTtcbiAgICAvLyBmb3IgcHJpbWl0aXZlIHdyYXBwZXIgb2JqZWN0c1xuICAgIGlmICghdmFsaWQgJiYgdCA9PT0gJ29iamVjdCcpIHtcbiAgICAgIHZhbGlkID0gdmFsdWUgaW5zdGFuY2VvZiB0eXBlO1xuICAgIH1cbiAgfSBlbHNlIGlmIChleHBlY3RlZFR5cGUgPT09ICdPYmplY3QnKSB7XG4gICAgdmFsaWQgPSBpc1BsYWluT2JqZWN0KHZhbHVlKTtcbiAgfSBlbHNlIGlmIChleHBlY3RlZFR5cGUgPT09ICdBcnJheScpIHtcbiAgICB2YWxpZCA9IEFycmF5LmlzQXJyYXkodmFsdWUpO1xuICB9IGVsc2Uge1xuICAgIHZhbGlkID0gdmFsdWUgaW5zdGFuY2VvZiB0eXBlO1xuICB9XG4gIHJldHVybiB7XG4gICAgdmFsaWQ6IHZhbGlkLFxuICAgIGV4cGVjdGVkVHlwZTogZXhwZWN0ZWRUeXBlXG4gIH1cbn1cblxuLyoqXG4gKiBVc2UgZnVuY3Rpb24gc3RyaW5nIG5hbWUgdG8gY2hlY2sgYnVpbHQtaW4gdHlwZXMsXG4gKiBiZWNhdXNlIGEgc2ltcGxlIGVxdWFsaXR5IGNoZWNrIHdpbGwgZmFpbCB3aGVuIHJ1bm5pbmdcbiAqIGFjcm9zcyBkaWZmZXJlbnQgdm1zIC8gaWZyYW1lcy5cbiAqL1xuZnVuY3Rpb24gZ2V0VHlwZSAoZm4pIHtcbiAgdmFyIG1hdGNoID0gZm4gJiYgZm4udG9TdHJpbmcoKS5tYXRjaCgvXlxccypmdW5jdGlvbiAoXFx3KykvKTtcbiAgcmV0dXJuIG1hdGNoID8gbWF0Y2hbMV0gOiAnJ1xufVxuXG5mdW5jdGlvbiBpc1NhbWVUeXBlIChhLCBiKSB7XG4gIHJldHVybiBnZXRUeXBlKGEpID09PSBnZXRUeXBlKGIpXG59XG5cbmZ1bmN0aW9uIGdldFR5cGVJbmRleCAodHlwZSwgZXhwZWN0ZWRUeXBlcykge1xuICBpZiAoIUFycmF5LmlzQXJyYXkoZXhwZWN0ZWRUeXBlcykpIHtcbiAgICByZXR1cm4gaXNTYW1lVHlwZShleHBlY3RlZFR5cGVzLCB0eXBlKSA/IDAgOiAtMVxuICB9XG4gIGZvciAodmFyIGkgPSAwLCBsZW4gPSBleHBlY3RlZFR5cGVzLmxlbmd0aDsgaSA8IGxlbjsgaSsrKSB7XG4gICAgaWYgKGlzU2FtZVR5cGUoZXhwZWN0ZWRUeXBlc1tpXSwgdHlwZSkpIHtcbiAgICAgIHJldHVybiBpXG4gICAgfVxuICB9XG4gIHJldHVybiAtMVxufVxuXG4vKiAgKi9cblxuZnVuY3Rpb24gaGFuZGxlRXJyb3IgKGVyciwgdm0sIGluZm8pIHtcbiAgaWYgKHZtKSB7XG4gICAgdmFyIGN1ciA9IHZtO1xuICAgIHdoaWxlICgoY3VyID0gY3VyLiRwYXJlbnQpKSB7XG4gICAgICB2YXIgaG9va3MgPSBjdXIuJG9wdGlvbnMuZXJyb3JDYXB0dXJlZDtcbiAgICAgIGlmIChob29rcykge1xuICAgICAgICBmb3IgKHZhciBpID0gMDsgaSA8IGhvb2tzLmxlbmd0aDsgaSsrKSB7XG4gICAgICAgICAgdHJ5IHtcbiAgICAgICAgICAgIHZhciBjYXB0dXJlID0gaG9va3NbaV0uY2FsbChjdXIsIGVyciwgdm0sIGluZm8pID09PSBmYWxzZTtcbiAgICAgICAgICAgIGlmIChjYXB0dXJlKSB7IHJldHVybiB9XG4gICAgICAgICAgfSBjYXRjaCAoZSkge1xuICAgICAgICAgICAgZ2xvYmFsSGFuZGxlRXJyb3IoZSwgY3VyLCAnZXJyb3JDYXB0dXJlZCBob29rJyk7XG4gICAgICAgICAgfVxuICAgICAgICB9XG4gICAgICB9XG4gICAgfVxuICB9XG4gIGdsb2JhbEhhbmRsZUVycm9yKGVyciwgdm0sIGluZm8pO1xufVxuXG5mdW5jdGlvbiBnbG9iYWxIYW5kbGVFcnJvciAoZXJyLCB2bSwgaW5mbykge1xuICBpZiAoY29uZmlnLmVycm9ySGFuZGxlcikge1xuICAgIHRyeSB7XG4gICAgICByZXR1cm4gY29uZmlnLmVycm9ySGFuZGxlci5jYWxsKG51bGwsIGVyciwgdm0sIGluZm8pXG4gICAgfSBjYXRjaCAoZSkge1xuICAgICAgbG9nRXJyb3IoZSwgbnVsbCwgJ2NvbmZpZy5lcnJvckhhbmRsZXInKTtcbiAgICB9XG4gIH1cbiAgbG9nRXJyb3IoZXJyLCB2bSwgaW5mbyk7XG59XG5cbmZ1bmN0aW9uIGxvZ0Vycm9yIChlcnIsIHZtLCBpbmZvKSB7XG4gIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nKSB7XG4gICAgd2FybigoXCJFcnJvciBpbiBcIiArIGluZm8gKyBcIjogXFxcIlwiICsgKGVyci50b1N0cmluZygpKSArIFwiXFxcIlwiKSwgdm0pO1xuICB9XG4gIC8qIGlzdGFuYnVsIGlnbm9yZSBlbHNlICovXG4gIGlmICgoaW5Ccm93c2VyIHx8IGluV2VleCkgJiYgdHlwZW9mIGNvbnNvbGUgIT09ICd1bmRlZmluZWQnKSB7XG4gICAgY29uc29sZS5lcnJvcihlcnIpO1xuICB9IGVsc2Uge1xuICAgIHRocm93IGVyclxuICB9XG59XG5cbi8qICAqL1xuLyogZ2xvYmFscyBNZXNzYWdlQ2hhbm5lbCAqL1xuXG52YXIgY2FsbGJhY2tzID0gW107XG52YXIgcGVuZGluZyA9IGZhbHNlO1xuXG5mdW5jdGlvbiBmbHVzaENhbGxiYWNrcyAoKSB7XG4gIHBlbmRpbmcgPSBmYWxzZTtcbiAgdmFyIGNvcGllcyA9IGNhbGxiYWNrcy5zbGljZSgwKTtcbiAgY2FsbGJhY2tzLmxlbmd0aCA9IDA7XG4gIGZvciAodmFyIGkgPSAwOyBpIDwgY29waWVzLmxlbmd0aDsgaSsrKSB7XG4gICAgY29waWVzW2ldKCk7XG4gIH1cbn1cblxuLy8gSGVyZSB3ZSBoYXZlIGFzeW5jIGRlZmVycmluZyB3cmFwcGVycyB1c2luZyBib3RoIG1pY3JvdGFza3MgYW5kIChtYWNybykgdGFza3MuXG4vLyBJbiA8IDIuNCB3ZSB1c2VkIG1pY3JvdGFza3MgZXZlcnl3aGVyZSwgYnV0IHRoZXJlIGFyZSBzb21lIHNjZW5hcmlvcyB3aGVyZVxuLy8gbWljcm90YXNrcyBoYXZlIHRvbyBoaWdoIGEgcHJpb3JpdHkgYW5kIGZpcmUgaW4gYmV0d2VlbiBzdXBwb3NlZGx5XG4vLyBzZXF1ZW50aWFsIGV2ZW50cyAoZS5nLiAjNDUyMSwgIzY2OTApIG9yIGV2ZW4gYmV0d2VlbiBidWJibGluZyBvZiB0aGUgc2FtZVxuLy8gZXZlbnQgKCM2NTY2KS4gSG93ZXZlciwgdXNpbmcgKG1hY3JvKSB0YXNrcyBldmVyeXdoZXJlIGFsc28gaGFzIHN1YnRsZSBwcm9ibGVtc1xuLy8gd2hlbiBzdGF0ZSBpcyBjaGFuZ2VkIHJpZ2h0IGJlZm9yZSByZXBhaW50IChlLmcuICM2ODEzLCBvdXQtaW4gdHJhbnNpdGlvbnMpLlxuLy8gSGVyZSB3ZSB1c2UgbWljcm90YXNrIGJ5IGRlZmF1bHQsIGJ1dCBleHBvc2UgYSB3YXkgdG8gZm9yY2UgKG1hY3JvKSB0YXNrIHdoZW5cbi8vIG5lZWRlZCAoZS5nLiBpbiBldmVudCBoYW5kbGVycyBhdHRhY2hlZCBieSB2LW9uKS5cbnZhciBtaWNyb1RpbWVyRnVuYztcbnZhciBtYWNyb1RpbWVyRnVuYztcbnZhciB1c2VNYWNyb1Rhc2sgPSBmYWxzZTtcblxuLy8gRGV0ZXJtaW5lIChtYWNybykgdGFzayBkZWZlciBpbXBsZW1lbnRhdGlvbi5cbi8vIFRlY2huaWNhbGx5IHNldEltbWVkaWF0ZSBzaG91bGQgYmUgdGhlIGlkZWFsIGNob2ljZSwgYnV0IGl0J3Mgb25seSBhdmFpbGFibGVcbi8vIGluIElFLiBUaGUgb25seSBwb2x5ZmlsbCB0aGF0IGNvbnNpc3RlbnRseSBxdWV1ZXMgdGhlIGNhbGxiYWNrIGFmdGVyIGFsbCBET01cbi8vIGV2ZW50cyB0cmlnZ2VyZWQgaW4gdGhlIHNhbWUgbG9vcCBpcyBieSB1c2luZyBNZXNzYWdlQ2hhbm5lbC5cbi8qIGlzdGFuYnVsIGlnbm9yZSBpZiAqL1xuaWYgKHR5cGVvZiBzZXRJbW1lZGlhdGUgIT09ICd1bmRlZmluZWQnICYmIGlzTmF0aXZlKHNldEltbWVkaWF0ZSkpIHtcbiAgbWFjcm9UaW1lckZ1bmMgPSBmdW5jdGlvbiAoKSB7XG4gICAgc2V0SW1tZWRpYXRlKGZsdXNoQ2FsbGJhY2tzKTtcbiAgfTtcbn0gZWxzZSBpZiAodHlwZW9mIE1lc3NhZ2VDaGFubmVsICE9PSAndW5kZWZpbmVkJyAmJiAoXG4gIGlzTmF0aXZlKE1lc3NhZ2VDaGFubmVsKSB8fFxuICAvLyBQaGFudG9tSlNcbiAgTWVzc2FnZUNoYW5uZWwudG9TdHJpbmcoKSA9PT0gJ1tvYmplY3QgTWVzc2FnZUNoYW5uZWxDb25zdHJ1Y3Rvcl0nXG4pKSB7XG4gIHZhciBjaGFubmVsID0gbmV3IE1lc3NhZ2VDaGFubmVsKCk7XG4gIHZhciBwb3J0ID0gY2hhbm5lbC5wb3J0MjtcbiAgY2hhbm5lbC5wb3J0MS5vbm1lc3NhZ2UgPSBmbHVzaENhbGxiYWNrcztcbiAgbWFjcm9UaW1lckZ1bmMgPSBmdW5jdGlvbiAoKSB7XG4gICAgcG9ydC5wb3N0TWVzc2FnZSgxKTtcbiAgfTtcbn0gZWxzZSB7XG4gIC8qIGlzdGFuYnVsIGlnbm9yZSBuZXh0ICovXG4gIG1hY3JvVGltZXJGdW5jID0gZnVuY3Rpb24gKCkge1xuICAgIHNldFRpbWVvdXQoZmx1c2hDYWxsYmFja3MsIDApO1xuICB9O1xufVxuXG4vLyBEZXRlcm1pbmUgbWljcm90YXNrIGRlZmVyIGltcGxlbWVudGF0aW9uLlxuLyogaXN0YW5idWwgaWdub3JlIG5leHQsICRmbG93LWRpc2FibGUtbGluZSAqL1xuaWYgKHR5cGVvZiBQcm9taXNlICE9PSAndW5kZWZpbmVkJyAmJiBpc05hdGl2ZShQcm9taXNlKSkge1xuICB2YXIgcCA9IFByb21pc2UucmVzb2x2ZSgpO1xuICBtaWNyb1RpbWVyRnVuYyA9IGZ1bmN0aW9uICgpIHtcbiAgICBwLnRoZW4oZmx1c2hDYWxsYmFja3MpO1xuICAgIC8vIGluIHByb2JsZW1hdGljIFVJV2ViVmlld3MsIFByb21pc2UudGhlbiBkb2Vzbid0IGNvbXBsZXRlbHkgYnJlYWssIGJ1dFxuICAgIC8vIGl0IGNhbiBnZXQgc3R1Y2sgaW4gYSB3ZWlyZCBzdGF0ZSB3aGVyZSBjYWxsYmFja3MgYXJlIHB1c2hlZCBpbnRvIHRoZVxuICAgIC8vIG1pY3JvdGFzayBxdWV1ZSBidXQgdGhlIHF1ZXVlIGlzbid0IGJlaW5nIGZsdXNoZWQsIHVudGlsIHRoZSBicm93c2VyXG4gICAgLy8gbmVlZHMgdG8gZG8gc29tZSBvdGhlciB3b3JrLCBlLmcuIGhhbmRsZSBhIHRpbWVyLiBUaGVyZWZvcmUgd2UgY2FuXG4gICAgLy8gXCJmb3JjZVwiIHRoZSBtaWNyb3Rhc2sgcXVldWUgdG8gYmUgZmx1c2hlZCBieSBhZGRpbmcgYW4gZW1wdHkgdGltZXIuXG4gICAgaWYgKGlzSU9TKSB7IHNldFRpbWVvdXQobm9vcCk7IH1cbiAgfTtcbn0gZWxzZSB7XG4gIC8vIGZhbGxiYWNrIHRvIG1hY3JvXG4gIG1pY3JvVGltZXJGdW5jID0gbWFjcm9UaW1lckZ1bmM7XG59XG5cbi8qKlxuICogV3JhcCBhIGZ1bmN0aW9uIHNvIHRoYXQgaWYgYW55IGNvZGUgaW5zaWRlIHRyaWdnZXJzIHN0YXRlIGNoYW5nZSxcbiAqIHRoZSBjaGFuZ2VzIGFyZSBxdWV1ZWQgdXNpbmcgYSAobWFjcm8pIHRhc2sgaW5zdGVhZCBvZiBhIG1pY3JvdGFzay5cbiAqL1xuZnVuY3Rpb24gd2l0aE1hY3JvVGFzayAoZm4pIHtcbiAgcmV0dXJuIGZuLl93aXRoVGFzayB8fCAoZm4uX3dpdGhUYXNrID0gZnVuY3Rpb24gKCkge1xuICAgIHVzZU1hY3JvVGFzayA9IHRydWU7XG4gICAgdmFyIHJlcyA9IGZuLmFwcGx5KG51bGwsIGFyZ3VtZW50cyk7XG4gICAgdXNlTWFjcm9UYXNrID0gZmFsc2U7XG4gICAgcmV0dXJuIHJlc1xuICB9KVxufVxuXG5mdW5jdGlvbiBuZXh0VGljayAoY2IsIGN0eCkge1xuICB2YXIgX3Jlc29sdmU7XG4gIGNhbGxiYWNrcy5wdXNoKGZ1bmN0aW9uICgpIHtcbiAgICBpZiAoY2IpIHtcbiAgICAgIHRyeSB7XG4gICAgICAgIGNiLmNhbGwoY3R4KTtcbiAgICAgIH0gY2F0Y2ggKGUpIHtcbiAgICAgICAgaGFuZGxlRXJyb3IoZSwgY3R4LCAnbmV4dFRpY2snKTtcbiAgICAgIH1cbiAgICB9IGVsc2UgaWYgKF9yZXNvbHZlKSB7XG4gICAgICBfcmVzb2x2ZShjdHgpO1xuICAgIH1cbiAgfSk7XG4gIGlmICghcGVuZGluZykge1xuICAgIHBlbmRpbmcgPSB0cnVlO1xuICAgIGlmICh1c2VNYWNyb1Rhc2spIHtcbiAgICAgIG1hY3JvVGltZXJGdW5jKCk7XG4gICAgfSBlbHNlIHtcbiAgICAgIG1pY3JvVGltZXJGdW5jKCk7XG4gICAgfVxuICB9XG4gIC8vICRmbG93LWRpc2FibGUtbGluZVxuICBpZiAoIWNiICYmIHR5cGVvZiBQcm9taXNlICE9PSAndW5kZWZpbmVkJykge1xuICAgIHJldHVybiBuZXcgUHJvbWlzZShmdW5jdGlvbiAocmVzb2x2ZSkge1xuICAgICAgX3Jlc29sdmUgPSByZXNvbHZlO1xuICAgIH0pXG4gIH1cbn1cblxuLyogICovXG5cbi8qIG5vdCB0eXBlIGNoZWNraW5nIHRoaXMgZmlsZSBiZWNhdXNlIGZsb3cgZG9lc24ndCBwbGF5IHdlbGwgd2l0aCBQcm94eSAqL1xuXG52YXIgaW5pdFByb3h5O1xuXG5pZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICB2YXIgYWxsb3dlZEdsb2JhbHMgPSBtYWtlTWFwKFxuICAgICdJbmZpbml0eSx1bmRlZmluZWQsTmFOLGlzRmluaXRlLGlzTmFOLCcgK1xuICAgICdwYXJzZUZsb2F0LHBhcnNlSW50LGRlY29kZVVSSSxkZWNvZGVVUklDb21wb25lbnQsZW5jb2RlVVJJLGVuY29kZVVSSUNvbXBvbmVudCwnICtcbiAgICAnTWF0aCxOdW1iZXIsRGF0ZSxBcnJheSxPYmplY3QsQm9vbGVhbixTdHJpbmcsUmVnRXhwLE1hcCxTZXQsSlNPTixJbnRsLCcgK1xuICAgICdyZXF1aXJlJyAvLyBmb3IgV2VicGFjay9Ccm93c2VyaWZ5XG4gICk7XG5cbiAgdmFyIHdhcm5Ob25QcmVzZW50ID0gZnVuY3Rpb24gKHRhcmdldCwga2V5KSB7XG4gICAgd2FybihcbiAgICAgIFwiUHJvcGVydHkgb3IgbWV0aG9kIFxcXCJcIiArIGtleSArIFwiXFxcIiBpcyBub3QgZGVmaW5lZCBvbiB0aGUgaW5zdGFuY2UgYnV0IFwiICtcbiAgICAgICdyZWZlcmVuY2VkIGR1cmluZyByZW5kZXIuIE1ha2Ugc3VyZSB0aGF0IHRoaXMgcHJvcGVydHkgaXMgcmVhY3RpdmUsICcgK1xuICAgICAgJ2VpdGhlciBpbiB0aGUgZGF0YSBvcHRpb24sIG9yIGZvciBjbGFzcy1iYXNlZCBjb21wb25lbnRzLCBieSAnICtcbiAgICAgICdpbml0aWFsaXppbmcgdGhlIHByb3BlcnR5LiAnICtcbiAgICAgICdTZWU6IGh0dHBzOi8vdnVlanMub3JnL3YyL2d1aWRlL3JlYWN0aXZpdHkuaHRtbCNEZWNsYXJpbmctUmVhY3RpdmUtUHJvcGVydGllcy4nLFxuICAgICAgdGFyZ2V0XG4gICAgKTtcbiAgfTtcblxuICB2YXIgaGFzUHJveHkgPVxuICAgIHR5cGVvZiBQcm94eSAhPT0gJ3VuZGVmaW5lZCcgJiYgaXNOYXRpdmUoUHJveHkpO1xuXG4gIGlmIChoYXNQcm94eSkge1xuICAgIHZhciBpc0J1aWx0SW5Nb2RpZmllciA9IG1ha2VNYXAoJ3N0b3AscHJldmVudCxzZWxmLGN0cmwsc2hpZnQsYWx0LG1ldGEsZXhhY3QnKTtcbiAgICBjb25maWcua2V5Q29kZXMgPSBuZXcgUHJveHkoY29uZmlnLmtleUNvZGVzLCB7XG4gICAgICBzZXQ6IGZ1bmN0aW9uIHNldCAodGFyZ2V0LCBrZXksIHZhbHVlKSB7XG4gICAgICAgIGlmIChpc0J1aWx0SW5Nb2RpZmllcihrZXkpKSB7XG4gICAgICAgICAgd2FybigoXCJBdm9pZCBvdmVyd3JpdGluZyBidWlsdC1pbiBtb2RpZmllciBpbiBjb25maWcua2V5Q29kZXM6IC5cIiArIGtleSkpO1xuICAgICAgICAgIHJldHVybiBmYWxzZVxuICAgICAgICB9IGVsc2Uge1xuICAgICAgICAgIHRhcmdldFtrZXldID0gdmFsdWU7XG4gICAgICAgICAgcmV0dXJuIHRydWVcbiAgICAgICAgfVxuICAgICAgfVxuICAgIH0pO1xuICB9XG5cbiAgdmFyIGhhc0hhbmRsZXIgPSB7XG4gICAgaGFzOiBmdW5jdGlvbiBoYXMgKHRhcmdldCwga2V5KSB7XG4gICAgICB2YXIgaGFzID0ga2V5IGluIHRhcmdldDtcbiAgICAgIHZhciBpc0FsbG93ZWQgPSBhbGxvd2VkR2xvYmFscyhrZXkpIHx8IGtleS5jaGFyQXQoMCkgPT09ICdfJztcbiAgICAgIGlmICghaGFzICYmICFpc0FsbG93ZWQpIHtcbiAgICAgICAgd2Fybk5vblByZXNlbnQodGFyZ2V0LCBrZXkpO1xuICAgICAgfVxuICAgICAgcmV0dXJuIGhhcyB8fCAhaXNBbGxvd2VkXG4gICAgfVxuICB9O1xuXG4gIHZhciBnZXRIYW5kbGVyID0ge1xuICAgIGdldDogZnVuY3Rpb24gZ2V0ICh0YXJnZXQsIGtleSkge1xuICAgICAgaWYgKHR5cGVvZiBrZXkgPT09ICdzdHJpbmcnICYmICEoa2V5IGluIHRhcmdldCkpIHtcbiAgICAgICAgd2Fybk5vblByZXNlbnQodGFyZ2V0LCBrZXkpO1xuICAgICAgfVxuICAgICAgcmV0dXJuIHRhcmdldFtrZXldXG4gICAgfVxuICB9O1xuXG4gIGluaXRQcm94eSA9IGZ1bmN0aW9uIGluaXRQcm94eSAodm0pIHtcbiAgICBpZiAoaGFzUHJveHkpIHtcbiAgICAgIC8vIGRldGVybWluZSB3aGljaCBwcm94eSBoYW5kbGVyIHRvIHVzZVxuICAgICAgdmFyIG9wdGlvbnMgPSB2bS4kb3B0aW9ucztcbiAgICAgIHZhciBoYW5kbGVycyA9IG9wdGlvbnMucmVuZGVyICYmIG9wdGlvbnMucmVuZGVyLl93aXRoU3RyaXBwZWRcbiAgICAgICAgPyBnZXRIYW5kbGVyXG4gICAgICAgIDogaGFzSGFuZGxlcjtcbiAgICAgIHZtLl9yZW5kZXJQcm94eSA9IG5ldyBQcm94eSh2bSwgaGFuZGxlcnMpO1xuICAgIH0gZWxzZSB7XG4gICAgICB2bS5fcmVuZGVyUHJveHkgPSB2bTtcbiAgICB9XG4gIH07XG59XG5cbi8qICAqL1xuXG52YXIgc2Vlbk9iamVjdHMgPSBuZXcgX1NldCgpO1xuXG4vKipcbiAqIFJlY3Vyc2l2ZWx5IHRyYXZlcnNlIGFuIG9iamVjdCB0byBldm9rZSBhbGwgY29udmVydGVkXG4gKiBnZXR0ZXJzLCBzbyB0aGF0IGV2ZXJ5IG5lc3RlZCBwcm9wZXJ0eSBpbnNpZGUgdGhlIG9iamVjdFxuICogaXMgY29sbGVjdGVkIGFzIGEgXCJkZWVwXCIgZGVwZW5kZW5jeS5cbiAqL1xuZnVuY3Rpb24gdHJhdmVyc2UgKHZhbCkge1xuICBfdHJhdmVyc2UodmFsLCBzZWVuT2JqZWN0cyk7XG4gIHNlZW5PYmplY3RzLmNsZWFyKCk7XG59XG5cbmZ1bmN0aW9uIF90cmF2ZXJzZSAodmFsLCBzZWVuKSB7XG4gIHZhciBpLCBrZXlzO1xuICB2YXIgaXNBID0gQXJyYXkuaXNBcnJheSh2YWwpO1xuICBpZiAoKCFpc0EgJiYgIWlzT2JqZWN0KHZhbCkpIHx8IE9iamVjdC5pc0Zyb3plbih2YWwpIHx8IHZhbCBpbnN0YW5jZW9mIFZOb2RlKSB7XG4gICAgcmV0dXJuXG4gIH1cbiAgaWYgKHZhbC5fX29iX18pIHtcbiAgICB2YXIgZGVwSWQgPSB2YWwuX19vYl9fLmRlcC5pZDtcbiAgICBpZiAoc2Vlbi5oYXMoZGVwSWQpKSB7XG4gICAgICByZXR1cm5cbiAgICB9XG4gICAgc2Vlbi5hZGQoZGVwSWQpO1xuICB9XG4gIGlmIChpc0EpIHtcbiAgICBpID0gdmFsLmxlbmd0aDtcbiAgICB3aGlsZSAoaS0tKSB7IF90cmF2ZXJzZSh2YWxbaV0sIHNlZW4pOyB9XG4gIH0gZWxzZSB7XG4gICAga2V5cyA9IE9iamVjdC5rZXlzKHZhbCk7XG4gICAgaSA9IGtleXMubGVuZ3RoO1xuICAgIHdoaWxlIChpLS0pIHsgX3RyYXZlcnNlKHZhbFtrZXlzW2ldXSwgc2Vlbik7IH1cbiAgfVxufVxuXG52YXIgbWFyaztcbnZhciBtZWFzdXJlO1xuXG5pZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICB2YXIgcGVyZiA9IGluQnJvd3NlciAmJiB3aW5kb3cucGVyZm9ybWFuY2U7XG4gIC8qIGlzdGFuYnVsIGlnbm9yZSBpZiAqL1xuICBpZiAoXG4gICAgcGVyZiAmJlxuICAgIHBlcmYubWFyayAmJlxuICAgIHBlcmYubWVhc3VyZSAmJlxuICAgIHBlcmYuY2xlYXJNYXJrcyAmJlxuICAgIHBlcmYuY2xlYXJNZWFzdXJlc1xuICApIHtcbiAgICBtYXJrID0gZnVuY3Rpb24gKHRhZykgeyByZXR1cm4gcGVyZi5tYXJrKHRhZyk7IH07XG4gICAgbWVhc3VyZSA9IGZ1bmN0aW9uIChuYW1lLCBzdGFydFRhZywgZW5kVGFnKSB7XG4gICAgICBwZXJmLm1lYXN1cmUobmFtZSwgc3RhcnRUYWcsIGVuZFRhZyk7XG4gICAgICBwZXJmLmNsZWFyTWFya3Moc3RhcnRUYWcpO1xuICAgICAgcGVyZi5jbGVhck1hcmtzKGVuZFRhZyk7XG4gICAgICBwZXJmLmNsZWFyTWVhc3VyZXMobmFtZSk7XG4gICAgfTtcbiAgfVxufVxuXG4vKiAgKi9cblxudmFyIG5vcm1hbGl6ZUV2ZW50ID0gY2FjaGVkKGZ1bmN0aW9uIChuYW1lKSB7XG4gIHZhciBwYXNzaXZlID0gbmFtZS5jaGFyQXQoMCkgPT09ICcmJztcbiAgbmFtZSA9IHBhc3NpdmUgPyBuYW1lLnNsaWNlKDEpIDogbmFtZTtcbiAgdmFyIG9uY2UkJDEgPSBuYW1lLmNoYXJBdCgwKSA9PT0gJ34nOyAvLyBQcmVmaXhlZCBsYXN0LCBjaGVja2VkIGZpcnN0XG4gIG5hbWUgPSBvbmNlJCQxID8gbmFtZS5zbGljZSgxKSA6IG5hbWU7XG4gIHZhciBjYXB0dXJlID0gbmFtZS5jaGFyQXQoMCkgPT09ICchJztcbiAgbmFtZSA9IGNhcHR1cmUgPyBuYW1lLnNsaWNlKDEpIDogbmFtZTtcbiAgcmV0dXJuIHtcbiAgICBuYW1lOiBuYW1lLFxuICAgIG9uY2U6IG9uY2UkJDEsXG4gICAgY2FwdHVyZTogY2FwdHVyZSxcbiAgICBwYXNzaXZlOiBwYXNzaXZlXG4gIH1cbn0pO1xuXG5mdW5jdGlvbiBjcmVhdGVGbkludm9rZXIgKGZucykge1xuICBmdW5jdGlvbiBpbnZva2VyICgpIHtcbiAgICB2YXIgYXJndW1lbnRzJDEgPSBhcmd1bWVudHM7XG5cbiAgICB2YXIgZm5zID0gaW52b2tlci5mbnM7XG4gICAgaWYgKEFycmF5LmlzQXJyYXkoZm5zKSkge1xuICAgICAgdmFyIGNsb25lZCA9IGZucy5zbGljZSgpO1xuICAgICAgZm9yICh2YXIgaSA9IDA7IGkgPCBjbG9uZWQubGVuZ3RoOyBpKyspIHtcbiAgICAgICAgY2xvbmVkW2ldLmFwcGx5KG51bGwsIGFyZ3VtZW50cyQxKTtcbiAgICAgIH1cbiAgICB9IGVsc2Uge1xuICAgICAgLy8gcmV0dXJuIGhhbmRsZXIgcmV0dXJuIHZhbHVlIGZvciBzaW5nbGUgaGFuZGxlcnNcbiAgICAgIHJldHVybiBmbnMuYXBwbHkobnVsbCwgYXJndW1lbnRzKVxuICAgIH1cbiAgfVxuICBpbnZva2VyLmZucyA9IGZucztcbiAgcmV0dXJuIGludm9rZXJcbn1cblxuZnVuY3Rpb24gdXBkYXRlTGlzdGVuZXJzIChcbiAgb24sXG4gIG9sZE9uLFxuICBhZGQsXG4gIHJlbW92ZSQkMSxcbiAgdm1cbikge1xuICB2YXIgbmFtZSwgZGVmLCBjdXIsIG9sZCwgZXZlbnQ7XG4gIGZvciAobmFtZSBpbiBvbikge1xuICAgIGRlZiA9IGN1ciA9IG9uW25hbWVdO1xuICAgIG9sZCA9IG9sZE9uW25hbWVdO1xuICAgIGV2ZW50ID0gbm9ybWFsaXplRXZlbnQobmFtZSk7XG4gICAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gICAgaWYgKGlzVW5kZWYoY3VyKSkge1xuICAgICAgcHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJiB3YXJuKFxuICAgICAgICBcIkludmFsaWQgaGFuZGxlciBmb3IgZXZlbnQgXFxcIlwiICsgKGV2ZW50Lm5hbWUpICsgXCJcXFwiOiBnb3QgXCIgKyBTdHJpbmcoY3VyKSxcbiAgICAgICAgdm1cbiAgICAgICk7XG4gICAgfSBlbHNlIGlmIChpc1VuZGVmKG9sZCkpIHtcbiAgICAgIGlmIChpc1VuZGVmKGN1ci5mbnMpKSB7XG4gICAgICAgIGN1ciA9IG9uW25hbWVdID0gY3JlYXRlRm5JbnZva2VyKGN1cik7XG4gICAgICB9XG4gICAgICBhZGQoZXZlbnQubmFtZSwgY3VyLCBldmVudC5vbmNlLCBldmVudC5jYXB0dXJlLCBldmVudC5wYXNzaXZlLCBldmVudC5wYXJhbXMpO1xuICAgIH0gZWxzZSBpZiAoY3VyICE9PSBvbGQpIHtcbiAgICAgIG9sZC5mbnMgPSBjdXI7XG4gICAgICBvbltuYW1lXSA9IG9sZDtcbiAgICB9XG4gIH1cbiAgZm9yIChuYW1lIGluIG9sZE9uKSB7XG4gICAgaWYgKGlzVW5kZWYob25bbmFtZV0pKSB7XG4gICAgICBldmVudCA9IG5vcm1hbGl6ZUV2ZW50KG5hbWUpO1xuICAgICAgcmVtb3ZlJCQxKGV2ZW50Lm5hbWUsIG9sZE9uW25hbWVdLCBldmVudC5jYXB0dXJlKTtcbiAgICB9XG4gIH1cbn1cblxuLyogICovXG5cbmZ1bmN0aW9uIG1lcmdlVk5vZGVIb29rIChkZWYsIGhvb2tLZXksIGhvb2spIHtcbiAgaWYgKGRlZiBpbnN0YW5jZW9mIFZOb2RlKSB7XG4gICAgZGVmID0gZGVmLmRhdGEuaG9vayB8fCAoZGVmLmRhdGEuaG9vayA9IHt9KTtcbiAgfVxuICB2YXIgaW52b2tlcjtcbiAgdmFyIG9sZEhvb2sgPSBkZWZbaG9va0tleV07XG5cbiAgZnVuY3Rpb24gd3JhcHBlZEhvb2sgKCkge1xuICAgIGhvb2suYXBwbHkodGhpcywgYXJndW1lbnRzKTtcbiAgICAvLyBpbXBvcnRhbnQ6IHJlbW92ZSBtZXJnZWQgaG9vayB0byBlbnN1cmUgaXQncyBjYWxsZWQgb25seSBvbmNlXG4gICAgLy8gYW5kIHByZXZlbnQgbWVtb3J5IGxlYWtcbiAgICByZW1vdmUoaW52b2tlci5mbnMsIHdyYXBwZWRIb29rKTtcbiAgfVxuXG4gIGlmIChpc1VuZGVmKG9sZEhvb2spKSB7XG4gICAgLy8gbm8gZXhpc3RpbmcgaG9va1xuICAgIGludm9rZXIgPSBjcmVhdGVGbkludm9rZXIoW3dyYXBwZWRIb29rXSk7XG4gIH0gZWxzZSB7XG4gICAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gICAgaWYgKGlzRGVmKG9sZEhvb2suZm5zKSAmJiBpc1RydWUob2xkSG9vay5tZXJnZWQpKSB7XG4gICAgICAvLyBhbHJlYWR5IGEgbWVyZ2VkIGludm9rZXJcbiAgICAgIGludm9rZXIgPSBvbGRIb29rO1xuICAgICAgaW52b2tlci5mbnMucHVzaCh3cmFwcGVkSG9vayk7XG4gICAgfSBlbHNlIHtcbiAgICAgIC8vIGV4aXN0aW5nIHBsYWluIGhvb2tcbiAgICAgIGludm9rZXIgPSBjcmVhdGVGbkludm9rZXIoW29sZEhvb2ssIHdyYXBwZWRIb29rXSk7XG4gICAgfVxuICB9XG5cbiAgaW52b2tlci5tZXJnZWQgPSB0cnVlO1xuICBkZWZbaG9va0tleV0gPSBpbnZva2VyO1xufVxuXG4vKiAgKi9cblxuZnVuY3Rpb24gZXh0cmFjdFByb3BzRnJvbVZOb2RlRGF0YSAoXG4gIGRhdGEsXG4gIEN0b3IsXG4gIHRhZ1xuKSB7XG4gIC8vIHdlIGFyZSBvbmx5IGV4dHJhY3RpbmcgcmF3IHZhbHVlcyBoZXJlLlxuICAvLyB2YWxpZGF0aW9uIGFuZCBkZWZhdWx0IHZhbHVlcyBhcmUgaGFuZGxlZCBpbiB0aGUgY2hpbGRcbiAgLy8gY29tcG9uZW50IGl0c2VsZi5cbiAgdmFyIHByb3BPcHRpb25zID0gQ3Rvci5vcHRpb25zLnByb3BzO1xuICBpZiAoaXNVbmRlZihwcm9wT3B0aW9ucykpIHtcbiAgICByZXR1cm5cbiAgfVxuICB2YXIgcmVzID0ge307XG4gIHZhciBhdHRycyA9IGRhdGEuYXR0cnM7XG4gIHZhciBwcm9wcyA9IGRhdGEucHJvcHM7XG4gIGlmIChpc0RlZihhdHRycykgfHwgaXNEZWYocHJvcHMpKSB7XG4gICAgZm9yICh2YXIga2V5IGluIHByb3BPcHRpb25zKSB7XG4gICAgICB2YXIgYWx0S2V5ID0gaHlwaGVuYXRlKGtleSk7XG4gICAgICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgICAgICB2YXIga2V5SW5Mb3dlckNhc2UgPSBrZXkudG9Mb3dlckNhc2UoKTtcbiAgICAgICAgaWYgKFxuICAgICAgICAgIGtleSAhPT0ga2V5SW5Mb3dlckNhc2UgJiZcbiAgICAgICAgICBhdHRycyAmJiBoYXNPd24oYXR0cnMsIGtleUluTG93ZXJDYXNlKVxuICAgICAgICApIHtcbiAgICAgICAgICB0aXAoXG4gICAgICAgICAgICBcIlByb3AgXFxcIlwiICsga2V5SW5Mb3dlckNhc2UgKyBcIlxcXCIgaXMgcGFzc2VkIHRvIGNvbXBvbmVudCBcIiArXG4gICAgICAgICAgICAoZm9ybWF0Q29tcG9uZW50TmFtZSh0YWcgfHwgQ3RvcikpICsgXCIsIGJ1dCB0aGUgZGVjbGFyZWQgcHJvcCBuYW1lIGlzXCIgK1xuICAgICAgICAgICAgXCIgXFxcIlwiICsga2V5ICsgXCJcXFwiLiBcIiArXG4gICAgICAgICAgICBcIk5vdGUgdGhhdCBIVE1MIGF0dHJpYnV0ZXMgYXJlIGNhc2UtaW5zZW5zaXRpdmUgYW5kIGNhbWVsQ2FzZWQgXCIgK1xuICAgICAgICAgICAgXCJwcm9wcyBuZWVkIHRvIHVzZSB0aGVpciBrZWJhYi1jYXNlIGVxdWl2YWxlbnRzIHdoZW4gdXNpbmcgaW4tRE9NIFwiICtcbiAgICAgICAgICAgIFwidGVtcGxhdGVzLiBZb3Ugc2hvdWxkIHByb2JhYmx5IHVzZSBcXFwiXCIgKyBhbHRLZXkgKyBcIlxcXCIgaW5zdGVhZCBvZiBcXFwiXCIgKyBrZXkgKyBcIlxcXCIuXCJcbiAgICAgICAgICApO1xuICAgICAgICB9XG4gICAgICB9XG4gICAgICBjaGVja1Byb3AocmVzLCBwcm9wcywga2V5LCBhbHRLZXksIHRydWUpIHx8XG4gICAgICBjaGVja1Byb3AocmVzLCBhdHRycywga2V5LCBhbHRLZXksIGZhbHNlKTtcbiAgICB9XG4gIH1cbiAgcmV0dXJuIHJlc1xufVxuXG5mdW5jdGlvbiBjaGVja1Byb3AgKFxuICByZXMsXG4gIGhhc2gsXG4gIGtleSxcbiAgYWx0S2V5LFxuICBwcmVzZXJ2ZVxuKSB7XG4gIGlmIChpc0RlZihoYXNoKSkge1xuICAgIGlmIChoYXNPd24oaGFzaCwga2V5KSkge1xuICAgICAgcmVzW2tleV0gPSBoYXNoW2tleV07XG4gICAgICBpZiAoIXByZXNlcnZlKSB7XG4gICAgICAgIGRlbGV0ZSBoYXNoW2tleV07XG4gICAgICB9XG4gICAgICByZXR1cm4gdHJ1ZVxuICAgIH0gZWxzZSBpZiAoaGFzT3duKGhhc2gsIGFsdEtleSkpIHtcbiAgICAgIHJlc1trZXldID0gaGFzaFthbHRLZXldO1xuICAgICAgaWYgKCFwcmVzZXJ2ZSkge1xuICAgICAgICBkZWxldGUgaGFzaFthbHRLZXldO1xuICAgICAgfVxuICAgICAgcmV0dXJuIHRydWVcbiAgICB9XG4gIH1cbiAgcmV0dXJuIGZhbHNlXG59XG5cbi8qICAqL1xuXG4vLyBUaGUgdGVtcGxhdGUgY29tcGlsZXIgYXR0ZW1wdHMgdG8gbWluaW1pemUgdGhlIG5lZWQgZm9yIG5vcm1hbGl6YXRpb24gYnlcbi8vIHN0YXRpY2FsbHkgYW5hbHl6aW5nIHRoZSB0ZW1wbGF0ZSBhdCBjb21waWxlIHRpbWUuXG4vL1xuLy8gRm9yIHBsYWluIEhUTUwgbWFya3VwLCBub3JtYWxpemF0aW9uIGNhbiBiZSBjb21wbGV0ZWx5IHNraXBwZWQgYmVjYXVzZSB0aGVcbi8vIGdlbmVyYXRlZCByZW5kZXIgZnVuY3Rpb24gaXMgZ3VhcmFudGVlZCB0byByZXR1cm4gQXJyYXk8Vk5vZGU+LiBUaGVyZSBhcmVcbi8vIHR3byBjYXNlcyB3aGVyZSBleHRyYSBub3JtYWxpemF0aW9uIGlzIG5lZWRlZDpcblxuLy8gMS4gV2hlbiB0aGUgY2hpbGRyZW4gY29udGFpbnMgY29tcG9uZW50cyAtIGJlY2F1c2UgYSBmdW5jdGlvbmFsIGNvbXBvbmVudFxuLy8gbWF5IHJldHVybiBhbiBBcnJheSBpbnN0ZWFkIG9mIGEgc2luZ2xlIHJvb3QuIEluIHRoaXMgY2FzZSwganVzdCBhIHNpbXBsZVxuLy8gbm9ybWFsaXphdGlvbiBpcyBuZWVkZWQgLSBpZiBhbnkgY2hpbGQgaXMgYW4gQXJyYXksIHdlIGZsYXR0ZW4gdGhlIHdob2xlXG4vLyB0aGluZyB3aXRoIEFycmF5LnByb3RvdHlwZS5jb25jYXQuIEl0IGlzIGd1YXJhbnRlZWQgdG8gYmUgb25seSAxLWxldmVsIGRlZXBcbi8vIGJlY2F1c2UgZnVuY3Rpb25hbCBjb21wb25lbnRzIGFscmVhZHkgbm9ybWFsaXplIHRoZWlyIG93biBjaGlsZHJlbi5cbmZ1bmN0aW9uIHNpbXBsZU5vcm1hbGl6ZUNoaWxkcmVuIChjaGlsZHJlbikge1xuICBmb3IgKHZhciBpID0gMDsgaSA8IGNoaWxkcmVuLmxlbmd0aDsgaSsrKSB7XG4gICAgaWYgKEFycmF5LmlzQXJyYXkoY2hpbGRyZW5baV0pKSB7XG4gICAgICByZXR1cm4gQXJyYXkucHJvdG90eXBlLmNvbmNhdC5hcHBseShbXSwgY2hpbGRyZW4pXG4gICAgfVxuICB9XG4gIHJldHVybiBjaGlsZHJlblxufVxuXG4vLyAyLiBXaGVuIHRoZSBjaGlsZHJlbiBjb250YWlucyBjb25zdHJ1Y3RzIHRoYXQgYWx3YXlzIGdlbmVyYXRlZCBuZXN0ZWQgQXJyYXlzLFxuLy8gZS5nLiA8dGVtcGxhdGU+LCA8c2xvdD4sIHYtZm9yLCBvciB3aGVuIHRoZSBjaGlsZHJlbiBpcyBwcm92aWRlZCBieSB1c2VyXG4vLyB3aXRoIGhhbmQtd3JpdHRlbiByZW5kZXIgZnVuY3Rpb25zIC8gSlNYLiBJbiBzdWNoIGNhc2VzIGEgZnVsbCBub3JtYWxpemF0aW9uXG4vLyBpcyBuZWVkZWQgdG8gY2F0ZXIgdG8gYWxsIHBvc3NpYmxlIHR5cGVzIG9mIGNoaWxkcmVuIHZhbHVlcy5cbmZ1bmN0aW9uIG5vcm1hbGl6ZUNoaWxkcmVuIChjaGlsZHJlbikge1xuICByZXR1cm4gaXNQcmltaXRpdmUoY2hpbGRyZW4pXG4gICAgPyBbY3JlYXRlVGV4dFZOb2RlKGNoaWxkcmVuKV1cbiAgICA6IEFycmF5LmlzQXJyYXkoY2hpbGRyZW4pXG4gICAgICA/IG5vcm1hbGl6ZUFycmF5Q2hpbGRyZW4oY2hpbGRyZW4pXG4gICAgICA6IHVuZGVmaW5lZFxufVxuXG5mdW5jdGlvbiBpc1RleHROb2RlIChub2RlKSB7XG4gIHJldHVybiBpc0RlZihub2RlKSAmJiBpc0RlZihub2RlLnRleHQpICYmIGlzRmFsc2Uobm9kZS5pc0NvbW1lbnQpXG59XG5cbmZ1bmN0aW9uIG5vcm1hbGl6ZUFycmF5Q2hpbGRyZW4gKGNoaWxkcmVuLCBuZXN0ZWRJbmRleCkge1xuICB2YXIgcmVzID0gW107XG4gIHZhciBpLCBjLCBsYXN0SW5kZXgsIGxhc3Q7XG4gIGZvciAoaSA9IDA7IGkgPCBjaGlsZHJlbi5sZW5ndGg7IGkrKykge1xuICAgIGMgPSBjaGlsZHJlbltpXTtcbiAgICBpZiAoaXNVbmRlZihjKSB8fCB0eXBlb2YgYyA9PT0gJ2Jvb2xlYW4nKSB7IGNvbnRpbnVlIH1cbiAgICBsYXN0SW5kZXggPSByZXMubGVuZ3RoIC0gMTtcbiAgICBsYXN0ID0gcmVzW2xhc3RJbmRleF07XG4gICAgLy8gIG5lc3RlZFxuICAgIGlmIChBcnJheS5pc0FycmF5KGMpKSB7XG4gICAgICBpZiAoYy5sZW5ndGggPiAwKSB7XG4gICAgICAgIGMgPSBub3JtYWxpemVBcnJheUNoaWxkcmVuKGMsICgobmVzdGVkSW5kZXggfHwgJycpICsgXCJfXCIgKyBpKSk7XG4gICAgICAgIC8vIG1lcmdlIGFkamFjZW50IHRleHQgbm9kZXNcbiAgICAgICAgaWYgKGlzVGV4dE5vZGUoY1swXSkgJiYgaXNUZXh0Tm9kZShsYXN0KSkge1xuICAgICAgICAgIHJlc1tsYXN0SW5kZXhdID0gY3JlYXRlVGV4dFZOb2RlKGxhc3QudGV4dCArIChjWzBdKS50ZXh0KTtcbiAgICAgICAgICBjLnNoaWZ0KCk7XG4gICAgICAgIH1cbiAgICAgICAgcmVzLnB1c2guYXBwbHkocmVzLCBjKTtcbiAgICAgIH1cbiAgICB9IGVsc2UgaWYgKGlzUHJpbWl0aXZlKGMpKSB7XG4gICAgICBpZiAoaXNUZXh0Tm9kZShsYXN0KSkge1xuICAgICAgICAvLyBtZXJnZSBhZGphY2VudCB0ZXh0IG5vZGVzXG4gICAgICAgIC8vIHRoaXMgaXMgbmVjZXNzYXJ5IGZvciBTU1IgaHlkcmF0aW9uIGJlY2F1c2UgdGV4dCBub2RlcyBhcmVcbiAgICAgICAgLy8gZXNzZW50aWFsbHkgbWVyZ2VkIHdoZW4gcmVuZGVyZWQgdG8gSFRNTCBzdHJpbmdzXG4gICAgICAgIHJlc1tsYXN0SW5kZXhdID0gY3JlYXRlVGV4dFZOb2RlKGxhc3QudGV4dCArIGMpO1xuICAgICAgfSBlbHNlIGlmIChjICE9PSAnJykge1xuICAgICAgICAvLyBjb252ZXJ0IHByaW1pdGl2ZSB0byB2bm9kZVxuICAgICAgICByZXMucHVzaChjcmVhdGVUZXh0Vk5vZGUoYykpO1xuICAgICAgfVxuICAgIH0gZWxzZSB7XG4gICAgICBpZiAoaXNUZXh0Tm9kZShjKSAmJiBpc1RleHROb2RlKGxhc3QpKSB7XG4gICAgICAgIC8vIG1lcmdlIGFkamFjZW50IHRleHQgbm9kZXNcbiAgICAgICAgcmVzW2xhc3RJbmRleF0gPSBjcmVhdGVUZXh0Vk5vZGUobGFzdC50ZXh0ICsgYy50ZXh0KTtcbiAgICAgIH0gZWxzZSB7XG4gICAgICAgIC8vIGRlZmF1bHQga2V5IGZvciBuZXN0ZWQgYXJyYXkgY2hpbGRyZW4gKGxpa2VseSBnZW5lcmF0ZWQgYnkgdi1mb3IpXG4gICAgICAgIGlmIChpc1RydWUoY2hpbGRyZW4uX2lzVkxpc3QpICYmXG4gICAgICAgICAgaXNEZWYoYy50YWcpICYmXG4gICAgICAgICAgaXNVbmRlZihjLmtleSkgJiZcbiAgICAgICAgICBpc0RlZihuZXN0ZWRJbmRleCkpIHtcbiAgICAgICAgICBjLmtleSA9IFwiX192bGlzdFwiICsgbmVzdGVkSW5kZXggKyBcIl9cIiArIGkgKyBcIl9fXCI7XG4gICAgICAgIH1cbiAgICAgICAgcmVzLnB1c2goYyk7XG4gICAgICB9XG4gICAgfVxuICB9XG4gIHJldHVybiByZXNcbn1cblxuLyogICovXG5cbmZ1bmN0aW9uIGVuc3VyZUN0b3IgKGNvbXAsIGJhc2UpIHtcbiAgaWYgKFxuICAgIGNvbXAuX19lc01vZHVsZSB8fFxuICAgIChoYXNTeW1ib2wgJiYgY29tcFtTeW1ib2wudG9TdHJpbmdUYWddID09PSAnTW9kdWxlJylcbiAgKSB7XG4gICAgY29tcCA9IGNvbXAuZGVmYXVsdDtcbiAgfVxuICByZXR1cm4gaXNPYmplY3QoY29tcClcbiAgICA/IGJhc2UuZXh0ZW5kKGNvbXApXG4gICAgOiBjb21wXG59XG5cbmZ1bmN0aW9uIGNyZWF0ZUFzeW5jUGxhY2Vob2xkZXIgKFxuICBmYWN0b3J5LFxuICBkYXRhLFxuICBjb250ZXh0LFxuICBjaGlsZHJlbixcbiAgdGFnXG4pIHtcbiAgdmFyIG5vZGUgPSBjcmVhdGVFbXB0eVZOb2RlKCk7XG4gIG5vZGUuYXN5bmNGYWN0b3J5ID0gZmFjdG9yeTtcbiAgbm9kZS5hc3luY01ldGEgPSB7IGRhdGE6IGRhdGEsIGNvbnRleHQ6IGNvbnRleHQsIGNoaWxkcmVuOiBjaGlsZHJlbiwgdGFnOiB0YWcgfTtcbiAgcmV0dXJuIG5vZGVcbn1cblxuZnVuY3Rpb24gcmVzb2x2ZUFzeW5jQ29tcG9uZW50IChcbiAgZmFjdG9yeSxcbiAgYmFzZUN0b3IsXG4gIGNvbnRleHRcbikge1xuICBpZiAoaXNUcnVlKGZhY3RvcnkuZXJyb3IpICYmIGlzRGVmKGZhY3RvcnkuZXJyb3JDb21wKSkge1xuICAgIHJldHVybiBmYWN0b3J5LmVycm9yQ29tcFxuICB9XG5cbiAgaWYgKGlzRGVmKGZhY3RvcnkucmVzb2x2ZWQpKSB7XG4gICAgcmV0dXJuIGZhY3RvcnkucmVzb2x2ZWRcbiAgfVxuXG4gIGlmIChpc1RydWUoZmFjdG9yeS5sb2FkaW5nKSAmJiBpc0RlZihmYWN0b3J5LmxvYWRpbmdDb21wKSkge1xuICAgIHJldHVybiBmYWN0b3J5LmxvYWRpbmdDb21wXG4gIH1cblxuICBpZiAoaXNEZWYoZmFjdG9yeS5jb250ZXh0cykpIHtcbiAgICAvLyBhbHJlYWR5IHBlbmRpbmdcbiAgICBmYWN0b3J5LmNvbnRleHRzLnB1c2goY29udGV4dCk7XG4gIH0gZWxzZSB7XG4gICAgdmFyIGNvbnRleHRzID0gZmFjdG9yeS5jb250ZXh0cyA9IFtjb250ZXh0XTtcbiAgICB2YXIgc3luYyA9IHRydWU7XG5cbiAgICB2YXIgZm9yY2VSZW5kZXIgPSBmdW5jdGlvbiAoKSB7XG4gICAgICBmb3IgKHZhciBpID0gMCwgbCA9IGNvbnRleHRzLmxlbmd0aDsgaSA8IGw7IGkrKykge1xuICAgICAgICBjb250ZXh0c1tpXS4kZm9yY2VVcGRhdGUoKTtcbiAgICAgIH1cbiAgICB9O1xuXG4gICAgdmFyIHJlc29sdmUgPSBvbmNlKGZ1bmN0aW9uIChyZXMpIHtcbiAgICAgIC8vIGNhY2hlIHJlc29sdmVkXG4gICAgICBmYWN0b3J5LnJlc29sdmVkID0gZW5zdXJlQ3RvcihyZXMsIGJhc2VDdG9yKTtcbiAgICAgIC8vIGludm9rZSBjYWxsYmFja3Mgb25seSBpZiB0aGlzIGlzIG5vdCBhIHN5bmNocm9ub3VzIHJlc29sdmVcbiAgICAgIC8vIChhc3luYyByZXNvbHZlcyBhcmUgc2hpbW1lZCBhcyBzeW5jaHJvbm91cyBkdXJpbmcgU1NSKVxuICAgICAgaWYgKCFzeW5jKSB7XG4gICAgICAgIGZvcmNlUmVuZGVyKCk7XG4gICAgICB9XG4gICAgfSk7XG5cbiAgICB2YXIgcmVqZWN0ID0gb25jZShmdW5jdGlvbiAocmVhc29uKSB7XG4gICAgICBwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nICYmIHdhcm4oXG4gICAgICAgIFwiRmFpbGVkIHRvIHJlc29sdmUgYXN5bmMgY29tcG9uZW50OiBcIiArIChTdHJpbmcoZmFjdG9yeSkpICtcbiAgICAgICAgKHJlYXNvbiA/IChcIlxcblJlYXNvbjogXCIgKyByZWFzb24pIDogJycpXG4gICAgICApO1xuICAgICAgaWYgKGlzRGVmKGZhY3RvcnkuZXJyb3JDb21wKSkge1xuICAgICAgICBmYWN0b3J5LmVycm9yID0gdHJ1ZTtcbiAgICAgICAgZm9yY2VSZW5kZXIoKTtcbiAgICAgIH1cbiAgICB9KTtcblxuICAgIHZhciByZXMgPSBmYWN0b3J5KHJlc29sdmUsIHJlamVjdCk7XG5cbiAgICBpZiAoaXNPYmplY3QocmVzKSkge1xuICAgICAgaWYgKHR5cGVvZiByZXMudGhlbiA9PT0gJ2Z1bmN0aW9uJykge1xuICAgICAgICAvLyAoKSA9PiBQcm9taXNlXG4gICAgICAgIGlmIChpc1VuZGVmKGZhY3RvcnkucmVzb2x2ZWQpKSB7XG4gICAgICAgICAgcmVzLnRoZW4ocmVzb2x2ZSwgcmVqZWN0KTtcbiAgICAgICAgfVxuICAgICAgfSBlbHNlIGlmIChpc0RlZihyZXMuY29tcG9uZW50KSAmJiB0eXBlb2YgcmVzLmNvbXBvbmVudC50aGVuID09PSAnZnVuY3Rpb24nKSB7XG4gICAgICAgIHJlcy5jb21wb25lbnQudGhlbihyZXNvbHZlLCByZWplY3QpO1xuXG4gICAgICAgIGlmIChpc0RlZihyZXMuZXJyb3IpKSB7XG4gICAgICAgICAgZmFjdG9yeS5lcnJvckNvbXAgPSBlbnN1cmVDdG9yKHJlcy5lcnJvciwgYmFzZUN0b3IpO1xuICAgICAgICB9XG5cbiAgICAgICAgaWYgKGlzRGVmKHJlcy5sb2FkaW5nKSkge1xuICAgICAgICAgIGZhY3RvcnkubG9hZGluZ0NvbXAgPSBlbnN1cmVDdG9yKHJlcy5sb2FkaW5nLCBiYXNlQ3Rvcik7XG4gICAgICAgICAgaWYgKHJlcy5kZWxheSA9PT0gMCkge1xuICAgICAgICAgICAgZmFjdG9yeS5sb2FkaW5nID0gdHJ1ZTtcbiAgICAgICAgICB9IGVsc2Uge1xuICAgICAgICAgICAgc2V0VGltZW91dChmdW5jdGlvbiAoKSB7XG4gICAgICAgICAgICAgIGlmIChpc1VuZGVmKGZhY3RvcnkucmVzb2x2ZWQpICYmIGlzVW5kZWYoZmFjdG9yeS5lcnJvcikpIHtcbiAgICAgICAgICAgICAgICBmYWN0b3J5LmxvYWRpbmcgPSB0cnVlO1xuICAgICAgICAgICAgICAgIGZvcmNlUmVuZGVyKCk7XG4gICAgICAgICAgICAgIH1cbiAgICAgICAgICAgIH0sIHJlcy5kZWxheSB8fCAyMDApO1xuICAgICAgICAgIH1cbiAgICAgICAgfVxuXG4gICAgICAgIGlmIChpc0RlZihyZXMudGltZW91dCkpIHtcbiAgICAgICAgICBzZXRUaW1lb3V0KGZ1bmN0aW9uICgpIHtcbiAgICAgICAgICAgIGlmIChpc1VuZGVmKGZhY3RvcnkucmVzb2x2ZWQpKSB7XG4gICAgICAgICAgICAgIHJlamVjdChcbiAgICAgICAgICAgICAgICBwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nXG4gICAgICAgICAgICAgICAgICA/IChcInRpbWVvdXQgKFwiICsgKHJlcy50aW1lb3V0KSArIFwibXMpXCIpXG4gICAgICAgICAgICAgICAgICA6IG51bGxcbiAgICAgICAgICAgICAgKTtcbiAgICAgICAgICAgIH1cbiAgICAgICAgICB9LCByZXMudGltZW91dCk7XG4gICAgICAgIH1cbiAgICAgIH1cbiAgICB9XG5cbiAgICBzeW5jID0gZmFsc2U7XG4gICAgLy8gcmV0dXJuIGluIGNhc2UgcmVzb2x2ZWQgc3luY2hyb25vdXNseVxuICAgIHJldHVybiBmYWN0b3J5LmxvYWRpbmdcbiAgICAgID8gZmFjdG9yeS5sb2FkaW5nQ29tcFxuICAgICAgOiBmYWN0b3J5LnJlc29sdmVkXG4gIH1cbn1cblxuLyogICovXG5cbmZ1bmN0aW9uIGlzQXN5bmNQbGFjZWhvbGRlciAobm9kZSkge1xuICByZXR1cm4gbm9kZS5pc0NvbW1lbnQgJiYgbm9kZS5hc3luY0ZhY3Rvcnlcbn1cblxuLyogICovXG5cbmZ1bmN0aW9uIGdldEZpcnN0Q29tcG9uZW50Q2hpbGQgKGNoaWxkcmVuKSB7XG4gIGlmIChBcnJheS5pc0FycmF5KGNoaWxkcmVuKSkge1xuICAgIGZvciAodmFyIGkgPSAwOyBpIDwgY2hpbGRyZW4ubGVuZ3RoOyBpKyspIHtcbiAgICAgIHZhciBjID0gY2hpbGRyZW5baV07XG4gICAgICBpZiAoaXNEZWYoYykgJiYgKGlzRGVmKGMuY29tcG9uZW50T3B0aW9ucykgfHwgaXNBc3luY1BsYWNlaG9sZGVyKGMpKSkge1xuICAgICAgICByZXR1cm4gY1xuICAgICAgfVxuICAgIH1cbiAgfVxufVxuXG4vKiAgKi9cblxuLyogICovXG5cbmZ1bmN0aW9uIGluaXRFdmVudHMgKHZtKSB7XG4gIHZtLl9ldmVudHMgPSBPYmplY3QuY3JlYXRlKG51bGwpO1xuICB2bS5faGFzSG9va0V2ZW50ID0gZmFsc2U7XG4gIC8vIGluaXQgcGFyZW50IGF0dGFjaGVkIGV2ZW50c1xuICB2YXIgbGlzdGVuZXJzID0gdm0uJG9wdGlvbnMuX3BhcmVudExpc3RlbmVycztcbiAgaWYgKGxpc3RlbmVycykge1xuICAgIHVwZGF0ZUNvbXBvbmVudExpc3RlbmVycyh2bSwgbGlzdGVuZXJzKTtcbiAgfVxufVxuXG52YXIgdGFyZ2V0O1xuXG5mdW5jdGlvbiBhZGQgKGV2ZW50LCBmbiwgb25jZSkge1xuICBpZiAob25jZSkge1xuICAgIHRhcmdldC4kb25jZShldmVudCwgZm4pO1xuICB9IGVsc2Uge1xuICAgIHRhcmdldC4kb24oZXZlbnQsIGZuKTtcbiAgfVxufVxuXG5mdW5jdGlvbiByZW1vdmUkMSAoZXZlbnQsIGZuKSB7XG4gIHRhcmdldC4kb2ZmKGV2ZW50LCBmbik7XG59XG5cbmZ1bmN0aW9uIHVwZGF0ZUNvbXBvbmVudExpc3RlbmVycyAoXG4gIHZtLFxuICBsaXN0ZW5lcnMsXG4gIG9sZExpc3RlbmVyc1xuKSB7XG4gIHRhcmdldCA9IHZtO1xuICB1cGRhdGVMaXN0ZW5lcnMobGlzdGVuZXJzLCBvbGRMaXN0ZW5lcnMgfHwge30sIGFkZCwgcmVtb3ZlJDEsIHZtKTtcbiAgdGFyZ2V0ID0gdW5kZWZpbmVkO1xufVxuXG5mdW5jdGlvbiBldmVudHNNaXhpbiAoVnVlKSB7XG4gIHZhciBob29rUkUgPSAvXmhvb2s6LztcbiAgVnVlLnByb3RvdHlwZS4kb24gPSBmdW5jdGlvbiAoZXZlbnQsIGZuKSB7XG4gICAgdmFyIHRoaXMkMSA9IHRoaXM7XG5cbiAgICB2YXIgdm0gPSB0aGlzO1xuICAgIGlmIChBcnJheS5pc0FycmF5KGV2ZW50KSkge1xuICAgICAgZm9yICh2YXIgaSA9IDAsIGwgPSBldmVudC5sZW5ndGg7IGkgPCBsOyBpKyspIHtcbiAgICAgICAgdGhpcyQxLiRvbihldmVudFtpXSwgZm4pO1xuICAgICAgfVxuICAgIH0gZWxzZSB7XG4gICAgICAodm0uX2V2ZW50c1tldmVudF0gfHwgKHZtLl9ldmVudHNbZXZlbnRdID0gW10pKS5wdXNoKGZuKTtcbiAgICAgIC8vIG9wdGltaXplIGhvb2s6ZXZlbnQgY29zdCBieSB1c2luZyBhIGJvb2xlYW4gZmxhZyBtYXJrZWQgYXQgcmVnaXN0cmF0aW9uXG4gICAgICAvLyBpbnN0ZWFkIG9mIGEgaGFzaCBsb29rdXBcbiAgICAgIGlmIChob29rUkUudGVzdChldmVudCkpIHtcbiAgICAgICAgdm0uX2hhc0hvb2tFdmVudCA9IHRydWU7XG4gICAgICB9XG4gICAgfVxuICAgIHJldHVybiB2bVxuICB9O1xuXG4gIFZ1ZS5wcm90b3R5cGUuJG9uY2UgPSBmdW5jdGlvbiAoZXZlbnQsIGZuKSB7XG4gICAgdmFyIHZtID0gdGhpcztcbiAgICBmdW5jdGlvbiBvbiAoKSB7XG4gICAgICB2bS4kb2ZmKGV2ZW50LCBvbik7XG4gICAgICBmbi5hcHBseSh2bSwgYXJndW1lbnRzKTtcbiAgICB9XG4gICAgb24uZm4gPSBmbjtcbiAgICB2bS4kb24oZXZlbnQsIG9uKTtcbiAgICByZXR1cm4gdm1cbiAgfTtcblxuICBWdWUucHJvdG90eXBlLiRvZmYgPSBmdW5jdGlvbiAoZXZlbnQsIGZuKSB7XG4gICAgdmFyIHRoaXMkMSA9IHRoaXM7XG5cbiAgICB2YXIgdm0gPSB0aGlzO1xuICAgIC8vIGFsbFxuICAgIGlmICghYXJndW1lbnRzLmxlbmd0aCkge1xuICAgICAgdm0uX2V2ZW50cyA9IE9iamVjdC5jcmVhdGUobnVsbCk7XG4gICAgICByZXR1cm4gdm1cbiAgICB9XG4gICAgLy8gYXJyYXkgb2YgZXZlbnRzXG4gICAgaWYgKEFycmF5LmlzQXJyYXkoZXZlbnQpKSB7XG4gICAgICBmb3IgKHZhciBpID0gMCwgbCA9IGV2ZW50Lmxlbmd0aDsgaSA8IGw7IGkrKykge1xuICAgICAgICB0aGlzJDEuJG9mZihldmVudFtpXSwgZm4pO1xuICAgICAgfVxuICAgICAgcmV0dXJuIHZtXG4gICAgfVxuICAgIC8vIHNwZWNpZmljIGV2ZW50XG4gICAgdmFyIGNicyA9IHZtLl9ldmVudHNbZXZlbnRdO1xuICAgIGlmICghY2JzKSB7XG4gICAgICByZXR1cm4gdm1cbiAgICB9XG4gICAgaWYgKCFmbikge1xuICAgICAgdm0uX2V2ZW50c1tldmVudF0gPSBudWxsO1xuICAgICAgcmV0dXJuIHZtXG4gICAgfVxuICAgIGlmIChmbikge1xuICAgICAgLy8gc3BlY2lmaWMgaGFuZGxlclxuICAgICAgdmFyIGNiO1xuICAgICAgdmFyIGkkMSA9IGNicy5sZW5ndGg7XG4gICAgICB3aGlsZSAoaSQxLS0pIHtcbiAgICAgICAgY2IgPSBjYnNbaSQxXTtcbiAgICAgICAgaWYgKGNiID09PSBmbiB8fCBjYi5mbiA9PT0gZm4pIHtcbiAgICAgICAgICBjYnMuc3BsaWNlKGkkMSwgMSk7XG4gICAgICAgICAgYnJlYWtcbiAgICAgICAgfVxuICAgICAgfVxuICAgIH1cbiAgICByZXR1cm4gdm1cbiAgfTtcblxuICBWdWUucHJvdG90eXBlLiRlbWl0ID0gZnVuY3Rpb24gKGV2ZW50KSB7XG4gICAgdmFyIHZtID0gdGhpcztcbiAgICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgICAgdmFyIGxvd2VyQ2FzZUV2ZW50ID0gZXZlbnQudG9Mb3dlckNhc2UoKTtcbiAgICAgIGlmIChsb3dlckNhc2VFdmVudCAhPT0gZXZlbnQgJiYgdm0uX2V2ZW50c1tsb3dlckNhc2VFdmVudF0pIHtcbiAgICAgICAgdGlwKFxuICAgICAgICAgIFwiRXZlbnQgXFxcIlwiICsgbG93ZXJDYXNlRXZlbnQgKyBcIlxcXCIgaXMgZW1pdHRlZCBpbiBjb21wb25lbnQgXCIgK1xuICAgICAgICAgIChmb3JtYXRDb21wb25lbnROYW1lKHZtKSkgKyBcIiBidXQgdGhlIGhhbmRsZXIgaXMgcmVnaXN0ZXJlZCBmb3IgXFxcIlwiICsgZXZlbnQgKyBcIlxcXCIuIFwiICtcbiAgICAgICAgICBcIk5vdGUgdGhhdCBIVE1MIGF0dHJpYnV0ZXMgYXJlIGNhc2UtaW5zZW5zaXRpdmUgYW5kIHlvdSBjYW5ub3QgdXNlIFwiICtcbiAgICAgICAgICBcInYtb24gdG8gbGlzdGVuIHRvIGNhbWVsQ2FzZSBldmVudHMgd2hlbiB1c2luZyBpbi1ET00gdGVtcGxhdGVzLiBcIiArXG4gICAgICAgICAgXCJZb3Ugc2hvdWxkIHByb2JhYmx5IHVzZSBcXFwiXCIgKyAoaHlwaGVuYXRlKGV2ZW50KSkgKyBcIlxcXCIgaW5zdGVhZCBvZiBcXFwiXCIgKyBldmVudCArIFwiXFxcIi5cIlxuICAgICAgICApO1xuICAgICAgfVxuICAgIH1cbiAgICB2YXIgY2JzID0gdm0uX2V2ZW50c1tldmVudF07XG4gICAgaWYgKGNicykge1xuICAgICAgY2JzID0gY2JzLmxlbmd0aCA+IDEgPyB0b0FycmF5KGNicykgOiBjYnM7XG4gICAgICB2YXIgYXJncyA9IHRvQXJyYXkoYXJndW1lbnRzLCAxKTtcbiAgICAgIGZvciAodmFyIGkgPSAwLCBsID0gY2JzLmxlbmd0aDsgaSA8IGw7IGkrKykge1xuICAgICAgICB0cnkge1xuICAgICAgICAgIGNic1tpXS5hcHBseSh2bSwgYXJncyk7XG4gICAgICAgIH0gY2F0Y2ggKGUpIHtcbiAgICAgICAgICBoYW5kbGVFcnJvcihlLCB2bSwgKFwiZXZlbnQgaGFuZGxlciBmb3IgXFxcIlwiICsgZXZlbnQgKyBcIlxcXCJcIikpO1xuICAgICAgICB9XG4gICAgICB9XG4gICAgfVxuICAgIHJldHVybiB2bVxuICB9O1xufVxuXG4vKiAgKi9cblxuXG5cbi8qKlxuICogUnVudGltZSBoZWxwZXIgZm9yIHJlc29sdmluZyByYXcgY2hpbGRyZW4gVk5vZGVzIGludG8gYSBzbG90IG9iamVjdC5cbiAqL1xuZnVuY3Rpb24gcmVzb2x2ZVNsb3RzIChcbiAgY2hpbGRyZW4sXG4gIGNvbnRleHRcbikge1xuICB2YXIgc2xvdHMgPSB7fTtcbiAgaWYgKCFjaGlsZHJlbikge1xuICAgIHJldHVybiBzbG90c1xuICB9XG4gIGZvciAodmFyIGkgPSAwLCBsID0gY2hpbGRyZW4ubGVuZ3RoOyBpIDwgbDsgaSsrKSB7XG4gICAgdmFyIGNoaWxkID0gY2hpbGRyZW5baV07XG4gICAgdmFyIGRhdGEgPSBjaGlsZC5kYXRhO1xuICAgIC8vIHJlbW92ZSBzbG90IGF0dHJpYnV0ZSBpZiB0aGUgbm9kZSBpcyByZXNvbHZlZCBhcyBhIFZ1ZSBzbG90IG5vZGVcbiAgICBpZiAoZGF0YSAmJiBkYXRhLmF0dHJzICYmIGRhdGEuYXR0cnMuc2xvdCkge1xuICAgICAgZGVsZXRlIGRhdGEuYXR0cnMuc2xvdDtcbiAgICB9XG4gICAgLy8gbmFtZWQgc2xvdHMgc2hvdWxkIG9ubHkgYmUgcmVzcGVjdGVkIGlmIHRoZSB2bm9kZSB3YXMgcmVuZGVyZWQgaW4gdGhlXG4gICAgLy8gc2FtZSBjb250ZXh0LlxuICAgIGlmICgoY2hpbGQuY29udGV4dCA9PT0gY29udGV4dCB8fCBjaGlsZC5mbkNvbnRleHQgPT09IGNvbnRleHQpICYmXG4gICAgICBkYXRhICYmIGRhdGEuc2xvdCAhPSBudWxsXG4gICAgKSB7XG4gICAgICB2YXIgbmFtZSA9IGRhdGEuc2xvdDtcbiAgICAgIHZhciBzbG90ID0gKHNsb3RzW25hbWVdIHx8IChzbG90c1tuYW1lXSA9IFtdKSk7XG4gICAgICBpZiAoY2hpbGQudGFnID09PSAndGVtcGxhdGUnKSB7XG4gICAgICAgIHNsb3QucHVzaC5hcHBseShzbG90LCBjaGlsZC5jaGlsZHJlbiB8fCBbXSk7XG4gICAgICB9IGVsc2Uge1xuICAgICAgICBzbG90LnB1c2goY2hpbGQpO1xuICAgICAgfVxuICAgIH0gZWxzZSB7XG4gICAgICAoc2xvdHMuZGVmYXVsdCB8fCAoc2xvdHMuZGVmYXVsdCA9IFtdKSkucHVzaChjaGlsZCk7XG4gICAgfVxuICB9XG4gIC8vIGlnbm9yZSBzbG90cyB0aGF0IGNvbnRhaW5zIG9ubHkgd2hpdGVzcGFjZVxuICBmb3IgKHZhciBuYW1lJDEgaW4gc2xvdHMpIHtcbiAgICBpZiAoc2xvdHNbbmFtZSQxXS5ldmVyeShpc1doaXRlc3BhY2UpKSB7XG4gICAgICBkZWxldGUgc2xvdHNbbmFtZSQxXTtcbiAgICB9XG4gIH1cbiAgcmV0dXJuIHNsb3RzXG59XG5cbmZ1bmN0aW9uIGlzV2hpdGVzcGFjZSAobm9kZSkge1xuICByZXR1cm4gKG5vZGUuaXNDb21tZW50ICYmICFub2RlLmFzeW5jRmFjdG9yeSkgfHwgbm9kZS50ZXh0ID09PSAnICdcbn1cblxuZnVuY3Rpb24gcmVzb2x2ZVNjb3BlZFNsb3RzIChcbiAgZm5zLCAvLyBzZWUgZmxvdy92bm9kZVxuICByZXNcbikge1xuICByZXMgPSByZXMgfHwge307XG4gIGZvciAodmFyIGkgPSAwOyBpIDwgZm5zLmxlbmd0aDsgaSsrKSB7XG4gICAgaWYgKEFycmF5LmlzQXJyYXkoZm5zW2ldKSkge1xuICAgICAgcmVzb2x2ZVNjb3BlZFNsb3RzKGZuc1tpXSwgcmVzKTtcbiAgICB9IGVsc2Uge1xuICAgICAgcmVzW2Zuc1tpXS5rZXldID0gZm5zW2ldLmZuO1xuICAgIH1cbiAgfVxuICByZXR1cm4gcmVzXG59XG5cbi8qICAqL1xuXG52YXIgYWN0aXZlSW5zdGFuY2UgPSBudWxsO1xudmFyIGlzVXBkYXRpbmdDaGlsZENvbXBvbmVudCA9IGZhbHNlO1xuXG5mdW5jdGlvbiBpbml0TGlmZWN5Y2xlICh2bSkge1xuICB2YXIgb3B0aW9ucyA9IHZtLiRvcHRpb25zO1xuXG4gIC8vIGxvY2F0ZSBmaXJzdCBub24tYWJzdHJhY3QgcGFyZW50XG4gIHZhciBwYXJlbnQgPSBvcHRpb25zLnBhcmVudDtcbiAgaWYgKHBhcmVudCAmJiAhb3B0aW9ucy5hYnN0cmFjdCkge1xuICAgIHdoaWxlIChwYXJlbnQuJG9wdGlvbnMuYWJzdHJhY3QgJiYgcGFyZW50LiRwYXJlbnQpIHtcbiAgICAgIHBhcmVudCA9IHBhcmVudC4kcGFyZW50O1xuICAgIH1cbiAgICBwYXJlbnQuJGNoaWxkcmVuLnB1c2godm0pO1xuICB9XG5cbiAgdm0uJHBhcmVudCA9IHBhcmVudDtcbiAgdm0uJHJvb3QgPSBwYXJlbnQgPyBwYXJlbnQuJHJvb3QgOiB2bTtcblxuICB2bS4kY2hpbGRyZW4gPSBbXTtcbiAgdm0uJHJlZnMgPSB7fTtcblxuICB2bS5fd2F0Y2hlciA9IG51bGw7XG4gIHZtLl9pbmFjdGl2ZSA9IG51bGw7XG4gIHZtLl9kaXJlY3RJbmFjdGl2ZSA9IGZhbHNlO1xuICB2bS5faXNNb3VudGVkID0gZmFsc2U7XG4gIHZtLl9pc0Rlc3Ryb3llZCA9IGZhbHNlO1xuICB2bS5faXNCZWluZ0Rlc3Ryb3llZCA9IGZhbHNlO1xufVxuXG5mdW5jdGlvbiBsaWZlY3ljbGVNaXhpbiAoVnVlKSB7XG4gIFZ1ZS5wcm90b3R5cGUuX3VwZGF0ZSA9IGZ1bmN0aW9uICh2bm9kZSwgaHlkcmF0aW5nKSB7XG4gICAgdmFyIHZtID0gdGhpcztcbiAgICBpZiAodm0uX2lzTW91bnRlZCkge1xuICAgICAgY2FsbEhvb2sodm0sICdiZWZvcmVVcGRhdGUnKTtcbiAgICB9XG4gICAgdmFyIHByZXZFbCA9IHZtLiRlbDtcbiAgICB2YXIgcHJldlZub2RlID0gdm0uX3Zub2RlO1xuICAgIHZhciBwcmV2QWN0aXZlSW5zdGFuY2UgPSBhY3RpdmVJbnN0YW5jZTtcbiAgICBhY3RpdmVJbnN0YW5jZSA9IHZtO1xuICAgIHZtLl92bm9kZSA9IHZub2RlO1xuICAgIC8vIFZ1ZS5wcm90b3R5cGUuX19wYXRjaF9fIGlzIGluamVjdGVkIGluIGVudHJ5IHBvaW50c1xuICAgIC8vIGJhc2VkIG9uIHRoZSByZW5kZXJpbmcgYmFja2VuZCB1c2VkLlxuICAgIGlmICghcHJldlZub2RlKSB7XG4gICAgICAvLyBpbml0aWFsIHJlbmRlclxuICAgICAgdm0uJGVsID0gdm0uX19wYXRjaF9fKFxuICAgICAgICB2bS4kZWwsIHZub2RlLCBoeWRyYXRpbmcsIGZhbHNlIC8qIHJlbW92ZU9ubHkgKi8sXG4gICAgICAgIHZtLiRvcHRpb25zLl9wYXJlbnRFbG0sXG4gICAgICAgIHZtLiRvcHRpb25zLl9yZWZFbG1cbiAgICAgICk7XG4gICAgICAvLyBubyBuZWVkIGZvciB0aGUgcmVmIG5vZGVzIGFmdGVyIGluaXRpYWwgcGF0Y2hcbiAgICAgIC8vIHRoaXMgcHJldmVudHMga2VlcGluZyBhIGRldGFjaGVkIERPTSB0cmVlIGluIG1lbW9yeSAoIzU4NTEpXG4gICAgICB2bS4kb3B0aW9ucy5fcGFyZW50RWxtID0gdm0uJG9wdGlvbnMuX3JlZkVsbSA9IG51bGw7XG4gICAgfSBlbHNlIHtcbiAgICAgIC8vIHVwZGF0ZXNcbiAgICAgIHZtLiRlbCA9IHZtLl9fcGF0Y2hfXyhwcmV2Vm5vZGUsIHZub2RlKTtcbiAgICB9XG4gICAgYWN0aXZlSW5zdGFuY2UgPSBwcmV2QWN0aXZlSW5zdGFuY2U7XG4gICAgLy8gdXBkYXRlIF9fdnVlX18gcmVmZXJlbmNlXG4gICAgaWYgKHByZXZFbCkge1xuICAgICAgcHJldkVsLl9fdnVlX18gPSBudWxsO1xuICAgIH1cbiAgICBpZiAodm0uJGVsKSB7XG4gICAgICB2bS4kZWwuX192dWVfXyA9IHZtO1xuICAgIH1cbiAgICAvLyBpZiBwYXJlbnQgaXMgYW4gSE9DLCB1cGRhdGUgaXRzICRlbCBhcyB3ZWxsXG4gICAgaWYgKHZtLiR2bm9kZSAmJiB2bS4kcGFyZW50ICYmIHZtLiR2bm9kZSA9PT0gdm0uJHBhcmVudC5fdm5vZGUpIHtcbiAgICAgIHZtLiRwYXJlbnQuJGVsID0gdm0uJGVsO1xuICAgIH1cbiAgICAvLyB1cGRhdGVkIGhvb2sgaXMgY2FsbGVkIGJ5IHRoZSBzY2hlZHVsZXIgdG8gZW5zdXJlIHRoYXQgY2hpbGRyZW4gYXJlXG4gICAgLy8gdXBkYXRlZCBpbiBhIHBhcmVudCdzIHVwZGF0ZWQgaG9vay5cbiAgfTtcblxuICBWdWUucHJvdG90eXBlLiRmb3JjZVVwZGF0ZSA9IGZ1bmN0aW9uICgpIHtcbiAgICB2YXIgdm0gPSB0aGlzO1xuICAgIGlmICh2bS5fd2F0Y2hlcikge1xuICAgICAgdm0uX3dhdGNoZXIudXBkYXRlKCk7XG4gICAgfVxuICB9O1xuXG4gIFZ1ZS5wcm90b3R5cGUuJGRlc3Ryb3kgPSBmdW5jdGlvbiAoKSB7XG4gICAgdmFyIHZtID0gdGhpcztcbiAgICBpZiAodm0uX2lzQmVpbmdEZXN0cm95ZWQpIHtcbiAgICAgIHJldHVyblxuICAgIH1cbiAgICBjYWxsSG9vayh2bSwgJ2JlZm9yZURlc3Ryb3knKTtcbiAgICB2bS5faXNCZWluZ0Rlc3Ryb3llZCA9IHRydWU7XG4gICAgLy8gcmVtb3ZlIHNlbGYgZnJvbSBwYXJlbnRcbiAgICB2YXIgcGFyZW50ID0gdm0uJHBhcmVudDtcbiAgICBpZiAocGFyZW50ICYmICFwYXJlbnQuX2lzQmVpbmdEZXN0cm95ZWQgJiYgIXZtLiRvcHRpb25zLmFic3RyYWN0KSB7XG4gICAgICByZW1vdmUocGFyZW50LiRjaGlsZHJlbiwgdm0pO1xuICAgIH1cbiAgICAvLyB0ZWFyZG93biB3YXRjaGVyc1xuICAgIGlmICh2bS5fd2F0Y2hlcikge1xuICAgICAgdm0uX3dhdGNoZXIudGVhcmRvd24oKTtcbiAgICB9XG4gICAgdmFyIGkgPSB2bS5fd2F0Y2hlcnMubGVuZ3RoO1xuICAgIHdoaWxlIChpLS0pIHtcbiAgICAgIHZtLl93YXRjaGVyc1tpXS50ZWFyZG93bigpO1xuICAgIH1cbiAgICAvLyByZW1vdmUgcmVmZXJlbmNlIGZyb20gZGF0YSBvYlxuICAgIC8vIGZyb3plbiBvYmplY3QgbWF5IG5vdCBoYXZlIG9ic2VydmVyLlxuICAgIGlmICh2bS5fZGF0YS5fX29iX18pIHtcbiAgICAgIHZtLl9kYXRhLl9fb2JfXy52bUNvdW50LS07XG4gICAgfVxuICAgIC8vIGNhbGwgdGhlIGxhc3QgaG9vay4uLlxuICAgIHZtLl9pc0Rlc3Ryb3llZCA9IHRydWU7XG4gICAgLy8gaW52b2tlIGRlc3Ryb3kgaG9va3Mgb24gY3VycmVudCByZW5kZXJlZCB0cmVlXG4gICAgdm0uX19wYXRjaF9fKHZtLl92bm9kZSwgbnVsbCk7XG4gICAgLy8gZmlyZSBkZXN0cm95ZWQgaG9va1xuICAgIGNhbGxIb29rKHZtLCAnZGVzdHJveWVkJyk7XG4gICAgLy8gdHVybiBvZmYgYWxsIGluc3RhbmNlIGxpc3RlbmVycy5cbiAgICB2bS4kb2ZmKCk7XG4gICAgLy8gcmVtb3ZlIF9fdnVlX18gcmVmZXJlbmNlXG4gICAgaWYgKHZtLiRlbCkge1xuICAgICAgdm0uJGVsLl9fdnVlX18gPSBudWxsO1xuICAgIH1cbiAgICAvLyByZWxlYXNlIGNpcmN1bGFyIHJlZmVyZW5jZSAoIzY3NTkpXG4gICAgaWYgKHZtLiR2bm9kZSkge1xuICAgICAgdm0uJHZub2RlLnBhcmVudCA9IG51bGw7XG4gICAgfVxuICB9O1xufVxuXG5mdW5jdGlvbiBtb3VudENvbXBvbmVudCAoXG4gIHZtLFxuICBlbCxcbiAgaHlkcmF0aW5nXG4pIHtcbiAgdm0uJGVsID0gZWw7XG4gIGlmICghdm0uJG9wdGlvbnMucmVuZGVyKSB7XG4gICAgdm0uJG9wdGlvbnMucmVuZGVyID0gY3JlYXRlRW1wdHlWTm9kZTtcbiAgICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgICAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gICAgICBpZiAoKHZtLiRvcHRpb25zLnRlbXBsYXRlICYmIHZtLiRvcHRpb25zLnRlbXBsYXRlLmNoYXJBdCgwKSAhPT0gJyMnKSB8fFxuICAgICAgICB2bS4kb3B0aW9ucy5lbCB8fCBlbCkge1xuICAgICAgICB3YXJuKFxuICAgICAgICAgICdZb3UgYXJlIHVzaW5nIHRoZSBydW50aW1lLW9ubHkgYnVpbGQgb2YgVnVlIHdoZXJlIHRoZSB0ZW1wbGF0ZSAnICtcbiAgICAgICAgICAnY29tcGlsZXIgaXMgbm90IGF2YWlsYWJsZS4gRWl0aGVyIHByZS1jb21waWxlIHRoZSB0ZW1wbGF0ZXMgaW50byAnICtcbiAgICAgICAgICAncmVuZGVyIGZ1bmN0aW9ucywgb3IgdXNlIHRoZSBjb21waWxlci1pbmNsdWRlZCBidWlsZC4nLFxuICAgICAgICAgIHZtXG4gICAgICAgICk7XG4gICAgICB9IGVsc2Uge1xuICAgICAgICB3YXJuKFxuICAgICAgICAgICdGYWlsZWQgdG8gbW91bnQgY29tcG9uZW50OiB0ZW1wbGF0ZSBvciByZW5kZXIgZnVuY3Rpb24gbm90IGRlZmluZWQuJyxcbiAgICAgICAgICB2bVxuICAgICAgICApO1xuICAgICAgfVxuICAgIH1cbiAgfVxuICBjYWxsSG9vayh2bSwgJ2JlZm9yZU1vdW50Jyk7XG5cbiAgdmFyIHVwZGF0ZUNvbXBvbmVudDtcbiAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nICYmIGNvbmZpZy5wZXJmb3JtYW5jZSAmJiBtYXJrKSB7XG4gICAgdXBkYXRlQ29tcG9uZW50ID0gZnVuY3Rpb24gKCkge1xuICAgICAgdmFyIG5hbWUgPSB2bS5fbmFtZTtcbiAgICAgIHZhciBpZCA9IHZtLl91aWQ7XG4gICAgICB2YXIgc3RhcnRUYWcgPSBcInZ1ZS1wZXJmLXN0YXJ0OlwiICsgaWQ7XG4gICAgICB2YXIgZW5kVGFnID0gXCJ2dWUtcGVyZi1lbmQ6XCIgKyBpZDtcblxuICAgICAgbWFyayhzdGFydFRhZyk7XG4gICAgICB2YXIgdm5vZGUgPSB2bS5fcmVuZGVyKCk7XG4gICAgICBtYXJrKGVuZFRhZyk7XG4gICAgICBtZWFzdXJlKChcInZ1ZSBcIiArIG5hbWUgKyBcIiByZW5kZXJcIiksIHN0YXJ0VGFnLCBlbmRUYWcpO1xuXG4gICAgICBtYXJrKHN0YXJ0VGFnKTtcbiAgICAgIHZtLl91cGRhdGUodm5vZGUsIGh5ZHJhdGluZyk7XG4gICAgICBtYXJrKGVuZFRhZyk7XG4gICAgICBtZWFzdXJlKChcInZ1ZSBcIiArIG5hbWUgKyBcIiBwYXRjaFwiKSwgc3RhcnRUYWcsIGVuZFRhZyk7XG4gICAgfTtcbiAgfSBlbHNlIHtcbiAgICB1cGRhdGVDb21wb25lbnQgPSBmdW5jdGlvbiAoKSB7XG4gICAgICB2bS5fdXBkYXRlKHZtLl9yZW5kZXIoKSwgaHlkcmF0aW5nKTtcbiAgICB9O1xuICB9XG5cbiAgLy8gd2Ugc2V0IHRoaXMgdG8gdm0uX3dhdGNoZXIgaW5zaWRlIHRoZSB3YXRjaGVyJ3MgY29uc3RydWN0b3JcbiAgLy8gc2luY2UgdGhlIHdhdGNoZXIncyBpbml0aWFsIHBhdGNoIG1heSBjYWxsICRmb3JjZVVwZGF0ZSAoZS5nLiBpbnNpZGUgY2hpbGRcbiAgLy8gY29tcG9uZW50J3MgbW91bnRlZCBob29rKSwgd2hpY2ggcmVsaWVzIG9uIHZtLl93YXRjaGVyIGJlaW5nIGFscmVhZHkgZGVmaW5lZFxuICBuZXcgV2F0Y2hlcih2bSwgdXBkYXRlQ29tcG9uZW50LCBub29wLCBudWxsLCB0cnVlIC8qIGlzUmVuZGVyV2F0Y2hlciAqLyk7XG4gIGh5ZHJhdGluZyA9IGZhbHNlO1xuXG4gIC8vIG1hbnVhbGx5IG1vdW50ZWQgaW5zdGFuY2UsIGNhbGwgbW91bnRlZCBvbiBzZWxmXG4gIC8vIG1vdW50ZWQgaXMgY2FsbGVkIGZvciByZW5kZXItY3JlYXRlZCBjaGlsZCBjb21wb25lbnRzIGluIGl0cyBpbnNlcnRlZCBob29rXG4gIGlmICh2bS4kdm5vZGUgPT0gbnVsbCkge1xuICAgIHZtLl9pc01vdW50ZWQgPSB0cnVlO1xuICAgIGNhbGxIb29rKHZtLCAnbW91bnRlZCcpO1xuICB9XG4gIHJldHVybiB2bVxufVxuXG5mdW5jdGlvbiB1cGRhdGVDaGlsZENvbXBvbmVudCAoXG4gIHZtLFxuICBwcm9wc0RhdGEsXG4gIGxpc3RlbmVycyxcbiAgcGFyZW50Vm5vZGUsXG4gIHJlbmRlckNoaWxkcmVuXG4pIHtcbiAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicpIHtcbiAgICBpc1VwZGF0aW5nQ2hpbGRDb21wb25lbnQgPSB0cnVlO1xuICB9XG5cbiAgLy8gZGV0ZXJtaW5lIHdoZXRoZXIgY29tcG9uZW50IGhhcyBzbG90IGNoaWxkcmVuXG4gIC8vIHdlIG5lZWQgdG8gZG8gdGhpcyBiZWZvcmUgb3ZlcndyaXRpbmcgJG9wdGlvbnMuX3JlbmRlckNoaWxkcmVuXG4gIHZhciBoYXNDaGlsZHJlbiA9ICEhKFxuICAgIHJlbmRlckNoaWxkcmVuIHx8ICAgICAgICAgICAgICAgLy8gaGFzIG5ldyBzdGF0aWMgc2xvdHNcbiAgICB2bS4kb3B0aW9ucy5fcmVuZGVyQ2hpbGRyZW4gfHwgIC8vIGhhcyBvbGQgc3RhdGljIHNsb3RzXG4gICAgcGFyZW50Vm5vZGUuZGF0YS5zY29wZWRTbG90cyB8fCAvLyBoYXMgbmV3IHNjb3BlZCBzbG90c1xuICAgIHZtLiRzY29wZWRTbG90cyAhPT0gZW1wdHlPYmplY3QgLy8gaGFzIG9sZCBzY29wZWQgc2xvdHNcbiAgKTtcblxuICB2bS4kb3B0aW9ucy5fcGFyZW50Vm5vZGUgPSBwYXJlbnRWbm9kZTtcbiAgdm0uJHZub2RlID0gcGFyZW50Vm5vZGU7IC8vIHVwZGF0ZSB2bSdzIHBsYWNlaG9sZGVyIG5vZGUgd2l0aG91dCByZS1yZW5kZXJcblxuICBpZiAodm0uX3Zub2RlKSB7IC8vIHVwZGF0ZSBjaGlsZCB0cmVlJ3MgcGFyZW50XG4gICAgdm0uX3Zub2RlLnBhcmVudCA9IHBhcmVudFZub2RlO1xuICB9XG4gIHZtLiRvcHRpb25zLl9yZW5kZXJDaGlsZHJlbiA9IHJlbmRlckNoaWxkcmVuO1xuXG4gIC8vIHVwZGF0ZSAkYXR0cnMgYW5kICRsaXN0ZW5lcnMgaGFzaFxuICAvLyB0aGVzZSBhcmUgYWxzbyByZWFjdGl2ZSBzbyB0aGV5IG1heSB0cmlnZ2VyIGNoaWxkIHVwZGF0ZSBpZiB0aGUgY2hpbGRcbiAgLy8gdXNlZCB0aGVtIGR1cmluZyByZW5kZXJcbiAgdm0uJGF0dHJzID0gcGFyZW50Vm5vZGUuZGF0YS5hdHRycyB8fCBlbXB0eU9iamVjdDtcbiAgdm0uJGxpc3RlbmVycyA9IGxpc3RlbmVycyB8fCBlbXB0eU9iamVjdDtcblxuICAvLyB1cGRhdGUgcHJvcHNcbiAgaWYgKHByb3BzRGF0YSAmJiB2bS4kb3B0aW9ucy5wcm9wcykge1xuICAgIHRvZ2dsZU9ic2VydmluZyhmYWxzZSk7XG4gICAgdmFyIHByb3BzID0gdm0uX3Byb3BzO1xuICAgIHZhciBwcm9wS2V5cyA9IHZtLiRvcHRpb25zLl9wcm9wS2V5cyB8fCBbXTtcbiAgICBmb3IgKHZhciBpID0gMDsgaSA8IHByb3BLZXlzLmxlbmd0aDsgaSsrKSB7XG4gICAgICB2YXIga2V5ID0gcHJvcEtleXNbaV07XG4gICAgICB2YXIgcHJvcE9wdGlvbnMgPSB2bS4kb3B0aW9ucy5wcm9wczsgLy8gd3RmIGZsb3c/XG4gICAgICBwcm9wc1trZXldID0gdmFsaWRhdGVQcm9wKGtleSwgcHJvcE9wdGlvbnMsIHByb3BzRGF0YSwgdm0pO1xuICAgIH1cbiAgICB0b2dnbGVPYnNlcnZpbmcodHJ1ZSk7XG4gICAgLy8ga2VlcCBhIGNvcHkgb2YgcmF3IHByb3BzRGF0YVxuICAgIHZtLiRvcHRpb25zLnByb3BzRGF0YSA9IHByb3BzRGF0YTtcbiAgfVxuXG4gIC8vIHVwZGF0ZSBsaXN0ZW5lcnNcbiAgbGlzdGVuZXJzID0gbGlzdGVuZXJzIHx8IGVtcHR5T2JqZWN0O1xuICB2YXIgb2xkTGlzdGVuZXJzID0gdm0uJG9wdGlvbnMuX3BhcmVudExpc3RlbmVycztcbiAgdm0uJG9wdGlvbnMuX3BhcmVudExpc3RlbmVycyA9IGxpc3RlbmVycztcbiAgdXBkYXRlQ29tcG9uZW50TGlzdGVuZXJzKHZtLCBsaXN0ZW5lcnMsIG9sZExpc3RlbmVycyk7XG5cbiAgLy8gcmVzb2x2ZSBzbG90cyArIGZvcmNlIHVwZGF0ZSBpZiBoYXMgY2hpbGRyZW5cbiAgaWYgKGhhc0NoaWxkcmVuKSB7XG4gICAgdm0uJHNsb3RzID0gcmVzb2x2ZVNsb3RzKHJlbmRlckNoaWxkcmVuLCBwYXJlbnRWbm9kZS5jb250ZXh0KTtcbiAgICB2bS4kZm9yY2VVcGRhdGUoKTtcbiAgfVxuXG4gIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nKSB7XG4gICAgaXNVcGRhdGluZ0NoaWxkQ29tcG9uZW50ID0gZmFsc2U7XG4gIH1cbn1cblxuZnVuY3Rpb24gaXNJbkluYWN0aXZlVHJlZSAodm0pIHtcbiAgd2hpbGUgKHZtICYmICh2bSA9IHZtLiRwYXJlbnQpKSB7XG4gICAgaWYgKHZtLl9pbmFjdGl2ZSkgeyByZXR1cm4gdHJ1ZSB9XG4gIH1cbiAgcmV0dXJuIGZhbHNlXG59XG5cbmZ1bmN0aW9uIGFjdGl2YXRlQ2hpbGRDb21wb25lbnQgKHZtLCBkaXJlY3QpIHtcbiAgaWYgKGRpcmVjdCkge1xuICAgIHZtLl9kaXJlY3RJbmFjdGl2ZSA9IGZhbHNlO1xuICAgIGlmIChpc0luSW5hY3RpdmVUcmVlKHZtKSkge1xuICAgICAgcmV0dXJuXG4gICAgfVxuICB9IGVsc2UgaWYgKHZtLl9kaXJlY3RJbmFjdGl2ZSkge1xuICAgIHJldHVyblxuICB9XG4gIGlmICh2bS5faW5hY3RpdmUgfHwgdm0uX2luYWN0aXZlID09PSBudWxsKSB7XG4gICAgdm0uX2luYWN0aXZlID0gZmFsc2U7XG4gICAgZm9yICh2YXIgaSA9IDA7IGkgPCB2bS4kY2hpbGRyZW4ubGVuZ3RoOyBpKyspIHtcbiAgICAgIGFjdGl2YXRlQ2hpbGRDb21wb25lbnQodm0uJGNoaWxkcmVuW2ldKTtcbiAgICB9XG4gICAgY2FsbEhvb2sodm0sICdhY3RpdmF0ZWQnKTtcbiAgfVxufVxuXG5mdW5jdGlvbiBkZWFjdGl2YXRlQ2hpbGRDb21wb25lbnQgKHZtLCBkaXJlY3QpIHtcbiAgaWYgKGRpcmVjdCkge1xuICAgIHZtLl9kaXJlY3RJbmFjdGl2ZSA9IHRydWU7XG4gICAgaWYgKGlzSW5JbmFjdGl2ZVRyZWUodm0pKSB7XG4gICAgICByZXR1cm5cbiAgICB9XG4gIH1cbiAgaWYgKCF2bS5faW5hY3RpdmUpIHtcbiAgICB2bS5faW5hY3RpdmUgPSB0cnVlO1xuICAgIGZvciAodmFyIGkgPSAwOyBpIDwgdm0uJGNoaWxkcmVuLmxlbmd0aDsgaSsrKSB7XG4gICAgICBkZWFjdGl2YXRlQ2hpbGRDb21wb25lbnQodm0uJGNoaWxkcmVuW2ldKTtcbiAgICB9XG4gICAgY2FsbEhvb2sodm0sICdkZWFjdGl2YXRlZCcpO1xuICB9XG59XG5cbmZ1bmN0aW9uIGNhbGxIb29rICh2bSwgaG9vaykge1xuICAvLyAjNzU3MyBkaXNhYmxlIGRlcCBjb2xsZWN0aW9uIHdoZW4gaW52b2tpbmcgbGlmZWN5Y2xlIGhvb2tzXG4gIHB1c2hUYXJnZXQoKTtcbiAgdmFyIGhhbmRsZXJzID0gdm0uJG9wdGlvbnNbaG9va107XG4gIGlmIChoYW5kbGVycykge1xuICAgIGZvciAodmFyIGkgPSAwLCBqID0gaGFuZGxlcnMubGVuZ3RoOyBpIDwgajsgaSsrKSB7XG4gICAgICB0cnkge1xuICAgICAgICBoYW5kbGVyc1tpXS5jYWxsKHZtKTtcbiAgICAgIH0gY2F0Y2ggKGUpIHtcbiAgICAgICAgaGFuZGxlRXJyb3IoZSwgdm0sIChob29rICsgXCIgaG9va1wiKSk7XG4gICAgICB9XG4gICAgfVxuICB9XG4gIGlmICh2bS5faGFzSG9va0V2ZW50KSB7XG4gICAgdm0uJGVtaXQoJ2hvb2s6JyArIGhvb2spO1xuICB9XG4gIHBvcFRhcmdldCgpO1xufVxuXG4vKiAgKi9cblxuXG52YXIgTUFYX1VQREFURV9DT1VOVCA9IDEwMDtcblxudmFyIHF1ZXVlID0gW107XG52YXIgYWN0aXZhdGVkQ2hpbGRyZW4gPSBbXTtcbnZhciBoYXMgPSB7fTtcbnZhciBjaXJjdWxhciA9IHt9O1xudmFyIHdhaXRpbmcgPSBmYWxzZTtcbnZhciBmbHVzaGluZyA9IGZhbHNlO1xudmFyIGluZGV4ID0gMDtcblxuLyoqXG4gKiBSZXNldCB0aGUgc2NoZWR1bGVyJ3Mgc3RhdGUuXG4gKi9cbmZ1bmN0aW9uIHJlc2V0U2NoZWR1bGVyU3RhdGUgKCkge1xuICBpbmRleCA9IHF1ZXVlLmxlbmd0aCA9IGFjdGl2YXRlZENoaWxkcmVuLmxlbmd0aCA9IDA7XG4gIGhhcyA9IHt9O1xuICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgIGNpcmN1bGFyID0ge307XG4gIH1cbiAgd2FpdGluZyA9IGZsdXNoaW5nID0gZmFsc2U7XG59XG5cbi8qKlxuICogRmx1c2ggYm90aCBxdWV1ZXMgYW5kIHJ1biB0aGUgd2F0Y2hlcnMuXG4gKi9cbmZ1bmN0aW9uIGZsdXNoU2NoZWR1bGVyUXVldWUgKCkge1xuICBmbHVzaGluZyA9IHRydWU7XG4gIHZhciB3YXRjaGVyLCBpZDtcblxuICAvLyBTb3J0IHF1ZXVlIGJlZm9yZSBmbHVzaC5cbiAgLy8gVGhpcyBlbnN1cmVzIHRoYXQ6XG4gIC8vIDEuIENvbXBvbmVudHMgYXJlIHVwZGF0ZWQgZnJvbSBwYXJlbnQgdG8gY2hpbGQuIChiZWNhdXNlIHBhcmVudCBpcyBhbHdheXNcbiAgLy8gICAgY3JlYXRlZCBiZWZvcmUgdGhlIGNoaWxkKVxuICAvLyAyLiBBIGNvbXBvbmVudCdzIHVzZXIgd2F0Y2hlcnMgYXJlIHJ1biBiZWZvcmUgaXRzIHJlbmRlciB3YXRjaGVyIChiZWNhdXNlXG4gIC8vICAgIHVzZXIgd2F0Y2hlcnMgYXJlIGNyZWF0ZWQgYmVmb3JlIHRoZSByZW5kZXIgd2F0Y2hlcilcbiAgLy8gMy4gSWYgYSBjb21wb25lbnQgaXMgZGVzdHJveWVkIGR1cmluZyBhIHBhcmVudCBjb21wb25lbnQncyB3YXRjaGVyIHJ1bixcbiAgLy8gICAgaXRzIHdhdGNoZXJzIGNhbiBiZSBza2lwcGVkLlxuICBxdWV1ZS5zb3J0KGZ1bmN0aW9uIChhLCBiKSB7IHJldHVybiBhLmlkIC0gYi5pZDsgfSk7XG5cbiAgLy8gZG8gbm90IGNhY2hlIGxlbmd0aCBiZWNhdXNlIG1vcmUgd2F0Y2hlcnMgbWlnaHQgYmUgcHVzaGVkXG4gIC8vIGFzIHdlIHJ1biBleGlzdGluZyB3YXRjaGVyc1xuICBmb3IgKGluZGV4ID0gMDsgaW5kZXggPCBxdWV1ZS5sZW5ndGg7IGluZGV4KyspIHtcbiAgICB3YXRjaGVyID0gcXVldWVbaW5kZXhdO1xuICAgIGlkID0gd2F0Y2hlci5pZDtcbiAgICBoYXNbaWRdID0gbnVsbDtcbiAgICB3YXRjaGVyLnJ1bigpO1xuICAgIC8vIGluIGRldiBidWlsZCwgY2hlY2sgYW5kIHN0b3AgY2lyY3VsYXIgdXBkYXRlcy5cbiAgICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJiBoYXNbaWRdICE9IG51bGwpIHtcbiAgICAgIGNpcmN1bGFyW2lkXSA9IChjaXJjdWxhcltpZF0gfHwgMCkgKyAxO1xuICAgICAgaWYgKGNpcmN1bGFyW2lkXSA+IE1BWF9VUERBVEVfQ09VTlQpIHtcbiAgICAgICAgd2FybihcbiAgICAgICAgICAnWW91IG1heSBoYXZlIGFuIGluZmluaXRlIHVwZGF0ZSBsb29wICcgKyAoXG4gICAgICAgICAgICB3YXRjaGVyLnVzZXJcbiAgICAgICAgICAgICAgPyAoXCJpbiB3YXRjaGVyIHdpdGggZXhwcmVzc2lvbiBcXFwiXCIgKyAod2F0Y2hlci5leHByZXNzaW9uKSArIFwiXFxcIlwiKVxuICAgICAgICAgICAgICA6IFwiaW4gYSBjb21wb25lbnQgcmVuZGVyIGZ1bmN0aW9uLlwiXG4gICAgICAgICAgKSxcbiAgICAgICAgICB3YXRjaGVyLnZtXG4gICAgICAgICk7XG4gICAgICAgIGJyZWFrXG4gICAgICB9XG4gICAgfVxuICB9XG5cbiAgLy8ga2VlcCBjb3BpZXMgb2YgcG9zdCBxdWV1ZXMgYmVmb3JlIHJlc2V0dGluZyBzdGF0ZVxuICB2YXIgYWN0aXZhdGVkUXVldWUgPSBhY3RpdmF0ZWRDaGlsZHJlbi5zbGljZSgpO1xuICB2YXIgdXBkYXRlZFF1ZXVlID0gcXVldWUuc2xpY2UoKTtcblxuICByZXNldFNjaGVkdWxlclN0YXRlKCk7XG5cbiAgLy8gY2FsbCBjb21wb25lbnQgdXBkYXRlZCBhbmQgYWN0aXZhdGVkIGhvb2tzXG4gIGNhbGxBY3RpdmF0ZWRIb29rcyhhY3RpdmF0ZWRRdWV1ZSk7XG4gIGNhbGxVcGRhdGVkSG9va3ModXBkYXRlZFF1ZXVlKTtcblxuICAvLyBkZXZ0b29sIGhvb2tcbiAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gIGlmIChkZXZ0b29scyAmJiBjb25maWcuZGV2dG9vbHMpIHtcbiAgICBkZXZ0b29scy5lbWl0KCdmbHVzaCcpO1xuICB9XG59XG5cbmZ1bmN0aW9uIGNhbGxVcGRhdGVkSG9va3MgKHF1ZXVlKSB7XG4gIHZhciBpID0gcXVldWUubGVuZ3RoO1xuICB3aGlsZSAoaS0tKSB7XG4gICAgdmFyIHdhdGNoZXIgPSBxdWV1ZVtpXTtcbiAgICB2YXIgdm0gPSB3YXRjaGVyLnZtO1xuICAgIGlmICh2bS5fd2F0Y2hlciA9PT0gd2F0Y2hlciAmJiB2bS5faXNNb3VudGVkKSB7XG4gICAgICBjYWxsSG9vayh2bSwgJ3VwZGF0ZWQnKTtcbiAgICB9XG4gIH1cbn1cblxuLyoqXG4gKiBRdWV1ZSBhIGtlcHQtYWxpdmUgY29tcG9uZW50IHRoYXQgd2FzIGFjdGl2YXRlZCBkdXJpbmcgcGF0Y2guXG4gKiBUaGUgcXVldWUgd2lsbCBiZSBwcm9jZXNzZWQgYWZ0ZXIgdGhlIGVudGlyZSB0cmVlIGhhcyBiZWVuIHBhdGNoZWQuXG4gKi9cbmZ1bmN0aW9uIHF1ZXVlQWN0aXZhdGVkQ29tcG9uZW50ICh2bSkge1xuICAvLyBzZXR0aW5nIF9pbmFjdGl2ZSB0byBmYWxzZSBoZXJlIHNvIHRoYXQgYSByZW5kZXIgZnVuY3Rpb24gY2FuXG4gIC8vIHJlbHkgb24gY2hlY2tpbmcgd2hldGhlciBpdCdzIGluIGFuIGluYWN0aXZlIHRyZWUgKGUuZy4gcm91dGVyLXZpZXcpXG4gIHZtLl9pbmFjdGl2ZSA9IGZhbHNlO1xuICBhY3RpdmF0ZWRDaGlsZHJlbi5wdXNoKHZtKTtcbn1cblxuZnVuY3Rpb24gY2FsbEFjdGl2YXRlZEhvb2tzIChxdWV1ZSkge1xuICBmb3IgKHZhciBpID0gMDsgaSA8IHF1ZXVlLmxlbmd0aDsgaSsrKSB7XG4gICAgcXVldWVbaV0uX2luYWN0aXZlID0gdHJ1ZTtcbiAgICBhY3RpdmF0ZUNoaWxkQ29tcG9uZW50KHF1ZXVlW2ldLCB0cnVlIC8qIHRydWUgKi8pO1xuICB9XG59XG5cbi8qKlxuICogUHVzaCBhIHdhdGNoZXIgaW50byB0aGUgd2F0Y2hlciBxdWV1ZS5cbiAqIEpvYnMgd2l0aCBkdXBsaWNhdGUgSURzIHdpbGwgYmUgc2tpcHBlZCB1bmxlc3MgaXQnc1xuICogcHVzaGVkIHdoZW4gdGhlIHF1ZXVlIGlzIGJlaW5nIGZsdXNoZWQuXG4gKi9cbmZ1bmN0aW9uIHF1ZXVlV2F0Y2hlciAod2F0Y2hlcikge1xuICB2YXIgaWQgPSB3YXRjaGVyLmlkO1xuICBpZiAoaGFzW2lkXSA9PSBudWxsKSB7XG4gICAgaGFzW2lkXSA9IHRydWU7XG4gICAgaWYgKCFmbHVzaGluZykge1xuICAgICAgcXVldWUucHVzaCh3YXRjaGVyKTtcbiAgICB9IGVsc2Uge1xuICAgICAgLy8gaWYgYWxyZWFkeSBmbHVzaGluZywgc3BsaWNlIHRoZSB3YXRjaGVyIGJhc2VkIG9uIGl0cyBpZFxuICAgICAgLy8gaWYgYWxyZWFkeSBwYXN0IGl0cyBpZCwgaXQgd2lsbCBiZSBydW4gbmV4dCBpbW1lZGlhdGVseS5cbiAgICAgIHZhciBpID0gcXVldWUubGVuZ3RoIC0gMTtcbiAgICAgIHdoaWxlIChpID4gaW5kZXggJiYgcXVldWVbaV0uaWQgPiB3YXRjaGVyLmlkKSB7XG4gICAgICAgIGktLTtcbiAgICAgIH1cbiAgICAgIHF1ZXVlLnNwbGljZShpICsgMSwgMCwgd2F0Y2hlcik7XG4gICAgfVxuICAgIC8vIHF1ZXVlIHRoZSBmbHVzaFxuICAgIGlmICghd2FpdGluZykge1xuICAgICAgd2FpdGluZyA9IHRydWU7XG4gICAgICBuZXh0VGljayhmbHVzaFNjaGVkdWxlclF1ZXVlKTtcbiAgICB9XG4gIH1cbn1cblxuLyogICovXG5cbnZhciB1aWQkMSA9IDA7XG5cbi8qKlxuICogQSB3YXRjaGVyIHBhcnNlcyBhbiBleHByZXNzaW9uLCBjb2xsZWN0cyBkZXBlbmRlbmNpZXMsXG4gKiBhbmQgZmlyZXMgY2FsbGJhY2sgd2hlbiB0aGUgZXhwcmVzc2lvbiB2YWx1ZSBjaGFuZ2VzLlxuICogVGhpcyBpcyB1c2VkIGZvciBib3RoIHRoZSAkd2F0Y2goKSBhcGkgYW5kIGRpcmVjdGl2ZXMuXG4gKi9cbnZhciBXYXRjaGVyID0gZnVuY3Rpb24gV2F0Y2hlciAoXG4gIHZtLFxuICBleHBPckZuLFxuICBjYixcbiAgb3B0aW9ucyxcbiAgaXNSZW5kZXJXYXRjaGVyXG4pIHtcbiAgdGhpcy52bSA9IHZtO1xuICBpZiAoaXNSZW5kZXJXYXRjaGVyKSB7XG4gICAgdm0uX3dhdGNoZXIgPSB0aGlzO1xuICB9XG4gIHZtLl93YXRjaGVycy5wdXNoKHRoaXMpO1xuICAvLyBvcHRpb25zXG4gIGlmIChvcHRpb25zKSB7XG4gICAgdGhpcy5kZWVwID0gISFvcHRpb25zLmRlZXA7XG4gICAgdGhpcy51c2VyID0gISFvcHRpb25zLnVzZXI7XG4gICAgdGhpcy5sYXp5ID0gISFvcHRpb25zLmxhenk7XG4gICAgdGhpcy5zeW5jID0gISFvcHRpb25zLnN5bmM7XG4gIH0gZWxzZSB7XG4gICAgdGhpcy5kZWVwID0gdGhpcy51c2VyID0gdGhpcy5sYXp5ID0gdGhpcy5zeW5jID0gZmFsc2U7XG4gIH1cbiAgdGhpcy5jYiA9IGNiO1xuICB0aGlzLmlkID0gKyt1aWQkMTsgLy8gdWlkIGZvciBiYXRjaGluZ1xuICB0aGlzLmFjdGl2ZSA9IHRydWU7XG4gIHRoaXMuZGlydHkgPSB0aGlzLmxhenk7IC8vIGZvciBsYXp5IHdhdGNoZXJzXG4gIHRoaXMuZGVwcyA9IFtdO1xuICB0aGlzLm5ld0RlcHMgPSBbXTtcbiAgdGhpcy5kZXBJZHMgPSBuZXcgX1NldCgpO1xuICB0aGlzLm5ld0RlcElkcyA9IG5ldyBfU2V0KCk7XG4gIHRoaXMuZXhwcmVzc2lvbiA9IHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbidcbiAgICA/IGV4cE9yRm4udG9TdHJpbmcoKVxuICAgIDogJyc7XG4gIC8vIHBhcnNlIGV4cHJlc3Npb24gZm9yIGdldHRlclxuICBpZiAodHlwZW9mIGV4cE9yRm4gPT09ICdmdW5jdGlvbicpIHtcbiAgICB0aGlzLmdldHRlciA9IGV4cE9yRm47XG4gIH0gZWxzZSB7XG4gICAgdGhpcy5nZXR0ZXIgPSBwYXJzZVBhdGgoZXhwT3JGbik7XG4gICAgaWYgKCF0aGlzLmdldHRlcikge1xuICAgICAgdGhpcy5nZXR0ZXIgPSBmdW5jdGlvbiAoKSB7fTtcbiAgICAgIHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiYgd2FybihcbiAgICAgICAgXCJGYWlsZWQgd2F0Y2hpbmcgcGF0aDogXFxcIlwiICsgZXhwT3JGbiArIFwiXFxcIiBcIiArXG4gICAgICAgICdXYXRjaGVyIG9ubHkgYWNjZXB0cyBzaW1wbGUgZG90LWRlbGltaXRlZCBwYXRocy4gJyArXG4gICAgICAgICdGb3IgZnVsbCBjb250cm9sLCB1c2UgYSBmdW5jdGlvbiBpbnN0ZWFkLicsXG4gICAgICAgIHZtXG4gICAgICApO1xuICAgIH1cbiAgfVxuICB0aGlzLnZhbHVlID0gdGhpcy5sYXp5XG4gICAgPyB1bmRlZmluZWRcbiAgICA6IHRoaXMuZ2V0KCk7XG59O1xuXG4vKipcbiAqIEV2YWx1YXRlIHRoZSBnZXR0ZXIsIGFuZCByZS1jb2xsZWN0IGRlcGVuZGVuY2llcy5cbiAqL1xuV2F0Y2hlci5wcm90b3R5cGUuZ2V0ID0gZnVuY3Rpb24gZ2V0ICgpIHtcbiAgcHVzaFRhcmdldCh0aGlzKTtcbiAgdmFyIHZhbHVlO1xuICB2YXIgdm0gPSB0aGlzLnZtO1xuICB0cnkge1xuICAgIHZhbHVlID0gdGhpcy5nZXR0ZXIuY2FsbCh2bSwgdm0pO1xuICB9IGNhdGNoIChlKSB7XG4gICAgaWYgKHRoaXMudXNlcikge1xuICAgICAgaGFuZGxlRXJyb3IoZSwgdm0sIChcImdldHRlciBmb3Igd2F0Y2hlciBcXFwiXCIgKyAodGhpcy5leHByZXNzaW9uKSArIFwiXFxcIlwiKSk7XG4gICAgfSBlbHNlIHtcbiAgICAgIHRocm93IGVcbiAgICB9XG4gIH0gZmluYWxseSB7XG4gICAgLy8gXCJ0b3VjaFwiIGV2ZXJ5IHByb3BlcnR5IHNvIHRoZXkgYXJlIGFsbCB0cmFja2VkIGFzXG4gICAgLy8gZGVwZW5kZW5jaWVzIGZvciBkZWVwIHdhdGNoaW5nXG4gICAgaWYgKHRoaXMuZGVlcCkge1xuICAgICAgdHJhdmVyc2UodmFsdWUpO1xuICAgIH1cbiAgICBwb3BUYXJnZXQoKTtcbiAgICB0aGlzLmNsZWFudXBEZXBzKCk7XG4gIH1cbiAgcmV0dXJuIHZhbHVlXG59O1xuXG4vKipcbiAqIEFkZCBhIGRlcGVuZGVuY3kgdG8gdGhpcyBkaXJlY3RpdmUuXG4gKi9cbldhdGNoZXIucHJvdG90eXBlLmFkZERlcCA9IGZ1bmN0aW9uIGFkZERlcCAoZGVwKSB7XG4gIHZhciBpZCA9IGRlcC5pZDtcbiAgaWYgKCF0aGlzLm5ld0RlcElkcy5oYXMoaWQpKSB7XG4gICAgdGhpcy5uZXdEZXBJZHMuYWRkKGlkKTtcbiAgICB0aGlzLm5ld0RlcHMucHVzaChkZXApO1xuICAgIGlmICghdGhpcy5kZXBJZHMuaGFzKGlkKSkge1xuICAgICAgZGVwLmFkZFN1Yih0aGlzKTtcbiAgICB9XG4gIH1cbn07XG5cbi8qKlxuICogQ2xlYW4gdXAgZm9yIGRlcGVuZGVuY3kgY29sbGVjdGlvbi5cbiAqL1xuV2F0Y2hlci5wcm90b3R5cGUuY2xlYW51cERlcHMgPSBmdW5jdGlvbiBjbGVhbnVwRGVwcyAoKSB7XG4gICAgdmFyIHRoaXMkMSA9IHRoaXM7XG5cbiAgdmFyIGkgPSB0aGlzLmRlcHMubGVuZ3RoO1xuICB3aGlsZSAoaS0tKSB7XG4gICAgdmFyIGRlcCA9IHRoaXMkMS5kZXBzW2ldO1xuICAgIGlmICghdGhpcyQxLm5ld0RlcElkcy5oYXMoZGVwLmlkKSkge1xuICAgICAgZGVwLnJlbW92ZVN1Yih0aGlzJDEpO1xuICAgIH1cbiAgfVxuICB2YXIgdG1wID0gdGhpcy5kZXBJZHM7XG4gIHRoaXMuZGVwSWRzID0gdGhpcy5uZXdEZXBJZHM7XG4gIHRoaXMubmV3RGVwSWRzID0gdG1wO1xuICB0aGlzLm5ld0RlcElkcy5jbGVhcigpO1xuICB0bXAgPSB0aGlzLmRlcHM7XG4gIHRoaXMuZGVwcyA9IHRoaXMubmV3RGVwcztcbiAgdGhpcy5uZXdEZXBzID0gdG1wO1xuICB0aGlzLm5ld0RlcHMubGVuZ3RoID0gMDtcbn07XG5cbi8qKlxuICogU3Vic2NyaWJlciBpbnRlcmZhY2UuXG4gKiBXaWxsIGJlIGNhbGxlZCB3aGVuIGEgZGVwZW5kZW5jeSBjaGFuZ2VzLlxuICovXG5XYXRjaGVyLnByb3RvdHlwZS51cGRhdGUgPSBmdW5jdGlvbiB1cGRhdGUgKCkge1xuICAvKiBpc3RhbmJ1bCBpZ25vcmUgZWxzZSAqL1xuICBpZiAodGhpcy5sYXp5KSB7XG4gICAgdGhpcy5kaXJ0eSA9IHRydWU7XG4gIH0gZWxzZSBpZiAodGhpcy5zeW5jKSB7XG4gICAgdGhpcy5ydW4oKTtcbiAgfSBlbHNlIHtcbiAgICBxdWV1ZVdhdGNoZXIodGhpcyk7XG4gIH1cbn07XG5cbi8qKlxuICogU2NoZWR1bGVyIGpvYiBpbnRlcmZhY2UuXG4gKiBXaWxsIGJlIGNhbGxlZCBieSB0aGUgc2NoZWR1bGVyLlxuICovXG5XYXRjaGVyLnByb3RvdHlwZS5ydW4gPSBmdW5jdGlvbiBydW4gKCkge1xuICBpZiAodGhpcy5hY3RpdmUpIHtcbiAgICB2YXIgdmFsdWUgPSB0aGlzLmdldCgpO1xuICAgIGlmIChcbiAgICAgIHZhbHVlICE9PSB0aGlzLnZhbHVlIHx8XG4gICAgICAvLyBEZWVwIHdhdGNoZXJzIGFuZCB3YXRjaGVycyBvbiBPYmplY3QvQXJyYXlzIHNob3VsZCBmaXJlIGV2ZW5cbiAgICAgIC8vIHdoZW4gdGhlIHZhbHVlIGlzIHRoZSBzYW1lLCBiZWNhdXNlIHRoZSB2YWx1ZSBtYXlcbiAgICAgIC8vIGhhdmUgbXV0YXRlZC5cbiAgICAgIGlzT2JqZWN0KHZhbHVlKSB8fFxuICAgICAgdGhpcy5kZWVwXG4gICAgKSB7XG4gICAgICAvLyBzZXQgbmV3IHZhbHVlXG4gICAgICB2YXIgb2xkVmFsdWUgPSB0aGlzLnZhbHVlO1xuICAgICAgdGhpcy52YWx1ZSA9IHZhbHVlO1xuICAgICAgaWYgKHRoaXMudXNlcikge1xuICAgICAgICB0cnkge1xuICAgICAgICAgIHRoaXMuY2IuY2FsbCh0aGlzLnZtLCB2YWx1ZSwgb2xkVmFsdWUpO1xuICAgICAgICB9IGNhdGNoIChlKSB7XG4gICAgICAgICAgaGFuZGxlRXJyb3IoZSwgdGhpcy52bSwgKFwiY2FsbGJhY2sgZm9yIHdhdGNoZXIgXFxcIlwiICsgKHRoaXMuZXhwcmVzc2lvbikgKyBcIlxcXCJcIikpO1xuICAgICAgICB9XG4gICAgICB9IGVsc2Uge1xuICAgICAgICB0aGlzLmNiLmNhbGwodGhpcy52bSwgdmFsdWUsIG9sZFZhbHVlKTtcbiAgICAgIH1cbiAgICB9XG4gIH1cbn07XG5cbi8qKlxuICogRXZhbHVhdGUgdGhlIHZhbHVlIG9mIHRoZSB3YXRjaGVyLlxuICogVGhpcyBvbmx5IGdldHMgY2FsbGVkIGZvciBsYXp5IHdhdGNoZXJzLlxuICovXG5XYXRjaGVyLnByb3RvdHlwZS5ldmFsdWF0ZSA9IGZ1bmN0aW9uIGV2YWx1YXRlICgpIHtcbiAgdGhpcy52YWx1ZSA9IHRoaXMuZ2V0KCk7XG4gIHRoaXMuZGlydHkgPSBmYWxzZTtcbn07XG5cbi8qKlxuICogRGVwZW5kIG9uIGFsbCBkZXBzIGNvbGxlY3RlZCBieSB0aGlzIHdhdGNoZXIuXG4gKi9cbldhdGNoZXIucHJvdG90eXBlLmRlcGVuZCA9IGZ1bmN0aW9uIGRlcGVuZCAoKSB7XG4gICAgdmFyIHRoaXMkMSA9IHRoaXM7XG5cbiAgdmFyIGkgPSB0aGlzLmRlcHMubGVuZ3RoO1xuICB3aGlsZSAoaS0tKSB7XG4gICAgdGhpcyQxLmRlcHNbaV0uZGVwZW5kKCk7XG4gIH1cbn07XG5cbi8qKlxuICogUmVtb3ZlIHNlbGYgZnJvbSBhbGwgZGVwZW5kZW5jaWVzJyBzdWJzY3JpYmVyIGxpc3QuXG4gKi9cbldhdGNoZXIucHJvdG90eXBlLnRlYXJkb3duID0gZnVuY3Rpb24gdGVhcmRvd24gKCkge1xuICAgIHZhciB0aGlzJDEgPSB0aGlzO1xuXG4gIGlmICh0aGlzLmFjdGl2ZSkge1xuICAgIC8vIHJlbW92ZSBzZWxmIGZyb20gdm0ncyB3YXRjaGVyIGxpc3RcbiAgICAvLyB0aGlzIGlzIGEgc29tZXdoYXQgZXhwZW5zaXZlIG9wZXJhdGlvbiBzbyB3ZSBza2lwIGl0XG4gICAgLy8gaWYgdGhlIHZtIGlzIGJlaW5nIGRlc3Ryb3llZC5cbiAgICBpZiAoIXRoaXMudm0uX2lzQmVpbmdEZXN0cm95ZWQpIHtcbiAgICAgIHJlbW92ZSh0aGlzLnZtLl93YXRjaGVycywgdGhpcyk7XG4gICAgfVxuICAgIHZhciBpID0gdGhpcy5kZXBzLmxlbmd0aDtcbiAgICB3aGlsZSAoaS0tKSB7XG4gICAgICB0aGlzJDEuZGVwc1tpXS5yZW1vdmVTdWIodGhpcyQxKTtcbiAgICB9XG4gICAgdGhpcy5hY3RpdmUgPSBmYWxzZTtcbiAgfVxufTtcblxuLyogICovXG5cbnZhciBzaGFyZWRQcm9wZXJ0eURlZmluaXRpb24gPSB7XG4gIGVudW1lcmFibGU6IHRydWUsXG4gIGNvbmZpZ3VyYWJsZTogdHJ1ZSxcbiAgZ2V0OiBub29wLFxuICBzZXQ6IG5vb3Bcbn07XG5cbmZ1bmN0aW9uIHByb3h5ICh0YXJnZXQsIHNvdXJjZUtleSwga2V5KSB7XG4gIHNoYXJlZFByb3BlcnR5RGVmaW5pdGlvbi5nZXQgPSBmdW5jdGlvbiBwcm94eUdldHRlciAoKSB7XG4gICAgcmV0dXJuIHRoaXNbc291cmNlS2V5XVtrZXldXG4gIH07XG4gIHNoYXJlZFByb3BlcnR5RGVmaW5pdGlvbi5zZXQgPSBmdW5jdGlvbiBwcm94eVNldHRlciAodmFsKSB7XG4gICAgdGhpc1tzb3VyY2VLZXldW2tleV0gPSB2YWw7XG4gIH07XG4gIE9iamVjdC5kZWZpbmVQcm9wZXJ0eSh0YXJnZXQsIGtleSwgc2hhcmVkUHJvcGVydHlEZWZpbml0aW9uKTtcbn1cblxuZnVuY3Rpb24gaW5pdFN0YXRlICh2bSkge1xuICB2bS5fd2F0Y2hlcnMgPSBbXTtcbiAgdmFyIG9wdHMgPSB2bS4kb3B0aW9ucztcbiAgaWYgKG9wdHMucHJvcHMpIHsgaW5pdFByb3BzKHZtLCBvcHRzLnByb3BzKTsgfVxuICBpZiAob3B0cy5tZXRob2RzKSB7IGluaXRNZXRob2RzKHZtLCBvcHRzLm1ldGhvZHMpOyB9XG4gIGlmIChvcHRzLmRhdGEpIHtcbiAgICBpbml0RGF0YSh2bSk7XG4gIH0gZWxzZSB7XG4gICAgb2JzZXJ2ZSh2bS5fZGF0YSA9IHt9LCB0cnVlIC8qIGFzUm9vdERhdGEgKi8pO1xuICB9XG4gIGlmIChvcHRzLmNvbXB1dGVkKSB7IGluaXRDb21wdXRlZCh2bSwgb3B0cy5jb21wdXRlZCk7IH1cbiAgaWYgKG9wdHMud2F0Y2ggJiYgb3B0cy53YXRjaCAhPT0gbmF0aXZlV2F0Y2gpIHtcbiAgICBpbml0V2F0Y2godm0sIG9wdHMud2F0Y2gpO1xuICB9XG59XG5cbmZ1bmN0aW9uIGluaXRQcm9wcyAodm0sIHByb3BzT3B0aW9ucykge1xuICB2YXIgcHJvcHNEYXRhID0gdm0uJG9wdGlvbnMucHJvcHNEYXRhIHx8IHt9O1xuICB2YXIgcHJvcHMgPSB2bS5fcHJvcHMgPSB7fTtcbiAgLy8gY2FjaGUgcHJvcCBrZXlzIHNvIHRoYXQgZnV0dXJlIHByb3BzIHVwZGF0ZXMgY2FuIGl0ZXJhdGUgdXNpbmcgQXJyYXlcbiAgLy8gaW5zdGVhZCBvZiBkeW5hbWljIG9iamVjdCBrZXkgZW51bWVyYXRpb24uXG4gIHZhciBrZXlzID0gdm0uJG9wdGlvbnMuX3Byb3BLZXlzID0gW107XG4gIHZhciBpc1Jvb3QgPSAhdm0uJHBhcmVudDtcbiAgLy8gcm9vdCBpbnN0YW5jZSBwcm9wcyBzaG91bGQgYmUgY29udmVydGVkXG4gIGlmICghaXNSb290KSB7XG4gICAgdG9nZ2xlT2JzZXJ2aW5nKGZhbHNlKTtcbiAgfVxuICB2YXIgbG9vcCA9IGZ1bmN0aW9uICgga2V5ICkge1xuICAgIGtleXMucHVzaChrZXkpO1xuICAgIHZhciB2YWx1ZSA9IHZhbGlkYXRlUHJvcChrZXksIHByb3BzT3B0aW9ucywgcHJvcHNEYXRhLCB2bSk7XG4gICAgLyogaXN0YW5idWwgaWdub3JlIGVsc2UgKi9cbiAgICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgICAgdmFyIGh5cGhlbmF0ZWRLZXkgPSBoeXBoZW5hdGUoa2V5KTtcbiAgICAgIGlmIChpc1Jlc2VydmVkQXR0cmlidXRlKGh5cGhlbmF0ZWRLZXkpIHx8XG4gICAgICAgICAgY29uZmlnLmlzUmVzZXJ2ZWRBdHRyKGh5cGhlbmF0ZWRLZXkpKSB7XG4gICAgICAgIHdhcm4oXG4gICAgICAgICAgKFwiXFxcIlwiICsgaHlwaGVuYXRlZEtleSArIFwiXFxcIiBpcyBhIHJlc2VydmVkIGF0dHJpYnV0ZSBhbmQgY2Fubm90IGJlIHVzZWQgYXMgY29tcG9uZW50IHByb3AuXCIpLFxuICAgICAgICAgIHZtXG4gICAgICAgICk7XG4gICAgICB9XG4gICAgICBkZWZpbmVSZWFjdGl2ZShwcm9wcywga2V5LCB2YWx1ZSwgZnVuY3Rpb24gKCkge1xuICAgICAgICBpZiAodm0uJHBhcmVudCAmJiAhaXNVcGRhdGluZ0NoaWxkQ29tcG9uZW50KSB7XG4gICAgICAgICAgd2FybihcbiAgICAgICAgICAgIFwiQXZvaWQgbXV0YXRpbmcgYSBwcm9wIGRpcmVjdGx5IHNpbmNlIHRoZSB2YWx1ZSB3aWxsIGJlIFwiICtcbiAgICAgICAgICAgIFwib3ZlcndyaXR0ZW4gd2hlbmV2ZXIgdGhlIHBhcmVudCBjb21wb25lbnQgcmUtcmVuZGVycy4gXCIgK1xuICAgICAgICAgICAgXCJJbnN0ZWFkLCB1c2UgYSBkYXRhIG9yIGNvbXB1dGVkIHByb3BlcnR5IGJhc2VkIG9uIHRoZSBwcm9wJ3MgXCIgK1xuICAgICAgICAgICAgXCJ2YWx1ZS4gUHJvcCBiZWluZyBtdXRhdGVkOiBcXFwiXCIgKyBrZXkgKyBcIlxcXCJcIixcbiAgICAgICAgICAgIHZtXG4gICAgICAgICAgKTtcbiAgICAgICAgfVxuICAgICAgfSk7XG4gICAgfSBlbHNlIHtcbiAgICAgIGRlZmluZVJlYWN0aXZlKHByb3BzLCBrZXksIHZhbHVlKTtcbiAgICB9XG4gICAgLy8gc3RhdGljIHByb3BzIGFyZSBhbHJlYWR5IHByb3hpZWQgb24gdGhlIGNvbXBvbmVudCdzIHByb3RvdHlwZVxuICAgIC8vIGR1cmluZyBWdWUuZXh0ZW5kKCkuIFdlIG9ubHkgbmVlZCB0byBwcm94eSBwcm9wcyBkZWZpbmVkIGF0XG4gICAgLy8gaW5zdGFudGlhdGlvbiBoZXJlLlxuICAgIGlmICghKGtleSBpbiB2bSkpIHtcbiAgICAgIHByb3h5KHZtLCBcIl9wcm9wc1wiLCBrZXkpO1xuICAgIH1cbiAgfTtcblxuICBmb3IgKHZhciBrZXkgaW4gcHJvcHNPcHRpb25zKSBsb29wKCBrZXkgKTtcbiAgdG9nZ2xlT2JzZXJ2aW5nKHRydWUpO1xufVxuXG5mdW5jdGlvbiBpbml0RGF0YSAodm0pIHtcbiAgdmFyIGRhdGEgPSB2bS4kb3B0aW9ucy5kYXRhO1xuICBkYXRhID0gdm0uX2RhdGEgPSB0eXBlb2YgZGF0YSA9PT0gJ2Z1bmN0aW9uJ1xuICAgID8gZ2V0RGF0YShkYXRhLCB2bSlcbiAgICA6IGRhdGEgfHwge307XG4gIGlmICghaXNQbGFpbk9iamVjdChkYXRhKSkge1xuICAgIGRhdGEgPSB7fTtcbiAgICBwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nICYmIHdhcm4oXG4gICAgICAnZGF0YSBmdW5jdGlvbnMgc2hvdWxkIHJldHVybiBhbiBvYmplY3Q6XFxuJyArXG4gICAgICAnaHR0cHM6Ly92dWVqcy5vcmcvdjIvZ3VpZGUvY29tcG9uZW50cy5odG1sI2RhdGEtTXVzdC1CZS1hLUZ1bmN0aW9uJyxcbiAgICAgIHZtXG4gICAgKTtcbiAgfVxuICAvLyBwcm94eSBkYXRhIG9uIGluc3RhbmNlXG4gIHZhciBrZXlzID0gT2JqZWN0LmtleXMoZGF0YSk7XG4gIHZhciBwcm9wcyA9IHZtLiRvcHRpb25zLnByb3BzO1xuICB2YXIgbWV0aG9kcyA9IHZtLiRvcHRpb25zLm1ldGhvZHM7XG4gIHZhciBpID0ga2V5cy5sZW5ndGg7XG4gIHdoaWxlIChpLS0pIHtcbiAgICB2YXIga2V5ID0ga2V5c1tpXTtcbiAgICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgICAgaWYgKG1ldGhvZHMgJiYgaGFzT3duKG1ldGhvZHMsIGtleSkpIHtcbiAgICAgICAgd2FybihcbiAgICAgICAgICAoXCJNZXRob2QgXFxcIlwiICsga2V5ICsgXCJcXFwiIGhhcyBhbHJlYWR5IGJlZW4gZGVmaW5lZCBhcyBhIGRhdGEgcHJvcGVydHkuXCIpLFxuICAgICAgICAgIHZtXG4gICAgICAgICk7XG4gICAgICB9XG4gICAgfVxuICAgIGlmIChwcm9wcyAmJiBoYXNPd24ocHJvcHMsIGtleSkpIHtcbiAgICAgIHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiYgd2FybihcbiAgICAgICAgXCJUaGUgZGF0YSBwcm9wZXJ0eSBcXFwiXCIgKyBrZXkgKyBcIlxcXCIgaXMgYWxyZWFkeSBkZWNsYXJlZCBhcyBhIHByb3AuIFwiICtcbiAgICAgICAgXCJVc2UgcHJvcCBkZWZhdWx0IHZhbHVlIGluc3RlYWQuXCIsXG4gICAgICAgIHZtXG4gICAgICApO1xuICAgIH0gZWxzZSBpZiAoIWlzUmVzZXJ2ZWQoa2V5KSkge1xuICAgICAgcHJveHkodm0sIFwiX2RhdGFcIiwga2V5KTtcbiAgICB9XG4gIH1cbiAgLy8gb2JzZXJ2ZSBkYXRhXG4gIG9ic2VydmUoZGF0YSwgdHJ1ZSAvKiBhc1Jvb3REYXRhICovKTtcbn1cblxuZnVuY3Rpb24gZ2V0RGF0YSAoZGF0YSwgdm0pIHtcbiAgLy8gIzc1NzMgZGlzYWJsZSBkZXAgY29sbGVjdGlvbiB3aGVuIGludm9raW5nIGRhdGEgZ2V0dGVyc1xuICBwdXNoVGFyZ2V0KCk7XG4gIHRyeSB7XG4gICAgcmV0dXJuIGRhdGEuY2FsbCh2bSwgdm0pXG4gIH0gY2F0Y2ggKGUpIHtcbiAgICBoYW5kbGVFcnJvcihlLCB2bSwgXCJkYXRhKClcIik7XG4gICAgcmV0dXJuIHt9XG4gIH0gZmluYWxseSB7XG4gICAgcG9wVGFyZ2V0KCk7XG4gIH1cbn1cblxudmFyIGNvbXB1dGVkV2F0Y2hlck9wdGlvbnMgPSB7IGxhenk6IHRydWUgfTtcblxuZnVuY3Rpb24gaW5pdENvbXB1dGVkICh2bSwgY29tcHV0ZWQpIHtcbiAgLy8gJGZsb3ctZGlzYWJsZS1saW5lXG4gIHZhciB3YXRjaGVycyA9IHZtLl9jb21wdXRlZFdhdGNoZXJzID0gT2JqZWN0LmNyZWF0ZShudWxsKTtcbiAgLy8gY29tcHV0ZWQgcHJvcGVydGllcyBhcmUganVzdCBnZXR0ZXJzIGR1cmluZyBTU1JcbiAgdmFyIGlzU1NSID0gaXNTZXJ2ZXJSZW5kZXJpbmcoKTtcblxuICBmb3IgKHZhciBrZXkgaW4gY29tcHV0ZWQpIHtcbiAgICB2YXIgdXNlckRlZiA9IGNvbXB1dGVkW2tleV07XG4gICAgdmFyIGdldHRlciA9IHR5cGVvZiB1c2VyRGVmID09PSAnZnVuY3Rpb24nID8gdXNlckRlZiA6IHVzZXJEZWYuZ2V0O1xuICAgIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nICYmIGdldHRlciA9PSBudWxsKSB7XG4gICAgICB3YXJuKFxuICAgICAgICAoXCJHZXR0ZXIgaXMgbWlzc2luZyBmb3IgY29tcHV0ZWQgcHJvcGVydHkgXFxcIlwiICsga2V5ICsgXCJcXFwiLlwiKSxcbiAgICAgICAgdm1cbiAgICAgICk7XG4gICAgfVxuXG4gICAgaWYgKCFpc1NTUikge1xuICAgICAgLy8gY3JlYXRlIGludGVybmFsIHdhdGNoZXIgZm9yIHRoZSBjb21wdXRlZCBwcm9wZXJ0eS5cbiAgICAgIHdhdGNoZXJzW2tleV0gPSBuZXcgV2F0Y2hlcihcbiAgICAgICAgdm0sXG4gICAgICAgIGdldHRlciB8fCBub29wLFxuICAgICAgICBub29wLFxuICAgICAgICBjb21wdXRlZFdhdGNoZXJPcHRpb25zXG4gICAgICApO1xuICAgIH1cblxuICAgIC8vIGNvbXBvbmVudC1kZWZpbmVkIGNvbXB1dGVkIHByb3BlcnRpZXMgYXJlIGFscmVhZHkgZGVmaW5lZCBvbiB0aGVcbiAgICAvLyBjb21wb25lbnQgcHJvdG90eXBlLiBXZSBvbmx5IG5lZWQgdG8gZGVmaW5lIGNvbXB1dGVkIHByb3BlcnRpZXMgZGVmaW5lZFxuICAgIC8vIGF0IGluc3RhbnRpYXRpb24gaGVyZS5cbiAgICBpZiAoIShrZXkgaW4gdm0pKSB7XG4gICAgICBkZWZpbmVDb21wdXRlZCh2bSwga2V5LCB1c2VyRGVmKTtcbiAgICB9IGVsc2UgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicpIHtcbiAgICAgIGlmIChrZXkgaW4gdm0uJGRhdGEpIHtcbiAgICAgICAgd2FybigoXCJUaGUgY29tcHV0ZWQgcHJvcGVydHkgXFxcIlwiICsga2V5ICsgXCJcXFwiIGlzIGFscmVhZHkgZGVmaW5lZCBpbiBkYXRhLlwiKSwgdm0pO1xuICAgICAgfSBlbHNlIGlmICh2bS4kb3B0aW9ucy5wcm9wcyAmJiBrZXkgaW4gdm0uJG9wdGlvbnMucHJvcHMpIHtcbiAgICAgICAgd2FybigoXCJUaGUgY29tcHV0ZWQgcHJvcGVydHkgXFxcIlwiICsga2V5ICsgXCJcXFwiIGlzIGFscmVhZHkgZGVmaW5lZCBhcyBhIHByb3AuXCIpLCB2bSk7XG4gICAgICB9XG4gICAgfVxuICB9XG59XG5cbmZ1bmN0aW9uIGRlZmluZUNvbXB1dGVkIChcbiAgdGFyZ2V0LFxuICBrZXksXG4gIHVzZXJEZWZcbikge1xuICB2YXIgc2hvdWxkQ2FjaGUgPSAhaXNTZXJ2ZXJSZW5kZXJpbmcoKTtcbiAgaWYgKHR5cGVvZiB1c2VyRGVmID09PSAnZnVuY3Rpb24nKSB7XG4gICAgc2hhcmVkUHJvcGVydHlEZWZpbml0aW9uLmdldCA9IHNob3VsZENhY2hlXG4gICAgICA/IGNyZWF0ZUNvbXB1dGVkR2V0dGVyKGtleSlcbiAgICAgIDogdXNlckRlZjtcbiAgICBzaGFyZWRQcm9wZXJ0eURlZmluaXRpb24uc2V0ID0gbm9vcDtcbiAgfSBlbHNlIHtcbiAgICBzaGFyZWRQcm9wZXJ0eURlZmluaXRpb24uZ2V0ID0gdXNlckRlZi5nZXRcbiAgICAgID8gc2hvdWxkQ2FjaGUgJiYgdXNlckRlZi5jYWNoZSAhPT0gZmFsc2VcbiAgICAgICAgPyBjcmVhdGVDb21wdXRlZEdldHRlcihrZXkpXG4gICAgICAgIDogdXNlckRlZi5nZXRcbiAgICAgIDogbm9vcDtcbiAgICBzaGFyZWRQcm9wZXJ0eURlZmluaXRpb24uc2V0ID0gdXNlckRlZi5zZXRcbiAgICAgID8gdXNlckRlZi5zZXRcbiAgICAgIDogbm9vcDtcbiAgfVxuICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJlxuICAgICAgc2hhcmVkUHJvcGVydHlEZWZpbml0aW9uLnNldCA9PT0gbm9vcCkge1xuICAgIHNoYXJlZFByb3BlcnR5RGVmaW5pdGlvbi5zZXQgPSBmdW5jdGlvbiAoKSB7XG4gICAgICB3YXJuKFxuICAgICAgICAoXCJDb21wdXRlZCBwcm9wZXJ0eSBcXFwiXCIgKyBrZXkgKyBcIlxcXCIgd2FzIGFzc2lnbmVkIHRvIGJ1dCBpdCBoYXMgbm8gc2V0dGVyLlwiKSxcbiAgICAgICAgdGhpc1xuICAgICAgKTtcbiAgICB9O1xuICB9XG4gIE9iamVjdC5kZWZpbmVQcm9wZXJ0eSh0YXJnZXQsIGtleSwgc2hhcmVkUHJvcGVydHlEZWZpbml0aW9uKTtcbn1cblxuZnVuY3Rpb24gY3JlYXRlQ29tcHV0ZWRHZXR0ZXIgKGtleSkge1xuICByZXR1cm4gZnVuY3Rpb24gY29tcHV0ZWRHZXR0ZXIgKCkge1xuICAgIHZhciB3YXRjaGVyID0gdGhpcy5fY29tcHV0ZWRXYXRjaGVycyAmJiB0aGlzLl9jb21wdXRlZFdhdGNoZXJzW2tleV07XG4gICAgaWYgKHdhdGNoZXIpIHtcbiAgICAgIGlmICh3YXRjaGVyLmRpcnR5KSB7XG4gICAgICAgIHdhdGNoZXIuZXZhbHVhdGUoKTtcbiAgICAgIH1cbiAgICAgIGlmIChEZXAudGFyZ2V0KSB7XG4gICAgICAgIHdhdGNoZXIuZGVwZW5kKCk7XG4gICAgICB9XG4gICAgICByZXR1cm4gd2F0Y2hlci52YWx1ZVxuICAgIH1cbiAgfVxufVxuXG5mdW5jdGlvbiBpbml0TWV0aG9kcyAodm0sIG1ldGhvZHMpIHtcbiAgdmFyIHByb3BzID0gdm0uJG9wdGlvbnMucHJvcHM7XG4gIGZvciAodmFyIGtleSBpbiBtZXRob2RzKSB7XG4gICAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicpIHtcbiAgICAgIGlmIChtZXRob2RzW2tleV0gPT0gbnVsbCkge1xuICAgICAgICB3YXJuKFxuICAgICAgICAgIFwiTWV0aG9kIFxcXCJcIiArIGtleSArIFwiXFxcIiBoYXMgYW4gdW5kZWZpbmVkIHZhbHVlIGluIHRoZSBjb21wb25lbnQgZGVmaW5pdGlvbi4gXCIgK1xuICAgICAgICAgIFwiRGlkIHlvdSByZWZlcmVuY2UgdGhlIGZ1bmN0aW9uIGNvcnJlY3RseT9cIixcbiAgICAgICAgICB2bVxuICAgICAgICApO1xuICAgICAgfVxuICAgICAgaWYgKHByb3BzICYmIGhhc093bihwcm9wcywga2V5KSkge1xuICAgICAgICB3YXJuKFxuICAgICAgICAgIChcIk1ldGhvZCBcXFwiXCIgKyBrZXkgKyBcIlxcXCIgaGFzIGFscmVhZHkgYmVlbiBkZWZpbmVkIGFzIGEgcHJvcC5cIiksXG4gICAgICAgICAgdm1cbiAgICAgICAgKTtcbiAgICAgIH1cbiAgICAgIGlmICgoa2V5IGluIHZtKSAmJiBpc1Jlc2VydmVkKGtleSkpIHtcbiAgICAgICAgd2FybihcbiAgICAgICAgICBcIk1ldGhvZCBcXFwiXCIgKyBrZXkgKyBcIlxcXCIgY29uZmxpY3RzIHdpdGggYW4gZXhpc3RpbmcgVnVlIGluc3RhbmNlIG1ldGhvZC4gXCIgK1xuICAgICAgICAgIFwiQXZvaWQgZGVmaW5pbmcgY29tcG9uZW50IG1ldGhvZHMgdGhhdCBzdGFydCB3aXRoIF8gb3IgJC5cIlxuICAgICAgICApO1xuICAgICAgfVxuICAgIH1cbiAgICB2bVtrZXldID0gbWV0aG9kc1trZXldID09IG51bGwgPyBub29wIDogYmluZChtZXRob2RzW2tleV0sIHZtKTtcbiAgfVxufVxuXG5mdW5jdGlvbiBpbml0V2F0Y2ggKHZtLCB3YXRjaCkge1xuICBmb3IgKHZhciBrZXkgaW4gd2F0Y2gpIHtcbiAgICB2YXIgaGFuZGxlciA9IHdhdGNoW2tleV07XG4gICAgaWYgKEFycmF5LmlzQXJyYXkoaGFuZGxlcikpIHtcbiAgICAgIGZvciAodmFyIGkgPSAwOyBpIDwgaGFuZGxlci5sZW5ndGg7IGkrKykge1xuICAgICAgICBjcmVhdGVXYXRjaGVyKHZtLCBrZXksIGhhbmRsZXJbaV0pO1xuICAgICAgfVxuICAgIH0gZWxzZSB7XG4gICAgICBjcmVhdGVXYXRjaGVyKHZtLCBrZXksIGhhbmRsZXIpO1xuICAgIH1cbiAgfVxufVxuXG5mdW5jdGlvbiBjcmVhdGVXYXRjaGVyIChcbiAgdm0sXG4gIGV4cE9yRm4sXG4gIGhhbmRsZXIsXG4gIG9wdGlvbnNcbikge1xuICBpZiAoaXNQbGFpbk9iamVjdChoYW5kbGVyKSkge1xuICAgIG9wdGlvbnMgPSBoYW5kbGVyO1xuICAgIGhhbmRsZXIgPSBoYW5kbGVyLmhhbmRsZXI7XG4gIH1cbiAgaWYgKHR5cGVvZiBoYW5kbGVyID09PSAnc3RyaW5nJykge1xuICAgIGhhbmRsZXIgPSB2bVtoYW5kbGVyXTtcbiAgfVxuICByZXR1cm4gdm0uJHdhdGNoKGV4cE9yRm4sIGhhbmRsZXIsIG9wdGlvbnMpXG59XG5cbmZ1bmN0aW9uIHN0YXRlTWl4aW4gKFZ1ZSkge1xuICAvLyBmbG93IHNvbWVob3cgaGFzIHByb2JsZW1zIHdpdGggZGlyZWN0bHkgZGVjbGFyZWQgZGVmaW5pdGlvbiBvYmplY3RcbiAgLy8gd2hlbiB1c2luZyBPYmplY3QuZGVmaW5lUHJvcGVydHksIHNvIHdlIGhhdmUgdG8gcHJvY2VkdXJhbGx5IGJ1aWxkIHVwXG4gIC8vIHRoZSBvYmplY3QgaGVyZS5cbiAgdmFyIGRhdGFEZWYgPSB7fTtcbiAgZGF0YURlZi5nZXQgPSBmdW5jdGlvbiAoKSB7IHJldHVybiB0aGlzLl9kYXRhIH07XG4gIHZhciBwcm9wc0RlZiA9IHt9O1xuICBwcm9wc0RlZi5nZXQgPSBmdW5jdGlvbiAoKSB7IHJldHVybiB0aGlzLl9wcm9wcyB9O1xuICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgIGRhdGFEZWYuc2V0ID0gZnVuY3Rpb24gKG5ld0RhdGEpIHtcbiAgICAgIHdhcm4oXG4gICAgICAgICdBdm9pZCByZXBsYWNpbmcgaW5zdGFuY2Ugcm9vdCAkZGF0YS4gJyArXG4gICAgICAgICdVc2UgbmVzdGVkIGRhdGEgcHJvcGVydGllcyBpbnN0ZWFkLicsXG4gICAgICAgIHRoaXNcbiAgICAgICk7XG4gICAgfTtcbiAgICBwcm9wc0RlZi5zZXQgPSBmdW5jdGlvbiAoKSB7XG4gICAgICB3YXJuKFwiJHByb3BzIGlzIHJlYWRvbmx5LlwiLCB0aGlzKTtcbiAgICB9O1xuICB9XG4gIE9iamVjdC5kZWZpbmVQcm9wZXJ0eShWdWUucHJvdG90eXBlLCAnJGRhdGEnLCBkYXRhRGVmKTtcbiAgT2JqZWN0LmRlZmluZVByb3BlcnR5KFZ1ZS5wcm90b3R5cGUsICckcHJvcHMnLCBwcm9wc0RlZik7XG5cbiAgVnVlLnByb3RvdHlwZS4kc2V0ID0gc2V0O1xuICBWdWUucHJvdG90eXBlLiRkZWxldGUgPSBkZWw7XG5cbiAgVnVlLnByb3RvdHlwZS4kd2F0Y2ggPSBmdW5jdGlvbiAoXG4gICAgZXhwT3JGbixcbiAgICBjYixcbiAgICBvcHRpb25zXG4gICkge1xuICAgIHZhciB2bSA9IHRoaXM7XG4gICAgaWYgKGlzUGxhaW5PYmplY3QoY2IpKSB7XG4gICAgICByZXR1cm4gY3JlYXRlV2F0Y2hlcih2bSwgZXhwT3JGbiwgY2IsIG9wdGlvbnMpXG4gICAgfVxuICAgIG9wdGlvbnMgPSBvcHRpb25zIHx8IHt9O1xuICAgIG9wdGlvbnMudXNlciA9IHRydWU7XG4gICAgdmFyIHdhdGNoZXIgPSBuZXcgV2F0Y2hlcih2bSwgZXhwT3JGbiwgY2IsIG9wdGlvbnMpO1xuICAgIGlmIChvcHRpb25zLmltbWVkaWF0ZSkge1xuICAgICAgY2IuY2FsbCh2bSwgd2F0Y2hlci52YWx1ZSk7XG4gICAgfVxuICAgIHJldHVybiBmdW5jdGlvbiB1bndhdGNoRm4gKCkge1xuICAgICAgd2F0Y2hlci50ZWFyZG93bigpO1xuICAgIH1cbiAgfTtcbn1cblxuLyogICovXG5cbmZ1bmN0aW9uIGluaXRQcm92aWRlICh2bSkge1xuICB2YXIgcHJvdmlkZSA9IHZtLiRvcHRpb25zLnByb3ZpZGU7XG4gIGlmIChwcm92aWRlKSB7XG4gICAgdm0uX3Byb3ZpZGVkID0gdHlwZW9mIHByb3ZpZGUgPT09ICdmdW5jdGlvbidcbiAgICAgID8gcHJvdmlkZS5jYWxsKHZtKVxuICAgICAgOiBwcm92aWRlO1xuICB9XG59XG5cbmZ1bmN0aW9uIGluaXRJbmplY3Rpb25zICh2bSkge1xuICB2YXIgcmVzdWx0ID0gcmVzb2x2ZUluamVjdCh2bS4kb3B0aW9ucy5pbmplY3QsIHZtKTtcbiAgaWYgKHJlc3VsdCkge1xuICAgIHRvZ2dsZU9ic2VydmluZyhmYWxzZSk7XG4gICAgT2JqZWN0LmtleXMocmVzdWx0KS5mb3JFYWNoKGZ1bmN0aW9uIChrZXkpIHtcbiAgICAgIC8qIGlzdGFuYnVsIGlnbm9yZSBlbHNlICovXG4gICAgICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgICAgICBkZWZpbmVSZWFjdGl2ZSh2bSwga2V5LCByZXN1bHRba2V5XSwgZnVuY3Rpb24gKCkge1xuICAgICAgICAgIHdhcm4oXG4gICAgICAgICAgICBcIkF2b2lkIG11dGF0aW5nIGFuIGluamVjdGVkIHZhbHVlIGRpcmVjdGx5IHNpbmNlIHRoZSBjaGFuZ2VzIHdpbGwgYmUgXCIgK1xuICAgICAgICAgICAgXCJvdmVyd3JpdHRlbiB3aGVuZXZlciB0aGUgcHJvdmlkZWQgY29tcG9uZW50IHJlLXJlbmRlcnMuIFwiICtcbiAgICAgICAgICAgIFwiaW5qZWN0aW9uIGJlaW5nIG11dGF0ZWQ6IFxcXCJcIiArIGtleSArIFwiXFxcIlwiLFxuICAgICAgICAgICAgdm1cbiAgICAgICAgICApO1xuICAgICAgICB9KTtcbiAgICAgIH0gZWxzZSB7XG4gICAgICAgIGRlZmluZVJlYWN0aXZlKHZtLCBrZXksIHJlc3VsdFtrZXldKTtcbiAgICAgIH1cbiAgICB9KTtcbiAgICB0b2dnbGVPYnNlcnZpbmcodHJ1ZSk7XG4gIH1cbn1cblxuZnVuY3Rpb24gcmVzb2x2ZUluamVjdCAoaW5qZWN0LCB2bSkge1xuICBpZiAoaW5qZWN0KSB7XG4gICAgLy8gaW5qZWN0IGlzIDphbnkgYmVjYXVzZSBmbG93IGlzIG5vdCBzbWFydCBlbm91Z2ggdG8gZmlndXJlIG91dCBjYWNoZWRcbiAgICB2YXIgcmVzdWx0ID0gT2JqZWN0LmNyZWF0ZShudWxsKTtcbiAgICB2YXIga2V5cyA9IGhhc1N5bWJvbFxuICAgICAgPyBSZWZsZWN0Lm93bktleXMoaW5qZWN0KS5maWx0ZXIoZnVuY3Rpb24gKGtleSkge1xuICAgICAgICAvKiBpc3RhbmJ1bCBpZ25vcmUgbmV4dCAqL1xuICAgICAgICByZXR1cm4gT2JqZWN0LmdldE93blByb3BlcnR5RGVzY3JpcHRvcihpbmplY3QsIGtleSkuZW51bWVyYWJsZVxuICAgICAgfSlcbiAgICAgIDogT2JqZWN0LmtleXMoaW5qZWN0KTtcblxuICAgIGZvciAodmFyIGkgPSAwOyBpIDwga2V5cy5sZW5ndGg7IGkrKykge1xuICAgICAgdmFyIGtleSA9IGtleXNbaV07XG4gICAgICB2YXIgcHJvdmlkZUtleSA9IGluamVjdFtrZXldLmZyb207XG4gICAgICB2YXIgc291cmNlID0gdm07XG4gICAgICB3aGlsZSAoc291cmNlKSB7XG4gICAgICAgIGlmIChzb3VyY2UuX3Byb3ZpZGVkICYmIGhhc093bihzb3VyY2UuX3Byb3ZpZGVkLCBwcm92aWRlS2V5KSkge1xuICAgICAgICAgIHJlc3VsdFtrZXldID0gc291cmNlLl9wcm92aWRlZFtwcm92aWRlS2V5XTtcbiAgICAgICAgICBicmVha1xuICAgICAgICB9XG4gICAgICAgIHNvdXJjZSA9IHNvdXJjZS4kcGFyZW50O1xuICAgICAgfVxuICAgICAgaWYgKCFzb3VyY2UpIHtcbiAgICAgICAgaWYgKCdkZWZhdWx0JyBpbiBpbmplY3Rba2V5XSkge1xuICAgICAgICAgIHZhciBwcm92aWRlRGVmYXVsdCA9IGluamVjdFtrZXldLmRlZmF1bHQ7XG4gICAgICAgICAgcmVzdWx0W2tleV0gPSB0eXBlb2YgcHJvdmlkZURlZmF1bHQgPT09ICdmdW5jdGlvbidcbiAgICAgICAgICAgID8gcHJvdmlkZURlZmF1bHQuY2FsbCh2bSlcbiAgICAgICAgICAgIDogcHJvdmlkZURlZmF1bHQ7XG4gICAgICAgIH0gZWxzZSBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgICAgICAgIHdhcm4oKFwiSW5qZWN0aW9uIFxcXCJcIiArIGtleSArIFwiXFxcIiBub3QgZm91bmRcIiksIHZtKTtcbiAgICAgICAgfVxuICAgICAgfVxuICAgIH1cbiAgICByZXR1cm4gcmVzdWx0XG4gIH1cbn1cblxuLyogICovXG5cbi8qKlxuICogUnVudGltZSBoZWxwZXIgZm9yIHJlbmRlcmluZyB2LWZvciBsaXN0cy5cbiAqL1xuZnVuY3Rpb24gcmVuZGVyTGlzdCAoXG4gIHZhbCxcbiAgcmVuZGVyXG4pIHtcbiAgdmFyIHJldCwgaSwgbCwga2V5cywga2V5O1xuICBpZiAoQXJyYXkuaXNBcnJheSh2YWwpIHx8IHR5cGVvZiB2YWwgPT09ICdzdHJpbmcnKSB7XG4gICAgcmV0ID0gbmV3IEFycmF5KHZhbC5sZW5ndGgpO1xuICAgIGZvciAoaSA9IDAsIGwgPSB2YWwubGVuZ3RoOyBpIDwgbDsgaSsrKSB7XG4gICAgICByZXRbaV0gPSByZW5kZXIodmFsW2ldLCBpKTtcbiAgICB9XG4gIH0gZWxzZSBpZiAodHlwZW9mIHZhbCA9PT0gJ251bWJlcicpIHtcbiAgICByZXQgPSBuZXcgQXJyYXkodmFsKTtcbiAgICBmb3IgKGkgPSAwOyBpIDwgdmFsOyBpKyspIHtcbiAgICAgIHJldFtpXSA9IHJlbmRlcihpICsgMSwgaSk7XG4gICAgfVxuICB9IGVsc2UgaWYgKGlzT2JqZWN0KHZhbCkpIHtcbiAgICBrZXlzID0gT2JqZWN0LmtleXModmFsKTtcbiAgICByZXQgPSBuZXcgQXJyYXkoa2V5cy5sZW5ndGgpO1xuICAgIGZvciAoaSA9IDAsIGwgPSBrZXlzLmxlbmd0aDsgaSA8IGw7IGkrKykge1xuICAgICAga2V5ID0ga2V5c1tpXTtcbiAgICAgIHJldFtpXSA9IHJlbmRlcih2YWxba2V5XSwga2V5LCBpKTtcbiAgICB9XG4gIH1cbiAgaWYgKGlzRGVmKHJldCkpIHtcbiAgICAocmV0KS5faXNWTGlzdCA9IHRydWU7XG4gIH1cbiAgcmV0dXJuIHJldFxufVxuXG4vKiAgKi9cblxuLyoqXG4gKiBSdW50aW1lIGhlbHBlciBmb3IgcmVuZGVyaW5nIDxzbG90PlxuICovXG5mdW5jdGlvbiByZW5kZXJTbG90IChcbiAgbmFtZSxcbiAgZmFsbGJhY2ssXG4gIHByb3BzLFxuICBiaW5kT2JqZWN0XG4pIHtcbiAgdmFyIHNjb3BlZFNsb3RGbiA9IHRoaXMuJHNjb3BlZFNsb3RzW25hbWVdO1xuICB2YXIgbm9kZXM7XG4gIGlmIChzY29wZWRTbG90Rm4pIHsgLy8gc2NvcGVkIHNsb3RcbiAgICBwcm9wcyA9IHByb3BzIHx8IHt9O1xuICAgIGlmIChiaW5kT2JqZWN0KSB7XG4gICAgICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJiAhaXNPYmplY3QoYmluZE9iamVjdCkpIHtcbiAgICAgICAgd2FybihcbiAgICAgICAgICAnc2xvdCB2LWJpbmQgd2l0aG91dCBhcmd1bWVudCBleHBlY3RzIGFuIE9iamVjdCcsXG4gICAgICAgICAgdGhpc1xuICAgICAgICApO1xuICAgICAgfVxuICAgICAgcHJvcHMgPSBleHRlbmQoZXh0ZW5kKHt9LCBiaW5kT2JqZWN0KSwgcHJvcHMpO1xuICAgIH1cbiAgICBub2RlcyA9IHNjb3BlZFNsb3RGbihwcm9wcykgfHwgZmFsbGJhY2s7XG4gIH0gZWxzZSB7XG4gICAgdmFyIHNsb3ROb2RlcyA9IHRoaXMuJHNsb3RzW25hbWVdO1xuICAgIC8vIHdhcm4gZHVwbGljYXRlIHNsb3QgdXNhZ2VcbiAgICBpZiAoc2xvdE5vZGVzKSB7XG4gICAgICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJiBzbG90Tm9kZXMuX3JlbmRlcmVkKSB7XG4gICAgICAgIHdhcm4oXG4gICAgICAgICAgXCJEdXBsaWNhdGUgcHJlc2VuY2Ugb2Ygc2xvdCBcXFwiXCIgKyBuYW1lICsgXCJcXFwiIGZvdW5kIGluIHRoZSBzYW1lIHJlbmRlciB0cmVlIFwiICtcbiAgICAgICAgICBcIi0gdGhpcyB3aWxsIGxpa2VseSBjYXVzZSByZW5kZXIgZXJyb3JzLlwiLFxuICAgICAgICAgIHRoaXNcbiAgICAgICAgKTtcbiAgICAgIH1cbiAgICAgIHNsb3ROb2Rlcy5fcmVuZGVyZWQgPSB0cnVlO1xuICAgIH1cbiAgICBub2RlcyA9IHNsb3ROb2RlcyB8fCBmYWxsYmFjaztcbiAgfVxuXG4gIHZhciB0YXJnZXQgPSBwcm9wcyAmJiBwcm9wcy5zbG90O1xuICBpZiAodGFyZ2V0KSB7XG4gICAgcmV0dXJuIHRoaXMuJGNyZWF0ZUVsZW1lbnQoJ3RlbXBsYXRlJywgeyBzbG90OiB0YXJnZXQgfSwgbm9kZXMpXG4gIH0gZWxzZSB7XG4gICAgcmV0dXJuIG5vZGVzXG4gIH1cbn1cblxuLyogICovXG5cbi8qKlxuICogUnVudGltZSBoZWxwZXIgZm9yIHJlc29sdmluZyBmaWx0ZXJzXG4gKi9cbmZ1bmN0aW9uIHJlc29sdmVGaWx0ZXIgKGlkKSB7XG4gIHJldHVybiByZXNvbHZlQXNzZXQodGhpcy4kb3B0aW9ucywgJ2ZpbHRlcnMnLCBpZCwgdHJ1ZSkgfHwgaWRlbnRpdHlcbn1cblxuLyogICovXG5cbmZ1bmN0aW9uIGlzS2V5Tm90TWF0Y2ggKGV4cGVjdCwgYWN0dWFsKSB7XG4gIGlmIChBcnJheS5pc0FycmF5KGV4cGVjdCkpIHtcbiAgICByZXR1cm4gZXhwZWN0LmluZGV4T2YoYWN0dWFsKSA9PT0gLTFcbiAgfSBlbHNlIHtcbiAgICByZXR1cm4gZXhwZWN0ICE9PSBhY3R1YWxcbiAgfVxufVxuXG4vKipcbiAqIFJ1bnRpbWUgaGVscGVyIGZvciBjaGVja2luZyBrZXlDb2RlcyBmcm9tIGNvbmZpZy5cbiAqIGV4cG9zZWQgYXMgVnVlLnByb3RvdHlwZS5fa1xuICogcGFzc2luZyBpbiBldmVudEtleU5hbWUgYXMgbGFzdCBhcmd1bWVudCBzZXBhcmF0ZWx5IGZvciBiYWNrd2FyZHMgY29tcGF0XG4gKi9cbmZ1bmN0aW9uIGNoZWNrS2V5Q29kZXMgKFxuICBldmVudEtleUNvZGUsXG4gIGtleSxcbiAgYnVpbHRJbktleUNvZGUsXG4gIGV2ZW50S2V5TmFtZSxcbiAgYnVpbHRJbktleU5hbWVcbikge1xuICB2YXIgbWFwcGVkS2V5Q29kZSA9IGNvbmZpZy5rZXlDb2Rlc1trZXldIHx8IGJ1aWx0SW5LZXlDb2RlO1xuICBpZiAoYnVpbHRJbktleU5hbWUgJiYgZXZlbnRLZXlOYW1lICYmICFjb25maWcua2V5Q29kZXNba2V5XSkge1xuICAgIHJldHVybiBpc0tleU5vdE1hdGNoKGJ1aWx0SW5LZXlOYW1lLCBldmVudEtleU5hbWUpXG4gIH0gZWxzZSBpZiAobWFwcGVkS2V5Q29kZSkge1xuICAgIHJldHVybiBpc0tleU5vdE1hdGNoKG1hcHBlZEtleUNvZGUsIGV2ZW50S2V5Q29kZSlcbiAgfSBlbHNlIGlmIChldmVudEtleU5hbWUpIHtcbiAgICByZXR1cm4gaHlwaGVuYXRlKGV2ZW50S2V5TmFtZSkgIT09IGtleVxuICB9XG59XG5cbi8qICAqL1xuXG4vKipcbiAqIFJ1bnRpbWUgaGVscGVyIGZvciBtZXJnaW5nIHYtYmluZD1cIm9iamVjdFwiIGludG8gYSBWTm9kZSdzIGRhdGEuXG4gKi9cbmZ1bmN0aW9uIGJpbmRPYmplY3RQcm9wcyAoXG4gIGRhdGEsXG4gIHRhZyxcbiAgdmFsdWUsXG4gIGFzUHJvcCxcbiAgaXNTeW5jXG4pIHtcbiAgaWYgKHZhbHVlKSB7XG4gICAgaWYgKCFpc09iamVjdCh2YWx1ZSkpIHtcbiAgICAgIHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiYgd2FybihcbiAgICAgICAgJ3YtYmluZCB3aXRob3V0IGFyZ3VtZW50IGV4cGVjdHMgYW4gT2JqZWN0IG9yIEFycmF5IHZhbHVlJyxcbiAgICAgICAgdGhpc1xuICAgICAgKTtcbiAgICB9IGVsc2Uge1xuICAgICAgaWYgKEFycmF5LmlzQXJyYXkodmFsdWUpKSB7XG4gICAgICAgIHZhbHVlID0gdG9PYmplY3QodmFsdWUpO1xuICAgICAgfVxuICAgICAgdmFyIGhhc2g7XG4gICAgICB2YXIgbG9vcCA9IGZ1bmN0aW9uICgga2V5ICkge1xuICAgICAgICBpZiAoXG4gICAgICAgICAga2V5ID09PSAnY2xhc3MnIHx8XG4gICAgICAgICAga2V5ID09PSAnc3R5bGUnIHx8XG4gICAgICAgICAgaXNSZXNlcnZlZEF0dHJpYnV0ZShrZXkpXG4gICAgICAgICkge1xuICAgICAgICAgIGhhc2ggPSBkYXRhO1xuICAgICAgICB9IGVsc2Uge1xuICAgICAgICAgIHZhciB0eXBlID0gZGF0YS5hdHRycyAmJiBkYXRhLmF0dHJzLnR5cGU7XG4gICAgICAgICAgaGFzaCA9IGFzUHJvcCB8fCBjb25maWcubXVzdFVzZVByb3AodGFnLCB0eXBlLCBrZXkpXG4gICAgICAgICAgICA/IGRhdGEuZG9tUHJvcHMgfHwgKGRhdGEuZG9tUHJvcHMgPSB7fSlcbiAgICAgICAgICAgIDogZGF0YS5hdHRycyB8fCAoZGF0YS5hdHRycyA9IHt9KTtcbiAgICAgICAgfVxuICAgICAgICBpZiAoIShrZXkgaW4gaGFzaCkpIHtcbiAgICAgICAgICBoYXNoW2tleV0gPSB2YWx1ZVtrZXldO1xuXG4gICAgICAgICAgaWYgKGlzU3luYykge1xuICAgICAgICAgICAgdmFyIG9uID0gZGF0YS5vbiB8fCAoZGF0YS5vbiA9IHt9KTtcbiAgICAgICAgICAgIG9uWyhcInVwZGF0ZTpcIiArIGtleSldID0gZnVuY3Rpb24gKCRldmVudCkge1xuICAgICAgICAgICAgICB2YWx1ZVtrZXldID0gJGV2ZW50O1xuICAgICAgICAgICAgfTtcbiAgICAgICAgICB9XG4gICAgICAgIH1cbiAgICAgIH07XG5cbiAgICAgIGZvciAodmFyIGtleSBpbiB2YWx1ZSkgbG9vcCgga2V5ICk7XG4gICAgfVxuICB9XG4gIHJldHVybiBkYXRhXG59XG5cbi8qICAqL1xuXG4vKipcbiAqIFJ1bnRpbWUgaGVscGVyIGZvciByZW5kZXJpbmcgc3RhdGljIHRyZWVzLlxuICovXG5mdW5jdGlvbiByZW5kZXJTdGF0aWMgKFxuICBpbmRleCxcbiAgaXNJbkZvclxuKSB7XG4gIHZhciBjYWNoZWQgPSB0aGlzLl9zdGF0aWNUcmVlcyB8fCAodGhpcy5fc3RhdGljVHJlZXMgPSBbXSk7XG4gIHZhciB0cmVlID0gY2FjaGVkW2luZGV4XTtcbiAgLy8gaWYgaGFzIGFscmVhZHktcmVuZGVyZWQgc3RhdGljIHRyZWUgYW5kIG5vdCBpbnNpZGUgdi1mb3IsXG4gIC8vIHdlIGNhbiByZXVzZSB0aGUgc2FtZSB0cmVlLlxuICBpZiAodHJlZSAmJiAhaXNJbkZvcikge1xuICAgIHJldHVybiB0cmVlXG4gIH1cbiAgLy8gb3RoZXJ3aXNlLCByZW5kZXIgYSBmcmVzaCB0cmVlLlxuICB0cmVlID0gY2FjaGVkW2luZGV4XSA9IHRoaXMuJG9wdGlvbnMuc3RhdGljUmVuZGVyRm5zW2luZGV4XS5jYWxsKFxuICAgIHRoaXMuX3JlbmRlclByb3h5LFxuICAgIG51bGwsXG4gICAgdGhpcyAvLyBmb3IgcmVuZGVyIGZucyBnZW5lcmF0ZWQgZm9yIGZ1bmN0aW9uYWwgY29tcG9uZW50IHRlbXBsYXRlc1xuICApO1xuICBtYXJrU3RhdGljKHRyZWUsIChcIl9fc3RhdGljX19cIiArIGluZGV4KSwgZmFsc2UpO1xuICByZXR1cm4gdHJlZVxufVxuXG4vKipcbiAqIFJ1bnRpbWUgaGVscGVyIGZvciB2LW9uY2UuXG4gKiBFZmZlY3RpdmVseSBpdCBtZWFucyBtYXJraW5nIHRoZSBub2RlIGFzIHN0YXRpYyB3aXRoIGEgdW5pcXVlIGtleS5cbiAqL1xuZnVuY3Rpb24gbWFya09uY2UgKFxuICB0cmVlLFxuICBpbmRleCxcbiAga2V5XG4pIHtcbiAgbWFya1N0YXRpYyh0cmVlLCAoXCJfX29uY2VfX1wiICsgaW5kZXggKyAoa2V5ID8gKFwiX1wiICsga2V5KSA6IFwiXCIpKSwgdHJ1ZSk7XG4gIHJldHVybiB0cmVlXG59XG5cbmZ1bmN0aW9uIG1hcmtTdGF0aWMgKFxuICB0cmVlLFxuICBrZXksXG4gIGlzT25jZVxuKSB7XG4gIGlmIChBcnJheS5pc0FycmF5KHRyZWUpKSB7XG4gICAgZm9yICh2YXIgaSA9IDA7IGkgPCB0cmVlLmxlbmd0aDsgaSsrKSB7XG4gICAgICBpZiAodHJlZVtpXSAmJiB0eXBlb2YgdHJlZVtpXSAhPT0gJ3N0cmluZycpIHtcbiAgICAgICAgbWFya1N0YXRpY05vZGUodHJlZVtpXSwgKGtleSArIFwiX1wiICsgaSksIGlzT25jZSk7XG4gICAgICB9XG4gICAgfVxuICB9IGVsc2Uge1xuICAgIG1hcmtTdGF0aWNOb2RlKHRyZWUsIGtleSwgaXNPbmNlKTtcbiAgfVxufVxuXG5mdW5jdGlvbiBtYXJrU3RhdGljTm9kZSAobm9kZSwga2V5LCBpc09uY2UpIHtcbiAgbm9kZS5pc1N0YXRpYyA9IHRydWU7XG4gIG5vZGUua2V5ID0ga2V5O1xuICBub2RlLmlzT25jZSA9IGlzT25jZTtcbn1cblxuLyogICovXG5cbmZ1bmN0aW9uIGJpbmRPYmplY3RMaXN0ZW5lcnMgKGRhdGEsIHZhbHVlKSB7XG4gIGlmICh2YWx1ZSkge1xuICAgIGlmICghaXNQbGFpbk9iamVjdCh2YWx1ZSkpIHtcbiAgICAgIHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiYgd2FybihcbiAgICAgICAgJ3Ytb24gd2l0aG91dCBhcmd1bWVudCBleHBlY3RzIGFuIE9iamVjdCB2YWx1ZScsXG4gICAgICAgIHRoaXNcbiAgICAgICk7XG4gICAgfSBlbHNlIHtcbiAgICAgIHZhciBvbiA9IGRhdGEub24gPSBkYXRhLm9uID8gZXh0ZW5kKHt9LCBkYXRhLm9uKSA6IHt9O1xuICAgICAgZm9yICh2YXIga2V5IGluIHZhbHVlKSB7XG4gICAgICAgIHZhciBleGlzdGluZyA9IG9uW2tleV07XG4gICAgICAgIHZhciBvdXJzID0gdmFsdWVba2V5XTtcbiAgICAgICAgb25ba2V5XSA9IGV4aXN0aW5nID8gW10uY29uY2F0KGV4aXN0aW5nLCBvdXJzKSA6IG91cnM7XG4gICAgICB9XG4gICAgfVxuICB9XG4gIHJldHVybiBkYXRhXG59XG5cbi8qICAqL1xuXG5mdW5jdGlvbiBpbnN0YWxsUmVuZGVySGVscGVycyAodGFyZ2V0KSB7XG4gIHRhcmdldC5fbyA9IG1hcmtPbmNlO1xuICB0YXJnZXQuX24gPSB0b051bWJlcjtcbiAgdGFyZ2V0Ll9zID0gdG9TdHJpbmc7XG4gIHRhcmdldC5fbCA9IHJlbmRlckxpc3Q7XG4gIHRhcmdldC5fdCA9IHJlbmRlclNsb3Q7XG4gIHRhcmdldC5fcSA9IGxvb3NlRXF1YWw7XG4gIHRhcmdldC5faSA9IGxvb3NlSW5kZXhPZjtcbiAgdGFyZ2V0Ll9tID0gcmVuZGVyU3RhdGljO1xuICB0YXJnZXQuX2YgPSByZXNvbHZlRmlsdGVyO1xuICB0YXJnZXQuX2sgPSBjaGVja0tleUNvZGVzO1xuICB0YXJnZXQuX2IgPSBiaW5kT2JqZWN0UHJvcHM7XG4gIHRhcmdldC5fdiA9IGNyZWF0ZVRleHRWTm9kZTtcbiAgdGFyZ2V0Ll9lID0gY3JlYXRlRW1wdHlWTm9kZTtcbiAgdGFyZ2V0Ll91ID0gcmVzb2x2ZVNjb3BlZFNsb3RzO1xuICB0YXJnZXQuX2cgPSBiaW5kT2JqZWN0TGlzdGVuZXJzO1xufVxuXG4vKiAgKi9cblxuZnVuY3Rpb24gRnVuY3Rpb25hbFJlbmRlckNvbnRleHQgKFxuICBkYXRhLFxuICBwcm9wcyxcbiAgY2hpbGRyZW4sXG4gIHBhcmVudCxcbiAgQ3RvclxuKSB7XG4gIHZhciBvcHRpb25zID0gQ3Rvci5vcHRpb25zO1xuICAvLyBlbnN1cmUgdGhlIGNyZWF0ZUVsZW1lbnQgZnVuY3Rpb24gaW4gZnVuY3Rpb25hbCBjb21wb25lbnRzXG4gIC8vIGdldHMgYSB1bmlxdWUgY29udGV4dCAtIHRoaXMgaXMgbmVjZXNzYXJ5IGZvciBjb3JyZWN0IG5hbWVkIHNsb3QgY2hlY2tcbiAgdmFyIGNvbnRleHRWbTtcbiAgaWYgKGhhc093bihwYXJlbnQsICdfdWlkJykpIHtcbiAgICBjb250ZXh0Vm0gPSBPYmplY3QuY3JlYXRlKHBhcmVudCk7XG4gICAgLy8gJGZsb3ctZGlzYWJsZS1saW5lXG4gICAgY29udGV4dFZtLl9vcmlnaW5hbCA9IHBhcmVudDtcbiAgfSBlbHNlIHtcbiAgICAvLyB0aGUgY29udGV4dCB2bSBwYXNzZWQgaW4gaXMgYSBmdW5jdGlvbmFsIGNvbnRleHQgYXMgd2VsbC5cbiAgICAvLyBpbiB0aGlzIGNhc2Ugd2Ugd2FudCB0byBtYWtlIHN1cmUgd2UgYXJlIGFibGUgdG8gZ2V0IGEgaG9sZCB0byB0aGVcbiAgICAvLyByZWFsIGNvbnRleHQgaW5zdGFuY2UuXG4gICAgY29udGV4dFZtID0gcGFyZW50O1xuICAgIC8vICRmbG93LWRpc2FibGUtbGluZVxuICAgIHBhcmVudCA9IHBhcmVudC5fb3JpZ2luYWw7XG4gIH1cbiAgdmFyIGlzQ29tcGlsZWQgPSBpc1RydWUob3B0aW9ucy5fY29tcGlsZWQpO1xuICB2YXIgbmVlZE5vcm1hbGl6YXRpb24gPSAhaXNDb21waWxlZDtcblxuICB0aGlzLmRhdGEgPSBkYXRhO1xuICB0aGlzLnByb3BzID0gcHJvcHM7XG4gIHRoaXMuY2hpbGRyZW4gPSBjaGlsZHJlbjtcbiAgdGhpcy5wYXJlbnQgPSBwYXJlbnQ7XG4gIHRoaXMubGlzdGVuZXJzID0gZGF0YS5vbiB8fCBlbXB0eU9iamVjdDtcbiAgdGhpcy5pbmplY3Rpb25zID0gcmVzb2x2ZUluamVjdChvcHRpb25zLmluamVjdCwgcGFyZW50KTtcbiAgdGhpcy5zbG90cyA9IGZ1bmN0aW9uICgpIHsgcmV0dXJuIHJlc29sdmVTbG90cyhjaGlsZHJlbiwgcGFyZW50KTsgfTtcblxuICAvLyBzdXBwb3J0IGZvciBjb21waWxlZCBmdW5jdGlvbmFsIHRlbXBsYXRlXG4gIGlmIChpc0NvbXBpbGVkKSB7XG4gICAgLy8gZXhwb3NpbmcgJG9wdGlvbnMgZm9yIHJlbmRlclN0YXRpYygpXG4gICAgdGhpcy4kb3B0aW9ucyA9IG9wdGlvbnM7XG4gICAgLy8gcHJlLXJlc29sdmUgc2xvdHMgZm9yIHJlbmRlclNsb3QoKVxuICAgIHRoaXMuJHNsb3RzID0gdGhpcy5zbG90cygpO1xuICAgIHRoaXMuJHNjb3BlZFNsb3RzID0gZGF0YS5zY29wZWRTbG90cyB8fCBlbXB0eU9iamVjdDtcbiAgfVxuXG4gIGlmIChvcHRpb25zLl9zY29wZUlkKSB7XG4gICAgdGhpcy5fYyA9IGZ1bmN0aW9uIChhLCBiLCBjLCBkKSB7XG4gICAgICB2YXIgdm5vZGUgPSBjcmVhdGVFbGVtZW50KGNvbnRleHRWbSwgYSwgYiwgYywgZCwgbmVlZE5vcm1hbGl6YXRpb24pO1xuICAgICAgaWYgKHZub2RlICYmICFBcnJheS5pc0FycmF5KHZub2RlKSkge1xuICAgICAgICB2bm9kZS5mblNjb3BlSWQgPSBvcHRpb25zLl9zY29wZUlkO1xuICAgICAgICB2bm9kZS5mbkNvbnRleHQgPSBwYXJlbnQ7XG4gICAgICB9XG4gICAgICByZXR1cm4gdm5vZGVcbiAgICB9O1xuICB9IGVsc2Uge1xuICAgIHRoaXMuX2MgPSBmdW5jdGlvbiAoYSwgYiwgYywgZCkgeyByZXR1cm4gY3JlYXRlRWxlbWVudChjb250ZXh0Vm0sIGEsIGIsIGMsIGQsIG5lZWROb3JtYWxpemF0aW9uKTsgfTtcbiAgfVxufVxuXG5pbnN0YWxsUmVuZGVySGVscGVycyhGdW5jdGlvbmFsUmVuZGVyQ29udGV4dC5wcm90b3R5cGUpO1xuXG5mdW5jdGlvbiBjcmVhdGVGdW5jdGlvbmFsQ29tcG9uZW50IChcbiAgQ3RvcixcbiAgcHJvcHNEYXRhLFxuICBkYXRhLFxuICBjb250ZXh0Vm0sXG4gIGNoaWxkcmVuXG4pIHtcbiAgdmFyIG9wdGlvbnMgPSBDdG9yLm9wdGlvbnM7XG4gIHZhciBwcm9wcyA9IHt9O1xuICB2YXIgcHJvcE9wdGlvbnMgPSBvcHRpb25zLnByb3BzO1xuICBpZiAoaXNEZWYocHJvcE9wdGlvbnMpKSB7XG4gICAgZm9yICh2YXIga2V5IGluIHByb3BPcHRpb25zKSB7XG4gICAgICBwcm9wc1trZXldID0gdmFsaWRhdGVQcm9wKGtleSwgcHJvcE9wdGlvbnMsIHByb3BzRGF0YSB8fCBlbXB0eU9iamVjdCk7XG4gICAgfVxuICB9IGVsc2Uge1xuICAgIGlmIChpc0RlZihkYXRhLmF0dHJzKSkgeyBtZXJnZVByb3BzKHByb3BzLCBkYXRhLmF0dHJzKTsgfVxuICAgIGlmIChpc0RlZihkYXRhLnByb3BzKSkgeyBtZXJnZVByb3BzKHByb3BzLCBkYXRhLnByb3BzKTsgfVxuICB9XG5cbiAgdmFyIHJlbmRlckNvbnRleHQgPSBuZXcgRnVuY3Rpb25hbFJlbmRlckNvbnRleHQoXG4gICAgZGF0YSxcbiAgICBwcm9wcyxcbiAgICBjaGlsZHJlbixcbiAgICBjb250ZXh0Vm0sXG4gICAgQ3RvclxuICApO1xuXG4gIHZhciB2bm9kZSA9IG9wdGlvbnMucmVuZGVyLmNhbGwobnVsbCwgcmVuZGVyQ29udGV4dC5fYywgcmVuZGVyQ29udGV4dCk7XG5cbiAgaWYgKHZub2RlIGluc3RhbmNlb2YgVk5vZGUpIHtcbiAgICByZXR1cm4gY2xvbmVBbmRNYXJrRnVuY3Rpb25hbFJlc3VsdCh2bm9kZSwgZGF0YSwgcmVuZGVyQ29udGV4dC5wYXJlbnQsIG9wdGlvbnMpXG4gIH0gZWxzZSBpZiAoQXJyYXkuaXNBcnJheSh2bm9kZSkpIHtcbiAgICB2YXIgdm5vZGVzID0gbm9ybWFsaXplQ2hpbGRyZW4odm5vZGUpIHx8IFtdO1xuICAgIHZhciByZXMgPSBuZXcgQXJyYXkodm5vZGVzLmxlbmd0aCk7XG4gICAgZm9yICh2YXIgaSA9IDA7IGkgPCB2bm9kZXMubGVuZ3RoOyBpKyspIHtcbiAgICAgIHJlc1tpXSA9IGNsb25lQW5kTWFya0Z1bmN0aW9uYWxSZXN1bHQodm5vZGVzW2ldLCBkYXRhLCByZW5kZXJDb250ZXh0LnBhcmVudCwgb3B0aW9ucyk7XG4gICAgfVxuICAgIHJldHVybiByZXNcbiAgfVxufVxuXG5mdW5jdGlvbiBjbG9uZUFuZE1hcmtGdW5jdGlvbmFsUmVzdWx0ICh2bm9kZSwgZGF0YSwgY29udGV4dFZtLCBvcHRpb25zKSB7XG4gIC8vICM3ODE3IGNsb25lIG5vZGUgYmVmb3JlIHNldHRpbmcgZm5Db250ZXh0LCBvdGhlcndpc2UgaWYgdGhlIG5vZGUgaXMgcmV1c2VkXG4gIC8vIChlLmcuIGl0IHdhcyBmcm9tIGEgY2FjaGVkIG5vcm1hbCBzbG90KSB0aGUgZm5Db250ZXh0IGNhdXNlcyBuYW1lZCBzbG90c1xuICAvLyB0aGF0IHNob3VsZCBub3QgYmUgbWF0Y2hlZCB0byBtYXRjaC5cbiAgdmFyIGNsb25lID0gY2xvbmVWTm9kZSh2bm9kZSk7XG4gIGNsb25lLmZuQ29udGV4dCA9IGNvbnRleHRWbTtcbiAgY2xvbmUuZm5PcHRpb25zID0gb3B0aW9ucztcbiAgaWYgKGRhdGEuc2xvdCkge1xuICAgIChjbG9uZS5kYXRhIHx8IChjbG9uZS5kYXRhID0ge30pKS5zbG90ID0gZGF0YS5zbG90O1xuICB9XG4gIHJldHVybiBjbG9uZVxufVxuXG5mdW5jdGlvbiBtZXJnZVByb3BzICh0bywgZnJvbSkge1xuICBmb3IgKHZhciBrZXkgaW4gZnJvbSkge1xuICAgIHRvW2NhbWVsaXplKGtleSldID0gZnJvbVtrZXldO1xuICB9XG59XG5cbi8qICAqL1xuXG5cblxuXG4vLyBSZWdpc3RlciB0aGUgY29tcG9uZW50IGhvb2sgdG8gd2VleCBuYXRpdmUgcmVuZGVyIGVuZ2luZS5cbi8vIFRoZSBob29rIHdpbGwgYmUgdHJpZ2dlcmVkIGJ5IG5hdGl2ZSwgbm90IGphdmFzY3JpcHQuXG5cblxuLy8gVXBkYXRlcyB0aGUgc3RhdGUgb2YgdGhlIGNvbXBvbmVudCB0byB3ZWV4IG5hdGl2ZSByZW5kZXIgZW5naW5lLlxuXG4vKiAgKi9cblxuLy8gaHR0cHM6Ly9naXRodWIuY29tL0hhbmtzMTAxMDAvd2VleC1uYXRpdmUtZGlyZWN0aXZlL3RyZWUvbWFzdGVyL2NvbXBvbmVudFxuXG4vLyBsaXN0ZW5pbmcgb24gbmF0aXZlIGNhbGxiYWNrXG5cbi8qICAqL1xuXG4vKiAgKi9cblxuLy8gaW5saW5lIGhvb2tzIHRvIGJlIGludm9rZWQgb24gY29tcG9uZW50IFZOb2RlcyBkdXJpbmcgcGF0Y2hcbnZhciBjb21wb25lbnRWTm9kZUhvb2tzID0ge1xuICBpbml0OiBmdW5jdGlvbiBpbml0IChcbiAgICB2bm9kZSxcbiAgICBoeWRyYXRpbmcsXG4gICAgcGFyZW50RWxtLFxuICAgIHJlZkVsbVxuICApIHtcbiAgICBpZiAoXG4gICAgICB2bm9kZS5jb21wb25lbnRJbnN0YW5jZSAmJlxuICAgICAgIXZub2RlLmNvbXBvbmVudEluc3RhbmNlLl9pc0Rlc3Ryb3llZCAmJlxuICAgICAgdm5vZGUuZGF0YS5rZWVwQWxpdmVcbiAgICApIHtcbiAgICAgIC8vIGtlcHQtYWxpdmUgY29tcG9uZW50cywgdHJlYXQgYXMgYSBwYXRjaFxuICAgICAgdmFyIG1vdW50ZWROb2RlID0gdm5vZGU7IC8vIHdvcmsgYXJvdW5kIGZsb3dcbiAgICAgIGNvbXBvbmVudFZOb2RlSG9va3MucHJlcGF0Y2gobW91bnRlZE5vZGUsIG1vdW50ZWROb2RlKTtcbiAgICB9IGVsc2Uge1xuICAgICAgdmFyIGNoaWxkID0gdm5vZGUuY29tcG9uZW50SW5zdGFuY2UgPSBjcmVhdGVDb21wb25lbnRJbnN0YW5jZUZvclZub2RlKFxuICAgICAgICB2bm9kZSxcbiAgICAgICAgYWN0aXZlSW5zdGFuY2UsXG4gICAgICAgIHBhcmVudEVsbSxcbiAgICAgICAgcmVmRWxtXG4gICAgICApO1xuICAgICAgY2hpbGQuJG1vdW50KGh5ZHJhdGluZyA/IHZub2RlLmVsbSA6IHVuZGVmaW5lZCwgaHlkcmF0aW5nKTtcbiAgICB9XG4gIH0sXG5cbiAgcHJlcGF0Y2g6IGZ1bmN0aW9uIHByZXBhdGNoIChvbGRWbm9kZSwgdm5vZGUpIHtcbiAgICB2YXIgb3B0aW9ucyA9IHZub2RlLmNvbXBvbmVudE9wdGlvbnM7XG4gICAgdmFyIGNoaWxkID0gdm5vZGUuY29tcG9uZW50SW5zdGFuY2UgPSBvbGRWbm9kZS5jb21wb25lbnRJbnN0YW5jZTtcbiAgICB1cGRhdGVDaGlsZENvbXBvbmVudChcbiAgICAgIGNoaWxkLFxuICAgICAgb3B0aW9ucy5wcm9wc0RhdGEsIC8vIHVwZGF0ZWQgcHJvcHNcbiAgICAgIG9wdGlvbnMubGlzdGVuZXJzLCAvLyB1cGRhdGVkIGxpc3RlbmVyc1xuICAgICAgdm5vZGUsIC8vIG5ldyBwYXJlbnQgdm5vZGVcbiAgICAgIG9wdGlvbnMuY2hpbGRyZW4gLy8gbmV3IGNoaWxkcmVuXG4gICAgKTtcbiAgfSxcblxuICBpbnNlcnQ6IGZ1bmN0aW9uIGluc2VydCAodm5vZGUpIHtcbiAgICB2YXIgY29udGV4dCA9IHZub2RlLmNvbnRleHQ7XG4gICAgdmFyIGNvbXBvbmVudEluc3RhbmNlID0gdm5vZGUuY29tcG9uZW50SW5zdGFuY2U7XG4gICAgaWYgKCFjb21wb25lbnRJbnN0YW5jZS5faXNNb3VudGVkKSB7XG4gICAgICBjb21wb25lbnRJbnN0YW5jZS5faXNNb3VudGVkID0gdHJ1ZTtcbiAgICAgIGNhbGxIb29rKGNvbXBvbmVudEluc3RhbmNlLCAnbW91bnRlZCcpO1xuICAgIH1cbiAgICBpZiAodm5vZGUuZGF0YS5rZWVwQWxpdmUpIHtcbiAgICAgIGlmIChjb250ZXh0Ll9pc01vdW50ZWQpIHtcbiAgICAgICAgLy8gdnVlLXJvdXRlciMxMjEyXG4gICAgICAgIC8vIER1cmluZyB1cGRhdGVzLCBhIGtlcHQtYWxpdmUgY29tcG9uZW50J3MgY2hpbGQgY29tcG9uZW50cyBtYXlcbiAgICAgICAgLy8gY2hhbmdlLCBzbyBkaXJlY3RseSB3YWxraW5nIHRoZSB0cmVlIGhlcmUgbWF5IGNhbGwgYWN0aXZhdGVkIGhvb2tzXG4gICAgICAgIC8vIG9uIGluY29ycmVjdCBjaGlsZHJlbi4gSW5zdGVhZCB3ZSBwdXNoIHRoZW0gaW50byBhIHF1ZXVlIHdoaWNoIHdpbGxcbiAgICAgICAgLy8gYmUgcHJvY2Vzc2VkIGFmdGVyIHRoZSB3aG9sZSBwYXRjaCBwcm9jZXNzIGVuZGVkLlxuICAgICAgICBxdWV1ZUFjdGl2YXRlZENvbXBvbmVudChjb21wb25lbnRJbnN0YW5jZSk7XG4gICAgICB9IGVsc2Uge1xuICAgICAgICBhY3RpdmF0ZUNoaWxkQ29tcG9uZW50KGNvbXBvbmVudEluc3RhbmNlLCB0cnVlIC8qIGRpcmVjdCAqLyk7XG4gICAgICB9XG4gICAgfVxuICB9LFxuXG4gIGRlc3Ryb3k6IGZ1bmN0aW9uIGRlc3Ryb3kgKHZub2RlKSB7XG4gICAgdmFyIGNvbXBvbmVudEluc3RhbmNlID0gdm5vZGUuY29tcG9uZW50SW5zdGFuY2U7XG4gICAgaWYgKCFjb21wb25lbnRJbnN0YW5jZS5faXNEZXN0cm95ZWQpIHtcbiAgICAgIGlmICghdm5vZGUuZGF0YS5rZWVwQWxpdmUpIHtcbiAgICAgICAgY29tcG9uZW50SW5zdGFuY2UuJGRlc3Ryb3koKTtcbiAgICAgIH0gZWxzZSB7XG4gICAgICAgIGRlYWN0aXZhdGVDaGlsZENvbXBvbmVudChjb21wb25lbnRJbnN0YW5jZSwgdHJ1ZSAvKiBkaXJlY3QgKi8pO1xuICAgICAgfVxuICAgIH1cbiAgfVxufTtcblxudmFyIGhvb2tzVG9NZXJnZSA9IE9iamVjdC5rZXlzKGNvbXBvbmVudFZOb2RlSG9va3MpO1xuXG5mdW5jdGlvbiBjcmVhdGVDb21wb25lbnQgKFxuICBDdG9yLFxuICBkYXRhLFxuICBjb250ZXh0LFxuICBjaGlsZHJlbixcbiAgdGFnXG4pIHtcbiAgaWYgKGlzVW5kZWYoQ3RvcikpIHtcbiAgICByZXR1cm5cbiAgfVxuXG4gIHZhciBiYXNlQ3RvciA9IGNvbnRleHQuJG9wdGlvbnMuX2Jhc2U7XG5cbiAgLy8gcGxhaW4gb3B0aW9ucyBvYmplY3Q6IHR1cm4gaXQgaW50byBhIGNvbnN0cnVjdG9yXG4gIGlmIChpc09iamVjdChDdG9yKSkge1xuICAgIEN0b3IgPSBiYXNlQ3Rvci5leHRlbmQoQ3Rvcik7XG4gIH1cblxuICAvLyBpZiBhdCB0aGlzIHN0YWdlIGl0J3Mgbm90IGEgY29uc3RydWN0b3Igb3IgYW4gYXN5bmMgY29tcG9uZW50IGZhY3RvcnksXG4gIC8vIHJlamVjdC5cbiAgaWYgKHR5cGVvZiBDdG9yICE9PSAnZnVuY3Rpb24nKSB7XG4gICAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicpIHtcbiAgICAgIHdhcm4oKFwiSW52YWxpZCBDb21wb25lbnQgZGVmaW5pdGlvbjogXCIgKyAoU3RyaW5nKEN0b3IpKSksIGNvbnRleHQpO1xuICAgIH1cbiAgICByZXR1cm5cbiAgfVxuXG4gIC8vIGFzeW5jIGNvbXBvbmVudFxuICB2YXIgYXN5bmNGYWN0b3J5O1xuICBpZiAoaXNVbmRlZihDdG9yLmNpZCkpIHtcbiAgICBhc3luY0ZhY3RvcnkgPSBDdG9yO1xuICAgIEN0b3IgPSByZXNvbHZlQXN5bmNDb21wb25lbnQoYXN5bmNGYWN0b3J5LCBiYXNlQ3RvciwgY29udGV4dCk7XG4gICAgaWYgKEN0b3IgPT09IHVuZGVmaW5lZCkge1xuICAgICAgLy8gcmV0dXJuIGEgcGxhY2Vob2xkZXIgbm9kZSBmb3IgYXN5bmMgY29tcG9uZW50LCB3aGljaCBpcyByZW5kZXJlZFxuICAgICAgLy8gYXMgYSBjb21tZW50IG5vZGUgYnV0IHByZXNlcnZlcyBhbGwgdGhlIHJhdyBpbmZvcm1hdGlvbiBmb3IgdGhlIG5vZGUuXG4gICAgICAvLyB0aGUgaW5mb3JtYXRpb24gd2lsbCBiZSB1c2VkIGZvciBhc3luYyBzZXJ2ZXItcmVuZGVyaW5nIGFuZCBoeWRyYXRpb24uXG4gICAgICByZXR1cm4gY3JlYXRlQXN5bmNQbGFjZWhvbGRlcihcbiAgICAgICAgYXN5bmNGYWN0b3J5LFxuICAgICAgICBkYXRhLFxuICAgICAgICBjb250ZXh0LFxuICAgICAgICBjaGlsZHJlbixcbiAgICAgICAgdGFnXG4gICAgICApXG4gICAgfVxuICB9XG5cbiAgZGF0YSA9IGRhdGEgfHwge307XG5cbiAgLy8gcmVzb2x2ZSBjb25zdHJ1Y3RvciBvcHRpb25zIGluIGNhc2UgZ2xvYmFsIG1peGlucyBhcmUgYXBwbGllZCBhZnRlclxuICAvLyBjb21wb25lbnQgY29uc3RydWN0b3IgY3JlYXRpb25cbiAgcmVzb2x2ZUNvbnN0cnVjdG9yT3B0aW9ucyhDdG9yKTtcblxuICAvLyB0cmFuc2Zvcm0gY29tcG9uZW50IHYtbW9kZWwgZGF0YSBpbnRvIHByb3BzICYgZXZlbnRzXG4gIGlmIChpc0RlZihkYXRhLm1vZGVsKSkge1xuICAgIHRyYW5zZm9ybU1vZGVsKEN0b3Iub3B0aW9ucywgZGF0YSk7XG4gIH1cblxuICAvLyBleHRyYWN0IHByb3BzXG4gIHZhciBwcm9wc0RhdGEgPSBleHRyYWN0UHJvcHNGcm9tVk5vZGVEYXRhKGRhdGEsIEN0b3IsIHRhZyk7XG5cbiAgLy8gZnVuY3Rpb25hbCBjb21wb25lbnRcbiAgaWYgKGlzVHJ1ZShDdG9yLm9wdGlvbnMuZnVuY3Rpb25hbCkpIHtcbiAgICByZXR1cm4gY3JlYXRlRnVuY3Rpb25hbENvbXBvbmVudChDdG9yLCBwcm9wc0RhdGEsIGRhdGEsIGNvbnRleHQsIGNoaWxkcmVuKVxuICB9XG5cbiAgLy8gZXh0cmFjdCBsaXN0ZW5lcnMsIHNpbmNlIHRoZXNlIG5lZWRzIHRvIGJlIHRyZWF0ZWQgYXNcbiAgLy8gY2hpbGQgY29tcG9uZW50IGxpc3RlbmVycyBpbnN0ZWFkIG9mIERPTSBsaXN0ZW5lcnNcbiAgdmFyIGxpc3RlbmVycyA9IGRhdGEub247XG4gIC8vIHJlcGxhY2Ugd2l0aCBsaXN0ZW5lcnMgd2l0aCAubmF0aXZlIG1vZGlmaWVyXG4gIC8vIHNvIGl0IGdldHMgcHJvY2Vzc2VkIGR1cmluZyBwYXJlbnQgY29tcG9uZW50IHBhdGNoLlxuICBkYXRhLm9uID0gZGF0YS5uYXRpdmVPbjtcblxuICBpZiAoaXNUcnVlKEN0b3Iub3B0aW9ucy5hYnN0cmFjdCkpIHtcbiAgICAvLyBhYnN0cmFjdCBjb21wb25lbnRzIGRvIG5vdCBrZWVwIGFueXRoaW5nXG4gICAgLy8gb3RoZXIgdGhhbiBwcm9wcyAmIGxpc3RlbmVycyAmIHNsb3RcblxuICAgIC8vIHdvcmsgYXJvdW5kIGZsb3dcbiAgICB2YXIgc2xvdCA9IGRhdGEuc2xvdDtcbiAgICBkYXRhID0ge307XG4gICAgaWYgKHNsb3QpIHtcbiAgICAgIGRhdGEuc2xvdCA9IHNsb3Q7XG4gICAgfVxuICB9XG5cbiAgLy8gaW5zdGFsbCBjb21wb25lbnQgbWFuYWdlbWVudCBob29rcyBvbnRvIHRoZSBwbGFjZWhvbGRlciBub2RlXG4gIGluc3RhbGxDb21wb25lbnRIb29rcyhkYXRhKTtcblxuICAvLyByZXR1cm4gYSBwbGFjZWhvbGRlciB2bm9kZVxuICB2YXIgbmFtZSA9IEN0b3Iub3B0aW9ucy5uYW1lIHx8IHRhZztcbiAgdmFyIHZub2RlID0gbmV3IFZOb2RlKFxuICAgIChcInZ1ZS1jb21wb25lbnQtXCIgKyAoQ3Rvci5jaWQpICsgKG5hbWUgPyAoXCItXCIgKyBuYW1lKSA6ICcnKSksXG4gICAgZGF0YSwgdW5kZWZpbmVkLCB1bmRlZmluZWQsIHVuZGVmaW5lZCwgY29udGV4dCxcbiAgICB7IEN0b3I6IEN0b3IsIHByb3BzRGF0YTogcHJvcHNEYXRhLCBsaXN0ZW5lcnM6IGxpc3RlbmVycywgdGFnOiB0YWcsIGNoaWxkcmVuOiBjaGlsZHJlbiB9LFxuICAgIGFzeW5jRmFjdG9yeVxuICApO1xuXG4gIC8vIFdlZXggc3BlY2lmaWM6IGludm9rZSByZWN5Y2xlLWxpc3Qgb3B0aW1pemVkIEByZW5kZXIgZnVuY3Rpb24gZm9yXG4gIC8vIGV4dHJhY3RpbmcgY2VsbC1zbG90IHRlbXBsYXRlLlxuICAvLyBodHRwczovL2dpdGh1Yi5jb20vSGFua3MxMDEwMC93ZWV4LW5hdGl2ZS1kaXJlY3RpdmUvdHJlZS9tYXN0ZXIvY29tcG9uZW50XG4gIC8qIGlzdGFuYnVsIGlnbm9yZSBpZiAqL1xuICByZXR1cm4gdm5vZGVcbn1cblxuZnVuY3Rpb24gY3JlYXRlQ29tcG9uZW50SW5zdGFuY2VGb3JWbm9kZSAoXG4gIHZub2RlLCAvLyB3ZSBrbm93IGl0J3MgTW91bnRlZENvbXBvbmVudFZOb2RlIGJ1dCBmbG93IGRvZXNuJ3RcbiAgcGFyZW50LCAvLyBhY3RpdmVJbnN0YW5jZSBpbiBsaWZlY3ljbGUgc3RhdGVcbiAgcGFyZW50RWxtLFxuICByZWZFbG1cbikge1xuICB2YXIgb3B0aW9ucyA9IHtcbiAgICBfaXNDb21wb25lbnQ6IHRydWUsXG4gICAgcGFyZW50OiBwYXJlbnQsXG4gICAgX3BhcmVudFZub2RlOiB2bm9kZSxcbiAgICBfcGFyZW50RWxtOiBwYXJlbnRFbG0gfHwgbnVsbCxcbiAgICBfcmVmRWxtOiByZWZFbG0gfHwgbnVsbFxuICB9O1xuICAvLyBjaGVjayBpbmxpbmUtdGVtcGxhdGUgcmVuZGVyIGZ1bmN0aW9uc1xuICB2YXIgaW5saW5lVGVtcGxhdGUgPSB2bm9kZS5kYXRhLmlubGluZVRlbXBsYXRlO1xuICBpZiAoaXNEZWYoaW5saW5lVGVtcGxhdGUpKSB7XG4gICAgb3B0aW9ucy5yZW5kZXIgPSBpbmxpbmVUZW1wbGF0ZS5yZW5kZXI7XG4gICAgb3B0aW9ucy5zdGF0aWNSZW5kZXJGbnMgPSBpbmxpbmVUZW1wbGF0ZS5zdGF0aWNSZW5kZXJGbnM7XG4gIH1cbiAgcmV0dXJuIG5ldyB2bm9kZS5jb21wb25lbnRPcHRpb25zLkN0b3Iob3B0aW9ucylcbn1cblxuZnVuY3Rpb24gaW5zdGFsbENvbXBvbmVudEhvb2tzIChkYXRhKSB7XG4gIHZhciBob29rcyA9IGRhdGEuaG9vayB8fCAoZGF0YS5ob29rID0ge30pO1xuICBmb3IgKHZhciBpID0gMDsgaSA8IGhvb2tzVG9NZXJnZS5sZW5ndGg7IGkrKykge1xuICAgIHZhciBrZXkgPSBob29rc1RvTWVyZ2VbaV07XG4gICAgaG9va3Nba2V5XSA9IGNvbXBvbmVudFZOb2RlSG9va3Nba2V5XTtcbiAgfVxufVxuXG4vLyB0cmFuc2Zvcm0gY29tcG9uZW50IHYtbW9kZWwgaW5mbyAodmFsdWUgYW5kIGNhbGxiYWNrKSBpbnRvXG4vLyBwcm9wIGFuZCBldmVudCBoYW5kbGVyIHJlc3BlY3RpdmVseS5cbmZ1bmN0aW9uIHRyYW5zZm9ybU1vZGVsIChvcHRpb25zLCBkYXRhKSB7XG4gIHZhciBwcm9wID0gKG9wdGlvbnMubW9kZWwgJiYgb3B0aW9ucy5tb2RlbC5wcm9wKSB8fCAndmFsdWUnO1xuICB2YXIgZXZlbnQgPSAob3B0aW9ucy5tb2RlbCAmJiBvcHRpb25zLm1vZGVsLmV2ZW50KSB8fCAnaW5wdXQnOyhkYXRhLnByb3BzIHx8IChkYXRhLnByb3BzID0ge30pKVtwcm9wXSA9IGRhdGEubW9kZWwudmFsdWU7XG4gIHZhciBvbiA9IGRhdGEub24gfHwgKGRhdGEub24gPSB7fSk7XG4gIGlmIChpc0RlZihvbltldmVudF0pKSB7XG4gICAgb25bZXZlbnRdID0gW2RhdGEubW9kZWwuY2FsbGJhY2tdLmNvbmNhdChvbltldmVudF0pO1xuICB9IGVsc2Uge1xuICAgIG9uW2V2ZW50XSA9IGRhdGEubW9kZWwuY2FsbGJhY2s7XG4gIH1cbn1cblxuLyogICovXG5cbnZhciBTSU1QTEVfTk9STUFMSVpFID0gMTtcbnZhciBBTFdBWVNfTk9STUFMSVpFID0gMjtcblxuLy8gd3JhcHBlciBmdW5jdGlvbiBmb3IgcHJvdmlkaW5nIGEgbW9yZSBmbGV4aWJsZSBpbnRlcmZhY2Vcbi8vIHdpdGhvdXQgZ2V0dGluZyB5ZWxsZWQgYXQgYnkgZmxvd1xuZnVuY3Rpb24gY3JlYXRlRWxlbWVudCAoXG4gIGNvbnRleHQsXG4gIHRhZyxcbiAgZGF0YSxcbiAgY2hpbGRyZW4sXG4gIG5vcm1hbGl6YXRpb25UeXBlLFxuICBhbHdheXNOb3JtYWxpemVcbikge1xuICBpZiAoQXJyYXkuaXNBcnJheShkYXRhKSB8fCBpc1ByaW1pdGl2ZShkYXRhKSkge1xuICAgIG5vcm1hbGl6YXRpb25UeXBlID0gY2hpbGRyZW47XG4gICAgY2hpbGRyZW4gPSBkYXRhO1xuICAgIGRhdGEgPSB1bmRlZmluZWQ7XG4gIH1cbiAgaWYgKGlzVHJ1ZShhbHdheXNOb3JtYWxpemUpKSB7XG4gICAgbm9ybWFsaXphdGlvblR5cGUgPSBBTFdBWVNfTk9STUFMSVpFO1xuICB9XG4gIHJldHVybiBfY3JlYXRlRWxlbWVudChjb250ZXh0LCB0YWcsIGRhdGEsIGNoaWxkcmVuLCBub3JtYWxpemF0aW9uVHlwZSlcbn1cblxuZnVuY3Rpb24gX2NyZWF0ZUVsZW1lbnQgKFxuICBjb250ZXh0LFxuICB0YWcsXG4gIGRhdGEsXG4gIGNoaWxkcmVuLFxuICBub3JtYWxpemF0aW9uVHlwZVxuKSB7XG4gIGlmIChpc0RlZihkYXRhKSAmJiBpc0RlZigoZGF0YSkuX19vYl9fKSkge1xuICAgIHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiYgd2FybihcbiAgICAgIFwiQXZvaWQgdXNpbmcgb2JzZXJ2ZWQgZGF0YSBvYmplY3QgYXMgdm5vZGUgZGF0YTogXCIgKyAoSlNPTi5zdHJpbmdpZnkoZGF0YSkpICsgXCJcXG5cIiArXG4gICAgICAnQWx3YXlzIGNyZWF0ZSBmcmVzaCB2bm9kZSBkYXRhIG9iamVjdHMgaW4gZWFjaCByZW5kZXIhJyxcbiAgICAgIGNvbnRleHRcbiAgICApO1xuICAgIHJldHVybiBjcmVhdGVFbXB0eVZOb2RlKClcbiAgfVxuICAvLyBvYmplY3Qgc3ludGF4IGluIHYtYmluZFxuICBpZiAoaXNEZWYoZGF0YSkgJiYgaXNEZWYoZGF0YS5pcykpIHtcbiAgICB0YWcgPSBkYXRhLmlzO1xuICB9XG4gIGlmICghdGFnKSB7XG4gICAgLy8gaW4gY2FzZSBvZiBjb21wb25lbnQgOmlzIHNldCB0byBmYWxzeSB2YWx1ZVxuICAgIHJldHVybiBjcmVhdGVFbXB0eVZOb2RlKClcbiAgfVxuICAvLyB3YXJuIGFnYWluc3Qgbm9uLXByaW1pdGl2ZSBrZXlcbiAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiZcbiAgICBpc0RlZihkYXRhKSAmJiBpc0RlZihkYXRhLmtleSkgJiYgIWlzUHJpbWl0aXZlKGRhdGEua2V5KVxuICApIHtcbiAgICB7XG4gICAgICB3YXJuKFxuICAgICAgICAnQXZvaWQgdXNpbmcgbm9uLXByaW1pdGl2ZSB2YWx1ZSBhcyBrZXksICcgK1xuICAgICAgICAndXNlIHN0cmluZy9udW1iZXIgdmFsdWUgaW5zdGVhZC4nLFxuICAgICAgICBjb250ZXh0XG4gICAgICApO1xuICAgIH1cbiAgfVxuICAvLyBzdXBwb3J0IHNpbmdsZSBmdW5jdGlvbiBjaGlsZHJlbiBhcyBkZWZhdWx0IHNjb3BlZCBzbG90XG4gIGlmIChBcnJheS5pc0FycmF5KGNoaWxkcmVuKSAmJlxuICAgIHR5cGVvZiBjaGlsZHJlblswXSA9PT0gJ2Z1bmN0aW9uJ1xuICApIHtcbiAgICBkYXRhID0gZGF0YSB8fCB7fTtcbiAgICBkYXRhLnNjb3BlZFNsb3RzID0geyBkZWZhdWx0OiBjaGlsZHJlblswXSB9O1xuICAgIGNoaWxkcmVuLmxlbmd0aCA9IDA7XG4gIH1cbiAgaWYgKG5vcm1hbGl6YXRpb25UeXBlID09PSBBTFdBWVNfTk9STUFMSVpFKSB7XG4gICAgY2hpbGRyZW4gPSBub3JtYWxpemVDaGlsZHJlbihjaGlsZHJlbik7XG4gIH0gZWxzZSBpZiAobm9ybWFsaXphdGlvblR5cGUgPT09IFNJTVBMRV9OT1JNQUxJWkUpIHtcbiAgICBjaGlsZHJlbiA9IHNpbXBsZU5vcm1hbGl6ZUNoaWxkcmVuKGNoaWxkcmVuKTtcbiAgfVxuICB2YXIgdm5vZGUsIG5zO1xuICBpZiAodHlwZW9mIHRhZyA9PT0gJ3N0cmluZycpIHtcbiAgICB2YXIgQ3RvcjtcbiAgICBucyA9IChjb250ZXh0LiR2bm9kZSAmJiBjb250ZXh0LiR2bm9kZS5ucykgfHwgY29uZmlnLmdldFRhZ05hbWVzcGFjZSh0YWcpO1xuICAgIGlmIChjb25maWcuaXNSZXNlcnZlZFRhZyh0YWcpKSB7XG4gICAgICAvLyBwbGF0Zm9ybSBidWlsdC1pbiBlbGVtZW50c1xuICAgICAgdm5vZGUgPSBuZXcgVk5vZGUoXG4gICAgICAgIGNvbmZpZy5wYXJzZVBsYXRmb3JtVGFnTmFtZSh0YWcpLCBkYXRhLCBjaGlsZHJlbixcbiAgICAgICAgdW5kZWZpbmVkLCB1bmRlZmluZWQsIGNvbnRleHRcbiAgICAgICk7XG4gICAgfSBlbHNlIGlmIChpc0RlZihDdG9yID0gcmVzb2x2ZUFzc2V0KGNvbnRleHQuJG9wdGlvbnMsICdjb21wb25lbnRzJywgdGFnKSkpIHtcbiAgICAgIC8vIGNvbXBvbmVudFxuICAgICAgdm5vZGUgPSBjcmVhdGVDb21wb25lbnQoQ3RvciwgZGF0YSwgY29udGV4dCwgY2hpbGRyZW4sIHRhZyk7XG4gICAgfSBlbHNlIHtcbiAgICAgIC8vIHVua25vd24gb3IgdW5saXN0ZWQgbmFtZXNwYWNlZCBlbGVtZW50c1xuICAgICAgLy8gY2hlY2sgYXQgcnVudGltZSBiZWNhdXNlIGl0IG1heSBnZXQgYXNzaWduZWQgYSBuYW1lc3BhY2Ugd2hlbiBpdHNcbiAgICAgIC8vIHBhcmVudCBub3JtYWxpemVzIGNoaWxkcmVuXG4gICAgICB2bm9kZSA9IG5ldyBWTm9kZShcbiAgICAgICAgdGFnLCBkYXRhLCBjaGlsZHJlbixcbiAgICAgICAgdW5kZWZpbmVkLCB1bmRlZmluZWQsIGNvbnRleHRcbiAgICAgICk7XG4gICAgfVxuICB9IGVsc2Uge1xuICAgIC8vIGRpcmVjdCBjb21wb25lbnQgb3B0aW9ucyAvIGNvbnN0cnVjdG9yXG4gICAgdm5vZGUgPSBjcmVhdGVDb21wb25lbnQodGFnLCBkYXRhLCBjb250ZXh0LCBjaGlsZHJlbik7XG4gIH1cbiAgaWYgKEFycmF5LmlzQXJyYXkodm5vZGUpKSB7XG4gICAgcmV0dXJuIHZub2RlXG4gIH0gZWxzZSBpZiAoaXNEZWYodm5vZGUpKSB7XG4gICAgaWYgKGlzRGVmKG5zKSkgeyBhcHBseU5TKHZub2RlLCBucyk7IH1cbiAgICBpZiAoaXNEZWYoZGF0YSkpIHsgcmVnaXN0ZXJEZWVwQmluZGluZ3MoZGF0YSk7IH1cbiAgICByZXR1cm4gdm5vZGVcbiAgfSBlbHNlIHtcbiAgICByZXR1cm4gY3JlYXRlRW1wdHlWTm9kZSgpXG4gIH1cbn1cblxuZnVuY3Rpb24gYXBwbHlOUyAodm5vZGUsIG5zLCBmb3JjZSkge1xuICB2bm9kZS5ucyA9IG5zO1xuICBpZiAodm5vZGUudGFnID09PSAnZm9yZWlnbk9iamVjdCcpIHtcbiAgICAvLyB1c2UgZGVmYXVsdCBuYW1lc3BhY2UgaW5zaWRlIGZvcmVpZ25PYmplY3RcbiAgICBucyA9IHVuZGVmaW5lZDtcbiAgICBmb3JjZSA9IHRydWU7XG4gIH1cbiAgaWYgKGlzRGVmKHZub2RlLmNoaWxkcmVuKSkge1xuICAgIGZvciAodmFyIGkgPSAwLCBsID0gdm5vZGUuY2hpbGRyZW4ubGVuZ3RoOyBpIDwgbDsgaSsrKSB7XG4gICAgICB2YXIgY2hpbGQgPSB2bm9kZS5jaGlsZHJlbltpXTtcbiAgICAgIGlmIChpc0RlZihjaGlsZC50YWcpICYmIChcbiAgICAgICAgaXNVbmRlZihjaGlsZC5ucykgfHwgKGlzVHJ1ZShmb3JjZSkgJiYgY2hpbGQudGFnICE9PSAnc3ZnJykpKSB7XG4gICAgICAgIGFwcGx5TlMoY2hpbGQsIG5zLCBmb3JjZSk7XG4gICAgICB9XG4gICAgfVxuICB9XG59XG5cbi8vIHJlZiAjNTMxOFxuLy8gbmVjZXNzYXJ5IHRvIGVuc3VyZSBwYXJlbnQgcmUtcmVuZGVyIHdoZW4gZGVlcCBiaW5kaW5ncyBsaWtlIDpzdHlsZSBhbmRcbi8vIDpjbGFzcyBhcmUgdXNlZCBvbiBzbG90IG5vZGVzXG5mdW5jdGlvbiByZWdpc3RlckRlZXBCaW5kaW5ncyAoZGF0YSkge1xuICBpZiAoaXNPYmplY3QoZGF0YS5zdHlsZSkpIHtcbiAgICB0cmF2ZXJzZShkYXRhLnN0eWxlKTtcbiAgfVxuICBpZiAoaXNPYmplY3QoZGF0YS5jbGFzcykpIHtcbiAgICB0cmF2ZXJzZShkYXRhLmNsYXNzKTtcbiAgfVxufVxuXG4vKiAgKi9cblxuZnVuY3Rpb24gaW5pdFJlbmRlciAodm0pIHtcbiAgdm0uX3Zub2RlID0gbnVsbDsgLy8gdGhlIHJvb3Qgb2YgdGhlIGNoaWxkIHRyZWVcbiAgdm0uX3N0YXRpY1RyZWVzID0gbnVsbDsgLy8gdi1vbmNlIGNhY2hlZCB0cmVlc1xuICB2YXIgb3B0aW9ucyA9IHZtLiRvcHRpb25zO1xuICB2YXIgcGFyZW50Vm5vZGUgPSB2bS4kdm5vZGUgPSBvcHRpb25zLl9wYXJlbnRWbm9kZTsgLy8gdGhlIHBsYWNlaG9sZGVyIG5vZGUgaW4gcGFyZW50IHRyZWVcbiAgdmFyIHJlbmRlckNvbnRleHQgPSBwYXJlbnRWbm9kZSAmJiBwYXJlbnRWbm9kZS5jb250ZXh0O1xuICB2bS4kc2xvdHMgPSByZXNvbHZlU2xvdHMob3B0aW9ucy5fcmVuZGVyQ2hpbGRyZW4sIHJlbmRlckNvbnRleHQpO1xuICB2bS4kc2NvcGVkU2xvdHMgPSBlbXB0eU9iamVjdDtcbiAgLy8gYmluZCB0aGUgY3JlYXRlRWxlbWVudCBmbiB0byB0aGlzIGluc3RhbmNlXG4gIC8vIHNvIHRoYXQgd2UgZ2V0IHByb3BlciByZW5kZXIgY29udGV4dCBpbnNpZGUgaXQuXG4gIC8vIGFyZ3Mgb3JkZXI6IHRhZywgZGF0YSwgY2hpbGRyZW4sIG5vcm1hbGl6YXRpb25UeXBlLCBhbHdheXNOb3JtYWxpemVcbiAgLy8gaW50ZXJuYWwgdmVyc2lvbiBpcyB1c2VkIGJ5IHJlbmRlciBmdW5jdGlvbnMgY29tcGlsZWQgZnJvbSB0ZW1wbGF0ZXNcbiAgdm0uX2MgPSBmdW5jdGlvbiAoYSwgYiwgYywgZCkgeyByZXR1cm4gY3JlYXRlRWxlbWVudCh2bSwgYSwgYiwgYywgZCwgZmFsc2UpOyB9O1xuICAvLyBub3JtYWxpemF0aW9uIGlzIGFsd2F5cyBhcHBsaWVkIGZvciB0aGUgcHVibGljIHZlcnNpb24sIHVzZWQgaW5cbiAgLy8gdXNlci13cml0dGVuIHJlbmRlciBmdW5jdGlvbnMuXG4gIHZtLiRjcmVhdGVFbGVtZW50ID0gZnVuY3Rpb24gKGEsIGIsIGMsIGQpIHsgcmV0dXJuIGNyZWF0ZUVsZW1lbnQodm0sIGEsIGIsIGMsIGQsIHRydWUpOyB9O1xuXG4gIC8vICRhdHRycyAmICRsaXN0ZW5lcnMgYXJlIGV4cG9zZWQgZm9yIGVhc2llciBIT0MgY3JlYXRpb24uXG4gIC8vIHRoZXkgbmVlZCB0byBiZSByZWFjdGl2ZSBzbyB0aGF0IEhPQ3MgdXNpbmcgdGhlbSBhcmUgYWx3YXlzIHVwZGF0ZWRcbiAgdmFyIHBhcmVudERhdGEgPSBwYXJlbnRWbm9kZSAmJiBwYXJlbnRWbm9kZS5kYXRhO1xuXG4gIC8qIGlzdGFuYnVsIGlnbm9yZSBlbHNlICovXG4gIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nKSB7XG4gICAgZGVmaW5lUmVhY3RpdmUodm0sICckYXR0cnMnLCBwYXJlbnREYXRhICYmIHBhcmVudERhdGEuYXR0cnMgfHwgZW1wdHlPYmplY3QsIGZ1bmN0aW9uICgpIHtcbiAgICAgICFpc1VwZGF0aW5nQ2hpbGRDb21wb25lbnQgJiYgd2FybihcIiRhdHRycyBpcyByZWFkb25seS5cIiwgdm0pO1xuICAgIH0sIHRydWUpO1xuICAgIGRlZmluZVJlYWN0aXZlKHZtLCAnJGxpc3RlbmVycycsIG9wdGlvbnMuX3BhcmVudExpc3RlbmVycyB8fCBlbXB0eU9iamVjdCwgZnVuY3Rpb24gKCkge1xuICAgICAgIWlzVXBkYXRpbmdDaGlsZENvbXBvbmVudCAmJiB3YXJuKFwiJGxpc3RlbmVycyBpcyByZWFkb25seS5cIiwgdm0pO1xuICAgIH0sIHRydWUpO1xuICB9IGVsc2Uge1xuICAgIGRlZmluZVJlYWN0aXZlKHZtLCAnJGF0dHJzJywgcGFyZW50RGF0YSAmJiBwYXJlbnREYXRhLmF0dHJzIHx8IGVtcHR5T2JqZWN0LCBudWxsLCB0cnVlKTtcbiAgICBkZWZpbmVSZWFjdGl2ZSh2bSwgJyRsaXN0ZW5lcnMnLCBvcHRpb25zLl9wYXJlbnRMaXN0ZW5lcnMgfHwgZW1wdHlPYmplY3QsIG51bGwsIHRydWUpO1xuICB9XG59XG5cbmZ1bmN0aW9uIHJlbmRlck1peGluIChWdWUpIHtcbiAgLy8gaW5zdGFsbCBydW50aW1lIGNvbnZlbmllbmNlIGhlbHBlcnNcbiAgaW5zdGFsbFJlbmRlckhlbHBlcnMoVnVlLnByb3RvdHlwZSk7XG5cbiAgVnVlLnByb3RvdHlwZS4kbmV4dFRpY2sgPSBmdW5jdGlvbiAoZm4pIHtcbiAgICByZXR1cm4gbmV4dFRpY2soZm4sIHRoaXMpXG4gIH07XG5cbiAgVnVlLnByb3RvdHlwZS5fcmVuZGVyID0gZnVuY3Rpb24gKCkge1xuICAgIHZhciB2bSA9IHRoaXM7XG4gICAgdmFyIHJlZiA9IHZtLiRvcHRpb25zO1xuICAgIHZhciByZW5kZXIgPSByZWYucmVuZGVyO1xuICAgIHZhciBfcGFyZW50Vm5vZGUgPSByZWYuX3BhcmVudFZub2RlO1xuXG4gICAgLy8gcmVzZXQgX3JlbmRlcmVkIGZsYWcgb24gc2xvdHMgZm9yIGR1cGxpY2F0ZSBzbG90IGNoZWNrXG4gICAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicpIHtcbiAgICAgIGZvciAodmFyIGtleSBpbiB2bS4kc2xvdHMpIHtcbiAgICAgICAgLy8gJGZsb3ctZGlzYWJsZS1saW5lXG4gICAgICAgIHZtLiRzbG90c1trZXldLl9yZW5kZXJlZCA9IGZhbHNlO1xuICAgICAgfVxuICAgIH1cblxuICAgIGlmIChfcGFyZW50Vm5vZGUpIHtcbiAgICAgIHZtLiRzY29wZWRTbG90cyA9IF9wYXJlbnRWbm9kZS5kYXRhLnNjb3BlZFNsb3RzIHx8IGVtcHR5T2JqZWN0O1xuICAgIH1cblxuICAgIC8vIHNldCBwYXJlbnQgdm5vZGUuIHRoaXMgYWxsb3dzIHJlbmRlciBmdW5jdGlvbnMgdG8gaGF2ZSBhY2Nlc3NcbiAgICAvLyB0byB0aGUgZGF0YSBvbiB0aGUgcGxhY2Vob2xkZXIgbm9kZS5cbiAgICB2bS4kdm5vZGUgPSBfcGFyZW50Vm5vZGU7XG4gICAgLy8gcmVuZGVyIHNlbGZcbiAgICB2YXIgdm5vZGU7XG4gICAgdHJ5IHtcbiAgICAgIHZub2RlID0gcmVuZGVyLmNhbGwodm0uX3JlbmRlclByb3h5LCB2bS4kY3JlYXRlRWxlbWVudCk7XG4gICAgfSBjYXRjaCAoZSkge1xuICAgICAgaGFuZGxlRXJyb3IoZSwgdm0sIFwicmVuZGVyXCIpO1xuICAgICAgLy8gcmV0dXJuIGVycm9yIHJlbmRlciByZXN1bHQsXG4gICAgICAvLyBvciBwcmV2aW91cyB2bm9kZSB0byBwcmV2ZW50IHJlbmRlciBlcnJvciBjYXVzaW5nIGJsYW5rIGNvbXBvbmVudFxuICAgICAgLyogaXN0YW5idWwgaWdub3JlIGVsc2UgKi9cbiAgICAgIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nKSB7XG4gICAgICAgIGlmICh2bS4kb3B0aW9ucy5yZW5kZXJFcnJvcikge1xuICAgICAgICAgIHRyeSB7XG4gICAgICAgICAgICB2bm9kZSA9IHZtLiRvcHRpb25zLnJlbmRlckVycm9yLmNhbGwodm0uX3JlbmRlclByb3h5LCB2bS4kY3JlYXRlRWxlbWVudCwgZSk7XG4gICAgICAgICAgfSBjYXRjaCAoZSkge1xuICAgICAgICAgICAgaGFuZGxlRXJyb3IoZSwgdm0sIFwicmVuZGVyRXJyb3JcIik7XG4gICAgICAgICAgICB2bm9kZSA9IHZtLl92bm9kZTtcbiAgICAgICAgICB9XG4gICAgICAgIH0gZWxzZSB7XG4gICAgICAgICAgdm5vZGUgPSB2bS5fdm5vZGU7XG4gICAgICAgIH1cbiAgICAgIH0gZWxzZSB7XG4gICAgICAgIHZub2RlID0gdm0uX3Zub2RlO1xuICAgICAgfVxuICAgIH1cbiAgICAvLyByZXR1cm4gZW1wdHkgdm5vZGUgaW4gY2FzZSB0aGUgcmVuZGVyIGZ1bmN0aW9uIGVycm9yZWQgb3V0XG4gICAgaWYgKCEodm5vZGUgaW5zdGFuY2VvZiBWTm9kZSkpIHtcbiAgICAgIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nICYmIEFycmF5LmlzQXJyYXkodm5vZGUpKSB7XG4gICAgICAgIHdhcm4oXG4gICAgICAgICAgJ011bHRpcGxlIHJvb3Qgbm9kZXMgcmV0dXJuZWQgZnJvbSByZW5kZXIgZnVuY3Rpb24uIFJlbmRlciBmdW5jdGlvbiAnICtcbiAgICAgICAgICAnc2hvdWxkIHJldHVybiBhIHNpbmdsZSByb290IG5vZGUuJyxcbiAgICAgICAgICB2bVxuICAgICAgICApO1xuICAgICAgfVxuICAgICAgdm5vZGUgPSBjcmVhdGVFbXB0eVZOb2RlKCk7XG4gICAgfVxuICAgIC8vIHNldCBwYXJlbnRcbiAgICB2bm9kZS5wYXJlbnQgPSBfcGFyZW50Vm5vZGU7XG4gICAgcmV0dXJuIHZub2RlXG4gIH07XG59XG5cbi8qICAqL1xuXG52YXIgdWlkJDMgPSAwO1xuXG5mdW5jdGlvbiBpbml0TWl4aW4gKFZ1ZSkge1xuICBWdWUucHJvdG90eXBlLl9pbml0ID0gZnVuY3Rpb24gKG9wdGlvbnMpIHtcbiAgICB2YXIgdm0gPSB0aGlzO1xuICAgIC8vIGEgdWlkXG4gICAgdm0uX3VpZCA9IHVpZCQzKys7XG5cbiAgICB2YXIgc3RhcnRUYWcsIGVuZFRhZztcbiAgICAvKiBpc3RhbmJ1bCBpZ25vcmUgaWYgKi9cbiAgICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJiBjb25maWcucGVyZm9ybWFuY2UgJiYgbWFyaykge1xuICAgICAgc3RhcnRUYWcgPSBcInZ1ZS1wZXJmLXN0YXJ0OlwiICsgKHZtLl91aWQpO1xuICAgICAgZW5kVGFnID0gXCJ2dWUtcGVyZi1lbmQ6XCIgKyAodm0uX3VpZCk7XG4gICAgICBtYXJrKHN0YXJ0VGFnKTtcbiAgICB9XG5cbiAgICAvLyBhIGZsYWcgdG8gYXZvaWQgdGhpcyBiZWluZyBvYnNlcnZlZFxuICAgIHZtLl9pc1Z1ZSA9IHRydWU7XG4gICAgLy8gbWVyZ2Ugb3B0aW9uc1xuICAgIGlmIChvcHRpb25zICYmIG9wdGlvbnMuX2lzQ29tcG9uZW50KSB7XG4gICAgICAvLyBvcHRpbWl6ZSBpbnRlcm5hbCBjb21wb25lbnQgaW5zdGFudGlhdGlvblxuICAgICAgLy8gc2luY2UgZHluYW1pYyBvcHRpb25zIG1lcmdpbmcgaXMgcHJldHR5IHNsb3csIGFuZCBub25lIG9mIHRoZVxuICAgICAgLy8gaW50ZXJuYWwgY29tcG9uZW50IG9wdGlvbnMgbmVlZHMgc3BlY2lhbCB0cmVhdG1lbnQuXG4gICAgICBpbml0SW50ZXJuYWxDb21wb25lbnQodm0sIG9wdGlvbnMpO1xuICAgIH0gZWxzZSB7XG4gICAgICB2bS4kb3B0aW9ucyA9IG1lcmdlT3B0aW9ucyhcbiAgICAgICAgcmVzb2x2ZUNvbnN0cnVjdG9yT3B0aW9ucyh2bS5jb25zdHJ1Y3RvciksXG4gICAgICAgIG9wdGlvbnMgfHwge30sXG4gICAgICAgIHZtXG4gICAgICApO1xuICAgIH1cbiAgICAvKiBpc3RhbmJ1bCBpZ25vcmUgZWxzZSAqL1xuICAgIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nKSB7XG4gICAgICBpbml0UHJveHkodm0pO1xuICAgIH0gZWxzZSB7XG4gICAgICB2bS5fcmVuZGVyUHJveHkgPSB2bTtcbiAgICB9XG4gICAgLy8gZXhwb3NlIHJlYWwgc2VsZlxuICAgIHZtLl9zZWxmID0gdm07XG4gICAgaW5pdExpZmVjeWNsZSh2bSk7XG4gICAgaW5pdEV2ZW50cyh2bSk7XG4gICAgaW5pdFJlbmRlcih2bSk7XG4gICAgY2FsbEhvb2sodm0sICdiZWZvcmVDcmVhdGUnKTtcbiAgICBpbml0SW5qZWN0aW9ucyh2bSk7IC8vIHJlc29sdmUgaW5qZWN0aW9ucyBiZWZvcmUgZGF0YS9wcm9wc1xuICAgIGluaXRTdGF0ZSh2bSk7XG4gICAgaW5pdFByb3ZpZGUodm0pOyAvLyByZXNvbHZlIHByb3ZpZGUgYWZ0ZXIgZGF0YS9wcm9wc1xuICAgIGNhbGxIb29rKHZtLCAnY3JlYXRlZCcpO1xuXG4gICAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gICAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiYgY29uZmlnLnBlcmZvcm1hbmNlICYmIG1hcmspIHtcbiAgICAgIHZtLl9uYW1lID0gZm9ybWF0Q29tcG9uZW50TmFtZSh2bSwgZmFsc2UpO1xuICAgICAgbWFyayhlbmRUYWcpO1xuICAgICAgbWVhc3VyZSgoXCJ2dWUgXCIgKyAodm0uX25hbWUpICsgXCIgaW5pdFwiKSwgc3RhcnRUYWcsIGVuZFRhZyk7XG4gICAgfVxuXG4gICAgaWYgKHZtLiRvcHRpb25zLmVsKSB7XG4gICAgICB2bS4kbW91bnQodm0uJG9wdGlvbnMuZWwpO1xuICAgIH1cbiAgfTtcbn1cblxuZnVuY3Rpb24gaW5pdEludGVybmFsQ29tcG9uZW50ICh2bSwgb3B0aW9ucykge1xuICB2YXIgb3B0cyA9IHZtLiRvcHRpb25zID0gT2JqZWN0LmNyZWF0ZSh2bS5jb25zdHJ1Y3Rvci5vcHRpb25zKTtcbiAgLy8gZG9pbmcgdGhpcyBiZWNhdXNlIGl0J3MgZmFzdGVyIHRoYW4gZHluYW1pYyBlbnVtZXJhdGlvbi5cbiAgdmFyIHBhcmVudFZub2RlID0gb3B0aW9ucy5fcGFyZW50Vm5vZGU7XG4gIG9wdHMucGFyZW50ID0gb3B0aW9ucy5wYXJlbnQ7XG4gIG9wdHMuX3BhcmVudFZub2RlID0gcGFyZW50Vm5vZGU7XG4gIG9wdHMuX3BhcmVudEVsbSA9IG9wdGlvbnMuX3BhcmVudEVsbTtcbiAgb3B0cy5fcmVmRWxtID0gb3B0aW9ucy5fcmVmRWxtO1xuXG4gIHZhciB2bm9kZUNvbXBvbmVudE9wdGlvbnMgPSBwYXJlbnRWbm9kZS5jb21wb25lbnRPcHRpb25zO1xuICBvcHRzLnByb3BzRGF0YSA9IHZub2RlQ29tcG9uZW50T3B0aW9ucy5wcm9wc0RhdGE7XG4gIG9wdHMuX3BhcmVudExpc3RlbmVycyA9IHZub2RlQ29tcG9uZW50T3B0aW9ucy5saXN0ZW5lcnM7XG4gIG9wdHMuX3JlbmRlckNoaWxkcmVuID0gdm5vZGVDb21wb25lbnRPcHRpb25zLmNoaWxkcmVuO1xuICBvcHRzLl9jb21wb25lbnRUYWcgPSB2bm9kZUNvbXBvbmVudE9wdGlvbnMudGFnO1xuXG4gIGlmIChvcHRpb25zLnJlbmRlcikge1xuICAgIG9wdHMucmVuZGVyID0gb3B0aW9ucy5yZW5kZXI7XG4gICAgb3B0cy5zdGF0aWNSZW5kZXJGbnMgPSBvcHRpb25zLnN0YXRpY1JlbmRlckZucztcbiAgfVxufVxuXG5mdW5jdGlvbiByZXNvbHZlQ29uc3RydWN0b3JPcHRpb25zIChDdG9yKSB7XG4gIHZhciBvcHRpb25zID0gQ3Rvci5vcHRpb25zO1xuICBpZiAoQ3Rvci5zdXBlcikge1xuICAgIHZhciBzdXBlck9wdGlvbnMgPSByZXNvbHZlQ29uc3RydWN0b3JPcHRpb25zKEN0b3Iuc3VwZXIpO1xuICAgIHZhciBjYWNoZWRTdXBlck9wdGlvbnMgPSBDdG9yLnN1cGVyT3B0aW9ucztcbiAgICBpZiAoc3VwZXJPcHRpb25zICE9PSBjYWNoZWRTdXBlck9wdGlvbnMpIHtcbiAgICAgIC8vIHN1cGVyIG9wdGlvbiBjaGFuZ2VkLFxuICAgICAgLy8gbmVlZCB0byByZXNvbHZlIG5ldyBvcHRpb25zLlxuICAgICAgQ3Rvci5zdXBlck9wdGlvbnMgPSBzdXBlck9wdGlvbnM7XG4gICAgICAvLyBjaGVjayBpZiB0aGVyZSBhcmUgYW55IGxhdGUtbW9kaWZpZWQvYXR0YWNoZWQgb3B0aW9ucyAoIzQ5NzYpXG4gICAgICB2YXIgbW9kaWZpZWRPcHRpb25zID0gcmVzb2x2ZU1vZGlmaWVkT3B0aW9ucyhDdG9yKTtcbiAgICAgIC8vIHVwZGF0ZSBiYXNlIGV4dGVuZCBvcHRpb25zXG4gICAgICBpZiAobW9kaWZpZWRPcHRpb25zKSB7XG4gICAgICAgIGV4dGVuZChDdG9yLmV4dGVuZE9wdGlvbnMsIG1vZGlmaWVkT3B0aW9ucyk7XG4gICAgICB9XG4gICAgICBvcHRpb25zID0gQ3Rvci5vcHRpb25zID0gbWVyZ2VPcHRpb25zKHN1cGVyT3B0aW9ucywgQ3Rvci5leHRlbmRPcHRpb25zKTtcbiAgICAgIGlmIChvcHRpb25zLm5hbWUpIHtcbiAgICAgICAgb3B0aW9ucy5jb21wb25lbnRzW29wdGlvbnMubmFtZV0gPSBDdG9yO1xuICAgICAgfVxuICAgIH1cbiAgfVxuICByZXR1cm4gb3B0aW9uc1xufVxuXG5mdW5jdGlvbiByZXNvbHZlTW9kaWZpZWRPcHRpb25zIChDdG9yKSB7XG4gIHZhciBtb2RpZmllZDtcbiAgdmFyIGxhdGVzdCA9IEN0b3Iub3B0aW9ucztcbiAgdmFyIGV4dGVuZGVkID0gQ3Rvci5leHRlbmRPcHRpb25zO1xuICB2YXIgc2VhbGVkID0gQ3Rvci5zZWFsZWRPcHRpb25zO1xuICBmb3IgKHZhciBrZXkgaW4gbGF0ZXN0KSB7XG4gICAgaWYgKGxhdGVzdFtrZXldICE9PSBzZWFsZWRba2V5XSkge1xuICAgICAgaWYgKCFtb2RpZmllZCkgeyBtb2RpZmllZCA9IHt9OyB9XG4gICAgICBtb2RpZmllZFtrZXldID0gZGVkdXBlKGxhdGVzdFtrZXldLCBleHRlbmRlZFtrZXldLCBzZWFsZWRba2V5XSk7XG4gICAgfVxuICB9XG4gIHJldHVybiBtb2RpZmllZFxufVxuXG5mdW5jdGlvbiBkZWR1cGUgKGxhdGVzdCwgZXh0ZW5kZWQsIHNlYWxlZCkge1xuICAvLyBjb21wYXJlIGxhdGVzdCBhbmQgc2VhbGVkIHRvIGVuc3VyZSBsaWZlY3ljbGUgaG9va3Mgd29uJ3QgYmUgZHVwbGljYXRlZFxuICAvLyBiZXR3ZWVuIG1lcmdlc1xuICBpZiAoQXJyYXkuaXNBcnJheShsYXRlc3QpKSB7XG4gICAgdmFyIHJlcyA9IFtdO1xuICAgIHNlYWxlZCA9IEFycmF5LmlzQXJyYXkoc2VhbGVkKSA/IHNlYWxlZCA6IFtzZWFsZWRdO1xuICAgIGV4dGVuZGVkID0gQXJyYXkuaXNBcnJheShleHRlbmRlZCkgPyBleHRlbmRlZCA6IFtleHRlbmRlZF07XG4gICAgZm9yICh2YXIgaSA9IDA7IGkgPCBsYXRlc3QubGVuZ3RoOyBpKyspIHtcbiAgICAgIC8vIHB1c2ggb3JpZ2luYWwgb3B0aW9ucyBhbmQgbm90IHNlYWxlZCBvcHRpb25zIHRvIGV4Y2x1ZGUgZHVwbGljYXRlZCBvcHRpb25zXG4gICAgICBpZiAoZXh0ZW5kZWQuaW5kZXhPZihsYXRlc3RbaV0pID49IDAgfHwgc2VhbGVkLmluZGV4T2YobGF0ZXN0W2ldKSA8IDApIHtcbiAgICAgICAgcmVzLnB1c2gobGF0ZXN0W2ldKTtcbiAgICAgIH1cbiAgICB9XG4gICAgcmV0dXJuIHJlc1xuICB9IGVsc2Uge1xuICAgIHJldHVybiBsYXRlc3RcbiAgfVxufVxuXG5mdW5jdGlvbiBWdWUgKG9wdGlvbnMpIHtcbiAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiZcbiAgICAhKHRoaXMgaW5zdGFuY2VvZiBWdWUpXG4gICkge1xuICAgIHdhcm4oJ1Z1ZSBpcyBhIGNvbnN0cnVjdG9yIGFuZCBzaG91bGQgYmUgY2FsbGVkIHdpdGggdGhlIGBuZXdgIGtleXdvcmQnKTtcbiAgfVxuICB0aGlzLl9pbml0KG9wdGlvbnMpO1xufVxuXG5pbml0TWl4aW4oVnVlKTtcbnN0YXRlTWl4aW4oVnVlKTtcbmV2ZW50c01peGluKFZ1ZSk7XG5saWZlY3ljbGVNaXhpbihWdWUpO1xucmVuZGVyTWl4aW4oVnVlKTtcblxuLyogICovXG5cbmZ1bmN0aW9uIGluaXRVc2UgKFZ1ZSkge1xuICBWdWUudXNlID0gZnVuY3Rpb24gKHBsdWdpbikge1xuICAgIHZhciBpbnN0YWxsZWRQbHVnaW5zID0gKHRoaXMuX2luc3RhbGxlZFBsdWdpbnMgfHwgKHRoaXMuX2luc3RhbGxlZFBsdWdpbnMgPSBbXSkpO1xuICAgIGlmIChpbnN0YWxsZWRQbHVnaW5zLmluZGV4T2YocGx1Z2luKSA+IC0xKSB7XG4gICAgICByZXR1cm4gdGhpc1xuICAgIH1cblxuICAgIC8vIGFkZGl0aW9uYWwgcGFyYW1ldGVyc1xuICAgIHZhciBhcmdzID0gdG9BcnJheShhcmd1bWVudHMsIDEpO1xuICAgIGFyZ3MudW5zaGlmdCh0aGlzKTtcbiAgICBpZiAodHlwZW9mIHBsdWdpbi5pbnN0YWxsID09PSAnZnVuY3Rpb24nKSB7XG4gICAgICBwbHVnaW4uaW5zdGFsbC5hcHBseShwbHVnaW4sIGFyZ3MpO1xuICAgIH0gZWxzZSBpZiAodHlwZW9mIHBsdWdpbiA9PT0gJ2Z1bmN0aW9uJykge1xuICAgICAgcGx1Z2luLmFwcGx5KG51bGwsIGFyZ3MpO1xuICAgIH1cbiAgICBpbnN0YWxsZWRQbHVnaW5zLnB1c2gocGx1Z2luKTtcbiAgICByZXR1cm4gdGhpc1xuICB9O1xufVxuXG4vKiAgKi9cblxuZnVuY3Rpb24gaW5pdE1peGluJDEgKFZ1ZSkge1xuICBWdWUubWl4aW4gPSBmdW5jdGlvbiAobWl4aW4pIHtcbiAgICB0aGlzLm9wdGlvbnMgPSBtZXJnZU9wdGlvbnModGhpcy5vcHRpb25zLCBtaXhpbik7XG4gICAgcmV0dXJuIHRoaXNcbiAgfTtcbn1cblxuLyogICovXG5cbmZ1bmN0aW9uIGluaXRFeHRlbmQgKFZ1ZSkge1xuICAvKipcbiAgICogRWFjaCBpbnN0YW5jZSBjb25zdHJ1Y3RvciwgaW5jbHVkaW5nIFZ1ZSwgaGFzIGEgdW5pcXVlXG4gICAqIGNpZC4gVGhpcyBlbmFibGVzIHVzIHRvIGNyZWF0ZSB3cmFwcGVkIFwiY2hpbGRcbiAgICogY29uc3RydWN0b3JzXCIgZm9yIHByb3RvdHlwYWwgaW5oZXJpdGFuY2UgYW5kIGNhY2hlIHRoZW0uXG4gICAqL1xuICBWdWUuY2lkID0gMDtcbiAgdmFyIGNpZCA9IDE7XG5cbiAgLyoqXG4gICAqIENsYXNzIGluaGVyaXRhbmNlXG4gICAqL1xuICBWdWUuZXh0ZW5kID0gZnVuY3Rpb24gKGV4dGVuZE9wdGlvbnMpIHtcbiAgICBleHRlbmRPcHRpb25zID0gZXh0ZW5kT3B0aW9ucyB8fCB7fTtcbiAgICB2YXIgU3VwZXIgPSB0aGlzO1xuICAgIHZhciBTdXBlcklkID0gU3VwZXIuY2lkO1xuICAgIHZhciBjYWNoZWRDdG9ycyA9IGV4dGVuZE9wdGlvbnMuX0N0b3IgfHwgKGV4dGVuZE9wdGlvbnMuX0N0b3IgPSB7fSk7XG4gICAgaWYgKGNhY2hlZEN0b3JzW1N1cGVySWRdKSB7XG4gICAgICByZXR1cm4gY2FjaGVkQ3RvcnNbU3VwZXJJZF1cbiAgICB9XG5cbiAgICB2YXIgbmFtZSA9IGV4dGVuZE9wdGlvbnMubmFtZSB8fCBTdXBlci5vcHRpb25zLm5hbWU7XG4gICAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiYgbmFtZSkge1xuICAgICAgdmFsaWRhdGVDb21wb25lbnROYW1lKG5hbWUpO1xuICAgIH1cblxuICAgIHZhciBTdWIgPSBmdW5jdGlvbiBWdWVDb21wb25lbnQgKG9wdGlvbnMpIHtcbiAgICAgIHRoaXMuX2luaXQob3B0aW9ucyk7XG4gICAgfTtcbiAgICBTdWIucHJvdG90eXBlID0gT2JqZWN0LmNyZWF0ZShTdXBlci5wcm90b3R5cGUpO1xuICAgIFN1Yi5wcm90b3R5cGUuY29uc3RydWN0b3IgPSBTdWI7XG4gICAgU3ViLmNpZCA9IGNpZCsrO1xuICAgIFN1Yi5vcHRpb25zID0gbWVyZ2VPcHRpb25zKFxuICAgICAgU3VwZXIub3B0aW9ucyxcbiAgICAgIGV4dGVuZE9wdGlvbnNcbiAgICApO1xuICAgIFN1Ylsnc3VwZXInXSA9IFN1cGVyO1xuXG4gICAgLy8gRm9yIHByb3BzIGFuZCBjb21wdXRlZCBwcm9wZXJ0aWVzLCB3ZSBkZWZpbmUgdGhlIHByb3h5IGdldHRlcnMgb25cbiAgICAvLyB0aGUgVnVlIGluc3RhbmNlcyBhdCBleHRlbnNpb24gdGltZSwgb24gdGhlIGV4dGVuZGVkIHByb3RvdHlwZS4gVGhpc1xuICAgIC8vIGF2b2lkcyBPYmplY3QuZGVmaW5lUHJvcGVydHkgY2FsbHMgZm9yIGVhY2ggaW5zdGFuY2UgY3JlYXRlZC5cbiAgICBpZiAoU3ViLm9wdGlvbnMucHJvcHMpIHtcbiAgICAgIGluaXRQcm9wcyQxKFN1Yik7XG4gICAgfVxuICAgIGlmIChTdWIub3B0aW9ucy5jb21wdXRlZCkge1xuICAgICAgaW5pdENvbXB1dGVkJDEoU3ViKTtcbiAgICB9XG5cbiAgICAvLyBhbGxvdyBmdXJ0aGVyIGV4dGVuc2lvbi9taXhpbi9wbHVnaW4gdXNhZ2VcbiAgICBTdWIuZXh0ZW5kID0gU3VwZXIuZXh0ZW5kO1xuICAgIFN1Yi5taXhpbiA9IFN1cGVyLm1peGluO1xuICAgIFN1Yi51c2UgPSBTdXBlci51c2U7XG5cbiAgICAvLyBjcmVhdGUgYXNzZXQgcmVnaXN0ZXJzLCBzbyBleHRlbmRlZCBjbGFzc2VzXG4gICAgLy8gY2FuIGhhdmUgdGhlaXIgcHJpdmF0ZSBhc3NldHMgdG9vLlxuICAgIEFTU0VUX1RZUEVTLmZvckVhY2goZnVuY3Rpb24gKHR5cGUpIHtcbiAgICAgIFN1Ylt0eXBlXSA9IFN1cGVyW3R5cGVdO1xuICAgIH0pO1xuICAgIC8vIGVuYWJsZSByZWN1cnNpdmUgc2VsZi1sb29rdXBcbiAgICBpZiAobmFtZSkge1xuICAgICAgU3ViLm9wdGlvbnMuY29tcG9uZW50c1tuYW1lXSA9IFN1YjtcbiAgICB9XG5cbiAgICAvLyBrZWVwIGEgcmVmZXJlbmNlIHRvIHRoZSBzdXBlciBvcHRpb25zIGF0IGV4dGVuc2lvbiB0aW1lLlxuICAgIC8vIGxhdGVyIGF0IGluc3RhbnRpYXRpb24gd2UgY2FuIGNoZWNrIGlmIFN1cGVyJ3Mgb3B0aW9ucyBoYXZlXG4gICAgLy8gYmVlbiB1cGRhdGVkLlxuICAgIFN1Yi5zdXBlck9wdGlvbnMgPSBTdXBlci5vcHRpb25zO1xuICAgIFN1Yi5leHRlbmRPcHRpb25zID0gZXh0ZW5kT3B0aW9ucztcbiAgICBTdWIuc2VhbGVkT3B0aW9ucyA9IGV4dGVuZCh7fSwgU3ViLm9wdGlvbnMpO1xuXG4gICAgLy8gY2FjaGUgY29uc3RydWN0b3JcbiAgICBjYWNoZWRDdG9yc1tTdXBlcklkXSA9IFN1YjtcbiAgICByZXR1cm4gU3ViXG4gIH07XG59XG5cbmZ1bmN0aW9uIGluaXRQcm9wcyQxIChDb21wKSB7XG4gIHZhciBwcm9wcyA9IENvbXAub3B0aW9ucy5wcm9wcztcbiAgZm9yICh2YXIga2V5IGluIHByb3BzKSB7XG4gICAgcHJveHkoQ29tcC5wcm90b3R5cGUsIFwiX3Byb3BzXCIsIGtleSk7XG4gIH1cbn1cblxuZnVuY3Rpb24gaW5pdENvbXB1dGVkJDEgKENvbXApIHtcbiAgdmFyIGNvbXB1dGVkID0gQ29tcC5vcHRpb25zLmNvbXB1dGVkO1xuICBmb3IgKHZhciBrZXkgaW4gY29tcHV0ZWQpIHtcbiAgICBkZWZpbmVDb21wdXRlZChDb21wLnByb3RvdHlwZSwga2V5LCBjb21wdXRlZFtrZXldKTtcbiAgfVxufVxuXG4vKiAgKi9cblxuZnVuY3Rpb24gaW5pdEFzc2V0UmVnaXN0ZXJzIChWdWUpIHtcbiAgLyoqXG4gICAqIENyZWF0ZSBhc3NldCByZWdpc3RyYXRpb24gbWV0aG9kcy5cbiAgICovXG4gIEFTU0VUX1RZUEVTLmZvckVhY2goZnVuY3Rpb24gKHR5cGUpIHtcbiAgICBWdWVbdHlwZV0gPSBmdW5jdGlvbiAoXG4gICAgICBpZCxcbiAgICAgIGRlZmluaXRpb25cbiAgICApIHtcbiAgICAgIGlmICghZGVmaW5pdGlvbikge1xuICAgICAgICByZXR1cm4gdGhpcy5vcHRpb25zW3R5cGUgKyAncyddW2lkXVxuICAgICAgfSBlbHNlIHtcbiAgICAgICAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gICAgICAgIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nICYmIHR5cGUgPT09ICdjb21wb25lbnQnKSB7XG4gICAgICAgICAgdmFsaWRhdGVDb21wb25lbnROYW1lKGlkKTtcbiAgICAgICAgfVxuICAgICAgICBpZiAodHlwZSA9PT0gJ2NvbXBvbmVudCcgJiYgaXNQbGFpbk9iamVjdChkZWZpbml0aW9uKSkge1xuICAgICAgICAgIGRlZmluaXRpb24ubmFtZSA9IGRlZmluaXRpb24ubmFtZSB8fCBpZDtcbiAgICAgICAgICBkZWZpbml0aW9uID0gdGhpcy5vcHRpb25zLl9iYXNlLmV4dGVuZChkZWZpbml0aW9uKTtcbiAgICAgICAgfVxuICAgICAgICBpZiAodHlwZSA9PT0gJ2RpcmVjdGl2ZScgJiYgdHlwZW9mIGRlZmluaXRpb24gPT09ICdmdW5jdGlvbicpIHtcbiAgICAgICAgICBkZWZpbml0aW9uID0geyBiaW5kOiBkZWZpbml0aW9uLCB1cGRhdGU6IGRlZmluaXRpb24gfTtcbiAgICAgICAgfVxuICAgICAgICB0aGlzLm9wdGlvbnNbdHlwZSArICdzJ11baWRdID0gZGVmaW5pdGlvbjtcbiAgICAgICAgcmV0dXJuIGRlZmluaXRpb25cbiAgICAgIH1cbiAgICB9O1xuICB9KTtcbn1cblxuLyogICovXG5cbmZ1bmN0aW9uIGdldENvbXBvbmVudE5hbWUgKG9wdHMpIHtcbiAgcmV0dXJuIG9wdHMgJiYgKG9wdHMuQ3Rvci5vcHRpb25zLm5hbWUgfHwgb3B0cy50YWcpXG59XG5cbmZ1bmN0aW9uIG1hdGNoZXMgKHBhdHRlcm4sIG5hbWUpIHtcbiAgaWYgKEFycmF5LmlzQXJyYXkocGF0dGVybikpIHtcbiAgICByZXR1cm4gcGF0dGVybi5pbmRleE9mKG5hbWUpID4gLTFcbiAgfSBlbHNlIGlmICh0eXBlb2YgcGF0dGVybiA9PT0gJ3N0cmluZycpIHtcbiAgICByZXR1cm4gcGF0dGVybi5zcGxpdCgnLCcpLmluZGV4T2YobmFtZSkgPiAtMVxuICB9IGVsc2UgaWYgKGlzUmVnRXhwKHBhdHRlcm4pKSB7XG4gICAgcmV0dXJuIHBhdHRlcm4udGVzdChuYW1lKVxuICB9XG4gIC8qIGlzdGFuYnVsIGlnbm9yZSBuZXh0ICovXG4gIHJldHVybiBmYWxzZVxufVxuXG5mdW5jdGlvbiBwcnVuZUNhY2hlIChrZWVwQWxpdmVJbnN0YW5jZSwgZmlsdGVyKSB7XG4gIHZhciBjYWNoZSA9IGtlZXBBbGl2ZUluc3RhbmNlLmNhY2hlO1xuICB2YXIga2V5cyA9IGtlZXBBbGl2ZUluc3RhbmNlLmtleXM7XG4gIHZhciBfdm5vZGUgPSBrZWVwQWxpdmVJbnN0YW5jZS5fdm5vZGU7XG4gIGZvciAodmFyIGtleSBpbiBjYWNoZSkge1xuICAgIHZhciBjYWNoZWROb2RlID0gY2FjaGVba2V5XTtcbiAgICBpZiAoY2FjaGVkTm9kZSkge1xuICAgICAgdmFyIG5hbWUgPSBnZXRDb21wb25lbnROYW1lKGNhY2hlZE5vZGUuY29tcG9uZW50T3B0aW9ucyk7XG4gICAgICBpZiAobmFtZSAmJiAhZmlsdGVyKG5hbWUpKSB7XG4gICAgICAgIHBydW5lQ2FjaGVFbnRyeShjYWNoZSwga2V5LCBrZXlzLCBfdm5vZGUpO1xuICAgICAgfVxuICAgIH1cbiAgfVxufVxuXG5mdW5jdGlvbiBwcnVuZUNhY2hlRW50cnkgKFxuICBjYWNoZSxcbiAga2V5LFxuICBrZXlzLFxuICBjdXJyZW50XG4pIHtcbiAgdmFyIGNhY2hlZCQkMSA9IGNhY2hlW2tleV07XG4gIGlmIChjYWNoZWQkJDEgJiYgKCFjdXJyZW50IHx8IGNhY2hlZCQkMS50YWcgIT09IGN1cnJlbnQudGFnKSkge1xuICAgIGNhY2hlZCQkMS5jb21wb25lbnRJbnN0YW5jZS4kZGVzdHJveSgpO1xuICB9XG4gIGNhY2hlW2tleV0gPSBudWxsO1xuICByZW1vdmUoa2V5cywga2V5KTtcbn1cblxudmFyIHBhdHRlcm5UeXBlcyA9IFtTdHJpbmcsIFJlZ0V4cCwgQXJyYXldO1xuXG52YXIgS2VlcEFsaXZlID0ge1xuICBuYW1lOiAna2VlcC1hbGl2ZScsXG4gIGFic3RyYWN0OiB0cnVlLFxuXG4gIHByb3BzOiB7XG4gICAgaW5jbHVkZTogcGF0dGVyblR5cGVzLFxuICAgIGV4Y2x1ZGU6IHBhdHRlcm5UeXBlcyxcbiAgICBtYXg6IFtTdHJpbmcsIE51bWJlcl1cbiAgfSxcblxuICBjcmVhdGVkOiBmdW5jdGlvbiBjcmVhdGVkICgpIHtcbiAgICB0aGlzLmNhY2hlID0gT2JqZWN0LmNyZWF0ZShudWxsKTtcbiAgICB0aGlzLmtleXMgPSBbXTtcbiAgfSxcblxuICBkZXN0cm95ZWQ6IGZ1bmN0aW9uIGRlc3Ryb3llZCAoKSB7XG4gICAgdmFyIHRoaXMkMSA9IHRoaXM7XG5cbiAgICBmb3IgKHZhciBrZXkgaW4gdGhpcyQxLmNhY2hlKSB7XG4gICAgICBwcnVuZUNhY2hlRW50cnkodGhpcyQxLmNhY2hlLCBrZXksIHRoaXMkMS5rZXlzKTtcbiAgICB9XG4gIH0sXG5cbiAgbW91bnRlZDogZnVuY3Rpb24gbW91bnRlZCAoKSB7XG4gICAgdmFyIHRoaXMkMSA9IHRoaXM7XG5cbiAgICB0aGlzLiR3YXRjaCgnaW5jbHVkZScsIGZ1bmN0aW9uICh2YWwpIHtcbiAgICAgIHBydW5lQ2FjaGUodGhpcyQxLCBmdW5jdGlvbiAobmFtZSkgeyByZXR1cm4gbWF0Y2hlcyh2YWwsIG5hbWUpOyB9KTtcbiAgICB9KTtcbiAgICB0aGlzLiR3YXRjaCgnZXhjbHVkZScsIGZ1bmN0aW9uICh2YWwpIHtcbiAgICAgIHBydW5lQ2FjaGUodGhpcyQxLCBmdW5jdGlvbiAobmFtZSkgeyByZXR1cm4gIW1hdGNoZXModmFsLCBuYW1lKTsgfSk7XG4gICAgfSk7XG4gIH0sXG5cbiAgcmVuZGVyOiBmdW5jdGlvbiByZW5kZXIgKCkge1xuICAgIHZhciBzbG90ID0gdGhpcy4kc2xvdHMuZGVmYXVsdDtcbiAgICB2YXIgdm5vZGUgPSBnZXRGaXJzdENvbXBvbmVudENoaWxkKHNsb3QpO1xuICAgIHZhciBjb21wb25lbnRPcHRpb25zID0gdm5vZGUgJiYgdm5vZGUuY29tcG9uZW50T3B0aW9ucztcbiAgICBpZiAoY29tcG9uZW50T3B0aW9ucykge1xuICAgICAgLy8gY2hlY2sgcGF0dGVyblxuICAgICAgdmFyIG5hbWUgPSBnZXRDb21wb25lbnROYW1lKGNvbXBvbmVudE9wdGlvbnMpO1xuICAgICAgdmFyIHJlZiA9IHRoaXM7XG4gICAgICB2YXIgaW5jbHVkZSA9IHJlZi5pbmNsdWRlO1xuICAgICAgdmFyIGV4Y2x1ZGUgPSByZWYuZXhjbHVkZTtcbiAgICAgIGlmIChcbiAgICAgICAgLy8gbm90IGluY2x1ZGVkXG4gICAgICAgIChpbmNsdWRlICYmICghbmFtZSB8fCAhbWF0Y2hlcyhpbmNsdWRlLCBuYW1lKSkpIHx8XG4gICAgICAgIC8vIGV4Y2x1ZGVkXG4gICAgICAgIChleGNsdWRlICYmIG5hbWUgJiYgbWF0Y2hlcyhleGNsdWRlLCBuYW1lKSlcbiAgICAgICkge1xuICAgICAgICByZXR1cm4gdm5vZGVcbiAgICAgIH1cblxuICAgICAgdmFyIHJlZiQxID0gdGhpcztcbiAgICAgIHZhciBjYWNoZSA9IHJlZiQxLmNhY2hlO1xuICAgICAgdmFyIGtleXMgPSByZWYkMS5rZXlzO1xuICAgICAgdmFyIGtleSA9IHZub2RlLmtleSA9PSBudWxsXG4gICAgICAgIC8vIHNhbWUgY29uc3RydWN0b3IgbWF5IGdldCByZWdpc3RlcmVkIGFzIGRpZmZlcmVudCBsb2NhbCBjb21wb25lbnRzXG4gICAgICAgIC8vIHNvIGNpZCBhbG9uZSBpcyBub3QgZW5vdWdoICgjMzI2OSlcbiAgICAgICAgPyBjb21wb25lbnRPcHRpb25zLkN0b3IuY2lkICsgKGNvbXBvbmVudE9wdGlvbnMudGFnID8gKFwiOjpcIiArIChjb21wb25lbnRPcHRpb25zLnRhZykpIDogJycpXG4gICAgICAgIDogdm5vZGUua2V5O1xuICAgICAgaWYgKGNhY2hlW2tleV0pIHtcbiAgICAgICAgdm5vZGUuY29tcG9uZW50SW5zdGFuY2UgPSBjYWNoZVtrZXldLmNvbXBvbmVudEluc3RhbmNlO1xuICAgICAgICAvLyBtYWtlIGN1cnJlbnQga2V5IGZyZXNoZXN0XG4gICAgICAgIHJlbW92ZShrZXlzLCBrZXkpO1xuICAgICAgICBrZXlzLnB1c2goa2V5KTtcbiAgICAgIH0gZWxzZSB7XG4gICAgICAgIGNhY2hlW2tleV0gPSB2bm9kZTtcbiAgICAgICAga2V5cy5wdXNoKGtleSk7XG4gICAgICAgIC8vIHBydW5lIG9sZGVzdCBlbnRyeVxuICAgICAgICBpZiAodGhpcy5tYXggJiYga2V5cy5sZW5ndGggPiBwYXJzZUludCh0aGlzLm1heCkpIHtcbiAgICAgICAgICBwcnVuZUNhY2hlRW50cnkoY2FjaGUsIGtleXNbMF0sIGtleXMsIHRoaXMuX3Zub2RlKTtcbiAgICAgICAgfVxuICAgICAgfVxuXG4gICAgICB2bm9kZS5kYXRhLmtlZXBBbGl2ZSA9IHRydWU7XG4gICAgfVxuICAgIHJldHVybiB2bm9kZSB8fCAoc2xvdCAmJiBzbG90WzBdKVxuICB9XG59XG5cbnZhciBidWlsdEluQ29tcG9uZW50cyA9IHtcbiAgS2VlcEFsaXZlOiBLZWVwQWxpdmVcbn1cblxuLyogICovXG5cbmZ1bmN0aW9uIGluaXRHbG9iYWxBUEkgKFZ1ZSkge1xuICAvLyBjb25maWdcbiAgdmFyIGNvbmZpZ0RlZiA9IHt9O1xuICBjb25maWdEZWYuZ2V0ID0gZnVuY3Rpb24gKCkgeyByZXR1cm4gY29uZmlnOyB9O1xuICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgIGNvbmZpZ0RlZi5zZXQgPSBmdW5jdGlvbiAoKSB7XG4gICAgICB3YXJuKFxuICAgICAgICAnRG8gbm90IHJlcGxhY2UgdGhlIFZ1ZS5jb25maWcgb2JqZWN0LCBzZXQgaW5kaXZpZHVhbCBmaWVsZHMgaW5zdGVhZC4nXG4gICAgICApO1xuICAgIH07XG4gIH1cbiAgT2JqZWN0LmRlZmluZVByb3BlcnR5KFZ1ZSwgJ2NvbmZpZycsIGNvbmZpZ0RlZik7XG5cbiAgLy8gZXhwb3NlZCB1dGlsIG1ldGhvZHMuXG4gIC8vIE5PVEU6IHRoZXNlIGFyZSBub3QgY29uc2lkZXJlZCBwYXJ0IG9mIHRoZSBwdWJsaWMgQVBJIC0gYXZvaWQgcmVseWluZyBvblxuICAvLyB0aGVtIHVubGVzcyB5b3UgYXJlIGF3YXJlIG9mIHRoZSByaXNrLlxuICBWdWUudXRpbCA9IHtcbiAgICB3YXJuOiB3YXJuLFxuICAgIGV4dGVuZDogZXh0ZW5kLFxuICAgIG1lcmdlT3B0aW9uczogbWVyZ2VPcHRpb25zLFxuICAgIGRlZmluZVJlYWN0aXZlOiBkZWZpbmVSZWFjdGl2ZVxuICB9O1xuXG4gIFZ1ZS5zZXQgPSBzZXQ7XG4gIFZ1ZS5kZWxldGUgPSBkZWw7XG4gIFZ1ZS5uZXh0VGljayA9IG5leHRUaWNrO1xuXG4gIFZ1ZS5vcHRpb25zID0gT2JqZWN0LmNyZWF0ZShudWxsKTtcbiAgQVNTRVRfVFlQRVMuZm9yRWFjaChmdW5jdGlvbiAodHlwZSkge1xuICAgIFZ1ZS5vcHRpb25zW3R5cGUgKyAncyddID0gT2JqZWN0LmNyZWF0ZShudWxsKTtcbiAgfSk7XG5cbiAgLy8gdGhpcyBpcyB1c2VkIHRvIGlkZW50aWZ5IHRoZSBcImJhc2VcIiBjb25zdHJ1Y3RvciB0byBleHRlbmQgYWxsIHBsYWluLW9iamVjdFxuICAvLyBjb21wb25lbnRzIHdpdGggaW4gV2VleCdzIG11bHRpLWluc3RhbmNlIHNjZW5hcmlvcy5cbiAgVnVlLm9wdGlvbnMuX2Jhc2UgPSBWdWU7XG5cbiAgZXh0ZW5kKFZ1ZS5vcHRpb25zLmNvbXBvbmVudHMsIGJ1aWx0SW5Db21wb25lbnRzKTtcblxuICBpbml0VXNlKFZ1ZSk7XG4gIGluaXRNaXhpbiQxKFZ1ZSk7XG4gIGluaXRFeHRlbmQoVnVlKTtcbiAgaW5pdEFzc2V0UmVnaXN0ZXJzKFZ1ZSk7XG59XG5cbmluaXRHbG9iYWxBUEkoVnVlKTtcblxuT2JqZWN0LmRlZmluZVByb3BlcnR5KFZ1ZS5wcm90b3R5cGUsICckaXNTZXJ2ZXInLCB7XG4gIGdldDogaXNTZXJ2ZXJSZW5kZXJpbmdcbn0pO1xuXG5PYmplY3QuZGVmaW5lUHJvcGVydHkoVnVlLnByb3RvdHlwZSwgJyRzc3JDb250ZXh0Jywge1xuICBnZXQ6IGZ1bmN0aW9uIGdldCAoKSB7XG4gICAgLyogaXN0YW5idWwgaWdub3JlIG5leHQgKi9cbiAgICByZXR1cm4gdGhpcy4kdm5vZGUgJiYgdGhpcy4kdm5vZGUuc3NyQ29udGV4dFxuICB9XG59KTtcblxuLy8gZXhwb3NlIEZ1bmN0aW9uYWxSZW5kZXJDb250ZXh0IGZvciBzc3IgcnVudGltZSBoZWxwZXIgaW5zdGFsbGF0aW9uXG5PYmplY3QuZGVmaW5lUHJvcGVydHkoVnVlLCAnRnVuY3Rpb25hbFJlbmRlckNvbnRleHQnLCB7XG4gIHZhbHVlOiBGdW5jdGlvbmFsUmVuZGVyQ29udGV4dFxufSk7XG5cblZ1ZS52ZXJzaW9uID0gJzIuNS4xNyc7XG5cbi8qICAqL1xuXG4vLyB0aGVzZSBhcmUgcmVzZXJ2ZWQgZm9yIHdlYiBiZWNhdXNlIHRoZXkgYXJlIGRpcmVjdGx5IGNvbXBpbGVkIGF3YXlcbi8vIGR1cmluZyB0ZW1wbGF0ZSBjb21waWxhdGlvblxudmFyIGlzUmVzZXJ2ZWRBdHRyID0gbWFrZU1hcCgnc3R5bGUsY2xhc3MnKTtcblxuLy8gYXR0cmlidXRlcyB0aGF0IHNob3VsZCBiZSB1c2luZyBwcm9wcyBmb3IgYmluZGluZ1xudmFyIGFjY2VwdFZhbHVlID0gbWFrZU1hcCgnaW5wdXQsdGV4dGFyZWEsb3B0aW9uLHNlbGVjdCxwcm9ncmVzcycpO1xudmFyIG11c3RVc2VQcm9wID0gZnVuY3Rpb24gKHRhZywgdHlwZSwgYXR0cikge1xuICByZXR1cm4gKFxuICAgIChhdHRyID09PSAndmFsdWUnICYmIGFjY2VwdFZhbHVlKHRhZykpICYmIHR5cGUgIT09ICdidXR0b24nIHx8XG4gICAgKGF0dHIgPT09ICdzZWxlY3RlZCcgJiYgdGFnID09PSAnb3B0aW9uJykgfHxcbiAgICAoYXR0ciA9PT0gJ2NoZWNrZWQnICYmIHRhZyA9PT0gJ2lucHV0JykgfHxcbiAgICAoYXR0ciA9PT0gJ211dGVkJyAmJiB0YWcgPT09ICd2aWRlbycpXG4gIClcbn07XG5cbnZhciBpc0VudW1lcmF0ZWRBdHRyID0gbWFrZU1hcCgnY29udGVudGVkaXRhYmxlLGRyYWdnYWJsZSxzcGVsbGNoZWNrJyk7XG5cbnZhciBpc0Jvb2xlYW5BdHRyID0gbWFrZU1hcChcbiAgJ2FsbG93ZnVsbHNjcmVlbixhc3luYyxhdXRvZm9jdXMsYXV0b3BsYXksY2hlY2tlZCxjb21wYWN0LGNvbnRyb2xzLGRlY2xhcmUsJyArXG4gICdkZWZhdWx0LGRlZmF1bHRjaGVja2VkLGRlZmF1bHRtdXRlZCxkZWZhdWx0c2VsZWN0ZWQsZGVmZXIsZGlzYWJsZWQsJyArXG4gICdlbmFibGVkLGZvcm1ub3ZhbGlkYXRlLGhpZGRlbixpbmRldGVybWluYXRlLGluZXJ0LGlzbWFwLGl0ZW1zY29wZSxsb29wLG11bHRpcGxlLCcgK1xuICAnbXV0ZWQsbm9ocmVmLG5vcmVzaXplLG5vc2hhZGUsbm92YWxpZGF0ZSxub3dyYXAsb3BlbixwYXVzZW9uZXhpdCxyZWFkb25seSwnICtcbiAgJ3JlcXVpcmVkLHJldmVyc2VkLHNjb3BlZCxzZWFtbGVzcyxzZWxlY3RlZCxzb3J0YWJsZSx0cmFuc2xhdGUsJyArXG4gICd0cnVlc3BlZWQsdHlwZW11c3RtYXRjaCx2aXNpYmxlJ1xuKTtcblxudmFyIHhsaW5rTlMgPSAnaHR0cDovL3d3dy53My5vcmcvMTk5OS94bGluayc7XG5cbnZhciBpc1hsaW5rID0gZnVuY3Rpb24gKG5hbWUpIHtcbiAgcmV0dXJuIG5hbWUuY2hhckF0KDUpID09PSAnOicgJiYgbmFtZS5zbGljZSgwLCA1KSA9PT0gJ3hsaW5rJ1xufTtcblxudmFyIGdldFhsaW5rUHJvcCA9IGZ1bmN0aW9uIChuYW1lKSB7XG4gIHJldHVybiBpc1hsaW5rKG5hbWUpID8gbmFtZS5zbGljZSg2LCBuYW1lLmxlbmd0aCkgOiAnJ1xufTtcblxudmFyIGlzRmFsc3lBdHRyVmFsdWUgPSBmdW5jdGlvbiAodmFsKSB7XG4gIHJldHVybiB2YWwgPT0gbnVsbCB8fCB2YWwgPT09IGZhbHNlXG59O1xuXG4vKiAgKi9cblxuZnVuY3Rpb24gZ2VuQ2xhc3NGb3JWbm9kZSAodm5vZGUpIHtcbiAgdmFyIGRhdGEgPSB2bm9kZS5kYXRhO1xuICB2YXIgcGFyZW50Tm9kZSA9IHZub2RlO1xuICB2YXIgY2hpbGROb2RlID0gdm5vZGU7XG4gIHdoaWxlIChpc0RlZihjaGlsZE5vZGUuY29tcG9uZW50SW5zdGFuY2UpKSB7XG4gICAgY2hpbGROb2RlID0gY2hpbGROb2RlLmNvbXBvbmVudEluc3RhbmNlLl92bm9kZTtcbiAgICBpZiAoY2hpbGROb2RlICYmIGNoaWxkTm9kZS5kYXRhKSB7XG4gICAgICBkYXRhID0gbWVyZ2VDbGFzc0RhdGEoY2hpbGROb2RlLmRhdGEsIGRhdGEpO1xuICAgIH1cbiAgfVxuICB3aGlsZSAoaXNEZWYocGFyZW50Tm9kZSA9IHBhcmVudE5vZGUucGFyZW50KSkge1xuICAgIGlmIChwYXJlbnROb2RlICYmIHBhcmVudE5vZGUuZGF0YSkge1xuICAgICAgZGF0YSA9IG1lcmdlQ2xhc3NEYXRhKGRhdGEsIHBhcmVudE5vZGUuZGF0YSk7XG4gICAgfVxuICB9XG4gIHJldHVybiByZW5kZXJDbGFzcyhkYXRhLnN0YXRpY0NsYXNzLCBkYXRhLmNsYXNzKVxufVxuXG5mdW5jdGlvbiBtZXJnZUNsYXNzRGF0YSAoY2hpbGQsIHBhcmVudCkge1xuICByZXR1cm4ge1xuICAgIHN0YXRpY0NsYXNzOiBjb25jYXQoY2hpbGQuc3RhdGljQ2xhc3MsIHBhcmVudC5zdGF0aWNDbGFzcyksXG4gICAgY2xhc3M6IGlzRGVmKGNoaWxkLmNsYXNzKVxuICAgICAgPyBbY2hpbGQuY2xhc3MsIHBhcmVudC5jbGFzc11cbiAgICAgIDogcGFyZW50LmNsYXNzXG4gIH1cbn1cblxuZnVuY3Rpb24gcmVuZGVyQ2xhc3MgKFxuICBzdGF0aWNDbGFzcyxcbiAgZHluYW1pY0NsYXNzXG4pIHtcbiAgaWYgKGlzRGVmKHN0YXRpY0NsYXNzKSB8fCBpc0RlZihkeW5hbWljQ2xhc3MpKSB7XG4gICAgcmV0dXJuIGNvbmNhdChzdGF0aWNDbGFzcywgc3RyaW5naWZ5Q2xhc3MoZHluYW1pY0NsYXNzKSlcbiAgfVxuICAvKiBpc3RhbmJ1bCBpZ25vcmUgbmV4dCAqL1xuICByZXR1cm4gJydcbn1cblxuZnVuY3Rpb24gY29uY2F0IChhLCBiKSB7XG4gIHJldHVybiBhID8gYiA/IChhICsgJyAnICsgYikgOiBhIDogKGIgfHwgJycpXG59XG5cbmZ1bmN0aW9uIHN0cmluZ2lmeUNsYXNzICh2YWx1ZSkge1xuICBpZiAoQXJyYXkuaXNBcnJheSh2YWx1ZSkpIHtcbiAgICByZXR1cm4gc3RyaW5naWZ5QXJyYXkodmFsdWUpXG4gIH1cbiAgaWYgKGlzT2JqZWN0KHZhbHVlKSkge1xuICAgIHJldHVybiBzdHJpbmdpZnlPYmplY3QodmFsdWUpXG4gIH1cbiAgaWYgKHR5cGVvZiB2YWx1ZSA9PT0gJ3N0cmluZycpIHtcbiAgICByZXR1cm4gdmFsdWVcbiAgfVxuICAvKiBpc3RhbmJ1bCBpZ25vcmUgbmV4dCAqL1xuICByZXR1cm4gJydcbn1cblxuZnVuY3Rpb24gc3RyaW5naWZ5QXJyYXkgKHZhbHVlKSB7XG4gIHZhciByZXMgPSAnJztcbiAgdmFyIHN0cmluZ2lmaWVkO1xuICBmb3IgKHZhciBpID0gMCwgbCA9IHZhbHVlLmxlbmd0aDsgaSA8IGw7IGkrKykge1xuICAgIGlmIChpc0RlZihzdHJpbmdpZmllZCA9IHN0cmluZ2lmeUNsYXNzKHZhbHVlW2ldKSkgJiYgc3RyaW5naWZpZWQgIT09ICcnKSB7XG4gICAgICBpZiAocmVzKSB7IHJlcyArPSAnICc7IH1cbiAgICAgIHJlcyArPSBzdHJpbmdpZmllZDtcbiAgICB9XG4gIH1cbiAgcmV0dXJuIHJlc1xufVxuXG5mdW5jdGlvbiBzdHJpbmdpZnlPYmplY3QgKHZhbHVlKSB7XG4gIHZhciByZXMgPSAnJztcbiAgZm9yICh2YXIga2V5IGluIHZhbHVlKSB7XG4gICAgaWYgKHZhbHVlW2tleV0pIHtcbiAgICAgIGlmIChyZXMpIHsgcmVzICs9ICcgJzsgfVxuICAgICAgcmVzICs9IGtleTtcbiAgICB9XG4gIH1cbiAgcmV0dXJuIHJlc1xufVxuXG4vKiAgKi9cblxudmFyIG5hbWVzcGFjZU1hcCA9IHtcbiAgc3ZnOiAnaHR0cDovL3d3dy53My5vcmcvMjAwMC9zdmcnLFxuICBtYXRoOiAnaHR0cDovL3d3dy53My5vcmcvMTk5OC9NYXRoL01hdGhNTCdcbn07XG5cbnZhciBpc0hUTUxUYWcgPSBtYWtlTWFwKFxuICAnaHRtbCxib2R5LGJhc2UsaGVhZCxsaW5rLG1ldGEsc3R5bGUsdGl0bGUsJyArXG4gICdhZGRyZXNzLGFydGljbGUsYXNpZGUsZm9vdGVyLGhlYWRlcixoMSxoMixoMyxoNCxoNSxoNixoZ3JvdXAsbmF2LHNlY3Rpb24sJyArXG4gICdkaXYsZGQsZGwsZHQsZmlnY2FwdGlvbixmaWd1cmUscGljdHVyZSxocixpbWcsbGksbWFpbixvbCxwLHByZSx1bCwnICtcbiAgJ2EsYixhYmJyLGJkaSxiZG8sYnIsY2l0ZSxjb2RlLGRhdGEsZGZuLGVtLGksa2JkLG1hcmsscSxycCxydCxydGMscnVieSwnICtcbiAgJ3Msc2FtcCxzbWFsbCxzcGFuLHN0cm9uZyxzdWIsc3VwLHRpbWUsdSx2YXIsd2JyLGFyZWEsYXVkaW8sbWFwLHRyYWNrLHZpZGVvLCcgK1xuICAnZW1iZWQsb2JqZWN0LHBhcmFtLHNvdXJjZSxjYW52YXMsc2NyaXB0LG5vc2NyaXB0LGRlbCxpbnMsJyArXG4gICdjYXB0aW9uLGNvbCxjb2xncm91cCx0YWJsZSx0aGVhZCx0Ym9keSx0ZCx0aCx0ciwnICtcbiAgJ2J1dHRvbixkYXRhbGlzdCxmaWVsZHNldCxmb3JtLGlucHV0LGxhYmVsLGxlZ2VuZCxtZXRlcixvcHRncm91cCxvcHRpb24sJyArXG4gICdvdXRwdXQscHJvZ3Jlc3Msc2VsZWN0LHRleHRhcmVhLCcgK1xuICAnZGV0YWlscyxkaWFsb2csbWVudSxtZW51aXRlbSxzdW1tYXJ5LCcgK1xuICAnY29udGVudCxlbGVtZW50LHNoYWRvdyx0ZW1wbGF0ZSxibG9ja3F1b3RlLGlmcmFtZSx0Zm9vdCdcbik7XG5cbi8vIHRoaXMgbWFwIGlzIGludGVudGlvbmFsbHkgc2VsZWN0aXZlLCBvbmx5IGNvdmVyaW5nIFNWRyBlbGVtZW50cyB0aGF0IG1heVxuLy8gY29udGFpbiBjaGlsZCBlbGVtZW50cy5cbnZhciBpc1NWRyA9IG1ha2VNYXAoXG4gICdzdmcsYW5pbWF0ZSxjaXJjbGUsY2xpcHBhdGgsY3Vyc29yLGRlZnMsZGVzYyxlbGxpcHNlLGZpbHRlcixmb250LWZhY2UsJyArXG4gICdmb3JlaWduT2JqZWN0LGcsZ2x5cGgsaW1hZ2UsbGluZSxtYXJrZXIsbWFzayxtaXNzaW5nLWdseXBoLHBhdGgscGF0dGVybiwnICtcbiAgJ3BvbHlnb24scG9seWxpbmUscmVjdCxzd2l0Y2gsc3ltYm9sLHRleHQsdGV4dHBhdGgsdHNwYW4sdXNlLHZpZXcnLFxuICB0cnVlXG4pO1xuXG5cblxudmFyIGlzUmVzZXJ2ZWRUYWcgPSBmdW5jdGlvbiAodGFnKSB7XG4gIHJldHVybiBpc0hUTUxUYWcodGFnKSB8fCBpc1NWRyh0YWcpXG59O1xuXG5mdW5jdGlvbiBnZXRUYWdOYW1lc3BhY2UgKHRhZykge1xuICBpZiAoaXNTVkcodGFnKSkge1xuICAgIHJldHVybiAnc3ZnJ1xuICB9XG4gIC8vIGJhc2ljIHN1cHBvcnQgZm9yIE1hdGhNTFxuICAvLyBub3RlIGl0IGRvZXNuJ3Qgc3VwcG9ydCBvdGhlciBNYXRoTUwgZWxlbWVudHMgYmVpbmcgY29tcG9uZW50IHJvb3RzXG4gIGlmICh0YWcgPT09ICdtYXRoJykge1xuICAgIHJldHVybiAnbWF0aCdcbiAgfVxufVxuXG52YXIgdW5rbm93bkVsZW1lbnRDYWNoZSA9IE9iamVjdC5jcmVhdGUobnVsbCk7XG5mdW5jdGlvbiBpc1Vua25vd25FbGVtZW50ICh0YWcpIHtcbiAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gIGlmICghaW5Ccm93c2VyKSB7XG4gICAgcmV0dXJuIHRydWVcbiAgfVxuICBpZiAoaXNSZXNlcnZlZFRhZyh0YWcpKSB7XG4gICAgcmV0dXJuIGZhbHNlXG4gIH1cbiAgdGFnID0gdGFnLnRvTG93ZXJDYXNlKCk7XG4gIC8qIGlzdGFuYnVsIGlnbm9yZSBpZiAqL1xuICBpZiAodW5rbm93bkVsZW1lbnRDYWNoZVt0YWddICE9IG51bGwpIHtcbiAgICByZXR1cm4gdW5rbm93bkVsZW1lbnRDYWNoZVt0YWddXG4gIH1cbiAgdmFyIGVsID0gZG9jdW1lbnQuY3JlYXRlRWxlbWVudCh0YWcpO1xuICBpZiAodGFnLmluZGV4T2YoJy0nKSA+IC0xKSB7XG4gICAgLy8gaHR0cDovL3N0YWNrb3ZlcmZsb3cuY29tL2EvMjgyMTAzNjQvMTA3MDI0NFxuICAgIHJldHVybiAodW5rbm93bkVsZW1lbnRDYWNoZVt0YWddID0gKFxuICAgICAgZWwuY29uc3RydWN0b3IgPT09IHdpbmRvdy5IVE1MVW5rbm93bkVsZW1lbnQgfHxcbiAgICAgIGVsLmNvbnN0cnVjdG9yID09PSB3aW5kb3cuSFRNTEVsZW1lbnRcbiAgICApKVxuICB9IGVsc2Uge1xuICAgIHJldHVybiAodW5rbm93bkVsZW1lbnRDYWNoZVt0YWddID0gL0hUTUxVbmtub3duRWxlbWVudC8udGVzdChlbC50b1N0cmluZygpKSlcbiAgfVxufVxuXG52YXIgaXNUZXh0SW5wdXRUeXBlID0gbWFrZU1hcCgndGV4dCxudW1iZXIscGFzc3dvcmQsc2VhcmNoLGVtYWlsLHRlbCx1cmwnKTtcblxuLyogICovXG5cbi8qKlxuICogUXVlcnkgYW4gZWxlbWVudCBzZWxlY3RvciBpZiBpdCdzIG5vdCBhbiBlbGVtZW50IGFscmVhZHkuXG4gKi9cbmZ1bmN0aW9uIHF1ZXJ5IChlbCkge1xuICBpZiAodHlwZW9mIGVsID09PSAnc3RyaW5nJykge1xuICAgIHZhciBzZWxlY3RlZCA9IGRvY3VtZW50LnF1ZXJ5U2VsZWN0b3IoZWwpO1xuICAgIGlmICghc2VsZWN0ZWQpIHtcbiAgICAgIHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiYgd2FybihcbiAgICAgICAgJ0Nhbm5vdCBmaW5kIGVsZW1lbnQ6ICcgKyBlbFxuICAgICAgKTtcbiAgICAgIHJldHVybiBkb2N1bWVudC5jcmVhdGVFbGVtZW50KCdkaXYnKVxuICAgIH1cbiAgICByZXR1cm4gc2VsZWN0ZWRcbiAgfSBlbHNlIHtcbiAgICByZXR1cm4gZWxcbiAgfVxufVxuXG4vKiAgKi9cblxuZnVuY3Rpb24gY3JlYXRlRWxlbWVudCQxICh0YWdOYW1lLCB2bm9kZSkge1xuICB2YXIgZWxtID0gZG9jdW1lbnQuY3JlYXRlRWxlbWVudCh0YWdOYW1lKTtcbiAgaWYgKHRhZ05hbWUgIT09ICdzZWxlY3QnKSB7XG4gICAgcmV0dXJuIGVsbVxuICB9XG4gIC8vIGZhbHNlIG9yIG51bGwgd2lsbCByZW1vdmUgdGhlIGF0dHJpYnV0ZSBidXQgdW5kZWZpbmVkIHdpbGwgbm90XG4gIGlmICh2bm9kZS5kYXRhICYmIHZub2RlLmRhdGEuYXR0cnMgJiYgdm5vZGUuZGF0YS5hdHRycy5tdWx0aXBsZSAhPT0gdW5kZWZpbmVkKSB7XG4gICAgZWxtLnNldEF0dHJpYnV0ZSgnbXVsdGlwbGUnLCAnbXVsdGlwbGUnKTtcbiAgfVxuICByZXR1cm4gZWxtXG59XG5cbmZ1bmN0aW9uIGNyZWF0ZUVsZW1lbnROUyAobmFtZXNwYWNlLCB0YWdOYW1lKSB7XG4gIHJldHVybiBkb2N1bWVudC5jcmVhdGVFbGVtZW50TlMobmFtZXNwYWNlTWFwW25hbWVzcGFjZV0sIHRhZ05hbWUpXG59XG5cbmZ1bmN0aW9uIGNyZWF0ZVRleHROb2RlICh0ZXh0KSB7XG4gIHJldHVybiBkb2N1bWVudC5jcmVhdGVUZXh0Tm9kZSh0ZXh0KVxufVxuXG5mdW5jdGlvbiBjcmVhdGVDb21tZW50ICh0ZXh0KSB7XG4gIHJldHVybiBkb2N1bWVudC5jcmVhdGVDb21tZW50KHRleHQpXG59XG5cbmZ1bmN0aW9uIGluc2VydEJlZm9yZSAocGFyZW50Tm9kZSwgbmV3Tm9kZSwgcmVmZXJlbmNlTm9kZSkge1xuICBwYXJlbnROb2RlLmluc2VydEJlZm9yZShuZXdOb2RlLCByZWZlcmVuY2VOb2RlKTtcbn1cblxuZnVuY3Rpb24gcmVtb3ZlQ2hpbGQgKG5vZGUsIGNoaWxkKSB7XG4gIG5vZGUucmVtb3ZlQ2hpbGQoY2hpbGQpO1xufVxuXG5mdW5jdGlvbiBhcHBlbmRDaGlsZCAobm9kZSwgY2hpbGQpIHtcbiAgbm9kZS5hcHBlbmRDaGlsZChjaGlsZCk7XG59XG5cbmZ1bmN0aW9uIHBhcmVudE5vZGUgKG5vZGUpIHtcbiAgcmV0dXJuIG5vZGUucGFyZW50Tm9kZVxufVxuXG5mdW5jdGlvbiBuZXh0U2libGluZyAobm9kZSkge1xuICByZXR1cm4gbm9kZS5uZXh0U2libGluZ1xufVxuXG5mdW5jdGlvbiB0YWdOYW1lIChub2RlKSB7XG4gIHJldHVybiBub2RlLnRhZ05hbWVcbn1cblxuZnVuY3Rpb24gc2V0VGV4dENvbnRlbnQgKG5vZGUsIHRleHQpIHtcbiAgbm9kZS50ZXh0Q29udGVudCA9IHRleHQ7XG59XG5cbmZ1bmN0aW9uIHNldFN0eWxlU2NvcGUgKG5vZGUsIHNjb3BlSWQpIHtcbiAgbm9kZS5zZXRBdHRyaWJ1dGUoc2NvcGVJZCwgJycpO1xufVxuXG5cbnZhciBub2RlT3BzID0gT2JqZWN0LmZyZWV6ZSh7XG5cdGNyZWF0ZUVsZW1lbnQ6IGNyZWF0ZUVsZW1lbnQkMSxcblx0Y3JlYXRlRWxlbWVudE5TOiBjcmVhdGVFbGVtZW50TlMsXG5cdGNyZWF0ZVRleHROb2RlOiBjcmVhdGVUZXh0Tm9kZSxcblx0Y3JlYXRlQ29tbWVudDogY3JlYXRlQ29tbWVudCxcblx0aW5zZXJ0QmVmb3JlOiBpbnNlcnRCZWZvcmUsXG5cdHJlbW92ZUNoaWxkOiByZW1vdmVDaGlsZCxcblx0YXBwZW5kQ2hpbGQ6IGFwcGVuZENoaWxkLFxuXHRwYXJlbnROb2RlOiBwYXJlbnROb2RlLFxuXHRuZXh0U2libGluZzogbmV4dFNpYmxpbmcsXG5cdHRhZ05hbWU6IHRhZ05hbWUsXG5cdHNldFRleHRDb250ZW50OiBzZXRUZXh0Q29udGVudCxcblx0c2V0U3R5bGVTY29wZTogc2V0U3R5bGVTY29wZVxufSk7XG5cbi8qICAqL1xuXG52YXIgcmVmID0ge1xuICBjcmVhdGU6IGZ1bmN0aW9uIGNyZWF0ZSAoXywgdm5vZGUpIHtcbiAgICByZWdpc3RlclJlZih2bm9kZSk7XG4gIH0sXG4gIHVwZGF0ZTogZnVuY3Rpb24gdXBkYXRlIChvbGRWbm9kZSwgdm5vZGUpIHtcbiAgICBpZiAob2xkVm5vZGUuZGF0YS5yZWYgIT09IHZub2RlLmRhdGEucmVmKSB7XG4gICAgICByZWdpc3RlclJlZihvbGRWbm9kZSwgdHJ1ZSk7XG4gICAgICByZWdpc3RlclJlZih2bm9kZSk7XG4gICAgfVxuICB9LFxuICBkZXN0cm95OiBmdW5jdGlvbiBkZXN0cm95ICh2bm9kZSkge1xuICAgIHJlZ2lzdGVyUmVmKHZub2RlLCB0cnVlKTtcbiAgfVxufVxuXG5mdW5jdGlvbiByZWdpc3RlclJlZiAodm5vZGUsIGlzUmVtb3ZhbCkge1xuICB2YXIga2V5ID0gdm5vZGUuZGF0YS5yZWY7XG4gIGlmICghaXNEZWYoa2V5KSkgeyByZXR1cm4gfVxuXG4gIHZhciB2bSA9IHZub2RlLmNvbnRleHQ7XG4gIHZhciByZWYgPSB2bm9kZS5jb21wb25lbnRJbnN0YW5jZSB8fCB2bm9kZS5lbG07XG4gIHZhciByZWZzID0gdm0uJHJlZnM7XG4gIGlmIChpc1JlbW92YWwpIHtcbiAgICBpZiAoQXJyYXkuaXNBcnJheShyZWZzW2tleV0pKSB7XG4gICAgICByZW1vdmUocmVmc1trZXldLCByZWYpO1xuICAgIH0gZWxzZSBpZiAocmVmc1trZXldID09PSByZWYpIHtcbiAgICAgIHJlZnNba2V5XSA9IHVuZGVmaW5lZDtcbiAgICB9XG4gIH0gZWxzZSB7XG4gICAgaWYgKHZub2RlLmRhdGEucmVmSW5Gb3IpIHtcbiAgICAgIGlmICghQXJyYXkuaXNBcnJheShyZWZzW2tleV0pKSB7XG4gICAgICAgIHJlZnNba2V5XSA9IFtyZWZdO1xuICAgICAgfSBlbHNlIGlmIChyZWZzW2tleV0uaW5kZXhPZihyZWYpIDwgMCkge1xuICAgICAgICAvLyAkZmxvdy1kaXNhYmxlLWxpbmVcbiAgICAgICAgcmVmc1trZXldLnB1c2gocmVmKTtcbiAgICAgIH1cbiAgICB9IGVsc2Uge1xuICAgICAgcmVmc1trZXldID0gcmVmO1xuICAgIH1cbiAgfVxufVxuXG4vKipcbiAqIFZpcnR1YWwgRE9NIHBhdGNoaW5nIGFsZ29yaXRobSBiYXNlZCBvbiBTbmFiYmRvbSBieVxuICogU2ltb24gRnJpaXMgVmluZHVtIChAcGFsZGVwaW5kKVxuICogTGljZW5zZWQgdW5kZXIgdGhlIE1JVCBMaWNlbnNlXG4gKiBodHRwczovL2dpdGh1Yi5jb20vcGFsZGVwaW5kL3NuYWJiZG9tL2Jsb2IvbWFzdGVyL0xJQ0VOU0VcbiAqXG4gKiBtb2RpZmllZCBieSBFdmFuIFlvdSAoQHl5eDk5MDgwMylcbiAqXG4gKiBOb3QgdHlwZS1jaGVja2luZyB0aGlzIGJlY2F1c2UgdGhpcyBmaWxlIGlzIHBlcmYtY3JpdGljYWwgYW5kIHRoZSBjb3N0XG4gKiBvZiBtYWtpbmcgZmxvdyB1bmRlcnN0YW5kIGl0IGlzIG5vdCB3b3J0aCBpdC5cbiAqL1xuXG52YXIgZW1wdHlOb2RlID0gbmV3IFZOb2RlKCcnLCB7fSwgW10pO1xuXG52YXIgaG9va3MgPSBbJ2NyZWF0ZScsICdhY3RpdmF0ZScsICd1cGRhdGUnLCAncmVtb3ZlJywgJ2Rlc3Ryb3knXTtcblxuZnVuY3Rpb24gc2FtZVZub2RlIChhLCBiKSB7XG4gIHJldHVybiAoXG4gICAgYS5rZXkgPT09IGIua2V5ICYmIChcbiAgICAgIChcbiAgICAgICAgYS50YWcgPT09IGIudGFnICYmXG4gICAgICAgIGEuaXNDb21tZW50ID09PSBiLmlzQ29tbWVudCAmJlxuICAgICAgICBpc0RlZihhLmRhdGEpID09PSBpc0RlZihiLmRhdGEpICYmXG4gICAgICAgIHNhbWVJbnB1dFR5cGUoYSwgYilcbiAgICAgICkgfHwgKFxuICAgICAgICBpc1RydWUoYS5pc0FzeW5jUGxhY2Vob2xkZXIpICYmXG4gICAgICAgIGEuYXN5bmNGYWN0b3J5ID09PSBiLmFzeW5jRmFjdG9yeSAmJlxuICAgICAgICBpc1VuZGVmKGIuYXN5bmNGYWN0b3J5LmVycm9yKVxuICAgICAgKVxuICAgIClcbiAgKVxufVxuXG5mdW5jdGlvbiBzYW1lSW5wdXRUeXBlIChhLCBiKSB7XG4gIGlmIChhLnRhZyAhPT0gJ2lucHV0JykgeyByZXR1cm4gdHJ1ZSB9XG4gIHZhciBpO1xuICB2YXIgdHlwZUEgPSBpc0RlZihpID0gYS5kYXRhKSAmJiBpc0RlZihpID0gaS5hdHRycykgJiYgaS50eXBlO1xuICB2YXIgdHlwZUIgPSBpc0RlZihpID0gYi5kYXRhKSAmJiBpc0RlZihpID0gaS5hdHRycykgJiYgaS50eXBlO1xuICByZXR1cm4gdHlwZUEgPT09IHR5cGVCIHx8IGlzVGV4dElucHV0VHlwZSh0eXBlQSkgJiYgaXNUZXh0SW5wdXRUeXBlKHR5cGVCKVxufVxuXG5mdW5jdGlvbiBjcmVhdGVLZXlUb09sZElkeCAoY2hpbGRyZW4sIGJlZ2luSWR4LCBlbmRJZHgpIHtcbiAgdmFyIGksIGtleTtcbiAgdmFyIG1hcCA9IHt9O1xuICBmb3IgKGkgPSBiZWdpbklkeDsgaSA8PSBlbmRJZHg7ICsraSkge1xuICAgIGtleSA9IGNoaWxkcmVuW2ldLmtleTtcbiAgICBpZiAoaXNEZWYoa2V5KSkgeyBtYXBba2V5XSA9IGk7IH1cbiAgfVxuICByZXR1cm4gbWFwXG59XG5cbmZ1bmN0aW9uIGNyZWF0ZVBhdGNoRnVuY3Rpb24gKGJhY2tlbmQpIHtcbiAgdmFyIGksIGo7XG4gIHZhciBjYnMgPSB7fTtcblxuICB2YXIgbW9kdWxlcyA9IGJhY2tlbmQubW9kdWxlcztcbiAgdmFyIG5vZGVPcHMgPSBiYWNrZW5kLm5vZGVPcHM7XG5cbiAgZm9yIChpID0gMDsgaSA8IGhvb2tzLmxlbmd0aDsgKytpKSB7XG4gICAgY2JzW2hvb2tzW2ldXSA9IFtdO1xuICAgIGZvciAoaiA9IDA7IGogPCBtb2R1bGVzLmxlbmd0aDsgKytqKSB7XG4gICAgICBpZiAoaXNEZWYobW9kdWxlc1tqXVtob29rc1tpXV0pKSB7XG4gICAgICAgIGNic1tob29rc1tpXV0ucHVzaChtb2R1bGVzW2pdW2hvb2tzW2ldXSk7XG4gICAgICB9XG4gICAgfVxuICB9XG5cbiAgZnVuY3Rpb24gZW1wdHlOb2RlQXQgKGVsbSkge1xuICAgIHJldHVybiBuZXcgVk5vZGUobm9kZU9wcy50YWdOYW1lKGVsbSkudG9Mb3dlckNhc2UoKSwge30sIFtdLCB1bmRlZmluZWQsIGVsbSlcbiAgfVxuXG4gIGZ1bmN0aW9uIGNyZWF0ZVJtQ2IgKGNoaWxkRWxtLCBsaXN0ZW5lcnMpIHtcbiAgICBmdW5jdGlvbiByZW1vdmUgKCkge1xuICAgICAgaWYgKC0tcmVtb3ZlLmxpc3RlbmVycyA9PT0gMCkge1xuICAgICAgICByZW1vdmVOb2RlKGNoaWxkRWxtKTtcbiAgICAgIH1cbiAgICB9XG4gICAgcmVtb3ZlLmxpc3RlbmVycyA9IGxpc3RlbmVycztcbiAgICByZXR1cm4gcmVtb3ZlXG4gIH1cblxuICBmdW5jdGlvbiByZW1vdmVOb2RlIChlbCkge1xuICAgIHZhciBwYXJlbnQgPSBub2RlT3BzLnBhcmVudE5vZGUoZWwpO1xuICAgIC8vIGVsZW1lbnQgbWF5IGhhdmUgYWxyZWFkeSBiZWVuIHJlbW92ZWQgZHVlIHRvIHYtaHRtbCAvIHYtdGV4dFxuICAgIGlmIChpc0RlZihwYXJlbnQpKSB7XG4gICAgICBub2RlT3BzLnJlbW92ZUNoaWxkKHBhcmVudCwgZWwpO1xuICAgIH1cbiAgfVxuXG4gIGZ1bmN0aW9uIGlzVW5rbm93bkVsZW1lbnQkJDEgKHZub2RlLCBpblZQcmUpIHtcbiAgICByZXR1cm4gKFxuICAgICAgIWluVlByZSAmJlxuICAgICAgIXZub2RlLm5zICYmXG4gICAgICAhKFxuICAgICAgICBjb25maWcuaWdub3JlZEVsZW1lbnRzLmxlbmd0aCAmJlxuICAgICAgICBjb25maWcuaWdub3JlZEVsZW1lbnRzLnNvbWUoZnVuY3Rpb24gKGlnbm9yZSkge1xuICAgICAgICAgIHJldHVybiBpc1JlZ0V4cChpZ25vcmUpXG4gICAgICAgICAgICA/IGlnbm9yZS50ZXN0KHZub2RlLnRhZylcbiAgICAgICAgICAgIDogaWdub3JlID09PSB2bm9kZS50YWdcbiAgICAgICAgfSlcbiAgICAgICkgJiZcbiAgICAgIGNvbmZpZy5pc1Vua25vd25FbGVtZW50KHZub2RlLnRhZylcbiAgICApXG4gIH1cblxuICB2YXIgY3JlYXRpbmdFbG1JblZQcmUgPSAwO1xuXG4gIGZ1bmN0aW9uIGNyZWF0ZUVsbSAoXG4gICAgdm5vZGUsXG4gICAgaW5zZXJ0ZWRWbm9kZVF1ZXVlLFxuICAgIHBhcmVudEVsbSxcbiAgICByZWZFbG0sXG4gICAgbmVzdGVkLFxuICAgIG93bmVyQXJyYXksXG4gICAgaW5kZXhcbiAgKSB7XG4gICAgaWYgKGlzRGVmKHZub2RlLmVsbSkgJiYgaXNEZWYob3duZXJBcnJheSkpIHtcbiAgICAgIC8vIFRoaXMgdm5vZGUgd2FzIHVzZWQgaW4gYSBwcmV2aW91cyByZW5kZXIhXG4gICAgICAvLyBub3cgaXQncyB1c2VkIGFzIGEgbmV3IG5vZGUsIG92ZXJ3cml0aW5nIGl0cyBlbG0gd291bGQgY2F1c2VcbiAgICAgIC8vIHBvdGVudGlhbCBwYXRjaCBlcnJvcnMgZG93biB0aGUgcm9hZCB3aGVuIGl0J3MgdXNlZCBhcyBhbiBpbnNlcnRpb25cbiAgICAgIC8vIHJlZmVyZW5jZSBub2RlLiBJbnN0ZWFkLCB3ZSBjbG9uZSB0aGUgbm9kZSBvbi1kZW1hbmQgYmVmb3JlIGNyZWF0aW5nXG4gICAgICAvLyBhc3NvY2lhdGVkIERPTSBlbGVtZW50IGZvciBpdC5cbiAgICAgIHZub2RlID0gb3duZXJBcnJheVtpbmRleF0gPSBjbG9uZVZOb2RlKHZub2RlKTtcbiAgICB9XG5cbiAgICB2bm9kZS5pc1Jvb3RJbnNlcnQgPSAhbmVzdGVkOyAvLyBmb3IgdHJhbnNpdGlvbiBlbnRlciBjaGVja1xuICAgIGlmIChjcmVhdGVDb21wb25lbnQodm5vZGUsIGluc2VydGVkVm5vZGVRdWV1ZSwgcGFyZW50RWxtLCByZWZFbG0pKSB7XG4gICAgICByZXR1cm5cbiAgICB9XG5cbiAgICB2YXIgZGF0YSA9IHZub2RlLmRhdGE7XG4gICAgdmFyIGNoaWxkcmVuID0gdm5vZGUuY2hpbGRyZW47XG4gICAgdmFyIHRhZyA9IHZub2RlLnRhZztcbiAgICBpZiAoaXNEZWYodGFnKSkge1xuICAgICAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicpIHtcbiAgICAgICAgaWYgKGRhdGEgJiYgZGF0YS5wcmUpIHtcbiAgICAgICAgICBjcmVhdGluZ0VsbUluVlByZSsrO1xuICAgICAgICB9XG4gICAgICAgIGlmIChpc1Vua25vd25FbGVtZW50JCQxKHZub2RlLCBjcmVhdGluZ0VsbUluVlByZSkpIHtcbiAgICAgICAgICB3YXJuKFxuICAgICAgICAgICAgJ1Vua25vd24gY3VzdG9tIGVsZW1lbnQ6IDwnICsgdGFnICsgJz4gLSBkaWQgeW91ICcgK1xuICAgICAgICAgICAgJ3JlZ2lzdGVyIHRoZSBjb21wb25lbnQgY29ycmVjdGx5PyBGb3IgcmVjdXJzaXZlIGNvbXBvbmVudHMsICcgK1xuICAgICAgICAgICAgJ21ha2Ugc3VyZSB0byBwcm92aWRlIHRoZSBcIm5hbWVcIiBvcHRpb24uJyxcbiAgICAgICAgICAgIHZub2RlLmNvbnRleHRcbiAgICAgICAgICApO1xuICAgICAgICB9XG4gICAgICB9XG5cbiAgICAgIHZub2RlLmVsbSA9IHZub2RlLm5zXG4gICAgICAgID8gbm9kZU9wcy5jcmVhdGVFbGVtZW50TlModm5vZGUubnMsIHRhZylcbiAgICAgICAgOiBub2RlT3BzLmNyZWF0ZUVsZW1lbnQodGFnLCB2bm9kZSk7XG4gICAgICBzZXRTY29wZSh2bm9kZSk7XG5cbiAgICAgIC8qIGlzdGFuYnVsIGlnbm9yZSBpZiAqL1xuICAgICAge1xuICAgICAgICBjcmVhdGVDaGlsZHJlbih2bm9kZSwgY2hpbGRyZW4sIGluc2VydGVkVm5vZGVRdWV1ZSk7XG4gICAgICAgIGlmIChpc0RlZihkYXRhKSkge1xuICAgICAgICAgIGludm9rZUNyZWF0ZUhvb2tzKHZub2RlLCBpbnNlcnRlZFZub2RlUXVldWUpO1xuICAgICAgICB9XG4gICAgICAgIGluc2VydChwYXJlbnRFbG0sIHZub2RlLmVsbSwgcmVmRWxtKTtcbiAgICAgIH1cblxuICAgICAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiYgZGF0YSAmJiBkYXRhLnByZSkge1xuICAgICAgICBjcmVhdGluZ0VsbUluVlByZS0tO1xuICAgICAgfVxuICAgIH0gZWxzZSBpZiAoaXNUcnVlKHZub2RlLmlzQ29tbWVudCkpIHtcbiAgICAgIHZub2RlLmVsbSA9IG5vZGVPcHMuY3JlYXRlQ29tbWVudCh2bm9kZS50ZXh0KTtcbiAgICAgIGluc2VydChwYXJlbnRFbG0sIHZub2RlLmVsbSwgcmVmRWxtKTtcbiAgICB9IGVsc2Uge1xuICAgICAgdm5vZGUuZWxtID0gbm9kZU9wcy5jcmVhdGVUZXh0Tm9kZSh2bm9kZS50ZXh0KTtcbiAgICAgIGluc2VydChwYXJlbnRFbG0sIHZub2RlLmVsbSwgcmVmRWxtKTtcbiAgICB9XG4gIH1cblxuICBmdW5jdGlvbiBjcmVhdGVDb21wb25lbnQgKHZub2RlLCBpbnNlcnRlZFZub2RlUXVldWUsIHBhcmVudEVsbSwgcmVmRWxtKSB7XG4gICAgdmFyIGkgPSB2bm9kZS5kYXRhO1xuICAgIGlmIChpc0RlZihpKSkge1xuICAgICAgdmFyIGlzUmVhY3RpdmF0ZWQgPSBpc0RlZih2bm9kZS5jb21wb25lbnRJbnN0YW5jZSkgJiYgaS5rZWVwQWxpdmU7XG4gICAgICBpZiAoaXNEZWYoaSA9IGkuaG9vaykgJiYgaXNEZWYoaSA9IGkuaW5pdCkpIHtcbiAgICAgICAgaSh2bm9kZSwgZmFsc2UgLyogaHlkcmF0aW5nICovLCBwYXJlbnRFbG0sIHJlZkVsbSk7XG4gICAgICB9XG4gICAgICAvLyBhZnRlciBjYWxsaW5nIHRoZSBpbml0IGhvb2ssIGlmIHRoZSB2bm9kZSBpcyBhIGNoaWxkIGNvbXBvbmVudFxuICAgICAgLy8gaXQgc2hvdWxkJ3ZlIGNyZWF0ZWQgYSBjaGlsZCBpbnN0YW5jZSBhbmQgbW91bnRlZCBpdC4gdGhlIGNoaWxkXG4gICAgICAvLyBjb21wb25lbnQgYWxzbyBoYXMgc2V0IHRoZSBwbGFjZWhvbGRlciB2bm9kZSdzIGVsbS5cbiAgICAgIC8vIGluIHRoYXQgY2FzZSB3ZSBjYW4ganVzdCByZXR1cm4gdGhlIGVsZW1lbnQgYW5kIGJlIGRvbmUuXG4gICAgICBpZiAoaXNEZWYodm5vZGUuY29tcG9uZW50SW5zdGFuY2UpKSB7XG4gICAgICAgIGluaXRDb21wb25lbnQodm5vZGUsIGluc2VydGVkVm5vZGVRdWV1ZSk7XG4gICAgICAgIGlmIChpc1RydWUoaXNSZWFjdGl2YXRlZCkpIHtcbiAgICAgICAgICByZWFjdGl2YXRlQ29tcG9uZW50KHZub2RlLCBpbnNlcnRlZFZub2RlUXVldWUsIHBhcmVudEVsbSwgcmVmRWxtKTtcbiAgICAgICAgfVxuICAgICAgICByZXR1cm4gdHJ1ZVxuICAgICAgfVxuICAgIH1cbiAgfVxuXG4gIGZ1bmN0aW9uIGluaXRDb21wb25lbnQgKHZub2RlLCBpbnNlcnRlZFZub2RlUXVldWUpIHtcbiAgICBpZiAoaXNEZWYodm5vZGUuZGF0YS5wZW5kaW5nSW5zZXJ0KSkge1xuICAgICAgaW5zZXJ0ZWRWbm9kZVF1ZXVlLnB1c2guYXBwbHkoaW5zZXJ0ZWRWbm9kZVF1ZXVlLCB2bm9kZS5kYXRhLnBlbmRpbmdJbnNlcnQpO1xuICAgICAgdm5vZGUuZGF0YS5wZW5kaW5nSW5zZXJ0ID0gbnVsbDtcbiAgICB9XG4gICAgdm5vZGUuZWxtID0gdm5vZGUuY29tcG9uZW50SW5zdGFuY2UuJGVsO1xuICAgIGlmIChpc1BhdGNoYWJsZSh2bm9kZSkpIHtcbiAgICAgIGludm9rZUNyZWF0ZUhvb2tzKHZub2RlLCBpbnNlcnRlZFZub2RlUXVldWUpO1xuICAgICAgc2V0U2NvcGUodm5vZGUpO1xuICAgIH0gZWxzZSB7XG4gICAgICAvLyBlbXB0eSBjb21wb25lbnQgcm9vdC5cbiAgICAgIC8vIHNraXAgYWxsIGVsZW1lbnQtcmVsYXRlZCBtb2R1bGVzIGV4Y2VwdCBmb3IgcmVmICgjMzQ1NSlcbiAgICAgIHJlZ2lzdGVyUmVmKHZub2RlKTtcbiAgICAgIC8vIG1ha2Ugc3VyZSB0byBpbnZva2UgdGhlIGluc2VydCBob29rXG4gICAgICBpbnNlcnRlZFZub2RlUXVldWUucHVzaCh2bm9kZSk7XG4gICAgfVxuICB9XG5cbiAgZnVuY3Rpb24gcmVhY3RpdmF0ZUNvbXBvbmVudCAodm5vZGUsIGluc2VydGVkVm5vZGVRdWV1ZSwgcGFyZW50RWxtLCByZWZFbG0pIHtcbiAgICB2YXIgaTtcbiAgICAvLyBoYWNrIGZvciAjNDMzOTogYSByZWFjdGl2YXRlZCBjb21wb25lbnQgd2l0aCBpbm5lciB0cmFuc2l0aW9uXG4gICAgLy8gZG9lcyBub3QgdHJpZ2dlciBiZWNhdXNlIHRoZSBpbm5lciBub2RlJ3MgY3JlYXRlZCBob29rcyBhcmUgbm90IGNhbGxlZFxuICAgIC8vIGFnYWluLiBJdCdzIG5vdCBpZGVhbCB0byBpbnZvbHZlIG1vZHVsZS1zcGVjaWZpYyBsb2dpYyBpbiBoZXJlIGJ1dFxuICAgIC8vIHRoZXJlIGRvZXNuJ3Qgc2VlbSB0byBiZSBhIGJldHRlciB3YXkgdG8gZG8gaXQuXG4gICAgdmFyIGlubmVyTm9kZSA9IHZub2RlO1xuICAgIHdoaWxlIChpbm5lck5vZGUuY29tcG9uZW50SW5zdGFuY2UpIHtcbiAgICAgIGlubmVyTm9kZSA9IGlubmVyTm9kZS5jb21wb25lbnRJbnN0YW5jZS5fdm5vZGU7XG4gICAgICBpZiAoaXNEZWYoaSA9IGlubmVyTm9kZS5kYXRhKSAmJiBpc0RlZihpID0gaS50cmFuc2l0aW9uKSkge1xuICAgICAgICBmb3IgKGkgPSAwOyBpIDwgY2JzLmFjdGl2YXRlLmxlbmd0aDsgKytpKSB7XG4gICAgICAgICAgY2JzLmFjdGl2YXRlW2ldKGVtcHR5Tm9kZSwgaW5uZXJOb2RlKTtcbiAgICAgICAgfVxuICAgICAgICBpbnNlcnRlZFZub2RlUXVldWUucHVzaChpbm5lck5vZGUpO1xuICAgICAgICBicmVha1xuICAgICAgfVxuICAgIH1cbiAgICAvLyB1bmxpa2UgYSBuZXdseSBjcmVhdGVkIGNvbXBvbmVudCxcbiAgICAvLyBhIHJlYWN0aXZhdGVkIGtlZXAtYWxpdmUgY29tcG9uZW50IGRvZXNuJ3QgaW5zZXJ0IGl0c2VsZlxuICAgIGluc2VydChwYXJlbnRFbG0sIHZub2RlLmVsbSwgcmVmRWxtKTtcbiAgfVxuXG4gIGZ1bmN0aW9uIGluc2VydCAocGFyZW50LCBlbG0sIHJlZiQkMSkge1xuICAgIGlmIChpc0RlZihwYXJlbnQpKSB7XG4gICAgICBpZiAoaXNEZWYocmVmJCQxKSkge1xuICAgICAgICBpZiAocmVmJCQxLnBhcmVudE5vZGUgPT09IHBhcmVudCkge1xuICAgICAgICAgIG5vZGVPcHMuaW5zZXJ0QmVmb3JlKHBhcmVudCwgZWxtLCByZWYkJDEpO1xuICAgICAgICB9XG4gICAgICB9IGVsc2Uge1xuICAgICAgICBub2RlT3BzLmFwcGVuZENoaWxkKHBhcmVudCwgZWxtKTtcbiAgICAgIH1cbiAgICB9XG4gIH1cblxuICBmdW5jdGlvbiBjcmVhdGVDaGlsZHJlbiAodm5vZGUsIGNoaWxkcmVuLCBpbnNlcnRlZFZub2RlUXVldWUpIHtcbiAgICBpZiAoQXJyYXkuaXNBcnJheShjaGlsZHJlbikpIHtcbiAgICAgIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nKSB7XG4gICAgICAgIGNoZWNrRHVwbGljYXRlS2V5cyhjaGlsZHJlbik7XG4gICAgICB9XG4gICAgICBmb3IgKHZhciBpID0gMDsgaSA8IGNoaWxkcmVuLmxlbmd0aDsgKytpKSB7XG4gICAgICAgIGNyZWF0ZUVsbShjaGlsZHJlbltpXSwgaW5zZXJ0ZWRWbm9kZVF1ZXVlLCB2bm9kZS5lbG0sIG51bGwsIHRydWUsIGNoaWxkcmVuLCBpKTtcbiAgICAgIH1cbiAgICB9IGVsc2UgaWYgKGlzUHJpbWl0aXZlKHZub2RlLnRleHQpKSB7XG4gICAgICBub2RlT3BzLmFwcGVuZENoaWxkKHZub2RlLmVsbSwgbm9kZU9wcy5jcmVhdGVUZXh0Tm9kZShTdHJpbmcodm5vZGUudGV4dCkpKTtcbiAgICB9XG4gIH1cblxuICBmdW5jdGlvbiBpc1BhdGNoYWJsZSAodm5vZGUpIHtcbiAgICB3aGlsZSAodm5vZGUuY29tcG9uZW50SW5zdGFuY2UpIHtcbiAgICAgIHZub2RlID0gdm5vZGUuY29tcG9uZW50SW5zdGFuY2UuX3Zub2RlO1xuICAgIH1cbiAgICByZXR1cm4gaXNEZWYodm5vZGUudGFnKVxuICB9XG5cbiAgZnVuY3Rpb24gaW52b2tlQ3JlYXRlSG9va3MgKHZub2RlLCBpbnNlcnRlZFZub2RlUXVldWUpIHtcbiAgICBmb3IgKHZhciBpJDEgPSAwOyBpJDEgPCBjYnMuY3JlYXRlLmxlbmd0aDsgKytpJDEpIHtcbiAgICAgIGNicy5jcmVhdGVbaSQxXShlbXB0eU5vZGUsIHZub2RlKTtcbiAgICB9XG4gICAgaSA9IHZub2RlLmRhdGEuaG9vazsgLy8gUmV1c2UgdmFyaWFibGVcbiAgICBpZiAoaXNEZWYoaSkpIHtcbiAgICAgIGlmIChpc0RlZihpLmNyZWF0ZSkpIHsgaS5jcmVhdGUoZW1wdHlOb2RlLCB2bm9kZSk7IH1cbiAgICAgIGlmIChpc0RlZihpLmluc2VydCkpIHsgaW5zZXJ0ZWRWbm9kZVF1ZXVlLnB1c2godm5vZGUpOyB9XG4gICAgfVxuICB9XG5cbiAgLy8gc2V0IHNjb3BlIGlkIGF0dHJpYnV0ZSBmb3Igc2NvcGVkIENTUy5cbiAgLy8gdGhpcyBpcyBpbXBsZW1lbnRlZCBhcyBhIHNwZWNpYWwgY2FzZSB0byBhdm9pZCB0aGUgb3ZlcmhlYWRcbiAgLy8gb2YgZ29pbmcgdGhyb3VnaCB0aGUgbm9ybWFsIGF0dHJpYnV0ZSBwYXRjaGluZyBwcm9jZXNzLlxuICBmdW5jdGlvbiBzZXRTY29wZSAodm5vZGUpIHtcbiAgICB2YXIgaTtcbiAgICBpZiAoaXNEZWYoaSA9IHZub2RlLmZuU2NvcGVJZCkpIHtcbiAgICAgIG5vZGVPcHMuc2V0U3R5bGVTY29wZSh2bm9kZS5lbG0sIGkpO1xuICAgIH0gZWxzZSB7XG4gICAgICB2YXIgYW5jZXN0b3IgPSB2bm9kZTtcbiAgICAgIHdoaWxlIChhbmNlc3Rvcikge1xuICAgICAgICBpZiAoaXNEZWYoaSA9IGFuY2VzdG9yLmNvbnRleHQpICYmIGlzRGVmKGkgPSBpLiRvcHRpb25zLl9zY29wZUlkKSkge1xuICAgICAgICAgIG5vZGVPcHMuc2V0U3R5bGVTY29wZSh2bm9kZS5lbG0sIGkpO1xuICAgICAgICB9XG4gICAgICAgIGFuY2VzdG9yID0gYW5jZXN0b3IucGFyZW50O1xuICAgICAgfVxuICAgIH1cbiAgICAvLyBmb3Igc2xvdCBjb250ZW50IHRoZXkgc2hvdWxkIGFsc28gZ2V0IHRoZSBzY29wZUlkIGZyb20gdGhlIGhvc3QgaW5zdGFuY2UuXG4gICAgaWYgKGlzRGVmKGkgPSBhY3RpdmVJbnN0YW5jZSkgJiZcbiAgICAgIGkgIT09IHZub2RlLmNvbnRleHQgJiZcbiAgICAgIGkgIT09IHZub2RlLmZuQ29udGV4dCAmJlxuICAgICAgaXNEZWYoaSA9IGkuJG9wdGlvbnMuX3Njb3BlSWQpXG4gICAgKSB7XG4gICAgICBub2RlT3BzLnNldFN0eWxlU2NvcGUodm5vZGUuZWxtLCBpKTtcbiAgICB9XG4gIH1cblxuICBmdW5jdGlvbiBhZGRWbm9kZXMgKHBhcmVudEVsbSwgcmVmRWxtLCB2bm9kZXMsIHN0YXJ0SWR4LCBlbmRJZHgsIGluc2VydGVkVm5vZGVRdWV1ZSkge1xuICAgIGZvciAoOyBzdGFydElkeCA8PSBlbmRJZHg7ICsrc3RhcnRJZHgpIHtcbiAgICAgIGNyZWF0ZUVsbSh2bm9kZXNbc3RhcnRJZHhdLCBpbnNlcnRlZFZub2RlUXVldWUsIHBhcmVudEVsbSwgcmVmRWxtLCBmYWxzZSwgdm5vZGVzLCBzdGFydElkeCk7XG4gICAgfVxuICB9XG5cbiAgZnVuY3Rpb24gaW52b2tlRGVzdHJveUhvb2sgKHZub2RlKSB7XG4gICAgdmFyIGksIGo7XG4gICAgdmFyIGRhdGEgPSB2bm9kZS5kYXRhO1xuICAgIGlmIChpc0RlZihkYXRhKSkge1xuICAgICAgaWYgKGlzRGVmKGkgPSBkYXRhLmhvb2spICYmIGlzRGVmKGkgPSBpLmRlc3Ryb3kpKSB7IGkodm5vZGUpOyB9XG4gICAgICBmb3IgKGkgPSAwOyBpIDwgY2JzLmRlc3Ryb3kubGVuZ3RoOyArK2kpIHsgY2JzLmRlc3Ryb3lbaV0odm5vZGUpOyB9XG4gICAgfVxuICAgIGlmIChpc0RlZihpID0gdm5vZGUuY2hpbGRyZW4pKSB7XG4gICAgICBmb3IgKGogPSAwOyBqIDwgdm5vZGUuY2hpbGRyZW4ubGVuZ3RoOyArK2opIHtcbiAgICAgICAgaW52b2tlRGVzdHJveUhvb2sodm5vZGUuY2hpbGRyZW5bal0pO1xuICAgICAgfVxuICAgIH1cbiAgfVxuXG4gIGZ1bmN0aW9uIHJlbW92ZVZub2RlcyAocGFyZW50RWxtLCB2bm9kZXMsIHN0YXJ0SWR4LCBlbmRJZHgpIHtcbiAgICBmb3IgKDsgc3RhcnRJZHggPD0gZW5kSWR4OyArK3N0YXJ0SWR4KSB7XG4gICAgICB2YXIgY2ggPSB2bm9kZXNbc3RhcnRJZHhdO1xuICAgICAgaWYgKGlzRGVmKGNoKSkge1xuICAgICAgICBpZiAoaXNEZWYoY2gudGFnKSkge1xuICAgICAgICAgIHJlbW92ZUFuZEludm9rZVJlbW92ZUhvb2soY2gpO1xuICAgICAgICAgIGludm9rZURlc3Ryb3lIb29rKGNoKTtcbiAgICAgICAgfSBlbHNlIHsgLy8gVGV4dCBub2RlXG4gICAgICAgICAgcmVtb3ZlTm9kZShjaC5lbG0pO1xuICAgICAgICB9XG4gICAgICB9XG4gICAgfVxuICB9XG5cbiAgZnVuY3Rpb24gcmVtb3ZlQW5kSW52b2tlUmVtb3ZlSG9vayAodm5vZGUsIHJtKSB7XG4gICAgaWYgKGlzRGVmKHJtKSB8fCBpc0RlZih2bm9kZS5kYXRhKSkge1xuICAgICAgdmFyIGk7XG4gICAgICB2YXIgbGlzdGVuZXJzID0gY2JzLnJlbW92ZS5sZW5ndGggKyAxO1xuICAgICAgaWYgKGlzRGVmKHJtKSkge1xuICAgICAgICAvLyB3ZSBoYXZlIGEgcmVjdXJzaXZlbHkgcGFzc2VkIGRvd24gcm0gY2FsbGJhY2tcbiAgICAgICAgLy8gaW5jcmVhc2UgdGhlIGxpc3RlbmVycyBjb3VudFxuICAgICAgICBybS5saXN0ZW5lcnMgKz0gbGlzdGVuZXJzO1xuICAgICAgfSBlbHNlIHtcbiAgICAgICAgLy8gZGlyZWN0bHkgcmVtb3ZpbmdcbiAgICAgICAgcm0gPSBjcmVhdGVSbUNiKHZub2RlLmVsbSwgbGlzdGVuZXJzKTtcbiAgICAgIH1cbiAgICAgIC8vIHJlY3Vyc2l2ZWx5IGludm9rZSBob29rcyBvbiBjaGlsZCBjb21wb25lbnQgcm9vdCBub2RlXG4gICAgICBpZiAoaXNEZWYoaSA9IHZub2RlLmNvbXBvbmVudEluc3RhbmNlKSAmJiBpc0RlZihpID0gaS5fdm5vZGUpICYmIGlzRGVmKGkuZGF0YSkpIHtcbiAgICAgICAgcmVtb3ZlQW5kSW52b2tlUmVtb3ZlSG9vayhpLCBybSk7XG4gICAgICB9XG4gICAgICBmb3IgKGkgPSAwOyBpIDwgY2JzLnJlbW92ZS5sZW5ndGg7ICsraSkge1xuICAgICAgICBjYnMucmVtb3ZlW2ldKHZub2RlLCBybSk7XG4gICAgICB9XG4gICAgICBpZiAoaXNEZWYoaSA9IHZub2RlLmRhdGEuaG9vaykgJiYgaXNEZWYoaSA9IGkucmVtb3ZlKSkge1xuICAgICAgICBpKHZub2RlLCBybSk7XG4gICAgICB9IGVsc2Uge1xuICAgICAgICBybSgpO1xuICAgICAgfVxuICAgIH0gZWxzZSB7XG4gICAgICByZW1vdmVOb2RlKHZub2RlLmVsbSk7XG4gICAgfVxuICB9XG5cbiAgZnVuY3Rpb24gdXBkYXRlQ2hpbGRyZW4gKHBhcmVudEVsbSwgb2xkQ2gsIG5ld0NoLCBpbnNlcnRlZFZub2RlUXVldWUsIHJlbW92ZU9ubHkpIHtcbiAgICB2YXIgb2xkU3RhcnRJZHggPSAwO1xuICAgIHZhciBuZXdTdGFydElkeCA9IDA7XG4gICAgdmFyIG9sZEVuZElkeCA9IG9sZENoLmxlbmd0aCAtIDE7XG4gICAgdmFyIG9sZFN0YXJ0Vm5vZGUgPSBvbGRDaFswXTtcbiAgICB2YXIgb2xkRW5kVm5vZGUgPSBvbGRDaFtvbGRFbmRJZHhdO1xuICAgIHZhciBuZXdFbmRJZHggPSBuZXdDaC5sZW5ndGggLSAxO1xuICAgIHZhciBuZXdTdGFydFZub2RlID0gbmV3Q2hbMF07XG4gICAgdmFyIG5ld0VuZFZub2RlID0gbmV3Q2hbbmV3RW5kSWR4XTtcbiAgICB2YXIgb2xkS2V5VG9JZHgsIGlkeEluT2xkLCB2bm9kZVRvTW92ZSwgcmVmRWxtO1xuXG4gICAgLy8gcmVtb3ZlT25seSBpcyBhIHNwZWNpYWwgZmxhZyB1c2VkIG9ubHkgYnkgPHRyYW5zaXRpb24tZ3JvdXA+XG4gICAgLy8gdG8gZW5zdXJlIHJlbW92ZWQgZWxlbWVudHMgc3RheSBpbiBjb3JyZWN0IHJlbGF0aXZlIHBvc2l0aW9uc1xuICAgIC8vIGR1cmluZyBsZWF2aW5nIHRyYW5zaXRpb25zXG4gICAgdmFyIGNhbk1vdmUgPSAhcmVtb3ZlT25seTtcblxuICAgIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nKSB7XG4gICAgICBjaGVja0R1cGxpY2F0ZUtleXMobmV3Q2gpO1xuICAgIH1cblxuICAgIHdoaWxlIChvbGRTdGFydElkeCA8PSBvbGRFbmRJZHggJiYgbmV3U3RhcnRJZHggPD0gbmV3RW5kSWR4KSB7XG4gICAgICBpZiAoaXNVbmRlZihvbGRTdGFydFZub2RlKSkge1xuICAgICAgICBvbGRTdGFydFZub2RlID0gb2xkQ2hbKytvbGRTdGFydElkeF07IC8vIFZub2RlIGhhcyBiZWVuIG1vdmVkIGxlZnRcbiAgICAgIH0gZWxzZSBpZiAoaXNVbmRlZihvbGRFbmRWbm9kZSkpIHtcbiAgICAgICAgb2xkRW5kVm5vZGUgPSBvbGRDaFstLW9sZEVuZElkeF07XG4gICAgICB9IGVsc2UgaWYgKHNhbWVWbm9kZShvbGRTdGFydFZub2RlLCBuZXdTdGFydFZub2RlKSkge1xuICAgICAgICBwYXRjaFZub2RlKG9sZFN0YXJ0Vm5vZGUsIG5ld1N0YXJ0Vm5vZGUsIGluc2VydGVkVm5vZGVRdWV1ZSk7XG4gICAgICAgIG9sZFN0YXJ0Vm5vZGUgPSBvbGRDaFsrK29sZFN0YXJ0SWR4XTtcbiAgICAgICAgbmV3U3RhcnRWbm9kZSA9IG5ld0NoWysrbmV3U3RhcnRJZHhdO1xuICAgICAgfSBlbHNlIGlmIChzYW1lVm5vZGUob2xkRW5kVm5vZGUsIG5ld0VuZFZub2RlKSkge1xuICAgICAgICBwYXRjaFZub2RlKG9sZEVuZFZub2RlLCBuZXdFbmRWbm9kZSwgaW5zZXJ0ZWRWbm9kZVF1ZXVlKTtcbiAgICAgICAgb2xkRW5kVm5vZGUgPSBvbGRDaFstLW9sZEVuZElkeF07XG4gICAgICAgIG5ld0VuZFZub2RlID0gbmV3Q2hbLS1uZXdFbmRJZHhdO1xuICAgICAgfSBlbHNlIGlmIChzYW1lVm5vZGUob2xkU3RhcnRWbm9kZSwgbmV3RW5kVm5vZGUpKSB7IC8vIFZub2RlIG1vdmVkIHJpZ2h0XG4gICAgICAgIHBhdGNoVm5vZGUob2xkU3RhcnRWbm9kZSwgbmV3RW5kVm5vZGUsIGluc2VydGVkVm5vZGVRdWV1ZSk7XG4gICAgICAgIGNhbk1vdmUgJiYgbm9kZU9wcy5pbnNlcnRCZWZvcmUocGFyZW50RWxtLCBvbGRTdGFydFZub2RlLmVsbSwgbm9kZU9wcy5uZXh0U2libGluZyhvbGRFbmRWbm9kZS5lbG0pKTtcbiAgICAgICAgb2xkU3RhcnRWbm9kZSA9IG9sZENoWysrb2xkU3RhcnRJZHhdO1xuICAgICAgICBuZXdFbmRWbm9kZSA9IG5ld0NoWy0tbmV3RW5kSWR4XTtcbiAgICAgIH0gZWxzZSBpZiAoc2FtZVZub2RlKG9sZEVuZFZub2RlLCBuZXdTdGFydFZub2RlKSkgeyAvLyBWbm9kZSBtb3ZlZCBsZWZ0XG4gICAgICAgIHBhdGNoVm5vZGUob2xkRW5kVm5vZGUsIG5ld1N0YXJ0Vm5vZGUsIGluc2VydGVkVm5vZGVRdWV1ZSk7XG4gICAgICAgIGNhbk1vdmUgJiYgbm9kZU9wcy5pbnNlcnRCZWZvcmUocGFyZW50RWxtLCBvbGRFbmRWbm9kZS5lbG0sIG9sZFN0YXJ0Vm5vZGUuZWxtKTtcbiAgICAgICAgb2xkRW5kVm5vZGUgPSBvbGRDaFstLW9sZEVuZElkeF07XG4gICAgICAgIG5ld1N0YXJ0Vm5vZGUgPSBuZXdDaFsrK25ld1N0YXJ0SWR4XTtcbiAgICAgIH0gZWxzZSB7XG4gICAgICAgIGlmIChpc1VuZGVmKG9sZEtleVRvSWR4KSkgeyBvbGRLZXlUb0lkeCA9IGNyZWF0ZUtleVRvT2xkSWR4KG9sZENoLCBvbGRTdGFydElkeCwgb2xkRW5kSWR4KTsgfVxuICAgICAgICBpZHhJbk9sZCA9IGlzRGVmKG5ld1N0YXJ0Vm5vZGUua2V5KVxuICAgICAgICAgID8gb2xkS2V5VG9JZHhbbmV3U3RhcnRWbm9kZS5rZXldXG4gICAgICAgICAgOiBmaW5kSWR4SW5PbGQobmV3U3RhcnRWbm9kZSwgb2xkQ2gsIG9sZFN0YXJ0SWR4LCBvbGRFbmRJZHgpO1xuICAgICAgICBpZiAoaXNVbmRlZihpZHhJbk9sZCkpIHsgLy8gTmV3IGVsZW1lbnRcbiAgICAgICAgICBjcmVhdGVFbG0obmV3U3RhcnRWbm9kZSwgaW5zZXJ0ZWRWbm9kZVF1ZXVlLCBwYXJlbnRFbG0sIG9sZFN0YXJ0Vm5vZGUuZWxtLCBmYWxzZSwgbmV3Q2gsIG5ld1N0YXJ0SWR4KTtcbiAgICAgICAgfSBlbHNlIHtcbiAgICAgICAgICB2bm9kZVRvTW92ZSA9IG9sZENoW2lkeEluT2xkXTtcbiAgICAgICAgICBpZiAoc2FtZVZub2RlKHZub2RlVG9Nb3ZlLCBuZXdTdGFydFZub2RlKSkge1xuICAgICAgICAgICAgcGF0Y2hWbm9kZSh2bm9kZVRvTW92ZSwgbmV3U3RhcnRWbm9kZSwgaW5zZXJ0ZWRWbm9kZVF1ZXVlKTtcbiAgICAgICAgICAgIG9sZENoW2lkeEluT2xkXSA9IHVuZGVmaW5lZDtcbiAgICAgICAgICAgIGNhbk1vdmUgJiYgbm9kZU9wcy5pbnNlcnRCZWZvcmUocGFyZW50RWxtLCB2bm9kZVRvTW92ZS5lbG0sIG9sZFN0YXJ0Vm5vZGUuZWxtKTtcbiAgICAgICAgICB9IGVsc2Uge1xuICAgICAgICAgICAgLy8gc2FtZSBrZXkgYnV0IGRpZmZlcmVudCBlbGVtZW50LiB0cmVhdCBhcyBuZXcgZWxlbWVudFxuICAgICAgICAgICAgY3JlYXRlRWxtKG5ld1N0YXJ0Vm5vZGUsIGluc2VydGVkVm5vZGVRdWV1ZSwgcGFyZW50RWxtLCBvbGRTdGFydFZub2RlLmVsbSwgZmFsc2UsIG5ld0NoLCBuZXdTdGFydElkeCk7XG4gICAgICAgICAgfVxuICAgICAgICB9XG4gICAgICAgIG5ld1N0YXJ0Vm5vZGUgPSBuZXdDaFsrK25ld1N0YXJ0SWR4XTtcbiAgICAgIH1cbiAgICB9XG4gICAgaWYgKG9sZFN0YXJ0SWR4ID4gb2xkRW5kSWR4KSB7XG4gICAgICByZWZFbG0gPSBpc1VuZGVmKG5ld0NoW25ld0VuZElkeCArIDFdKSA/IG51bGwgOiBuZXdDaFtuZXdFbmRJZHggKyAxXS5lbG07XG4gICAgICBhZGRWbm9kZXMocGFyZW50RWxtLCByZWZFbG0sIG5ld0NoLCBuZXdTdGFydElkeCwgbmV3RW5kSWR4LCBpbnNlcnRlZFZub2RlUXVldWUpO1xuICAgIH0gZWxzZSBpZiAobmV3U3RhcnRJZHggPiBuZXdFbmRJZHgpIHtcbiAgICAgIHJlbW92ZVZub2RlcyhwYXJlbnRFbG0sIG9sZENoLCBvbGRTdGFydElkeCwgb2xkRW5kSWR4KTtcbiAgICB9XG4gIH1cblxuICBmdW5jdGlvbiBjaGVja0R1cGxpY2F0ZUtleXMgKGNoaWxkcmVuKSB7XG4gICAgdmFyIHNlZW5LZXlzID0ge307XG4gICAgZm9yICh2YXIgaSA9IDA7IGkgPCBjaGlsZHJlbi5sZW5ndGg7IGkrKykge1xuICAgICAgdmFyIHZub2RlID0gY2hpbGRyZW5baV07XG4gICAgICB2YXIga2V5ID0gdm5vZGUua2V5O1xuICAgICAgaWYgKGlzRGVmKGtleSkpIHtcbiAgICAgICAgaWYgKHNlZW5LZXlzW2tleV0pIHtcbiAgICAgICAgICB3YXJuKFxuICAgICAgICAgICAgKFwiRHVwbGljYXRlIGtleXMgZGV0ZWN0ZWQ6ICdcIiArIGtleSArIFwiJy4gVGhpcyBtYXkgY2F1c2UgYW4gdXBkYXRlIGVycm9yLlwiKSxcbiAgICAgICAgICAgIHZub2RlLmNvbnRleHRcbiAgICAgICAgICApO1xuICAgICAgICB9IGVsc2Uge1xuICAgICAgICAgIHNlZW5LZXlzW2tleV0gPSB0cnVlO1xuICAgICAgICB9XG4gICAgICB9XG4gICAgfVxuICB9XG5cbiAgZnVuY3Rpb24gZmluZElkeEluT2xkIChub2RlLCBvbGRDaCwgc3RhcnQsIGVuZCkge1xuICAgIGZvciAodmFyIGkgPSBzdGFydDsgaSA8IGVuZDsgaSsrKSB7XG4gICAgICB2YXIgYyA9IG9sZENoW2ldO1xuICAgICAgaWYgKGlzRGVmKGMpICYmIHNhbWVWbm9kZShub2RlLCBjKSkgeyByZXR1cm4gaSB9XG4gICAgfVxuICB9XG5cbiAgZnVuY3Rpb24gcGF0Y2hWbm9kZSAob2xkVm5vZGUsIHZub2RlLCBpbnNlcnRlZFZub2RlUXVldWUsIHJlbW92ZU9ubHkpIHtcbiAgICBpZiAob2xkVm5vZGUgPT09IHZub2RlKSB7XG4gICAgICByZXR1cm5cbiAgICB9XG5cbiAgICB2YXIgZWxtID0gdm5vZGUuZWxtID0gb2xkVm5vZGUuZWxtO1xuXG4gICAgaWYgKGlzVHJ1ZShvbGRWbm9kZS5pc0FzeW5jUGxhY2Vob2xkZXIpKSB7XG4gICAgICBpZiAoaXNEZWYodm5vZGUuYXN5bmNGYWN0b3J5LnJlc29sdmVkKSkge1xuICAgICAgICBoeWRyYXRlKG9sZFZub2RlLmVsbSwgdm5vZGUsIGluc2VydGVkVm5vZGVRdWV1ZSk7XG4gICAgICB9IGVsc2Uge1xuICAgICAgICB2bm9kZS5pc0FzeW5jUGxhY2Vob2xkZXIgPSB0cnVlO1xuICAgICAgfVxuICAgICAgcmV0dXJuXG4gICAgfVxuXG4gICAgLy8gcmV1c2UgZWxlbWVudCBmb3Igc3RhdGljIHRyZWVzLlxuICAgIC8vIG5vdGUgd2Ugb25seSBkbyB0aGlzIGlmIHRoZSB2bm9kZSBpcyBjbG9uZWQgLVxuICAgIC8vIGlmIHRoZSBuZXcgbm9kZSBpcyBub3QgY2xvbmVkIGl0IG1lYW5zIHRoZSByZW5kZXIgZnVuY3Rpb25zIGhhdmUgYmVlblxuICAgIC8vIHJlc2V0IGJ5IHRoZSBob3QtcmVsb2FkLWFwaSBhbmQgd2UgbmVlZCB0byBkbyBhIHByb3BlciByZS1yZW5kZXIuXG4gICAgaWYgKGlzVHJ1ZSh2bm9kZS5pc1N0YXRpYykgJiZcbiAgICAgIGlzVHJ1ZShvbGRWbm9kZS5pc1N0YXRpYykgJiZcbiAgICAgIHZub2RlLmtleSA9PT0gb2xkVm5vZGUua2V5ICYmXG4gICAgICAoaXNUcnVlKHZub2RlLmlzQ2xvbmVkKSB8fCBpc1RydWUodm5vZGUuaXNPbmNlKSlcbiAgICApIHtcbiAgICAgIHZub2RlLmNvbXBvbmVudEluc3RhbmNlID0gb2xkVm5vZGUuY29tcG9uZW50SW5zdGFuY2U7XG4gICAgICByZXR1cm5cbiAgICB9XG5cbiAgICB2YXIgaTtcbiAgICB2YXIgZGF0YSA9IHZub2RlLmRhdGE7XG4gICAgaWYgKGlzRGVmKGRhdGEpICYmIGlzRGVmKGkgPSBkYXRhLmhvb2spICYmIGlzRGVmKGkgPSBpLnByZXBhdGNoKSkge1xuICAgICAgaShvbGRWbm9kZSwgdm5vZGUpO1xuICAgIH1cblxuICAgIHZhciBvbGRDaCA9IG9sZFZub2RlLmNoaWxkcmVuO1xuICAgIHZhciBjaCA9IHZub2RlLmNoaWxkcmVuO1xuICAgIGlmIChpc0RlZihkYXRhKSAmJiBpc1BhdGNoYWJsZSh2bm9kZSkpIHtcbiAgICAgIGZvciAoaSA9IDA7IGkgPCBjYnMudXBkYXRlLmxlbmd0aDsgKytpKSB7IGNicy51cGRhdGVbaV0ob2xkVm5vZGUsIHZub2RlKTsgfVxuICAgICAgaWYgKGlzRGVmKGkgPSBkYXRhLmhvb2spICYmIGlzRGVmKGkgPSBpLnVwZGF0ZSkpIHsgaShvbGRWbm9kZSwgdm5vZGUpOyB9XG4gICAgfVxuICAgIGlmIChpc1VuZGVmKHZub2RlLnRleHQpKSB7XG4gICAgICBpZiAoaXNEZWYob2xkQ2gpICYmIGlzRGVmKGNoKSkge1xuICAgICAgICBpZiAob2xkQ2ggIT09IGNoKSB7IHVwZGF0ZUNoaWxkcmVuKGVsbSwgb2xkQ2gsIGNoLCBpbnNlcnRlZFZub2RlUXVldWUsIHJlbW92ZU9ubHkpOyB9XG4gICAgICB9IGVsc2UgaWYgKGlzRGVmKGNoKSkge1xuICAgICAgICBpZiAoaXNEZWYob2xkVm5vZGUudGV4dCkpIHsgbm9kZU9wcy5zZXRUZXh0Q29udGVudChlbG0sICcnKTsgfVxuICAgICAgICBhZGRWbm9kZXMoZWxtLCBudWxsLCBjaCwgMCwgY2gubGVuZ3RoIC0gMSwgaW5zZXJ0ZWRWbm9kZVF1ZXVlKTtcbiAgICAgIH0gZWxzZSBpZiAoaXNEZWYob2xkQ2gpKSB7XG4gICAgICAgIHJlbW92ZVZub2RlcyhlbG0sIG9sZENoLCAwLCBvbGRDaC5sZW5ndGggLSAxKTtcbiAgICAgIH0gZWxzZSBpZiAoaXNEZWYob2xkVm5vZGUudGV4dCkpIHtcbiAgICAgICAgbm9kZU9wcy5zZXRUZXh0Q29udGVudChlbG0sICcnKTtcbiAgICAgIH1cbiAgICB9IGVsc2UgaWYgKG9sZFZub2RlLnRleHQgIT09IHZub2RlLnRleHQpIHtcbiAgICAgIG5vZGVPcHMuc2V0VGV4dENvbnRlbnQoZWxtLCB2bm9kZS50ZXh0KTtcbiAgICB9XG4gICAgaWYgKGlzRGVmKGRhdGEpKSB7XG4gICAgICBpZiAoaXNEZWYoaSA9IGRhdGEuaG9vaykgJiYgaXNEZWYoaSA9IGkucG9zdHBhdGNoKSkgeyBpKG9sZFZub2RlLCB2bm9kZSk7IH1cbiAgICB9XG4gIH1cblxuICBmdW5jdGlvbiBpbnZva2VJbnNlcnRIb29rICh2bm9kZSwgcXVldWUsIGluaXRpYWwpIHtcbiAgICAvLyBkZWxheSBpbnNlcnQgaG9va3MgZm9yIGNvbXBvbmVudCByb290IG5vZGVzLCBpbnZva2UgdGhlbSBhZnRlciB0aGVcbiAgICAvLyBlbGVtZW50IGlzIHJlYWxseSBpbnNlcnRlZFxuICAgIGlmIChpc1RydWUoaW5pdGlhbCkgJiYgaXNEZWYodm5vZGUucGFyZW50KSkge1xuICAgICAgdm5vZGUucGFyZW50LmRhdGEucGVuZGluZ0luc2VydCA9IHF1ZXVlO1xuICAgIH0gZWxzZSB7XG4gICAgICBmb3IgKHZhciBpID0gMDsgaSA8IHF1ZXVlLmxlbmd0aDsgKytpKSB7XG4gICAgICAgIHF1ZXVlW2ldLmRhdGEuaG9vay5pbnNlcnQocXVldWVbaV0pO1xuICAgICAgfVxuICAgIH1cbiAgfVxuXG4gIHZhciBoeWRyYXRpb25CYWlsZWQgPSBmYWxzZTtcbiAgLy8gbGlzdCBvZiBtb2R1bGVzIHRoYXQgY2FuIHNraXAgY3JlYXRlIGhvb2sgZHVyaW5nIGh5ZHJhdGlvbiBiZWNhdXNlIHRoZXlcbiAgLy8gYXJlIGFscmVhZHkgcmVuZGVyZWQgb24gdGhlIGNsaWVudCBvciBoYXMgbm8gbmVlZCBmb3IgaW5pdGlhbGl6YXRpb25cbiAgLy8gTm90ZTogc3R5bGUgaXMgZXhjbHVkZWQgYmVjYXVzZSBpdCByZWxpZXMgb24gaW5pdGlhbCBjbG9uZSBmb3IgZnV0dXJlXG4gIC8vIGRlZXAgdXBkYXRlcyAoIzcwNjMpLlxuICB2YXIgaXNSZW5kZXJlZE1vZHVsZSA9IG1ha2VNYXAoJ2F0dHJzLGNsYXNzLHN0YXRpY0NsYXNzLHN0YXRpY1N0eWxlLGtleScpO1xuXG4gIC8vIE5vdGU6IHRoaXMgaXMgYSBicm93c2VyLW9ubHkgZnVuY3Rpb24gc28gd2UgY2FuIGFzc3VtZSBlbG1zIGFyZSBET00gbm9kZXMuXG4gIGZ1bmN0aW9uIGh5ZHJhdGUgKGVsbSwgdm5vZGUsIGluc2VydGVkVm5vZGVRdWV1ZSwgaW5WUHJlKSB7XG4gICAgdmFyIGk7XG4gICAgdmFyIHRhZyA9IHZub2RlLnRhZztcbiAgICB2YXIgZGF0YSA9IHZub2RlLmRhdGE7XG4gICAgdmFyIGNoaWxkcmVuID0gdm5vZGUuY2hpbGRyZW47XG4gICAgaW5WUHJlID0gaW5WUHJlIHx8IChkYXRhICYmIGRhdGEucHJlKTtcbiAgICB2bm9kZS5lbG0gPSBlbG07XG5cbiAgICBpZiAoaXNUcnVlKHZub2RlLmlzQ29tbWVudCkgJiYgaXNEZWYodm5vZGUuYXN5bmNGYWN0b3J5KSkge1xuICAgICAgdm5vZGUuaXNBc3luY1BsYWNlaG9sZGVyID0gdHJ1ZTtcbiAgICAgIHJldHVybiB0cnVlXG4gICAgfVxuICAgIC8vIGFzc2VydCBub2RlIG1hdGNoXG4gICAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicpIHtcbiAgICAgIGlmICghYXNzZXJ0Tm9kZU1hdGNoKGVsbSwgdm5vZGUsIGluVlByZSkpIHtcbiAgICAgICAgcmV0dXJuIGZhbHNlXG4gICAgICB9XG4gICAgfVxuICAgIGlmIChpc0RlZihkYXRhKSkge1xuICAgICAgaWYgKGlzRGVmKGkgPSBkYXRhLmhvb2spICYmIGlzRGVmKGkgPSBpLmluaXQpKSB7IGkodm5vZGUsIHRydWUgLyogaHlkcmF0aW5nICovKTsgfVxuICAgICAgaWYgKGlzRGVmKGkgPSB2bm9kZS5jb21wb25lbnRJbnN0YW5jZSkpIHtcbiAgICAgICAgLy8gY2hpbGQgY29tcG9uZW50LiBpdCBzaG91bGQgaGF2ZSBoeWRyYXRlZCBpdHMgb3duIHRyZWUuXG4gICAgICAgIGluaXRDb21wb25lbnQodm5vZGUsIGluc2VydGVkVm5vZGVRdWV1ZSk7XG4gICAgICAgIHJldHVybiB0cnVlXG4gICAgICB9XG4gICAgfVxuICAgIGlmIChpc0RlZih0YWcpKSB7XG4gICAgICBpZiAoaXNEZWYoY2hpbGRyZW4pKSB7XG4gICAgICAgIC8vIGVtcHR5IGVsZW1lbnQsIGFsbG93IGNsaWVudCB0byBwaWNrIHVwIGFuZCBwb3B1bGF0ZSBjaGlsZHJlblxuICAgICAgICBpZiAoIWVsbS5oYXNDaGlsZE5vZGVzKCkpIHtcbiAgICAgICAgICBjcmVhdGVDaGlsZHJlbih2bm9kZSwgY2hpbGRyZW4sIGluc2VydGVkVm5vZGVRdWV1ZSk7XG4gICAgICAgIH0gZWxzZSB7XG4gICAgICAgICAgLy8gdi1odG1sIGFuZCBkb21Qcm9wczogaW5uZXJIVE1MXG4gICAgICAgICAgaWYgKGlzRGVmKGkgPSBkYXRhKSAmJiBpc0RlZihpID0gaS5kb21Qcm9wcykgJiYgaXNEZWYoaSA9IGkuaW5uZXJIVE1MKSkge1xuICAgICAgICAgICAgaWYgKGkgIT09IGVsbS5pbm5lckhUTUwpIHtcbiAgICAgICAgICAgICAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gICAgICAgICAgICAgIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nICYmXG4gICAgICAgICAgICAgICAgdHlwZW9mIGNvbnNvbGUgIT09ICd1bmRlZmluZWQnICYmXG4gICAgICAgICAgICAgICAgIWh5ZHJhdGlvbkJhaWxlZFxuICAgICAgICAgICAgICApIHtcbiAgICAgICAgICAgICAgICBoeWRyYXRpb25CYWlsZWQgPSB0cnVlO1xuICAgICAgICAgICAgICAgIGNvbnNvbGUud2FybignUGFyZW50OiAnLCBlbG0pO1xuICAgICAgICAgICAgICAgIGNvbnNvbGUud2Fybignc2VydmVyIGlubmVySFRNTDogJywgaSk7XG4gICAgICAgICAgICAgICAgY29uc29sZS53YXJuKCdjbGllbnQgaW5uZXJIVE1MOiAnLCBlbG0uaW5uZXJIVE1MKTtcbiAgICAgICAgICAgICAgfVxuICAgICAgICAgICAgICByZXR1cm4gZmFsc2VcbiAgICAgICAgICAgIH1cbiAgICAgICAgICB9IGVsc2Uge1xuICAgICAgICAgICAgLy8gaXRlcmF0ZSBhbmQgY29tcGFyZSBjaGlsZHJlbiBsaXN0c1xuICAgICAgICAgICAgdmFyIGNoaWxkcmVuTWF0Y2ggPSB0cnVlO1xuICAgICAgICAgICAgdmFyIGNoaWxkTm9kZSA9IGVsbS5maXJzdENoaWxkO1xuICAgICAgICAgICAgZm9yICh2YXIgaSQxID0gMDsgaSQxIDwgY2hpbGRyZW4ubGVuZ3RoOyBpJDErKykge1xuICAgICAgICAgICAgICBpZiAoIWNoaWxkTm9kZSB8fCAhaHlkcmF0ZShjaGlsZE5vZGUsIGNoaWxkcmVuW2kkMV0sIGluc2VydGVkVm5vZGVRdWV1ZSwgaW5WUHJlKSkge1xuICAgICAgICAgICAgICAgIGNoaWxkcmVuTWF0Y2ggPSBmYWxzZTtcbiAgICAgICAgICAgICAgICBicmVha1xuICAgICAgICAgICAgICB9XG4gICAgICAgICAgICAgIGNoaWxkTm9kZSA9IGNoaWxkTm9kZS5uZXh0U2libGluZztcbiAgICAgICAgICAgIH1cbiAgICAgICAgICAgIC8vIGlmIGNoaWxkTm9kZSBpcyBub3QgbnVsbCwgaXQgbWVhbnMgdGhlIGFjdHVhbCBjaGlsZE5vZGVzIGxpc3QgaXNcbiAgICAgICAgICAgIC8vIGxvbmdlciB0aGFuIHRoZSB2aXJ0dWFsIGNoaWxkcmVuIGxpc3QuXG4gICAgICAgICAgICBpZiAoIWNoaWxkcmVuTWF0Y2ggfHwgY2hpbGROb2RlKSB7XG4gICAgICAgICAgICAgIC8qIGlzdGFuYnVsIGlnbm9yZSBpZiAqL1xuICAgICAgICAgICAgICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJlxuICAgICAgICAgICAgICAgIHR5cGVvZiBjb25zb2xlICE9PSAndW5kZWZpbmVkJyAmJlxuICAgICAgICAgICAgICAgICFoeWRyYXRpb25CYWlsZWRcbiAgICAgICAgICAgICAgKSB7XG4gICAgICAgICAgICAgICAgaHlkcmF0aW9uQmFpbGVkID0gdHJ1ZTtcbiAgICAgICAgICAgICAgICBjb25zb2xlLndhcm4oJ1BhcmVudDogJywgZWxtKTtcbiAgICAgICAgICAgICAgICBjb25zb2xlLndhcm4oJ01pc21hdGNoaW5nIGNoaWxkTm9kZXMgdnMuIFZOb2RlczogJywgZWxtLmNoaWxkTm9kZXMsIGNoaWxkcmVuKTtcbiAgICAgICAgICAgICAgfVxuICAgICAgICAgICAgICByZXR1cm4gZmFsc2VcbiAgICAgICAgICAgIH1cbiAgICAgICAgICB9XG4gICAgICAgIH1cbiAgICAgIH1cbiAgICAgIGlmIChpc0RlZihkYXRhKSkge1xuICAgICAgICB2YXIgZnVsbEludm9rZSA9IGZhbHNlO1xuICAgICAgICBmb3IgKHZhciBrZXkgaW4gZGF0YSkge1xuICAgICAgICAgIGlmICghaXNSZW5kZXJlZE1vZHVsZShrZXkpKSB7XG4gICAgICAgICAgICBmdWxsSW52b2tlID0gdHJ1ZTtcbiAgICAgICAgICAgIGludm9rZUNyZWF0ZUhvb2tzKHZub2RlLCBpbnNlcnRlZFZub2RlUXVldWUpO1xuICAgICAgICAgICAgYnJlYWtcbiAgICAgICAgICB9XG4gICAgICAgIH1cbiAgICAgICAgaWYgKCFmdWxsSW52b2tlICYmIGRhdGFbJ2NsYXNzJ10pIHtcbiAgICAgICAgICAvLyBlbnN1cmUgY29sbGVjdGluZyBkZXBzIGZvciBkZWVwIGNsYXNzIGJpbmRpbmdzIGZvciBmdXR1cmUgdXBkYXRlc1xuICAgICAgICAgIHRyYXZlcnNlKGRhdGFbJ2NsYXNzJ10pO1xuICAgICAgICB9XG4gICAgICB9XG4gICAgfSBlbHNlIGlmIChlbG0uZGF0YSAhPT0gdm5vZGUudGV4dCkge1xuICAgICAgZWxtLmRhdGEgPSB2bm9kZS50ZXh0O1xuICAgIH1cbiAgICByZXR1cm4gdHJ1ZVxuICB9XG5cbiAgZnVuY3Rpb24gYXNzZXJ0Tm9kZU1hdGNoIChub2RlLCB2bm9kZSwgaW5WUHJlKSB7XG4gICAgaWYgKGlzRGVmKHZub2RlLnRhZykpIHtcbiAgICAgIHJldHVybiB2bm9kZS50YWcuaW5kZXhPZigndnVlLWNvbXBvbmVudCcpID09PSAwIHx8IChcbiAgICAgICAgIWlzVW5rbm93bkVsZW1lbnQkJDEodm5vZGUsIGluVlByZSkgJiZcbiAgICAgICAgdm5vZGUudGFnLnRvTG93ZXJDYXNlKCkgPT09IChub2RlLnRhZ05hbWUgJiYgbm9kZS50YWdOYW1lLnRvTG93ZXJDYXNlKCkpXG4gICAgICApXG4gICAgfSBlbHNlIHtcbiAgICAgIHJldHVybiBub2RlLm5vZGVUeXBlID09PSAodm5vZGUuaXNDb21tZW50ID8gOCA6IDMpXG4gICAgfVxuICB9XG5cbiAgcmV0dXJuIGZ1bmN0aW9uIHBhdGNoIChvbGRWbm9kZSwgdm5vZGUsIGh5ZHJhdGluZywgcmVtb3ZlT25seSwgcGFyZW50RWxtLCByZWZFbG0pIHtcbiAgICBpZiAoaXNVbmRlZih2bm9kZSkpIHtcbiAgICAgIGlmIChpc0RlZihvbGRWbm9kZSkpIHsgaW52b2tlRGVzdHJveUhvb2sob2xkVm5vZGUpOyB9XG4gICAgICByZXR1cm5cbiAgICB9XG5cbiAgICB2YXIgaXNJbml0aWFsUGF0Y2ggPSBmYWxzZTtcbiAgICB2YXIgaW5zZXJ0ZWRWbm9kZVF1ZXVlID0gW107XG5cbiAgICBpZiAoaXNVbmRlZihvbGRWbm9kZSkpIHtcbiAgICAgIC8vIGVtcHR5IG1vdW50IChsaWtlbHkgYXMgY29tcG9uZW50KSwgY3JlYXRlIG5ldyByb290IGVsZW1lbnRcbiAgICAgIGlzSW5pdGlhbFBhdGNoID0gdHJ1ZTtcbiAgICAgIGNyZWF0ZUVsbSh2bm9kZSwgaW5zZXJ0ZWRWbm9kZVF1ZXVlLCBwYXJlbnRFbG0sIHJlZkVsbSk7XG4gICAgfSBlbHNlIHtcbiAgICAgIHZhciBpc1JlYWxFbGVtZW50ID0gaXNEZWYob2xkVm5vZGUubm9kZVR5cGUpO1xuICAgICAgaWYgKCFpc1JlYWxFbGVtZW50ICYmIHNhbWVWbm9kZShvbGRWbm9kZSwgdm5vZGUpKSB7XG4gICAgICAgIC8vIHBhdGNoIGV4aXN0aW5nIHJvb3Qgbm9kZVxuICAgICAgICBwYXRjaFZub2RlKG9sZFZub2RlLCB2bm9kZSwgaW5zZXJ0ZWRWbm9kZVF1ZXVlLCByZW1vdmVPbmx5KTtcbiAgICAgIH0gZWxzZSB7XG4gICAgICAgIGlmIChpc1JlYWxFbGVtZW50KSB7XG4gICAgICAgICAgLy8gbW91bnRpbmcgdG8gYSByZWFsIGVsZW1lbnRcbiAgICAgICAgICAvLyBjaGVjayBpZiB0aGlzIGlzIHNlcnZlci1yZW5kZXJlZCBjb250ZW50IGFuZCBpZiB3ZSBjYW4gcGVyZm9ybVxuICAgICAgICAgIC8vIGEgc3VjY2Vzc2Z1bCBoeWRyYXRpb24uXG4gICAgICAgICAgaWYgKG9sZFZub2RlLm5vZGVUeXBlID09PSAxICYmIG9sZFZub2RlLmhhc0F0dHJpYnV0ZShTU1JfQVRUUikpIHtcbiAgICAgICAgICAgIG9sZFZub2RlLnJlbW92ZUF0dHJpYnV0ZShTU1JfQVRUUik7XG4gICAgICAgICAgICBoeWRyYXRpbmcgPSB0cnVlO1xuICAgICAgICAgIH1cbiAgICAgICAgICBpZiAoaXNUcnVlKGh5ZHJhdGluZykpIHtcbiAgICAgICAgICAgIGlmIChoeWRyYXRlKG9sZFZub2RlLCB2bm9kZSwgaW5zZXJ0ZWRWbm9kZVF1ZXVlKSkge1xuICAgICAgICAgICAgICBpbnZva2VJbnNlcnRIb29rKHZub2RlLCBpbnNlcnRlZFZub2RlUXVldWUsIHRydWUpO1xuICAgICAgICAgICAgICByZXR1cm4gb2xkVm5vZGVcbiAgICAgICAgICAgIH0gZWxzZSBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgICAgICAgICAgICB3YXJuKFxuICAgICAgICAgICAgICAgICdUaGUgY2xpZW50LXNpZGUgcmVuZGVyZWQgdmlydHVhbCBET00gdHJlZSBpcyBub3QgbWF0Y2hpbmcgJyArXG4gICAgICAgICAgICAgICAgJ3NlcnZlci1yZW5kZXJlZCBjb250ZW50LiBUaGlzIGlzIGxpa2VseSBjYXVzZWQgYnkgaW5jb3JyZWN0ICcgK1xuICAgICAgICAgICAgICAgICdIVE1MIG1hcmt1cCwgZm9yIGV4YW1wbGUgbmVzdGluZyBibG9jay1sZXZlbCBlbGVtZW50cyBpbnNpZGUgJyArXG4gICAgICAgICAgICAgICAgJzxwPiwgb3IgbWlzc2luZyA8dGJvZHk+LiBCYWlsaW5nIGh5ZHJhdGlvbiBhbmQgcGVyZm9ybWluZyAnICtcbiAgICAgICAgICAgICAgICAnZnVsbCBjbGllbnQtc2lkZSByZW5kZXIuJ1xuICAgICAgICAgICAgICApO1xuICAgICAgICAgICAgfVxuICAgICAgICAgIH1cbiAgICAgICAgICAvLyBlaXRoZXIgbm90IHNlcnZlci1yZW5kZXJlZCwgb3IgaHlkcmF0aW9uIGZhaWxlZC5cbiAgICAgICAgICAvLyBjcmVhdGUgYW4gZW1wdHkgbm9kZSBhbmQgcmVwbGFjZSBpdFxuICAgICAgICAgIG9sZFZub2RlID0gZW1wdHlOb2RlQXQob2xkVm5vZGUpO1xuICAgICAgICB9XG5cbiAgICAgICAgLy8gcmVwbGFjaW5nIGV4aXN0aW5nIGVsZW1lbnRcbiAgICAgICAgdmFyIG9sZEVsbSA9IG9sZFZub2RlLmVsbTtcbiAgICAgICAgdmFyIHBhcmVudEVsbSQxID0gbm9kZU9wcy5wYXJlbnROb2RlKG9sZEVsbSk7XG5cbiAgICAgICAgLy8gY3JlYXRlIG5ldyBub2RlXG4gICAgICAgIGNyZWF0ZUVsbShcbiAgICAgICAgICB2bm9kZSxcbiAgICAgICAgICBpbnNlcnRlZFZub2RlUXVldWUsXG4gICAgICAgICAgLy8gZXh0cmVtZWx5IHJhcmUgZWRnZSBjYXNlOiBkbyBub3QgaW5zZXJ0IGlmIG9sZCBlbGVtZW50IGlzIGluIGFcbiAgICAgICAgICAvLyBsZWF2aW5nIHRyYW5zaXRpb24uIE9ubHkgaGFwcGVucyB3aGVuIGNvbWJpbmluZyB0cmFuc2l0aW9uICtcbiAgICAgICAgICAvLyBrZWVwLWFsaXZlICsgSE9Dcy4gKCM0NTkwKVxuICAgICAgICAgIG9sZEVsbS5fbGVhdmVDYiA/IG51bGwgOiBwYXJlbnRFbG0kMSxcbiAgICAgICAgICBub2RlT3BzLm5leHRTaWJsaW5nKG9sZEVsbSlcbiAgICAgICAgKTtcblxuICAgICAgICAvLyB1cGRhdGUgcGFyZW50IHBsYWNlaG9sZGVyIG5vZGUgZWxlbWVudCwgcmVjdXJzaXZlbHlcbiAgICAgICAgaWYgKGlzRGVmKHZub2RlLnBhcmVudCkpIHtcbiAgICAgICAgICB2YXIgYW5jZXN0b3IgPSB2bm9kZS5wYXJlbnQ7XG4gICAgICAgICAgdmFyIHBhdGNoYWJsZSA9IGlzUGF0Y2hhYmxlKHZub2RlKTtcbiAgICAgICAgICB3aGlsZSAoYW5jZXN0b3IpIHtcbiAgICAgICAgICAgIGZvciAodmFyIGkgPSAwOyBpIDwgY2JzLmRlc3Ryb3kubGVuZ3RoOyArK2kpIHtcbiAgICAgICAgICAgICAgY2JzLmRlc3Ryb3lbaV0oYW5jZXN0b3IpO1xuICAgICAgICAgICAgfVxuICAgICAgICAgICAgYW5jZXN0b3IuZWxtID0gdm5vZGUuZWxtO1xuICAgICAgICAgICAgaWYgKHBhdGNoYWJsZSkge1xuICAgICAgICAgICAgICBmb3IgKHZhciBpJDEgPSAwOyBpJDEgPCBjYnMuY3JlYXRlLmxlbmd0aDsgKytpJDEpIHtcbiAgICAgICAgICAgICAgICBjYnMuY3JlYXRlW2kkMV0oZW1wdHlOb2RlLCBhbmNlc3Rvcik7XG4gICAgICAgICAgICAgIH1cbiAgICAgICAgICAgICAgLy8gIzY1MTNcbiAgICAgICAgICAgICAgLy8gaW52b2tlIGluc2VydCBob29rcyB0aGF0IG1heSBoYXZlIGJlZW4gbWVyZ2VkIGJ5IGNyZWF0ZSBob29rcy5cbiAgICAgICAgICAgICAgLy8gZS5nLiBmb3IgZGlyZWN0aXZlcyB0aGF0IHVzZXMgdGhlIFwiaW5zZXJ0ZWRcIiBob29rLlxuICAgICAgICAgICAgICB2YXIgaW5zZXJ0ID0gYW5jZXN0b3IuZGF0YS5ob29rLmluc2VydDtcbiAgICAgICAgICAgICAgaWYgKGluc2VydC5tZXJnZWQpIHtcbiAgICAgICAgICAgICAgICAvLyBzdGFydCBhdCBpbmRleCAxIHRvIGF2b2lkIHJlLWludm9raW5nIGNvbXBvbmVudCBtb3VudGVkIGhvb2tcbiAgICAgICAgICAgICAgICBmb3IgKHZhciBpJDIgPSAxOyBpJDIgPCBpbnNlcnQuZm5zLmxlbmd0aDsgaSQyKyspIHtcbiAgICAgICAgICAgICAgICAgIGluc2VydC5mbnNbaSQyXSgpO1xuICAgICAgICAgICAgICAgIH1cbiAgICAgICAgICAgICAgfVxuICAgICAgICAgICAgfSBlbHNlIHtcbiAgICAgICAgICAgICAgcmVnaXN0ZXJSZWYoYW5jZXN0b3IpO1xuICAgICAgICAgICAgfVxuICAgICAgICAgICAgYW5jZXN0b3IgPSBhbmNlc3Rvci5wYXJlbnQ7XG4gICAgICAgICAgfVxuICAgICAgICB9XG5cbiAgICAgICAgLy8gZGVzdHJveSBvbGQgbm9kZVxuICAgICAgICBpZiAoaXNEZWYocGFyZW50RWxtJDEpKSB7XG4gICAgICAgICAgcmVtb3ZlVm5vZGVzKHBhcmVudEVsbSQxLCBbb2xkVm5vZGVdLCAwLCAwKTtcbiAgICAgICAgfSBlbHNlIGlmIChpc0RlZihvbGRWbm9kZS50YWcpKSB7XG4gICAgICAgICAgaW52b2tlRGVzdHJveUhvb2sob2xkVm5vZGUpO1xuICAgICAgICB9XG4gICAgICB9XG4gICAgfVxuXG4gICAgaW52b2tlSW5zZXJ0SG9vayh2bm9kZSwgaW5zZXJ0ZWRWbm9kZVF1ZXVlLCBpc0luaXRpYWxQYXRjaCk7XG4gICAgcmV0dXJuIHZub2RlLmVsbVxuICB9XG59XG5cbi8qICAqL1xuXG52YXIgZGlyZWN0aXZlcyA9IHtcbiAgY3JlYXRlOiB1cGRhdGVEaXJlY3RpdmVzLFxuICB1cGRhdGU6IHVwZGF0ZURpcmVjdGl2ZXMsXG4gIGRlc3Ryb3k6IGZ1bmN0aW9uIHVuYmluZERpcmVjdGl2ZXMgKHZub2RlKSB7XG4gICAgdXBkYXRlRGlyZWN0aXZlcyh2bm9kZSwgZW1wdHlOb2RlKTtcbiAgfVxufVxuXG5mdW5jdGlvbiB1cGRhdGVEaXJlY3RpdmVzIChvbGRWbm9kZSwgdm5vZGUpIHtcbiAgaWYgKG9sZFZub2RlLmRhdGEuZGlyZWN0aXZlcyB8fCB2bm9kZS5kYXRhLmRpcmVjdGl2ZXMpIHtcbiAgICBfdXBkYXRlKG9sZFZub2RlLCB2bm9kZSk7XG4gIH1cbn1cblxuZnVuY3Rpb24gX3VwZGF0ZSAob2xkVm5vZGUsIHZub2RlKSB7XG4gIHZhciBpc0NyZWF0ZSA9IG9sZFZub2RlID09PSBlbXB0eU5vZGU7XG4gIHZhciBpc0Rlc3Ryb3kgPSB2bm9kZSA9PT0gZW1wdHlOb2RlO1xuICB2YXIgb2xkRGlycyA9IG5vcm1hbGl6ZURpcmVjdGl2ZXMkMShvbGRWbm9kZS5kYXRhLmRpcmVjdGl2ZXMsIG9sZFZub2RlLmNvbnRleHQpO1xuICB2YXIgbmV3RGlycyA9IG5vcm1hbGl6ZURpcmVjdGl2ZXMkMSh2bm9kZS5kYXRhLmRpcmVjdGl2ZXMsIHZub2RlLmNvbnRleHQpO1xuXG4gIHZhciBkaXJzV2l0aEluc2VydCA9IFtdO1xuICB2YXIgZGlyc1dpdGhQb3N0cGF0Y2ggPSBbXTtcblxuICB2YXIga2V5LCBvbGREaXIsIGRpcjtcbiAgZm9yIChrZXkgaW4gbmV3RGlycykge1xuICAgIG9sZERpciA9IG9sZERpcnNba2V5XTtcbiAgICBkaXIgPSBuZXdEaXJzW2tleV07XG4gICAgaWYgKCFvbGREaXIpIHtcbiAgICAgIC8vIG5ldyBkaXJlY3RpdmUsIGJpbmRcbiAgICAgIGNhbGxIb29rJDEoZGlyLCAnYmluZCcsIHZub2RlLCBvbGRWbm9kZSk7XG4gICAgICBpZiAoZGlyLmRlZiAmJiBkaXIuZGVmLmluc2VydGVkKSB7XG4gICAgICAgIGRpcnNXaXRoSW5zZXJ0LnB1c2goZGlyKTtcbiAgICAgIH1cbiAgICB9IGVsc2Uge1xuICAgICAgLy8gZXhpc3RpbmcgZGlyZWN0aXZlLCB1cGRhdGVcbiAgICAgIGRpci5vbGRWYWx1ZSA9IG9sZERpci52YWx1ZTtcbiAgICAgIGNhbGxIb29rJDEoZGlyLCAndXBkYXRlJywgdm5vZGUsIG9sZFZub2RlKTtcbiAgICAgIGlmIChkaXIuZGVmICYmIGRpci5kZWYuY29tcG9uZW50VXBkYXRlZCkge1xuICAgICAgICBkaXJzV2l0aFBvc3RwYXRjaC5wdXNoKGRpcik7XG4gICAgICB9XG4gICAgfVxuICB9XG5cbiAgaWYgKGRpcnNXaXRoSW5zZXJ0Lmxlbmd0aCkge1xuICAgIHZhciBjYWxsSW5zZXJ0ID0gZnVuY3Rpb24gKCkge1xuICAgICAgZm9yICh2YXIgaSA9IDA7IGkgPCBkaXJzV2l0aEluc2VydC5sZW5ndGg7IGkrKykge1xuICAgICAgICBjYWxsSG9vayQxKGRpcnNXaXRoSW5zZXJ0W2ldLCAnaW5zZXJ0ZWQnLCB2bm9kZSwgb2xkVm5vZGUpO1xuICAgICAgfVxuICAgIH07XG4gICAgaWYgKGlzQ3JlYXRlKSB7XG4gICAgICBtZXJnZVZOb2RlSG9vayh2bm9kZSwgJ2luc2VydCcsIGNhbGxJbnNlcnQpO1xuICAgIH0gZWxzZSB7XG4gICAgICBjYWxsSW5zZXJ0KCk7XG4gICAgfVxuICB9XG5cbiAgaWYgKGRpcnNXaXRoUG9zdHBhdGNoLmxlbmd0aCkge1xuICAgIG1lcmdlVk5vZGVIb29rKHZub2RlLCAncG9zdHBhdGNoJywgZnVuY3Rpb24gKCkge1xuICAgICAgZm9yICh2YXIgaSA9IDA7IGkgPCBkaXJzV2l0aFBvc3RwYXRjaC5sZW5ndGg7IGkrKykge1xuICAgICAgICBjYWxsSG9vayQxKGRpcnNXaXRoUG9zdHBhdGNoW2ldLCAnY29tcG9uZW50VXBkYXRlZCcsIHZub2RlLCBvbGRWbm9kZSk7XG4gICAgICB9XG4gICAgfSk7XG4gIH1cblxuICBpZiAoIWlzQ3JlYXRlKSB7XG4gICAgZm9yIChrZXkgaW4gb2xkRGlycykge1xuICAgICAgaWYgKCFuZXdEaXJzW2tleV0pIHtcbiAgICAgICAgLy8gbm8gbG9uZ2VyIHByZXNlbnQsIHVuYmluZFxuICAgICAgICBjYWxsSG9vayQxKG9sZERpcnNba2V5XSwgJ3VuYmluZCcsIG9sZFZub2RlLCBvbGRWbm9kZSwgaXNEZXN0cm95KTtcbiAgICAgIH1cbiAgICB9XG4gIH1cbn1cblxudmFyIGVtcHR5TW9kaWZpZXJzID0gT2JqZWN0LmNyZWF0ZShudWxsKTtcblxuZnVuY3Rpb24gbm9ybWFsaXplRGlyZWN0aXZlcyQxIChcbiAgZGlycyxcbiAgdm1cbikge1xuICB2YXIgcmVzID0gT2JqZWN0LmNyZWF0ZShudWxsKTtcbiAgaWYgKCFkaXJzKSB7XG4gICAgLy8gJGZsb3ctZGlzYWJsZS1saW5lXG4gICAgcmV0dXJuIHJlc1xuICB9XG4gIHZhciBpLCBkaXI7XG4gIGZvciAoaSA9IDA7IGkgPCBkaXJzLmxlbmd0aDsgaSsrKSB7XG4gICAgZGlyID0gZGlyc1tpXTtcbiAgICBpZiAoIWRpci5tb2RpZmllcnMpIHtcbiAgICAgIC8vICRmbG93LWRpc2FibGUtbGluZVxuICAgICAgZGlyLm1vZGlmaWVycyA9IGVtcHR5TW9kaWZpZXJzO1xuICAgIH1cbiAgICByZXNbZ2V0UmF3RGlyTmFtZShkaXIpXSA9IGRpcjtcbiAgICBkaXIuZGVmID0gcmVzb2x2ZUFzc2V0KHZtLiRvcHRpb25zLCAnZGlyZWN0aXZlcycsIGRpci5uYW1lLCB0cnVlKTtcbiAgfVxuICAvLyAkZmxvdy1kaXNhYmxlLWxpbmVcbiAgcmV0dXJuIHJlc1xufVxuXG5mdW5jdGlvbiBnZXRSYXdEaXJOYW1lIChkaXIpIHtcbiAgcmV0dXJuIGRpci5yYXdOYW1lIHx8ICgoZGlyLm5hbWUpICsgXCIuXCIgKyAoT2JqZWN0LmtleXMoZGlyLm1vZGlmaWVycyB8fCB7fSkuam9pbignLicpKSlcbn1cblxuZnVuY3Rpb24gY2FsbEhvb2skMSAoZGlyLCBob29rLCB2bm9kZSwgb2xkVm5vZGUsIGlzRGVzdHJveSkge1xuICB2YXIgZm4gPSBkaXIuZGVmICYmIGRpci5kZWZbaG9va107XG4gIGlmIChmbikge1xuICAgIHRyeSB7XG4gICAgICBmbih2bm9kZS5lbG0sIGRpciwgdm5vZGUsIG9sZFZub2RlLCBpc0Rlc3Ryb3kpO1xuICAgIH0gY2F0Y2ggKGUpIHtcbiAgICAgIGhhbmRsZUVycm9yKGUsIHZub2RlLmNvbnRleHQsIChcImRpcmVjdGl2ZSBcIiArIChkaXIubmFtZSkgKyBcIiBcIiArIGhvb2sgKyBcIiBob29rXCIpKTtcbiAgICB9XG4gIH1cbn1cblxudmFyIGJhc2VNb2R1bGVzID0gW1xuICByZWYsXG4gIGRpcmVjdGl2ZXNcbl1cblxuLyogICovXG5cbmZ1bmN0aW9uIHVwZGF0ZUF0dHJzIChvbGRWbm9kZSwgdm5vZGUpIHtcbiAgdmFyIG9wdHMgPSB2bm9kZS5jb21wb25lbnRPcHRpb25zO1xuICBpZiAoaXNEZWYob3B0cykgJiYgb3B0cy5DdG9yLm9wdGlvbnMuaW5oZXJpdEF0dHJzID09PSBmYWxzZSkge1xuICAgIHJldHVyblxuICB9XG4gIGlmIChpc1VuZGVmKG9sZFZub2RlLmRhdGEuYXR0cnMpICYmIGlzVW5kZWYodm5vZGUuZGF0YS5hdHRycykpIHtcbiAgICByZXR1cm5cbiAgfVxuICB2YXIga2V5LCBjdXIsIG9sZDtcbiAgdmFyIGVsbSA9IHZub2RlLmVsbTtcbiAgdmFyIG9sZEF0dHJzID0gb2xkVm5vZGUuZGF0YS5hdHRycyB8fCB7fTtcbiAgdmFyIGF0dHJzID0gdm5vZGUuZGF0YS5hdHRycyB8fCB7fTtcbiAgLy8gY2xvbmUgb2JzZXJ2ZWQgb2JqZWN0cywgYXMgdGhlIHVzZXIgcHJvYmFibHkgd2FudHMgdG8gbXV0YXRlIGl0XG4gIGlmIChpc0RlZihhdHRycy5fX29iX18pKSB7XG4gICAgYXR0cnMgPSB2bm9kZS5kYXRhLmF0dHJzID0gZXh0ZW5kKHt9LCBhdHRycyk7XG4gIH1cblxuICBmb3IgKGtleSBpbiBhdHRycykge1xuICAgIGN1ciA9IGF0dHJzW2tleV07XG4gICAgb2xkID0gb2xkQXR0cnNba2V5XTtcbiAgICBpZiAob2xkICE9PSBjdXIpIHtcbiAgICAgIHNldEF0dHIoZWxtLCBrZXksIGN1cik7XG4gICAgfVxuICB9XG4gIC8vICM0MzkxOiBpbiBJRTksIHNldHRpbmcgdHlwZSBjYW4gcmVzZXQgdmFsdWUgZm9yIGlucHV0W3R5cGU9cmFkaW9dXG4gIC8vICM2NjY2OiBJRS9FZGdlIGZvcmNlcyBwcm9ncmVzcyB2YWx1ZSBkb3duIHRvIDEgYmVmb3JlIHNldHRpbmcgYSBtYXhcbiAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gIGlmICgoaXNJRSB8fCBpc0VkZ2UpICYmIGF0dHJzLnZhbHVlICE9PSBvbGRBdHRycy52YWx1ZSkge1xuICAgIHNldEF0dHIoZWxtLCAndmFsdWUnLCBhdHRycy52YWx1ZSk7XG4gIH1cbiAgZm9yIChrZXkgaW4gb2xkQXR0cnMpIHtcbiAgICBpZiAoaXNVbmRlZihhdHRyc1trZXldKSkge1xuICAgICAgaWYgKGlzWGxpbmsoa2V5KSkge1xuICAgICAgICBlbG0ucmVtb3ZlQXR0cmlidXRlTlMoeGxpbmtOUywgZ2V0WGxpbmtQcm9wKGtleSkpO1xuICAgICAgfSBlbHNlIGlmICghaXNFbnVtZXJhdGVkQXR0cihrZXkpKSB7XG4gICAgICAgIGVsbS5yZW1vdmVBdHRyaWJ1dGUoa2V5KTtcbiAgICAgIH1cbiAgICB9XG4gIH1cbn1cblxuZnVuY3Rpb24gc2V0QXR0ciAoZWwsIGtleSwgdmFsdWUpIHtcbiAgaWYgKGVsLnRhZ05hbWUuaW5kZXhPZignLScpID4gLTEpIHtcbiAgICBiYXNlU2V0QXR0cihlbCwga2V5LCB2YWx1ZSk7XG4gIH0gZWxzZSBpZiAoaXNCb29sZWFuQXR0cihrZXkpKSB7XG4gICAgLy8gc2V0IGF0dHJpYnV0ZSBmb3IgYmxhbmsgdmFsdWVcbiAgICAvLyBlLmcuIDxvcHRpb24gZGlzYWJsZWQ+U2VsZWN0IG9uZTwvb3B0aW9uPlxuICAgIGlmIChpc0ZhbHN5QXR0clZhbHVlKHZhbHVlKSkge1xuICAgICAgZWwucmVtb3ZlQXR0cmlidXRlKGtleSk7XG4gICAgfSBlbHNlIHtcbiAgICAgIC8vIHRlY2huaWNhbGx5IGFsbG93ZnVsbHNjcmVlbiBpcyBhIGJvb2xlYW4gYXR0cmlidXRlIGZvciA8aWZyYW1lPixcbiAgICAgIC8vIGJ1dCBGbGFzaCBleHBlY3RzIGEgdmFsdWUgb2YgXCJ0cnVlXCIgd2hlbiB1c2VkIG9uIDxlbWJlZD4gdGFnXG4gICAgICB2YWx1ZSA9IGtleSA9PT0gJ2FsbG93ZnVsbHNjcmVlbicgJiYgZWwudGFnTmFtZSA9PT0gJ0VNQkVEJ1xuICAgICAgICA/ICd0cnVlJ1xuICAgICAgICA6IGtleTtcbiAgICAgIGVsLnNldEF0dHJpYnV0ZShrZXksIHZhbHVlKTtcbiAgICB9XG4gIH0gZWxzZSBpZiAoaXNFbnVtZXJhdGVkQXR0cihrZXkpKSB7XG4gICAgZWwuc2V0QXR0cmlidXRlKGtleSwgaXNGYWxzeUF0dHJWYWx1ZSh2YWx1ZSkgfHwgdmFsdWUgPT09ICdmYWxzZScgPyAnZmFsc2UnIDogJ3RydWUnKTtcbiAgfSBlbHNlIGlmIChpc1hsaW5rKGtleSkpIHtcbiAgICBpZiAoaXNGYWxzeUF0dHJWYWx1ZSh2YWx1ZSkpIHtcbiAgICAgIGVsLnJlbW92ZUF0dHJpYnV0ZU5TKHhsaW5rTlMsIGdldFhsaW5rUHJvcChrZXkpKTtcbiAgICB9IGVsc2Uge1xuICAgICAgZWwuc2V0QXR0cmlidXRlTlMoeGxpbmtOUywga2V5LCB2YWx1ZSk7XG4gICAgfVxuICB9IGVsc2Uge1xuICAgIGJhc2VTZXRBdHRyKGVsLCBrZXksIHZhbHVlKTtcbiAgfVxufVxuXG5mdW5jdGlvbiBiYXNlU2V0QXR0ciAoZWwsIGtleSwgdmFsdWUpIHtcbiAgaWYgKGlzRmFsc3lBdHRyVmFsdWUodmFsdWUpKSB7XG4gICAgZWwucmVtb3ZlQXR0cmlidXRlKGtleSk7XG4gIH0gZWxzZSB7XG4gICAgLy8gIzcxMzg6IElFMTAgJiAxMSBmaXJlcyBpbnB1dCBldmVudCB3aGVuIHNldHRpbmcgcGxhY2Vob2xkZXIgb25cbiAgICAvLyA8dGV4dGFyZWE+Li4uIGJsb2NrIHRoZSBmaXJzdCBpbnB1dCBldmVudCBhbmQgcmVtb3ZlIHRoZSBibG9ja2VyXG4gICAgLy8gaW1tZWRpYXRlbHkuXG4gICAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gICAgaWYgKFxuICAgICAgaXNJRSAmJiAhaXNJRTkgJiZcbiAgICAgIGVsLnRhZ05hbWUgPT09ICdURVhUQVJFQScgJiZcbiAgICAgIGtleSA9PT0gJ3BsYWNlaG9sZGVyJyAmJiAhZWwuX19pZXBoXG4gICAgKSB7XG4gICAgICB2YXIgYmxvY2tlciA9IGZ1bmN0aW9uIChlKSB7XG4gICAgICAgIGUuc3RvcEltbWVkaWF0ZVByb3BhZ2F0aW9uKCk7XG4gICAgICAgIGVsLnJlbW92ZUV2ZW50TGlzdGVuZXIoJ2lucHV0JywgYmxvY2tlcik7XG4gICAgICB9O1xuICAgICAgZWwuYWRkRXZlbnRMaXN0ZW5lcignaW5wdXQnLCBibG9ja2VyKTtcbiAgICAgIC8vICRmbG93LWRpc2FibGUtbGluZVxuICAgICAgZWwuX19pZXBoID0gdHJ1ZTsgLyogSUUgcGxhY2Vob2xkZXIgcGF0Y2hlZCAqL1xuICAgIH1cbiAgICBlbC5zZXRBdHRyaWJ1dGUoa2V5LCB2YWx1ZSk7XG4gIH1cbn1cblxudmFyIGF0dHJzID0ge1xuICBjcmVhdGU6IHVwZGF0ZUF0dHJzLFxuICB1cGRhdGU6IHVwZGF0ZUF0dHJzXG59XG5cbi8qICAqL1xuXG5mdW5jdGlvbiB1cGRhdGVDbGFzcyAob2xkVm5vZGUsIHZub2RlKSB7XG4gIHZhciBlbCA9IHZub2RlLmVsbTtcbiAgdmFyIGRhdGEgPSB2bm9kZS5kYXRhO1xuICB2YXIgb2xkRGF0YSA9IG9sZFZub2RlLmRhdGE7XG4gIGlmIChcbiAgICBpc1VuZGVmKGRhdGEuc3RhdGljQ2xhc3MpICYmXG4gICAgaXNVbmRlZihkYXRhLmNsYXNzKSAmJiAoXG4gICAgICBpc1VuZGVmKG9sZERhdGEpIHx8IChcbiAgICAgICAgaXNVbmRlZihvbGREYXRhLnN0YXRpY0NsYXNzKSAmJlxuICAgICAgICBpc1VuZGVmKG9sZERhdGEuY2xhc3MpXG4gICAgICApXG4gICAgKVxuICApIHtcbiAgICByZXR1cm5cbiAgfVxuXG4gIHZhciBjbHMgPSBnZW5DbGFzc0ZvclZub2RlKHZub2RlKTtcblxuICAvLyBoYW5kbGUgdHJhbnNpdGlvbiBjbGFzc2VzXG4gIHZhciB0cmFuc2l0aW9uQ2xhc3MgPSBlbC5fdHJhbnNpdGlvbkNsYXNzZXM7XG4gIGlmIChpc0RlZih0cmFuc2l0aW9uQ2xhc3MpKSB7XG4gICAgY2xzID0gY29uY2F0KGNscywgc3RyaW5naWZ5Q2xhc3ModHJhbnNpdGlvbkNsYXNzKSk7XG4gIH1cblxuICAvLyBzZXQgdGhlIGNsYXNzXG4gIGlmIChjbHMgIT09IGVsLl9wcmV2Q2xhc3MpIHtcbiAgICBlbC5zZXRBdHRyaWJ1dGUoJ2NsYXNzJywgY2xzKTtcbiAgICBlbC5fcHJldkNsYXNzID0gY2xzO1xuICB9XG59XG5cbnZhciBrbGFzcyA9IHtcbiAgY3JlYXRlOiB1cGRhdGVDbGFzcyxcbiAgdXBkYXRlOiB1cGRhdGVDbGFzc1xufVxuXG4vKiAgKi9cblxuLyogICovXG5cblxuXG5cblxuXG5cblxuXG4vLyBhZGQgYSByYXcgYXR0ciAodXNlIHRoaXMgaW4gcHJlVHJhbnNmb3JtcylcblxuXG5cblxuXG5cblxuXG4vLyBub3RlOiB0aGlzIG9ubHkgcmVtb3ZlcyB0aGUgYXR0ciBmcm9tIHRoZSBBcnJheSAoYXR0cnNMaXN0KSBzbyB0aGF0IGl0XG4vLyBkb2Vzbid0IGdldCBwcm9jZXNzZWQgYnkgcHJvY2Vzc0F0dHJzLlxuLy8gQnkgZGVmYXVsdCBpdCBkb2VzIE5PVCByZW1vdmUgaXQgZnJvbSB0aGUgbWFwIChhdHRyc01hcCkgYmVjYXVzZSB0aGUgbWFwIGlzXG4vLyBuZWVkZWQgZHVyaW5nIGNvZGVnZW4uXG5cbi8qICAqL1xuXG4vKipcbiAqIENyb3NzLXBsYXRmb3JtIGNvZGUgZ2VuZXJhdGlvbiBmb3IgY29tcG9uZW50IHYtbW9kZWxcbiAqL1xuXG5cbi8qKlxuICogQ3Jvc3MtcGxhdGZvcm0gY29kZWdlbiBoZWxwZXIgZm9yIGdlbmVyYXRpbmcgdi1tb2RlbCB2YWx1ZSBhc3NpZ25tZW50IGNvZGUuXG4gKi9cblxuLyogICovXG5cbi8vIGluIHNvbWUgY2FzZXMsIHRoZSBldmVudCB1c2VkIGhhcyB0byBiZSBkZXRlcm1pbmVkIGF0IHJ1bnRpbWVcbi8vIHNvIHdlIHVzZWQgc29tZSByZXNlcnZlZCB0b2tlbnMgZHVyaW5nIGNvbXBpbGUuXG52YXIgUkFOR0VfVE9LRU4gPSAnX19yJztcbnZhciBDSEVDS0JPWF9SQURJT19UT0tFTiA9ICdfX2MnO1xuXG4vKiAgKi9cblxuLy8gbm9ybWFsaXplIHYtbW9kZWwgZXZlbnQgdG9rZW5zIHRoYXQgY2FuIG9ubHkgYmUgZGV0ZXJtaW5lZCBhdCBydW50aW1lLlxuLy8gaXQncyBpbXBvcnRhbnQgdG8gcGxhY2UgdGhlIGV2ZW50IGFzIHRoZSBmaXJzdCBpbiB0aGUgYXJyYXkgYmVjYXVzZVxuLy8gdGhlIHdob2xlIHBvaW50IGlzIGVuc3VyaW5nIHRoZSB2LW1vZGVsIGNhbGxiYWNrIGdldHMgY2FsbGVkIGJlZm9yZVxuLy8gdXNlci1hdHRhY2hlZCBoYW5kbGVycy5cbmZ1bmN0aW9uIG5vcm1hbGl6ZUV2ZW50cyAob24pIHtcbiAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gIGlmIChpc0RlZihvbltSQU5HRV9UT0tFTl0pKSB7XG4gICAgLy8gSUUgaW5wdXRbdHlwZT1yYW5nZV0gb25seSBzdXBwb3J0cyBgY2hhbmdlYCBldmVudFxuICAgIHZhciBldmVudCA9IGlzSUUgPyAnY2hhbmdlJyA6ICdpbnB1dCc7XG4gICAgb25bZXZlbnRdID0gW10uY29uY2F0KG9uW1JBTkdFX1RPS0VOXSwgb25bZXZlbnRdIHx8IFtdKTtcbiAgICBkZWxldGUgb25bUkFOR0VfVE9LRU5dO1xuICB9XG4gIC8vIFRoaXMgd2FzIG9yaWdpbmFsbHkgaW50ZW5kZWQgdG8gZml4ICM0NTIxIGJ1dCBubyBsb25nZXIgbmVjZXNzYXJ5XG4gIC8vIGFmdGVyIDIuNS4gS2VlcGluZyBpdCBmb3IgYmFja3dhcmRzIGNvbXBhdCB3aXRoIGdlbmVyYXRlZCBjb2RlIGZyb20gPCAyLjRcbiAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gIGlmIChpc0RlZihvbltDSEVDS0JPWF9SQURJT19UT0tFTl0pKSB7XG4gICAgb24uY2hhbmdlID0gW10uY29uY2F0KG9uW0NIRUNLQk9YX1JBRElPX1RPS0VOXSwgb24uY2hhbmdlIHx8IFtdKTtcbiAgICBkZWxldGUgb25bQ0hFQ0tCT1hfUkFESU9fVE9LRU5dO1xuICB9XG59XG5cbnZhciB0YXJnZXQkMTtcblxuZnVuY3Rpb24gY3JlYXRlT25jZUhhbmRsZXIgKGhhbmRsZXIsIGV2ZW50LCBjYXB0dXJlKSB7XG4gIHZhciBfdGFyZ2V0ID0gdGFyZ2V0JDE7IC8vIHNhdmUgY3VycmVudCB0YXJnZXQgZWxlbWVudCBpbiBjbG9zdXJlXG4gIHJldHVybiBmdW5jdGlvbiBvbmNlSGFuZGxlciAoKSB7XG4gICAgdmFyIHJlcyA9IGhhbmRsZXIuYXBwbHkobnVsbCwgYXJndW1lbnRzKTtcbiAgICBpZiAocmVzICE9PSBudWxsKSB7XG4gICAgICByZW1vdmUkMihldmVudCwgb25jZUhhbmRsZXIsIGNhcHR1cmUsIF90YXJnZXQpO1xuICAgIH1cbiAgfVxufVxuXG5mdW5jdGlvbiBhZGQkMSAoXG4gIGV2ZW50LFxuICBoYW5kbGVyLFxuICBvbmNlJCQxLFxuICBjYXB0dXJlLFxuICBwYXNzaXZlXG4pIHtcbiAgaGFuZGxlciA9IHdpdGhNYWNyb1Rhc2soaGFuZGxlcik7XG4gIGlmIChvbmNlJCQxKSB7IGhhbmRsZXIgPSBjcmVhdGVPbmNlSGFuZGxlcihoYW5kbGVyLCBldmVudCwgY2FwdHVyZSk7IH1cbiAgdGFyZ2V0JDEuYWRkRXZlbnRMaXN0ZW5lcihcbiAgICBldmVudCxcbiAgICBoYW5kbGVyLFxuICAgIHN1cHBvcnRzUGFzc2l2ZVxuICAgICAgPyB7IGNhcHR1cmU6IGNhcHR1cmUsIHBhc3NpdmU6IHBhc3NpdmUgfVxuICAgICAgOiBjYXB0dXJlXG4gICk7XG59XG5cbmZ1bmN0aW9uIHJlbW92ZSQyIChcbiAgZXZlbnQsXG4gIGhhbmRsZXIsXG4gIGNhcHR1cmUsXG4gIF90YXJnZXRcbikge1xuICAoX3RhcmdldCB8fCB0YXJnZXQkMSkucmVtb3ZlRXZlbnRMaXN0ZW5lcihcbiAgICBldmVudCxcbiAgICBoYW5kbGVyLl93aXRoVGFzayB8fCBoYW5kbGVyLFxuICAgIGNhcHR1cmVcbiAgKTtcbn1cblxuZnVuY3Rpb24gdXBkYXRlRE9NTGlzdGVuZXJzIChvbGRWbm9kZSwgdm5vZGUpIHtcbiAgaWYgKGlzVW5kZWYob2xkVm5vZGUuZGF0YS5vbikgJiYgaXNVbmRlZih2bm9kZS5kYXRhLm9uKSkge1xuICAgIHJldHVyblxuICB9XG4gIHZhciBvbiA9IHZub2RlLmRhdGEub24gfHwge307XG4gIHZhciBvbGRPbiA9IG9sZFZub2RlLmRhdGEub24gfHwge307XG4gIHRhcmdldCQxID0gdm5vZGUuZWxtO1xuICBub3JtYWxpemVFdmVudHMob24pO1xuICB1cGRhdGVMaXN0ZW5lcnMob24sIG9sZE9uLCBhZGQkMSwgcmVtb3ZlJDIsIHZub2RlLmNvbnRleHQpO1xuICB0YXJnZXQkMSA9IHVuZGVmaW5lZDtcbn1cblxudmFyIGV2ZW50cyA9IHtcbiAgY3JlYXRlOiB1cGRhdGVET01MaXN0ZW5lcnMsXG4gIHVwZGF0ZTogdXBkYXRlRE9NTGlzdGVuZXJzXG59XG5cbi8qICAqL1xuXG5mdW5jdGlvbiB1cGRhdGVET01Qcm9wcyAob2xkVm5vZGUsIHZub2RlKSB7XG4gIGlmIChpc1VuZGVmKG9sZFZub2RlLmRhdGEuZG9tUHJvcHMpICYmIGlzVW5kZWYodm5vZGUuZGF0YS5kb21Qcm9wcykpIHtcbiAgICByZXR1cm5cbiAgfVxuICB2YXIga2V5LCBjdXI7XG4gIHZhciBlbG0gPSB2bm9kZS5lbG07XG4gIHZhciBvbGRQcm9wcyA9IG9sZFZub2RlLmRhdGEuZG9tUHJvcHMgfHwge307XG4gIHZhciBwcm9wcyA9IHZub2RlLmRhdGEuZG9tUHJvcHMgfHwge307XG4gIC8vIGNsb25lIG9ic2VydmVkIG9iamVjdHMsIGFzIHRoZSB1c2VyIHByb2JhYmx5IHdhbnRzIHRvIG11dGF0ZSBpdFxuICBpZiAoaXNEZWYocHJvcHMuX19vYl9fKSkge1xuICAgIHByb3BzID0gdm5vZGUuZGF0YS5kb21Qcm9wcyA9IGV4dGVuZCh7fSwgcHJvcHMpO1xuICB9XG5cbiAgZm9yIChrZXkgaW4gb2xkUHJvcHMpIHtcbiAgICBpZiAoaXNVbmRlZihwcm9wc1trZXldKSkge1xuICAgICAgZWxtW2tleV0gPSAnJztcbiAgICB9XG4gIH1cbiAgZm9yIChrZXkgaW4gcHJvcHMpIHtcbiAgICBjdXIgPSBwcm9wc1trZXldO1xuICAgIC8vIGlnbm9yZSBjaGlsZHJlbiBpZiB0aGUgbm9kZSBoYXMgdGV4dENvbnRlbnQgb3IgaW5uZXJIVE1MLFxuICAgIC8vIGFzIHRoZXNlIHdpbGwgdGhyb3cgYXdheSBleGlzdGluZyBET00gbm9kZXMgYW5kIGNhdXNlIHJlbW92YWwgZXJyb3JzXG4gICAgLy8gb24gc3Vic2VxdWVudCBwYXRjaGVzICgjMzM2MClcbiAgICBpZiAoa2V5ID09PSAndGV4dENvbnRlbnQnIHx8IGtleSA9PT0gJ2lubmVySFRNTCcpIHtcbiAgICAgIGlmICh2bm9kZS5jaGlsZHJlbikgeyB2bm9kZS5jaGlsZHJlbi5sZW5ndGggPSAwOyB9XG4gICAgICBpZiAoY3VyID09PSBvbGRQcm9wc1trZXldKSB7IGNvbnRpbnVlIH1cbiAgICAgIC8vICM2NjAxIHdvcmsgYXJvdW5kIENocm9tZSB2ZXJzaW9uIDw9IDU1IGJ1ZyB3aGVyZSBzaW5nbGUgdGV4dE5vZGVcbiAgICAgIC8vIHJlcGxhY2VkIGJ5IGlubmVySFRNTC90ZXh0Q29udGVudCByZXRhaW5zIGl0cyBwYXJlbnROb2RlIHByb3BlcnR5XG4gICAgICBpZiAoZWxtLmNoaWxkTm9kZXMubGVuZ3RoID09PSAxKSB7XG4gICAgICAgIGVsbS5yZW1vdmVDaGlsZChlbG0uY2hpbGROb2Rlc1swXSk7XG4gICAgICB9XG4gICAgfVxuXG4gICAgaWYgKGtleSA9PT0gJ3ZhbHVlJykge1xuICAgICAgLy8gc3RvcmUgdmFsdWUgYXMgX3ZhbHVlIGFzIHdlbGwgc2luY2VcbiAgICAgIC8vIG5vbi1zdHJpbmcgdmFsdWVzIHdpbGwgYmUgc3RyaW5naWZpZWRcbiAgICAgIGVsbS5fdmFsdWUgPSBjdXI7XG4gICAgICAvLyBhdm9pZCByZXNldHRpbmcgY3Vyc29yIHBvc2l0aW9uIHdoZW4gdmFsdWUgaXMgdGhlIHNhbWVcbiAgICAgIHZhciBzdHJDdXIgPSBpc1VuZGVmKGN1cikgPyAnJyA6IFN0cmluZyhjdXIpO1xuICAgICAgaWYgKHNob3VsZFVwZGF0ZVZhbHVlKGVsbSwgc3RyQ3VyKSkge1xuICAgICAgICBlbG0udmFsdWUgPSBzdHJDdXI7XG4gICAgICB9XG4gICAgfSBlbHNlIHtcbiAgICAgIGVsbVtrZXldID0gY3VyO1xuICAgIH1cbiAgfVxufVxuXG4vLyBjaGVjayBwbGF0Zm9ybXMvd2ViL3V0aWwvYXR0cnMuanMgYWNjZXB0VmFsdWVcblxuXG5mdW5jdGlvbiBzaG91bGRVcGRhdGVWYWx1ZSAoZWxtLCBjaGVja1ZhbCkge1xuICByZXR1cm4gKCFlbG0uY29tcG9zaW5nICYmIChcbiAgICBlbG0udGFnTmFtZSA9PT0gJ09QVElPTicgfHxcbiAgICBpc05vdEluRm9jdXNBbmREaXJ0eShlbG0sIGNoZWNrVmFsKSB8fFxuICAgIGlzRGlydHlXaXRoTW9kaWZpZXJzKGVsbSwgY2hlY2tWYWwpXG4gICkpXG59XG5cbmZ1bmN0aW9uIGlzTm90SW5Gb2N1c0FuZERpcnR5IChlbG0sIGNoZWNrVmFsKSB7XG4gIC8vIHJldHVybiB0cnVlIHdoZW4gdGV4dGJveCAoLm51bWJlciBhbmQgLnRyaW0pIGxvc2VzIGZvY3VzIGFuZCBpdHMgdmFsdWUgaXNcbiAgLy8gbm90IGVxdWFsIHRvIHRoZSB1cGRhdGVkIHZhbHVlXG4gIHZhciBub3RJbkZvY3VzID0gdHJ1ZTtcbiAgLy8gIzYxNTdcbiAgLy8gd29yayBhcm91bmQgSUUgYnVnIHdoZW4gYWNjZXNzaW5nIGRvY3VtZW50LmFjdGl2ZUVsZW1lbnQgaW4gYW4gaWZyYW1lXG4gIHRyeSB7IG5vdEluRm9jdXMgPSBkb2N1bWVudC5hY3RpdmVFbGVtZW50ICE9PSBlbG07IH0gY2F0Y2ggKGUpIHt9XG4gIHJldHVybiBub3RJbkZvY3VzICYmIGVsbS52YWx1ZSAhPT0gY2hlY2tWYWxcbn1cblxuZnVuY3Rpb24gaXNEaXJ0eVdpdGhNb2RpZmllcnMgKGVsbSwgbmV3VmFsKSB7XG4gIHZhciB2YWx1ZSA9IGVsbS52YWx1ZTtcbiAgdmFyIG1vZGlmaWVycyA9IGVsbS5fdk1vZGlmaWVyczsgLy8gaW5qZWN0ZWQgYnkgdi1tb2RlbCBydW50aW1lXG4gIGlmIChpc0RlZihtb2RpZmllcnMpKSB7XG4gICAgaWYgKG1vZGlmaWVycy5sYXp5KSB7XG4gICAgICAvLyBpbnB1dHMgd2l0aCBsYXp5IHNob3VsZCBvbmx5IGJlIHVwZGF0ZWQgd2hlbiBub3QgaW4gZm9jdXNcbiAgICAgIHJldHVybiBmYWxzZVxuICAgIH1cbiAgICBpZiAobW9kaWZpZXJzLm51bWJlcikge1xuICAgICAgcmV0dXJuIHRvTnVtYmVyKHZhbHVlKSAhPT0gdG9OdW1iZXIobmV3VmFsKVxuICAgIH1cbiAgICBpZiAobW9kaWZpZXJzLnRyaW0pIHtcbiAgICAgIHJldHVybiB2YWx1ZS50cmltKCkgIT09IG5ld1ZhbC50cmltKClcbiAgICB9XG4gIH1cbiAgcmV0dXJuIHZhbHVlICE9PSBuZXdWYWxcbn1cblxudmFyIGRvbVByb3BzID0ge1xuICBjcmVhdGU6IHVwZGF0ZURPTVByb3BzLFxuICB1cGRhdGU6IHVwZGF0ZURPTVByb3BzXG59XG5cbi8qICAqL1xuXG52YXIgcGFyc2VTdHlsZVRleHQgPSBjYWNoZWQoZnVuY3Rpb24gKGNzc1RleHQpIHtcbiAgdmFyIHJlcyA9IHt9O1xuICB2YXIgbGlzdERlbGltaXRlciA9IC87KD8hW14oXSpcXCkpL2c7XG4gIHZhciBwcm9wZXJ0eURlbGltaXRlciA9IC86KC4rKS87XG4gIGNzc1RleHQuc3BsaXQobGlzdERlbGltaXRlcikuZm9yRWFjaChmdW5jdGlvbiAoaXRlbSkge1xuICAgIGlmIChpdGVtKSB7XG4gICAgICB2YXIgdG1wID0gaXRlbS5zcGxpdChwcm9wZXJ0eURlbGltaXRlcik7XG4gICAgICB0bXAubGVuZ3RoID4gMSAmJiAocmVzW3RtcFswXS50cmltKCldID0gdG1wWzFdLnRyaW0oKSk7XG4gICAgfVxuICB9KTtcbiAgcmV0dXJuIHJlc1xufSk7XG5cbi8vIG1lcmdlIHN0YXRpYyBhbmQgZHluYW1pYyBzdHlsZSBkYXRhIG9uIHRoZSBzYW1lIHZub2RlXG5mdW5jdGlvbiBub3JtYWxpemVTdHlsZURhdGEgKGRhdGEpIHtcbiAgdmFyIHN0eWxlID0gbm9ybWFsaXplU3R5bGVCaW5kaW5nKGRhdGEuc3R5bGUpO1xuICAvLyBzdGF0aWMgc3R5bGUgaXMgcHJlLXByb2Nlc3NlZCBpbnRvIGFuIG9iamVjdCBkdXJpbmcgY29tcGlsYXRpb25cbiAgLy8gYW5kIGlzIGFsd2F5cyBhIGZyZXNoIG9iamVjdCwgc28gaXQncyBzYWZlIHRvIG1lcmdlIGludG8gaXRcbiAgcmV0dXJuIGRhdGEuc3RhdGljU3R5bGVcbiAgICA/IGV4dGVuZChkYXRhLnN0YXRpY1N0eWxlLCBzdHlsZSlcbiAgICA6IHN0eWxlXG59XG5cbi8vIG5vcm1hbGl6ZSBwb3NzaWJsZSBhcnJheSAvIHN0cmluZyB2YWx1ZXMgaW50byBPYmplY3RcbmZ1bmN0aW9uIG5vcm1hbGl6ZVN0eWxlQmluZGluZyAoYmluZGluZ1N0eWxlKSB7XG4gIGlmIChBcnJheS5pc0FycmF5KGJpbmRpbmdTdHlsZSkpIHtcbiAgICByZXR1cm4gdG9PYmplY3QoYmluZGluZ1N0eWxlKVxuICB9XG4gIGlmICh0eXBlb2YgYmluZGluZ1N0eWxlID09PSAnc3RyaW5nJykge1xuICAgIHJldHVybiBwYXJzZVN0eWxlVGV4dChiaW5kaW5nU3R5bGUpXG4gIH1cbiAgcmV0dXJuIGJpbmRpbmdTdHlsZVxufVxuXG4vKipcbiAqIHBhcmVudCBjb21wb25lbnQgc3R5bGUgc2hvdWxkIGJlIGFmdGVyIGNoaWxkJ3NcbiAqIHNvIHRoYXQgcGFyZW50IGNvbXBvbmVudCdzIHN0eWxlIGNvdWxkIG92ZXJyaWRlIGl0XG4gKi9cbmZ1bmN0aW9uIGdldFN0eWxlICh2bm9kZSwgY2hlY2tDaGlsZCkge1xuICB2YXIgcmVzID0ge307XG4gIHZhciBzdHlsZURhdGE7XG5cbiAgaWYgKGNoZWNrQ2hpbGQpIHtcbiAgICB2YXIgY2hpbGROb2RlID0gdm5vZGU7XG4gICAgd2hpbGUgKGNoaWxkTm9kZS5jb21wb25lbnRJbnN0YW5jZSkge1xuICAgICAgY2hpbGROb2RlID0gY2hpbGROb2RlLmNvbXBvbmVudEluc3RhbmNlLl92bm9kZTtcbiAgICAgIGlmIChcbiAgICAgICAgY2hpbGROb2RlICYmIGNoaWxkTm9kZS5kYXRhICYmXG4gICAgICAgIChzdHlsZURhdGEgPSBub3JtYWxpemVTdHlsZURhdGEoY2hpbGROb2RlLmRhdGEpKVxuICAgICAgKSB7XG4gICAgICAgIGV4dGVuZChyZXMsIHN0eWxlRGF0YSk7XG4gICAgICB9XG4gICAgfVxuICB9XG5cbiAgaWYgKChzdHlsZURhdGEgPSBub3JtYWxpemVTdHlsZURhdGEodm5vZGUuZGF0YSkpKSB7XG4gICAgZXh0ZW5kKHJlcywgc3R5bGVEYXRhKTtcbiAgfVxuXG4gIHZhciBwYXJlbnROb2RlID0gdm5vZGU7XG4gIHdoaWxlICgocGFyZW50Tm9kZSA9IHBhcmVudE5vZGUucGFyZW50KSkge1xuICAgIGlmIChwYXJlbnROb2RlLmRhdGEgJiYgKHN0eWxlRGF0YSA9IG5vcm1hbGl6ZVN0eWxlRGF0YShwYXJlbnROb2RlLmRhdGEpKSkge1xuICAgICAgZXh0ZW5kKHJlcywgc3R5bGVEYXRhKTtcbiAgICB9XG4gIH1cbiAgcmV0dXJuIHJlc1xufVxuXG4vKiAgKi9cblxudmFyIGNzc1ZhclJFID0gL14tLS87XG52YXIgaW1wb3J0YW50UkUgPSAvXFxzKiFpbXBvcnRhbnQkLztcbnZhciBzZXRQcm9wID0gZnVuY3Rpb24gKGVsLCBuYW1lLCB2YWwpIHtcbiAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gIGlmIChjc3NWYXJSRS50ZXN0KG5hbWUpKSB7XG4gICAgZWwuc3R5bGUuc2V0UHJvcGVydHkobmFtZSwgdmFsKTtcbiAgfSBlbHNlIGlmIChpbXBvcnRhbnRSRS50ZXN0KHZhbCkpIHtcbiAgICBlbC5zdHlsZS5zZXRQcm9wZXJ0eShuYW1lLCB2YWwucmVwbGFjZShpbXBvcnRhbnRSRSwgJycpLCAnaW1wb3J0YW50Jyk7XG4gIH0gZWxzZSB7XG4gICAgdmFyIG5vcm1hbGl6ZWROYW1lID0gbm9ybWFsaXplKG5hbWUpO1xuICAgIGlmIChBcnJheS5pc0FycmF5KHZhbCkpIHtcbiAgICAgIC8vIFN1cHBvcnQgdmFsdWVzIGFycmF5IGNyZWF0ZWQgYnkgYXV0b3ByZWZpeGVyLCBlLmcuXG4gICAgICAvLyB7ZGlzcGxheTogW1wiLXdlYmtpdC1ib3hcIiwgXCItbXMtZmxleGJveFwiLCBcImZsZXhcIl19XG4gICAgICAvLyBTZXQgdGhlbSBvbmUgYnkgb25lLCBhbmQgdGhlIGJyb3dzZXIgd2lsbCBvbmx5IHNldCB0aG9zZSBpdCBjYW4gcmVjb2duaXplXG4gICAgICBmb3IgKHZhciBpID0gMCwgbGVuID0gdmFsLmxlbmd0aDsgaSA8IGxlbjsgaSsrKSB7XG4gICAgICAgIGVsLnN0eWxlW25vcm1hbGl6ZWROYW1lXSA9IHZhbFtpXTtcbiAgICAgIH1cbiAgICB9IGVsc2Uge1xuICAgICAgZWwuc3R5bGVbbm9ybWFsaXplZE5hbWVdID0gdmFsO1xuICAgIH1cbiAgfVxufTtcblxudmFyIHZlbmRvck5hbWVzID0gWydXZWJraXQnLCAnTW96JywgJ21zJ107XG5cbnZhciBlbXB0eVN0eWxlO1xudmFyIG5vcm1hbGl6ZSA9IGNhY2hlZChmdW5jdGlvbiAocHJvcCkge1xuICBlbXB0eVN0eWxlID0gZW1wdHlTdHlsZSB8fCBkb2N1bWVudC5jcmVhdGVFbGVtZW50KCdkaXYnKS5zdHlsZTtcbiAgcHJvcCA9IGNhbWVsaXplKHByb3ApO1xuICBpZiAocHJvcCAhPT0gJ2ZpbHRlcicgJiYgKHByb3AgaW4gZW1wdHlTdHlsZSkpIHtcbiAgICByZXR1cm4gcHJvcFxuICB9XG4gIHZhciBjYXBOYW1lID0gcHJvcC5jaGFyQXQoMCkudG9VcHBlckNhc2UoKSArIHByb3Auc2xpY2UoMSk7XG4gIGZvciAodmFyIGkgPSAwOyBpIDwgdmVuZG9yTmFtZXMubGVuZ3RoOyBpKyspIHtcbiAgICB2YXIgbmFtZSA9IHZlbmRvck5hbWVzW2ldICsgY2FwTmFtZTtcbiAgICBpZiAobmFtZSBpbiBlbXB0eVN0eWxlKSB7XG4gICAgICByZXR1cm4gbmFtZVxuICAgIH1cbiAgfVxufSk7XG5cbmZ1bmN0aW9uIHVwZGF0ZVN0eWxlIChvbGRWbm9kZSwgdm5vZGUpIHtcbiAgdmFyIGRhdGEgPSB2bm9kZS5kYXRhO1xuICB2YXIgb2xkRGF0YSA9IG9sZFZub2RlLmRhdGE7XG5cbiAgaWYgKGlzVW5kZWYoZGF0YS5zdGF0aWNTdHlsZSkgJiYgaXNVbmRlZihkYXRhLnN0eWxlKSAmJlxuICAgIGlzVW5kZWYob2xkRGF0YS5zdGF0aWNTdHlsZSkgJiYgaXNVbmRlZihvbGREYXRhLnN0eWxlKVxuICApIHtcbiAgICByZXR1cm5cbiAgfVxuXG4gIHZhciBjdXIsIG5hbWU7XG4gIHZhciBlbCA9IHZub2RlLmVsbTtcbiAgdmFyIG9sZFN0YXRpY1N0eWxlID0gb2xkRGF0YS5zdGF0aWNTdHlsZTtcbiAgdmFyIG9sZFN0eWxlQmluZGluZyA9IG9sZERhdGEubm9ybWFsaXplZFN0eWxlIHx8IG9sZERhdGEuc3R5bGUgfHwge307XG5cbiAgLy8gaWYgc3RhdGljIHN0eWxlIGV4aXN0cywgc3R5bGViaW5kaW5nIGFscmVhZHkgbWVyZ2VkIGludG8gaXQgd2hlbiBkb2luZyBub3JtYWxpemVTdHlsZURhdGFcbiAgdmFyIG9sZFN0eWxlID0gb2xkU3RhdGljU3R5bGUgfHwgb2xkU3R5bGVCaW5kaW5nO1xuXG4gIHZhciBzdHlsZSA9IG5vcm1hbGl6ZVN0eWxlQmluZGluZyh2bm9kZS5kYXRhLnN0eWxlKSB8fCB7fTtcblxuICAvLyBzdG9yZSBub3JtYWxpemVkIHN0eWxlIHVuZGVyIGEgZGlmZmVyZW50IGtleSBmb3IgbmV4dCBkaWZmXG4gIC8vIG1ha2Ugc3VyZSB0byBjbG9uZSBpdCBpZiBpdCdzIHJlYWN0aXZlLCBzaW5jZSB0aGUgdXNlciBsaWtlbHkgd2FudHNcbiAgLy8gdG8gbXV0YXRlIGl0LlxuICB2bm9kZS5kYXRhLm5vcm1hbGl6ZWRTdHlsZSA9IGlzRGVmKHN0eWxlLl9fb2JfXylcbiAgICA/IGV4dGVuZCh7fSwgc3R5bGUpXG4gICAgOiBzdHlsZTtcblxuICB2YXIgbmV3U3R5bGUgPSBnZXRTdHlsZSh2bm9kZSwgdHJ1ZSk7XG5cbiAgZm9yIChuYW1lIGluIG9sZFN0eWxlKSB7XG4gICAgaWYgKGlzVW5kZWYobmV3U3R5bGVbbmFtZV0pKSB7XG4gICAgICBzZXRQcm9wKGVsLCBuYW1lLCAnJyk7XG4gICAgfVxuICB9XG4gIGZvciAobmFtZSBpbiBuZXdTdHlsZSkge1xuICAgIGN1ciA9IG5ld1N0eWxlW25hbWVdO1xuICAgIGlmIChjdXIgIT09IG9sZFN0eWxlW25hbWVdKSB7XG4gICAgICAvLyBpZTkgc2V0dGluZyB0byBudWxsIGhhcyBubyBlZmZlY3QsIG11c3QgdXNlIGVtcHR5IHN0cmluZ1xuICAgICAgc2V0UHJvcChlbCwgbmFtZSwgY3VyID09IG51bGwgPyAnJyA6IGN1cik7XG4gICAgfVxuICB9XG59XG5cbnZhciBzdHlsZSA9IHtcbiAgY3JlYXRlOiB1cGRhdGVTdHlsZSxcbiAgdXBkYXRlOiB1cGRhdGVTdHlsZVxufVxuXG4vKiAgKi9cblxuLyoqXG4gKiBBZGQgY2xhc3Mgd2l0aCBjb21wYXRpYmlsaXR5IGZvciBTVkcgc2luY2UgY2xhc3NMaXN0IGlzIG5vdCBzdXBwb3J0ZWQgb25cbiAqIFNWRyBlbGVtZW50cyBpbiBJRVxuICovXG5mdW5jdGlvbiBhZGRDbGFzcyAoZWwsIGNscykge1xuICAvKiBpc3RhbmJ1bCBpZ25vcmUgaWYgKi9cbiAgaWYgKCFjbHMgfHwgIShjbHMgPSBjbHMudHJpbSgpKSkge1xuICAgIHJldHVyblxuICB9XG5cbiAgLyogaXN0YW5idWwgaWdub3JlIGVsc2UgKi9cbiAgaWYgKGVsLmNsYXNzTGlzdCkge1xuICAgIGlmIChjbHMuaW5kZXhPZignICcpID4gLTEpIHtcbiAgICAgIGNscy5zcGxpdCgvXFxzKy8pLmZvckVhY2goZnVuY3Rpb24gKGMpIHsgcmV0dXJuIGVsLmNsYXNzTGlzdC5hZGQoYyk7IH0pO1xuICAgIH0gZWxzZSB7XG4gICAgICBlbC5jbGFzc0xpc3QuYWRkKGNscyk7XG4gICAgfVxuICB9IGVsc2Uge1xuICAgIHZhciBjdXIgPSBcIiBcIiArIChlbC5nZXRBdHRyaWJ1dGUoJ2NsYXNzJykgfHwgJycpICsgXCIgXCI7XG4gICAgaWYgKGN1ci5pbmRleE9mKCcgJyArIGNscyArICcgJykgPCAwKSB7XG4gICAgICBlbC5zZXRBdHRyaWJ1dGUoJ2NsYXNzJywgKGN1ciArIGNscykudHJpbSgpKTtcbiAgICB9XG4gIH1cbn1cblxuLyoqXG4gKiBSZW1vdmUgY2xhc3Mgd2l0aCBjb21wYXRpYmlsaXR5IGZvciBTVkcgc2luY2UgY2xhc3NMaXN0IGlzIG5vdCBzdXBwb3J0ZWQgb25cbiAqIFNWRyBlbGVtZW50cyBpbiBJRVxuICovXG5mdW5jdGlvbiByZW1vdmVDbGFzcyAoZWwsIGNscykge1xuICAvKiBpc3RhbmJ1bCBpZ25vcmUgaWYgKi9cbiAgaWYgKCFjbHMgfHwgIShjbHMgPSBjbHMudHJpbSgpKSkge1xuICAgIHJldHVyblxuICB9XG5cbiAgLyogaXN0YW5idWwgaWdub3JlIGVsc2UgKi9cbiAgaWYgKGVsLmNsYXNzTGlzdCkge1xuICAgIGlmIChjbHMuaW5kZXhPZignICcpID4gLTEpIHtcbiAgICAgIGNscy5zcGxpdCgvXFxzKy8pLmZvckVhY2goZnVuY3Rpb24gKGMpIHsgcmV0dXJuIGVsLmNsYXNzTGlzdC5yZW1vdmUoYyk7IH0pO1xuICAgIH0gZWxzZSB7XG4gICAgICBlbC5jbGFzc0xpc3QucmVtb3ZlKGNscyk7XG4gICAgfVxuICAgIGlmICghZWwuY2xhc3NMaXN0Lmxlbmd0aCkge1xuICAgICAgZWwucmVtb3ZlQXR0cmlidXRlKCdjbGFzcycpO1xuICAgIH1cbiAgfSBlbHNlIHtcbiAgICB2YXIgY3VyID0gXCIgXCIgKyAoZWwuZ2V0QXR0cmlidXRlKCdjbGFzcycpIHx8ICcnKSArIFwiIFwiO1xuICAgIHZhciB0YXIgPSAnICcgKyBjbHMgKyAnICc7XG4gICAgd2hpbGUgKGN1ci5pbmRleE9mKHRhcikgPj0gMCkge1xuICAgICAgY3VyID0gY3VyLnJlcGxhY2UodGFyLCAnICcpO1xuICAgIH1cbiAgICBjdXIgPSBjdXIudHJpbSgpO1xuICAgIGlmIChjdXIpIHtcbiAgICAgIGVsLnNldEF0dHJpYnV0ZSgnY2xhc3MnLCBjdXIpO1xuICAgIH0gZWxzZSB7XG4gICAgICBlbC5yZW1vdmVBdHRyaWJ1dGUoJ2NsYXNzJyk7XG4gICAgfVxuICB9XG59XG5cbi8qICAqL1xuXG5mdW5jdGlvbiByZXNvbHZlVHJhbnNpdGlvbiAoZGVmKSB7XG4gIGlmICghZGVmKSB7XG4gICAgcmV0dXJuXG4gIH1cbiAgLyogaXN0YW5idWwgaWdub3JlIGVsc2UgKi9cbiAgaWYgKHR5cGVvZiBkZWYgPT09ICdvYmplY3QnKSB7XG4gICAgdmFyIHJlcyA9IHt9O1xuICAgIGlmIChkZWYuY3NzICE9PSBmYWxzZSkge1xuICAgICAgZXh0ZW5kKHJlcywgYXV0b0Nzc1RyYW5zaXRpb24oZGVmLm5hbWUgfHwgJ3YnKSk7XG4gICAgfVxuICAgIGV4dGVuZChyZXMsIGRlZik7XG4gICAgcmV0dXJuIHJlc1xuICB9IGVsc2UgaWYgKHR5cGVvZiBkZWYgPT09ICdzdHJpbmcnKSB7XG4gICAgcmV0dXJuIGF1dG9Dc3NUcmFuc2l0aW9uKGRlZilcbiAgfVxufVxuXG52YXIgYXV0b0Nzc1RyYW5zaXRpb24gPSBjYWNoZWQoZnVuY3Rpb24gKG5hbWUpIHtcbiAgcmV0dXJuIHtcbiAgICBlbnRlckNsYXNzOiAobmFtZSArIFwiLWVudGVyXCIpLFxuICAgIGVudGVyVG9DbGFzczogKG5hbWUgKyBcIi1lbnRlci10b1wiKSxcbiAgICBlbnRlckFjdGl2ZUNsYXNzOiAobmFtZSArIFwiLWVudGVyLWFjdGl2ZVwiKSxcbiAgICBsZWF2ZUNsYXNzOiAobmFtZSArIFwiLWxlYXZlXCIpLFxuICAgIGxlYXZlVG9DbGFzczogKG5hbWUgKyBcIi1sZWF2ZS10b1wiKSxcbiAgICBsZWF2ZUFjdGl2ZUNsYXNzOiAobmFtZSArIFwiLWxlYXZlLWFjdGl2ZVwiKVxuICB9XG59KTtcblxudmFyIGhhc1RyYW5zaXRpb24gPSBpbkJyb3dzZXIgJiYgIWlzSUU5O1xudmFyIFRSQU5TSVRJT04gPSAndHJhbnNpdGlvbic7XG52YXIgQU5JTUFUSU9OID0gJ2FuaW1hdGlvbic7XG5cbi8vIFRyYW5zaXRpb24gcHJvcGVydHkvZXZlbnQgc25pZmZpbmdcbnZhciB0cmFuc2l0aW9uUHJvcCA9ICd0cmFuc2l0aW9uJztcbnZhciB0cmFuc2l0aW9uRW5kRXZlbnQgPSAndHJhbnNpdGlvbmVuZCc7XG52YXIgYW5pbWF0aW9uUHJvcCA9ICdhbmltYXRpb24nO1xudmFyIGFuaW1hdGlvbkVuZEV2ZW50ID0gJ2FuaW1hdGlvbmVuZCc7XG5pZiAoaGFzVHJhbnNpdGlvbikge1xuICAvKiBpc3RhbmJ1bCBpZ25vcmUgaWYgKi9cbiAgaWYgKHdpbmRvdy5vbnRyYW5zaXRpb25lbmQgPT09IHVuZGVmaW5lZCAmJlxuICAgIHdpbmRvdy5vbndlYmtpdHRyYW5zaXRpb25lbmQgIT09IHVuZGVmaW5lZFxuICApIHtcbiAgICB0cmFuc2l0aW9uUHJvcCA9ICdXZWJraXRUcmFuc2l0aW9uJztcbiAgICB0cmFuc2l0aW9uRW5kRXZlbnQgPSAnd2Via2l0VHJhbnNpdGlvbkVuZCc7XG4gIH1cbiAgaWYgKHdpbmRvdy5vbmFuaW1hdGlvbmVuZCA9PT0gdW5kZWZpbmVkICYmXG4gICAgd2luZG93Lm9ud2Via2l0YW5pbWF0aW9uZW5kICE9PSB1bmRlZmluZWRcbiAgKSB7XG4gICAgYW5pbWF0aW9uUHJvcCA9ICdXZWJraXRBbmltYXRpb24nO1xuICAgIGFuaW1hdGlvbkVuZEV2ZW50ID0gJ3dlYmtpdEFuaW1hdGlvbkVuZCc7XG4gIH1cbn1cblxuLy8gYmluZGluZyB0byB3aW5kb3cgaXMgbmVjZXNzYXJ5IHRvIG1ha2UgaG90IHJlbG9hZCB3b3JrIGluIElFIGluIHN0cmljdCBtb2RlXG52YXIgcmFmID0gaW5Ccm93c2VyXG4gID8gd2luZG93LnJlcXVlc3RBbmltYXRpb25GcmFtZVxuICAgID8gd2luZG93LnJlcXVlc3RBbmltYXRpb25GcmFtZS5iaW5kKHdpbmRvdylcbiAgICA6IHNldFRpbWVvdXRcbiAgOiAvKiBpc3RhbmJ1bCBpZ25vcmUgbmV4dCAqLyBmdW5jdGlvbiAoZm4pIHsgcmV0dXJuIGZuKCk7IH07XG5cbmZ1bmN0aW9uIG5leHRGcmFtZSAoZm4pIHtcbiAgcmFmKGZ1bmN0aW9uICgpIHtcbiAgICByYWYoZm4pO1xuICB9KTtcbn1cblxuZnVuY3Rpb24gYWRkVHJhbnNpdGlvbkNsYXNzIChlbCwgY2xzKSB7XG4gIHZhciB0cmFuc2l0aW9uQ2xhc3NlcyA9IGVsLl90cmFuc2l0aW9uQ2xhc3NlcyB8fCAoZWwuX3RyYW5zaXRpb25DbGFzc2VzID0gW10pO1xuICBpZiAodHJhbnNpdGlvbkNsYXNzZXMuaW5kZXhPZihjbHMpIDwgMCkge1xuICAgIHRyYW5zaXRpb25DbGFzc2VzLnB1c2goY2xzKTtcbiAgICBhZGRDbGFzcyhlbCwgY2xzKTtcbiAgfVxufVxuXG5mdW5jdGlvbiByZW1vdmVUcmFuc2l0aW9uQ2xhc3MgKGVsLCBjbHMpIHtcbiAgaWYgKGVsLl90cmFuc2l0aW9uQ2xhc3Nlcykge1xuICAgIHJlbW92ZShlbC5fdHJhbnNpdGlvbkNsYXNzZXMsIGNscyk7XG4gIH1cbiAgcmVtb3ZlQ2xhc3MoZWwsIGNscyk7XG59XG5cbmZ1bmN0aW9uIHdoZW5UcmFuc2l0aW9uRW5kcyAoXG4gIGVsLFxuICBleHBlY3RlZFR5cGUsXG4gIGNiXG4pIHtcbiAgdmFyIHJlZiA9IGdldFRyYW5zaXRpb25JbmZvKGVsLCBleHBlY3RlZFR5cGUpO1xuICB2YXIgdHlwZSA9IHJlZi50eXBlO1xuICB2YXIgdGltZW91dCA9IHJlZi50aW1lb3V0O1xuICB2YXIgcHJvcENvdW50ID0gcmVmLnByb3BDb3VudDtcbiAgaWYgKCF0eXBlKSB7IHJldHVybiBjYigpIH1cbiAgdmFyIGV2ZW50ID0gdHlwZSA9PT0gVFJBTlNJVElPTiA/IHRyYW5zaXRpb25FbmRFdmVudCA6IGFuaW1hdGlvbkVuZEV2ZW50O1xuICB2YXIgZW5kZWQgPSAwO1xuICB2YXIgZW5kID0gZnVuY3Rpb24gKCkge1xuICAgIGVsLnJlbW92ZUV2ZW50TGlzdGVuZXIoZXZlbnQsIG9uRW5kKTtcbiAgICBjYigpO1xuICB9O1xuICB2YXIgb25FbmQgPSBmdW5jdGlvbiAoZSkge1xuICAgIGlmIChlLnRhcmdldCA9PT0gZWwpIHtcbiAgICAgIGlmICgrK2VuZGVkID49IHByb3BDb3VudCkge1xuICAgICAgICBlbmQoKTtcbiAgICAgIH1cbiAgICB9XG4gIH07XG4gIHNldFRpbWVvdXQoZnVuY3Rpb24gKCkge1xuICAgIGlmIChlbmRlZCA8IHByb3BDb3VudCkge1xuICAgICAgZW5kKCk7XG4gICAgfVxuICB9LCB0aW1lb3V0ICsgMSk7XG4gIGVsLmFkZEV2ZW50TGlzdGVuZXIoZXZlbnQsIG9uRW5kKTtcbn1cblxudmFyIHRyYW5zZm9ybVJFID0gL1xcYih0cmFuc2Zvcm18YWxsKSgsfCQpLztcblxuZnVuY3Rpb24gZ2V0VHJhbnNpdGlvbkluZm8gKGVsLCBleHBlY3RlZFR5cGUpIHtcbiAgdmFyIHN0eWxlcyA9IHdpbmRvdy5nZXRDb21wdXRlZFN0eWxlKGVsKTtcbiAgdmFyIHRyYW5zaXRpb25EZWxheXMgPSBzdHlsZXNbdHJhbnNpdGlvblByb3AgKyAnRGVsYXknXS5zcGxpdCgnLCAnKTtcbiAgdmFyIHRyYW5zaXRpb25EdXJhdGlvbnMgPSBzdHlsZXNbdHJhbnNpdGlvblByb3AgKyAnRHVyYXRpb24nXS5zcGxpdCgnLCAnKTtcbiAgdmFyIHRyYW5zaXRpb25UaW1lb3V0ID0gZ2V0VGltZW91dCh0cmFuc2l0aW9uRGVsYXlzLCB0cmFuc2l0aW9uRHVyYXRpb25zKTtcbiAgdmFyIGFuaW1hdGlvbkRlbGF5cyA9IHN0eWxlc1thbmltYXRpb25Qcm9wICsgJ0RlbGF5J10uc3BsaXQoJywgJyk7XG4gIHZhciBhbmltYXRpb25EdXJhdGlvbnMgPSBzdHlsZXNbYW5pbWF0aW9uUHJvcCArICdEdXJhdGlvbiddLnNwbGl0KCcsICcpO1xuICB2YXIgYW5pbWF0aW9uVGltZW91dCA9IGdldFRpbWVvdXQoYW5pbWF0aW9uRGVsYXlzLCBhbmltYXRpb25EdXJhdGlvbnMpO1xuXG4gIHZhciB0eXBlO1xuICB2YXIgdGltZW91dCA9IDA7XG4gIHZhciBwcm9wQ291bnQgPSAwO1xuICAvKiBpc3RhbmJ1bCBpZ25vcmUgaWYgKi9cbiAgaWYgKGV4cGVjdGVkVHlwZSA9PT0gVFJBTlNJVElPTikge1xuICAgIGlmICh0cmFuc2l0aW9uVGltZW91dCA+IDApIHtcbiAgICAgIHR5cGUgPSBUUkFOU0lUSU9OO1xuICAgICAgdGltZW91dCA9IHRyYW5zaXRpb25UaW1lb3V0O1xuICAgICAgcHJvcENvdW50ID0gdHJhbnNpdGlvbkR1cmF0aW9ucy5sZW5ndGg7XG4gICAgfVxuICB9IGVsc2UgaWYgKGV4cGVjdGVkVHlwZSA9PT0gQU5JTUFUSU9OKSB7XG4gICAgaWYgKGFuaW1hdGlvblRpbWVvdXQgPiAwKSB7XG4gICAgICB0eXBlID0gQU5JTUFUSU9OO1xuICAgICAgdGltZW91dCA9IGFuaW1hdGlvblRpbWVvdXQ7XG4gICAgICBwcm9wQ291bnQgPSBhbmltYXRpb25EdXJhdGlvbnMubGVuZ3RoO1xuICAgIH1cbiAgfSBlbHNlIHtcbiAgICB0aW1lb3V0ID0gTWF0aC5tYXgodHJhbnNpdGlvblRpbWVvdXQsIGFuaW1hdGlvblRpbWVvdXQpO1xuICAgIHR5cGUgPSB0aW1lb3V0ID4gMFxuICAgICAgPyB0cmFuc2l0aW9uVGltZW91dCA+IGFuaW1hdGlvblRpbWVvdXRcbiAgICAgICAgPyBUUkFOU0lUSU9OXG4gICAgICAgIDogQU5JTUFUSU9OXG4gICAgICA6IG51bGw7XG4gICAgcHJvcENvdW50ID0gdHlwZVxuICAgICAgPyB0eXBlID09PSBUUkFOU0lUSU9OXG4gICAgICAgID8gdHJhbnNpdGlvbkR1cmF0aW9ucy5sZW5ndGhcbiAgICAgICAgOiBhbmltYXRpb25EdXJhdGlvbnMubGVuZ3RoXG4gICAgICA6IDA7XG4gIH1cbiAgdmFyIGhhc1RyYW5zZm9ybSA9XG4gICAgdHlwZSA9PT0gVFJBTlNJVElPTiAmJlxuICAgIHRyYW5zZm9ybVJFLnRlc3Qoc3R5bGVzW3RyYW5zaXRpb25Qcm9wICsgJ1Byb3BlcnR5J10pO1xuICByZXR1cm4ge1xuICAgIHR5cGU6IHR5cGUsXG4gICAgdGltZW91dDogdGltZW91dCxcbiAgICBwcm9wQ291bnQ6IHByb3BDb3VudCxcbiAgICBoYXNUcmFuc2Zvcm06IGhhc1RyYW5zZm9ybVxuICB9XG59XG5cbmZ1bmN0aW9uIGdldFRpbWVvdXQgKGRlbGF5cywgZHVyYXRpb25zKSB7XG4gIC8qIGlzdGFuYnVsIGlnbm9yZSBuZXh0ICovXG4gIHdoaWxlIChkZWxheXMubGVuZ3RoIDwgZHVyYXRpb25zLmxlbmd0aCkge1xuICAgIGRlbGF5cyA9IGRlbGF5cy5jb25jYXQoZGVsYXlzKTtcbiAgfVxuXG4gIHJldHVybiBNYXRoLm1heC5hcHBseShudWxsLCBkdXJhdGlvbnMubWFwKGZ1bmN0aW9uIChkLCBpKSB7XG4gICAgcmV0dXJuIHRvTXMoZCkgKyB0b01zKGRlbGF5c1tpXSlcbiAgfSkpXG59XG5cbmZ1bmN0aW9uIHRvTXMgKHMpIHtcbiAgcmV0dXJuIE51bWJlcihzLnNsaWNlKDAsIC0xKSkgKiAxMDAwXG59XG5cbi8qICAqL1xuXG5mdW5jdGlvbiBlbnRlciAodm5vZGUsIHRvZ2dsZURpc3BsYXkpIHtcbiAgdmFyIGVsID0gdm5vZGUuZWxtO1xuXG4gIC8vIGNhbGwgbGVhdmUgY2FsbGJhY2sgbm93XG4gIGlmIChpc0RlZihlbC5fbGVhdmVDYikpIHtcbiAgICBlbC5fbGVhdmVDYi5jYW5jZWxsZWQgPSB0cnVlO1xuICAgIGVsLl9sZWF2ZUNiKCk7XG4gIH1cblxuICB2YXIgZGF0YSA9IHJlc29sdmVUcmFuc2l0aW9uKHZub2RlLmRhdGEudHJhbnNpdGlvbik7XG4gIGlmIChpc1VuZGVmKGRhdGEpKSB7XG4gICAgcmV0dXJuXG4gIH1cblxuICAvKiBpc3RhbmJ1bCBpZ25vcmUgaWYgKi9cbiAgaWYgKGlzRGVmKGVsLl9lbnRlckNiKSB8fCBlbC5ub2RlVHlwZSAhPT0gMSkge1xuICAgIHJldHVyblxuICB9XG5cbiAgdmFyIGNzcyA9IGRhdGEuY3NzO1xuICB2YXIgdHlwZSA9IGRhdGEudHlwZTtcbiAgdmFyIGVudGVyQ2xhc3MgPSBkYXRhLmVudGVyQ2xhc3M7XG4gIHZhciBlbnRlclRvQ2xhc3MgPSBkYXRhLmVudGVyVG9DbGFzcztcbiAgdmFyIGVudGVyQWN0aXZlQ2xhc3MgPSBkYXRhLmVudGVyQWN0aXZlQ2xhc3M7XG4gIHZhciBhcHBlYXJDbGFzcyA9IGRhdGEuYXBwZWFyQ2xhc3M7XG4gIHZhciBhcHBlYXJUb0NsYXNzID0gZGF0YS5hcHBlYXJUb0NsYXNzO1xuICB2YXIgYXBwZWFyQWN0aXZlQ2xhc3MgPSBkYXRhLmFwcGVhckFjdGl2ZUNsYXNzO1xuICB2YXIgYmVmb3JlRW50ZXIgPSBkYXRhLmJlZm9yZUVudGVyO1xuICB2YXIgZW50ZXIgPSBkYXRhLmVudGVyO1xuICB2YXIgYWZ0ZXJFbnRlciA9IGRhdGEuYWZ0ZXJFbnRlcjtcbiAgdmFyIGVudGVyQ2FuY2VsbGVkID0gZGF0YS5lbnRlckNhbmNlbGxlZDtcbiAgdmFyIGJlZm9yZUFwcGVhciA9IGRhdGEuYmVmb3JlQXBwZWFyO1xuICB2YXIgYXBwZWFyID0gZGF0YS5hcHBlYXI7XG4gIHZhciBhZnRlckFwcGVhciA9IGRhdGEuYWZ0ZXJBcHBlYXI7XG4gIHZhciBhcHBlYXJDYW5jZWxsZWQgPSBkYXRhLmFwcGVhckNhbmNlbGxlZDtcbiAgdmFyIGR1cmF0aW9uID0gZGF0YS5kdXJhdGlvbjtcblxuICAvLyBhY3RpdmVJbnN0YW5jZSB3aWxsIGFsd2F5cyBiZSB0aGUgPHRyYW5zaXRpb24+IGNvbXBvbmVudCBtYW5hZ2luZyB0aGlzXG4gIC8vIHRyYW5zaXRpb24uIE9uZSBlZGdlIGNhc2UgdG8gY2hlY2sgaXMgd2hlbiB0aGUgPHRyYW5zaXRpb24+IGlzIHBsYWNlZFxuICAvLyBhcyB0aGUgcm9vdCBub2RlIG9mIGEgY2hpbGQgY29tcG9uZW50LiBJbiB0aGF0IGNhc2Ugd2UgbmVlZCB0byBjaGVja1xuICAvLyA8dHJhbnNpdGlvbj4ncyBwYXJlbnQgZm9yIGFwcGVhciBjaGVjay5cbiAgdmFyIGNvbnRleHQgPSBhY3RpdmVJbnN0YW5jZTtcbiAgdmFyIHRyYW5zaXRpb25Ob2RlID0gYWN0aXZlSW5zdGFuY2UuJHZub2RlO1xuICB3aGlsZSAodHJhbnNpdGlvbk5vZGUgJiYgdHJhbnNpdGlvbk5vZGUucGFyZW50KSB7XG4gICAgdHJhbnNpdGlvbk5vZGUgPSB0cmFuc2l0aW9uTm9kZS5wYXJlbnQ7XG4gICAgY29udGV4dCA9IHRyYW5zaXRpb25Ob2RlLmNvbnRleHQ7XG4gIH1cblxuICB2YXIgaXNBcHBlYXIgPSAhY29udGV4dC5faXNNb3VudGVkIHx8ICF2bm9kZS5pc1Jvb3RJbnNlcnQ7XG5cbiAgaWYgKGlzQXBwZWFyICYmICFhcHBlYXIgJiYgYXBwZWFyICE9PSAnJykge1xuICAgIHJldHVyblxuICB9XG5cbiAgdmFyIHN0YXJ0Q2xhc3MgPSBpc0FwcGVhciAmJiBhcHBlYXJDbGFzc1xuICAgID8gYXBwZWFyQ2xhc3NcbiAgICA6IGVudGVyQ2xhc3M7XG4gIHZhciBhY3RpdmVDbGFzcyA9IGlzQXBwZWFyICYmIGFwcGVhckFjdGl2ZUNsYXNzXG4gICAgPyBhcHBlYXJBY3RpdmVDbGFzc1xuICAgIDogZW50ZXJBY3RpdmVDbGFzcztcbiAgdmFyIHRvQ2xhc3MgPSBpc0FwcGVhciAmJiBhcHBlYXJUb0NsYXNzXG4gICAgPyBhcHBlYXJUb0NsYXNzXG4gICAgOiBlbnRlclRvQ2xhc3M7XG5cbiAgdmFyIGJlZm9yZUVudGVySG9vayA9IGlzQXBwZWFyXG4gICAgPyAoYmVmb3JlQXBwZWFyIHx8IGJlZm9yZUVudGVyKVxuICAgIDogYmVmb3JlRW50ZXI7XG4gIHZhciBlbnRlckhvb2sgPSBpc0FwcGVhclxuICAgID8gKHR5cGVvZiBhcHBlYXIgPT09ICdmdW5jdGlvbicgPyBhcHBlYXIgOiBlbnRlcilcbiAgICA6IGVudGVyO1xuICB2YXIgYWZ0ZXJFbnRlckhvb2sgPSBpc0FwcGVhclxuICAgID8gKGFmdGVyQXBwZWFyIHx8IGFmdGVyRW50ZXIpXG4gICAgOiBhZnRlckVudGVyO1xuICB2YXIgZW50ZXJDYW5jZWxsZWRIb29rID0gaXNBcHBlYXJcbiAgICA/IChhcHBlYXJDYW5jZWxsZWQgfHwgZW50ZXJDYW5jZWxsZWQpXG4gICAgOiBlbnRlckNhbmNlbGxlZDtcblxuICB2YXIgZXhwbGljaXRFbnRlckR1cmF0aW9uID0gdG9OdW1iZXIoXG4gICAgaXNPYmplY3QoZHVyYXRpb24pXG4gICAgICA/IGR1cmF0aW9uLmVudGVyXG4gICAgICA6IGR1cmF0aW9uXG4gICk7XG5cbiAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiYgZXhwbGljaXRFbnRlckR1cmF0aW9uICE9IG51bGwpIHtcbiAgICBjaGVja0R1cmF0aW9uKGV4cGxpY2l0RW50ZXJEdXJhdGlvbiwgJ2VudGVyJywgdm5vZGUpO1xuICB9XG5cbiAgdmFyIGV4cGVjdHNDU1MgPSBjc3MgIT09IGZhbHNlICYmICFpc0lFOTtcbiAgdmFyIHVzZXJXYW50c0NvbnRyb2wgPSBnZXRIb29rQXJndW1lbnRzTGVuZ3RoKGVudGVySG9vayk7XG5cbiAgdmFyIGNiID0gZWwuX2VudGVyQ2IgPSBvbmNlKGZ1bmN0aW9uICgpIHtcbiAgICBpZiAoZXhwZWN0c0NTUykge1xuICAgICAgcmVtb3ZlVHJhbnNpdGlvbkNsYXNzKGVsLCB0b0NsYXNzKTtcbiAgICAgIHJlbW92ZVRyYW5zaXRpb25DbGFzcyhlbCwgYWN0aXZlQ2xhc3MpO1xuICAgIH1cbiAgICBpZiAoY2IuY2FuY2VsbGVkKSB7XG4gICAgICBpZiAoZXhwZWN0c0NTUykge1xuICAgICAgICByZW1vdmVUcmFuc2l0aW9uQ2xhc3MoZWwsIHN0YXJ0Q2xhc3MpO1xuICAgICAgfVxuICAgICAgZW50ZXJDYW5jZWxsZWRIb29rICYmIGVudGVyQ2FuY2VsbGVkSG9vayhlbCk7XG4gICAgfSBlbHNlIHtcbiAgICAgIGFmdGVyRW50ZXJIb29rICYmIGFmdGVyRW50ZXJIb29rKGVsKTtcbiAgICB9XG4gICAgZWwuX2VudGVyQ2IgPSBudWxsO1xuICB9KTtcblxuICBpZiAoIXZub2RlLmRhdGEuc2hvdykge1xuICAgIC8vIHJlbW92ZSBwZW5kaW5nIGxlYXZlIGVsZW1lbnQgb24gZW50ZXIgYnkgaW5qZWN0aW5nIGFuIGluc2VydCBob29rXG4gICAgbWVyZ2VWTm9kZUhvb2sodm5vZGUsICdpbnNlcnQnLCBmdW5jdGlvbiAoKSB7XG4gICAgICB2YXIgcGFyZW50ID0gZWwucGFyZW50Tm9kZTtcbiAgICAgIHZhciBwZW5kaW5nTm9kZSA9IHBhcmVudCAmJiBwYXJlbnQuX3BlbmRpbmcgJiYgcGFyZW50Ll9wZW5kaW5nW3Zub2RlLmtleV07XG4gICAgICBpZiAocGVuZGluZ05vZGUgJiZcbiAgICAgICAgcGVuZGluZ05vZGUudGFnID09PSB2bm9kZS50YWcgJiZcbiAgICAgICAgcGVuZGluZ05vZGUuZWxtLl9sZWF2ZUNiXG4gICAgICApIHtcbiAgICAgICAgcGVuZGluZ05vZGUuZWxtLl9sZWF2ZUNiKCk7XG4gICAgICB9XG4gICAgICBlbnRlckhvb2sgJiYgZW50ZXJIb29rKGVsLCBjYik7XG4gICAgfSk7XG4gIH1cblxuICAvLyBzdGFydCBlbnRlciB0cmFuc2l0aW9uXG4gIGJlZm9yZUVudGVySG9vayAmJiBiZWZvcmVFbnRlckhvb2soZWwpO1xuICBpZiAoZXhwZWN0c0NTUykge1xuICAgIGFkZFRyYW5zaXRpb25DbGFzcyhlbCwgc3RhcnRDbGFzcyk7XG4gICAgYWRkVHJhbnNpdGlvbkNsYXNzKGVsLCBhY3RpdmVDbGFzcyk7XG4gICAgbmV4dEZyYW1lKGZ1bmN0aW9uICgpIHtcbiAgICAgIHJlbW92ZVRyYW5zaXRpb25DbGFzcyhlbCwgc3RhcnRDbGFzcyk7XG4gICAgICBpZiAoIWNiLmNhbmNlbGxlZCkge1xuICAgICAgICBhZGRUcmFuc2l0aW9uQ2xhc3MoZWwsIHRvQ2xhc3MpO1xuICAgICAgICBpZiAoIXVzZXJXYW50c0NvbnRyb2wpIHtcbiAgICAgICAgICBpZiAoaXNWYWxpZER1cmF0aW9uKGV4cGxpY2l0RW50ZXJEdXJhdGlvbikpIHtcbiAgICAgICAgICAgIHNldFRpbWVvdXQoY2IsIGV4cGxpY2l0RW50ZXJEdXJhdGlvbik7XG4gICAgICAgICAgfSBlbHNlIHtcbiAgICAgICAgICAgIHdoZW5UcmFuc2l0aW9uRW5kcyhlbCwgdHlwZSwgY2IpO1xuICAgICAgICAgIH1cbiAgICAgICAgfVxuICAgICAgfVxuICAgIH0pO1xuICB9XG5cbiAgaWYgKHZub2RlLmRhdGEuc2hvdykge1xuICAgIHRvZ2dsZURpc3BsYXkgJiYgdG9nZ2xlRGlzcGxheSgpO1xuICAgIGVudGVySG9vayAmJiBlbnRlckhvb2soZWwsIGNiKTtcbiAgfVxuXG4gIGlmICghZXhwZWN0c0NTUyAmJiAhdXNlcldhbnRzQ29udHJvbCkge1xuICAgIGNiKCk7XG4gIH1cbn1cblxuZnVuY3Rpb24gbGVhdmUgKHZub2RlLCBybSkge1xuICB2YXIgZWwgPSB2bm9kZS5lbG07XG5cbiAgLy8gY2FsbCBlbnRlciBjYWxsYmFjayBub3dcbiAgaWYgKGlzRGVmKGVsLl9lbnRlckNiKSkge1xuICAgIGVsLl9lbnRlckNiLmNhbmNlbGxlZCA9IHRydWU7XG4gICAgZWwuX2VudGVyQ2IoKTtcbiAgfVxuXG4gIHZhciBkYXRhID0gcmVzb2x2ZVRyYW5zaXRpb24odm5vZGUuZGF0YS50cmFuc2l0aW9uKTtcbiAgaWYgKGlzVW5kZWYoZGF0YSkgfHwgZWwubm9kZVR5cGUgIT09IDEpIHtcbiAgICByZXR1cm4gcm0oKVxuICB9XG5cbiAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gIGlmIChpc0RlZihlbC5fbGVhdmVDYikpIHtcbiAgICByZXR1cm5cbiAgfVxuXG4gIHZhciBjc3MgPSBkYXRhLmNzcztcbiAgdmFyIHR5cGUgPSBkYXRhLnR5cGU7XG4gIHZhciBsZWF2ZUNsYXNzID0gZGF0YS5sZWF2ZUNsYXNzO1xuICB2YXIgbGVhdmVUb0NsYXNzID0gZGF0YS5sZWF2ZVRvQ2xhc3M7XG4gIHZhciBsZWF2ZUFjdGl2ZUNsYXNzID0gZGF0YS5sZWF2ZUFjdGl2ZUNsYXNzO1xuICB2YXIgYmVmb3JlTGVhdmUgPSBkYXRhLmJlZm9yZUxlYXZlO1xuICB2YXIgbGVhdmUgPSBkYXRhLmxlYXZlO1xuICB2YXIgYWZ0ZXJMZWF2ZSA9IGRhdGEuYWZ0ZXJMZWF2ZTtcbiAgdmFyIGxlYXZlQ2FuY2VsbGVkID0gZGF0YS5sZWF2ZUNhbmNlbGxlZDtcbiAgdmFyIGRlbGF5TGVhdmUgPSBkYXRhLmRlbGF5TGVhdmU7XG4gIHZhciBkdXJhdGlvbiA9IGRhdGEuZHVyYXRpb247XG5cbiAgdmFyIGV4cGVjdHNDU1MgPSBjc3MgIT09IGZhbHNlICYmICFpc0lFOTtcbiAgdmFyIHVzZXJXYW50c0NvbnRyb2wgPSBnZXRIb29rQXJndW1lbnRzTGVuZ3RoKGxlYXZlKTtcblxuICB2YXIgZXhwbGljaXRMZWF2ZUR1cmF0aW9uID0gdG9OdW1iZXIoXG4gICAgaXNPYmplY3QoZHVyYXRpb24pXG4gICAgICA/IGR1cmF0aW9uLmxlYXZlXG4gICAgICA6IGR1cmF0aW9uXG4gICk7XG5cbiAgaWYgKHByb2Nlc3MuZW52Lk5PREVfRU5WICE9PSAncHJvZHVjdGlvbicgJiYgaXNEZWYoZXhwbGljaXRMZWF2ZUR1cmF0aW9uKSkge1xuICAgIGNoZWNrRHVyYXRpb24oZXhwbGljaXRMZWF2ZUR1cmF0aW9uLCAnbGVhdmUnLCB2bm9kZSk7XG4gIH1cblxuICB2YXIgY2IgPSBlbC5fbGVhdmVDYiA9IG9uY2UoZnVuY3Rpb24gKCkge1xuICAgIGlmIChlbC5wYXJlbnROb2RlICYmIGVsLnBhcmVudE5vZGUuX3BlbmRpbmcpIHtcbiAgICAgIGVsLnBhcmVudE5vZGUuX3BlbmRpbmdbdm5vZGUua2V5XSA9IG51bGw7XG4gICAgfVxuICAgIGlmIChleHBlY3RzQ1NTKSB7XG4gICAgICByZW1vdmVUcmFuc2l0aW9uQ2xhc3MoZWwsIGxlYXZlVG9DbGFzcyk7XG4gICAgICByZW1vdmVUcmFuc2l0aW9uQ2xhc3MoZWwsIGxlYXZlQWN0aXZlQ2xhc3MpO1xuICAgIH1cbiAgICBpZiAoY2IuY2FuY2VsbGVkKSB7XG4gICAgICBpZiAoZXhwZWN0c0NTUykge1xuICAgICAgICByZW1vdmVUcmFuc2l0aW9uQ2xhc3MoZWwsIGxlYXZlQ2xhc3MpO1xuICAgICAgfVxuICAgICAgbGVhdmVDYW5jZWxsZWQgJiYgbGVhdmVDYW5jZWxsZWQoZWwpO1xuICAgIH0gZWxzZSB7XG4gICAgICBybSgpO1xuICAgICAgYWZ0ZXJMZWF2ZSAmJiBhZnRlckxlYXZlKGVsKTtcbiAgICB9XG4gICAgZWwuX2xlYXZlQ2IgPSBudWxsO1xuICB9KTtcblxuICBpZiAoZGVsYXlMZWF2ZSkge1xuICAgIGRlbGF5TGVhdmUocGVyZm9ybUxlYXZlKTtcbiAgfSBlbHNlIHtcbiAgICBwZXJmb3JtTGVhdmUoKTtcbiAgfVxuXG4gIGZ1bmN0aW9uIHBlcmZvcm1MZWF2ZSAoKSB7XG4gICAgLy8gdGhlIGRlbGF5ZWQgbGVhdmUgbWF5IGhhdmUgYWxyZWFkeSBiZWVuIGNhbmNlbGxlZFxuICAgIGlmIChjYi5jYW5jZWxsZWQpIHtcbiAgICAgIHJldHVyblxuICAgIH1cbiAgICAvLyByZWNvcmQgbGVhdmluZyBlbGVtZW50XG4gICAgaWYgKCF2bm9kZS5kYXRhLnNob3cpIHtcbiAgICAgIChlbC5wYXJlbnROb2RlLl9wZW5kaW5nIHx8IChlbC5wYXJlbnROb2RlLl9wZW5kaW5nID0ge30pKVsodm5vZGUua2V5KV0gPSB2bm9kZTtcbiAgICB9XG4gICAgYmVmb3JlTGVhdmUgJiYgYmVmb3JlTGVhdmUoZWwpO1xuICAgIGlmIChleHBlY3RzQ1NTKSB7XG4gICAgICBhZGRUcmFuc2l0aW9uQ2xhc3MoZWwsIGxlYXZlQ2xhc3MpO1xuICAgICAgYWRkVHJhbnNpdGlvbkNsYXNzKGVsLCBsZWF2ZUFjdGl2ZUNsYXNzKTtcbiAgICAgIG5leHRGcmFtZShmdW5jdGlvbiAoKSB7XG4gICAgICAgIHJlbW92ZVRyYW5zaXRpb25DbGFzcyhlbCwgbGVhdmVDbGFzcyk7XG4gICAgICAgIGlmICghY2IuY2FuY2VsbGVkKSB7XG4gICAgICAgICAgYWRkVHJhbnNpdGlvbkNsYXNzKGVsLCBsZWF2ZVRvQ2xhc3MpO1xuICAgICAgICAgIGlmICghdXNlcldhbnRzQ29udHJvbCkge1xuICAgICAgICAgICAgaWYgKGlzVmFsaWREdXJhdGlvbihleHBsaWNpdExlYXZlRHVyYXRpb24pKSB7XG4gICAgICAgICAgICAgIHNldFRpbWVvdXQoY2IsIGV4cGxpY2l0TGVhdmVEdXJhdGlvbik7XG4gICAgICAgICAgICB9IGVsc2Uge1xuICAgICAgICAgICAgICB3aGVuVHJhbnNpdGlvbkVuZHMoZWwsIHR5cGUsIGNiKTtcbiAgICAgICAgICAgIH1cbiAgICAgICAgICB9XG4gICAgICAgIH1cbiAgICAgIH0pO1xuICAgIH1cbiAgICBsZWF2ZSAmJiBsZWF2ZShlbCwgY2IpO1xuICAgIGlmICghZXhwZWN0c0NTUyAmJiAhdXNlcldhbnRzQ29udHJvbCkge1xuICAgICAgY2IoKTtcbiAgICB9XG4gIH1cbn1cblxuLy8gb25seSB1c2VkIGluIGRldiBtb2RlXG5mdW5jdGlvbiBjaGVja0R1cmF0aW9uICh2YWwsIG5hbWUsIHZub2RlKSB7XG4gIGlmICh0eXBlb2YgdmFsICE9PSAnbnVtYmVyJykge1xuICAgIHdhcm4oXG4gICAgICBcIjx0cmFuc2l0aW9uPiBleHBsaWNpdCBcIiArIG5hbWUgKyBcIiBkdXJhdGlvbiBpcyBub3QgYSB2YWxpZCBudW1iZXIgLSBcIiArXG4gICAgICBcImdvdCBcIiArIChKU09OLnN0cmluZ2lmeSh2YWwpKSArIFwiLlwiLFxuICAgICAgdm5vZGUuY29udGV4dFxuICAgICk7XG4gIH0gZWxzZSBpZiAoaXNOYU4odmFsKSkge1xuICAgIHdhcm4oXG4gICAgICBcIjx0cmFuc2l0aW9uPiBleHBsaWNpdCBcIiArIG5hbWUgKyBcIiBkdXJhdGlvbiBpcyBOYU4gLSBcIiArXG4gICAgICAndGhlIGR1cmF0aW9uIGV4cHJlc3Npb24gbWlnaHQgYmUgaW5jb3JyZWN0LicsXG4gICAgICB2bm9kZS5jb250ZXh0XG4gICAgKTtcbiAgfVxufVxuXG5mdW5jdGlvbiBpc1ZhbGlkRHVyYXRpb24gKHZhbCkge1xuICByZXR1cm4gdHlwZW9mIHZhbCA9PT0gJ251bWJlcicgJiYgIWlzTmFOKHZhbClcbn1cblxuLyoqXG4gKiBOb3JtYWxpemUgYSB0cmFuc2l0aW9uIGhvb2sncyBhcmd1bWVudCBsZW5ndGguIFRoZSBob29rIG1heSBiZTpcbiAqIC0gYSBtZXJnZWQgaG9vayAoaW52b2tlcikgd2l0aCB0aGUgb3JpZ2luYWwgaW4gLmZuc1xuICogLSBhIHdyYXBwZWQgY29tcG9uZW50IG1ldGhvZCAoY2hlY2sgLl9sZW5ndGgpXG4gKiAtIGEgcGxhaW4gZnVuY3Rpb24gKC5sZW5ndGgpXG4gKi9cbmZ1bmN0aW9uIGdldEhvb2tBcmd1bWVudHNMZW5ndGggKGZuKSB7XG4gIGlmIChpc1VuZGVmKGZuKSkge1xuICAgIHJldHVybiBmYWxzZVxuICB9XG4gIHZhciBpbnZva2VyRm5zID0gZm4uZm5zO1xuICBpZiAoaXNEZWYoaW52b2tlckZucykpIHtcbiAgICAvLyBpbnZva2VyXG4gICAgcmV0dXJuIGdldEhvb2tBcmd1bWVudHNMZW5ndGgoXG4gICAgICBBcnJheS5pc0FycmF5KGludm9rZXJGbnMpXG4gICAgICAgID8gaW52b2tlckZuc1swXVxuICAgICAgICA6IGludm9rZXJGbnNcbiAgICApXG4gIH0gZWxzZSB7XG4gICAgcmV0dXJuIChmbi5fbGVuZ3RoIHx8IGZuLmxlbmd0aCkgPiAxXG4gIH1cbn1cblxuZnVuY3Rpb24gX2VudGVyIChfLCB2bm9kZSkge1xuICBpZiAodm5vZGUuZGF0YS5zaG93ICE9PSB0cnVlKSB7XG4gICAgZW50ZXIodm5vZGUpO1xuICB9XG59XG5cbnZhciB0cmFuc2l0aW9uID0gaW5Ccm93c2VyID8ge1xuICBjcmVhdGU6IF9lbnRlcixcbiAgYWN0aXZhdGU6IF9lbnRlcixcbiAgcmVtb3ZlOiBmdW5jdGlvbiByZW1vdmUkJDEgKHZub2RlLCBybSkge1xuICAgIC8qIGlzdGFuYnVsIGlnbm9yZSBlbHNlICovXG4gICAgaWYgKHZub2RlLmRhdGEuc2hvdyAhPT0gdHJ1ZSkge1xuICAgICAgbGVhdmUodm5vZGUsIHJtKTtcbiAgICB9IGVsc2Uge1xuICAgICAgcm0oKTtcbiAgICB9XG4gIH1cbn0gOiB7fVxuXG52YXIgcGxhdGZvcm1Nb2R1bGVzID0gW1xuICBhdHRycyxcbiAga2xhc3MsXG4gIGV2ZW50cyxcbiAgZG9tUHJvcHMsXG4gIHN0eWxlLFxuICB0cmFuc2l0aW9uXG5dXG5cbi8qICAqL1xuXG4vLyB0aGUgZGlyZWN0aXZlIG1vZHVsZSBzaG91bGQgYmUgYXBwbGllZCBsYXN0LCBhZnRlciBhbGxcbi8vIGJ1aWx0LWluIG1vZHVsZXMgaGF2ZSBiZWVuIGFwcGxpZWQuXG52YXIgbW9kdWxlcyA9IHBsYXRmb3JtTW9kdWxlcy5jb25jYXQoYmFzZU1vZHVsZXMpO1xuXG52YXIgcGF0Y2ggPSBjcmVhdGVQYXRjaEZ1bmN0aW9uKHsgbm9kZU9wczogbm9kZU9wcywgbW9kdWxlczogbW9kdWxlcyB9KTtcblxuLyoqXG4gKiBOb3QgdHlwZSBjaGVja2luZyB0aGlzIGZpbGUgYmVjYXVzZSBmbG93IGRvZXNuJ3QgbGlrZSBhdHRhY2hpbmdcbiAqIHByb3BlcnRpZXMgdG8gRWxlbWVudHMuXG4gKi9cblxuLyogaXN0YW5idWwgaWdub3JlIGlmICovXG5pZiAoaXNJRTkpIHtcbiAgLy8gaHR0cDovL3d3dy5tYXR0czQxMS5jb20vcG9zdC9pbnRlcm5ldC1leHBsb3Jlci05LW9uaW5wdXQvXG4gIGRvY3VtZW50LmFkZEV2ZW50TGlzdGVuZXIoJ3NlbGVjdGlvbmNoYW5nZScsIGZ1bmN0aW9uICgpIHtcbiAgICB2YXIgZWwgPSBkb2N1bWVudC5hY3RpdmVFbGVtZW50O1xuICAgIGlmIChlbCAmJiBlbC52bW9kZWwpIHtcbiAgICAgIHRyaWdnZXIoZWwsICdpbnB1dCcpO1xuICAgIH1cbiAgfSk7XG59XG5cbnZhciBkaXJlY3RpdmUgPSB7XG4gIGluc2VydGVkOiBmdW5jdGlvbiBpbnNlcnRlZCAoZWwsIGJpbmRpbmcsIHZub2RlLCBvbGRWbm9kZSkge1xuICAgIGlmICh2bm9kZS50YWcgPT09ICdzZWxlY3QnKSB7XG4gICAgICAvLyAjNjkwM1xuICAgICAgaWYgKG9sZFZub2RlLmVsbSAmJiAhb2xkVm5vZGUuZWxtLl92T3B0aW9ucykge1xuICAgICAgICBtZXJnZVZOb2RlSG9vayh2bm9kZSwgJ3Bvc3RwYXRjaCcsIGZ1bmN0aW9uICgpIHtcbiAgICAgICAgICBkaXJlY3RpdmUuY29tcG9uZW50VXBkYXRlZChlbCwgYmluZGluZywgdm5vZGUpO1xuICAgICAgICB9KTtcbiAgICAgIH0gZWxzZSB7XG4gICAgICAgIHNldFNlbGVjdGVkKGVsLCBiaW5kaW5nLCB2bm9kZS5jb250ZXh0KTtcbiAgICAgIH1cbiAgICAgIGVsLl92T3B0aW9ucyA9IFtdLm1hcC5jYWxsKGVsLm9wdGlvbnMsIGdldFZhbHVlKTtcbiAgICB9IGVsc2UgaWYgKHZub2RlLnRhZyA9PT0gJ3RleHRhcmVhJyB8fCBpc1RleHRJbnB1dFR5cGUoZWwudHlwZSkpIHtcbiAgICAgIGVsLl92TW9kaWZpZXJzID0gYmluZGluZy5tb2RpZmllcnM7XG4gICAgICBpZiAoIWJpbmRpbmcubW9kaWZpZXJzLmxhenkpIHtcbiAgICAgICAgZWwuYWRkRXZlbnRMaXN0ZW5lcignY29tcG9zaXRpb25zdGFydCcsIG9uQ29tcG9zaXRpb25TdGFydCk7XG4gICAgICAgIGVsLmFkZEV2ZW50TGlzdGVuZXIoJ2NvbXBvc2l0aW9uZW5kJywgb25Db21wb3NpdGlvbkVuZCk7XG4gICAgICAgIC8vIFNhZmFyaSA8IDEwLjIgJiBVSVdlYlZpZXcgZG9lc24ndCBmaXJlIGNvbXBvc2l0aW9uZW5kIHdoZW5cbiAgICAgICAgLy8gc3dpdGNoaW5nIGZvY3VzIGJlZm9yZSBjb25maXJtaW5nIGNvbXBvc2l0aW9uIGNob2ljZVxuICAgICAgICAvLyB0aGlzIGFsc28gZml4ZXMgdGhlIGlzc3VlIHdoZXJlIHNvbWUgYnJvd3NlcnMgZS5nLiBpT1MgQ2hyb21lXG4gICAgICAgIC8vIGZpcmVzIFwiY2hhbmdlXCIgaW5zdGVhZCBvZiBcImlucHV0XCIgb24gYXV0b2NvbXBsZXRlLlxuICAgICAgICBlbC5hZGRFdmVudExpc3RlbmVyKCdjaGFuZ2UnLCBvbkNvbXBvc2l0aW9uRW5kKTtcbiAgICAgICAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gICAgICAgIGlmIChpc0lFOSkge1xuICAgICAgICAgIGVsLnZtb2RlbCA9IHRydWU7XG4gICAgICAgIH1cbiAgICAgIH1cbiAgICB9XG4gIH0sXG5cbiAgY29tcG9uZW50VXBkYXRlZDogZnVuY3Rpb24gY29tcG9uZW50VXBkYXRlZCAoZWwsIGJpbmRpbmcsIHZub2RlKSB7XG4gICAgaWYgKHZub2RlLnRhZyA9PT0gJ3NlbGVjdCcpIHtcbiAgICAgIHNldFNlbGVjdGVkKGVsLCBiaW5kaW5nLCB2bm9kZS5jb250ZXh0KTtcbiAgICAgIC8vIGluIGNhc2UgdGhlIG9wdGlvbnMgcmVuZGVyZWQgYnkgdi1mb3IgaGF2ZSBjaGFuZ2VkLFxuICAgICAgLy8gaXQncyBwb3NzaWJsZSB0aGF0IHRoZSB2YWx1ZSBpcyBvdXQtb2Ytc3luYyB3aXRoIHRoZSByZW5kZXJlZCBvcHRpb25zLlxuICAgICAgLy8gZGV0ZWN0IHN1Y2ggY2FzZXMgYW5kIGZpbHRlciBvdXQgdmFsdWVzIHRoYXQgbm8gbG9uZ2VyIGhhcyBhIG1hdGNoaW5nXG4gICAgICAvLyBvcHRpb24gaW4gdGhlIERPTS5cbiAgICAgIHZhciBwcmV2T3B0aW9ucyA9IGVsLl92T3B0aW9ucztcbiAgICAgIHZhciBjdXJPcHRpb25zID0gZWwuX3ZPcHRpb25zID0gW10ubWFwLmNhbGwoZWwub3B0aW9ucywgZ2V0VmFsdWUpO1xuICAgICAgaWYgKGN1ck9wdGlvbnMuc29tZShmdW5jdGlvbiAobywgaSkgeyByZXR1cm4gIWxvb3NlRXF1YWwobywgcHJldk9wdGlvbnNbaV0pOyB9KSkge1xuICAgICAgICAvLyB0cmlnZ2VyIGNoYW5nZSBldmVudCBpZlxuICAgICAgICAvLyBubyBtYXRjaGluZyBvcHRpb24gZm91bmQgZm9yIGF0IGxlYXN0IG9uZSB2YWx1ZVxuICAgICAgICB2YXIgbmVlZFJlc2V0ID0gZWwubXVsdGlwbGVcbiAgICAgICAgICA/IGJpbmRpbmcudmFsdWUuc29tZShmdW5jdGlvbiAodikgeyByZXR1cm4gaGFzTm9NYXRjaGluZ09wdGlvbih2LCBjdXJPcHRpb25zKTsgfSlcbiAgICAgICAgICA6IGJpbmRpbmcudmFsdWUgIT09IGJpbmRpbmcub2xkVmFsdWUgJiYgaGFzTm9NYXRjaGluZ09wdGlvbihiaW5kaW5nLnZhbHVlLCBjdXJPcHRpb25zKTtcbiAgICAgICAgaWYgKG5lZWRSZXNldCkge1xuICAgICAgICAgIHRyaWdnZXIoZWwsICdjaGFuZ2UnKTtcbiAgICAgICAgfVxuICAgICAgfVxuICAgIH1cbiAgfVxufTtcblxuZnVuY3Rpb24gc2V0U2VsZWN0ZWQgKGVsLCBiaW5kaW5nLCB2bSkge1xuICBhY3R1YWxseVNldFNlbGVjdGVkKGVsLCBiaW5kaW5nLCB2bSk7XG4gIC8qIGlzdGFuYnVsIGlnbm9yZSBpZiAqL1xuICBpZiAoaXNJRSB8fCBpc0VkZ2UpIHtcbiAgICBzZXRUaW1lb3V0KGZ1bmN0aW9uICgpIHtcbiAgICAgIGFjdHVhbGx5U2V0U2VsZWN0ZWQoZWwsIGJpbmRpbmcsIHZtKTtcbiAgICB9LCAwKTtcbiAgfVxufVxuXG5mdW5jdGlvbiBhY3R1YWxseVNldFNlbGVjdGVkIChlbCwgYmluZGluZywgdm0pIHtcbiAgdmFyIHZhbHVlID0gYmluZGluZy52YWx1ZTtcbiAgdmFyIGlzTXVsdGlwbGUgPSBlbC5tdWx0aXBsZTtcbiAgaWYgKGlzTXVsdGlwbGUgJiYgIUFycmF5LmlzQXJyYXkodmFsdWUpKSB7XG4gICAgcHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJiB3YXJuKFxuICAgICAgXCI8c2VsZWN0IG11bHRpcGxlIHYtbW9kZWw9XFxcIlwiICsgKGJpbmRpbmcuZXhwcmVzc2lvbikgKyBcIlxcXCI+IFwiICtcbiAgICAgIFwiZXhwZWN0cyBhbiBBcnJheSB2YWx1ZSBmb3IgaXRzIGJpbmRpbmcsIGJ1dCBnb3QgXCIgKyAoT2JqZWN0LnByb3RvdHlwZS50b1N0cmluZy5jYWxsKHZhbHVlKS5zbGljZSg4LCAtMSkpLFxuICAgICAgdm1cbiAgICApO1xuICAgIHJldHVyblxuICB9XG4gIHZhciBzZWxlY3RlZCwgb3B0aW9uO1xuICBmb3IgKHZhciBpID0gMCwgbCA9IGVsLm9wdGlvbnMubGVuZ3RoOyBpIDwgbDsgaSsrKSB7XG4gICAgb3B0aW9uID0gZWwub3B0aW9uc1tpXTtcbiAgICBpZiAoaXNNdWx0aXBsZSkge1xuICAgICAgc2VsZWN0ZWQgPSBsb29zZUluZGV4T2YodmFsdWUsIGdldFZhbHVlKG9wdGlvbikpID4gLTE7XG4gICAgICBpZiAob3B0aW9uLnNlbGVjdGVkICE9PSBzZWxlY3RlZCkge1xuICAgICAgICBvcHRpb24uc2VsZWN0ZWQgPSBzZWxlY3RlZDtcbiAgICAgIH1cbiAgICB9IGVsc2Uge1xuICAgICAgaWYgKGxvb3NlRXF1YWwoZ2V0VmFsdWUob3B0aW9uKSwgdmFsdWUpKSB7XG4gICAgICAgIGlmIChlbC5zZWxlY3RlZEluZGV4ICE9PSBpKSB7XG4gICAgICAgICAgZWwuc2VsZWN0ZWRJbmRleCA9IGk7XG4gICAgICAgIH1cbiAgICAgICAgcmV0dXJuXG4gICAgICB9XG4gICAgfVxuICB9XG4gIGlmICghaXNNdWx0aXBsZSkge1xuICAgIGVsLnNlbGVjdGVkSW5kZXggPSAtMTtcbiAgfVxufVxuXG5mdW5jdGlvbiBoYXNOb01hdGNoaW5nT3B0aW9uICh2YWx1ZSwgb3B0aW9ucykge1xuICByZXR1cm4gb3B0aW9ucy5ldmVyeShmdW5jdGlvbiAobykgeyByZXR1cm4gIWxvb3NlRXF1YWwobywgdmFsdWUpOyB9KVxufVxuXG5mdW5jdGlvbiBnZXRWYWx1ZSAob3B0aW9uKSB7XG4gIHJldHVybiAnX3ZhbHVlJyBpbiBvcHRpb25cbiAgICA/IG9wdGlvbi5fdmFsdWVcbiAgICA6IG9wdGlvbi52YWx1ZVxufVxuXG5mdW5jdGlvbiBvbkNvbXBvc2l0aW9uU3RhcnQgKGUpIHtcbiAgZS50YXJnZXQuY29tcG9zaW5nID0gdHJ1ZTtcbn1cblxuZnVuY3Rpb24gb25Db21wb3NpdGlvbkVuZCAoZSkge1xuICAvLyBwcmV2ZW50IHRyaWdnZXJpbmcgYW4gaW5wdXQgZXZlbnQgZm9yIG5vIHJlYXNvblxuICBpZiAoIWUudGFyZ2V0LmNvbXBvc2luZykgeyByZXR1cm4gfVxuICBlLnRhcmdldC5jb21wb3NpbmcgPSBmYWxzZTtcbiAgdHJpZ2dlcihlLnRhcmdldCwgJ2lucHV0Jyk7XG59XG5cbmZ1bmN0aW9uIHRyaWdnZXIgKGVsLCB0eXBlKSB7XG4gIHZhciBlID0gZG9jdW1lbnQuY3JlYXRlRXZlbnQoJ0hUTUxFdmVudHMnKTtcbiAgZS5pbml0RXZlbnQodHlwZSwgdHJ1ZSwgdHJ1ZSk7XG4gIGVsLmRpc3BhdGNoRXZlbnQoZSk7XG59XG5cbi8qICAqL1xuXG4vLyByZWN1cnNpdmVseSBzZWFyY2ggZm9yIHBvc3NpYmxlIHRyYW5zaXRpb24gZGVmaW5lZCBpbnNpZGUgdGhlIGNvbXBvbmVudCByb290XG5mdW5jdGlvbiBsb2NhdGVOb2RlICh2bm9kZSkge1xuICByZXR1cm4gdm5vZGUuY29tcG9uZW50SW5zdGFuY2UgJiYgKCF2bm9kZS5kYXRhIHx8ICF2bm9kZS5kYXRhLnRyYW5zaXRpb24pXG4gICAgPyBsb2NhdGVOb2RlKHZub2RlLmNvbXBvbmVudEluc3RhbmNlLl92bm9kZSlcbiAgICA6IHZub2RlXG59XG5cbnZhciBzaG93ID0ge1xuICBiaW5kOiBmdW5jdGlvbiBiaW5kIChlbCwgcmVmLCB2bm9kZSkge1xuICAgIHZhciB2YWx1ZSA9IHJlZi52YWx1ZTtcblxuICAgIHZub2RlID0gbG9jYXRlTm9kZSh2bm9kZSk7XG4gICAgdmFyIHRyYW5zaXRpb24kJDEgPSB2bm9kZS5kYXRhICYmIHZub2RlLmRhdGEudHJhbnNpdGlvbjtcbiAgICB2YXIgb3JpZ2luYWxEaXNwbGF5ID0gZWwuX192T3JpZ2luYWxEaXNwbGF5ID1cbiAgICAgIGVsLnN0eWxlLmRpc3BsYXkgPT09ICdub25lJyA/ICcnIDogZWwuc3R5bGUuZGlzcGxheTtcbiAgICBpZiAodmFsdWUgJiYgdHJhbnNpdGlvbiQkMSkge1xuICAgICAgdm5vZGUuZGF0YS5zaG93ID0gdHJ1ZTtcbiAgICAgIGVudGVyKHZub2RlLCBmdW5jdGlvbiAoKSB7XG4gICAgICAgIGVsLnN0eWxlLmRpc3BsYXkgPSBvcmlnaW5hbERpc3BsYXk7XG4gICAgICB9KTtcbiAgICB9IGVsc2Uge1xuICAgICAgZWwuc3R5bGUuZGlzcGxheSA9IHZhbHVlID8gb3JpZ2luYWxEaXNwbGF5IDogJ25vbmUnO1xuICAgIH1cbiAgfSxcblxuICB1cGRhdGU6IGZ1bmN0aW9uIHVwZGF0ZSAoZWwsIHJlZiwgdm5vZGUpIHtcbiAgICB2YXIgdmFsdWUgPSByZWYudmFsdWU7XG4gICAgdmFyIG9sZFZhbHVlID0gcmVmLm9sZFZhbHVlO1xuXG4gICAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gICAgaWYgKCF2YWx1ZSA9PT0gIW9sZFZhbHVlKSB7IHJldHVybiB9XG4gICAgdm5vZGUgPSBsb2NhdGVOb2RlKHZub2RlKTtcbiAgICB2YXIgdHJhbnNpdGlvbiQkMSA9IHZub2RlLmRhdGEgJiYgdm5vZGUuZGF0YS50cmFuc2l0aW9uO1xuICAgIGlmICh0cmFuc2l0aW9uJCQxKSB7XG4gICAgICB2bm9kZS5kYXRhLnNob3cgPSB0cnVlO1xuICAgICAgaWYgKHZhbHVlKSB7XG4gICAgICAgIGVudGVyKHZub2RlLCBmdW5jdGlvbiAoKSB7XG4gICAgICAgICAgZWwuc3R5bGUuZGlzcGxheSA9IGVsLl9fdk9yaWdpbmFsRGlzcGxheTtcbiAgICAgICAgfSk7XG4gICAgICB9IGVsc2Uge1xuICAgICAgICBsZWF2ZSh2bm9kZSwgZnVuY3Rpb24gKCkge1xuICAgICAgICAgIGVsLnN0eWxlLmRpc3BsYXkgPSAnbm9uZSc7XG4gICAgICAgIH0pO1xuICAgICAgfVxuICAgIH0gZWxzZSB7XG4gICAgICBlbC5zdHlsZS5kaXNwbGF5ID0gdmFsdWUgPyBlbC5fX3ZPcmlnaW5hbERpc3BsYXkgOiAnbm9uZSc7XG4gICAgfVxuICB9LFxuXG4gIHVuYmluZDogZnVuY3Rpb24gdW5iaW5kIChcbiAgICBlbCxcbiAgICBiaW5kaW5nLFxuICAgIHZub2RlLFxuICAgIG9sZFZub2RlLFxuICAgIGlzRGVzdHJveVxuICApIHtcbiAgICBpZiAoIWlzRGVzdHJveSkge1xuICAgICAgZWwuc3R5bGUuZGlzcGxheSA9IGVsLl9fdk9yaWdpbmFsRGlzcGxheTtcbiAgICB9XG4gIH1cbn1cblxudmFyIHBsYXRmb3JtRGlyZWN0aXZlcyA9IHtcbiAgbW9kZWw6IGRpcmVjdGl2ZSxcbiAgc2hvdzogc2hvd1xufVxuXG4vKiAgKi9cblxuLy8gUHJvdmlkZXMgdHJhbnNpdGlvbiBzdXBwb3J0IGZvciBhIHNpbmdsZSBlbGVtZW50L2NvbXBvbmVudC5cbi8vIHN1cHBvcnRzIHRyYW5zaXRpb24gbW9kZSAob3V0LWluIC8gaW4tb3V0KVxuXG52YXIgdHJhbnNpdGlvblByb3BzID0ge1xuICBuYW1lOiBTdHJpbmcsXG4gIGFwcGVhcjogQm9vbGVhbixcbiAgY3NzOiBCb29sZWFuLFxuICBtb2RlOiBTdHJpbmcsXG4gIHR5cGU6IFN0cmluZyxcbiAgZW50ZXJDbGFzczogU3RyaW5nLFxuICBsZWF2ZUNsYXNzOiBTdHJpbmcsXG4gIGVudGVyVG9DbGFzczogU3RyaW5nLFxuICBsZWF2ZVRvQ2xhc3M6IFN0cmluZyxcbiAgZW50ZXJBY3RpdmVDbGFzczogU3RyaW5nLFxuICBsZWF2ZUFjdGl2ZUNsYXNzOiBTdHJpbmcsXG4gIGFwcGVhckNsYXNzOiBTdHJpbmcsXG4gIGFwcGVhckFjdGl2ZUNsYXNzOiBTdHJpbmcsXG4gIGFwcGVhclRvQ2xhc3M6IFN0cmluZyxcbiAgZHVyYXRpb246IFtOdW1iZXIsIFN0cmluZywgT2JqZWN0XVxufTtcblxuLy8gaW4gY2FzZSB0aGUgY2hpbGQgaXMgYWxzbyBhbiBhYnN0cmFjdCBjb21wb25lbnQsIGUuZy4gPGtlZXAtYWxpdmU+XG4vLyB3ZSB3YW50IHRvIHJlY3Vyc2l2ZWx5IHJldHJpZXZlIHRoZSByZWFsIGNvbXBvbmVudCB0byBiZSByZW5kZXJlZFxuZnVuY3Rpb24gZ2V0UmVhbENoaWxkICh2bm9kZSkge1xuICB2YXIgY29tcE9wdGlvbnMgPSB2bm9kZSAmJiB2bm9kZS5jb21wb25lbnRPcHRpb25zO1xuICBpZiAoY29tcE9wdGlvbnMgJiYgY29tcE9wdGlvbnMuQ3Rvci5vcHRpb25zLmFic3RyYWN0KSB7XG4gICAgcmV0dXJuIGdldFJlYWxDaGlsZChnZXRGaXJzdENvbXBvbmVudENoaWxkKGNvbXBPcHRpb25zLmNoaWxkcmVuKSlcbiAgfSBlbHNlIHtcbiAgICByZXR1cm4gdm5vZGVcbiAgfVxufVxuXG5mdW5jdGlvbiBleHRyYWN0VHJhbnNpdGlvbkRhdGEgKGNvbXApIHtcbiAgdmFyIGRhdGEgPSB7fTtcbiAgdmFyIG9wdGlvbnMgPSBjb21wLiRvcHRpb25zO1xuICAvLyBwcm9wc1xuICBmb3IgKHZhciBrZXkgaW4gb3B0aW9ucy5wcm9wc0RhdGEpIHtcbiAgICBkYXRhW2tleV0gPSBjb21wW2tleV07XG4gIH1cbiAgLy8gZXZlbnRzLlxuICAvLyBleHRyYWN0IGxpc3RlbmVycyBhbmQgcGFzcyB0aGVtIGRpcmVjdGx5IHRvIHRoZSB0cmFuc2l0aW9uIG1ldGhvZHNcbiAgdmFyIGxpc3RlbmVycyA9IG9wdGlvbnMuX3BhcmVudExpc3RlbmVycztcbiAgZm9yICh2YXIga2V5JDEgaW4gbGlzdGVuZXJzKSB7XG4gICAgZGF0YVtjYW1lbGl6ZShrZXkkMSldID0gbGlzdGVuZXJzW2tleSQxXTtcbiAgfVxuICByZXR1cm4gZGF0YVxufVxuXG5mdW5jdGlvbiBwbGFjZWhvbGRlciAoaCwgcmF3Q2hpbGQpIHtcbiAgaWYgKC9cXGQta2VlcC1hbGl2ZSQvLnRlc3QocmF3Q2hpbGQudGFnKSkge1xuICAgIHJldHVybiBoKCdrZWVwLWFsaXZlJywge1xuICAgICAgcHJvcHM6IHJhd0NoaWxkLmNvbXBvbmVudE9wdGlvbnMucHJvcHNEYXRhXG4gICAgfSlcbiAgfVxufVxuXG5mdW5jdGlvbiBoYXNQYXJlbnRUcmFuc2l0aW9uICh2bm9kZSkge1xuICB3aGlsZSAoKHZub2RlID0gdm5vZGUucGFyZW50KSkge1xuICAgIGlmICh2bm9kZS5kYXRhLnRyYW5zaXRpb24pIHtcbiAgICAgIHJldHVybiB0cnVlXG4gICAgfVxuICB9XG59XG5cbmZ1bmN0aW9uIGlzU2FtZUNoaWxkIChjaGlsZCwgb2xkQ2hpbGQpIHtcbiAgcmV0dXJuIG9sZENoaWxkLmtleSA9PT0gY2hpbGQua2V5ICYmIG9sZENoaWxkLnRhZyA9PT0gY2hpbGQudGFnXG59XG5cbnZhciBUcmFuc2l0aW9uID0ge1xuICBuYW1lOiAndHJhbnNpdGlvbicsXG4gIHByb3BzOiB0cmFuc2l0aW9uUHJvcHMsXG4gIGFic3RyYWN0OiB0cnVlLFxuXG4gIHJlbmRlcjogZnVuY3Rpb24gcmVuZGVyIChoKSB7XG4gICAgdmFyIHRoaXMkMSA9IHRoaXM7XG5cbiAgICB2YXIgY2hpbGRyZW4gPSB0aGlzLiRzbG90cy5kZWZhdWx0O1xuICAgIGlmICghY2hpbGRyZW4pIHtcbiAgICAgIHJldHVyblxuICAgIH1cblxuICAgIC8vIGZpbHRlciBvdXQgdGV4dCBub2RlcyAocG9zc2libGUgd2hpdGVzcGFjZXMpXG4gICAgY2hpbGRyZW4gPSBjaGlsZHJlbi5maWx0ZXIoZnVuY3Rpb24gKGMpIHsgcmV0dXJuIGMudGFnIHx8IGlzQXN5bmNQbGFjZWhvbGRlcihjKTsgfSk7XG4gICAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gICAgaWYgKCFjaGlsZHJlbi5sZW5ndGgpIHtcbiAgICAgIHJldHVyblxuICAgIH1cblxuICAgIC8vIHdhcm4gbXVsdGlwbGUgZWxlbWVudHNcbiAgICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJiBjaGlsZHJlbi5sZW5ndGggPiAxKSB7XG4gICAgICB3YXJuKFxuICAgICAgICAnPHRyYW5zaXRpb24+IGNhbiBvbmx5IGJlIHVzZWQgb24gYSBzaW5nbGUgZWxlbWVudC4gVXNlICcgK1xuICAgICAgICAnPHRyYW5zaXRpb24tZ3JvdXA+IGZvciBsaXN0cy4nLFxuICAgICAgICB0aGlzLiRwYXJlbnRcbiAgICAgICk7XG4gICAgfVxuXG4gICAgdmFyIG1vZGUgPSB0aGlzLm1vZGU7XG5cbiAgICAvLyB3YXJuIGludmFsaWQgbW9kZVxuICAgIGlmIChwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Byb2R1Y3Rpb24nICYmXG4gICAgICBtb2RlICYmIG1vZGUgIT09ICdpbi1vdXQnICYmIG1vZGUgIT09ICdvdXQtaW4nXG4gICAgKSB7XG4gICAgICB3YXJuKFxuICAgICAgICAnaW52YWxpZCA8dHJhbnNpdGlvbj4gbW9kZTogJyArIG1vZGUsXG4gICAgICAgIHRoaXMuJHBhcmVudFxuICAgICAgKTtcbiAgICB9XG5cbiAgICB2YXIgcmF3Q2hpbGQgPSBjaGlsZHJlblswXTtcblxuICAgIC8vIGlmIHRoaXMgaXMgYSBjb21wb25lbnQgcm9vdCBub2RlIGFuZCB0aGUgY29tcG9uZW50J3NcbiAgICAvLyBwYXJlbnQgY29udGFpbmVyIG5vZGUgYWxzbyBoYXMgdHJhbnNpdGlvbiwgc2tpcC5cbiAgICBpZiAoaGFzUGFyZW50VHJhbnNpdGlvbih0aGlzLiR2bm9kZSkpIHtcbiAgICAgIHJldHVybiByYXdDaGlsZFxuICAgIH1cblxuICAgIC8vIGFwcGx5IHRyYW5zaXRpb24gZGF0YSB0byBjaGlsZFxuICAgIC8vIHVzZSBnZXRSZWFsQ2hpbGQoKSB0byBpZ25vcmUgYWJzdHJhY3QgY29tcG9uZW50cyBlLmcuIGtlZXAtYWxpdmVcbiAgICB2YXIgY2hpbGQgPSBnZXRSZWFsQ2hpbGQocmF3Q2hpbGQpO1xuICAgIC8qIGlzdGFuYnVsIGlnbm9yZSBpZiAqL1xuICAgIGlmICghY2hpbGQpIHtcbiAgICAgIHJldHVybiByYXdDaGlsZFxuICAgIH1cblxuICAgIGlmICh0aGlzLl9sZWF2aW5nKSB7XG4gICAgICByZXR1cm4gcGxhY2Vob2xkZXIoaCwgcmF3Q2hpbGQpXG4gICAgfVxuXG4gICAgLy8gZW5zdXJlIGEga2V5IHRoYXQgaXMgdW5pcXVlIHRvIHRoZSB2bm9kZSB0eXBlIGFuZCB0byB0aGlzIHRyYW5zaXRpb25cbiAgICAvLyBjb21wb25lbnQgaW5zdGFuY2UuIFRoaXMga2V5IHdpbGwgYmUgdXNlZCB0byByZW1vdmUgcGVuZGluZyBsZWF2aW5nIG5vZGVzXG4gICAgLy8gZHVyaW5nIGVudGVyaW5nLlxuICAgIHZhciBpZCA9IFwiX190cmFuc2l0aW9uLVwiICsgKHRoaXMuX3VpZCkgKyBcIi1cIjtcbiAgICBjaGlsZC5rZXkgPSBjaGlsZC5rZXkgPT0gbnVsbFxuICAgICAgPyBjaGlsZC5pc0NvbW1lbnRcbiAgICAgICAgPyBpZCArICdjb21tZW50J1xuICAgICAgICA6IGlkICsgY2hpbGQudGFnXG4gICAgICA6IGlzUHJpbWl0aXZlKGNoaWxkLmtleSlcbiAgICAgICAgPyAoU3RyaW5nKGNoaWxkLmtleSkuaW5kZXhPZihpZCkgPT09IDAgPyBjaGlsZC5rZXkgOiBpZCArIGNoaWxkLmtleSlcbiAgICAgICAgOiBjaGlsZC5rZXk7XG5cbiAgICB2YXIgZGF0YSA9IChjaGlsZC5kYXRhIHx8IChjaGlsZC5kYXRhID0ge30pKS50cmFuc2l0aW9uID0gZXh0cmFjdFRyYW5zaXRpb25EYXRhKHRoaXMpO1xuICAgIHZhciBvbGRSYXdDaGlsZCA9IHRoaXMuX3Zub2RlO1xuICAgIHZhciBvbGRDaGlsZCA9IGdldFJlYWxDaGlsZChvbGRSYXdDaGlsZCk7XG5cbiAgICAvLyBtYXJrIHYtc2hvd1xuICAgIC8vIHNvIHRoYXQgdGhlIHRyYW5zaXRpb24gbW9kdWxlIGNhbiBoYW5kIG92ZXIgdGhlIGNvbnRyb2wgdG8gdGhlIGRpcmVjdGl2ZVxuICAgIGlmIChjaGlsZC5kYXRhLmRpcmVjdGl2ZXMgJiYgY2hpbGQuZGF0YS5kaXJlY3RpdmVzLnNvbWUoZnVuY3Rpb24gKGQpIHsgcmV0dXJuIGQubmFtZSA9PT0gJ3Nob3cnOyB9KSkge1xuICAgICAgY2hpbGQuZGF0YS5zaG93ID0gdHJ1ZTtcbiAgICB9XG5cbiAgICBpZiAoXG4gICAgICBvbGRDaGlsZCAmJlxuICAgICAgb2xkQ2hpbGQuZGF0YSAmJlxuICAgICAgIWlzU2FtZUNoaWxkKGNoaWxkLCBvbGRDaGlsZCkgJiZcbiAgICAgICFpc0FzeW5jUGxhY2Vob2xkZXIob2xkQ2hpbGQpICYmXG4gICAgICAvLyAjNjY4NyBjb21wb25lbnQgcm9vdCBpcyBhIGNvbW1lbnQgbm9kZVxuICAgICAgIShvbGRDaGlsZC5jb21wb25lbnRJbnN0YW5jZSAmJiBvbGRDaGlsZC5jb21wb25lbnRJbnN0YW5jZS5fdm5vZGUuaXNDb21tZW50KVxuICAgICkge1xuICAgICAgLy8gcmVwbGFjZSBvbGQgY2hpbGQgdHJhbnNpdGlvbiBkYXRhIHdpdGggZnJlc2ggb25lXG4gICAgICAvLyBpbXBvcnRhbnQgZm9yIGR5bmFtaWMgdHJhbnNpdGlvbnMhXG4gICAgICB2YXIgb2xkRGF0YSA9IG9sZENoaWxkLmRhdGEudHJhbnNpdGlvbiA9IGV4dGVuZCh7fSwgZGF0YSk7XG4gICAgICAvLyBoYW5kbGUgdHJhbnNpdGlvbiBtb2RlXG4gICAgICBpZiAobW9kZSA9PT0gJ291dC1pbicpIHtcbiAgICAgICAgLy8gcmV0dXJuIHBsYWNlaG9sZGVyIG5vZGUgYW5kIHF1ZXVlIHVwZGF0ZSB3aGVuIGxlYXZlIGZpbmlzaGVzXG4gICAgICAgIHRoaXMuX2xlYXZpbmcgPSB0cnVlO1xuICAgICAgICBtZXJnZVZOb2RlSG9vayhvbGREYXRhLCAnYWZ0ZXJMZWF2ZScsIGZ1bmN0aW9uICgpIHtcbiAgICAgICAgICB0aGlzJDEuX2xlYXZpbmcgPSBmYWxzZTtcbiAgICAgICAgICB0aGlzJDEuJGZvcmNlVXBkYXRlKCk7XG4gICAgICAgIH0pO1xuICAgICAgICByZXR1cm4gcGxhY2Vob2xkZXIoaCwgcmF3Q2hpbGQpXG4gICAgICB9IGVsc2UgaWYgKG1vZGUgPT09ICdpbi1vdXQnKSB7XG4gICAgICAgIGlmIChpc0FzeW5jUGxhY2Vob2xkZXIoY2hpbGQpKSB7XG4gICAgICAgICAgcmV0dXJuIG9sZFJhd0NoaWxkXG4gICAgICAgIH1cbiAgICAgICAgdmFyIGRlbGF5ZWRMZWF2ZTtcbiAgICAgICAgdmFyIHBlcmZvcm1MZWF2ZSA9IGZ1bmN0aW9uICgpIHsgZGVsYXllZExlYXZlKCk7IH07XG4gICAgICAgIG1lcmdlVk5vZGVIb29rKGRhdGEsICdhZnRlckVudGVyJywgcGVyZm9ybUxlYXZlKTtcbiAgICAgICAgbWVyZ2VWTm9kZUhvb2soZGF0YSwgJ2VudGVyQ2FuY2VsbGVkJywgcGVyZm9ybUxlYXZlKTtcbiAgICAgICAgbWVyZ2VWTm9kZUhvb2sob2xkRGF0YSwgJ2RlbGF5TGVhdmUnLCBmdW5jdGlvbiAobGVhdmUpIHsgZGVsYXllZExlYXZlID0gbGVhdmU7IH0pO1xuICAgICAgfVxuICAgIH1cblxuICAgIHJldHVybiByYXdDaGlsZFxuICB9XG59XG5cbi8qICAqL1xuXG4vLyBQcm92aWRlcyB0cmFuc2l0aW9uIHN1cHBvcnQgZm9yIGxpc3QgaXRlbXMuXG4vLyBzdXBwb3J0cyBtb3ZlIHRyYW5zaXRpb25zIHVzaW5nIHRoZSBGTElQIHRlY2huaXF1ZS5cblxuLy8gQmVjYXVzZSB0aGUgdmRvbSdzIGNoaWxkcmVuIHVwZGF0ZSBhbGdvcml0aG0gaXMgXCJ1bnN0YWJsZVwiIC0gaS5lLlxuLy8gaXQgZG9lc24ndCBndWFyYW50ZWUgdGhlIHJlbGF0aXZlIHBvc2l0aW9uaW5nIG9mIHJlbW92ZWQgZWxlbWVudHMsXG4vLyB3ZSBmb3JjZSB0cmFuc2l0aW9uLWdyb3VwIHRvIHVwZGF0ZSBpdHMgY2hpbGRyZW4gaW50byB0d28gcGFzc2VzOlxuLy8gaW4gdGhlIGZpcnN0IHBhc3MsIHdlIHJlbW92ZSBhbGwgbm9kZXMgdGhhdCBuZWVkIHRvIGJlIHJlbW92ZWQsXG4vLyB0cmlnZ2VyaW5nIHRoZWlyIGxlYXZpbmcgdHJhbnNpdGlvbjsgaW4gdGhlIHNlY29uZCBwYXNzLCB3ZSBpbnNlcnQvbW92ZVxuLy8gaW50byB0aGUgZmluYWwgZGVzaXJlZCBzdGF0ZS4gVGhpcyB3YXkgaW4gdGhlIHNlY29uZCBwYXNzIHJlbW92ZWRcbi8vIG5vZGVzIHdpbGwgcmVtYWluIHdoZXJlIHRoZXkgc2hvdWxkIGJlLlxuXG52YXIgcHJvcHMgPSBleHRlbmQoe1xuICB0YWc6IFN0cmluZyxcbiAgbW92ZUNsYXNzOiBTdHJpbmdcbn0sIHRyYW5zaXRpb25Qcm9wcyk7XG5cbmRlbGV0ZSBwcm9wcy5tb2RlO1xuXG52YXIgVHJhbnNpdGlvbkdyb3VwID0ge1xuICBwcm9wczogcHJvcHMsXG5cbiAgcmVuZGVyOiBmdW5jdGlvbiByZW5kZXIgKGgpIHtcbiAgICB2YXIgdGFnID0gdGhpcy50YWcgfHwgdGhpcy4kdm5vZGUuZGF0YS50YWcgfHwgJ3NwYW4nO1xuICAgIHZhciBtYXAgPSBPYmplY3QuY3JlYXRlKG51bGwpO1xuICAgIHZhciBwcmV2Q2hpbGRyZW4gPSB0aGlzLnByZXZDaGlsZHJlbiA9IHRoaXMuY2hpbGRyZW47XG4gICAgdmFyIHJhd0NoaWxkcmVuID0gdGhpcy4kc2xvdHMuZGVmYXVsdCB8fCBbXTtcbiAgICB2YXIgY2hpbGRyZW4gPSB0aGlzLmNoaWxkcmVuID0gW107XG4gICAgdmFyIHRyYW5zaXRpb25EYXRhID0gZXh0cmFjdFRyYW5zaXRpb25EYXRhKHRoaXMpO1xuXG4gICAgZm9yICh2YXIgaSA9IDA7IGkgPCByYXdDaGlsZHJlbi5sZW5ndGg7IGkrKykge1xuICAgICAgdmFyIGMgPSByYXdDaGlsZHJlbltpXTtcbiAgICAgIGlmIChjLnRhZykge1xuICAgICAgICBpZiAoYy5rZXkgIT0gbnVsbCAmJiBTdHJpbmcoYy5rZXkpLmluZGV4T2YoJ19fdmxpc3QnKSAhPT0gMCkge1xuICAgICAgICAgIGNoaWxkcmVuLnB1c2goYyk7XG4gICAgICAgICAgbWFwW2Mua2V5XSA9IGNcbiAgICAgICAgICA7KGMuZGF0YSB8fCAoYy5kYXRhID0ge30pKS50cmFuc2l0aW9uID0gdHJhbnNpdGlvbkRhdGE7XG4gICAgICAgIH0gZWxzZSBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJykge1xuICAgICAgICAgIHZhciBvcHRzID0gYy5jb21wb25lbnRPcHRpb25zO1xuICAgICAgICAgIHZhciBuYW1lID0gb3B0cyA/IChvcHRzLkN0b3Iub3B0aW9ucy5uYW1lIHx8IG9wdHMudGFnIHx8ICcnKSA6IGMudGFnO1xuICAgICAgICAgIHdhcm4oKFwiPHRyYW5zaXRpb24tZ3JvdXA+IGNoaWxkcmVuIG11c3QgYmUga2V5ZWQ6IDxcIiArIG5hbWUgKyBcIj5cIikpO1xuICAgICAgICB9XG4gICAgICB9XG4gICAgfVxuXG4gICAgaWYgKHByZXZDaGlsZHJlbikge1xuICAgICAgdmFyIGtlcHQgPSBbXTtcbiAgICAgIHZhciByZW1vdmVkID0gW107XG4gICAgICBmb3IgKHZhciBpJDEgPSAwOyBpJDEgPCBwcmV2Q2hpbGRyZW4ubGVuZ3RoOyBpJDErKykge1xuICAgICAgICB2YXIgYyQxID0gcHJldkNoaWxkcmVuW2kkMV07XG4gICAgICAgIGMkMS5kYXRhLnRyYW5zaXRpb24gPSB0cmFuc2l0aW9uRGF0YTtcbiAgICAgICAgYyQxLmRhdGEucG9zID0gYyQxLmVsbS5nZXRCb3VuZGluZ0NsaWVudFJlY3QoKTtcbiAgICAgICAgaWYgKG1hcFtjJDEua2V5XSkge1xuICAgICAgICAgIGtlcHQucHVzaChjJDEpO1xuICAgICAgICB9IGVsc2Uge1xuICAgICAgICAgIHJlbW92ZWQucHVzaChjJDEpO1xuICAgICAgICB9XG4gICAgICB9XG4gICAgICB0aGlzLmtlcHQgPSBoKHRhZywgbnVsbCwga2VwdCk7XG4gICAgICB0aGlzLnJlbW92ZWQgPSByZW1vdmVkO1xuICAgIH1cblxuICAgIHJldHVybiBoKHRhZywgbnVsbCwgY2hpbGRyZW4pXG4gIH0sXG5cbiAgYmVmb3JlVXBkYXRlOiBmdW5jdGlvbiBiZWZvcmVVcGRhdGUgKCkge1xuICAgIC8vIGZvcmNlIHJlbW92aW5nIHBhc3NcbiAgICB0aGlzLl9fcGF0Y2hfXyhcbiAgICAgIHRoaXMuX3Zub2RlLFxuICAgICAgdGhpcy5rZXB0LFxuICAgICAgZmFsc2UsIC8vIGh5ZHJhdGluZ1xuICAgICAgdHJ1ZSAvLyByZW1vdmVPbmx5ICghaW1wb3J0YW50LCBhdm9pZHMgdW5uZWNlc3NhcnkgbW92ZXMpXG4gICAgKTtcbiAgICB0aGlzLl92bm9kZSA9IHRoaXMua2VwdDtcbiAgfSxcblxuICB1cGRhdGVkOiBmdW5jdGlvbiB1cGRhdGVkICgpIHtcbiAgICB2YXIgY2hpbGRyZW4gPSB0aGlzLnByZXZDaGlsZHJlbjtcbiAgICB2YXIgbW92ZUNsYXNzID0gdGhpcy5tb3ZlQ2xhc3MgfHwgKCh0aGlzLm5hbWUgfHwgJ3YnKSArICctbW92ZScpO1xuICAgIGlmICghY2hpbGRyZW4ubGVuZ3RoIHx8ICF0aGlzLmhhc01vdmUoY2hpbGRyZW5bMF0uZWxtLCBtb3ZlQ2xhc3MpKSB7XG4gICAgICByZXR1cm5cbiAgICB9XG5cbiAgICAvLyB3ZSBkaXZpZGUgdGhlIHdvcmsgaW50byB0aHJlZSBsb29wcyB0byBhdm9pZCBtaXhpbmcgRE9NIHJlYWRzIGFuZCB3cml0ZXNcbiAgICAvLyBpbiBlYWNoIGl0ZXJhdGlvbiAtIHdoaWNoIGhlbHBzIHByZXZlbnQgbGF5b3V0IHRocmFzaGluZy5cbiAgICBjaGlsZHJlbi5mb3JFYWNoKGNhbGxQZW5kaW5nQ2JzKTtcbiAgICBjaGlsZHJlbi5mb3JFYWNoKHJlY29yZFBvc2l0aW9uKTtcbiAgICBjaGlsZHJlbi5mb3JFYWNoKGFwcGx5VHJhbnNsYXRpb24pO1xuXG4gICAgLy8gZm9yY2UgcmVmbG93IHRvIHB1dCBldmVyeXRoaW5nIGluIHBvc2l0aW9uXG4gICAgLy8gYXNzaWduIHRvIHRoaXMgdG8gYXZvaWQgYmVpbmcgcmVtb3ZlZCBpbiB0cmVlLXNoYWtpbmdcbiAgICAvLyAkZmxvdy1kaXNhYmxlLWxpbmVcbiAgICB0aGlzLl9yZWZsb3cgPSBkb2N1bWVudC5ib2R5Lm9mZnNldEhlaWdodDtcblxuICAgIGNoaWxkcmVuLmZvckVhY2goZnVuY3Rpb24gKGMpIHtcbiAgICAgIGlmIChjLmRhdGEubW92ZWQpIHtcbiAgICAgICAgdmFyIGVsID0gYy5lbG07XG4gICAgICAgIHZhciBzID0gZWwuc3R5bGU7XG4gICAgICAgIGFkZFRyYW5zaXRpb25DbGFzcyhlbCwgbW92ZUNsYXNzKTtcbiAgICAgICAgcy50cmFuc2Zvcm0gPSBzLldlYmtpdFRyYW5zZm9ybSA9IHMudHJhbnNpdGlvbkR1cmF0aW9uID0gJyc7XG4gICAgICAgIGVsLmFkZEV2ZW50TGlzdGVuZXIodHJhbnNpdGlvbkVuZEV2ZW50LCBlbC5fbW92ZUNiID0gZnVuY3Rpb24gY2IgKGUpIHtcbiAgICAgICAgICBpZiAoIWUgfHwgL3RyYW5zZm9ybSQvLnRlc3QoZS5wcm9wZXJ0eU5hbWUpKSB7XG4gICAgICAgICAgICBlbC5yZW1vdmVFdmVudExpc3RlbmVyKHRyYW5zaXRpb25FbmRFdmVudCwgY2IpO1xuICAgICAgICAgICAgZWwuX21vdmVDYiA9IG51bGw7XG4gICAgICAgICAgICByZW1vdmVUcmFuc2l0aW9uQ2xhc3MoZWwsIG1vdmVDbGFzcyk7XG4gICAgICAgICAgfVxuICAgICAgICB9KTtcbiAgICAgIH1cbiAgICB9KTtcbiAgfSxcblxuICBtZXRob2RzOiB7XG4gICAgaGFzTW92ZTogZnVuY3Rpb24gaGFzTW92ZSAoZWwsIG1vdmVDbGFzcykge1xuICAgICAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gICAgICBpZiAoIWhhc1RyYW5zaXRpb24pIHtcbiAgICAgICAgcmV0dXJuIGZhbHNlXG4gICAgICB9XG4gICAgICAvKiBpc3RhbmJ1bCBpZ25vcmUgaWYgKi9cbiAgICAgIGlmICh0aGlzLl9oYXNNb3ZlKSB7XG4gICAgICAgIHJldHVybiB0aGlzLl9oYXNNb3ZlXG4gICAgICB9XG4gICAgICAvLyBEZXRlY3Qgd2hldGhlciBhbiBlbGVtZW50IHdpdGggdGhlIG1vdmUgY2xhc3MgYXBwbGllZCBoYXNcbiAgICAgIC8vIENTUyB0cmFuc2l0aW9ucy4gU2luY2UgdGhlIGVsZW1lbnQgbWF5IGJlIGluc2lkZSBhbiBlbnRlcmluZ1xuICAgICAgLy8gdHJhbnNpdGlvbiBhdCB0aGlzIHZlcnkgbW9tZW50LCB3ZSBtYWtlIGEgY2xvbmUgb2YgaXQgYW5kIHJlbW92ZVxuICAgICAgLy8gYWxsIG90aGVyIHRyYW5zaXRpb24gY2xhc3NlcyBhcHBsaWVkIHRvIGVuc3VyZSBvbmx5IHRoZSBtb3ZlIGNsYXNzXG4gICAgICAvLyBpcyBhcHBsaWVkLlxuICAgICAgdmFyIGNsb25lID0gZWwuY2xvbmVOb2RlKCk7XG4gICAgICBpZiAoZWwuX3RyYW5zaXRpb25DbGFzc2VzKSB7XG4gICAgICAgIGVsLl90cmFuc2l0aW9uQ2xhc3Nlcy5mb3JFYWNoKGZ1bmN0aW9uIChjbHMpIHsgcmVtb3ZlQ2xhc3MoY2xvbmUsIGNscyk7IH0pO1xuICAgICAgfVxuICAgICAgYWRkQ2xhc3MoY2xvbmUsIG1vdmVDbGFzcyk7XG4gICAgICBjbG9uZS5zdHlsZS5kaXNwbGF5ID0gJ25vbmUnO1xuICAgICAgdGhpcy4kZWwuYXBwZW5kQ2hpbGQoY2xvbmUpO1xuICAgICAgdmFyIGluZm8gPSBnZXRUcmFuc2l0aW9uSW5mbyhjbG9uZSk7XG4gICAgICB0aGlzLiRlbC5yZW1vdmVDaGlsZChjbG9uZSk7XG4gICAgICByZXR1cm4gKHRoaXMuX2hhc01vdmUgPSBpbmZvLmhhc1RyYW5zZm9ybSlcbiAgICB9XG4gIH1cbn1cblxuZnVuY3Rpb24gY2FsbFBlbmRpbmdDYnMgKGMpIHtcbiAgLyogaXN0YW5idWwgaWdub3JlIGlmICovXG4gIGlmIChjLmVsbS5fbW92ZUNiKSB7XG4gICAgYy5lbG0uX21vdmVDYigpO1xuICB9XG4gIC8qIGlzdGFuYnVsIGlnbm9yZSBpZiAqL1xuICBpZiAoYy5lbG0uX2VudGVyQ2IpIHtcbiAgICBjLmVsbS5fZW50ZXJDYigpO1xuICB9XG59XG5cbmZ1bmN0aW9uIHJlY29yZFBvc2l0aW9uIChjKSB7XG4gIGMuZGF0YS5uZXdQb3MgPSBjLmVsbS5nZXRCb3VuZGluZ0NsaWVudFJlY3QoKTtcbn1cblxuZnVuY3Rpb24gYXBwbHlUcmFuc2xhdGlvbiAoYykge1xuICB2YXIgb2xkUG9zID0gYy5kYXRhLnBvcztcbiAgdmFyIG5ld1BvcyA9IGMuZGF0YS5uZXdQb3M7XG4gIHZhciBkeCA9IG9sZFBvcy5sZWZ0IC0gbmV3UG9zLmxlZnQ7XG4gIHZhciBkeSA9IG9sZFBvcy50b3AgLSBuZXdQb3MudG9wO1xuICBpZiAoZHggfHwgZHkpIHtcbiAgICBjLmRhdGEubW92ZWQgPSB0cnVlO1xuICAgIHZhciBzID0gYy5lbG0uc3R5bGU7XG4gICAgcy50cmFuc2Zvcm0gPSBzLldlYmtpdFRyYW5zZm9ybSA9IFwidHJhbnNsYXRlKFwiICsgZHggKyBcInB4LFwiICsgZHkgKyBcInB4KVwiO1xuICAgIHMudHJhbnNpdGlvbkR1cmF0aW9uID0gJzBzJztcbiAgfVxufVxuXG52YXIgcGxhdGZvcm1Db21wb25lbnRzID0ge1xuICBUcmFuc2l0aW9uOiBUcmFuc2l0aW9uLFxuICBUcmFuc2l0aW9uR3JvdXA6IFRyYW5zaXRpb25Hcm91cFxufVxuXG4vKiAgKi9cblxuLy8gaW5zdGFsbCBwbGF0Zm9ybSBzcGVjaWZpYyB1dGlsc1xuVnVlLmNvbmZpZy5tdXN0VXNlUHJvcCA9IG11c3RVc2VQcm9wO1xuVnVlLmNvbmZpZy5pc1Jlc2VydmVkVGFnID0gaXNSZXNlcnZlZFRhZztcblZ1ZS5jb25maWcuaXNSZXNlcnZlZEF0dHIgPSBpc1Jlc2VydmVkQXR0cjtcblZ1ZS5jb25maWcuZ2V0VGFnTmFtZXNwYWNlID0gZ2V0VGFnTmFtZXNwYWNlO1xuVnVlLmNvbmZpZy5pc1Vua25vd25FbGVtZW50ID0gaXNVbmtub3duRWxlbWVudDtcblxuLy8gaW5zdGFsbCBwbGF0Zm9ybSBydW50aW1lIGRpcmVjdGl2ZXMgJiBjb21wb25lbnRzXG5leHRlbmQoVnVlLm9wdGlvbnMuZGlyZWN0aXZlcywgcGxhdGZvcm1EaXJlY3RpdmVzKTtcbmV4dGVuZChWdWUub3B0aW9ucy5jb21wb25lbnRzLCBwbGF0Zm9ybUNvbXBvbmVudHMpO1xuXG4vLyBpbnN0YWxsIHBsYXRmb3JtIHBhdGNoIGZ1bmN0aW9uXG5WdWUucHJvdG90eXBlLl9fcGF0Y2hfXyA9IGluQnJvd3NlciA/IHBhdGNoIDogbm9vcDtcblxuLy8gcHVibGljIG1vdW50IG1ldGhvZFxuVnVlLnByb3RvdHlwZS4kbW91bnQgPSBmdW5jdGlvbiAoXG4gIGVsLFxuICBoeWRyYXRpbmdcbikge1xuICBlbCA9IGVsICYmIGluQnJvd3NlciA/IHF1ZXJ5KGVsKSA6IHVuZGVmaW5lZDtcbiAgcmV0dXJuIG1vdW50Q29tcG9uZW50KHRoaXMsIGVsLCBoeWRyYXRpbmcpXG59O1xuXG4vLyBkZXZ0b29scyBnbG9iYWwgaG9va1xuLyogaXN0YW5idWwgaWdub3JlIG5leHQgKi9cbmlmIChpbkJyb3dzZXIpIHtcbiAgc2V0VGltZW91dChmdW5jdGlvbiAoKSB7XG4gICAgaWYgKGNvbmZpZy5kZXZ0b29scykge1xuICAgICAgaWYgKGRldnRvb2xzKSB7XG4gICAgICAgIGRldnRvb2xzLmVtaXQoJ2luaXQnLCBWdWUpO1xuICAgICAgfSBlbHNlIGlmIChcbiAgICAgICAgcHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJlxuICAgICAgICBwcm9jZXNzLmVudi5OT0RFX0VOViAhPT0gJ3Rlc3QnICYmXG4gICAgICAgIGlzQ2hyb21lXG4gICAgICApIHtcbiAgICAgICAgY29uc29sZVtjb25zb2xlLmluZm8gPyAnaW5mbycgOiAnbG9nJ10oXG4gICAgICAgICAgJ0Rvd25sb2FkIHRoZSBWdWUgRGV2dG9vbHMgZXh0ZW5zaW9uIGZvciBhIGJldHRlciBkZXZlbG9wbWVudCBleHBlcmllbmNlOlxcbicgK1xuICAgICAgICAgICdodHRwczovL2dpdGh1Yi5jb20vdnVlanMvdnVlLWRldnRvb2xzJ1xuICAgICAgICApO1xuICAgICAgfVxuICAgIH1cbiAgICBpZiAocHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICdwcm9kdWN0aW9uJyAmJlxuICAgICAgcHJvY2Vzcy5lbnYuTk9ERV9FTlYgIT09ICd0ZXN0JyAmJlxuICAgICAgY29uZmlnLnByb2R1Y3Rpb25UaXAgIT09IGZhbHNlICYmXG4gICAgICB0eXBlb2YgY29uc29sZSAhPT0gJ3VuZGVmaW5lZCdcbiAgICApIHtcbiAgICAgIGNvbnNvbGVbY29uc29sZS5pbmZvID8gJ2luZm8nIDogJ2xvZyddKFxuICAgICAgICBcIllvdSBhcmUgcnVubmluZyBWdWUgaW4gZGV2ZWxvcG1lbnQgbW9kZS5cXG5cIiArXG4gICAgICAgIFwiTWFrZSBzdXJlIHRvIHR1cm4gb24gcHJvZHVjdGlvbiBtb2RlIHdoZW4gZGVwbG95aW5nIGZvciBwcm9kdWN0aW9uLlxcblwiICtcbiAgICAgICAgXCJTZWUgbW9yZSB0aXBzIGF0IGh0dHBzOi8vdnVlanMub3JnL2d1aWRlL2RlcGxveW1lbnQuaHRtbFwiXG4gICAgICApO1xuICAgIH1cbiAgfSwgMCk7XG59XG5cbi8qICAqL1xuXG5leHBvcnQgZGVmYXVsdCBWdWU7XG4iLCJ2YXIgc3RvcmUgPSByZXF1aXJlKCcuL19zaGFyZWQnKSgnd2tzJyk7XG52YXIgdWlkID0gcmVxdWlyZSgnLi9fdWlkJyk7XG52YXIgU3ltYm9sID0gcmVxdWlyZSgnLi9fZ2xvYmFsJykuU3ltYm9sO1xudmFyIFVTRV9TWU1CT0wgPSB0eXBlb2YgU3ltYm9sID09ICdmdW5jdGlvbic7XG5cbnZhciAkZXhwb3J0cyA9IG1vZHVsZS5leHBvcnRzID0gZnVuY3Rpb24gKG5hbWUpIHtcbiAgcmV0dXJuIHN0b3JlW25hbWVdIHx8IChzdG9yZVtuYW1lXSA9XG4gICAgVVNFX1NZTUJPTCAmJiBTeW1ib2xbbmFtZV0gfHwgKFVTRV9TWU1CT0wgPyBTeW1ib2wgOiB1aWQpKCdTeW1ib2wuJyArIG5hbWUpKTtcbn07XG5cbiRleHBvcnRzLnN0b3JlID0gc3RvcmU7XG4iLCJtb2R1bGUuZXhwb3J0cyA9IGZhbHNlO1xuIiwidmFyIHRvU3RyaW5nID0ge30udG9TdHJpbmc7XG5cbm1vZHVsZS5leHBvcnRzID0gZnVuY3Rpb24gKGl0KSB7XG4gIHJldHVybiB0b1N0cmluZy5jYWxsKGl0KS5zbGljZSg4LCAtMSk7XG59O1xuIiwiLy8gZmFzdCBhcHBseSwgaHR0cDovL2pzcGVyZi5sbmtpdC5jb20vZmFzdC1hcHBseS81XG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChmbiwgYXJncywgdGhhdCkge1xuICB2YXIgdW4gPSB0aGF0ID09PSB1bmRlZmluZWQ7XG4gIHN3aXRjaCAoYXJncy5sZW5ndGgpIHtcbiAgICBjYXNlIDA6IHJldHVybiB1biA/IGZuKClcbiAgICAgICAgICAgICAgICAgICAgICA6IGZuLmNhbGwodGhhdCk7XG4gICAgY2FzZSAxOiByZXR1cm4gdW4gPyBmbihhcmdzWzBdKVxuICAgICAgICAgICAgICAgICAgICAgIDogZm4uY2FsbCh0aGF0LCBhcmdzWzBdKTtcbiAgICBjYXNlIDI6IHJldHVybiB1biA/IGZuKGFyZ3NbMF0sIGFyZ3NbMV0pXG4gICAgICAgICAgICAgICAgICAgICAgOiBmbi5jYWxsKHRoYXQsIGFyZ3NbMF0sIGFyZ3NbMV0pO1xuICAgIGNhc2UgMzogcmV0dXJuIHVuID8gZm4oYXJnc1swXSwgYXJnc1sxXSwgYXJnc1syXSlcbiAgICAgICAgICAgICAgICAgICAgICA6IGZuLmNhbGwodGhhdCwgYXJnc1swXSwgYXJnc1sxXSwgYXJnc1syXSk7XG4gICAgY2FzZSA0OiByZXR1cm4gdW4gPyBmbihhcmdzWzBdLCBhcmdzWzFdLCBhcmdzWzJdLCBhcmdzWzNdKVxuICAgICAgICAgICAgICAgICAgICAgIDogZm4uY2FsbCh0aGF0LCBhcmdzWzBdLCBhcmdzWzFdLCBhcmdzWzJdLCBhcmdzWzNdKTtcbiAgfSByZXR1cm4gZm4uYXBwbHkodGhhdCwgYXJncyk7XG59O1xuIiwidmFyIGRQID0gcmVxdWlyZSgnLi9fb2JqZWN0LWRwJyk7XG52YXIgY3JlYXRlRGVzYyA9IHJlcXVpcmUoJy4vX3Byb3BlcnR5LWRlc2MnKTtcbm1vZHVsZS5leHBvcnRzID0gcmVxdWlyZSgnLi9fZGVzY3JpcHRvcnMnKSA/IGZ1bmN0aW9uIChvYmplY3QsIGtleSwgdmFsdWUpIHtcbiAgcmV0dXJuIGRQLmYob2JqZWN0LCBrZXksIGNyZWF0ZURlc2MoMSwgdmFsdWUpKTtcbn0gOiBmdW5jdGlvbiAob2JqZWN0LCBrZXksIHZhbHVlKSB7XG4gIG9iamVjdFtrZXldID0gdmFsdWU7XG4gIHJldHVybiBvYmplY3Q7XG59O1xuIiwiLy8gY2hlY2sgb24gZGVmYXVsdCBBcnJheSBpdGVyYXRvclxudmFyIEl0ZXJhdG9ycyA9IHJlcXVpcmUoJy4vX2l0ZXJhdG9ycycpO1xudmFyIElURVJBVE9SID0gcmVxdWlyZSgnLi9fd2tzJykoJ2l0ZXJhdG9yJyk7XG52YXIgQXJyYXlQcm90byA9IEFycmF5LnByb3RvdHlwZTtcblxubW9kdWxlLmV4cG9ydHMgPSBmdW5jdGlvbiAoaXQpIHtcbiAgcmV0dXJuIGl0ICE9PSB1bmRlZmluZWQgJiYgKEl0ZXJhdG9ycy5BcnJheSA9PT0gaXQgfHwgQXJyYXlQcm90b1tJVEVSQVRPUl0gPT09IGl0KTtcbn07XG4iLCIvLyAxOS4xLjIuOSAvIDE1LjIuMy4yIE9iamVjdC5nZXRQcm90b3R5cGVPZihPKVxudmFyIGhhcyA9IHJlcXVpcmUoJy4vX2hhcycpO1xudmFyIHRvT2JqZWN0ID0gcmVxdWlyZSgnLi9fdG8tb2JqZWN0Jyk7XG52YXIgSUVfUFJPVE8gPSByZXF1aXJlKCcuL19zaGFyZWQta2V5JykoJ0lFX1BST1RPJyk7XG52YXIgT2JqZWN0UHJvdG8gPSBPYmplY3QucHJvdG90eXBlO1xuXG5tb2R1bGUuZXhwb3J0cyA9IE9iamVjdC5nZXRQcm90b3R5cGVPZiB8fCBmdW5jdGlvbiAoTykge1xuICBPID0gdG9PYmplY3QoTyk7XG4gIGlmIChoYXMoTywgSUVfUFJPVE8pKSByZXR1cm4gT1tJRV9QUk9UT107XG4gIGlmICh0eXBlb2YgTy5jb25zdHJ1Y3RvciA9PSAnZnVuY3Rpb24nICYmIE8gaW5zdGFuY2VvZiBPLmNvbnN0cnVjdG9yKSB7XG4gICAgcmV0dXJuIE8uY29uc3RydWN0b3IucHJvdG90eXBlO1xuICB9IHJldHVybiBPIGluc3RhbmNlb2YgT2JqZWN0ID8gT2JqZWN0UHJvdG8gOiBudWxsO1xufTtcbiIsIid1c2Ugc3RyaWN0JztcbnZhciBjcmVhdGUgPSByZXF1aXJlKCcuL19vYmplY3QtY3JlYXRlJyk7XG52YXIgZGVzY3JpcHRvciA9IHJlcXVpcmUoJy4vX3Byb3BlcnR5LWRlc2MnKTtcbnZhciBzZXRUb1N0cmluZ1RhZyA9IHJlcXVpcmUoJy4vX3NldC10by1zdHJpbmctdGFnJyk7XG52YXIgSXRlcmF0b3JQcm90b3R5cGUgPSB7fTtcblxuLy8gMjUuMS4yLjEuMSAlSXRlcmF0b3JQcm90b3R5cGUlW0BAaXRlcmF0b3JdKClcbnJlcXVpcmUoJy4vX2hpZGUnKShJdGVyYXRvclByb3RvdHlwZSwgcmVxdWlyZSgnLi9fd2tzJykoJ2l0ZXJhdG9yJyksIGZ1bmN0aW9uICgpIHsgcmV0dXJuIHRoaXM7IH0pO1xuXG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChDb25zdHJ1Y3RvciwgTkFNRSwgbmV4dCkge1xuICBDb25zdHJ1Y3Rvci5wcm90b3R5cGUgPSBjcmVhdGUoSXRlcmF0b3JQcm90b3R5cGUsIHsgbmV4dDogZGVzY3JpcHRvcigxLCBuZXh0KSB9KTtcbiAgc2V0VG9TdHJpbmdUYWcoQ29uc3RydWN0b3IsIE5BTUUgKyAnIEl0ZXJhdG9yJyk7XG59O1xuIiwiLy8gNy4xLjQgVG9JbnRlZ2VyXG52YXIgY2VpbCA9IE1hdGguY2VpbDtcbnZhciBmbG9vciA9IE1hdGguZmxvb3I7XG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChpdCkge1xuICByZXR1cm4gaXNOYU4oaXQgPSAraXQpID8gMCA6IChpdCA+IDAgPyBmbG9vciA6IGNlaWwpKGl0KTtcbn07XG4iLCJtb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChiaXRtYXAsIHZhbHVlKSB7XG4gIHJldHVybiB7XG4gICAgZW51bWVyYWJsZTogIShiaXRtYXAgJiAxKSxcbiAgICBjb25maWd1cmFibGU6ICEoYml0bWFwICYgMiksXG4gICAgd3JpdGFibGU6ICEoYml0bWFwICYgNCksXG4gICAgdmFsdWU6IHZhbHVlXG4gIH07XG59O1xuIiwidmFyIGN0eCA9IHJlcXVpcmUoJy4vX2N0eCcpO1xudmFyIGNhbGwgPSByZXF1aXJlKCcuL19pdGVyLWNhbGwnKTtcbnZhciBpc0FycmF5SXRlciA9IHJlcXVpcmUoJy4vX2lzLWFycmF5LWl0ZXInKTtcbnZhciBhbk9iamVjdCA9IHJlcXVpcmUoJy4vX2FuLW9iamVjdCcpO1xudmFyIHRvTGVuZ3RoID0gcmVxdWlyZSgnLi9fdG8tbGVuZ3RoJyk7XG52YXIgZ2V0SXRlckZuID0gcmVxdWlyZSgnLi9jb3JlLmdldC1pdGVyYXRvci1tZXRob2QnKTtcbnZhciBCUkVBSyA9IHt9O1xudmFyIFJFVFVSTiA9IHt9O1xudmFyIGV4cG9ydHMgPSBtb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChpdGVyYWJsZSwgZW50cmllcywgZm4sIHRoYXQsIElURVJBVE9SKSB7XG4gIHZhciBpdGVyRm4gPSBJVEVSQVRPUiA/IGZ1bmN0aW9uICgpIHsgcmV0dXJuIGl0ZXJhYmxlOyB9IDogZ2V0SXRlckZuKGl0ZXJhYmxlKTtcbiAgdmFyIGYgPSBjdHgoZm4sIHRoYXQsIGVudHJpZXMgPyAyIDogMSk7XG4gIHZhciBpbmRleCA9IDA7XG4gIHZhciBsZW5ndGgsIHN0ZXAsIGl0ZXJhdG9yLCByZXN1bHQ7XG4gIGlmICh0eXBlb2YgaXRlckZuICE9ICdmdW5jdGlvbicpIHRocm93IFR5cGVFcnJvcihpdGVyYWJsZSArICcgaXMgbm90IGl0ZXJhYmxlIScpO1xuICAvLyBmYXN0IGNhc2UgZm9yIGFycmF5cyB3aXRoIGRlZmF1bHQgaXRlcmF0b3JcbiAgaWYgKGlzQXJyYXlJdGVyKGl0ZXJGbikpIGZvciAobGVuZ3RoID0gdG9MZW5ndGgoaXRlcmFibGUubGVuZ3RoKTsgbGVuZ3RoID4gaW5kZXg7IGluZGV4KyspIHtcbiAgICByZXN1bHQgPSBlbnRyaWVzID8gZihhbk9iamVjdChzdGVwID0gaXRlcmFibGVbaW5kZXhdKVswXSwgc3RlcFsxXSkgOiBmKGl0ZXJhYmxlW2luZGV4XSk7XG4gICAgaWYgKHJlc3VsdCA9PT0gQlJFQUsgfHwgcmVzdWx0ID09PSBSRVRVUk4pIHJldHVybiByZXN1bHQ7XG4gIH0gZWxzZSBmb3IgKGl0ZXJhdG9yID0gaXRlckZuLmNhbGwoaXRlcmFibGUpOyAhKHN0ZXAgPSBpdGVyYXRvci5uZXh0KCkpLmRvbmU7KSB7XG4gICAgcmVzdWx0ID0gY2FsbChpdGVyYXRvciwgZiwgc3RlcC52YWx1ZSwgZW50cmllcyk7XG4gICAgaWYgKHJlc3VsdCA9PT0gQlJFQUsgfHwgcmVzdWx0ID09PSBSRVRVUk4pIHJldHVybiByZXN1bHQ7XG4gIH1cbn07XG5leHBvcnRzLkJSRUFLID0gQlJFQUs7XG5leHBvcnRzLlJFVFVSTiA9IFJFVFVSTjtcbiIsIi8vIDcuMS4xMyBUb09iamVjdChhcmd1bWVudClcbnZhciBkZWZpbmVkID0gcmVxdWlyZSgnLi9fZGVmaW5lZCcpO1xubW9kdWxlLmV4cG9ydHMgPSBmdW5jdGlvbiAoaXQpIHtcbiAgcmV0dXJuIE9iamVjdChkZWZpbmVkKGl0KSk7XG59O1xuIiwiJ3VzZSBzdHJpY3QnO1xudmFyIExJQlJBUlkgPSByZXF1aXJlKCcuL19saWJyYXJ5Jyk7XG52YXIgZ2xvYmFsID0gcmVxdWlyZSgnLi9fZ2xvYmFsJyk7XG52YXIgY3R4ID0gcmVxdWlyZSgnLi9fY3R4Jyk7XG52YXIgY2xhc3NvZiA9IHJlcXVpcmUoJy4vX2NsYXNzb2YnKTtcbnZhciAkZXhwb3J0ID0gcmVxdWlyZSgnLi9fZXhwb3J0Jyk7XG52YXIgaXNPYmplY3QgPSByZXF1aXJlKCcuL19pcy1vYmplY3QnKTtcbnZhciBhRnVuY3Rpb24gPSByZXF1aXJlKCcuL19hLWZ1bmN0aW9uJyk7XG52YXIgYW5JbnN0YW5jZSA9IHJlcXVpcmUoJy4vX2FuLWluc3RhbmNlJyk7XG52YXIgZm9yT2YgPSByZXF1aXJlKCcuL19mb3Itb2YnKTtcbnZhciBzcGVjaWVzQ29uc3RydWN0b3IgPSByZXF1aXJlKCcuL19zcGVjaWVzLWNvbnN0cnVjdG9yJyk7XG52YXIgdGFzayA9IHJlcXVpcmUoJy4vX3Rhc2snKS5zZXQ7XG52YXIgbWljcm90YXNrID0gcmVxdWlyZSgnLi9fbWljcm90YXNrJykoKTtcbnZhciBuZXdQcm9taXNlQ2FwYWJpbGl0eU1vZHVsZSA9IHJlcXVpcmUoJy4vX25ldy1wcm9taXNlLWNhcGFiaWxpdHknKTtcbnZhciBwZXJmb3JtID0gcmVxdWlyZSgnLi9fcGVyZm9ybScpO1xudmFyIHVzZXJBZ2VudCA9IHJlcXVpcmUoJy4vX3VzZXItYWdlbnQnKTtcbnZhciBwcm9taXNlUmVzb2x2ZSA9IHJlcXVpcmUoJy4vX3Byb21pc2UtcmVzb2x2ZScpO1xudmFyIFBST01JU0UgPSAnUHJvbWlzZSc7XG52YXIgVHlwZUVycm9yID0gZ2xvYmFsLlR5cGVFcnJvcjtcbnZhciBwcm9jZXNzID0gZ2xvYmFsLnByb2Nlc3M7XG52YXIgdmVyc2lvbnMgPSBwcm9jZXNzICYmIHByb2Nlc3MudmVyc2lvbnM7XG52YXIgdjggPSB2ZXJzaW9ucyAmJiB2ZXJzaW9ucy52OCB8fCAnJztcbnZhciAkUHJvbWlzZSA9IGdsb2JhbFtQUk9NSVNFXTtcbnZhciBpc05vZGUgPSBjbGFzc29mKHByb2Nlc3MpID09ICdwcm9jZXNzJztcbnZhciBlbXB0eSA9IGZ1bmN0aW9uICgpIHsgLyogZW1wdHkgKi8gfTtcbnZhciBJbnRlcm5hbCwgbmV3R2VuZXJpY1Byb21pc2VDYXBhYmlsaXR5LCBPd25Qcm9taXNlQ2FwYWJpbGl0eSwgV3JhcHBlcjtcbnZhciBuZXdQcm9taXNlQ2FwYWJpbGl0eSA9IG5ld0dlbmVyaWNQcm9taXNlQ2FwYWJpbGl0eSA9IG5ld1Byb21pc2VDYXBhYmlsaXR5TW9kdWxlLmY7XG5cbnZhciBVU0VfTkFUSVZFID0gISFmdW5jdGlvbiAoKSB7XG4gIHRyeSB7XG4gICAgLy8gY29ycmVjdCBzdWJjbGFzc2luZyB3aXRoIEBAc3BlY2llcyBzdXBwb3J0XG4gICAgdmFyIHByb21pc2UgPSAkUHJvbWlzZS5yZXNvbHZlKDEpO1xuICAgIHZhciBGYWtlUHJvbWlzZSA9IChwcm9taXNlLmNvbnN0cnVjdG9yID0ge30pW3JlcXVpcmUoJy4vX3drcycpKCdzcGVjaWVzJyldID0gZnVuY3Rpb24gKGV4ZWMpIHtcbiAgICAgIGV4ZWMoZW1wdHksIGVtcHR5KTtcbiAgICB9O1xuICAgIC8vIHVuaGFuZGxlZCByZWplY3Rpb25zIHRyYWNraW5nIHN1cHBvcnQsIE5vZGVKUyBQcm9taXNlIHdpdGhvdXQgaXQgZmFpbHMgQEBzcGVjaWVzIHRlc3RcbiAgICByZXR1cm4gKGlzTm9kZSB8fCB0eXBlb2YgUHJvbWlzZVJlamVjdGlvbkV2ZW50ID09ICdmdW5jdGlvbicpXG4gICAgICAmJiBwcm9taXNlLnRoZW4oZW1wdHkpIGluc3RhbmNlb2YgRmFrZVByb21pc2VcbiAgICAgIC8vIHY4IDYuNiAoTm9kZSAxMCBhbmQgQ2hyb21lIDY2KSBoYXZlIGEgYnVnIHdpdGggcmVzb2x2aW5nIGN1c3RvbSB0aGVuYWJsZXNcbiAgICAgIC8vIGh0dHBzOi8vYnVncy5jaHJvbWl1bS5vcmcvcC9jaHJvbWl1bS9pc3N1ZXMvZGV0YWlsP2lkPTgzMDU2NVxuICAgICAgLy8gd2UgY2FuJ3QgZGV0ZWN0IGl0IHN5bmNocm9ub3VzbHksIHNvIGp1c3QgY2hlY2sgdmVyc2lvbnNcbiAgICAgICYmIHY4LmluZGV4T2YoJzYuNicpICE9PSAwXG4gICAgICAmJiB1c2VyQWdlbnQuaW5kZXhPZignQ2hyb21lLzY2JykgPT09IC0xO1xuICB9IGNhdGNoIChlKSB7IC8qIGVtcHR5ICovIH1cbn0oKTtcblxuLy8gaGVscGVyc1xudmFyIGlzVGhlbmFibGUgPSBmdW5jdGlvbiAoaXQpIHtcbiAgdmFyIHRoZW47XG4gIHJldHVybiBpc09iamVjdChpdCkgJiYgdHlwZW9mICh0aGVuID0gaXQudGhlbikgPT0gJ2Z1bmN0aW9uJyA/IHRoZW4gOiBmYWxzZTtcbn07XG52YXIgbm90aWZ5ID0gZnVuY3Rpb24gKHByb21pc2UsIGlzUmVqZWN0KSB7XG4gIGlmIChwcm9taXNlLl9uKSByZXR1cm47XG4gIHByb21pc2UuX24gPSB0cnVlO1xuICB2YXIgY2hhaW4gPSBwcm9taXNlLl9jO1xuICBtaWNyb3Rhc2soZnVuY3Rpb24gKCkge1xuICAgIHZhciB2YWx1ZSA9IHByb21pc2UuX3Y7XG4gICAgdmFyIG9rID0gcHJvbWlzZS5fcyA9PSAxO1xuICAgIHZhciBpID0gMDtcbiAgICB2YXIgcnVuID0gZnVuY3Rpb24gKHJlYWN0aW9uKSB7XG4gICAgICB2YXIgaGFuZGxlciA9IG9rID8gcmVhY3Rpb24ub2sgOiByZWFjdGlvbi5mYWlsO1xuICAgICAgdmFyIHJlc29sdmUgPSByZWFjdGlvbi5yZXNvbHZlO1xuICAgICAgdmFyIHJlamVjdCA9IHJlYWN0aW9uLnJlamVjdDtcbiAgICAgIHZhciBkb21haW4gPSByZWFjdGlvbi5kb21haW47XG4gICAgICB2YXIgcmVzdWx0LCB0aGVuLCBleGl0ZWQ7XG4gICAgICB0cnkge1xuICAgICAgICBpZiAoaGFuZGxlcikge1xuICAgICAgICAgIGlmICghb2spIHtcbiAgICAgICAgICAgIGlmIChwcm9taXNlLl9oID09IDIpIG9uSGFuZGxlVW5oYW5kbGVkKHByb21pc2UpO1xuICAgICAgICAgICAgcHJvbWlzZS5faCA9IDE7XG4gICAgICAgICAgfVxuICAgICAgICAgIGlmIChoYW5kbGVyID09PSB0cnVlKSByZXN1bHQgPSB2YWx1ZTtcbiAgICAgICAgICBlbHNlIHtcbiAgICAgICAgICAgIGlmIChkb21haW4pIGRvbWFpbi5lbnRlcigpO1xuICAgICAgICAgICAgcmVzdWx0ID0gaGFuZGxlcih2YWx1ZSk7IC8vIG1heSB0aHJvd1xuICAgICAgICAgICAgaWYgKGRvbWFpbikge1xuICAgICAgICAgICAgICBkb21haW4uZXhpdCgpO1xuICAgICAgICAgICAgICBleGl0ZWQgPSB0cnVlO1xuICAgICAgICAgICAgfVxuICAgICAgICAgIH1cbiAgICAgICAgICBpZiAocmVzdWx0ID09PSByZWFjdGlvbi5wcm9taXNlKSB7XG4gICAgICAgICAgICByZWplY3QoVHlwZUVycm9yKCdQcm9taXNlLWNoYWluIGN5Y2xlJykpO1xuICAgICAgICAgIH0gZWxzZSBpZiAodGhlbiA9IGlzVGhlbmFibGUocmVzdWx0KSkge1xuICAgICAgICAgICAgdGhlbi5jYWxsKHJlc3VsdCwgcmVzb2x2ZSwgcmVqZWN0KTtcbiAgICAgICAgICB9IGVsc2UgcmVzb2x2ZShyZXN1bHQpO1xuICAgICAgICB9IGVsc2UgcmVqZWN0KHZhbHVlKTtcbiAgICAgIH0gY2F0Y2ggKGUpIHtcbiAgICAgICAgaWYgKGRvbWFpbiAmJiAhZXhpdGVkKSBkb21haW4uZXhpdCgpO1xuICAgICAgICByZWplY3QoZSk7XG4gICAgICB9XG4gICAgfTtcbiAgICB3aGlsZSAoY2hhaW4ubGVuZ3RoID4gaSkgcnVuKGNoYWluW2krK10pOyAvLyB2YXJpYWJsZSBsZW5ndGggLSBjYW4ndCB1c2UgZm9yRWFjaFxuICAgIHByb21pc2UuX2MgPSBbXTtcbiAgICBwcm9taXNlLl9uID0gZmFsc2U7XG4gICAgaWYgKGlzUmVqZWN0ICYmICFwcm9taXNlLl9oKSBvblVuaGFuZGxlZChwcm9taXNlKTtcbiAgfSk7XG59O1xudmFyIG9uVW5oYW5kbGVkID0gZnVuY3Rpb24gKHByb21pc2UpIHtcbiAgdGFzay5jYWxsKGdsb2JhbCwgZnVuY3Rpb24gKCkge1xuICAgIHZhciB2YWx1ZSA9IHByb21pc2UuX3Y7XG4gICAgdmFyIHVuaGFuZGxlZCA9IGlzVW5oYW5kbGVkKHByb21pc2UpO1xuICAgIHZhciByZXN1bHQsIGhhbmRsZXIsIGNvbnNvbGU7XG4gICAgaWYgKHVuaGFuZGxlZCkge1xuICAgICAgcmVzdWx0ID0gcGVyZm9ybShmdW5jdGlvbiAoKSB7XG4gICAgICAgIGlmIChpc05vZGUpIHtcbiAgICAgICAgICBwcm9jZXNzLmVtaXQoJ3VuaGFuZGxlZFJlamVjdGlvbicsIHZhbHVlLCBwcm9taXNlKTtcbiAgICAgICAgfSBlbHNlIGlmIChoYW5kbGVyID0gZ2xvYmFsLm9udW5oYW5kbGVkcmVqZWN0aW9uKSB7XG4gICAgICAgICAgaGFuZGxlcih7IHByb21pc2U6IHByb21pc2UsIHJlYXNvbjogdmFsdWUgfSk7XG4gICAgICAgIH0gZWxzZSBpZiAoKGNvbnNvbGUgPSBnbG9iYWwuY29uc29sZSkgJiYgY29uc29sZS5lcnJvcikge1xuICAgICAgICAgIGNvbnNvbGUuZXJyb3IoJ1VuaGFuZGxlZCBwcm9taXNlIHJlamVjdGlvbicsIHZhbHVlKTtcbiAgICAgICAgfVxuICAgICAgfSk7XG4gICAgICAvLyBCcm93c2VycyBzaG91bGQgbm90IHRyaWdnZXIgYHJlamVjdGlvbkhhbmRsZWRgIGV2ZW50IGlmIGl0IHdhcyBoYW5kbGVkIGhlcmUsIE5vZGVKUyAtIHNob3VsZFxuICAgICAgcHJvbWlzZS5faCA9IGlzTm9kZSB8fCBpc1VuaGFuZGxlZChwcm9taXNlKSA/IDIgOiAxO1xuICAgIH0gcHJvbWlzZS5fYSA9IHVuZGVmaW5lZDtcbiAgICBpZiAodW5oYW5kbGVkICYmIHJlc3VsdC5lKSB0aHJvdyByZXN1bHQudjtcbiAgfSk7XG59O1xudmFyIGlzVW5oYW5kbGVkID0gZnVuY3Rpb24gKHByb21pc2UpIHtcbiAgcmV0dXJuIHByb21pc2UuX2ggIT09IDEgJiYgKHByb21pc2UuX2EgfHwgcHJvbWlzZS5fYykubGVuZ3RoID09PSAwO1xufTtcbnZhciBvbkhhbmRsZVVuaGFuZGxlZCA9IGZ1bmN0aW9uIChwcm9taXNlKSB7XG4gIHRhc2suY2FsbChnbG9iYWwsIGZ1bmN0aW9uICgpIHtcbiAgICB2YXIgaGFuZGxlcjtcbiAgICBpZiAoaXNOb2RlKSB7XG4gICAgICBwcm9jZXNzLmVtaXQoJ3JlamVjdGlvbkhhbmRsZWQnLCBwcm9taXNlKTtcbiAgICB9IGVsc2UgaWYgKGhhbmRsZXIgPSBnbG9iYWwub25yZWplY3Rpb25oYW5kbGVkKSB7XG4gICAgICBoYW5kbGVyKHsgcHJvbWlzZTogcHJvbWlzZSwgcmVhc29uOiBwcm9taXNlLl92IH0pO1xuICAgIH1cbiAgfSk7XG59O1xudmFyICRyZWplY3QgPSBmdW5jdGlvbiAodmFsdWUpIHtcbiAgdmFyIHByb21pc2UgPSB0aGlzO1xuICBpZiAocHJvbWlzZS5fZCkgcmV0dXJuO1xuICBwcm9taXNlLl9kID0gdHJ1ZTtcbiAgcHJvbWlzZSA9IHByb21pc2UuX3cgfHwgcHJvbWlzZTsgLy8gdW53cmFwXG4gIHByb21pc2UuX3YgPSB2YWx1ZTtcbiAgcHJvbWlzZS5fcyA9IDI7XG4gIGlmICghcHJvbWlzZS5fYSkgcHJvbWlzZS5fYSA9IHByb21pc2UuX2Muc2xpY2UoKTtcbiAgbm90aWZ5KHByb21pc2UsIHRydWUpO1xufTtcbnZhciAkcmVzb2x2ZSA9IGZ1bmN0aW9uICh2YWx1ZSkge1xuICB2YXIgcHJvbWlzZSA9IHRoaXM7XG4gIHZhciB0aGVuO1xuICBpZiAocHJvbWlzZS5fZCkgcmV0dXJuO1xuICBwcm9taXNlLl9kID0gdHJ1ZTtcbiAgcHJvbWlzZSA9IHByb21pc2UuX3cgfHwgcHJvbWlzZTsgLy8gdW53cmFwXG4gIHRyeSB7XG4gICAgaWYgKHByb21pc2UgPT09IHZhbHVlKSB0aHJvdyBUeXBlRXJyb3IoXCJQcm9taXNlIGNhbid0IGJlIHJlc29sdmVkIGl0c2VsZlwiKTtcbiAgICBpZiAodGhlbiA9IGlzVGhlbmFibGUodmFsdWUpKSB7XG4gICAgICBtaWNyb3Rhc2soZnVuY3Rpb24gKCkge1xuICAgICAgICB2YXIgd3JhcHBlciA9IHsgX3c6IHByb21pc2UsIF9kOiBmYWxzZSB9OyAvLyB3cmFwXG4gICAgICAgIHRyeSB7XG4gICAgICAgICAgdGhlbi5jYWxsKHZhbHVlLCBjdHgoJHJlc29sdmUsIHdyYXBwZXIsIDEpLCBjdHgoJHJlamVjdCwgd3JhcHBlciwgMSkpO1xuICAgICAgICB9IGNhdGNoIChlKSB7XG4gICAgICAgICAgJHJlamVjdC5jYWxsKHdyYXBwZXIsIGUpO1xuICAgICAgICB9XG4gICAgICB9KTtcbiAgICB9IGVsc2Uge1xuICAgICAgcHJvbWlzZS5fdiA9IHZhbHVlO1xuICAgICAgcHJvbWlzZS5fcyA9IDE7XG4gICAgICBub3RpZnkocHJvbWlzZSwgZmFsc2UpO1xuICAgIH1cbiAgfSBjYXRjaCAoZSkge1xuICAgICRyZWplY3QuY2FsbCh7IF93OiBwcm9taXNlLCBfZDogZmFsc2UgfSwgZSk7IC8vIHdyYXBcbiAgfVxufTtcblxuLy8gY29uc3RydWN0b3IgcG9seWZpbGxcbmlmICghVVNFX05BVElWRSkge1xuICAvLyAyNS40LjMuMSBQcm9taXNlKGV4ZWN1dG9yKVxuICAkUHJvbWlzZSA9IGZ1bmN0aW9uIFByb21pc2UoZXhlY3V0b3IpIHtcbiAgICBhbkluc3RhbmNlKHRoaXMsICRQcm9taXNlLCBQUk9NSVNFLCAnX2gnKTtcbiAgICBhRnVuY3Rpb24oZXhlY3V0b3IpO1xuICAgIEludGVybmFsLmNhbGwodGhpcyk7XG4gICAgdHJ5IHtcbiAgICAgIGV4ZWN1dG9yKGN0eCgkcmVzb2x2ZSwgdGhpcywgMSksIGN0eCgkcmVqZWN0LCB0aGlzLCAxKSk7XG4gICAgfSBjYXRjaCAoZXJyKSB7XG4gICAgICAkcmVqZWN0LmNhbGwodGhpcywgZXJyKTtcbiAgICB9XG4gIH07XG4gIC8vIGVzbGludC1kaXNhYmxlLW5leHQtbGluZSBuby11bnVzZWQtdmFyc1xuICBJbnRlcm5hbCA9IGZ1bmN0aW9uIFByb21pc2UoZXhlY3V0b3IpIHtcbiAgICB0aGlzLl9jID0gW107ICAgICAgICAgICAgIC8vIDwtIGF3YWl0aW5nIHJlYWN0aW9uc1xuICAgIHRoaXMuX2EgPSB1bmRlZmluZWQ7ICAgICAgLy8gPC0gY2hlY2tlZCBpbiBpc1VuaGFuZGxlZCByZWFjdGlvbnNcbiAgICB0aGlzLl9zID0gMDsgICAgICAgICAgICAgIC8vIDwtIHN0YXRlXG4gICAgdGhpcy5fZCA9IGZhbHNlOyAgICAgICAgICAvLyA8LSBkb25lXG4gICAgdGhpcy5fdiA9IHVuZGVmaW5lZDsgICAgICAvLyA8LSB2YWx1ZVxuICAgIHRoaXMuX2ggPSAwOyAgICAgICAgICAgICAgLy8gPC0gcmVqZWN0aW9uIHN0YXRlLCAwIC0gZGVmYXVsdCwgMSAtIGhhbmRsZWQsIDIgLSB1bmhhbmRsZWRcbiAgICB0aGlzLl9uID0gZmFsc2U7ICAgICAgICAgIC8vIDwtIG5vdGlmeVxuICB9O1xuICBJbnRlcm5hbC5wcm90b3R5cGUgPSByZXF1aXJlKCcuL19yZWRlZmluZS1hbGwnKSgkUHJvbWlzZS5wcm90b3R5cGUsIHtcbiAgICAvLyAyNS40LjUuMyBQcm9taXNlLnByb3RvdHlwZS50aGVuKG9uRnVsZmlsbGVkLCBvblJlamVjdGVkKVxuICAgIHRoZW46IGZ1bmN0aW9uIHRoZW4ob25GdWxmaWxsZWQsIG9uUmVqZWN0ZWQpIHtcbiAgICAgIHZhciByZWFjdGlvbiA9IG5ld1Byb21pc2VDYXBhYmlsaXR5KHNwZWNpZXNDb25zdHJ1Y3Rvcih0aGlzLCAkUHJvbWlzZSkpO1xuICAgICAgcmVhY3Rpb24ub2sgPSB0eXBlb2Ygb25GdWxmaWxsZWQgPT0gJ2Z1bmN0aW9uJyA/IG9uRnVsZmlsbGVkIDogdHJ1ZTtcbiAgICAgIHJlYWN0aW9uLmZhaWwgPSB0eXBlb2Ygb25SZWplY3RlZCA9PSAnZnVuY3Rpb24nICYmIG9uUmVqZWN0ZWQ7XG4gICAgICByZWFjdGlvbi5kb21haW4gPSBpc05vZGUgPyBwcm9jZXNzLmRvbWFpbiA6IHVuZGVmaW5lZDtcbiAgICAgIHRoaXMuX2MucHVzaChyZWFjdGlvbik7XG4gICAgICBpZiAodGhpcy5fYSkgdGhpcy5fYS5wdXNoKHJlYWN0aW9uKTtcbiAgICAgIGlmICh0aGlzLl9zKSBub3RpZnkodGhpcywgZmFsc2UpO1xuICAgICAgcmV0dXJuIHJlYWN0aW9uLnByb21pc2U7XG4gICAgfSxcbiAgICAvLyAyNS40LjUuMSBQcm9taXNlLnByb3RvdHlwZS5jYXRjaChvblJlamVjdGVkKVxuICAgICdjYXRjaCc6IGZ1bmN0aW9uIChvblJlamVjdGVkKSB7XG4gICAgICByZXR1cm4gdGhpcy50aGVuKHVuZGVmaW5lZCwgb25SZWplY3RlZCk7XG4gICAgfVxuICB9KTtcbiAgT3duUHJvbWlzZUNhcGFiaWxpdHkgPSBmdW5jdGlvbiAoKSB7XG4gICAgdmFyIHByb21pc2UgPSBuZXcgSW50ZXJuYWwoKTtcbiAgICB0aGlzLnByb21pc2UgPSBwcm9taXNlO1xuICAgIHRoaXMucmVzb2x2ZSA9IGN0eCgkcmVzb2x2ZSwgcHJvbWlzZSwgMSk7XG4gICAgdGhpcy5yZWplY3QgPSBjdHgoJHJlamVjdCwgcHJvbWlzZSwgMSk7XG4gIH07XG4gIG5ld1Byb21pc2VDYXBhYmlsaXR5TW9kdWxlLmYgPSBuZXdQcm9taXNlQ2FwYWJpbGl0eSA9IGZ1bmN0aW9uIChDKSB7XG4gICAgcmV0dXJuIEMgPT09ICRQcm9taXNlIHx8IEMgPT09IFdyYXBwZXJcbiAgICAgID8gbmV3IE93blByb21pc2VDYXBhYmlsaXR5KEMpXG4gICAgICA6IG5ld0dlbmVyaWNQcm9taXNlQ2FwYWJpbGl0eShDKTtcbiAgfTtcbn1cblxuJGV4cG9ydCgkZXhwb3J0LkcgKyAkZXhwb3J0LlcgKyAkZXhwb3J0LkYgKiAhVVNFX05BVElWRSwgeyBQcm9taXNlOiAkUHJvbWlzZSB9KTtcbnJlcXVpcmUoJy4vX3NldC10by1zdHJpbmctdGFnJykoJFByb21pc2UsIFBST01JU0UpO1xucmVxdWlyZSgnLi9fc2V0LXNwZWNpZXMnKShQUk9NSVNFKTtcbldyYXBwZXIgPSByZXF1aXJlKCcuL19jb3JlJylbUFJPTUlTRV07XG5cbi8vIHN0YXRpY3NcbiRleHBvcnQoJGV4cG9ydC5TICsgJGV4cG9ydC5GICogIVVTRV9OQVRJVkUsIFBST01JU0UsIHtcbiAgLy8gMjUuNC40LjUgUHJvbWlzZS5yZWplY3QocilcbiAgcmVqZWN0OiBmdW5jdGlvbiByZWplY3Qocikge1xuICAgIHZhciBjYXBhYmlsaXR5ID0gbmV3UHJvbWlzZUNhcGFiaWxpdHkodGhpcyk7XG4gICAgdmFyICQkcmVqZWN0ID0gY2FwYWJpbGl0eS5yZWplY3Q7XG4gICAgJCRyZWplY3Qocik7XG4gICAgcmV0dXJuIGNhcGFiaWxpdHkucHJvbWlzZTtcbiAgfVxufSk7XG4kZXhwb3J0KCRleHBvcnQuUyArICRleHBvcnQuRiAqIChMSUJSQVJZIHx8ICFVU0VfTkFUSVZFKSwgUFJPTUlTRSwge1xuICAvLyAyNS40LjQuNiBQcm9taXNlLnJlc29sdmUoeClcbiAgcmVzb2x2ZTogZnVuY3Rpb24gcmVzb2x2ZSh4KSB7XG4gICAgcmV0dXJuIHByb21pc2VSZXNvbHZlKExJQlJBUlkgJiYgdGhpcyA9PT0gV3JhcHBlciA/ICRQcm9taXNlIDogdGhpcywgeCk7XG4gIH1cbn0pO1xuJGV4cG9ydCgkZXhwb3J0LlMgKyAkZXhwb3J0LkYgKiAhKFVTRV9OQVRJVkUgJiYgcmVxdWlyZSgnLi9faXRlci1kZXRlY3QnKShmdW5jdGlvbiAoaXRlcikge1xuICAkUHJvbWlzZS5hbGwoaXRlcilbJ2NhdGNoJ10oZW1wdHkpO1xufSkpLCBQUk9NSVNFLCB7XG4gIC8vIDI1LjQuNC4xIFByb21pc2UuYWxsKGl0ZXJhYmxlKVxuICBhbGw6IGZ1bmN0aW9uIGFsbChpdGVyYWJsZSkge1xuICAgIHZhciBDID0gdGhpcztcbiAgICB2YXIgY2FwYWJpbGl0eSA9IG5ld1Byb21pc2VDYXBhYmlsaXR5KEMpO1xuICAgIHZhciByZXNvbHZlID0gY2FwYWJpbGl0eS5yZXNvbHZlO1xuICAgIHZhciByZWplY3QgPSBjYXBhYmlsaXR5LnJlamVjdDtcbiAgICB2YXIgcmVzdWx0ID0gcGVyZm9ybShmdW5jdGlvbiAoKSB7XG4gICAgICB2YXIgdmFsdWVzID0gW107XG4gICAgICB2YXIgaW5kZXggPSAwO1xuICAgICAgdmFyIHJlbWFpbmluZyA9IDE7XG4gICAgICBmb3JPZihpdGVyYWJsZSwgZmFsc2UsIGZ1bmN0aW9uIChwcm9taXNlKSB7XG4gICAgICAgIHZhciAkaW5kZXggPSBpbmRleCsrO1xuICAgICAgICB2YXIgYWxyZWFkeUNhbGxlZCA9IGZhbHNlO1xuICAgICAgICB2YWx1ZXMucHVzaCh1bmRlZmluZWQpO1xuICAgICAgICByZW1haW5pbmcrKztcbiAgICAgICAgQy5yZXNvbHZlKHByb21pc2UpLnRoZW4oZnVuY3Rpb24gKHZhbHVlKSB7XG4gICAgICAgICAgaWYgKGFscmVhZHlDYWxsZWQpIHJldHVybjtcbiAgICAgICAgICBhbHJlYWR5Q2FsbGVkID0gdHJ1ZTtcbiAgICAgICAgICB2YWx1ZXNbJGluZGV4XSA9IHZhbHVlO1xuICAgICAgICAgIC0tcmVtYWluaW5nIHx8IHJlc29sdmUodmFsdWVzKTtcbiAgICAgICAgfSwgcmVqZWN0KTtcbiAgICAgIH0pO1xuICAgICAgLS1yZW1haW5pbmcgfHwgcmVzb2x2ZSh2YWx1ZXMpO1xuICAgIH0pO1xuICAgIGlmIChyZXN1bHQuZSkgcmVqZWN0KHJlc3VsdC52KTtcbiAgICByZXR1cm4gY2FwYWJpbGl0eS5wcm9taXNlO1xuICB9LFxuICAvLyAyNS40LjQuNCBQcm9taXNlLnJhY2UoaXRlcmFibGUpXG4gIHJhY2U6IGZ1bmN0aW9uIHJhY2UoaXRlcmFibGUpIHtcbiAgICB2YXIgQyA9IHRoaXM7XG4gICAgdmFyIGNhcGFiaWxpdHkgPSBuZXdQcm9taXNlQ2FwYWJpbGl0eShDKTtcbiAgICB2YXIgcmVqZWN0ID0gY2FwYWJpbGl0eS5yZWplY3Q7XG4gICAgdmFyIHJlc3VsdCA9IHBlcmZvcm0oZnVuY3Rpb24gKCkge1xuICAgICAgZm9yT2YoaXRlcmFibGUsIGZhbHNlLCBmdW5jdGlvbiAocHJvbWlzZSkge1xuICAgICAgICBDLnJlc29sdmUocHJvbWlzZSkudGhlbihjYXBhYmlsaXR5LnJlc29sdmUsIHJlamVjdCk7XG4gICAgICB9KTtcbiAgICB9KTtcbiAgICBpZiAocmVzdWx0LmUpIHJlamVjdChyZXN1bHQudik7XG4gICAgcmV0dXJuIGNhcGFiaWxpdHkucHJvbWlzZTtcbiAgfVxufSk7XG4iLCJ2YXIgY29yZSA9IHJlcXVpcmUoJy4vX2NvcmUnKTtcbnZhciBnbG9iYWwgPSByZXF1aXJlKCcuL19nbG9iYWwnKTtcbnZhciBTSEFSRUQgPSAnX19jb3JlLWpzX3NoYXJlZF9fJztcbnZhciBzdG9yZSA9IGdsb2JhbFtTSEFSRURdIHx8IChnbG9iYWxbU0hBUkVEXSA9IHt9KTtcblxuKG1vZHVsZS5leHBvcnRzID0gZnVuY3Rpb24gKGtleSwgdmFsdWUpIHtcbiAgcmV0dXJuIHN0b3JlW2tleV0gfHwgKHN0b3JlW2tleV0gPSB2YWx1ZSAhPT0gdW5kZWZpbmVkID8gdmFsdWUgOiB7fSk7XG59KSgndmVyc2lvbnMnLCBbXSkucHVzaCh7XG4gIHZlcnNpb246IGNvcmUudmVyc2lvbixcbiAgbW9kZTogcmVxdWlyZSgnLi9fbGlicmFyeScpID8gJ3B1cmUnIDogJ2dsb2JhbCcsXG4gIGNvcHlyaWdodDogJ8KpIDIwMTggRGVuaXMgUHVzaGthcmV2ICh6bG9pcm9jay5ydSknXG59KTtcbiIsInZhciBnbG9iYWwgPSByZXF1aXJlKCcuL19nbG9iYWwnKTtcbnZhciBjb3JlID0gcmVxdWlyZSgnLi9fY29yZScpO1xudmFyIGhpZGUgPSByZXF1aXJlKCcuL19oaWRlJyk7XG52YXIgcmVkZWZpbmUgPSByZXF1aXJlKCcuL19yZWRlZmluZScpO1xudmFyIGN0eCA9IHJlcXVpcmUoJy4vX2N0eCcpO1xudmFyIFBST1RPVFlQRSA9ICdwcm90b3R5cGUnO1xuXG52YXIgJGV4cG9ydCA9IGZ1bmN0aW9uICh0eXBlLCBuYW1lLCBzb3VyY2UpIHtcbiAgdmFyIElTX0ZPUkNFRCA9IHR5cGUgJiAkZXhwb3J0LkY7XG4gIHZhciBJU19HTE9CQUwgPSB0eXBlICYgJGV4cG9ydC5HO1xuICB2YXIgSVNfU1RBVElDID0gdHlwZSAmICRleHBvcnQuUztcbiAgdmFyIElTX1BST1RPID0gdHlwZSAmICRleHBvcnQuUDtcbiAgdmFyIElTX0JJTkQgPSB0eXBlICYgJGV4cG9ydC5CO1xuICB2YXIgdGFyZ2V0ID0gSVNfR0xPQkFMID8gZ2xvYmFsIDogSVNfU1RBVElDID8gZ2xvYmFsW25hbWVdIHx8IChnbG9iYWxbbmFtZV0gPSB7fSkgOiAoZ2xvYmFsW25hbWVdIHx8IHt9KVtQUk9UT1RZUEVdO1xuICB2YXIgZXhwb3J0cyA9IElTX0dMT0JBTCA/IGNvcmUgOiBjb3JlW25hbWVdIHx8IChjb3JlW25hbWVdID0ge30pO1xuICB2YXIgZXhwUHJvdG8gPSBleHBvcnRzW1BST1RPVFlQRV0gfHwgKGV4cG9ydHNbUFJPVE9UWVBFXSA9IHt9KTtcbiAgdmFyIGtleSwgb3duLCBvdXQsIGV4cDtcbiAgaWYgKElTX0dMT0JBTCkgc291cmNlID0gbmFtZTtcbiAgZm9yIChrZXkgaW4gc291cmNlKSB7XG4gICAgLy8gY29udGFpbnMgaW4gbmF0aXZlXG4gICAgb3duID0gIUlTX0ZPUkNFRCAmJiB0YXJnZXQgJiYgdGFyZ2V0W2tleV0gIT09IHVuZGVmaW5lZDtcbiAgICAvLyBleHBvcnQgbmF0aXZlIG9yIHBhc3NlZFxuICAgIG91dCA9IChvd24gPyB0YXJnZXQgOiBzb3VyY2UpW2tleV07XG4gICAgLy8gYmluZCB0aW1lcnMgdG8gZ2xvYmFsIGZvciBjYWxsIGZyb20gZXhwb3J0IGNvbnRleHRcbiAgICBleHAgPSBJU19CSU5EICYmIG93biA/IGN0eChvdXQsIGdsb2JhbCkgOiBJU19QUk9UTyAmJiB0eXBlb2Ygb3V0ID09ICdmdW5jdGlvbicgPyBjdHgoRnVuY3Rpb24uY2FsbCwgb3V0KSA6IG91dDtcbiAgICAvLyBleHRlbmQgZ2xvYmFsXG4gICAgaWYgKHRhcmdldCkgcmVkZWZpbmUodGFyZ2V0LCBrZXksIG91dCwgdHlwZSAmICRleHBvcnQuVSk7XG4gICAgLy8gZXhwb3J0XG4gICAgaWYgKGV4cG9ydHNba2V5XSAhPSBvdXQpIGhpZGUoZXhwb3J0cywga2V5LCBleHApO1xuICAgIGlmIChJU19QUk9UTyAmJiBleHBQcm90b1trZXldICE9IG91dCkgZXhwUHJvdG9ba2V5XSA9IG91dDtcbiAgfVxufTtcbmdsb2JhbC5jb3JlID0gY29yZTtcbi8vIHR5cGUgYml0bWFwXG4kZXhwb3J0LkYgPSAxOyAgIC8vIGZvcmNlZFxuJGV4cG9ydC5HID0gMjsgICAvLyBnbG9iYWxcbiRleHBvcnQuUyA9IDQ7ICAgLy8gc3RhdGljXG4kZXhwb3J0LlAgPSA4OyAgIC8vIHByb3RvXG4kZXhwb3J0LkIgPSAxNjsgIC8vIGJpbmRcbiRleHBvcnQuVyA9IDMyOyAgLy8gd3JhcFxuJGV4cG9ydC5VID0gNjQ7ICAvLyBzYWZlXG4kZXhwb3J0LlIgPSAxMjg7IC8vIHJlYWwgcHJvdG8gbWV0aG9kIGZvciBgbGlicmFyeWBcbm1vZHVsZS5leHBvcnRzID0gJGV4cG9ydDtcbiIsInZhciBJVEVSQVRPUiA9IHJlcXVpcmUoJy4vX3drcycpKCdpdGVyYXRvcicpO1xudmFyIFNBRkVfQ0xPU0lORyA9IGZhbHNlO1xuXG50cnkge1xuICB2YXIgcml0ZXIgPSBbN11bSVRFUkFUT1JdKCk7XG4gIHJpdGVyWydyZXR1cm4nXSA9IGZ1bmN0aW9uICgpIHsgU0FGRV9DTE9TSU5HID0gdHJ1ZTsgfTtcbiAgLy8gZXNsaW50LWRpc2FibGUtbmV4dC1saW5lIG5vLXRocm93LWxpdGVyYWxcbiAgQXJyYXkuZnJvbShyaXRlciwgZnVuY3Rpb24gKCkgeyB0aHJvdyAyOyB9KTtcbn0gY2F0Y2ggKGUpIHsgLyogZW1wdHkgKi8gfVxuXG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChleGVjLCBza2lwQ2xvc2luZykge1xuICBpZiAoIXNraXBDbG9zaW5nICYmICFTQUZFX0NMT1NJTkcpIHJldHVybiBmYWxzZTtcbiAgdmFyIHNhZmUgPSBmYWxzZTtcbiAgdHJ5IHtcbiAgICB2YXIgYXJyID0gWzddO1xuICAgIHZhciBpdGVyID0gYXJyW0lURVJBVE9SXSgpO1xuICAgIGl0ZXIubmV4dCA9IGZ1bmN0aW9uICgpIHsgcmV0dXJuIHsgZG9uZTogc2FmZSA9IHRydWUgfTsgfTtcbiAgICBhcnJbSVRFUkFUT1JdID0gZnVuY3Rpb24gKCkgeyByZXR1cm4gaXRlcjsgfTtcbiAgICBleGVjKGFycik7XG4gIH0gY2F0Y2ggKGUpIHsgLyogZW1wdHkgKi8gfVxuICByZXR1cm4gc2FmZTtcbn07XG4iLCJ2YXIgc2hhcmVkID0gcmVxdWlyZSgnLi9fc2hhcmVkJykoJ2tleXMnKTtcbnZhciB1aWQgPSByZXF1aXJlKCcuL191aWQnKTtcbm1vZHVsZS5leHBvcnRzID0gZnVuY3Rpb24gKGtleSkge1xuICByZXR1cm4gc2hhcmVkW2tleV0gfHwgKHNoYXJlZFtrZXldID0gdWlkKGtleSkpO1xufTtcbiIsIi8vIGZhbGxiYWNrIGZvciBub24tYXJyYXktbGlrZSBFUzMgYW5kIG5vbi1lbnVtZXJhYmxlIG9sZCBWOCBzdHJpbmdzXG52YXIgY29mID0gcmVxdWlyZSgnLi9fY29mJyk7XG4vLyBlc2xpbnQtZGlzYWJsZS1uZXh0LWxpbmUgbm8tcHJvdG90eXBlLWJ1aWx0aW5zXG5tb2R1bGUuZXhwb3J0cyA9IE9iamVjdCgneicpLnByb3BlcnR5SXNFbnVtZXJhYmxlKDApID8gT2JqZWN0IDogZnVuY3Rpb24gKGl0KSB7XG4gIHJldHVybiBjb2YoaXQpID09ICdTdHJpbmcnID8gaXQuc3BsaXQoJycpIDogT2JqZWN0KGl0KTtcbn07XG4iLCIvLyB0byBpbmRleGVkIG9iamVjdCwgdG9PYmplY3Qgd2l0aCBmYWxsYmFjayBmb3Igbm9uLWFycmF5LWxpa2UgRVMzIHN0cmluZ3NcbnZhciBJT2JqZWN0ID0gcmVxdWlyZSgnLi9faW9iamVjdCcpO1xudmFyIGRlZmluZWQgPSByZXF1aXJlKCcuL19kZWZpbmVkJyk7XG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChpdCkge1xuICByZXR1cm4gSU9iamVjdChkZWZpbmVkKGl0KSk7XG59O1xuIiwidmFyIGhhc093blByb3BlcnR5ID0ge30uaGFzT3duUHJvcGVydHk7XG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChpdCwga2V5KSB7XG4gIHJldHVybiBoYXNPd25Qcm9wZXJ0eS5jYWxsKGl0LCBrZXkpO1xufTtcbiIsIi8vIDcuMS4xIFRvUHJpbWl0aXZlKGlucHV0IFssIFByZWZlcnJlZFR5cGVdKVxudmFyIGlzT2JqZWN0ID0gcmVxdWlyZSgnLi9faXMtb2JqZWN0Jyk7XG4vLyBpbnN0ZWFkIG9mIHRoZSBFUzYgc3BlYyB2ZXJzaW9uLCB3ZSBkaWRuJ3QgaW1wbGVtZW50IEBAdG9QcmltaXRpdmUgY2FzZVxuLy8gYW5kIHRoZSBzZWNvbmQgYXJndW1lbnQgLSBmbGFnIC0gcHJlZmVycmVkIHR5cGUgaXMgYSBzdHJpbmdcbm1vZHVsZS5leHBvcnRzID0gZnVuY3Rpb24gKGl0LCBTKSB7XG4gIGlmICghaXNPYmplY3QoaXQpKSByZXR1cm4gaXQ7XG4gIHZhciBmbiwgdmFsO1xuICBpZiAoUyAmJiB0eXBlb2YgKGZuID0gaXQudG9TdHJpbmcpID09ICdmdW5jdGlvbicgJiYgIWlzT2JqZWN0KHZhbCA9IGZuLmNhbGwoaXQpKSkgcmV0dXJuIHZhbDtcbiAgaWYgKHR5cGVvZiAoZm4gPSBpdC52YWx1ZU9mKSA9PSAnZnVuY3Rpb24nICYmICFpc09iamVjdCh2YWwgPSBmbi5jYWxsKGl0KSkpIHJldHVybiB2YWw7XG4gIGlmICghUyAmJiB0eXBlb2YgKGZuID0gaXQudG9TdHJpbmcpID09ICdmdW5jdGlvbicgJiYgIWlzT2JqZWN0KHZhbCA9IGZuLmNhbGwoaXQpKSkgcmV0dXJuIHZhbDtcbiAgdGhyb3cgVHlwZUVycm9yKFwiQ2FuJ3QgY29udmVydCBvYmplY3QgdG8gcHJpbWl0aXZlIHZhbHVlXCIpO1xufTtcbiIsIi8vIGh0dHBzOi8vZ2l0aHViLmNvbS96bG9pcm9jay9jb3JlLWpzL2lzc3Vlcy84NiNpc3N1ZWNvbW1lbnQtMTE1NzU5MDI4XG52YXIgZ2xvYmFsID0gbW9kdWxlLmV4cG9ydHMgPSB0eXBlb2Ygd2luZG93ICE9ICd1bmRlZmluZWQnICYmIHdpbmRvdy5NYXRoID09IE1hdGhcbiAgPyB3aW5kb3cgOiB0eXBlb2Ygc2VsZiAhPSAndW5kZWZpbmVkJyAmJiBzZWxmLk1hdGggPT0gTWF0aCA/IHNlbGZcbiAgLy8gZXNsaW50LWRpc2FibGUtbmV4dC1saW5lIG5vLW5ldy1mdW5jXG4gIDogRnVuY3Rpb24oJ3JldHVybiB0aGlzJykoKTtcbmlmICh0eXBlb2YgX19nID09ICdudW1iZXInKSBfX2cgPSBnbG9iYWw7IC8vIGVzbGludC1kaXNhYmxlLWxpbmUgbm8tdW5kZWZcbiIsInZhciB0b0ludGVnZXIgPSByZXF1aXJlKCcuL190by1pbnRlZ2VyJyk7XG52YXIgbWF4ID0gTWF0aC5tYXg7XG52YXIgbWluID0gTWF0aC5taW47XG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChpbmRleCwgbGVuZ3RoKSB7XG4gIGluZGV4ID0gdG9JbnRlZ2VyKGluZGV4KTtcbiAgcmV0dXJuIGluZGV4IDwgMCA/IG1heChpbmRleCArIGxlbmd0aCwgMCkgOiBtaW4oaW5kZXgsIGxlbmd0aCk7XG59O1xuIiwibW9kdWxlLmV4cG9ydHMgPSBmdW5jdGlvbiAoZXhlYykge1xuICB0cnkge1xuICAgIHJldHVybiAhIWV4ZWMoKTtcbiAgfSBjYXRjaCAoZSkge1xuICAgIHJldHVybiB0cnVlO1xuICB9XG59O1xuIiwiJ3VzZSBzdHJpY3QnO1xudmFyIGdsb2JhbCA9IHJlcXVpcmUoJy4vX2dsb2JhbCcpO1xudmFyIGRQID0gcmVxdWlyZSgnLi9fb2JqZWN0LWRwJyk7XG52YXIgREVTQ1JJUFRPUlMgPSByZXF1aXJlKCcuL19kZXNjcmlwdG9ycycpO1xudmFyIFNQRUNJRVMgPSByZXF1aXJlKCcuL193a3MnKSgnc3BlY2llcycpO1xuXG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChLRVkpIHtcbiAgdmFyIEMgPSBnbG9iYWxbS0VZXTtcbiAgaWYgKERFU0NSSVBUT1JTICYmIEMgJiYgIUNbU1BFQ0lFU10pIGRQLmYoQywgU1BFQ0lFUywge1xuICAgIGNvbmZpZ3VyYWJsZTogdHJ1ZSxcbiAgICBnZXQ6IGZ1bmN0aW9uICgpIHsgcmV0dXJuIHRoaXM7IH1cbiAgfSk7XG59O1xuIiwidmFyIGRlZiA9IHJlcXVpcmUoJy4vX29iamVjdC1kcCcpLmY7XG52YXIgaGFzID0gcmVxdWlyZSgnLi9faGFzJyk7XG52YXIgVEFHID0gcmVxdWlyZSgnLi9fd2tzJykoJ3RvU3RyaW5nVGFnJyk7XG5cbm1vZHVsZS5leHBvcnRzID0gZnVuY3Rpb24gKGl0LCB0YWcsIHN0YXQpIHtcbiAgaWYgKGl0ICYmICFoYXMoaXQgPSBzdGF0ID8gaXQgOiBpdC5wcm90b3R5cGUsIFRBRykpIGRlZihpdCwgVEFHLCB7IGNvbmZpZ3VyYWJsZTogdHJ1ZSwgdmFsdWU6IHRhZyB9KTtcbn07XG4iLCJ2YXIgZFAgPSByZXF1aXJlKCcuL19vYmplY3QtZHAnKS5mO1xudmFyIEZQcm90byA9IEZ1bmN0aW9uLnByb3RvdHlwZTtcbnZhciBuYW1lUkUgPSAvXlxccypmdW5jdGlvbiAoW14gKF0qKS87XG52YXIgTkFNRSA9ICduYW1lJztcblxuLy8gMTkuMi40LjIgbmFtZVxuTkFNRSBpbiBGUHJvdG8gfHwgcmVxdWlyZSgnLi9fZGVzY3JpcHRvcnMnKSAmJiBkUChGUHJvdG8sIE5BTUUsIHtcbiAgY29uZmlndXJhYmxlOiB0cnVlLFxuICBnZXQ6IGZ1bmN0aW9uICgpIHtcbiAgICB0cnkge1xuICAgICAgcmV0dXJuICgnJyArIHRoaXMpLm1hdGNoKG5hbWVSRSlbMV07XG4gICAgfSBjYXRjaCAoZSkge1xuICAgICAgcmV0dXJuICcnO1xuICAgIH1cbiAgfVxufSk7XG4iLCJ2YXIgZ2xvYmFsID0gcmVxdWlyZSgnLi9fZ2xvYmFsJyk7XG52YXIgbWFjcm90YXNrID0gcmVxdWlyZSgnLi9fdGFzaycpLnNldDtcbnZhciBPYnNlcnZlciA9IGdsb2JhbC5NdXRhdGlvbk9ic2VydmVyIHx8IGdsb2JhbC5XZWJLaXRNdXRhdGlvbk9ic2VydmVyO1xudmFyIHByb2Nlc3MgPSBnbG9iYWwucHJvY2VzcztcbnZhciBQcm9taXNlID0gZ2xvYmFsLlByb21pc2U7XG52YXIgaXNOb2RlID0gcmVxdWlyZSgnLi9fY29mJykocHJvY2VzcykgPT0gJ3Byb2Nlc3MnO1xuXG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uICgpIHtcbiAgdmFyIGhlYWQsIGxhc3QsIG5vdGlmeTtcblxuICB2YXIgZmx1c2ggPSBmdW5jdGlvbiAoKSB7XG4gICAgdmFyIHBhcmVudCwgZm47XG4gICAgaWYgKGlzTm9kZSAmJiAocGFyZW50ID0gcHJvY2Vzcy5kb21haW4pKSBwYXJlbnQuZXhpdCgpO1xuICAgIHdoaWxlIChoZWFkKSB7XG4gICAgICBmbiA9IGhlYWQuZm47XG4gICAgICBoZWFkID0gaGVhZC5uZXh0O1xuICAgICAgdHJ5IHtcbiAgICAgICAgZm4oKTtcbiAgICAgIH0gY2F0Y2ggKGUpIHtcbiAgICAgICAgaWYgKGhlYWQpIG5vdGlmeSgpO1xuICAgICAgICBlbHNlIGxhc3QgPSB1bmRlZmluZWQ7XG4gICAgICAgIHRocm93IGU7XG4gICAgICB9XG4gICAgfSBsYXN0ID0gdW5kZWZpbmVkO1xuICAgIGlmIChwYXJlbnQpIHBhcmVudC5lbnRlcigpO1xuICB9O1xuXG4gIC8vIE5vZGUuanNcbiAgaWYgKGlzTm9kZSkge1xuICAgIG5vdGlmeSA9IGZ1bmN0aW9uICgpIHtcbiAgICAgIHByb2Nlc3MubmV4dFRpY2soZmx1c2gpO1xuICAgIH07XG4gIC8vIGJyb3dzZXJzIHdpdGggTXV0YXRpb25PYnNlcnZlciwgZXhjZXB0IGlPUyBTYWZhcmkgLSBodHRwczovL2dpdGh1Yi5jb20vemxvaXJvY2svY29yZS1qcy9pc3N1ZXMvMzM5XG4gIH0gZWxzZSBpZiAoT2JzZXJ2ZXIgJiYgIShnbG9iYWwubmF2aWdhdG9yICYmIGdsb2JhbC5uYXZpZ2F0b3Iuc3RhbmRhbG9uZSkpIHtcbiAgICB2YXIgdG9nZ2xlID0gdHJ1ZTtcbiAgICB2YXIgbm9kZSA9IGRvY3VtZW50LmNyZWF0ZVRleHROb2RlKCcnKTtcbiAgICBuZXcgT2JzZXJ2ZXIoZmx1c2gpLm9ic2VydmUobm9kZSwgeyBjaGFyYWN0ZXJEYXRhOiB0cnVlIH0pOyAvLyBlc2xpbnQtZGlzYWJsZS1saW5lIG5vLW5ld1xuICAgIG5vdGlmeSA9IGZ1bmN0aW9uICgpIHtcbiAgICAgIG5vZGUuZGF0YSA9IHRvZ2dsZSA9ICF0b2dnbGU7XG4gICAgfTtcbiAgLy8gZW52aXJvbm1lbnRzIHdpdGggbWF5YmUgbm9uLWNvbXBsZXRlbHkgY29ycmVjdCwgYnV0IGV4aXN0ZW50IFByb21pc2VcbiAgfSBlbHNlIGlmIChQcm9taXNlICYmIFByb21pc2UucmVzb2x2ZSkge1xuICAgIC8vIFByb21pc2UucmVzb2x2ZSB3aXRob3V0IGFuIGFyZ3VtZW50IHRocm93cyBhbiBlcnJvciBpbiBMRyBXZWJPUyAyXG4gICAgdmFyIHByb21pc2UgPSBQcm9taXNlLnJlc29sdmUodW5kZWZpbmVkKTtcbiAgICBub3RpZnkgPSBmdW5jdGlvbiAoKSB7XG4gICAgICBwcm9taXNlLnRoZW4oZmx1c2gpO1xuICAgIH07XG4gIC8vIGZvciBvdGhlciBlbnZpcm9ubWVudHMgLSBtYWNyb3Rhc2sgYmFzZWQgb246XG4gIC8vIC0gc2V0SW1tZWRpYXRlXG4gIC8vIC0gTWVzc2FnZUNoYW5uZWxcbiAgLy8gLSB3aW5kb3cucG9zdE1lc3NhZ1xuICAvLyAtIG9ucmVhZHlzdGF0ZWNoYW5nZVxuICAvLyAtIHNldFRpbWVvdXRcbiAgfSBlbHNlIHtcbiAgICBub3RpZnkgPSBmdW5jdGlvbiAoKSB7XG4gICAgICAvLyBzdHJhbmdlIElFICsgd2VicGFjayBkZXYgc2VydmVyIGJ1ZyAtIHVzZSAuY2FsbChnbG9iYWwpXG4gICAgICBtYWNyb3Rhc2suY2FsbChnbG9iYWwsIGZsdXNoKTtcbiAgICB9O1xuICB9XG5cbiAgcmV0dXJuIGZ1bmN0aW9uIChmbikge1xuICAgIHZhciB0YXNrID0geyBmbjogZm4sIG5leHQ6IHVuZGVmaW5lZCB9O1xuICAgIGlmIChsYXN0KSBsYXN0Lm5leHQgPSB0YXNrO1xuICAgIGlmICghaGVhZCkge1xuICAgICAgaGVhZCA9IHRhc2s7XG4gICAgICBub3RpZnkoKTtcbiAgICB9IGxhc3QgPSB0YXNrO1xuICB9O1xufTtcbiIsInZhciBjb3JlID0gbW9kdWxlLmV4cG9ydHMgPSB7IHZlcnNpb246ICcyLjUuNycgfTtcbmlmICh0eXBlb2YgX19lID09ICdudW1iZXInKSBfX2UgPSBjb3JlOyAvLyBlc2xpbnQtZGlzYWJsZS1saW5lIG5vLXVuZGVmXG4iLCJtb2R1bGUuZXhwb3J0cyA9IHt9O1xuIiwidmFyIGFuT2JqZWN0ID0gcmVxdWlyZSgnLi9fYW4tb2JqZWN0Jyk7XG52YXIgSUU4X0RPTV9ERUZJTkUgPSByZXF1aXJlKCcuL19pZTgtZG9tLWRlZmluZScpO1xudmFyIHRvUHJpbWl0aXZlID0gcmVxdWlyZSgnLi9fdG8tcHJpbWl0aXZlJyk7XG52YXIgZFAgPSBPYmplY3QuZGVmaW5lUHJvcGVydHk7XG5cbmV4cG9ydHMuZiA9IHJlcXVpcmUoJy4vX2Rlc2NyaXB0b3JzJykgPyBPYmplY3QuZGVmaW5lUHJvcGVydHkgOiBmdW5jdGlvbiBkZWZpbmVQcm9wZXJ0eShPLCBQLCBBdHRyaWJ1dGVzKSB7XG4gIGFuT2JqZWN0KE8pO1xuICBQID0gdG9QcmltaXRpdmUoUCwgdHJ1ZSk7XG4gIGFuT2JqZWN0KEF0dHJpYnV0ZXMpO1xuICBpZiAoSUU4X0RPTV9ERUZJTkUpIHRyeSB7XG4gICAgcmV0dXJuIGRQKE8sIFAsIEF0dHJpYnV0ZXMpO1xuICB9IGNhdGNoIChlKSB7IC8qIGVtcHR5ICovIH1cbiAgaWYgKCdnZXQnIGluIEF0dHJpYnV0ZXMgfHwgJ3NldCcgaW4gQXR0cmlidXRlcykgdGhyb3cgVHlwZUVycm9yKCdBY2Nlc3NvcnMgbm90IHN1cHBvcnRlZCEnKTtcbiAgaWYgKCd2YWx1ZScgaW4gQXR0cmlidXRlcykgT1tQXSA9IEF0dHJpYnV0ZXMudmFsdWU7XG4gIHJldHVybiBPO1xufTtcbiIsIi8vIG9wdGlvbmFsIC8gc2ltcGxlIGNvbnRleHQgYmluZGluZ1xudmFyIGFGdW5jdGlvbiA9IHJlcXVpcmUoJy4vX2EtZnVuY3Rpb24nKTtcbm1vZHVsZS5leHBvcnRzID0gZnVuY3Rpb24gKGZuLCB0aGF0LCBsZW5ndGgpIHtcbiAgYUZ1bmN0aW9uKGZuKTtcbiAgaWYgKHRoYXQgPT09IHVuZGVmaW5lZCkgcmV0dXJuIGZuO1xuICBzd2l0Y2ggKGxlbmd0aCkge1xuICAgIGNhc2UgMTogcmV0dXJuIGZ1bmN0aW9uIChhKSB7XG4gICAgICByZXR1cm4gZm4uY2FsbCh0aGF0LCBhKTtcbiAgICB9O1xuICAgIGNhc2UgMjogcmV0dXJuIGZ1bmN0aW9uIChhLCBiKSB7XG4gICAgICByZXR1cm4gZm4uY2FsbCh0aGF0LCBhLCBiKTtcbiAgICB9O1xuICAgIGNhc2UgMzogcmV0dXJuIGZ1bmN0aW9uIChhLCBiLCBjKSB7XG4gICAgICByZXR1cm4gZm4uY2FsbCh0aGF0LCBhLCBiLCBjKTtcbiAgICB9O1xuICB9XG4gIHJldHVybiBmdW5jdGlvbiAoLyogLi4uYXJncyAqLykge1xuICAgIHJldHVybiBmbi5hcHBseSh0aGF0LCBhcmd1bWVudHMpO1xuICB9O1xufTtcbiIsIi8vIDIyLjEuMy4zMSBBcnJheS5wcm90b3R5cGVbQEB1bnNjb3BhYmxlc11cbnZhciBVTlNDT1BBQkxFUyA9IHJlcXVpcmUoJy4vX3drcycpKCd1bnNjb3BhYmxlcycpO1xudmFyIEFycmF5UHJvdG8gPSBBcnJheS5wcm90b3R5cGU7XG5pZiAoQXJyYXlQcm90b1tVTlNDT1BBQkxFU10gPT0gdW5kZWZpbmVkKSByZXF1aXJlKCcuL19oaWRlJykoQXJyYXlQcm90bywgVU5TQ09QQUJMRVMsIHt9KTtcbm1vZHVsZS5leHBvcnRzID0gZnVuY3Rpb24gKGtleSkge1xuICBBcnJheVByb3RvW1VOU0NPUEFCTEVTXVtrZXldID0gdHJ1ZTtcbn07XG4iLCJtb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChleGVjKSB7XG4gIHRyeSB7XG4gICAgcmV0dXJuIHsgZTogZmFsc2UsIHY6IGV4ZWMoKSB9O1xuICB9IGNhdGNoIChlKSB7XG4gICAgcmV0dXJuIHsgZTogdHJ1ZSwgdjogZSB9O1xuICB9XG59O1xuIiwiLy8gNy4xLjE1IFRvTGVuZ3RoXG52YXIgdG9JbnRlZ2VyID0gcmVxdWlyZSgnLi9fdG8taW50ZWdlcicpO1xudmFyIG1pbiA9IE1hdGgubWluO1xubW9kdWxlLmV4cG9ydHMgPSBmdW5jdGlvbiAoaXQpIHtcbiAgcmV0dXJuIGl0ID4gMCA/IG1pbih0b0ludGVnZXIoaXQpLCAweDFmZmZmZmZmZmZmZmZmKSA6IDA7IC8vIHBvdygyLCA1MykgLSAxID09IDkwMDcxOTkyNTQ3NDA5OTFcbn07XG4iLCIvLyBUaGFuaydzIElFOCBmb3IgaGlzIGZ1bm55IGRlZmluZVByb3BlcnR5XG5tb2R1bGUuZXhwb3J0cyA9ICFyZXF1aXJlKCcuL19mYWlscycpKGZ1bmN0aW9uICgpIHtcbiAgcmV0dXJuIE9iamVjdC5kZWZpbmVQcm9wZXJ0eSh7fSwgJ2EnLCB7IGdldDogZnVuY3Rpb24gKCkgeyByZXR1cm4gNzsgfSB9KS5hICE9IDc7XG59KTtcbiIsInZhciBnbG9iYWwgPSByZXF1aXJlKCcuL19nbG9iYWwnKTtcbnZhciBuYXZpZ2F0b3IgPSBnbG9iYWwubmF2aWdhdG9yO1xuXG5tb2R1bGUuZXhwb3J0cyA9IG5hdmlnYXRvciAmJiBuYXZpZ2F0b3IudXNlckFnZW50IHx8ICcnO1xuIiwiJ3VzZSBzdHJpY3QnO1xuLy8gMjUuNC4xLjUgTmV3UHJvbWlzZUNhcGFiaWxpdHkoQylcbnZhciBhRnVuY3Rpb24gPSByZXF1aXJlKCcuL19hLWZ1bmN0aW9uJyk7XG5cbmZ1bmN0aW9uIFByb21pc2VDYXBhYmlsaXR5KEMpIHtcbiAgdmFyIHJlc29sdmUsIHJlamVjdDtcbiAgdGhpcy5wcm9taXNlID0gbmV3IEMoZnVuY3Rpb24gKCQkcmVzb2x2ZSwgJCRyZWplY3QpIHtcbiAgICBpZiAocmVzb2x2ZSAhPT0gdW5kZWZpbmVkIHx8IHJlamVjdCAhPT0gdW5kZWZpbmVkKSB0aHJvdyBUeXBlRXJyb3IoJ0JhZCBQcm9taXNlIGNvbnN0cnVjdG9yJyk7XG4gICAgcmVzb2x2ZSA9ICQkcmVzb2x2ZTtcbiAgICByZWplY3QgPSAkJHJlamVjdDtcbiAgfSk7XG4gIHRoaXMucmVzb2x2ZSA9IGFGdW5jdGlvbihyZXNvbHZlKTtcbiAgdGhpcy5yZWplY3QgPSBhRnVuY3Rpb24ocmVqZWN0KTtcbn1cblxubW9kdWxlLmV4cG9ydHMuZiA9IGZ1bmN0aW9uIChDKSB7XG4gIHJldHVybiBuZXcgUHJvbWlzZUNhcGFiaWxpdHkoQyk7XG59O1xuIiwidmFyIGFuT2JqZWN0ID0gcmVxdWlyZSgnLi9fYW4tb2JqZWN0Jyk7XG52YXIgaXNPYmplY3QgPSByZXF1aXJlKCcuL19pcy1vYmplY3QnKTtcbnZhciBuZXdQcm9taXNlQ2FwYWJpbGl0eSA9IHJlcXVpcmUoJy4vX25ldy1wcm9taXNlLWNhcGFiaWxpdHknKTtcblxubW9kdWxlLmV4cG9ydHMgPSBmdW5jdGlvbiAoQywgeCkge1xuICBhbk9iamVjdChDKTtcbiAgaWYgKGlzT2JqZWN0KHgpICYmIHguY29uc3RydWN0b3IgPT09IEMpIHJldHVybiB4O1xuICB2YXIgcHJvbWlzZUNhcGFiaWxpdHkgPSBuZXdQcm9taXNlQ2FwYWJpbGl0eS5mKEMpO1xuICB2YXIgcmVzb2x2ZSA9IHByb21pc2VDYXBhYmlsaXR5LnJlc29sdmU7XG4gIHJlc29sdmUoeCk7XG4gIHJldHVybiBwcm9taXNlQ2FwYWJpbGl0eS5wcm9taXNlO1xufTtcbiIsIi8vIDcuMi4xIFJlcXVpcmVPYmplY3RDb2VyY2libGUoYXJndW1lbnQpXG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChpdCkge1xuICBpZiAoaXQgPT0gdW5kZWZpbmVkKSB0aHJvdyBUeXBlRXJyb3IoXCJDYW4ndCBjYWxsIG1ldGhvZCBvbiAgXCIgKyBpdCk7XG4gIHJldHVybiBpdDtcbn07XG4iLCIvLyBmYWxzZSAtPiBBcnJheSNpbmRleE9mXG4vLyB0cnVlICAtPiBBcnJheSNpbmNsdWRlc1xudmFyIHRvSU9iamVjdCA9IHJlcXVpcmUoJy4vX3RvLWlvYmplY3QnKTtcbnZhciB0b0xlbmd0aCA9IHJlcXVpcmUoJy4vX3RvLWxlbmd0aCcpO1xudmFyIHRvQWJzb2x1dGVJbmRleCA9IHJlcXVpcmUoJy4vX3RvLWFic29sdXRlLWluZGV4Jyk7XG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChJU19JTkNMVURFUykge1xuICByZXR1cm4gZnVuY3Rpb24gKCR0aGlzLCBlbCwgZnJvbUluZGV4KSB7XG4gICAgdmFyIE8gPSB0b0lPYmplY3QoJHRoaXMpO1xuICAgIHZhciBsZW5ndGggPSB0b0xlbmd0aChPLmxlbmd0aCk7XG4gICAgdmFyIGluZGV4ID0gdG9BYnNvbHV0ZUluZGV4KGZyb21JbmRleCwgbGVuZ3RoKTtcbiAgICB2YXIgdmFsdWU7XG4gICAgLy8gQXJyYXkjaW5jbHVkZXMgdXNlcyBTYW1lVmFsdWVaZXJvIGVxdWFsaXR5IGFsZ29yaXRobVxuICAgIC8vIGVzbGludC1kaXNhYmxlLW5leHQtbGluZSBuby1zZWxmLWNvbXBhcmVcbiAgICBpZiAoSVNfSU5DTFVERVMgJiYgZWwgIT0gZWwpIHdoaWxlIChsZW5ndGggPiBpbmRleCkge1xuICAgICAgdmFsdWUgPSBPW2luZGV4KytdO1xuICAgICAgLy8gZXNsaW50LWRpc2FibGUtbmV4dC1saW5lIG5vLXNlbGYtY29tcGFyZVxuICAgICAgaWYgKHZhbHVlICE9IHZhbHVlKSByZXR1cm4gdHJ1ZTtcbiAgICAvLyBBcnJheSNpbmRleE9mIGlnbm9yZXMgaG9sZXMsIEFycmF5I2luY2x1ZGVzIC0gbm90XG4gICAgfSBlbHNlIGZvciAoO2xlbmd0aCA+IGluZGV4OyBpbmRleCsrKSBpZiAoSVNfSU5DTFVERVMgfHwgaW5kZXggaW4gTykge1xuICAgICAgaWYgKE9baW5kZXhdID09PSBlbCkgcmV0dXJuIElTX0lOQ0xVREVTIHx8IGluZGV4IHx8IDA7XG4gICAgfSByZXR1cm4gIUlTX0lOQ0xVREVTICYmIC0xO1xuICB9O1xufTtcbiIsIm1vZHVsZS5leHBvcnRzID0gIXJlcXVpcmUoJy4vX2Rlc2NyaXB0b3JzJykgJiYgIXJlcXVpcmUoJy4vX2ZhaWxzJykoZnVuY3Rpb24gKCkge1xuICByZXR1cm4gT2JqZWN0LmRlZmluZVByb3BlcnR5KHJlcXVpcmUoJy4vX2RvbS1jcmVhdGUnKSgnZGl2JyksICdhJywgeyBnZXQ6IGZ1bmN0aW9uICgpIHsgcmV0dXJuIDc7IH0gfSkuYSAhPSA3O1xufSk7XG4iLCJ2YXIgZztcblxuLy8gVGhpcyB3b3JrcyBpbiBub24tc3RyaWN0IG1vZGVcbmcgPSAoZnVuY3Rpb24oKSB7XG5cdHJldHVybiB0aGlzO1xufSkoKTtcblxudHJ5IHtcblx0Ly8gVGhpcyB3b3JrcyBpZiBldmFsIGlzIGFsbG93ZWQgKHNlZSBDU1ApXG5cdGcgPSBnIHx8IEZ1bmN0aW9uKFwicmV0dXJuIHRoaXNcIikoKSB8fCAoMSwgZXZhbCkoXCJ0aGlzXCIpO1xufSBjYXRjaCAoZSkge1xuXHQvLyBUaGlzIHdvcmtzIGlmIHRoZSB3aW5kb3cgcmVmZXJlbmNlIGlzIGF2YWlsYWJsZVxuXHRpZiAodHlwZW9mIHdpbmRvdyA9PT0gXCJvYmplY3RcIikgZyA9IHdpbmRvdztcbn1cblxuLy8gZyBjYW4gc3RpbGwgYmUgdW5kZWZpbmVkLCBidXQgbm90aGluZyB0byBkbyBhYm91dCBpdC4uLlxuLy8gV2UgcmV0dXJuIHVuZGVmaW5lZCwgaW5zdGVhZCBvZiBub3RoaW5nIGhlcmUsIHNvIGl0J3Ncbi8vIGVhc2llciB0byBoYW5kbGUgdGhpcyBjYXNlLiBpZighZ2xvYmFsKSB7IC4uLn1cblxubW9kdWxlLmV4cG9ydHMgPSBnO1xuIiwidmFyIGlkID0gMDtcbnZhciBweCA9IE1hdGgucmFuZG9tKCk7XG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChrZXkpIHtcbiAgcmV0dXJuICdTeW1ib2woJy5jb25jYXQoa2V5ID09PSB1bmRlZmluZWQgPyAnJyA6IGtleSwgJylfJywgKCsraWQgKyBweCkudG9TdHJpbmcoMzYpKTtcbn07XG4iLCIndXNlIHN0cmljdCc7XG52YXIgYWRkVG9VbnNjb3BhYmxlcyA9IHJlcXVpcmUoJy4vX2FkZC10by11bnNjb3BhYmxlcycpO1xudmFyIHN0ZXAgPSByZXF1aXJlKCcuL19pdGVyLXN0ZXAnKTtcbnZhciBJdGVyYXRvcnMgPSByZXF1aXJlKCcuL19pdGVyYXRvcnMnKTtcbnZhciB0b0lPYmplY3QgPSByZXF1aXJlKCcuL190by1pb2JqZWN0Jyk7XG5cbi8vIDIyLjEuMy40IEFycmF5LnByb3RvdHlwZS5lbnRyaWVzKClcbi8vIDIyLjEuMy4xMyBBcnJheS5wcm90b3R5cGUua2V5cygpXG4vLyAyMi4xLjMuMjkgQXJyYXkucHJvdG90eXBlLnZhbHVlcygpXG4vLyAyMi4xLjMuMzAgQXJyYXkucHJvdG90eXBlW0BAaXRlcmF0b3JdKClcbm1vZHVsZS5leHBvcnRzID0gcmVxdWlyZSgnLi9faXRlci1kZWZpbmUnKShBcnJheSwgJ0FycmF5JywgZnVuY3Rpb24gKGl0ZXJhdGVkLCBraW5kKSB7XG4gIHRoaXMuX3QgPSB0b0lPYmplY3QoaXRlcmF0ZWQpOyAvLyB0YXJnZXRcbiAgdGhpcy5faSA9IDA7ICAgICAgICAgICAgICAgICAgIC8vIG5leHQgaW5kZXhcbiAgdGhpcy5fayA9IGtpbmQ7ICAgICAgICAgICAgICAgIC8vIGtpbmRcbi8vIDIyLjEuNS4yLjEgJUFycmF5SXRlcmF0b3JQcm90b3R5cGUlLm5leHQoKVxufSwgZnVuY3Rpb24gKCkge1xuICB2YXIgTyA9IHRoaXMuX3Q7XG4gIHZhciBraW5kID0gdGhpcy5faztcbiAgdmFyIGluZGV4ID0gdGhpcy5faSsrO1xuICBpZiAoIU8gfHwgaW5kZXggPj0gTy5sZW5ndGgpIHtcbiAgICB0aGlzLl90ID0gdW5kZWZpbmVkO1xuICAgIHJldHVybiBzdGVwKDEpO1xuICB9XG4gIGlmIChraW5kID09ICdrZXlzJykgcmV0dXJuIHN0ZXAoMCwgaW5kZXgpO1xuICBpZiAoa2luZCA9PSAndmFsdWVzJykgcmV0dXJuIHN0ZXAoMCwgT1tpbmRleF0pO1xuICByZXR1cm4gc3RlcCgwLCBbaW5kZXgsIE9baW5kZXhdXSk7XG59LCAndmFsdWVzJyk7XG5cbi8vIGFyZ3VtZW50c0xpc3RbQEBpdGVyYXRvcl0gaXMgJUFycmF5UHJvdG9fdmFsdWVzJSAoOS40LjQuNiwgOS40LjQuNylcbkl0ZXJhdG9ycy5Bcmd1bWVudHMgPSBJdGVyYXRvcnMuQXJyYXk7XG5cbmFkZFRvVW5zY29wYWJsZXMoJ2tleXMnKTtcbmFkZFRvVW5zY29wYWJsZXMoJ3ZhbHVlcycpO1xuYWRkVG9VbnNjb3BhYmxlcygnZW50cmllcycpO1xuIiwidmFyIGlzT2JqZWN0ID0gcmVxdWlyZSgnLi9faXMtb2JqZWN0Jyk7XG5tb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChpdCkge1xuICBpZiAoIWlzT2JqZWN0KGl0KSkgdGhyb3cgVHlwZUVycm9yKGl0ICsgJyBpcyBub3QgYW4gb2JqZWN0IScpO1xuICByZXR1cm4gaXQ7XG59O1xuIiwidmFyIGhhcyA9IHJlcXVpcmUoJy4vX2hhcycpO1xudmFyIHRvSU9iamVjdCA9IHJlcXVpcmUoJy4vX3RvLWlvYmplY3QnKTtcbnZhciBhcnJheUluZGV4T2YgPSByZXF1aXJlKCcuL19hcnJheS1pbmNsdWRlcycpKGZhbHNlKTtcbnZhciBJRV9QUk9UTyA9IHJlcXVpcmUoJy4vX3NoYXJlZC1rZXknKSgnSUVfUFJPVE8nKTtcblxubW9kdWxlLmV4cG9ydHMgPSBmdW5jdGlvbiAob2JqZWN0LCBuYW1lcykge1xuICB2YXIgTyA9IHRvSU9iamVjdChvYmplY3QpO1xuICB2YXIgaSA9IDA7XG4gIHZhciByZXN1bHQgPSBbXTtcbiAgdmFyIGtleTtcbiAgZm9yIChrZXkgaW4gTykgaWYgKGtleSAhPSBJRV9QUk9UTykgaGFzKE8sIGtleSkgJiYgcmVzdWx0LnB1c2goa2V5KTtcbiAgLy8gRG9uJ3QgZW51bSBidWcgJiBoaWRkZW4ga2V5c1xuICB3aGlsZSAobmFtZXMubGVuZ3RoID4gaSkgaWYgKGhhcyhPLCBrZXkgPSBuYW1lc1tpKytdKSkge1xuICAgIH5hcnJheUluZGV4T2YocmVzdWx0LCBrZXkpIHx8IHJlc3VsdC5wdXNoKGtleSk7XG4gIH1cbiAgcmV0dXJuIHJlc3VsdDtcbn07XG4iLCJtb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChpdCkge1xuICByZXR1cm4gdHlwZW9mIGl0ID09PSAnb2JqZWN0JyA/IGl0ICE9PSBudWxsIDogdHlwZW9mIGl0ID09PSAnZnVuY3Rpb24nO1xufTtcbiIsIm1vZHVsZS5leHBvcnRzID0gZnVuY3Rpb24gKGRvbmUsIHZhbHVlKSB7XG4gIHJldHVybiB7IHZhbHVlOiB2YWx1ZSwgZG9uZTogISFkb25lIH07XG59O1xuIiwibW9kdWxlLmV4cG9ydHMgPSBmdW5jdGlvbiAoaXQpIHtcbiAgaWYgKHR5cGVvZiBpdCAhPSAnZnVuY3Rpb24nKSB0aHJvdyBUeXBlRXJyb3IoaXQgKyAnIGlzIG5vdCBhIGZ1bmN0aW9uIScpO1xuICByZXR1cm4gaXQ7XG59O1xuIiwidmFyIHJlZGVmaW5lID0gcmVxdWlyZSgnLi9fcmVkZWZpbmUnKTtcbm1vZHVsZS5leHBvcnRzID0gZnVuY3Rpb24gKHRhcmdldCwgc3JjLCBzYWZlKSB7XG4gIGZvciAodmFyIGtleSBpbiBzcmMpIHJlZGVmaW5lKHRhcmdldCwga2V5LCBzcmNba2V5XSwgc2FmZSk7XG4gIHJldHVybiB0YXJnZXQ7XG59O1xuIiwiLy8gSUUgOC0gZG9uJ3QgZW51bSBidWcga2V5c1xubW9kdWxlLmV4cG9ydHMgPSAoXG4gICdjb25zdHJ1Y3RvcixoYXNPd25Qcm9wZXJ0eSxpc1Byb3RvdHlwZU9mLHByb3BlcnR5SXNFbnVtZXJhYmxlLHRvTG9jYWxlU3RyaW5nLHRvU3RyaW5nLHZhbHVlT2YnXG4pLnNwbGl0KCcsJyk7XG4iLCIvLyA3LjMuMjAgU3BlY2llc0NvbnN0cnVjdG9yKE8sIGRlZmF1bHRDb25zdHJ1Y3RvcilcbnZhciBhbk9iamVjdCA9IHJlcXVpcmUoJy4vX2FuLW9iamVjdCcpO1xudmFyIGFGdW5jdGlvbiA9IHJlcXVpcmUoJy4vX2EtZnVuY3Rpb24nKTtcbnZhciBTUEVDSUVTID0gcmVxdWlyZSgnLi9fd2tzJykoJ3NwZWNpZXMnKTtcbm1vZHVsZS5leHBvcnRzID0gZnVuY3Rpb24gKE8sIEQpIHtcbiAgdmFyIEMgPSBhbk9iamVjdChPKS5jb25zdHJ1Y3RvcjtcbiAgdmFyIFM7XG4gIHJldHVybiBDID09PSB1bmRlZmluZWQgfHwgKFMgPSBhbk9iamVjdChDKVtTUEVDSUVTXSkgPT0gdW5kZWZpbmVkID8gRCA6IGFGdW5jdGlvbihTKTtcbn07XG4iLCJtb2R1bGUuZXhwb3J0cyA9IGZ1bmN0aW9uIChpdCwgQ29uc3RydWN0b3IsIG5hbWUsIGZvcmJpZGRlbkZpZWxkKSB7XG4gIGlmICghKGl0IGluc3RhbmNlb2YgQ29uc3RydWN0b3IpIHx8IChmb3JiaWRkZW5GaWVsZCAhPT0gdW5kZWZpbmVkICYmIGZvcmJpZGRlbkZpZWxkIGluIGl0KSkge1xuICAgIHRocm93IFR5cGVFcnJvcihuYW1lICsgJzogaW5jb3JyZWN0IGludm9jYXRpb24hJyk7XG4gIH0gcmV0dXJuIGl0O1xufTtcbiIsInZhciBkb2N1bWVudCA9IHJlcXVpcmUoJy4vX2dsb2JhbCcpLmRvY3VtZW50O1xubW9kdWxlLmV4cG9ydHMgPSBkb2N1bWVudCAmJiBkb2N1bWVudC5kb2N1bWVudEVsZW1lbnQ7XG4iXSwic291cmNlUm9vdCI6IiJ9\"")
 	}
@@ -2,10 +2,17 @@ package e2e
 
 import (
 	"bytes"
+	"context"
 	"fmt"
+	"log/slog"
+	"reflect"
+	"regexp"
 	"runtime"
 	"strings"
 	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/trace"
 )
 
 const (
@@ -21,6 +28,13 @@ func Run(name string, testFn Test) (t *T) {
 }
 
 func doRun(name string, testFn Test, t *T) {
+	t.mu.Lock()
+	t.start = time.Now()
+	t.mu.Unlock()
+	t.startSpan()
+	if t.reporter != nil {
+		t.reporter.started(t)
+	}
 	defer func() {
 		if r := recover(); r != nil {
 			switch r {
@@ -33,16 +47,18 @@ func doRun(name string, testFn Test, t *T) {
 			default:
 				panic(r)
 			}
-			if r == PanicFailNow {
-				t.Fail()
-			} else {
-				panic(r)
-			}
+		}
+		t.runCleanups()
+		t.mu.Lock()
+		t.end = time.Now()
+		t.done = true
+		t.mu.Unlock()
+		t.endSpan()
+		if t.reporter != nil {
+			t.reporter.finished(t)
 		}
 	}()
 	testFn(t)
-	t.done = true
-	return
 }
 
 // Test is a test function these should be written in a similar manner to tests with the "testing" package
@@ -54,16 +70,37 @@ type Test func(t *T)
 // rather than test binary. T satisfies the testing.TB interface
 // as best it can, as testing.TB contains unexported methods.
 type T struct {
-	name string
+	name   string
+	ctx    context.Context
+	parent *T
+	// filter holds the patterns that subtests must match to be run,
+	// the first applies to this test's subtests, the rest to their subtests
+	filter   []*regexp.Regexp
+	reporter reporter
+	// tracer creates the span for the test, each test's span is carried in its context
+	tracer trace.Tracer
 
 	mu       sync.RWMutex
 	failed   bool
 	skipped  bool
 	done     bool
+	start    time.Time
+	end      time.Time
 	output   []byte
 	subTests []*T
-	runner   string
+	records  []LogRecord
+	metrics  map[string]float64
 	helpers  map[string]struct{}
+
+	attachments []Attachment
+	// attachmentBytes is the total size of attachments added by this test
+	// and its subtests, it's only tracked on the top level test
+	attachmentBytes int
+	span            trace.Span
+	cleanups        []func()
+	// httpExchanges counts the requests made by the test's HTTP clients, it
+	// numbers their attachments so clients don't overwrite each other's
+	httpExchanges int
 }
 
 // Name returns the name of this test
@@ -71,43 +108,67 @@ func (t *T) Name() string {
 	return t.name
 }
 
-func (t *T) log(s string) {
+// reporter receives events from tests as they run, it's used to stream
+// output when tests are run from the command line
+type reporter interface {
+	started(t *T)
+	logged(t *T, s string)
+	finished(t *T)
+}
+
+// log records a message logged from a public method of T, the record is attributed to
+// the caller of that method
+func (t *T) log(level slog.Level, msg string, attrs ...slog.Attr) {
 	t.mu.Lock()
-	defer t.mu.Unlock()
-	t.output = append(t.output, t.decorate(s)...)
+	file, line := t.caller()
+	t.mu.Unlock()
+	t.addRecord(newLogRecord(level, msg, file, line, attrs))
+}
+
+// addRecord stores a log record, and writes it to the test output
+func (t *T) addRecord(rec LogRecord) {
+	s := decorate(rec.File, rec.Line, rec.text())
+	t.mu.Lock()
+	t.records = append(t.records, rec)
+	t.output = append(t.output, s...)
+	t.mu.Unlock()
+	t.addSpanEvent(rec)
+	if t.reporter != nil {
+		t.reporter.logged(t, s)
+	}
 }
 
 // Log some output to the test log, args will be printed with fmt.Sprint
 func (t *T) Log(args ...interface{}) {
-	t.log(fmt.Sprint(args...))
+	t.log(slog.LevelInfo, fmt.Sprint(args...))
 }
 
 // Logf logs output with formatting, printed using fmt.Sprintf
 func (t *T) Logf(f string, v ...interface{}) {
-	t.log(fmt.Sprintf(f, v...))
+	t.log(slog.LevelInfo, fmt.Sprintf(f, v...))
 }
 
 // Error logs the arguments with fmt.Sprint, and marks the test as failed, but does not abort
 func (t *T) Error(args ...interface{}) {
 	t.Fail()
-	t.log(fmt.Sprint(args...))
+	t.log(slog.LevelError, fmt.Sprint(args...))
 }
 
 // Errorf is the same as t.Error but with formatting
 func (t *T) Errorf(f string, v ...interface{}) {
 	t.Fail()
-	t.log(fmt.Sprintf(f, v...))
+	t.log(slog.LevelError, fmt.Sprintf(f, v...))
 }
 
 // Fatal logs the arguments then immediately aborts the test using t.FailNow()
 func (t *T) Fatal(args ...interface{}) {
-	t.log(fmt.Sprint(args...))
+	t.log(slog.LevelError, fmt.Sprint(args...))
 	t.FailNow()
 }
 
 // Fatalf is the same as Fatal, but logs with formatting using fmt.Sprintf
 func (t *T) Fatalf(f string, v ...interface{}) {
-	t.log(fmt.Sprintf(f, v...))
+	t.log(slog.LevelError, fmt.Sprintf(f, v...))
 	t.FailNow()
 }
 
@@ -134,50 +195,134 @@ func (t *T) Failed() bool {
 	return t.failed
 }
 
-// Output gives you the log output of a test
+// Output gives you the log output of a test, followed by the output of any failed subtests
 func (t *T) Output() []byte {
 	t.mu.RLock()
-	defer t.mu.RUnlock()
-	for i := range t.subTests {
-		if t.subTests[i].Failed() {
-			t.output = append(t.output, t.subTests[i].Output()...)
-			t.output = append(t.output, '\n')
+	output := append([]byte(nil), t.output...)
+	subTests := t.subTests
+	done, failed, skipped := t.done, t.failed, t.skipped
+	t.mu.RUnlock()
+	for _, st := range subTests {
+		if st.Failed() {
+			output = append(output, st.resultHeader()...)
+			output = append(output, '\n')
+			output = append(output, st.Output()...)
+			output = append(output, '\n')
 		}
 	}
+	if !done {
+		return output
+	}
+	if skipped {
+		return append(output, "skipped\n"...)
+	}
+	if failed {
+		return append(output, "FAIL\n"...)
+	}
+	return append(output, "PASS\n"...)
+}
+
+// Duration gives the time taken by the test, if the test is still
+// running it gives the time since the test started
+func (t *T) Duration() time.Duration {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	if t.start.IsZero() {
+		return 0
+	}
 	if !t.done {
-		return t.output
+		return time.Since(t.start)
+	}
+	return t.end.Sub(t.start)
+}
+
+// resultHeader gives the go test style result line for a finished test, e.g.
+// --- FAIL: Parent/child (1.23s)
+func (t *T) resultHeader() string {
+	return fmt.Sprintf("--- %s: %s (%.2fs)", result(t), t.fullName(), t.Duration().Seconds())
+}
+
+// result gives the outcome of a finished test as used by go test, PASS, FAIL or SKIP
+func result(t *T) string {
+	if t.Failed() {
+		return "FAIL"
 	}
 	if t.Skipped() {
-		return append(t.output, "skipped\n"...)
+		return "SKIP"
 	}
-	if t.Failed() {
-		return append(t.output, "FAIL\n"...)
+	return "PASS"
+}
+
+// Context returns the context for the test, it is cancelled if the run is cancelled
+// by the Runner, tests doing long running work should respect it
+func (t *T) Context() context.Context {
+	if t.ctx == nil {
+		return context.Background()
 	}
-	return append(t.output, "PASS\n"...)
+	return t.ctx
 }
 
-// Run is used to run subtests, any failed subtests will cause the parent test to fail
-func (t *T) Run(name string, testFn Test) {
-	tt := Run(name, testFn)
+// logs gives the output logged by the test, without the output of its subtests
+func (t *T) logs() string {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return string(t.output)
+}
+
+// fullName gives the name of the test qualified with the names of its parents, e.g. Parent/child
+func (t *T) fullName() string {
+	if t.parent == nil {
+		return t.name
+	}
+	return t.parent.fullName() + "/" + t.name
+}
+
+// depth gives the number of parents of the test
+func (t *T) depth() int {
+	var d int
+	for p := t.parent; p != nil; p = p.parent {
+		d++
+	}
+	return d
+}
+
+// Run is used to run subtests, any failed subtests will cause the parent test to fail,
+// and the Result of the subtest is returned. If the name of the subtest doesn't match
+// the -run flag of a one-shot run the subtest is not run, and Run returns nil.
+func (t *T) Run(name string, testFn Test) *Result {
+	if len(t.filter) > 0 && !t.filter[0].MatchString(name) {
+		return nil
+	}
+	tt := &T{
+		name:     name,
+		ctx:      t.Context(),
+		parent:   t,
+		reporter: t.reporter,
+		tracer:   t.tracer,
+	}
+	if len(t.filter) > 1 {
+		tt.filter = t.filter[1:]
+	}
+	doRun(name, testFn, tt)
 	if tt.Failed() {
 		t.Fail()
 	}
 	t.mu.Lock()
-	defer t.mu.Unlock()
-	tt.output = append([]byte(fmt.Sprintf("- %s/%s\n", t.name, name)), tt.output...)
 	t.subTests = append(t.subTests, tt)
+	t.mu.Unlock()
+	return tt.Result()
 }
 
 // Skip logs the args, then aborts the test without marking as failed, using the same panic based
 // mechanism as FailNow()
 func (t *T) Skip(args ...interface{}) {
-	t.Log(args...)
+	t.log(slog.LevelInfo, fmt.Sprint(args...))
 	t.SkipNow()
 }
 
 // Skipf is the same as Skip but with formatting
 func (t *T) Skipf(s string, v ...interface{}) {
-	t.Logf(s, v...)
+	t.log(slog.LevelInfo, fmt.Sprintf(s, v...))
 	t.SkipNow()
 }
 
@@ -208,25 +353,64 @@ func (t *T) Helper() {
 	t.helpers[callerName(1)] = struct{}{}
 }
 
-// decorate prefixes the string with the file and line of the call site
-// and inserts the final newline if needed and indentation tabs for formatting.
+// cleanup registers a function to be called when the test and all its subtests
+// complete, cleanup functions are called in last added, first called order
+func (t *T) cleanup(f func()) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.cleanups = append(t.cleanups, f)
+}
 
-// decorate is lifted verbatim from https://golang.org/src/testing/testing.go#L365
-func (t *T) decorate(s string) string {
-	skip := t.frameSkip(3) // decorate + log + public function.
-	_, file, line, ok := runtime.Caller(skip)
-	if ok {
-		// Truncate file name at last file name separator.
-		if index := strings.LastIndex(file, "/"); index >= 0 {
-			file = file[index+1:]
-		} else if index = strings.LastIndex(file, "\\"); index >= 0 {
-			file = file[index+1:]
+// runCleanups calls the cleanup functions of the test, FailNow and SkipNow
+// within a cleanup function only stop that function
+func (t *T) runCleanups() {
+	for {
+		t.mu.Lock()
+		n := len(t.cleanups)
+		if n == 0 {
+			t.mu.Unlock()
+			return
 		}
-	} else {
-		file = "???"
-		line = 1
+		f := t.cleanups[n-1]
+		t.cleanups = t.cleanups[:n-1]
+		t.mu.Unlock()
+		func() {
+			defer func() {
+				if r := recover(); r != nil && r != PanicFailNow && r != PanicSkipNow {
+					panic(r)
+				}
+			}()
+			f()
+		}()
 	}
+}
 
+// caller gives the file and line of the call site of the public function that is logging,
+// skipping any functions marked as helpers. This must be called with t.mu held.
+func (t *T) caller() (string, int) {
+	skip := t.frameSkip(3) // caller + log + public function.
+	_, file, line, ok := runtime.Caller(skip)
+	if !ok {
+		return "???", 1
+	}
+	return shortFile(file), line
+}
+
+// shortFile truncates a file name at the last file name separator
+func shortFile(file string) string {
+	if index := strings.LastIndex(file, "/"); index >= 0 {
+		file = file[index+1:]
+	} else if index = strings.LastIndex(file, "\\"); index >= 0 {
+		file = file[index+1:]
+	}
+	return file
+}
+
+// decorate prefixes the string with the file and line of the call site
+// and inserts the final newline if needed and indentation tabs for formatting.
+
+// decorate is lifted from https://golang.org/src/testing/testing.go#L365
+func decorate(file string, line int, s string) string {
 	buf := new(bytes.Buffer)
 
 	// Every line is indented at least one tab.
@@ -252,8 +436,8 @@ func (t *T) decorate(s string) string {
 // frameSkip searches, starting after skip frames, for the first caller frame
 
 // in a function not marked as a helper and returns the frames to skip
-// to reach that site. The search stops if it finds a tRunner function that
-// was the entry point into the test.
+// to reach that site. The search stops if it finds the function in this
+// package that was the entry point into the test.
 // This function must be called with c.mu held.
 func (t *T) frameSkip(skip int) int {
 	if t.helpers == nil {
@@ -272,8 +456,8 @@ func (t *T) frameSkip(skip int) int {
 	more := true
 	for i := 0; more; i++ {
 		frame, more = frames.Next()
-		if frame.Function == t.runner {
-			// We've gone up all the way to the tRunner calling
+		if i > 0 && entryFrame(frame) {
+			// We've gone up all the way to the runner calling
 			// the test function (so the user must have
 			// called tb.Helper from inside that test function).
 			// Only skip up to the test function itself.
@@ -287,6 +471,15 @@ func (t *T) frameSkip(skip int) int {
 	return skip
 }
 
+// pkgPrefix prefixes the names of the functions in this package
+var pkgPrefix = reflect.TypeOf((*T)(nil)).Elem().PkgPath() + "."
+
+// entryFrame reports whether frame is in this package, outside of its tests, such
+// as the function that runs a test. Helpers called directly from it are the test.
+func entryFrame(frame runtime.Frame) bool {
+	return strings.HasPrefix(frame.Function, pkgPrefix) && !strings.HasSuffix(frame.File, "_test.go")
+}
+
 // callerName gives the function name (qualified with a package path)
 // for the caller after skip frames (where 0 means the current function).
 func callerName(skip int) string {
@@ -55,7 +55,7 @@ func TestNotificationOnFailure(t *testing.T) {
 		},
 		n: n,
 	}
-	runner.runJob()
+	runner.runJob("")
 	if n.n == nil {
 		t.Fatal("should have received a notification")
 	}
@@ -83,7 +83,7 @@ func TestNotificationOnSuccess(t *testing.T) {
 		},
 		n: n,
 	}
-	runner.runJob()
+	runner.runJob("")
 	if n.n == nil {
 		t.Fatal("should have received a notification")
 	}
@@ -0,0 +1,104 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+)
+
+// panicCollectFailNow is used by Collect.FailNow to abort an attempt of an Eventually block
+const panicCollectFailNow = "collectfailnow"
+
+// Collect gathers the errors of a single attempt of an Eventually block, it can be
+// used with the assert and require packages in place of T
+type Collect struct {
+	ctx    context.Context
+	errors []string
+}
+
+// Errorf records an error for this attempt, the block continues
+func (c *Collect) Errorf(format string, args ...interface{}) {
+	c.errors = append(c.errors, fmt.Sprintf(format, args...))
+}
+
+// Error records an error for this attempt with fmt.Sprint, the block continues
+func (c *Collect) Error(args ...interface{}) {
+	c.errors = append(c.errors, fmt.Sprint(args...))
+}
+
+// FailNow stops this attempt, it will be retried after the next tick
+func (c *Collect) FailNow() {
+	if len(c.errors) == 0 {
+		c.errors = append(c.errors, "FailNow called")
+	}
+	panic(panicCollectFailNow)
+}
+
+// Failed reports whether this attempt has recorded any errors
+func (c *Collect) Failed() bool {
+	return len(c.errors) > 0
+}
+
+// Helper does nothing, errors from an Eventually block are reported at the call to Eventually
+func (c *Collect) Helper() {}
+
+// Context returns the test's context, it's done when the test times out or is cancelled
+func (c *Collect) Context() context.Context {
+	return c.ctx
+}
+
+// run calls the block, recovering an aborted attempt
+func (c *Collect) run(block func(c *Collect)) {
+	defer func() {
+		if r := recover(); r != nil && r != panicCollectFailNow {
+			panic(r)
+		}
+	}()
+	block(c)
+}
+
+// Eventually calls block every tick until an attempt passes without errors, or timeout
+// elapses. It stops early if the test's context is done. Only the errors of the final
+// attempt are logged, and the test is marked as failed if no attempt passed. It returns
+// whether an attempt passed.
+//
+//	t.Eventually(func(c *e2e.Collect) {
+//		resp, err := search(c.Context(), orderID)
+//		require.NoError(c, err)
+//		assert.Equal(c, 1, len(resp.Hits))
+//	}, 30*time.Second, time.Second)
+func (t *T) Eventually(block func(c *Collect), timeout, tick time.Duration) bool {
+	ctx := t.Context()
+	deadline := time.NewTimer(timeout)
+	defer deadline.Stop()
+	ticker := time.NewTicker(tick)
+	defer ticker.Stop()
+	var (
+		c        *Collect
+		attempts int
+	)
+	for {
+		attempts++
+		c = &Collect{ctx: ctx}
+		c.run(block)
+		if !c.Failed() {
+			return true
+		}
+		var reason string
+		select {
+		case <-ticker.C:
+			continue
+		case <-deadline.C:
+			reason = fmt.Sprintf("condition not satisfied within %s", timeout)
+		case <-ctx.Done():
+			reason = fmt.Sprintf("condition not satisfied before test context was done: %v", ctx.Err())
+		}
+		msg := fmt.Sprintf("%s after %d attempts, errors from the final attempt:\n%s",
+			reason, attempts, strings.Join(c.errors, "\n"))
+		t.Fail()
+		t.log(slog.LevelError, msg)
+		return false
+	}
+}
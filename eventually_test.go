@@ -0,0 +1,79 @@
+package e2e
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestEventuallyPasses(t *testing.T) {
+	var calls int
+	tt := Run("test", func(t *T) {
+		ok := t.Eventually(func(c *Collect) {
+			calls++
+			if calls < 3 {
+				c.Errorf("attempt %d failed", calls)
+				c.FailNow()
+			}
+		}, time.Second, time.Millisecond)
+		if !ok {
+			t.Error("expected eventually to pass")
+		}
+	})
+	if tt.Failed() {
+		t.Errorf("Expected test to pass, got %s", tt.Output())
+	}
+	if calls != 3 {
+		t.Errorf("Expected 3 attempts, got %d", calls)
+	}
+	if len(tt.Records()) != 0 {
+		t.Errorf("Expected errors from failed attempts not to be logged, got %+v", tt.Records())
+	}
+}
+
+func TestEventuallyTimeout(t *testing.T) {
+	var calls int
+	tt := Run("test", func(t *T) {
+		t.Eventually(func(c *Collect) {
+			calls++
+			c.Errorf("attempt %d failed", calls)
+		}, 20*time.Millisecond, time.Millisecond)
+	})
+	if !tt.Failed() {
+		t.Error("Expected test to fail")
+	}
+	records := tt.Records()
+	if len(records) != 1 {
+		t.Fatalf("Expected 1 record, got %d", len(records))
+	}
+	if records[0].File != "eventually_test.go" {
+		t.Errorf("Expected error reported at the call to Eventually, got %s", records[0].File)
+	}
+	msg := records[0].Message
+	if !strings.Contains(msg, "condition not satisfied within 20ms") || !strings.HasSuffix(msg, fmt.Sprintf("attempt %d failed", calls)) {
+		t.Errorf("Expected only the final attempt's errors, got %q", msg)
+	}
+	if strings.Count(msg, "failed") != 1 {
+		t.Errorf("Expected a single error, got %q", msg)
+	}
+}
+
+func TestEventuallyContext(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+	tt := &T{name: "test", ctx: ctx}
+	start := time.Now()
+	doRun("test", func(t *T) {
+		t.Eventually(func(c *Collect) {
+			c.Error("not yet")
+		}, time.Minute, time.Millisecond)
+	}, tt)
+	if time.Since(start) > 10*time.Second {
+		t.Error("Expected Eventually to stop when the context was done")
+	}
+	if !tt.Failed() || !strings.Contains(string(tt.Output()), "test context was done: context deadline exceeded") {
+		t.Errorf("Unexpected output %s", tt.Output())
+	}
+}
@@ -0,0 +1,390 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/http/httptrace"
+	"runtime"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// DefaultRedactedHeaders are the headers whose values are never written to the
+// test log or attachments by the client returned from T.HTTPClient
+var DefaultRedactedHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"X-Api-Key",
+}
+
+// HTTPClientOption configures the client returned by T.HTTPClient
+type HTTPClientOption func(ht *httpTransport)
+
+// RedactHeaders adds headers to DefaultRedactedHeaders for the client
+func RedactHeaders(names ...string) HTTPClientOption {
+	return func(ht *httpTransport) {
+		for _, name := range names {
+			ht.redact[http.CanonicalHeaderKey(name)] = true
+		}
+	}
+}
+
+// WithRoundTripper sets the transport used to make requests, it defaults to http.DefaultTransport
+func WithRoundTripper(rt http.RoundTripper) HTTPClientOption {
+	return func(ht *httpTransport) {
+		ht.base = rt
+	}
+}
+
+// HTTPClient returns a client which logs every request it makes to the test log, with
+// the response status and a breakdown of the time taken to resolve DNS, connect,
+// complete the TLS handshake and receive the first byte of the response. Requests are
+// cancelled when the test's context is done, and carry the test's trace context in
+// their headers. If the test fails, each request and response is added as an attachment,
+// response bodies include only the part read by the test.
+func (t *T) HTTPClient(opts ...HTTPClientOption) *http.Client {
+	ht := &httpTransport{
+		t:      t,
+		base:   http.DefaultTransport,
+		redact: make(map[string]bool),
+	}
+	for _, name := range DefaultRedactedHeaders {
+		ht.redact[http.CanonicalHeaderKey(name)] = true
+	}
+	for _, opt := range opts {
+		opt(ht)
+	}
+	t.cleanup(ht.attachOnFailure)
+	return &http.Client{Transport: ht}
+}
+
+// httpTransport is the instrumented transport of T.HTTPClient
+type httpTransport struct {
+	t      *T
+	base   http.RoundTripper
+	redact map[string]bool
+
+	mu        sync.Mutex
+	exchanges []*httpExchange
+}
+
+// httpExchange is a request made by the client and its response, kept to be
+// attached to the test if it fails
+type httpExchange struct {
+	// n numbers the exchange among all requests made by the test
+	n    int
+	req  []byte
+	resp []byte
+	err  error
+	body *captureBody
+}
+
+// httpTimings is the breakdown of a request's timing collected with httptrace
+type httpTimings struct {
+	start                     time.Time
+	dnsStart, dnsDone         time.Time
+	connectStart, connectDone time.Time
+	tlsStart, tlsDone         time.Time
+	firstByte                 time.Time
+	reused                    bool
+}
+
+// httpTrace collects the timings of a request, the httptrace callbacks can run
+// concurrently, e.g. when dialing several addresses, and after RoundTrip returns,
+// so the timings are guarded by mu and only the first of each event is kept
+type httpTrace struct {
+	mu      sync.Mutex
+	timings httpTimings
+}
+
+// first sets the time of an event to now, unless it's already set. tm must point
+// to one of the fields of tr.timings.
+func (tr *httpTrace) first(tm *time.Time) {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if tm.IsZero() {
+		*tm = time.Now()
+	}
+}
+
+// snapshot gives a copy of the timings collected so far
+func (tr *httpTrace) snapshot() httpTimings {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	return tr.timings
+}
+
+func (ht *httpTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	file, line := ht.caller()
+	ctx, release := ht.requestContext(req.Context())
+	var tr httpTrace
+	ctx = httptrace.WithClientTrace(ctx, &httptrace.ClientTrace{
+		DNSStart:     func(httptrace.DNSStartInfo) { tr.first(&tr.timings.dnsStart) },
+		DNSDone:      func(httptrace.DNSDoneInfo) { tr.first(&tr.timings.dnsDone) },
+		ConnectStart: func(string, string) { tr.first(&tr.timings.connectStart) },
+		ConnectDone: func(_, _ string, err error) {
+			// only the connection that succeeded is used
+			if err == nil {
+				tr.first(&tr.timings.connectDone)
+			}
+		},
+		TLSHandshakeStart: func() { tr.first(&tr.timings.tlsStart) },
+		TLSHandshakeDone:  func(tls.ConnectionState, error) { tr.first(&tr.timings.tlsDone) },
+		GotConn: func(info httptrace.GotConnInfo) {
+			tr.mu.Lock()
+			tr.timings.reused = info.Reused
+			tr.mu.Unlock()
+		},
+		GotFirstResponseByte: func() { tr.first(&tr.timings.firstByte) },
+	})
+	ctx, span := ht.tracer().Start(ctx, "HTTP "+req.Method,
+		trace.WithSpanKind(trace.SpanKindClient),
+		trace.WithAttributes(
+			attribute.String("http.request.method", req.Method),
+			attribute.String("url.full", req.URL.Redacted()),
+		),
+	)
+
+	out := req.Clone(ctx)
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(out.Header))
+	ht.t.mu.Lock()
+	ht.t.httpExchanges++
+	ex := &httpExchange{n: ht.t.httpExchanges}
+	ht.t.mu.Unlock()
+	if req.Body != nil && req.Body != http.NoBody {
+		body, err := io.ReadAll(req.Body)
+		req.Body.Close()
+		if err != nil {
+			span.End()
+			release()
+			return nil, err
+		}
+		out.Body = io.NopCloser(bytes.NewReader(body))
+		ex.req = ht.dump(requestLine(out), out.Header, body)
+	} else {
+		ex.req = ht.dump(requestLine(out), out.Header, nil)
+	}
+	ht.mu.Lock()
+	ht.exchanges = append(ht.exchanges, ex)
+	ht.mu.Unlock()
+
+	tr.first(&tr.timings.start)
+	resp, err := ht.base.RoundTrip(out)
+	timings := tr.snapshot()
+	elapsed := time.Since(timings.start)
+	if err != nil {
+		ex.err = err
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+		span.End()
+		release()
+		ht.t.addRecord(newLogRecord(slog.LevelWarn,
+			fmt.Sprintf("%s %s: %v", req.Method, req.URL.Redacted(), err),
+			file, line, timings.attrs(elapsed)))
+		return nil, err
+	}
+	span.SetAttributes(attribute.Int("http.response.status_code", resp.StatusCode))
+	if resp.StatusCode >= 500 {
+		span.SetStatus(codes.Error, resp.Status)
+	}
+	ex.resp = ht.dump(fmt.Sprintf("%s %s", resp.Proto, resp.Status), resp.Header, nil)
+	ex.body = &captureBody{ReadCloser: resp.Body, release: func() {
+		span.End()
+		release()
+	}}
+	resp.Body = ex.body
+	attrs := append([]slog.Attr{slog.Int("status", resp.StatusCode)}, timings.attrs(elapsed)...)
+	ht.t.addRecord(newLogRecord(slog.LevelInfo,
+		fmt.Sprintf("%s %s %s", req.Method, req.URL.Redacted(), resp.Status),
+		file, line, attrs))
+	return resp, nil
+}
+
+// requestContext bounds the request's context by the test's context, so requests are
+// cancelled when the test times out. The returned func must be called once the
+// response has been read.
+func (ht *httpTransport) requestContext(ctx context.Context) (context.Context, func()) {
+	tctx := ht.t.Context()
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		ctx = trace.ContextWithSpan(ctx, trace.SpanFromContext(tctx))
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	// a test deadline is applied below, so the request fails with DeadlineExceeded
+	// rather than racing to be cancelled
+	stop := context.AfterFunc(tctx, func() {
+		if tctx.Err() != context.DeadlineExceeded {
+			cancel()
+		}
+	})
+	if deadline, ok := tctx.Deadline(); ok {
+		var cancelDeadline context.CancelFunc
+		ctx, cancelDeadline = context.WithDeadline(ctx, deadline)
+		return ctx, func() {
+			stop()
+			cancelDeadline()
+			cancel()
+		}
+	}
+	return ctx, func() {
+		stop()
+		cancel()
+	}
+}
+
+func (ht *httpTransport) tracer() trace.Tracer {
+	ht.t.mu.RLock()
+	defer ht.t.mu.RUnlock()
+	if ht.t.tracer != nil {
+		return ht.t.tracer
+	}
+	return otel.Tracer(tracerName)
+}
+
+// dump writes a request or response line, headers and body in HTTP/1.1 wire
+// format, with the values of redacted headers replaced
+func (ht *httpTransport) dump(first string, header http.Header, body []byte) []byte {
+	var buf bytes.Buffer
+	buf.WriteString(first + "\r\n")
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range header[k] {
+			if ht.redact[http.CanonicalHeaderKey(k)] {
+				v = "[REDACTED]"
+			}
+			fmt.Fprintf(&buf, "%s: %s\r\n", k, v)
+		}
+	}
+	buf.WriteString("\r\n")
+	buf.Write(body)
+	return buf.Bytes()
+}
+
+// attachOnFailure adds every request and response made by the client as
+// attachments if the test failed
+func (ht *httpTransport) attachOnFailure() {
+	if !ht.t.Failed() {
+		return
+	}
+	ht.mu.Lock()
+	defer ht.mu.Unlock()
+	for _, ex := range ht.exchanges {
+		ht.t.Attach(fmt.Sprintf("http-%03d-request.txt", ex.n), "text/plain", ex.req)
+		switch {
+		case ex.err != nil:
+			ht.t.Attach(fmt.Sprintf("http-%03d-error.txt", ex.n), "text/plain", []byte(ex.err.Error()))
+		case ex.resp != nil:
+			ht.t.Attach(fmt.Sprintf("http-%03d-response.txt", ex.n), "text/plain", append(ex.resp, ex.body.bytes()...))
+		}
+	}
+}
+
+func requestLine(req *http.Request) string {
+	return fmt.Sprintf("%s %s HTTP/1.1\r\nHost: %s", req.Method, req.URL.RequestURI(), req.Host)
+}
+
+// attrs gives the timing breakdown of a request as log attributes, phases
+// that didn't happen, such as DNS on a reused connection, are left out
+func (tm *httpTimings) attrs(total time.Duration) []slog.Attr {
+	var timing []any
+	if !tm.dnsDone.IsZero() {
+		timing = append(timing, slog.Duration("dns", tm.dnsDone.Sub(tm.dnsStart)))
+	}
+	if !tm.connectDone.IsZero() {
+		timing = append(timing, slog.Duration("connect", tm.connectDone.Sub(tm.connectStart)))
+	}
+	if !tm.tlsDone.IsZero() {
+		timing = append(timing, slog.Duration("tls", tm.tlsDone.Sub(tm.tlsStart)))
+	}
+	if !tm.firstByte.IsZero() {
+		timing = append(timing, slog.Duration("ttfb", tm.firstByte.Sub(tm.start)))
+	}
+	timing = append(timing, slog.Duration("total", total))
+	return []slog.Attr{
+		slog.Bool("reused", tm.reused),
+		slog.Group("timing", timing...),
+	}
+}
+
+// captureBody keeps a copy of the response body as it's read, up to MaxAttachmentSize
+type captureBody struct {
+	io.ReadCloser
+	release func()
+
+	mu   sync.Mutex
+	buf  bytes.Buffer
+	once sync.Once
+}
+
+func (cb *captureBody) Read(p []byte) (int, error) {
+	n, err := cb.ReadCloser.Read(p)
+	cb.mu.Lock()
+	if room := MaxAttachmentSize - cb.buf.Len(); room > 0 {
+		cb.buf.Write(p[:min(n, room)])
+	}
+	cb.mu.Unlock()
+	if err == io.EOF {
+		cb.once.Do(cb.release)
+	}
+	return n, err
+}
+
+func (cb *captureBody) Close() error {
+	err := cb.ReadCloser.Close()
+	cb.once.Do(cb.release)
+	return err
+}
+
+func (cb *captureBody) bytes() []byte {
+	if cb == nil {
+		return nil
+	}
+	cb.mu.Lock()
+	defer cb.mu.Unlock()
+	return append([]byte(nil), cb.buf.Bytes()...)
+}
+
+// caller finds the file and line of the code that made a request, the first frame
+// outside of net/http and functions marked with T.Helper. As with the test log, if
+// only helpers lead up to the test's entry point the outermost helper is used.
+func (ht *httpTransport) caller() (string, int) {
+	ht.t.mu.RLock()
+	defer ht.t.mu.RUnlock()
+	pc := make([]uintptr, 32)
+	n := runtime.Callers(3, pc) // runtime.Callers + caller + RoundTrip
+	frames := runtime.CallersFrames(pc[:n])
+	var helper runtime.Frame
+	for {
+		frame, more := frames.Next()
+		if !strings.HasPrefix(frame.Function, "net/http.") {
+			if helper.Function != "" && entryFrame(frame) {
+				return shortFile(helper.File), helper.Line
+			}
+			if _, ok := ht.t.helpers[frame.Function]; !ok {
+				return shortFile(frame.File), frame.Line
+			}
+			helper = frame
+		}
+		if !more {
+			return "???", 1
+		}
+	}
+}
@@ -0,0 +1,143 @@
+package e2e
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"runtime"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestHTTPClient(t *testing.T) {
+	var traceparent string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		traceparent = req.Header.Get("Traceparent")
+		w.Header().Set("Set-Cookie", "session=secret")
+		w.WriteHeader(http.StatusTeapot)
+		io.WriteString(w, "short and stout")
+	}))
+	defer srv.Close()
+
+	tp, _ := newInMemoryTracerProvider()
+	tt := &T{name: "test", tracer: tp.Tracer(tracerName)}
+	doRun("test", func(t *T) {
+		req, _ := http.NewRequest("POST", srv.URL+"/teapot", strings.NewReader("hello"))
+		req.Header.Set("Authorization", "Bearer secret")
+		req.Header.Set("X-Custom", "secret")
+		resp, err := t.HTTPClient(RedactHeaders("x-custom")).Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.ReadAll(resp.Body)
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusOK {
+			t.Errorf("expected 200, got %d", resp.StatusCode)
+		}
+	}, tt)
+
+	if traceparent == "" {
+		t.Error("Expected request to carry a traceparent header")
+	}
+	records := tt.Records()
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 log records, got %d", len(records))
+	}
+	rec := records[0]
+	if !strings.HasPrefix(rec.Message, "POST "+srv.URL+"/teapot 418") {
+		t.Errorf("Unexpected message %q", rec.Message)
+	}
+	if rec.File != "httpclient_test.go" {
+		t.Errorf("Expected request to be logged from %q, got %q", "httpclient_test.go", rec.File)
+	}
+	if _, ok := rec.Attrs["timing.connect"]; !ok {
+		t.Errorf("Expected connect timing, got %v", rec.Attrs)
+	}
+
+	attachments := tt.Attachments()
+	if len(attachments) != 2 {
+		t.Fatalf("Expected request and response attachments, got %d", len(attachments))
+	}
+	req, resp := string(attachments[0].Data), string(attachments[1].Data)
+	if strings.Contains(req, "secret") || strings.Contains(resp, "secret") {
+		t.Errorf("Expected headers to be redacted, got %q and %q", req, resp)
+	}
+	if !strings.HasSuffix(req, "\r\n\r\nhello") {
+		t.Errorf("Expected request body in attachment, got %q", req)
+	}
+	if !strings.HasSuffix(resp, "\r\n\r\nshort and stout") {
+		t.Errorf("Expected response body in attachment, got %q", resp)
+	}
+}
+
+func TestHTTPClientDeadline(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		select {
+		case <-req.Context().Done():
+		case <-time.After(5 * time.Second):
+		}
+	}))
+	defer srv.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	tt := &T{name: "test", ctx: ctx}
+	var err error
+	doRun("test", func(t *T) {
+		_, err = t.HTTPClient().Get(srv.URL)
+	}, tt)
+	if err == nil || !strings.Contains(err.Error(), "deadline exceeded") {
+		t.Errorf("Expected deadline exceeded, got %v", err)
+	}
+	if tt.Failed() || len(tt.Attachments()) != 0 {
+		t.Error("Expected no attachments for a passing test")
+	}
+}
+
+func TestHTTPClientAttachments(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		io.WriteString(w, req.URL.Path)
+	}))
+	defer srv.Close()
+
+	get := func(t *T, client *http.Client, path string) {
+		t.Helper()
+		resp, err := client.Get(srv.URL + path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		io.ReadAll(resp.Body)
+		resp.Body.Close()
+	}
+	tt := &T{name: "test"}
+	var line int
+	doRun("test", func(t *T) {
+		get(t, t.HTTPClient(), "/first")
+		_, _, line, _ = runtime.Caller(0)
+		get(t, t.HTTPClient(), "/second")
+		t.Fail()
+	}, tt)
+
+	if rec := tt.Records()[1]; rec.File != "httpclient_test.go" || rec.Line != line+1 {
+		t.Errorf("Expected request to be logged from httpclient_test.go:%d, got %s:%d", line+1, rec.File, rec.Line)
+	}
+	expected := []string{"http-001-request.txt", "http-001-response.txt", "http-002-request.txt", "http-002-response.txt"}
+	attachments := tt.Attachments()
+	if len(attachments) != len(expected) {
+		t.Fatalf("Expected %d attachments, got %d", len(expected), len(attachments))
+	}
+	names := make(map[string]string)
+	for _, a := range attachments {
+		names[a.Name] = string(a.Data)
+	}
+	for _, name := range expected {
+		if _, ok := names[name]; !ok {
+			t.Errorf("Expected attachment %s, got %v", name, attachments)
+		}
+	}
+	if !strings.HasSuffix(names["http-001-response.txt"], "/first") || !strings.HasSuffix(names["http-002-response.txt"], "/second") {
+		t.Errorf("Expected each client's response to be attached, got %q", names)
+	}
+}
@@ -0,0 +1,114 @@
+package e2e
+
+import (
+	"hash/fnv"
+	"math/rand"
+	"time"
+)
+
+// WithFailureInterval runs the test every interval while it is failing, once the
+// test passes again it returns to its scheduled interval. This gives quick
+// confirmation of both an outage and its recovery.
+func WithFailureInterval(interval time.Duration) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.failureInterval = interval
+	}
+}
+
+// WithFailureBackoff doubles the interval between runs for every consecutive failure,
+// starting at initial and never exceeding max. This is useful for tests that are
+// costly to repeat while they are failing. If used together with WithFailureInterval
+// the backoff starts from the failure interval.
+func WithFailureBackoff(initial, max time.Duration) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.backoffInitial = initial
+		tr.failureBackoff = max
+	}
+}
+
+// WithJitter adds a random delay of up to max to every scheduled run, this spreads
+// out load on the services under test when many tests share an interval. With
+// RunOnStart the first run isn't delayed, jitter applies from the second run.
+func WithJitter(max time.Duration) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.maxJitter = max
+	}
+}
+
+// WithStagger delays the first run of the test by a phase offset within its interval,
+// the offset is derived from the test name so it is stable across restarts, and
+// tests scheduled with the same interval won't all fire at the same moment. With
+// RunOnStart the test still runs immediately, and the offset delays its second run.
+func WithStagger() ScheduleOption {
+	return func(tr *testRunner) {
+		tr.stagger = true
+	}
+}
+
+// RunOnStart runs the test as soon as it is scheduled, rather than waiting for
+// the first interval to elapse, any stagger or jitter is applied to later runs
+func RunOnStart() ScheduleOption {
+	return func(tr *testRunner) {
+		tr.runOnStart = true
+	}
+}
+
+// firstInterval gives the time to wait before the first run of the test, not
+// including jitter
+func (tr *testRunner) firstInterval() time.Duration {
+	if tr.runOnStart {
+		return 0
+	}
+	return tr.interval + tr.staggerPhase()
+}
+
+// staggerPhase is the offset of the test's schedule if it's staggered
+func (tr *testRunner) staggerPhase() time.Duration {
+	if !tr.stagger {
+		return 0
+	}
+	return tr.phase()
+}
+
+// phase is a deterministic offset within the test's interval, based on its name
+func (tr *testRunner) phase() time.Duration {
+	if tr.interval <= 0 {
+		return 0
+	}
+	h := fnv.New64a()
+	h.Write([]byte(tr.Name))
+	return time.Duration(h.Sum64() % uint64(tr.interval))
+}
+
+// jitter gives a random delay to be added to the next wait
+func (tr *testRunner) jitter() time.Duration {
+	if tr.maxJitter <= 0 {
+		return 0
+	}
+	return time.Duration(rand.Int63n(int64(tr.maxJitter)))
+}
+
+// nextInterval gives the time to wait before the next scheduled run
+// based on the test's current run of failures
+func (tr *testRunner) nextInterval() time.Duration {
+	tr.stateMu.Lock()
+	failures := tr.ConsecutiveFailures
+	tr.stateMu.Unlock()
+	d := tr.failureInterval
+	if d <= 0 {
+		d = tr.backoffInitial
+	}
+	if failures == 0 || d <= 0 {
+		return tr.interval
+	}
+	if tr.failureBackoff <= 0 {
+		return d
+	}
+	for i := 1; i < failures && d < tr.failureBackoff; i++ {
+		d *= 2
+	}
+	if d > tr.failureBackoff {
+		d = tr.failureBackoff
+	}
+	return d
+}
@@ -0,0 +1,212 @@
+package e2e
+
+import (
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// junitTestSuites is the root element of a JUnit XML report
+type junitTestSuites struct {
+	XMLName xml.Name         `xml:"testsuites"`
+	Suites  []junitTestSuite `xml:"testsuite"`
+}
+
+// junitTestSuite holds the results of a single run of a test
+type junitTestSuite struct {
+	Name      string          `xml:"name,attr"`
+	Tests     int             `xml:"tests,attr"`
+	Failures  int             `xml:"failures,attr"`
+	Skipped   int             `xml:"skipped,attr"`
+	Time      string          `xml:"time,attr"`
+	Timestamp string          `xml:"timestamp,attr"`
+	Cases     []junitTestCase `xml:"testcase"`
+}
+
+type junitTestCase struct {
+	ClassName string        `xml:"classname,attr"`
+	Name      string        `xml:"name,attr"`
+	Time      string        `xml:"time,attr"`
+	Failure   *junitMessage `xml:"failure,omitempty"`
+	Skipped   *junitMessage `xml:"skipped,omitempty"`
+	SystemOut *junitOutput  `xml:"system-out,omitempty"`
+}
+
+type junitMessage struct {
+	Message  string `xml:"message,attr"`
+	Contents string `xml:",cdata"`
+}
+
+type junitOutput struct {
+	Contents string `xml:",cdata"`
+}
+
+// junitSuite builds a testsuite from the result of a finished test, the test
+// and each of its subtests become a testcase
+func junitSuite(res *Result) junitTestSuite {
+	s := junitTestSuite{
+		Name:      res.Name,
+		Time:      junitTime(res.Duration),
+		Timestamp: res.Start.UTC().Format("2006-01-02T15:04:05"),
+	}
+	var add func(name string, res *Result)
+	add = func(name string, res *Result) {
+		s.Cases = append(s.Cases, junitCase(s.Name, name, res))
+		for _, c := range res.Children {
+			add(name+"/"+c.Name, c)
+		}
+	}
+	add(res.Name, res)
+	s.count()
+	return s
+}
+
+func junitCase(suite, name string, res *Result) junitTestCase {
+	c := junitTestCase{
+		ClassName: suite,
+		Name:      name,
+		Time:      junitTime(res.Duration),
+	}
+	// rebuild the output of the test, without the output of its subtests,
+	// from its log records
+	var output string
+	for _, rec := range res.Logs {
+		output += decorate(rec.File, rec.Line, rec.text())
+	}
+	switch res.State {
+	case TestStateFailed:
+		c.Failure = &junitMessage{Message: "Failed", Contents: output}
+	case TestStateSkipped:
+		c.Skipped = &junitMessage{Message: "Skipped", Contents: output}
+	default:
+		if output != "" {
+			c.SystemOut = &junitOutput{Contents: output}
+		}
+	}
+	return c
+}
+
+// junitRunSuite builds a testsuite from a run recorded by a Runner
+func junitRunSuite(rec RunRecord) junitTestSuite {
+	if rec.Result != nil {
+		return junitSuite(rec.Result)
+	}
+	// runs without a result were blocked, or cancelled before they started,
+	// so report them as skipped
+	var output string
+	if len(rec.BlockedBy) > 0 {
+		output = blockedMessage(rec.BlockedBy)
+	}
+	s := junitTestSuite{
+		Name:      rec.Name,
+		Time:      junitTime(rec.Duration),
+		Timestamp: rec.Start.UTC().Format("2006-01-02T15:04:05"),
+		Cases: []junitTestCase{{
+			ClassName: rec.Name,
+			Name:      rec.Name,
+			Time:      junitTime(rec.Duration),
+			Skipped:   &junitMessage{Message: string(rec.State), Contents: output},
+		}},
+	}
+	s.count()
+	return s
+}
+
+func (s *junitTestSuite) count() {
+	s.Tests = len(s.Cases)
+	for _, c := range s.Cases {
+		if c.Failure != nil {
+			s.Failures++
+		}
+		if c.Skipped != nil {
+			s.Skipped++
+		}
+	}
+}
+
+// junitTime formats a duration in seconds, as used by the time attributes
+func junitTime(d time.Duration) string {
+	return fmt.Sprintf("%.3f", d.Seconds())
+}
+
+// writeJUnit writes the suites as a JUnit XML report
+func writeJUnit(w io.Writer, suites []junitTestSuite) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "\t")
+	if err := enc.Encode(junitTestSuites{Suites: suites}); err != nil {
+		return err
+	}
+	_, err := io.WriteString(w, "\n")
+	return err
+}
+
+// JUnitHandler renders recorded runs as a JUnit XML report. By default the latest
+// run of each test is included, the since and until query parameters select all
+// runs in a time window, and accept either a duration before now such as 1h, or
+// an RFC3339 timestamp. The name parameter limits the report to a single test.
+func (r *Runner) JUnitHandler(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
+	var since, until time.Time
+	var err error
+	if v := q.Get("since"); v != "" {
+		if since, err = parseReportTime(v); err != nil {
+			http.Error(w, "400 bad request (invalid since param)", http.StatusBadRequest)
+			return
+		}
+	}
+	if v := q.Get("until"); v != "" {
+		if until, err = parseReportTime(v); err != nil {
+			http.Error(w, "400 bad request (invalid until param)", http.StatusBadRequest)
+			return
+		}
+	}
+	window := !since.IsZero() || !until.IsZero()
+
+	r.mu.Lock()
+	var runs []RunRecord
+	for name, hist := range r.history {
+		if n := q.Get("name"); n != "" && n != name {
+			continue
+		}
+		if !window {
+			if len(hist) > 0 {
+				runs = append(runs, hist[len(hist)-1])
+			}
+			continue
+		}
+		for _, rec := range hist {
+			if rec.Start.Before(since) || (!until.IsZero() && rec.Start.After(until)) {
+				continue
+			}
+			runs = append(runs, rec)
+		}
+	}
+	r.mu.Unlock()
+	sort.Slice(runs, func(i, j int) bool {
+		if runs[i].Name != runs[j].Name {
+			return runs[i].Name < runs[j].Name
+		}
+		return runs[i].Start.Before(runs[j].Start)
+	})
+
+	suites := make([]junitTestSuite, 0, len(runs))
+	for _, rec := range runs {
+		suites = append(suites, junitRunSuite(rec))
+	}
+	w.Header().Set("Content-Type", "application/xml")
+	writeJUnit(w, suites)
+}
+
+// parseReportTime parses either a duration before now, or an RFC3339 timestamp
+func parseReportTime(v string) (time.Time, error) {
+	if d, err := time.ParseDuration(v); err == nil {
+		return time.Now().Add(-d), nil
+	}
+	return time.Parse(time.RFC3339, v)
+}
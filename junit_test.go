@@ -0,0 +1,57 @@
+package e2e
+
+import (
+	"encoding/xml"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestJUnitHandler(t *testing.T) {
+	r := &Runner{}
+	tr := &testRunner{
+		Name: "TestSubtests",
+		t: func(t *T) {
+			t.Run("pass", func(t *T) {})
+			t.Run("fail", func(t *T) {
+				t.Error("failing")
+			})
+			t.Run("skip", func(t *T) {
+				t.Skip("skipping")
+			})
+		},
+		n: defaultNotifier,
+	}
+	r.runTest(tr)
+	r.runTest(tr)
+
+	for _, tc := range []struct {
+		query  string
+		suites int
+	}{
+		{query: "", suites: 1},
+		{query: "?since=1h", suites: 2},
+		{query: "?since=1h&name=TestOther", suites: 0},
+	} {
+		w := httptest.NewRecorder()
+		r.JUnitHandler(w, httptest.NewRequest("GET", "/api/report/junit"+tc.query, nil))
+		var report junitTestSuites
+		if err := xml.Unmarshal(w.Body.Bytes(), &report); err != nil {
+			t.Fatalf("%q: %v", tc.query, err)
+		}
+		if len(report.Suites) != tc.suites {
+			t.Errorf("%q: expected %d suites, got %d", tc.query, tc.suites, len(report.Suites))
+			continue
+		}
+		if tc.suites == 0 {
+			continue
+		}
+		s := report.Suites[0]
+		if s.Tests != 4 || s.Failures != 2 || s.Skipped != 1 {
+			t.Errorf("%q: expected 4 tests, 2 failures and 1 skipped, got %+v", tc.query, s)
+		}
+		if c := s.Cases[2]; c.Name != "TestSubtests/fail" || c.Failure == nil || !strings.Contains(c.Failure.Contents, "failing") {
+			t.Errorf("%q: expected TestSubtests/fail to have failed, got %+v", tc.query, c)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+package e2e
+
+import (
+	"context"
+	"log/slog"
+	"runtime"
+	"strings"
+	"time"
+)
+
+// LogRecord is a structured record of a single log call made by a test
+type LogRecord struct {
+	Time    time.Time
+	Level   slog.Level
+	Message string
+	File    string
+	Line    int
+	Attrs   map[string]interface{} `json:",omitempty"`
+
+	// attrs keeps the attributes in the order they were logged, for rendering as text
+	attrs []slog.Attr
+}
+
+func newLogRecord(level slog.Level, msg, file string, line int, attrs []slog.Attr) LogRecord {
+	rec := LogRecord{
+		Time:    time.Now(),
+		Level:   level,
+		Message: msg,
+		File:    file,
+		Line:    line,
+		attrs:   attrs,
+	}
+	if len(attrs) > 0 {
+		rec.Attrs = make(map[string]interface{}, len(attrs))
+		for _, a := range attrs {
+			addAttr(rec.Attrs, "", a)
+		}
+	}
+	return rec
+}
+
+// addAttr adds an attribute to m, the keys of attributes within groups are
+// prefixed by the group name, e.g. request.status
+func addAttr(m map[string]interface{}, prefix string, a slog.Attr) {
+	v := a.Value.Resolve()
+	if v.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+		for _, ga := range v.Group() {
+			addAttr(m, prefix, ga)
+		}
+		return
+	}
+	if a.Key == "" {
+		return
+	}
+	m[prefix+a.Key] = v.Any()
+}
+
+// text renders the record as it appears in the test output, the message
+// followed by the attributes as key=value pairs
+func (rec LogRecord) text() string {
+	if len(rec.attrs) == 0 {
+		return rec.Message
+	}
+	parts := make([]string, 0, len(rec.attrs)+1)
+	if rec.Message != "" {
+		parts = append(parts, rec.Message)
+	}
+	for _, a := range rec.attrs {
+		parts = appendAttrText(parts, "", a)
+	}
+	return strings.Join(parts, " ")
+}
+
+// appendAttrText appends the attribute as key=value, with groups flattened the same as addAttr
+func appendAttrText(parts []string, prefix string, a slog.Attr) []string {
+	v := a.Value.Resolve()
+	if v.Kind() == slog.KindGroup {
+		if a.Key != "" {
+			prefix += a.Key + "."
+		}
+		for _, ga := range v.Group() {
+			parts = appendAttrText(parts, prefix, ga)
+		}
+		return parts
+	}
+	if a.Key == "" {
+		return parts
+	}
+	return append(parts, prefix+a.Key+"="+v.String())
+}
+
+// Logw logs a message with key/value attributes, which are recorded in the test's
+// LogRecords and rendered as key=value pairs in the output. Arguments are interpreted
+// in the same way as slog.Logger.Info, e.g.
+//
+//	t.Logw("checked region", "region", r, "status", code)
+func (t *T) Logw(msg string, args ...interface{}) {
+	t.log(slog.LevelInfo, msg, argsToAttrs(args)...)
+}
+
+// argsToAttrs converts alternating keys and values to attributes, the same as slog
+func argsToAttrs(args []interface{}) []slog.Attr {
+	var r slog.Record
+	r.Add(args...)
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	return attrs
+}
+
+// Records returns the structured log records of the test
+func (t *T) Records() []LogRecord {
+	t.mu.RLock()
+	defer t.mu.RUnlock()
+	return append([]LogRecord(nil), t.records...)
+}
+
+// Logger returns a *slog.Logger which writes to the test log, records logged at any
+// level are kept, and logging at error level does not fail the test
+func (t *T) Logger() *slog.Logger {
+	return slog.New(&logHandler{t: t})
+}
+
+// logHandler is a slog.Handler that writes records to a T
+type logHandler struct {
+	t      *T
+	attrs  []slog.Attr
+	groups []string
+}
+
+func (h *logHandler) Enabled(context.Context, slog.Level) bool {
+	return true
+}
+
+func (h *logHandler) Handle(_ context.Context, r slog.Record) error {
+	file, line := "???", 1
+	if r.PC != 0 {
+		frame, _ := runtime.CallersFrames([]uintptr{r.PC}).Next()
+		file, line = shortFile(frame.File), frame.Line
+	}
+	attrs := make([]slog.Attr, 0, r.NumAttrs())
+	r.Attrs(func(a slog.Attr) bool {
+		attrs = append(attrs, a)
+		return true
+	})
+	attrs = append(append([]slog.Attr(nil), h.attrs...), h.group(attrs)...)
+	rec := newLogRecord(r.Level, r.Message, file, line, attrs)
+	rec.Time = r.Time
+	h.t.addRecord(rec)
+	return nil
+}
+
+// group nests attributes within the handler's groups
+func (h *logHandler) group(attrs []slog.Attr) []slog.Attr {
+	for i := len(h.groups) - 1; i >= 0; i-- {
+		if len(attrs) == 0 {
+			return nil
+		}
+		args := make([]interface{}, len(attrs))
+		for j := range attrs {
+			args[j] = attrs[j]
+		}
+		attrs = []slog.Attr{slog.Group(h.groups[i], args...)}
+	}
+	return attrs
+}
+
+func (h *logHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &logHandler{
+		t:      h.t,
+		attrs:  append(append([]slog.Attr(nil), h.attrs...), h.group(attrs)...),
+		groups: h.groups,
+	}
+}
+
+func (h *logHandler) WithGroup(name string) slog.Handler {
+	if name == "" {
+		return h
+	}
+	return &logHandler{
+		t:      h.t,
+		attrs:  h.attrs,
+		groups: append(append([]string(nil), h.groups...), name),
+	}
+}
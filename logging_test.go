@@ -0,0 +1,71 @@
+package e2e
+
+import (
+	"encoding/json"
+	"log/slog"
+	"strings"
+	"testing"
+)
+
+func TestLogw(t *testing.T) {
+	tt := Run("logw", func(t *T) {
+		t.Logw("checked region", "region", "eu-west-1", "status", 200)
+	})
+	out := string(tt.output)
+	if !strings.HasSuffix(out, ": checked region region=eu-west-1 status=200\n") {
+		t.Errorf("Unexpected output %q", out)
+	}
+	recs := tt.Records()
+	if len(recs) != 1 {
+		t.Fatalf("Expected 1 record, got %d", len(recs))
+	}
+	rec := recs[0]
+	if rec.Level != slog.LevelInfo || rec.Message != "checked region" || rec.File != "logging_test.go" {
+		t.Errorf("Unexpected record %+v", rec)
+	}
+	if rec.Attrs["region"] != "eu-west-1" || rec.Attrs["status"] != int64(200) {
+		t.Errorf("Unexpected attrs %v", rec.Attrs)
+	}
+	b, err := json.Marshal(rec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(string(b), `"Level":"INFO"`) || !strings.Contains(string(b), `"region":"eu-west-1"`) {
+		t.Errorf("Unexpected JSON %s", b)
+	}
+}
+
+func TestLogger(t *testing.T) {
+	tt := Run("logger", func(t *T) {
+		l := t.Logger().With("step", "login").WithGroup("resp")
+		l.Warn("slow response", "status", 200)
+	})
+	if tt.Failed() {
+		t.Error("Expected logging not to fail the test")
+	}
+	recs := tt.Records()
+	if len(recs) != 1 {
+		t.Fatalf("Expected 1 record, got %d", len(recs))
+	}
+	rec := recs[0]
+	if rec.Level != slog.LevelWarn || rec.File != "logging_test.go" {
+		t.Errorf("Unexpected record %+v", rec)
+	}
+	if rec.Attrs["step"] != "login" || rec.Attrs["resp.status"] != int64(200) {
+		t.Errorf("Unexpected attrs %v", rec.Attrs)
+	}
+	if out := string(tt.output); !strings.Contains(out, "slow response step=login resp.status=200\n") {
+		t.Errorf("Unexpected output %q", out)
+	}
+}
+
+func TestErrorLevel(t *testing.T) {
+	tt := Run("error", func(t *T) {
+		t.Log("info")
+		t.Errorf("error")
+	})
+	recs := tt.Records()
+	if len(recs) != 2 || recs[0].Level != slog.LevelInfo || recs[1].Level != slog.LevelError {
+		t.Errorf("Unexpected records %+v", recs)
+	}
+}
@@ -0,0 +1,72 @@
+package e2e
+
+// Severity indicates how important a failure of a test is
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+// Metadata describes a scheduled test, it is exposed in the status API
+// and included in notifications so they can be routed and filtered
+type Metadata struct {
+	Description string   `json:",omitempty"`
+	Owner       string   `json:",omitempty"`
+	Tags        []string `json:",omitempty"`
+	RunbookURL  string   `json:",omitempty"`
+	Severity    Severity `json:",omitempty"`
+}
+
+// HasTag reports whether the metadata contains the given tag
+func (m Metadata) HasTag(tag string) bool {
+	for _, t := range m.Tags {
+		if t == tag {
+			return true
+		}
+	}
+	return false
+}
+
+// WithMetadata sets all of the metadata for a scheduled test
+func WithMetadata(m Metadata) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.Metadata = m
+	}
+}
+
+// WithDescription sets a human readable description of the test
+func WithDescription(description string) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.Metadata.Description = description
+	}
+}
+
+// WithOwner sets the team or person responsible for the test
+func WithOwner(owner string) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.Metadata.Owner = owner
+	}
+}
+
+// WithTags adds tags to the test
+func WithTags(tags ...string) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.Metadata.Tags = append(tr.Metadata.Tags, tags...)
+	}
+}
+
+// WithRunbook sets a link to the runbook to follow when the test fails
+func WithRunbook(url string) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.Metadata.RunbookURL = url
+	}
+}
+
+// WithSeverity sets the severity of a failure of the test
+func WithSeverity(s Severity) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.Metadata.Severity = s
+	}
+}
@@ -0,0 +1,45 @@
+package e2e
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+func TestStatusHandlerFilters(t *testing.T) {
+	r := &Runner{tests: map[string]*testRunner{
+		"TestLogin":    {Name: "TestLogin", Metadata: Metadata{Owner: "identity", Tags: []string{"auth"}, Severity: SeverityCritical}},
+		"TestCheckout": {Name: "TestCheckout", Metadata: Metadata{Owner: "payments", Tags: []string{"auth", "db"}, Severity: SeverityCritical}},
+		"TestSearch":   {Name: "TestSearch", Metadata: Metadata{Owner: "search", Tags: []string{"db"}, Severity: SeverityInfo}},
+	}}
+	for _, tc := range []struct {
+		query    string
+		expected []string
+	}{
+		{"", []string{"TestCheckout", "TestLogin", "TestSearch"}},
+		{"?tag=auth", []string{"TestCheckout", "TestLogin"}},
+		{"?tag=db", []string{"TestCheckout", "TestSearch"}},
+		{"?owner=payments", []string{"TestCheckout"}},
+		{"?severity=critical", []string{"TestCheckout", "TestLogin"}},
+		{"?tag=db&severity=critical", []string{"TestCheckout"}},
+		{"?tag=auth&owner=search", []string{}},
+		{"?tag=missing", []string{}},
+	} {
+		w := httptest.NewRecorder()
+		r.StatusHandler(w, httptest.NewRequest("GET", "/api/status"+tc.query, nil))
+		var tests map[string]json.RawMessage
+		if err := json.Unmarshal(w.Body.Bytes(), &tests); err != nil {
+			t.Fatalf("%q: %v", tc.query, err)
+		}
+		names := []string{}
+		for name := range tests {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		if !reflect.DeepEqual(names, tc.expected) {
+			t.Errorf("%q: expected %v, got %v", tc.query, tc.expected, names)
+		}
+	}
+}
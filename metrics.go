@@ -0,0 +1,99 @@
+package e2e
+
+import (
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+)
+
+// MetricsHandler exposes metrics about test runs in the Prometheus text format,
+// durations are taken from the latest run of each test and its subtests
+func (r *Runner) MetricsHandler(w http.ResponseWriter, req *http.Request) {
+	r.mu.Lock()
+	tests := make([]*testRunner, 0, len(r.tests))
+	for _, tr := range r.tests {
+		tests = append(tests, tr)
+	}
+	r.mu.Unlock()
+	sort.Slice(tests, func(i, j int) bool {
+		return tests[i].Name < tests[j].Name
+	})
+
+	type snapshot struct {
+		name   string
+		counts map[TestState]int
+		last   *RunRecord
+	}
+	snapshots := make([]snapshot, 0, len(tests))
+	for _, tr := range tests {
+		tr.stateMu.Lock()
+		s := snapshot{name: tr.Name, last: tr.lastRun, counts: make(map[TestState]int)}
+		for state, n := range tr.runCounts {
+			s.counts[state] = n
+		}
+		tr.stateMu.Unlock()
+		snapshots = append(snapshots, s)
+	}
+
+	w.Header().Set("Content-Type", "text/plain; version=0.0.4")
+	writeMetricHeader(w, "e2e_runs_total", "counter", "Number of completed runs of each test by state.")
+	for _, s := range snapshots {
+		states := make([]string, 0, len(s.counts))
+		for state := range s.counts {
+			states = append(states, string(state))
+		}
+		sort.Strings(states)
+		for _, state := range states {
+			fmt.Fprintf(w, "e2e_runs_total{test=%s,state=%s} %d\n", labelValue(s.name), labelValue(state), s.counts[TestState(state)])
+		}
+	}
+	writeMetricHeader(w, "e2e_run_duration_seconds", "gauge", "Duration of the latest run of each test, including retries.")
+	for _, s := range snapshots {
+		if s.last != nil {
+			fmt.Fprintf(w, "e2e_run_duration_seconds{test=%s} %g\n", labelValue(s.name), s.last.Duration.Seconds())
+		}
+	}
+	writeMetricHeader(w, "e2e_run_queue_wait_seconds", "gauge", "Time the latest run of each test waited for a concurrency slot.")
+	for _, s := range snapshots {
+		if s.last != nil {
+			fmt.Fprintf(w, "e2e_run_queue_wait_seconds{test=%s} %g\n", labelValue(s.name), s.last.QueueWait.Seconds())
+		}
+	}
+	writeMetricHeader(w, "e2e_subtest_duration_seconds", "gauge", "Duration of each subtest in the latest run of each test.")
+	for _, s := range snapshots {
+		if s.last == nil || s.last.Result == nil {
+			continue
+		}
+		var walk func(prefix string, res *Result)
+		walk = func(prefix string, res *Result) {
+			for _, c := range res.Children {
+				name := prefix + "/" + c.Name
+				fmt.Fprintf(w, "e2e_subtest_duration_seconds{test=%s,subtest=%s} %g\n", labelValue(s.name), labelValue(name), c.Duration.Seconds())
+				walk(name, c)
+			}
+		}
+		walk(s.name, s.last.Result)
+	}
+	writeMetricHeader(w, "e2e_reported_metric", "gauge", "Values reported by tests with ReportMetric in the latest run of each test.")
+	for _, s := range snapshots {
+		if s.last == nil {
+			continue
+		}
+		for _, m := range s.last.Metrics {
+			fmt.Fprintf(w, "e2e_reported_metric{test=%s,source=%s,unit=%s} %g\n", labelValue(s.name), labelValue(m.Test), labelValue(m.Unit), m.Value)
+		}
+	}
+}
+
+func writeMetricHeader(w io.Writer, name, typ, help string) {
+	fmt.Fprintf(w, "# HELP %s %s\n# TYPE %s %s\n", name, help, name, typ)
+}
+
+var labelReplacer = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+// labelValue quotes and escapes a Prometheus label value
+func labelValue(v string) string {
+	return `"` + labelReplacer.Replace(v) + `"`
+}
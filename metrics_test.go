@@ -0,0 +1,111 @@
+package e2e
+
+import (
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	r := &Runner{}
+	tr := &testRunner{
+		Name: "TestJourney",
+		t: func(t *T) {
+			t.Run("login", func(t *T) {
+				time.Sleep(10 * time.Millisecond)
+			})
+			t.Run("checkout", func(t *T) {
+				t.Error("failed")
+			})
+		},
+		n: defaultNotifier,
+	}
+	r.tests = map[string]*testRunner{tr.Name: tr}
+	r.runTest(tr)
+
+	w := httptest.NewRecorder()
+	r.MetricsHandler(w, httptest.NewRequest("GET", "/metrics", nil))
+	body := w.Body.String()
+	for _, expected := range []string{
+		`e2e_runs_total{test="TestJourney",state="FAILED"} 1`,
+		`e2e_run_duration_seconds{test="TestJourney"} `,
+		`e2e_subtest_duration_seconds{test="TestJourney",subtest="TestJourney/login"} 0.0`,
+		`e2e_subtest_duration_seconds{test="TestJourney",subtest="TestJourney/checkout"} `,
+	} {
+		if !strings.Contains(body, expected) {
+			t.Errorf("Expected metrics to contain %q, got:\n%s", expected, body)
+		}
+	}
+}
+
+func TestSubtestDuration(t *testing.T) {
+	tt := Run("parent", func(t *T) {
+		t.Run("slow", func(t *T) {
+			time.Sleep(20 * time.Millisecond)
+		})
+	})
+	sub := tt.subTests[0]
+	if d := sub.Duration(); d < 20*time.Millisecond {
+		t.Errorf("Expected subtest duration of at least 20ms, got %s", d)
+	}
+	if tt.Duration() < sub.Duration() {
+		t.Errorf("Expected parent duration %s to include subtest duration %s", tt.Duration(), sub.Duration())
+	}
+	if res := tt.Result().Find("slow"); res.Duration != sub.Duration() {
+		t.Errorf("Expected result duration %s, got %s", sub.Duration(), res.Duration)
+	}
+}
+
+func TestReportMetric(t *testing.T) {
+	n := &testNotifier{}
+	r := &Runner{}
+	tr := &testRunner{
+		Name: "TestQueue",
+		t: func(t *T) {
+			t.ReportMetric(42, "items")
+			t.Run("consume", func(t *T) {
+				t.ReportMetric(1.5, "lag_seconds")
+				t.Timer("poll").Stop()
+			})
+			t.ReportMetric(7, "bad unit")
+		},
+		n: n,
+	}
+	r.tests = map[string]*testRunner{tr.Name: tr}
+	r.runTest(tr)
+
+	if !n.n.Failed {
+		t.Error("Expected invalid unit to fail the test")
+	}
+	if logs := n.n.Result.Logs; len(logs) != 1 || logs[0].File != "metrics_test.go" {
+		t.Errorf("Expected the invalid unit to be reported at the call site, got %+v", logs)
+	}
+	metrics := n.n.Metrics
+	if len(metrics) != 3 {
+		t.Fatalf("Expected 3 metrics, got %+v", metrics)
+	}
+	if m := metrics[0]; m.Test != "TestQueue" || m.Unit != "items" || m.Value != 42 {
+		t.Errorf("Unexpected metric %+v", m)
+	}
+	if m := metrics[1]; m.Test != "TestQueue/consume" || m.Unit != "lag_seconds" || m.Value != 1.5 {
+		t.Errorf("Unexpected metric %+v", m)
+	}
+	if m := metrics[2]; m.Test != "TestQueue/consume" || m.Unit != "poll_seconds" {
+		t.Errorf("Unexpected metric %+v", m)
+	}
+	hist := r.History("TestQueue")
+	if len(hist[0].Metrics) != 3 {
+		t.Errorf("Expected metrics in history, got %+v", hist[0].Metrics)
+	}
+	if res := hist[0].Result.Find("consume"); res.Metrics["lag_seconds"] != 1.5 {
+		t.Errorf("Expected metrics in result, got %v", res.Metrics)
+	}
+
+	w := httptest.NewRecorder()
+	r.MetricsHandler(w, httptest.NewRequest("GET", "/metrics", nil))
+	expected := `e2e_reported_metric{test="TestQueue",source="TestQueue/consume",unit="lag_seconds"} 1.5`
+	if !strings.Contains(w.Body.String(), expected) {
+		t.Errorf("Expected metrics to contain %q, got:\n%s", expected, w.Body.String())
+	}
+}
@@ -1,18 +1,145 @@
 package e2e
 
-import "time"
+import (
+	"fmt"
+	"time"
+)
+
+// NotificationKind is the reason a notification was sent
+type NotificationKind string
+
+const (
+	// NotificationRun is sent after every run of a test
+	NotificationRun NotificationKind = "run"
+	// NotificationBudgetBurn is sent when a test's SLO starts burning its error budget too
+	// fast, it isn't the result of a run so Failed is always false
+	NotificationBudgetBurn NotificationKind = "budget_burn"
+)
 
 type Notification struct {
-	Name     string
-	Failed   bool
-	Output   []byte
+	Kind NotificationKind
+	Name string
+	// RunID identifies the run in the Runner's API, its attachments can be
+	// downloaded from /api/runs/{id}/artifacts/{test}/{name}
+	RunID  string
+	Failed bool
+	Output []byte
+	// Duration is the time taken by the run, including every attempt
+	// and the backoff between them
 	Duration time.Duration
+	// Attempts is the number of times the test was run, this will be
+	// greater than 1 if the test has a RetryPolicy and earlier attempts failed
+	Attempts int
+	// QueueWait is the time the run spent waiting for a concurrency slot
+	QueueWait time.Duration
+	// Metadata is the metadata the test was scheduled with
+	Metadata Metadata
+	// Result holds the pass or fail state of the test and each of its subtests
+	Result *Result
+	// Metrics are the values reported by the test with ReportMetric and Timer
+	Metrics []Metric
+	// Attachments are the files saved by the test with Attach
+	Attachments []Attachment
+	// SLO is the status of the SLO that caused a NotificationBudgetBurn
+	SLO *SLOStatus
 }
 
 type Notifier interface {
 	Notify(n Notification)
 }
 
+// NotifierFunc allows a func to be used as a Notifier
+type NotifierFunc func(n Notification)
+
+// Notify calls f(n)
+func (f NotifierFunc) Notify(n Notification) {
+	f(n)
+}
+
+// MultiNotifier sends every notification to all of its Notifiers
+type MultiNotifier []Notifier
+
+// Notify sends n to every notifier
+func (mn MultiNotifier) Notify(n Notification) {
+	for _, notifier := range mn {
+		notifier.Notify(n)
+	}
+}
+
+// Matcher is used to filter and route notifications
+type Matcher func(n Notification) bool
+
+// MatchTag matches notifications for tests with the given tag
+func MatchTag(tag string) Matcher {
+	return func(n Notification) bool {
+		return n.Metadata.HasTag(tag)
+	}
+}
+
+// MatchOwner matches notifications for tests owned by owner
+func MatchOwner(owner string) Matcher {
+	return func(n Notification) bool {
+		return n.Metadata.Owner == owner
+	}
+}
+
+// MatchSeverity matches notifications for tests with the given severity
+func MatchSeverity(s Severity) Matcher {
+	return func(n Notification) bool {
+		return n.Metadata.Severity == s
+	}
+}
+
+// FilterNotifier returns a Notifier that only forwards notifications matching m
+func FilterNotifier(notifier Notifier, m Matcher) Notifier {
+	return NotifierFunc(func(n Notification) {
+		if m(n) {
+			notifier.Notify(n)
+		}
+	})
+}
+
+// Route sends notifications that match to a notifier
+type Route struct {
+	Match    Matcher
+	Notifier Notifier
+}
+
+// Router sends each notification to the Notifier of the first matching Route, if no
+// Route matches the notification is sent to Default, if it is set. Routers should be
+// created with NewRouter so their routes are checked.
+type Router struct {
+	Routes  []Route
+	Default Notifier
+}
+
+// NewRouter creates a Router, it returns an error if any of the routes is missing
+// its Match or Notifier
+func NewRouter(def Notifier, routes ...Route) (*Router, error) {
+	for i, route := range routes {
+		if route.Match == nil {
+			return nil, fmt.Errorf("route %d has no Match", i)
+		}
+		if route.Notifier == nil {
+			return nil, fmt.Errorf("route %d has no Notifier", i)
+		}
+	}
+	return &Router{Routes: routes, Default: def}, nil
+}
+
+// Notify routes the notification
+func (r *Router) Notify(n Notification) {
+	for _, route := range r.Routes {
+		if route.Match(n) {
+			route.Notifier.Notify(n)
+			return
+		}
+	}
+	if r.Default != nil {
+		r.Default.Notify(n)
+	}
+}
+
 type noopNotifier struct{}
 
 func (nn noopNotifier) Notify(n Notification) {
@@ -0,0 +1,45 @@
+package e2e
+
+import "testing"
+
+func TestRouter(t *testing.T) {
+	var db, payments, other []string
+	record := func(names *[]string) Notifier {
+		return NotifierFunc(func(n Notification) {
+			*names = append(*names, n.Name)
+		})
+	}
+	r := &Router{
+		Routes: []Route{
+			{Match: MatchTag("db"), Notifier: record(&db)},
+			{Match: MatchOwner("payments"), Notifier: record(&payments)},
+		},
+		Default: FilterNotifier(record(&other), MatchSeverity(SeverityCritical)),
+	}
+	r.Notify(Notification{Name: "a", Metadata: Metadata{Tags: []string{"db"}, Owner: "payments"}})
+	r.Notify(Notification{Name: "b", Metadata: Metadata{Owner: "payments"}})
+	r.Notify(Notification{Name: "c", Metadata: Metadata{Severity: SeverityCritical}})
+	r.Notify(Notification{Name: "d", Metadata: Metadata{Severity: SeverityInfo}})
+	if len(db) != 1 || db[0] != "a" {
+		t.Errorf("Expected [a], got %v", db)
+	}
+	if len(payments) != 1 || payments[0] != "b" {
+		t.Errorf("Expected [b], got %v", payments)
+	}
+	if len(other) != 1 || other[0] != "c" {
+		t.Errorf("Expected [c], got %v", other)
+	}
+}
+
+func TestNewRouter(t *testing.T) {
+	noop := NotifierFunc(func(Notification) {})
+	if _, err := NewRouter(nil, Route{Match: MatchTag("db"), Notifier: noop}); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if _, err := NewRouter(nil, Route{Notifier: noop}); err == nil {
+		t.Error("Expected an error for a route without a Match")
+	}
+	if _, err := NewRouter(nil, Route{Match: MatchTag("db")}); err == nil {
+		t.Error("Expected an error for a route without a Notifier")
+	}
+}
@@ -0,0 +1,139 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/mux"
+)
+
+// OverlapPolicy decides what happens when a test is triggered while a previous run
+// of the same test is still in progress, either because the test took longer than
+// its interval or because it was forced
+type OverlapPolicy int
+
+const (
+	// OverlapQueueOne allows a single run to wait for the current run to finish,
+	// any further triggers are skipped
+	OverlapQueueOne OverlapPolicy = iota
+	// OverlapSkip skips the triggered run
+	OverlapSkip
+	// OverlapCancelPrevious cancels the context of the current run, and starts
+	// the triggered run as soon as it returns
+	OverlapCancelPrevious
+)
+
+// WithOverlapPolicy sets the policy used when a test is triggered while it is already running
+func WithOverlapPolicy(p OverlapPolicy) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.overlap = p
+	}
+}
+
+const (
+	EventSkipped   = "skipped"
+	EventCancelled = "cancelled"
+)
+
+// maxEvents is the number of events kept for each test
+const maxEvents = 100
+
+// Event records a trigger of a test that didn't result in a normal run
+type Event struct {
+	Time    time.Time
+	Name    string
+	Type    string
+	Message string
+}
+
+// trigger starts a run of the test, or applies the test's OverlapPolicy
+// if a run is already in progress
+func (r *Runner) trigger(tr *testRunner, source string) {
+	tr.stateMu.Lock()
+	defer tr.stateMu.Unlock()
+	if !tr.running {
+		tr.running = true
+		go r.runUntilIdle(tr)
+		return
+	}
+	switch tr.overlap {
+	case OverlapSkip:
+		r.addEvent(tr.Name, EventSkipped, fmt.Sprintf("%s trigger skipped, test already running", source))
+	case OverlapQueueOne:
+		if tr.pending {
+			r.addEvent(tr.Name, EventSkipped, fmt.Sprintf("%s trigger skipped, a run is already queued", source))
+			return
+		}
+		tr.pending = true
+	case OverlapCancelPrevious:
+		if tr.cancel != nil {
+			tr.cancel()
+		}
+		r.addEvent(tr.Name, EventCancelled, fmt.Sprintf("running test cancelled by %s trigger", source))
+		tr.pending = true
+	}
+}
+
+// runUntilIdle runs the test, then any run that was queued while it was running
+func (r *Runner) runUntilIdle(tr *testRunner) {
+	for {
+		r.runTest(tr)
+		tr.stateMu.Lock()
+		if !tr.pending {
+			tr.running = false
+			tr.stateMu.Unlock()
+			return
+		}
+		tr.pending = false
+		tr.stateMu.Unlock()
+	}
+}
+
+// start runs the test if it isn't already running, reporting whether it was started
+func (r *Runner) start(tr *testRunner) bool {
+	tr.stateMu.Lock()
+	defer tr.stateMu.Unlock()
+	if tr.running {
+		return false
+	}
+	tr.running = true
+	go r.runUntilIdle(tr)
+	return true
+}
+
+func (r *Runner) addEvent(name, typ, msg string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.events == nil {
+		r.events = make(map[string][]Event)
+	}
+	events := append(r.events[name], Event{
+		Time:    time.Now(),
+		Name:    name,
+		Type:    typ,
+		Message: msg,
+	})
+	if len(events) > maxEvents {
+		events = events[len(events)-maxEvents:]
+	}
+	r.events[name] = events
+}
+
+// Events returns the recorded events for the named test, oldest first
+func (r *Runner) Events(name string) []Event {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]Event(nil), r.events[name]...)
+}
+
+func (r *Runner) EventsHandler(w http.ResponseWriter, req *http.Request) {
+	name, ok := mux.Vars(req)["name"]
+	if !ok {
+		http.Error(w, "400 bad request (missing test name param)", http.StatusBadRequest)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(r.Events(name))
+}
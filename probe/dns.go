@@ -0,0 +1,37 @@
+package probe
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/arussellsaw/e2e"
+)
+
+// DNS returns a test which resolves name to its addresses, and fails unless every one
+// of expectRecords is among them. If no records are expected the name must resolve
+// to at least one address.
+func DNS(name string, expectRecords []string, opts ...Option) e2e.Test {
+	c := newConfig(opts)
+	return func(t *e2e.T) {
+		ctx, cancel := c.context(t.Context())
+		defer cancel()
+		addrs, err := c.resolver.LookupHost(ctx, name)
+		if err != nil {
+			t.Fatalf("resolving %s: %v", name, err)
+		}
+		sort.Strings(addrs)
+		t.Logw("resolved", "name", name, "records", strings.Join(addrs, ","))
+		if len(addrs) == 0 {
+			t.Fatalf("resolving %s: no records", name)
+		}
+		found := make(map[string]bool, len(addrs))
+		for _, a := range addrs {
+			found[a] = true
+		}
+		for _, want := range expectRecords {
+			if !found[want] {
+				t.Errorf("expected %s to resolve to %s, got %s", name, want, strings.Join(addrs, ", "))
+			}
+		}
+	}
+}
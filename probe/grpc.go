@@ -0,0 +1,389 @@
+package probe
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/arussellsaw/e2e"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionalphapb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/resolver"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// GRPCOption configures the calls made by a GRPC probe
+type GRPCOption func(c *grpcConfig)
+
+type grpcConfig struct {
+	metadata    []string
+	descriptors *protoregistry.Files
+}
+
+func newGRPCConfig(opts []GRPCOption) *grpcConfig {
+	c := &grpcConfig{}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithMetadata adds key value pairs to the metadata of the probe's gRPC call
+func WithMetadata(kv ...string) GRPCOption {
+	return func(c *grpcConfig) {
+		c.metadata = append(c.metadata, kv...)
+	}
+}
+
+// WithDescriptors sets the descriptors used to find the request and response types of
+// a GRPC probe's method, by default protoregistry.GlobalFiles is checked first, then
+// the server's reflection service
+func WithDescriptors(files *protoregistry.Files) GRPCOption {
+	return func(c *grpcConfig) {
+		c.descriptors = files
+	}
+}
+
+// WithGRPCOptions sets options for the calls made by GRPCHealth, such as WithMetadata
+func WithGRPCOptions(opts ...GRPCOption) Option {
+	return func(c *config) {
+		c.grpcOpts = append(c.grpcOpts, opts...)
+	}
+}
+
+// dial connects to a gRPC target, using TLS if the probe has a TLS config and
+// plaintext otherwise. gRPC resolves names itself, so with WithResolver the target
+// is passed through to a dialer using the probe's resolver, and targets with a
+// resolver scheme such as dns:/// are rejected.
+func (c *config) dial(target string) (*grpc.ClientConn, error) {
+	creds := insecure.NewCredentials()
+	if c.tlsConfig != nil {
+		creds = credentials.NewTLS(c.tlsConfig)
+	}
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if c.resolver != net.DefaultResolver {
+		if u, err := url.Parse(target); err == nil && resolver.Get(u.Scheme) != nil {
+			return nil, fmt.Errorf("WithResolver can't be used with the %s scheme", u.Scheme)
+		}
+		target = "passthrough:///" + target
+		opts = append(opts, grpc.WithContextDialer(func(ctx context.Context, addr string) (net.Conn, error) {
+			return c.dialer().DialContext(ctx, "tcp", addr)
+		}))
+	}
+	return grpc.NewClient(target, opts...)
+}
+
+// outgoing adds the probe's metadata to a context
+func (c *grpcConfig) outgoing(ctx context.Context) context.Context {
+	if len(c.metadata) == 0 {
+		return ctx
+	}
+	return metadata.AppendToOutgoingContext(ctx, c.metadata...)
+}
+
+// GRPCHealth returns a test which checks the health of service on target with the
+// standard gRPC health protocol, it fails unless the service is SERVING. An empty
+// service checks the health of the server as a whole. Connections are plaintext
+// unless WithTLSConfig is used, and metadata can be added to the check with
+// WithGRPCOptions and WithMetadata.
+func GRPCHealth(target, service string, opts ...Option) e2e.Test {
+	c := newConfig(opts)
+	gc := newGRPCConfig(c.grpcOpts)
+	return func(t *e2e.T) {
+		ctx, cancel := c.context(t.Context())
+		defer cancel()
+		conn, err := c.dial(target)
+		if err != nil {
+			t.Fatalf("connecting to %s: %v", target, err)
+		}
+		defer conn.Close()
+		start := time.Now()
+		resp, err := healthpb.NewHealthClient(conn).Check(gc.outgoing(ctx), &healthpb.HealthCheckRequest{Service: service})
+		if err != nil {
+			t.Fatalf("health check of %q on %s: %v", service, target, err)
+		}
+		t.Logw("health check", "target", target, "service", service, "status", resp.GetStatus().String(), "duration", time.Since(start))
+		if resp.GetStatus() != healthpb.HealthCheckResponse_SERVING {
+			t.Errorf("expected %q on %s to be SERVING, got %s", service, target, resp.GetStatus())
+		}
+	}
+}
+
+// GRPCProbe is a declarative check of a unary gRPC method, it's created with GRPC and
+// configured by chaining expectations, e.g.
+//
+//	probe.GRPC("orders.internal:443", "/orders.v1.Orders/GetOrder").
+//		WithRequest(`{"id": "test-order"}`).
+//		ExpectJSONPath("$.order.state", "SHIPPED").
+//		WithOptions(probe.WithTLSConfig(&tls.Config{})).
+//		WithGRPCOptions(probe.WithMetadata("x-probe", "e2e")).
+//		Test()
+//
+// The request and response types are found from registered descriptors, or with the
+// server's reflection service if the method isn't registered.
+type GRPCProbe struct {
+	target   string
+	method   string
+	request  string
+	opts     []Option
+	grpcOpts []GRPCOption
+
+	code      codes.Code
+	jsonPaths []jsonPathExpectation
+}
+
+// GRPC creates a probe which calls method on target, method is the full name of the
+// method in the form /package.Service/Method
+func GRPC(target, method string) *GRPCProbe {
+	return &GRPCProbe{
+		target:  target,
+		method:  method,
+		request: "{}",
+	}
+}
+
+// WithRequest sets the request message as protobuf JSON, it defaults to an empty message
+func (p *GRPCProbe) WithRequest(json string) *GRPCProbe {
+	p.request = json
+	return p
+}
+
+// WithOptions sets options for the probe, such as WithTimeout or WithTLSConfig
+func (p *GRPCProbe) WithOptions(opts ...Option) *GRPCProbe {
+	p.opts = append(p.opts, opts...)
+	return p
+}
+
+// WithGRPCOptions sets options for the probe's call, such as WithMetadata or WithDescriptors
+func (p *GRPCProbe) WithGRPCOptions(opts ...GRPCOption) *GRPCProbe {
+	p.grpcOpts = append(p.grpcOpts, opts...)
+	return p
+}
+
+// ExpectCode fails the probe unless the call returns the status code, it defaults to OK
+func (p *GRPCProbe) ExpectCode(code codes.Code) *GRPCProbe {
+	p.code = code
+	return p
+}
+
+// ExpectJSONPath fails the probe unless the value at path in the response is equal to value,
+// the response is encoded as protobuf JSON with the field names from the .proto file and
+// unset fields included. See HTTPProbe.ExpectJSONPath for the supported paths.
+func (p *GRPCProbe) ExpectJSONPath(path string, value interface{}) *GRPCProbe {
+	p.jsonPaths = append(p.jsonPaths, jsonPathExpectation{path, value})
+	return p
+}
+
+// Test returns the probe as a test which can be scheduled
+func (p *GRPCProbe) Test() e2e.Test {
+	c := newConfig(p.opts)
+	gc := newGRPCConfig(append(c.grpcOpts, p.grpcOpts...))
+	return func(t *e2e.T) {
+		ctx, cancel := c.context(t.Context())
+		defer cancel()
+		conn, err := c.dial(p.target)
+		if err != nil {
+			t.Fatalf("connecting to %s: %v", p.target, err)
+		}
+		defer conn.Close()
+
+		md, err := p.methodDescriptor(gc.outgoing(ctx), gc, conn)
+		if err != nil {
+			t.Fatalf("finding method %s: %v", p.method, err)
+		}
+		req := dynamicpb.NewMessage(md.Input())
+		if err := protojson.Unmarshal([]byte(p.request), req); err != nil {
+			t.Fatalf("invalid request for %s: %v", md.Input().FullName(), err)
+		}
+		resp := dynamicpb.NewMessage(md.Output())
+		start := time.Now()
+		err = conn.Invoke(gc.outgoing(ctx), p.method, req, resp)
+		code := status.Code(err)
+		t.Logw("grpc call", "target", p.target, "method", p.method, "code", code.String(), "duration", time.Since(start))
+		if code != p.code {
+			t.Fatalf("expected code %s from %s, got %s: %v", p.code, p.method, code, status.Convert(err).Message())
+		}
+		if err != nil || len(p.jsonPaths) == 0 {
+			return
+		}
+		b, err := protojson.MarshalOptions{UseProtoNames: true, EmitUnpopulated: true}.Marshal(resp)
+		if err != nil {
+			t.Fatalf("encoding response: %v", err)
+		}
+		var doc interface{}
+		if err := json.Unmarshal(b, &doc); err != nil {
+			t.Fatalf("decoding response: %v", err)
+		}
+		checkJSONPaths(t, doc, p.jsonPaths)
+	}
+}
+
+// methodDescriptor finds the descriptor of the probe's method, from the configured
+// descriptors, the global registry, or the server's reflection service
+func (p *GRPCProbe) methodDescriptor(ctx context.Context, c *grpcConfig, conn *grpc.ClientConn) (protoreflect.MethodDescriptor, error) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(p.method, "/"), "/")
+	if !ok {
+		return nil, fmt.Errorf("method must be in the form /package.Service/Method")
+	}
+	files := c.descriptors
+	if files == nil {
+		files = protoregistry.GlobalFiles
+		if _, err := files.FindDescriptorByName(protoreflect.FullName(service)); err != nil {
+			if files, err = reflectFiles(ctx, conn, service); err != nil {
+				return nil, fmt.Errorf("server reflection: %w", err)
+			}
+		}
+	}
+	d, err := files.FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return nil, err
+	}
+	sd, ok := d.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a service", service)
+	}
+	md := sd.Methods().ByName(protoreflect.Name(method))
+	if md == nil {
+		return nil, fmt.Errorf("service %s has no method %s", service, method)
+	}
+	if md.IsStreamingClient() || md.IsStreamingServer() {
+		return nil, fmt.Errorf("%s is a streaming method, only unary methods can be probed", p.method)
+	}
+	return md, nil
+}
+
+// reflectFiles uses the server reflection service to fetch the file defining symbol and
+// all of its dependencies, falling back to the v1alpha service for older servers
+func reflectFiles(ctx context.Context, conn *grpc.ClientConn, symbol string) (*protoregistry.Files, error) {
+	files, err := reflectFilesWith(ctx, symbol, func(ctx context.Context) (reflectionStream, error) {
+		return reflectionpb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+	})
+	if status.Code(err) == codes.Unimplemented {
+		files, err = reflectFilesWith(ctx, symbol, func(ctx context.Context) (reflectionStream, error) {
+			stream, err := reflectionalphapb.NewServerReflectionClient(conn).ServerReflectionInfo(ctx)
+			return alphaReflectionStream{stream}, err
+		})
+	}
+	return files, err
+}
+
+// reflectionStream is a stream to the server reflection service, it sends and
+// receives v1 messages whichever version the server speaks
+type reflectionStream interface {
+	Send(req *reflectionpb.ServerReflectionRequest) error
+	Recv() (*reflectionpb.ServerReflectionResponse, error)
+	CloseSend() error
+}
+
+func reflectFilesWith(ctx context.Context, symbol string, open func(ctx context.Context) (reflectionStream, error)) (*protoregistry.Files, error) {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := open(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer stream.CloseSend()
+
+	fds := make(map[string]*descriptorpb.FileDescriptorProto)
+	requested := make(map[string]bool)
+	req := &reflectionpb.ServerReflectionRequest{
+		MessageRequest: &reflectionpb.ServerReflectionRequest_FileContainingSymbol{FileContainingSymbol: symbol},
+	}
+	for req != nil {
+		if err := stream.Send(req); err != nil {
+			return nil, err
+		}
+		resp, err := stream.Recv()
+		if err != nil {
+			return nil, err
+		}
+		if e := resp.GetErrorResponse(); e != nil {
+			return nil, status.Error(codes.Code(e.GetErrorCode()), e.GetErrorMessage())
+		}
+		for _, b := range resp.GetFileDescriptorResponse().GetFileDescriptorProto() {
+			fd := &descriptorpb.FileDescriptorProto{}
+			if err := proto.Unmarshal(b, fd); err != nil {
+				return nil, err
+			}
+			fds[fd.GetName()] = fd
+		}
+		// the server may leave out dependencies it has already sent on the stream,
+		// or that it expects the client to have, so request any that are missing.
+		// Each file is only requested once, in case the server never sends it.
+		req = nil
+	missing:
+		for _, fd := range fds {
+			for _, dep := range fd.GetDependency() {
+				if _, ok := fds[dep]; ok {
+					continue
+				}
+				if requested[dep] {
+					return nil, fmt.Errorf("server didn't send %s, a dependency of %s", dep, fd.GetName())
+				}
+				requested[dep] = true
+				req = &reflectionpb.ServerReflectionRequest{
+					MessageRequest: &reflectionpb.ServerReflectionRequest_FileByFilename{FileByFilename: dep},
+				}
+				break missing
+			}
+		}
+	}
+	set := &descriptorpb.FileDescriptorSet{}
+	for _, fd := range fds {
+		set.File = append(set.File, fd)
+	}
+	return protodesc.NewFiles(set)
+}
+
+// alphaReflectionStream adapts a v1alpha reflection stream to v1 messages, the two
+// versions are identical on the wire so messages are converted by re-encoding them
+type alphaReflectionStream struct {
+	stream reflectionalphapb.ServerReflection_ServerReflectionInfoClient
+}
+
+func (s alphaReflectionStream) Send(req *reflectionpb.ServerReflectionRequest) error {
+	alpha := &reflectionalphapb.ServerReflectionRequest{}
+	if err := convertMessage(req, alpha); err != nil {
+		return err
+	}
+	return s.stream.Send(alpha)
+}
+
+func (s alphaReflectionStream) Recv() (*reflectionpb.ServerReflectionResponse, error) {
+	alpha, err := s.stream.Recv()
+	if err != nil {
+		return nil, err
+	}
+	resp := &reflectionpb.ServerReflectionResponse{}
+	return resp, convertMessage(alpha, resp)
+}
+
+func (s alphaReflectionStream) CloseSend() error {
+	return s.stream.CloseSend()
+}
+
+// convertMessage copies from into to, which must have the same wire format
+func convertMessage(from, to proto.Message) error {
+	b, err := proto.Marshal(from)
+	if err != nil {
+		return err
+	}
+	return proto.Unmarshal(b, to)
+}
@@ -0,0 +1,326 @@
+package probe
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arussellsaw/e2e"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/reflection"
+	reflectionpb "google.golang.org/grpc/reflection/grpc_reflection_v1"
+	reflectionalphapb "google.golang.org/grpc/reflection/grpc_reflection_v1alpha"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+)
+
+// echoFiles describes a service which isn't in the global registry, so probes
+// have to find it with server reflection
+func echoFiles(t *testing.T) *protoregistry.Files {
+	field := func(name string, number int32, typ descriptorpb.FieldDescriptorProto_Type, label descriptorpb.FieldDescriptorProto_Label) *descriptorpb.FieldDescriptorProto {
+		return &descriptorpb.FieldDescriptorProto{
+			Name:     proto.String(name),
+			JsonName: proto.String(name),
+			Number:   proto.Int32(number),
+			Type:     typ.Enum(),
+			Label:    label.Enum(),
+		}
+	}
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	fd := &descriptorpb.FileDescriptorProto{
+		Name:    proto.String("probetest/echo.proto"),
+		Package: proto.String("probetest"),
+		Syntax:  proto.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: proto.String("EchoRequest"), Field: []*descriptorpb.FieldDescriptorProto{
+				field("message", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional),
+			}},
+			{Name: proto.String("EchoResponse"), Field: []*descriptorpb.FieldDescriptorProto{
+				field("message", 1, descriptorpb.FieldDescriptorProto_TYPE_STRING, optional),
+				field("tags", 2, descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_LABEL_REPEATED),
+			}},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name: proto.String("Echo"),
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       proto.String("Echo"),
+				InputType:  proto.String(".probetest.EchoRequest"),
+				OutputType: proto.String(".probetest.EchoResponse"),
+			}},
+		}},
+	}
+	files, err := protodesc.NewFiles(&descriptorpb.FileDescriptorSet{File: []*descriptorpb.FileDescriptorProto{fd}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+// grpcServer starts an in-process server with the health, reflection and echo services
+func grpcServer(t *testing.T, opts ...grpc.ServerOption) string {
+	s, files := newGRPCServer(t, opts...)
+	reflectionpb.RegisterServerReflectionServer(s, reflection.NewServerV1(reflection.ServerOptions{
+		Services:           s,
+		DescriptorResolver: files,
+	}))
+	return serveGRPC(t, s)
+}
+
+// newGRPCServer creates a server with the health and echo services, it returns the
+// files describing the echo service so a reflection service can be added
+func newGRPCServer(t *testing.T, opts ...grpc.ServerOption) (*grpc.Server, *protoregistry.Files) {
+	files := echoFiles(t)
+	d, _ := files.FindDescriptorByName("probetest.Echo")
+	echo := d.(protoreflect.ServiceDescriptor).Methods().ByName("Echo")
+
+	s := grpc.NewServer(opts...)
+	s.RegisterService(&grpc.ServiceDesc{
+		ServiceName: "probetest.Echo",
+		HandlerType: (*interface{})(nil),
+		Methods: []grpc.MethodDesc{{
+			MethodName: "Echo",
+			Handler: func(_ interface{}, ctx context.Context, dec func(interface{}) error, _ grpc.UnaryServerInterceptor) (interface{}, error) {
+				req := dynamicpb.NewMessage(echo.Input())
+				if err := dec(req); err != nil {
+					return nil, err
+				}
+				msg := req.Get(echo.Input().Fields().ByName("message")).String()
+				if msg == "" {
+					return nil, status.Error(codes.InvalidArgument, "message is required")
+				}
+				resp := dynamicpb.NewMessage(echo.Output())
+				resp.Set(echo.Output().Fields().ByName("message"), protoreflect.ValueOfString(msg))
+				md, _ := metadata.FromIncomingContext(ctx)
+				tags := resp.Mutable(echo.Output().Fields().ByName("tags")).List()
+				for _, v := range md.Get("x-probe") {
+					tags.Append(protoreflect.ValueOfString(v))
+				}
+				return resp, nil
+			},
+		}},
+	}, struct{}{})
+
+	hs := health.NewServer()
+	hs.SetServingStatus("probetest.Echo", healthpb.HealthCheckResponse_SERVING)
+	hs.SetServingStatus("down", healthpb.HealthCheckResponse_NOT_SERVING)
+	healthpb.RegisterHealthServer(s, hs)
+	return s, files
+}
+
+// serveGRPC serves s on a local port until the test ends
+func serveGRPC(t *testing.T, s *grpc.Server) string {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go s.Serve(l)
+	t.Cleanup(s.Stop)
+	return l.Addr().String()
+}
+
+func TestGRPCHealth(t *testing.T) {
+	addr := grpcServer(t)
+	tc := []struct {
+		service string
+		failed  string
+	}{
+		{"", ""},
+		{"probetest.Echo", ""},
+		{"down", `expected "down" on ` + addr + ` to be SERVING, got NOT_SERVING`},
+		{"missing", "NotFound"},
+	}
+	for _, tt := range tc {
+		et := e2e.Run("health", GRPCHealth(addr, tt.service, WithTimeout(5*time.Second)))
+		if tt.failed == "" {
+			if et.Failed() {
+				t.Errorf("Expected %q to pass, got %s", tt.service, et.Output())
+			}
+			continue
+		}
+		if !et.Failed() || !strings.Contains(string(et.Output()), tt.failed) {
+			t.Errorf("Expected %q to fail with %q, got %s", tt.service, tt.failed, et.Output())
+		}
+	}
+}
+
+func TestGRPCHealthTLS(t *testing.T) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "localhost"},
+		IPAddresses:  []net.IP{net.ParseIP("127.0.0.1")},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, _ := x509.ParseCertificate(der)
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	creds := credentials.NewTLS(&tls.Config{Certificates: []tls.Certificate{{Certificate: [][]byte{der}, PrivateKey: key}}})
+	addr := grpcServer(t, grpc.Creds(creds))
+
+	if et := e2e.Run("health", GRPCHealth(addr, "", WithTLSConfig(&tls.Config{RootCAs: roots}))); et.Failed() {
+		t.Errorf("Expected probe to pass over TLS, got %s", et.Output())
+	}
+	if et := e2e.Run("health", GRPCHealth(addr, "", WithTimeout(time.Second))); !et.Failed() {
+		t.Error("Expected plaintext probe of TLS server to fail")
+	}
+}
+
+func TestGRPC(t *testing.T) {
+	addr := grpcServer(t)
+	tc := []struct {
+		name   string
+		probe  *GRPCProbe
+		failed string
+	}{
+		{"reflection", GRPC(addr, "/probetest.Echo/Echo").
+			WithRequest(`{"message": "hello"}`).
+			WithGRPCOptions(WithMetadata("x-probe", "e2e")).
+			ExpectJSONPath("$.message", "hello").
+			ExpectJSONPath("$.tags", []string{"e2e"}), ""},
+		{"descriptors", GRPC(addr, "/probetest.Echo/Echo").
+			WithRequest(`{"message": "hello"}`).
+			WithGRPCOptions(WithDescriptors(echoFiles(t))).
+			ExpectJSONPath("$.tags", []string{}), ""},
+		{"registered", GRPC(addr, "/grpc.health.v1.Health/Check").
+			WithRequest(`{"service": "down"}`).
+			ExpectJSONPath("$.status", "NOT_SERVING"), ""},
+		{"expected code", GRPC(addr, "/probetest.Echo/Echo").ExpectCode(codes.InvalidArgument), ""},
+		{"unexpected code", GRPC(addr, "/probetest.Echo/Echo"), "expected code OK from /probetest.Echo/Echo, got InvalidArgument: message is required"},
+		{"unexpected value", GRPC(addr, "/probetest.Echo/Echo").
+			WithRequest(`{"message": "hello"}`).
+			ExpectJSONPath("$.message", "goodbye"), `$.message: expected "goodbye", got "hello"`},
+		{"invalid request", GRPC(addr, "/probetest.Echo/Echo").WithRequest(`{"count": 1}`), "invalid request for probetest.EchoRequest"},
+		{"missing method", GRPC(addr, "/probetest.Echo/Missing"), "service probetest.Echo has no method Missing"},
+		{"missing service", GRPC(addr, "/probetest.Missing/Echo"), "server reflection"},
+	}
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			et := e2e.Run("grpc", tt.probe.WithOptions(WithTimeout(5*time.Second)).Test())
+			if tt.failed == "" {
+				if et.Failed() {
+					t.Errorf("Expected probe to pass, got %s", et.Output())
+				}
+				return
+			}
+			if !et.Failed() || !strings.Contains(string(et.Output()), tt.failed) {
+				t.Errorf("Expected failure containing %q, got %s", tt.failed, et.Output())
+			}
+		})
+	}
+}
+
+func TestGRPCReflectionV1Alpha(t *testing.T) {
+	s, files := newGRPCServer(t)
+	reflectionalphapb.RegisterServerReflectionServer(s, reflection.NewServer(reflection.ServerOptions{
+		Services:           s,
+		DescriptorResolver: files,
+	}))
+	addr := serveGRPC(t, s)
+	p := GRPC(addr, "/probetest.Echo/Echo").
+		WithRequest(`{"message": "hello"}`).
+		ExpectJSONPath("$.message", "hello").
+		WithOptions(WithTimeout(5 * time.Second))
+	if et := e2e.Run("grpc", p.Test()); et.Failed() {
+		t.Errorf("Expected probe to pass with v1alpha reflection, got %s", et.Output())
+	}
+}
+
+func TestGRPCHealthMetadata(t *testing.T) {
+	addr := grpcServer(t, grpc.UnaryInterceptor(func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		if md, _ := metadata.FromIncomingContext(ctx); len(md.Get("x-probe")) == 0 {
+			return nil, status.Error(codes.Unauthenticated, "x-probe is required")
+		}
+		return handler(ctx, req)
+	}))
+	if et := e2e.Run("health", GRPCHealth(addr, "", WithTimeout(5*time.Second), WithGRPCOptions(WithMetadata("x-probe", "e2e")))); et.Failed() {
+		t.Errorf("Expected probe with metadata to pass, got %s", et.Output())
+	}
+	if et := e2e.Run("health", GRPCHealth(addr, "", WithTimeout(5*time.Second))); !et.Failed() || !strings.Contains(string(et.Output()), "Unauthenticated") {
+		t.Errorf("Expected probe without metadata to fail, got %s", et.Output())
+	}
+}
+
+func TestGRPCResolver(t *testing.T) {
+	_, port, _ := net.SplitHostPort(grpcServer(t))
+	resolver := dnsServer(t, "grpc.test", "127.0.0.1")
+	if et := e2e.Run("health", GRPCHealth("grpc.test:"+port, "", WithResolver(resolver), WithTimeout(5*time.Second))); et.Failed() {
+		t.Errorf("Expected probe to resolve the target with the resolver, got %s", et.Output())
+	}
+	et := e2e.Run("health", GRPCHealth("dns:///grpc.test:"+port, "", WithResolver(resolver), WithTimeout(5*time.Second)))
+	if !et.Failed() || !strings.Contains(string(et.Output()), "WithResolver can't be used with the dns scheme") {
+		t.Errorf("Expected a target with a scheme to be rejected, got %s", et.Output())
+	}
+}
+
+// missingDependencyStream is a reflection stream which sends a file, but never its dependency
+type missingDependencyStream struct {
+	requests int
+}
+
+func (s *missingDependencyStream) Send(req *reflectionpb.ServerReflectionRequest) error {
+	s.requests++
+	return nil
+}
+
+func (s *missingDependencyStream) Recv() (*reflectionpb.ServerReflectionResponse, error) {
+	fds := &reflectionpb.FileDescriptorResponse{}
+	if s.requests == 1 {
+		b, err := proto.Marshal(&descriptorpb.FileDescriptorProto{
+			Name:       proto.String("echo.proto"),
+			Dependency: []string{"missing.proto"},
+		})
+		if err != nil {
+			return nil, err
+		}
+		fds.FileDescriptorProto = [][]byte{b}
+	}
+	return &reflectionpb.ServerReflectionResponse{
+		MessageResponse: &reflectionpb.ServerReflectionResponse_FileDescriptorResponse{FileDescriptorResponse: fds},
+	}, nil
+}
+
+func (s *missingDependencyStream) CloseSend() error {
+	return nil
+}
+
+func TestReflectMissingDependency(t *testing.T) {
+	stream := &missingDependencyStream{}
+	_, err := reflectFilesWith(context.Background(), "probetest.Echo", func(ctx context.Context) (reflectionStream, error) {
+		return stream, nil
+	})
+	if err == nil || !strings.Contains(err.Error(), "server didn't send missing.proto") {
+		t.Errorf("Expected an error for the missing dependency, got %v", err)
+	}
+	if stream.requests != 2 {
+		t.Errorf("Expected the dependency to be requested once, got %d requests", stream.requests)
+	}
+}
@@ -0,0 +1,285 @@
+package probe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/arussellsaw/e2e"
+)
+
+// HTTPProbe is a declarative check of an HTTP endpoint, it's created with HTTP and
+// configured by chaining expectations, e.g.
+//
+//	probe.HTTP("GET", "https://api.example.com/health").
+//		ExpectStatus(200).
+//		ExpectJSONPath("$.status", "ok").
+//		MaxLatency(500 * time.Millisecond).
+//		Test()
+type HTTPProbe struct {
+	method string
+	url    string
+	header http.Header
+	body   []byte
+	opts   []Option
+
+	status     int
+	headers    []headerExpectation
+	jsonPaths  []jsonPathExpectation
+	maxLatency time.Duration
+}
+
+type headerExpectation struct {
+	name, value string
+}
+
+type jsonPathExpectation struct {
+	path  string
+	value interface{}
+}
+
+// HTTP creates a probe which makes a request to url
+func HTTP(method, url string) *HTTPProbe {
+	return &HTTPProbe{
+		method: method,
+		url:    url,
+		header: make(http.Header),
+	}
+}
+
+// WithHeader adds a header to the request, a Host header sets the host the request
+// is sent for, e.g. to check a virtual host by the address of one of its servers
+func (p *HTTPProbe) WithHeader(name, value string) *HTTPProbe {
+	p.header.Add(name, value)
+	return p
+}
+
+// WithBody sets the body of the request and its content type
+func (p *HTTPProbe) WithBody(contentType string, body []byte) *HTTPProbe {
+	p.header.Set("Content-Type", contentType)
+	p.body = body
+	return p
+}
+
+// WithOptions sets options for the probe, such as WithTimeout or WithTLSConfig
+func (p *HTTPProbe) WithOptions(opts ...Option) *HTTPProbe {
+	p.opts = append(p.opts, opts...)
+	return p
+}
+
+// ExpectStatus fails the probe unless the response has the status code, without
+// it any status below 400 passes
+func (p *HTTPProbe) ExpectStatus(code int) *HTTPProbe {
+	p.status = code
+	return p
+}
+
+// ExpectHeader fails the probe unless the response has a header with the value
+func (p *HTTPProbe) ExpectHeader(name, value string) *HTTPProbe {
+	p.headers = append(p.headers, headerExpectation{name, value})
+	return p
+}
+
+// ExpectJSONPath fails the probe unless the value at path in the JSON response body is
+// equal to value. Paths are a subset of JSONPath, made of object keys and array indexes,
+// e.g. $.items[0].name or $['content-type']. Values are compared after encoding them
+// as JSON, so numbers of any type can be compared.
+func (p *HTTPProbe) ExpectJSONPath(path string, value interface{}) *HTTPProbe {
+	p.jsonPaths = append(p.jsonPaths, jsonPathExpectation{path, value})
+	return p
+}
+
+// MaxLatency fails the probe if the request takes longer than d, including reading the body
+func (p *HTTPProbe) MaxLatency(d time.Duration) *HTTPProbe {
+	p.maxLatency = d
+	return p
+}
+
+// Test returns the probe as a test which can be scheduled
+func (p *HTTPProbe) Test() e2e.Test {
+	c := newConfig(p.opts)
+	return func(t *e2e.T) {
+		ctx, cancel := c.context(t.Context())
+		defer cancel()
+		req, err := http.NewRequestWithContext(ctx, p.method, p.url, bytes.NewReader(p.body))
+		if err != nil {
+			t.Fatalf("creating request: %v", err)
+		}
+		for name, values := range p.header {
+			req.Header[name] = values
+		}
+		// the client sends req.Host rather than a Host header
+		if host := p.header.Get("Host"); host != "" {
+			req.Host = host
+		}
+		transport := http.DefaultTransport.(*http.Transport).Clone()
+		transport.DialContext = c.dialer().DialContext
+		transport.TLSClientConfig = c.tlsConfig
+		// each run measures a fresh connection, so don't leave this one's pool open
+		defer transport.CloseIdleConnections()
+		client := t.HTTPClient(e2e.WithRoundTripper(transport))
+
+		start := time.Now()
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("%s %s: %v", p.method, p.url, err)
+		}
+		defer resp.Body.Close()
+		body, err := io.ReadAll(resp.Body)
+		elapsed := time.Since(start)
+		if err != nil {
+			t.Fatalf("reading response body: %v", err)
+		}
+
+		switch {
+		case p.status != 0 && resp.StatusCode != p.status:
+			t.Errorf("expected status %d %s, got %s", p.status, http.StatusText(p.status), resp.Status)
+		case p.status == 0 && resp.StatusCode >= 400:
+			t.Errorf("expected a successful status, got %s", resp.Status)
+		}
+		for _, h := range p.headers {
+			if !contains(resp.Header.Values(h.name), h.value) {
+				t.Errorf("expected header %s: %s, got %q", h.name, h.value, resp.Header.Values(h.name))
+			}
+		}
+		if len(p.jsonPaths) > 0 {
+			p.checkJSON(t, body)
+		}
+		if p.maxLatency > 0 && elapsed > p.maxLatency {
+			t.Errorf("request took %s, more than the max latency of %s", elapsed, p.maxLatency)
+		}
+	}
+}
+
+func (p *HTTPProbe) checkJSON(t *e2e.T, body []byte) {
+	var doc interface{}
+	if err := json.Unmarshal(body, &doc); err != nil {
+		t.Errorf("response body is not valid JSON: %v", err)
+		return
+	}
+	checkJSONPaths(t, doc, p.jsonPaths)
+}
+
+// checkJSONPaths fails the test for each expected value which doesn't match the
+// value at its path in a decoded JSON document
+func checkJSONPaths(t *e2e.T, doc interface{}, paths []jsonPathExpectation) {
+	for _, jp := range paths {
+		got, err := lookupJSONPath(doc, jp.path)
+		if err != nil {
+			t.Errorf("%s: %v", jp.path, err)
+			continue
+		}
+		want, err := normalizeJSON(jp.value)
+		if err != nil {
+			t.Errorf("%s: invalid expected value: %v", jp.path, err)
+			continue
+		}
+		if !reflect.DeepEqual(got, want) {
+			gb, _ := json.Marshal(got)
+			wb, _ := json.Marshal(want)
+			t.Errorf("%s: expected %s, got %s", jp.path, wb, gb)
+		}
+	}
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+// normalizeJSON converts a value to the types produced by decoding JSON into an interface{}
+func normalizeJSON(v interface{}) (interface{}, error) {
+	v = convertYAML(v)
+	b, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var n interface{}
+	err = json.Unmarshal(b, &n)
+	return n, err
+}
+
+// convertYAML converts the map[interface{}]interface{} values produced by decoding
+// YAML to map[string]interface{}, so they can be encoded as JSON
+func convertYAML(v interface{}) interface{} {
+	switch v := v.(type) {
+	case map[interface{}]interface{}:
+		m := make(map[string]interface{}, len(v))
+		for k, val := range v {
+			m[fmt.Sprint(k)] = convertYAML(val)
+		}
+		return m
+	case []interface{}:
+		s := make([]interface{}, len(v))
+		for i, val := range v {
+			s[i] = convertYAML(val)
+		}
+		return s
+	}
+	return v
+}
+
+// lookupJSONPath finds the value at path in a decoded JSON document
+func lookupJSONPath(doc interface{}, path string) (interface{}, error) {
+	if !strings.HasPrefix(path, "$") {
+		return nil, fmt.Errorf("path must start with $")
+	}
+	rest := path[1:]
+	v := doc
+	for rest != "" {
+		var key string
+		index := -1
+		switch {
+		case strings.HasPrefix(rest, "."):
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			key, rest = rest[1:end+1], rest[end+1:]
+		case strings.HasPrefix(rest, "['"):
+			end := strings.Index(rest, "']")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated key in path")
+			}
+			key, rest = rest[2:end], rest[end+2:]
+		case strings.HasPrefix(rest, "["):
+			end := strings.Index(rest, "]")
+			if end < 0 {
+				return nil, fmt.Errorf("unterminated index in path")
+			}
+			i, err := strconv.Atoi(rest[1:end])
+			if err != nil {
+				return nil, fmt.Errorf("invalid index %q in path", rest[1:end])
+			}
+			index, rest = i, rest[end+1:]
+		default:
+			return nil, fmt.Errorf("unexpected %q in path", rest)
+		}
+		if index >= 0 {
+			a, ok := v.([]interface{})
+			if !ok || index >= len(a) {
+				return nil, fmt.Errorf("no element at index %d", index)
+			}
+			v = a[index]
+			continue
+		}
+		m, ok := v.(map[string]interface{})
+		if !ok {
+			return nil, fmt.Errorf("no key %q, value is not an object", key)
+		}
+		if v, ok = m[key]; !ok {
+			return nil, fmt.Errorf("no key %q", key)
+		}
+	}
+	return v, nil
+}
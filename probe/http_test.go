@@ -0,0 +1,141 @@
+package probe
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arussellsaw/e2e"
+)
+
+func healthServer(t *testing.T) *httptest.Server {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/health":
+			w.Header().Set("Content-Type", "application/json")
+			w.Write([]byte(`{"status":"ok","checks":[{"name":"db","latency":3}],"content-type":"json"}`))
+		case "/slow":
+			time.Sleep(50 * time.Millisecond)
+		case "/host":
+			w.Write([]byte(`{"host":"` + r.Host + `"}`))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	t.Cleanup(srv.Close)
+	return srv
+}
+
+func TestHTTP(t *testing.T) {
+	srv := healthServer(t)
+	tc := []struct {
+		name   string
+		probe  *HTTPProbe
+		failed string
+	}{
+		{"passes", HTTP("GET", srv.URL+"/health").
+			ExpectStatus(200).
+			ExpectHeader("Content-Type", "application/json").
+			ExpectJSONPath("$.status", "ok").
+			ExpectJSONPath("$.checks[0].latency", 3).
+			ExpectJSONPath("$['content-type']", "json").
+			MaxLatency(time.Second), ""},
+		{"default status", HTTP("GET", srv.URL+"/missing"), "expected a successful status, got 404 Not Found"},
+		{"status", HTTP("GET", srv.URL+"/health").ExpectStatus(201), "expected status 201 Created, got 200 OK"},
+		{"header", HTTP("GET", srv.URL+"/health").ExpectHeader("Content-Type", "text/plain"), `expected header Content-Type: text/plain, got ["application/json"]`},
+		{"json value", HTTP("GET", srv.URL+"/health").ExpectJSONPath("$.status", "degraded"), `$.status: expected "degraded", got "ok"`},
+		{"json missing", HTTP("GET", srv.URL+"/health").ExpectJSONPath("$.checks[1].name", "db"), "$.checks[1].name: no element at index 1"},
+		{"not json", HTTP("GET", srv.URL+"/slow").ExpectJSONPath("$.status", "ok"), "response body is not valid JSON"},
+		{"latency", HTTP("GET", srv.URL+"/slow").MaxLatency(time.Millisecond), "more than the max latency of 1ms"},
+		{"host", HTTP("GET", srv.URL+"/host").WithHeader("Host", "api.example.com").ExpectJSONPath("$.host", "api.example.com"), ""},
+	}
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			et := e2e.Run("http", tt.probe.Test())
+			if tt.failed == "" {
+				if et.Failed() {
+					t.Errorf("Expected probe to pass, got %s", et.Output())
+				}
+				return
+			}
+			if !et.Failed() || !strings.Contains(string(et.Output()), tt.failed) {
+				t.Errorf("Expected failure containing %q, got %s", tt.failed, et.Output())
+			}
+		})
+	}
+}
+
+func TestHTTPCaller(t *testing.T) {
+	srv := healthServer(t)
+	et := e2e.Run("http", HTTP("GET", srv.URL+"/missing").Test())
+	records := et.Records()
+	if len(records) != 2 {
+		t.Fatalf("Expected 2 log records, got %d", len(records))
+	}
+	for _, rec := range records {
+		if rec.File != "http.go" {
+			t.Errorf("Expected %q to be logged from the probe, got %s:%d", rec.Message, rec.File, rec.Line)
+		}
+	}
+}
+
+func TestHTTPConfig(t *testing.T) {
+	srv := healthServer(t)
+	path := filepath.Join(t.TempDir(), "probes.yaml")
+	config := `
+http:
+  - name: TestHealth
+    url: ` + srv.URL + `/health
+    interval: 30s
+    tags: [api]
+    expect:
+      status: 200
+      json:
+        $.status: ok
+        $.checks[0]: {name: db, latency: 3}
+      max_latency: 1s
+`
+	if err := os.WriteFile(path, []byte(config), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	c, err := LoadHTTPConfig(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reg := &e2e.Registry{}
+	c.Register(reg)
+	tests := reg.Tests(e2e.Filter{Tags: []string{"api"}})
+	if len(tests) != 1 || tests[0].Name != "TestHealth" {
+		t.Fatalf("Expected TestHealth to be registered, got %+v", tests)
+	}
+	if et := e2e.Run("TestHealth", tests[0].Test); et.Failed() {
+		t.Errorf("Expected probe to pass, got %s", et.Output())
+	}
+
+	os.WriteFile(path, []byte("http:\n  - name: TestNoURL\n"), 0o644)
+	if _, err := LoadHTTPConfig(path); err == nil {
+		t.Error("Expected error for probe without url")
+	}
+	jsonPath := filepath.Join(t.TempDir(), "probes.json")
+	os.WriteFile(jsonPath, []byte(`{"http": [{"name": "TestHealth", "url": "http://localhost", "intervl": "30s"}]}`), 0o644)
+	if _, err := LoadHTTPConfig(jsonPath); err == nil {
+		t.Error("Expected error for unknown field")
+	}
+
+	for _, tc := range []struct {
+		pc       HTTPProbeConfig
+		expected string
+	}{
+		{HTTPProbeConfig{Body: `{"id": 1}`, ContentType: "application/json"}, "application/json"},
+		{HTTPProbeConfig{Body: "hello"}, "text/plain; charset=utf-8"},
+		{HTTPProbeConfig{Body: "hello", Headers: map[string]string{"Content-Type": "text/csv"}}, "text/csv"},
+	} {
+		if ct := tc.pc.Probe().header.Get("Content-Type"); ct != tc.expected {
+			t.Errorf("Expected Content-Type %q, got %q", tc.expected, ct)
+		}
+	}
+}
@@ -0,0 +1,151 @@
+package probe
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/arussellsaw/e2e"
+	"gopkg.in/yaml.v2"
+)
+
+// HTTPConfig declares HTTP probes in a YAML or JSON file, so simple endpoint checks
+// can be added without writing code, e.g.
+//
+//	http:
+//	  - name: TestAPIHealth
+//	    method: GET
+//	    url: https://api.example.com/health
+//	    headers:
+//	      Accept: application/json
+//	    interval: 30s
+//	    timeout: 5s
+//	    tags: [api]
+//	    expect:
+//	      status: 200
+//	      headers:
+//	        Content-Type: application/json
+//	      json:
+//	        $.status: ok
+//	      max_latency: 500ms
+type HTTPConfig struct {
+	Probes []HTTPProbeConfig `json:"http" yaml:"http"`
+}
+
+// HTTPProbeConfig is the configuration of a single HTTP probe, the Content-Type of
+// Body is detected from the body unless ContentType or a Content-Type header is set
+type HTTPProbeConfig struct {
+	Name        string            `json:"name" yaml:"name"`
+	Method      string            `json:"method" yaml:"method"`
+	URL         string            `json:"url" yaml:"url"`
+	Headers     map[string]string `json:"headers" yaml:"headers"`
+	Body        string            `json:"body" yaml:"body"`
+	ContentType string            `json:"content_type" yaml:"content_type"`
+	Interval    e2e.Duration      `json:"interval" yaml:"interval"`
+	Timeout     e2e.Duration      `json:"timeout" yaml:"timeout"`
+	Tags        []string          `json:"tags" yaml:"tags"`
+	Expect      struct {
+		Status     int                    `json:"status" yaml:"status"`
+		Headers    map[string]string      `json:"headers" yaml:"headers"`
+		JSON       map[string]interface{} `json:"json" yaml:"json"`
+		MaxLatency e2e.Duration           `json:"max_latency" yaml:"max_latency"`
+	} `json:"expect" yaml:"expect"`
+}
+
+// LoadHTTPConfig reads HTTP probes from a file, files with a .yaml or .yml
+// extension are parsed as YAML, anything else is parsed as JSON
+func LoadHTTPConfig(path string) (*HTTPConfig, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	c := &HTTPConfig{}
+	switch filepath.Ext(path) {
+	case ".yaml", ".yml":
+		err = yaml.UnmarshalStrict(b, c)
+	default:
+		dec := json.NewDecoder(bytes.NewReader(b))
+		dec.DisallowUnknownFields()
+		err = dec.Decode(c)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("probe: parsing config %s: %v", path, err)
+	}
+	for i, pc := range c.Probes {
+		if pc.Name == "" || pc.URL == "" {
+			return nil, fmt.Errorf("probe: parsing config %s: probe %d must have a name and url", path, i)
+		}
+	}
+	return c, nil
+}
+
+// Probe builds the HTTPProbe described by the config
+func (pc HTTPProbeConfig) Probe() *HTTPProbe {
+	method := pc.Method
+	if method == "" {
+		method = "GET"
+	}
+	p := HTTP(method, pc.URL)
+	for _, name := range sortedKeys(pc.Headers) {
+		p.WithHeader(name, pc.Headers[name])
+	}
+	if pc.Body != "" {
+		contentType := pc.ContentType
+		if contentType == "" {
+			contentType = p.header.Get("Content-Type")
+		}
+		if contentType == "" {
+			contentType = http.DetectContentType([]byte(pc.Body))
+		}
+		p.WithBody(contentType, []byte(pc.Body))
+	}
+	if pc.Expect.Status != 0 {
+		p.ExpectStatus(pc.Expect.Status)
+	}
+	for _, name := range sortedKeys(pc.Expect.Headers) {
+		p.ExpectHeader(name, pc.Expect.Headers[name])
+	}
+	paths := make([]string, 0, len(pc.Expect.JSON))
+	for path := range pc.Expect.JSON {
+		paths = append(paths, path)
+	}
+	sort.Strings(paths)
+	for _, path := range paths {
+		p.ExpectJSONPath(path, pc.Expect.JSON[path])
+	}
+	if pc.Expect.MaxLatency > 0 {
+		p.MaxLatency(time.Duration(pc.Expect.MaxLatency))
+	}
+	return p
+}
+
+// Register adds each probe in the config to the registry, with its interval, timeout and tags
+func (c *HTTPConfig) Register(reg *e2e.Registry) {
+	for _, pc := range c.Probes {
+		var opts []e2e.ScheduleOption
+		if pc.Interval > 0 {
+			opts = append(opts, e2e.WithInterval(time.Duration(pc.Interval)))
+		}
+		if pc.Timeout > 0 {
+			opts = append(opts, e2e.WithTimeout(time.Duration(pc.Timeout)))
+		}
+		if len(pc.Tags) > 0 {
+			opts = append(opts, e2e.WithTags(pc.Tags...))
+		}
+		reg.Register(pc.Name, pc.Probe().Test(), opts...)
+	}
+}
+
+func sortedKeys(m map[string]string) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
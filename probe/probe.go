@@ -0,0 +1,66 @@
+// Package probe provides ready made tests for common infrastructure checks, so they
+// can be scheduled without writing a test function, e.g.
+//
+//	r.Schedule("TestDatabasePort", probe.TCP("db.internal:5432"), time.Minute)
+//	r.Schedule("TestCertificate", probe.TLSCert("example.com:443", 14*24*time.Hour), time.Hour)
+//
+// Each probe is bounded by the test's context, so the timeout of the scheduled test applies.
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"net"
+	"time"
+)
+
+// Option configures a probe
+type Option func(c *config)
+
+type config struct {
+	timeout   time.Duration
+	resolver  *net.Resolver
+	tlsConfig *tls.Config
+	grpcOpts  []GRPCOption
+}
+
+func newConfig(opts []Option) *config {
+	c := &config{resolver: net.DefaultResolver}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
+}
+
+// WithTimeout limits the time taken by a probe, in addition to the test's context
+func WithTimeout(d time.Duration) Option {
+	return func(c *config) {
+		c.timeout = d
+	}
+}
+
+// WithResolver sets the resolver used to look up names, it defaults to net.DefaultResolver
+func WithResolver(r *net.Resolver) Option {
+	return func(c *config) {
+		c.resolver = r
+	}
+}
+
+// WithTLSConfig sets the TLS config used to connect, e.g. to verify against private roots
+func WithTLSConfig(tc *tls.Config) Option {
+	return func(c *config) {
+		c.tlsConfig = tc
+	}
+}
+
+// context bounds the test's context by the probe's timeout
+func (c *config) context(ctx context.Context) (context.Context, context.CancelFunc) {
+	if c.timeout > 0 {
+		return context.WithTimeout(ctx, c.timeout)
+	}
+	return context.WithCancel(ctx)
+}
+
+func (c *config) dialer() *net.Dialer {
+	return &net.Dialer{Resolver: c.resolver}
+}
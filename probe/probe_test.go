@@ -0,0 +1,131 @@
+package probe
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/arussellsaw/e2e"
+	"golang.org/x/net/dns/dnsmessage"
+)
+
+func TestTCP(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	if tt := e2e.Run("tcp", TCP(addr)); tt.Failed() {
+		t.Errorf("Expected probe to pass, got %s", tt.Output())
+	}
+	l.Close()
+	if tt := e2e.Run("tcp", TCP(addr, WithTimeout(time.Second))); !tt.Failed() {
+		t.Error("Expected probe of closed listener to fail")
+	}
+}
+
+// dnsServer answers A queries for name with addrs, on a local UDP listener
+func dnsServer(t *testing.T, name string, addrs ...string) *net.Resolver {
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { conn.Close() })
+	go func() {
+		buf := make([]byte, 512)
+		for {
+			n, from, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			var msg dnsmessage.Message
+			if err := msg.Unpack(buf[:n]); err != nil || len(msg.Questions) == 0 {
+				continue
+			}
+			q := msg.Questions[0]
+			msg.Header.Response = true
+			msg.Header.Authoritative = true
+			if q.Name.String() != name+"." {
+				msg.Header.RCode = dnsmessage.RCodeNameError
+			} else if q.Type == dnsmessage.TypeA {
+				for _, a := range addrs {
+					var ip [4]byte
+					copy(ip[:], net.ParseIP(a).To4())
+					msg.Answers = append(msg.Answers, dnsmessage.Resource{
+						Header: dnsmessage.ResourceHeader{Name: q.Name, Type: dnsmessage.TypeA, Class: dnsmessage.ClassINET, TTL: 60},
+						Body:   &dnsmessage.AResource{A: ip},
+					})
+				}
+			}
+			b, err := msg.Pack()
+			if err != nil {
+				continue
+			}
+			conn.WriteTo(b, from)
+		}
+	}()
+	return &net.Resolver{
+		PreferGo: true,
+		Dial: func(ctx context.Context, network, address string) (net.Conn, error) {
+			var d net.Dialer
+			return d.DialContext(ctx, "udp", conn.LocalAddr().String())
+		},
+	}
+}
+
+func TestDNS(t *testing.T) {
+	resolver := dnsServer(t, "service.test", "10.0.0.1", "10.0.0.2")
+	tc := []struct {
+		name   string
+		host   string
+		expect []string
+		failed string
+	}{
+		{"any record", "service.test", nil, ""},
+		{"expected records", "service.test", []string{"10.0.0.2", "10.0.0.1"}, ""},
+		{"missing record", "service.test", []string{"10.0.0.3"}, "expected service.test to resolve to 10.0.0.3, got 10.0.0.1, 10.0.0.2"},
+		{"no such host", "missing.test", nil, "resolving missing.test"},
+	}
+	for _, tt := range tc {
+		t.Run(tt.name, func(t *testing.T) {
+			et := e2e.Run("dns", DNS(tt.host, tt.expect, WithResolver(resolver), WithTimeout(5*time.Second)))
+			if tt.failed == "" {
+				if et.Failed() {
+					t.Errorf("Expected probe to pass, got %s", et.Output())
+				}
+				return
+			}
+			if !et.Failed() || !strings.Contains(string(et.Output()), tt.failed) {
+				t.Errorf("Expected failure containing %q, got %s", tt.failed, et.Output())
+			}
+		})
+	}
+}
+
+func TestTLSCert(t *testing.T) {
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer srv.Close()
+	addr := srv.Listener.Addr().String()
+	roots := x509.NewCertPool()
+	roots.AddCert(srv.Certificate())
+	trusted := WithTLSConfig(&tls.Config{RootCAs: roots})
+
+	if et := e2e.Run("tls", TLSCert(addr, 24*time.Hour, trusted)); et.Failed() {
+		t.Errorf("Expected probe to pass, got %s", et.Output())
+	}
+	remaining := time.Until(srv.Certificate().NotAfter)
+	et := e2e.Run("tls", TLSCert(addr, remaining+24*time.Hour, trusted))
+	if !et.Failed() || !strings.Contains(string(et.Output()), "less than the minimum validity") {
+		t.Errorf("Expected expiry failure, got %s", et.Output())
+	}
+	et = e2e.Run("tls", TLSCert(addr, 24*time.Hour))
+	if !et.Failed() || !strings.Contains(string(et.Output()), "certificate") {
+		t.Errorf("Expected verification failure, got %s", et.Output())
+	}
+}
@@ -0,0 +1,23 @@
+package probe
+
+import (
+	"time"
+
+	"github.com/arussellsaw/e2e"
+)
+
+// TCP returns a test which passes if a TCP connection can be opened to addr
+func TCP(addr string, opts ...Option) e2e.Test {
+	c := newConfig(opts)
+	return func(t *e2e.T) {
+		ctx, cancel := c.context(t.Context())
+		defer cancel()
+		start := time.Now()
+		conn, err := c.dialer().DialContext(ctx, "tcp", addr)
+		if err != nil {
+			t.Fatalf("connecting to %s: %v", addr, err)
+		}
+		defer conn.Close()
+		t.Logw("connected", "addr", addr, "remote", conn.RemoteAddr().String(), "duration", time.Since(start))
+	}
+}
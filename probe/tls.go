@@ -0,0 +1,50 @@
+package probe
+
+import (
+	"crypto/tls"
+	"net"
+	"time"
+
+	"github.com/arussellsaw/e2e"
+)
+
+// TLSCert returns a test which connects to addr with TLS, and fails if the certificate
+// chain doesn't verify for the host, or any certificate in the chain expires within
+// minValidity
+func TLSCert(addr string, minValidity time.Duration, opts ...Option) e2e.Test {
+	c := newConfig(opts)
+	return func(t *e2e.T) {
+		ctx, cancel := c.context(t.Context())
+		defer cancel()
+		tc := &tls.Config{}
+		if c.tlsConfig != nil {
+			tc = c.tlsConfig.Clone()
+		}
+		if tc.ServerName == "" {
+			host, _, err := net.SplitHostPort(addr)
+			if err != nil {
+				t.Fatalf("invalid address %s: %v", addr, err)
+			}
+			tc.ServerName = host
+		}
+		d := &tls.Dialer{NetDialer: c.dialer(), Config: tc}
+		conn, err := d.DialContext(ctx, "tcp", addr)
+		if err != nil {
+			t.Fatalf("tls handshake with %s: %v", addr, err)
+		}
+		defer conn.Close()
+		state := conn.(*tls.Conn).ConnectionState()
+		if len(state.VerifiedChains) == 0 {
+			t.Fatalf("certificate chain for %s was not verified", addr)
+		}
+		now := time.Now()
+		for _, cert := range state.VerifiedChains[0] {
+			remaining := cert.NotAfter.Sub(now)
+			t.Logw("certificate", "subject", cert.Subject.String(), "not_after", cert.NotAfter, "remaining", remaining.Round(time.Hour))
+			if remaining < minValidity {
+				t.Errorf("certificate %q expires at %s, in %s, less than the minimum validity of %s",
+					cert.Subject.String(), cert.NotAfter.Format(time.RFC3339), remaining.Round(time.Minute), minValidity)
+			}
+		}
+	}
+}
@@ -0,0 +1,167 @@
+package e2e
+
+import (
+	"regexp"
+	"sync"
+	"time"
+)
+
+// DefaultInterval is the interval used for registered tests which
+// don't set one with WithInterval
+var DefaultInterval = time.Minute
+
+// WithInterval sets the interval between runs of the test, overriding the
+// interval passed to Schedule
+func WithInterval(interval time.Duration) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.interval = interval
+	}
+}
+
+// WithTimeout sets a deadline on the context of each attempt of the test. The test
+// isn't stopped at the deadline, it should return once its context is done, and
+// it fails if the deadline passed before it returned.
+func WithTimeout(timeout time.Duration) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.timeout = timeout
+	}
+}
+
+// WithNotifier sets the Notifier for the test
+func WithNotifier(n Notifier) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.n = n
+	}
+}
+
+// Registration is a test added to a Registry
+type Registration struct {
+	Name    string
+	Test    Test
+	Options []ScheduleOption
+
+	// metadata and dependsOn are set by the options, they're found once when the
+	// test is registered so filtering and loading don't apply the options again
+	metadata  Metadata
+	dependsOn []string
+}
+
+// Registry collects tests so they can be declared alongside their code, and
+// loaded into a Runner later with Runner.Load
+type Registry struct {
+	mu    sync.Mutex
+	tests []Registration
+}
+
+// DefaultRegistry is the Registry used by Register
+var DefaultRegistry = &Registry{}
+
+// Register adds a test to the DefaultRegistry, this is intended to be called
+// from init functions in the packages that define tests
+func Register(name string, t Test, opts ...ScheduleOption) {
+	DefaultRegistry.Register(name, t, opts...)
+}
+
+// Register adds a test to the Registry
+func (reg *Registry) Register(name string, t Test, opts ...ScheduleOption) {
+	tr := &testRunner{Name: name}
+	for _, opt := range opts {
+		opt(tr)
+	}
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	reg.tests = append(reg.tests, Registration{
+		Name:      name,
+		Test:      t,
+		Options:   opts,
+		metadata:  tr.Metadata,
+		dependsOn: tr.dependsOn,
+	})
+}
+
+// Tests returns the registered tests which match the Filter, in the order they were registered
+func (reg *Registry) Tests(f Filter) []Registration {
+	reg.mu.Lock()
+	defer reg.mu.Unlock()
+	var tests []Registration
+	for _, t := range reg.tests {
+		if f.Match(t.Name, t.metadata) {
+			tests = append(tests, t)
+		}
+	}
+	return tests
+}
+
+// Filter selects tests by name and tag, the zero Filter matches every test
+type Filter struct {
+	// Include matches the names of tests to select, if nil all tests are included
+	Include *regexp.Regexp
+	// Exclude matches the names of tests to leave out
+	Exclude *regexp.Regexp
+	// Tags selects tests with at least one of the tags, if empty tags aren't checked
+	Tags []string
+	// ExcludeTags leaves out tests with any of the tags
+	ExcludeTags []string
+}
+
+// Match reports whether a test with the given name and metadata is selected by the filter
+func (f Filter) Match(name string, m Metadata) bool {
+	if f.Include != nil && !f.Include.MatchString(name) {
+		return false
+	}
+	if f.Exclude != nil && f.Exclude.MatchString(name) {
+		return false
+	}
+	for _, tag := range f.ExcludeTags {
+		if m.HasTag(tag) {
+			return false
+		}
+	}
+	if len(f.Tags) == 0 {
+		return true
+	}
+	for _, tag := range f.Tags {
+		if m.HasTag(tag) {
+			return true
+		}
+	}
+	return false
+}
+
+// Load schedules every test in the Registry that matches the Filter, tests are
+// scheduled every DefaultInterval unless they set their own with WithInterval.
+// Tests are scheduled after the tests they depend on.
+func (r *Runner) Load(reg *Registry, f Filter) {
+	pending := reg.Tests(f)
+	for len(pending) > 0 {
+		var waiting []Registration
+		for _, t := range pending {
+			if r.scheduled(t.dependsOn) {
+				r.schedule(t.Name, t.Test, DefaultInterval, nil, t.Options)
+			} else {
+				waiting = append(waiting, t)
+			}
+		}
+		if len(waiting) == len(pending) {
+			// the remaining tests depend on tests which won't be scheduled, or on
+			// each other, so schedule logs why each of them is rejected
+			for _, t := range waiting {
+				r.schedule(t.Name, t.Test, DefaultInterval, nil, t.Options)
+			}
+			return
+		}
+		pending = waiting
+	}
+}
+
+// scheduled reports whether all of the named tests are scheduled
+func (r *Runner) scheduled(names []string) bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	for _, name := range names {
+		if _, ok := r.tests[name]; !ok {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,144 @@
+package e2e
+
+import (
+	"log/slog"
+	"os"
+	"path/filepath"
+	"regexp"
+	"testing"
+	"time"
+)
+
+func TestRegistryFilter(t *testing.T) {
+	reg := &Registry{}
+	noop := func(t *T) {}
+	reg.Register("TestLogin", noop, WithTags("auth"))
+	reg.Register("TestCheckout", noop, WithTags("payments"))
+	reg.Register("TestCheckoutSlow", noop, WithTags("payments", "slow"))
+	var applied int
+	reg.Register("TestSearch", noop, func(tr *testRunner) { applied++ })
+
+	for _, tc := range []struct {
+		name     string
+		filter   Filter
+		expected []string
+	}{
+		{
+			name:     "all",
+			expected: []string{"TestLogin", "TestCheckout", "TestCheckoutSlow", "TestSearch"},
+		},
+		{
+			name:     "include",
+			filter:   Filter{Include: regexp.MustCompile("Checkout")},
+			expected: []string{"TestCheckout", "TestCheckoutSlow"},
+		},
+		{
+			name:     "exclude",
+			filter:   Filter{Exclude: regexp.MustCompile("Slow$")},
+			expected: []string{"TestLogin", "TestCheckout", "TestSearch"},
+		},
+		{
+			name:     "tags",
+			filter:   Filter{Tags: []string{"auth", "payments"}, ExcludeTags: []string{"slow"}},
+			expected: []string{"TestLogin", "TestCheckout"},
+		},
+	} {
+		var names []string
+		for _, r := range reg.Tests(tc.filter) {
+			names = append(names, r.Name)
+		}
+		if len(names) != len(tc.expected) {
+			t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, names)
+			continue
+		}
+		for i := range names {
+			if names[i] != tc.expected[i] {
+				t.Errorf("%s: expected %v, got %v", tc.name, tc.expected, names)
+				break
+			}
+		}
+	}
+	if applied != 1 {
+		t.Errorf("Expected options to be applied once when registered, got %d", applied)
+	}
+}
+
+func TestLoadConfig(t *testing.T) {
+	dir := t.TempDir()
+	files := map[string]string{
+		"config.yaml": "tests:\n  TestCheckout:\n    interval: 30s\n    timeout: 5s\n    notifiers: [slack]\n  TestSlow:\n    disabled: true\n",
+		"config.json": `{"tests": {"TestCheckout": {"interval": "30s", "timeout": "5s", "notifiers": ["slack"]}, "TestSlow": {"disabled": true}}}`,
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		c, err := LoadConfig(path)
+		if err != nil {
+			t.Fatalf("%s: %v", name, err)
+		}
+		tc := c.Tests["TestCheckout"]
+		if time.Duration(tc.Interval) != 30*time.Second || time.Duration(tc.Timeout) != 5*time.Second {
+			t.Errorf("%s: unexpected config %+v", name, tc)
+		}
+		if len(tc.Notifiers) != 1 || tc.Notifiers[0] != "slack" {
+			t.Errorf("%s: expected notifiers [slack], got %v", name, tc.Notifiers)
+		}
+		if !c.Tests["TestSlow"].Disabled {
+			t.Errorf("%s: expected TestSlow to be disabled", name)
+		}
+	}
+	for name, content := range map[string]string{
+		"unknown.yaml": "tests:\n  TestCheckout:\n    intervl: 30s\n",
+		"unknown.json": `{"tests": {"TestCheckout": {"intervl": "30s"}}}`,
+	} {
+		path := filepath.Join(dir, name)
+		if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := LoadConfig(path); err == nil {
+			t.Errorf("%s: expected an error for an unknown field", name)
+		}
+	}
+}
+
+func TestApplyConfig(t *testing.T) {
+	n := &testNotifier{}
+	r := &Runner{
+		Config: &Config{Tests: map[string]TestConfig{
+			"test": {Interval: Duration(time.Hour), Notifiers: []string{"test"}},
+		}},
+		Notifiers: map[string]Notifier{"test": n},
+	}
+	tr := &testRunner{Name: "test", interval: time.Minute, n: defaultNotifier}
+	tc, _ := r.Config.test("test")
+	r.applyConfig(tr, tc)
+	if tr.interval != time.Hour {
+		t.Errorf("Expected %s, got %s", time.Hour, tr.interval)
+	}
+	tr.t = func(t *T) {}
+	tr.runJob("")
+	if n.n == nil {
+		t.Error("Expected notification to be sent to the configured notifier")
+	}
+}
+
+func TestTimeout(t *testing.T) {
+	tr := &testRunner{
+		Name: "test",
+		t: func(t *T) {
+			<-t.Context().Done()
+		},
+		n:       defaultNotifier,
+		timeout: 10 * time.Millisecond,
+	}
+	rec := tr.runJob("")
+	if rec.State != TestStateFailed {
+		t.Errorf("Expected %q, got %q", TestStateFailed, rec.State)
+	}
+	logs := rec.Result.Logs
+	if len(logs) != 1 || logs[0].Level != slog.LevelError || logs[0].Message != "test timed out after 10ms" {
+		t.Errorf("Expected a timeout error to be logged, got %+v", logs)
+	}
+}
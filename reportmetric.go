@@ -0,0 +1,81 @@
+package e2e
+
+import (
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Metric is a custom value reported by a test with ReportMetric
+type Metric struct {
+	// Test is the full name of the test or subtest that reported the metric, e.g. Parent/child
+	Test  string
+	Unit  string
+	Value float64
+}
+
+// ReportMetric adds "n unit" to the reported metrics of the test, in the same way as
+// testing.B. If the metric is per-operation, the unit should end in "/op". If the
+// same unit is reported more than once, the last value is kept. Metrics are stored
+// with the run and exported by the Runner.
+func (t *T) ReportMetric(n float64, unit string) {
+	t.Helper()
+	if unit == "" || strings.IndexFunc(unit, func(r rune) bool { return r == ' ' || r == '\t' || r == '\n' }) >= 0 {
+		t.Errorf("metric unit must not be empty or contain white space: %q", unit)
+		return
+	}
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.metrics == nil {
+		t.metrics = make(map[string]float64)
+	}
+	t.metrics[unit] = n
+}
+
+// Metrics returns the metrics reported by the test and its subtests
+func (t *T) Metrics() []Metric {
+	t.mu.RLock()
+	name := t.fullName()
+	metrics := make([]Metric, 0, len(t.metrics))
+	for unit, v := range t.metrics {
+		metrics = append(metrics, Metric{Test: name, Unit: unit, Value: v})
+	}
+	subTests := t.subTests
+	t.mu.RUnlock()
+	sort.Slice(metrics, func(i, j int) bool {
+		return metrics[i].Unit < metrics[j].Unit
+	})
+	for _, st := range subTests {
+		metrics = append(metrics, st.Metrics()...)
+	}
+	return metrics
+}
+
+// Timer measures the time taken by a step of a test, it is created with T.Timer
+type Timer struct {
+	t     *T
+	name  string
+	start time.Time
+	once  sync.Once
+}
+
+// Timer starts a named timer, when it is stopped the elapsed time is reported as
+// a metric with the unit <name>_seconds, e.g.
+//
+//	timer := t.Timer("login")
+//	login(t)
+//	timer.Stop()
+func (t *T) Timer(name string) *Timer {
+	return &Timer{t: t, name: name, start: time.Now()}
+}
+
+// Stop reports the time since the timer was started and returns it, only
+// the first call to Stop reports a metric
+func (tm *Timer) Stop() time.Duration {
+	d := time.Since(tm.start)
+	tm.once.Do(func() {
+		tm.t.ReportMetric(d.Seconds(), tm.name+"_seconds")
+	})
+	return d
+}
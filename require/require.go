@@ -0,0 +1,121 @@
+// Package require has the same assertions as the assert package, but stops
+// the test with FailNow when an assertion fails.
+package require
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/arussellsaw/e2e/assert"
+)
+
+// TestingT is the subset of testing.TB used by assertions, it's satisfied by
+// both *e2e.T and *testing.T
+type TestingT interface {
+	assert.TestingT
+	FailNow()
+}
+
+// Fail reports a failure and stops the test
+func Fail(t TestingT, failure string, msgAndArgs ...interface{}) {
+	t.Helper()
+	assert.Fail(t, failure, msgAndArgs...)
+	t.FailNow()
+}
+
+// Equal requires that expected and actual are deeply equal
+func Equal(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !assert.Equal(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotEqual requires that expected and actual are not deeply equal
+func NotEqual(t TestingT, expected, actual interface{}, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !assert.NotEqual(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// True requires that value is true
+func True(t TestingT, value bool, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !assert.True(t, value, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// False requires that value is false
+func False(t TestingT, value bool, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !assert.False(t, value, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Nil requires that value is nil
+func Nil(t TestingT, value interface{}, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !assert.Nil(t, value, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NotNil requires that value is not nil
+func NotNil(t TestingT, value interface{}, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !assert.NotNil(t, value, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// NoError requires that err is nil
+func NoError(t TestingT, err error, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !assert.NoError(t, err, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Error requires that err is not nil
+func Error(t TestingT, err error, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !assert.Error(t, err, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Contains requires that s contains element, see assert.Contains
+func Contains(t TestingT, s, element interface{}, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !assert.Contains(t, s, element, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// JSONEq requires that expected and actual are equivalent JSON documents
+func JSONEq(t TestingT, expected, actual string, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !assert.JSONEq(t, expected, actual, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// StatusCode requires that the response has the expected status code
+func StatusCode(t TestingT, resp *http.Response, expected int, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !assert.StatusCode(t, resp, expected, msgAndArgs...) {
+		t.FailNow()
+	}
+}
+
+// Eventually requires that condition returns true within waitFor, it's called every tick until it does
+// or, if t has a Context, until the test's context is done
+func Eventually(t TestingT, condition func() bool, waitFor, tick time.Duration, msgAndArgs ...interface{}) {
+	t.Helper()
+	if !assert.Eventually(t, condition, waitFor, tick, msgAndArgs...) {
+		t.FailNow()
+	}
+}
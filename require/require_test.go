@@ -0,0 +1,44 @@
+package require_test
+
+import (
+	"io"
+	"testing"
+	"time"
+
+	"github.com/arussellsaw/e2e"
+	"github.com/arussellsaw/e2e/require"
+)
+
+func TestRequireStopsTest(t *testing.T) {
+	var reached bool
+	et := e2e.Run("test", func(t *e2e.T) {
+		require.NoError(t, io.EOF)
+		reached = true
+	})
+	if !et.Failed() {
+		t.Error("Expected test to fail")
+	}
+	if reached {
+		t.Error("Expected test to stop at the failed requirement")
+	}
+	if records := et.Records(); len(records) != 1 || records[0].File != "require_test.go" {
+		t.Errorf("Expected failure reported from require_test.go, got %+v", records)
+	}
+}
+
+func TestRequireInEventually(t *testing.T) {
+	var calls int
+	et := e2e.Run("test", func(t *e2e.T) {
+		t.Eventually(func(c *e2e.Collect) {
+			calls++
+			var err error
+			if calls < 2 {
+				err = io.EOF
+			}
+			require.NoError(c, err)
+		}, time.Second, time.Millisecond)
+	})
+	if et.Failed() || calls != 2 {
+		t.Errorf("Expected to pass on the second attempt, got %d attempts: %s", calls, et.Output())
+	}
+}
@@ -0,0 +1,79 @@
+package e2e
+
+import (
+	"strings"
+	"time"
+)
+
+// Result is the structured outcome of a test and its subtests
+type Result struct {
+	Name     string
+	State    TestState
+	Start    time.Time
+	Duration time.Duration
+	// Logs holds the structured records logged by the test
+	Logs []LogRecord `json:",omitempty"`
+	// Metrics holds the values reported with ReportMetric, keyed by unit
+	Metrics  map[string]float64 `json:",omitempty"`
+	Children []*Result          `json:",omitempty"`
+}
+
+// Failed reports whether the test failed
+func (r *Result) Failed() bool {
+	return r.State == TestStateFailed
+}
+
+// Find returns the result of the subtest with the given name, names of nested subtests
+// are separated by slashes, e.g. "parent/child". It returns nil if there's no such subtest.
+func (r *Result) Find(name string) *Result {
+	parts := strings.SplitN(name, "/", 2)
+	for _, c := range r.Children {
+		if c.Name != parts[0] {
+			continue
+		}
+		if len(parts) == 1 {
+			return c
+		}
+		return c.Find(parts[1])
+	}
+	return nil
+}
+
+// Result returns the structured result of the test and its subtests, if the test is
+// still running the state is TestStateRunning
+func (t *T) Result() *Result {
+	t.mu.RLock()
+	r := &Result{
+		Name:  t.name,
+		State: t.state(),
+		Start: t.start,
+	}
+	r.Logs = append([]LogRecord(nil), t.records...)
+	if len(t.metrics) > 0 {
+		r.Metrics = make(map[string]float64, len(t.metrics))
+		for unit, v := range t.metrics {
+			r.Metrics[unit] = v
+		}
+	}
+	subTests := t.subTests
+	t.mu.RUnlock()
+	r.Duration = t.Duration()
+	for _, st := range subTests {
+		r.Children = append(r.Children, st.Result())
+	}
+	return r
+}
+
+// state gives the TestState of the test, this must be called with t.mu held
+func (t *T) state() TestState {
+	switch {
+	case !t.done:
+		return TestStateRunning
+	case t.failed:
+		return TestStateFailed
+	case t.skipped:
+		return TestStateSkipped
+	default:
+		return TestStatePassed
+	}
+}
@@ -0,0 +1,62 @@
+package e2e
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestResult(t *testing.T) {
+	var sub *Result
+	tt := Run("parent", func(t *T) {
+		t.Log("parent log")
+		sub = t.Run("child", func(t *T) {
+			t.Run("pass", func(t *T) {})
+			t.Run("fail", func(t *T) {
+				t.Error("child failed")
+			})
+		})
+		t.Run("skip", func(t *T) {
+			t.SkipNow()
+		})
+	})
+	res := tt.Result()
+	if res.State != TestStateFailed {
+		t.Errorf("Expected %q, got %q", TestStateFailed, res.State)
+	}
+	if len(res.Logs) != 1 || res.Logs[0].Message != "parent log" {
+		t.Errorf("Expected parent log, got %q", res.Logs)
+	}
+	if sub == nil || sub.Name != "child" || !sub.Failed() {
+		t.Errorf("Expected failed child result from Run, got %+v", sub)
+	}
+	for name, state := range map[string]TestState{
+		"child":      TestStateFailed,
+		"child/pass": TestStatePassed,
+		"child/fail": TestStateFailed,
+		"skip":       TestStateSkipped,
+	} {
+		r := res.Find(name)
+		if r == nil {
+			t.Errorf("Expected result for %s", name)
+			continue
+		}
+		if r.State != state {
+			t.Errorf("%s: expected %q, got %q", name, state, r.State)
+		}
+	}
+}
+
+func TestOutputIsStable(t *testing.T) {
+	tt := Run("parent", func(t *T) {
+		t.Run("child", func(t *T) {
+			t.Error("child failed")
+		})
+	})
+	first := string(tt.Output())
+	if second := string(tt.Output()); first != second {
+		t.Errorf("Expected repeated calls to Output to match, got %q and %q", first, second)
+	}
+	if !strings.HasPrefix(first, "--- FAIL: parent/child (0.00s)\n") || !strings.HasSuffix(first, "FAIL\n") {
+		t.Errorf("Unexpected output %q", first)
+	}
+}
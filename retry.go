@@ -0,0 +1,61 @@
+package e2e
+
+import (
+	"bytes"
+	"context"
+	"time"
+)
+
+// RetryPolicy controls how many times a scheduled test is attempted before
+// the run is marked as failed. This is useful for tests against external
+// dependencies that are prone to transient failures, such as DNS blips.
+type RetryPolicy struct {
+	// MaxAttempts is the maximum number of times the test will be run,
+	// values less than 1 are treated as a single attempt
+	MaxAttempts int
+	// Backoff is how long to wait between attempts
+	Backoff time.Duration
+	// RetryOn restricts retries to failures where the test output contains
+	// one of these markers, if empty every failure is retried
+	RetryOn []string
+}
+
+// WithRetry sets the RetryPolicy used when running a scheduled test
+func WithRetry(p RetryPolicy) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.retry = p
+	}
+}
+
+func (p RetryPolicy) attempts() int {
+	if p.MaxAttempts < 1 {
+		return 1
+	}
+	return p.MaxAttempts
+}
+
+// shouldRetry reports whether a failed attempt with the given output
+// is eligible for another attempt
+func (p RetryPolicy) shouldRetry(output []byte) bool {
+	if len(p.RetryOn) == 0 {
+		return true
+	}
+	for _, marker := range p.RetryOn {
+		if bytes.Contains(output, []byte(marker)) {
+			return true
+		}
+	}
+	return false
+}
+
+// sleep waits for d, it returns false without waiting the full duration if ctx is done first
+func sleep(ctx context.Context, d time.Duration) bool {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return true
+	case <-ctx.Done():
+		return false
+	}
+}
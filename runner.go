@@ -1,6 +1,7 @@
 package e2e
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"net/http"
@@ -10,16 +11,38 @@ import (
 	"sync"
 	"time"
 
-	"github.com/avct/schedule"
 	"github.com/gobuffalo/packr"
 	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type Runner struct {
-	s       schedule.Scheduler
-	mu      sync.Mutex
-	tests   map[string]*testRunner
-	history map[string][]testRunner
+	// MaxConcurrentRuns limits the number of tests that can run at once,
+	// if zero there is no limit
+	MaxConcurrentRuns int
+	// ConcurrencyGroups limits the number of tests in each named group that can
+	// run at once, tests are added to a group with InConcurrencyGroup
+	ConcurrencyGroups map[string]int
+	// Config overrides the schedule options of tests as they are scheduled
+	Config *Config
+	// Notifiers are named notifiers which can be referred to by Config
+	Notifiers map[string]Notifier
+	// ArtifactRetention is how long the attachments of a run are kept,
+	// if zero DefaultArtifactRetention is used
+	ArtifactRetention time.Duration
+	// TracerProvider creates the spans for each run and subtest, if nil the global
+	// provider from otel.GetTracerProvider is used
+	TracerProvider trace.TracerProvider
+
+	mu        sync.Mutex
+	tests     map[string]*testRunner
+	history   map[string][]RunRecord
+	events    map[string][]Event
+	sem       semaphore
+	groups    map[string]semaphore
+	lastRunID uint64
+	// stop is closed by Stop to end the scheduling loop of every test
+	stop chan struct{}
 }
 
 func (r *Runner) Mux() http.Handler {
@@ -31,6 +54,12 @@ func (r *Runner) Mux() http.Handler {
 	m.HandleFunc("/api/status", r.StatusHandler)
 	m.HandleFunc("/api/force/{name}", r.ForceRunHandler)
 	m.HandleFunc("/api/log/{name}", r.LiveOutputHandler)
+	m.HandleFunc("/api/events/{name}", r.EventsHandler)
+	m.HandleFunc("/api/report/junit", r.JUnitHandler)
+	m.HandleFunc("/api/slo", r.SLOHandler)
+	m.HandleFunc("/api/runs/{id}", r.RunHandler)
+	m.HandleFunc("/api/runs/{id}/artifacts/{path:.+}", r.ArtifactHandler)
+	m.HandleFunc("/metrics", r.MetricsHandler)
 
 	m.HandleFunc("/healthz", func(w http.ResponseWriter, req *http.Request) {
 		w.Write([]byte("ok"))
@@ -40,56 +69,205 @@ func (r *Runner) Mux() http.Handler {
 	return m
 }
 
-func (r *Runner) Schedule(name string, t Test, interval time.Duration) {
-	r.schedule(name, t, interval, nil)
+func (r *Runner) Schedule(name string, t Test, interval time.Duration, opts ...ScheduleOption) {
+	r.schedule(name, t, interval, nil, opts)
 }
 
-func (r *Runner) ScheduleWithNotifier(name string, t Test, interval time.Duration, n Notifier) {
-	r.schedule(name, t, interval, n)
+func (r *Runner) ScheduleWithNotifier(name string, t Test, interval time.Duration, n Notifier, opts ...ScheduleOption) {
+	r.schedule(name, t, interval, n, opts)
 }
 
-func (r *Runner) schedule(name string, t Test, interval time.Duration, notifier Notifier) {
+func (r *Runner) schedule(name string, t Test, interval time.Duration, notifier Notifier, opts []ScheduleOption) {
 	if notifier == nil {
 		notifier = defaultNotifier
 	}
 	r.mu.Lock()
 	tr := &testRunner{
-		Name: name,
-		t:    t,
-		n:    notifier,
+		Name:     name,
+		t:        t,
+		n:        notifier,
+		interval: interval,
+	}
+	for _, opt := range opts {
+		opt(tr)
+	}
+	if tc, ok := r.Config.test(name); ok {
+		if tc.Disabled {
+			r.mu.Unlock()
+			return
+		}
+		r.applyConfig(tr, tc)
+	}
+	tr.checkSLOs()
+	if tr.interval <= 0 {
+		r.mu.Unlock()
+		log.Printf("e2e: not scheduling %s, interval must be positive, got %s", name, tr.interval)
+		return
+	}
+	if err := r.checkDependencies(tr); err != nil {
+		r.mu.Unlock()
+		log.Printf("e2e: not scheduling %s, %v", name, err)
+		return
 	}
 	if r.tests == nil {
 		r.tests = make(map[string]*testRunner)
 	}
+	tr.finished = make(chan struct{}, 1)
+	tr.sems = r.semaphores(tr.group)
+	tr.tracer = r.tracer()
 	r.tests[name] = tr
+	stop := r.stopped()
 	r.mu.Unlock()
-	r.s.Schedule(
-		schedule.JobFunc(func() {
-			r.addPastTest(tr)
-			tr.runJob()
-		}),
-		schedule.Every(interval),
-	)
+	go r.loop(tr, stop)
 }
 
-func (r *Runner) addPastTest(tr *testRunner) {
+// Stop stops triggering scheduled runs of every test, runs already in progress are
+// left to finish and tests can still be run with the force API. Tests scheduled
+// after the Runner is stopped are never run on a schedule.
+func (r *Runner) Stop() {
 	r.mu.Lock()
 	defer r.mu.Unlock()
-	// if this is the first run, don't add history
-	if tr.Successes == 0 && tr.Failures == 0 {
-		return
+	stop := r.stopped()
+	select {
+	case <-stop:
+	default:
+		close(stop)
+	}
+}
+
+// stopped returns the channel that's closed when the Runner is stopped, it must be
+// called with r.mu held
+func (r *Runner) stopped() chan struct{} {
+	if r.stop == nil {
+		r.stop = make(chan struct{})
+	}
+	return r.stop
+}
+
+// loop triggers a run of the test each time its interval elapses, runs are started
+// in their own goroutine so a slow test doesn't delay the schedule. The interval is
+// recalculated each time a run finishes so it can adapt to the test's recent results.
+// The loop returns when stop is closed.
+func (r *Runner) loop(tr *testRunner, stop <-chan struct{}) {
+	var (
+		triggered time.Time
+		jitter    time.Duration
+		// offset delays the run after one on start, so the test keeps its stagger
+		offset time.Duration
+		next   = time.Now().Add(tr.firstInterval())
+	)
+	if tr.runOnStart {
+		offset = tr.staggerPhase()
+	} else {
+		jitter = tr.jitter()
+		next = next.Add(jitter)
+	}
+	for {
+		tr.stateMu.Lock()
+		tr.NextRun = next
+		tr.stateMu.Unlock()
+		timer := time.NewTimer(time.Until(next))
+		select {
+		case <-timer.C:
+			if !triggered.IsZero() {
+				offset = 0
+			}
+			triggered, jitter = time.Now(), tr.jitter()
+			r.trigger(tr, "schedule")
+		case <-stop:
+			timer.Stop()
+			return
+		case <-tr.finished:
+			timer.Stop()
+			if triggered.IsZero() {
+				// the first scheduled run hasn't happened yet
+				continue
+			}
+		}
+		next = triggered.Add(tr.nextInterval() + jitter + offset)
+	}
+}
+
+// runTest runs a scheduled test and records the result in the history
+func (r *Runner) runTest(tr *testRunner) {
+	// the ID is assigned up front so notifications can link to the run
+	id := r.newRunID()
+	var rec RunRecord
+	if failing := r.failingDependencies(tr); len(failing) > 0 {
+		rec = tr.block(id, failing)
+	} else {
+		rec = tr.runJob(id)
+	}
+	// the metrics only need a summary of the latest run, the attachments are
+	// left in the history where they can be expired
+	last := rec
+	last.Attachments = nil
+	tr.stateMu.Lock()
+	tr.lastRun = &last
+	if tr.runCounts == nil {
+		tr.runCounts = make(map[TestState]int)
 	}
+	tr.runCounts[rec.State]++
+	tr.stateMu.Unlock()
+	r.addHistory(rec, tr.sloWindow())
+	r.evaluateSLOs(tr)
+	// wake the schedule so the next interval reflects this run's result
+	select {
+	case tr.finished <- struct{}{}:
+	default:
+	}
+}
+
+// maxHistory is the number of runs kept for each test, older runs are
+// only kept while they are within the window of one of the test's SLOs
+const maxHistory = 1000
+
+// addHistory records a run, trimming the oldest runs of the test that are
+// beyond maxHistory and older than window
+func (r *Runner) addHistory(rec RunRecord, window time.Duration) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
 	if r.history == nil {
-		r.history = make(map[string][]testRunner)
+		r.history = make(map[string][]RunRecord)
+	}
+	now := time.Now()
+	history := append(r.history[rec.Name], rec)
+	for len(history) > maxHistory && now.Sub(history[0].Start) > window {
+		history[0] = RunRecord{}
+		history = history[1:]
 	}
-	r.history[tr.Name] = append(r.history[tr.Name], *tr)
+	r.history[rec.Name] = history
+	r.expireArtifacts(now)
+}
+
+// History returns the recorded runs of the named test, oldest first
+func (r *Runner) History(name string) []RunRecord {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]RunRecord(nil), r.history[name]...)
 }
 
+// StatusHandler writes the status of all tests as JSON, tests can be filtered
+// by metadata with the tag, owner and severity query parameters
 func (r *Runner) StatusHandler(w http.ResponseWriter, req *http.Request) {
+	q := req.URL.Query()
 	w.Header().Set("Content-Type", "application/json")
 	r.mu.Lock()
-	json.NewEncoder(w).Encode(r.tests)
-	r.mu.Unlock()
+	defer r.mu.Unlock()
+	tests := make(map[string]*testRunner, len(r.tests))
+	for name, tr := range r.tests {
+		if tag := q.Get("tag"); tag != "" && !tr.Metadata.HasTag(tag) {
+			continue
+		}
+		if owner := q.Get("owner"); owner != "" && tr.Metadata.Owner != owner {
+			continue
+		}
+		if sev := q.Get("severity"); sev != "" && tr.Metadata.Severity != Severity(sev) {
+			continue
+		}
+		tests[name] = tr
+	}
+	json.NewEncoder(w).Encode(tests)
 }
 
 func (r *Runner) GetUIHandler(dev bool) http.Handler {
@@ -112,16 +290,16 @@ func (r *Runner) ForceRunHandler(w http.ResponseWriter, req *http.Request) {
 		return
 	}
 	r.mu.Lock()
-	defer r.mu.Unlock()
 	tr, ok := r.tests[name]
+	r.mu.Unlock()
 	if !ok {
 		http.Error(w, "404 not found", http.StatusNotFound)
 		return
 	}
-	go func() {
-		r.addPastTest(tr)
-		tr.runJob()
-	}()
+	if !r.start(tr) {
+		http.Error(w, "409 conflict (test is already running)", http.StatusConflict)
+		return
+	}
 }
 
 func (r *Runner) LiveOutputHandler(w http.ResponseWriter, req *http.Request) {
@@ -147,15 +325,90 @@ type TestState string
 
 const (
 	TestStateUnknown TestState = ""
+	TestStateQueued  TestState = "QUEUED"
 	TestStateRunning TestState = "RUNNING"
 	TestStatePassed  TestState = "PASSED"
 	TestStateFailed  TestState = "FAILED"
+	TestStateSkipped TestState = "SKIPPED"
+	// TestStateCancelled is used for runs cancelled by the OverlapCancelPrevious policy
+	TestStateCancelled TestState = "CANCELLED"
+	// TestStateBlocked is used for runs that didn't happen because a dependency was failing
+	TestStateBlocked TestState = "BLOCKED"
 )
 
+// ScheduleOption configures a test scheduled on a Runner
+type ScheduleOption func(tr *testRunner)
+
+// RunRecord is the result of a single scheduled run of a test, a run
+// may be made up of multiple attempts if the test has a RetryPolicy
+type RunRecord struct {
+	// ID uniquely identifies the run within the Runner
+	ID       string
+	Name     string
+	State    TestState
+	Start    time.Time
+	Duration time.Duration
+	// QueueWait is the time spent waiting for a concurrency slot before the
+	// run started, it is not included in Duration
+	QueueWait time.Duration
+	Attempts  []Attempt
+	// BlockedBy lists the failing dependencies that stopped this run
+	BlockedBy []string `json:",omitempty"`
+
+	// Result is the result of the final attempt, or nil if the run was blocked
+	Result *Result `json:",omitempty"`
+	// Metrics are the metrics reported by the final attempt and its subtests
+	Metrics []Metric `json:",omitempty"`
+	// Attachments are the attachments of the final attempt and its subtests,
+	// they are removed once the Runner's ArtifactRetention has passed
+	Attachments []Attachment `json:",omitempty"`
+}
+
+// Attempt is a single execution of a test within a run
+type Attempt struct {
+	Number   int
+	Failed   bool
+	Output   string
+	Start    time.Time
+	Duration time.Duration
+	Result   *Result
+}
+
 type testRunner struct {
-	Name string
-	t    Test
-	n    Notifier
+	Name     string
+	Metadata Metadata
+	t        Test
+	n        Notifier
+	retry    RetryPolicy
+
+	interval        time.Duration
+	failureInterval time.Duration
+	failureBackoff  time.Duration
+	backoffInitial  time.Duration
+	maxJitter       time.Duration
+	stagger         bool
+	runOnStart      bool
+	group           string
+	sems            []semaphore
+	overlap         OverlapPolicy
+	dependsOn       []string
+	timeout         time.Duration
+	slos            []SLO
+	tracer          trace.Tracer
+	// finished is signalled at the end of every run, to wake the schedule
+	finished chan struct{}
+
+	// stateMu guards fields read by the scheduler while a run is in progress
+	stateMu             sync.Mutex
+	ConsecutiveFailures int
+	NextRun             time.Time
+	running             bool
+	blocked             bool
+	SLOs                []SLOStatus `json:",omitempty"`
+	lastRun             *RunRecord
+	runCounts           map[TestState]int
+	pending             bool
+	cancel              context.CancelFunc
 
 	mu                sync.Mutex
 	currentT          *T
@@ -163,35 +416,146 @@ type testRunner struct {
 	LastSuccessTime   time.Time
 	LastFailureTime   time.Time
 	LastFailureOutput string
+	LastResult        *Result
+	LastAttempts      int
+	LastQueueWait     time.Duration
+	BlockedBy         []string
 	Failures          int
 	Successes         int
 }
 
-func (tr *testRunner) runJob() {
+// runJob runs the test until it passes or the retry policy is exhausted,
+// a run is only marked as failed if every attempt fails
+func (tr *testRunner) runJob(id string) RunRecord {
 	tr.mu.Lock()
 	defer tr.mu.Unlock()
+	// the cancel func is set before waiting for a slot, so a queued run
+	// can be cancelled in favour of a newer one too
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	tr.stateMu.Lock()
+	tr.cancel = cancel
+	tr.stateMu.Unlock()
+	tr.State = TestStateQueued
+	queued := time.Now()
+	release, ok := acquire(ctx, tr.sems)
+	if !ok {
+		tr.State = TestStateCancelled
+		return RunRecord{
+			ID:        id,
+			Name:      tr.Name,
+			State:     tr.State,
+			Start:     queued,
+			QueueWait: time.Since(queued),
+		}
+	}
+	defer release()
 	tr.State = TestStateRunning
-	t := &T{}
-	tr.currentT = t
-	start := time.Now()
-	doRun(tr.Name, tr.t, t)
-	taken := time.Since(start)
+	tr.BlockedBy = nil
+	tr.stateMu.Lock()
+	tr.blocked = false
+	tr.stateMu.Unlock()
+	rec := RunRecord{
+		ID:        id,
+		Name:      tr.Name,
+		Start:     time.Now(),
+		QueueWait: time.Since(queued),
+	}
+	tr.LastQueueWait = rec.QueueWait
+	ctx, span := tr.startRunSpan(ctx)
+	defer func() { endRunSpan(span, rec) }()
+	var t *T
+	for n := 1; ; n++ {
+		start := time.Now()
+		t = tr.attempt(ctx)
+		rec.Attempts = append(rec.Attempts, Attempt{
+			Number:   n,
+			Failed:   t.Failed(),
+			Output:   string(t.Output()),
+			Start:    start,
+			Duration: time.Since(start),
+			Result:   t.Result(),
+		})
+		if !t.Failed() || n >= tr.retry.attempts() || !tr.retry.shouldRetry(t.Output()) || ctx.Err() != nil {
+			break
+		}
+		if !sleep(ctx, tr.retry.Backoff) {
+			break
+		}
+	}
+	rec.Duration = time.Since(rec.Start)
+	rec.Result = rec.Attempts[len(rec.Attempts)-1].Result
+	rec.Metrics = t.Metrics()
+	rec.Attachments = t.Attachments()
+	tr.LastResult = rec.Result
+	tr.LastAttempts = len(rec.Attempts)
+	if ctx.Err() != nil {
+		// the run was cancelled in favour of a newer one, so its result isn't
+		// counted and no notification is sent
+		tr.State = TestStateCancelled
+		rec.State = tr.State
+		return rec
+	}
 	if t.Failed() {
 		tr.State = TestStateFailed
 		tr.Failures++
+		tr.stateMu.Lock()
+		tr.ConsecutiveFailures++
+		tr.stateMu.Unlock()
 		tr.LastFailureTime = time.Now()
 		tr.LastFailureOutput = string(t.Output())
 	} else {
 		tr.State = TestStatePassed
 		tr.Successes++
+		tr.stateMu.Lock()
+		tr.ConsecutiveFailures = 0
+		tr.stateMu.Unlock()
 		tr.LastSuccessTime = time.Now()
 	}
+	rec.State = tr.State
 	tr.n.Notify(Notification{
-		Name:     tr.Name,
-		Failed:   t.Failed(),
-		Output:   t.output,
-		Duration: taken,
+		Kind:        NotificationRun,
+		Name:        tr.Name,
+		RunID:       rec.ID,
+		Failed:      t.Failed(),
+		Output:      t.output,
+		Duration:    rec.Duration,
+		Attempts:    len(rec.Attempts),
+		QueueWait:   rec.QueueWait,
+		Metadata:    tr.Metadata,
+		Result:      rec.Result,
+		Metrics:     rec.Metrics,
+		Attachments: rec.Attachments,
 	})
+	return rec
+}
+
+// attempt runs the test once, if the test has a timeout it is applied to the
+// context of the test, and the test fails if the deadline passed before it returned
+func (tr *testRunner) attempt(ctx context.Context) *T {
+	if tr.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, tr.timeout)
+		defer cancel()
+	}
+	t := &T{name: tr.Name, ctx: ctx, tracer: tr.tracer}
+	tr.currentT = t
+	doRun(tr.Name, failOnDeadline(ctx, tr.timeout, tr.t), t)
+	return t
+}
+
+// failOnDeadline wraps a test so it fails if the deadline of ctx, set from timeout,
+// passed before it returned. The deadline is checked before the test finishes, so a
+// timeout is included in the test's result and span.
+func failOnDeadline(ctx context.Context, timeout time.Duration, test Test) Test {
+	return func(t *T) {
+		defer func() {
+			if ctx.Err() == context.DeadlineExceeded {
+				t.Errorf("test timed out after %s", timeout)
+			}
+		}()
+		test(t)
+	}
 }
 
 type loggingFileSystem struct {
@@ -0,0 +1,425 @@
+package e2e
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestRetryUntilPass(t *testing.T) {
+	n := &testNotifier{}
+	var calls int
+	runner := &testRunner{
+		Name: "test",
+		t: func(t *T) {
+			calls++
+			if calls < 3 {
+				t.Errorf("dial tcp: lookup example.com: no such host")
+			}
+		},
+		n:     n,
+		retry: RetryPolicy{MaxAttempts: 5, Backoff: time.Millisecond, RetryOn: []string{"no such host"}},
+	}
+	rec := runner.runJob("")
+	if rec.State != TestStatePassed {
+		t.Errorf("Expected %q, got %q", TestStatePassed, rec.State)
+	}
+	if len(rec.Attempts) != 3 {
+		t.Fatalf("Expected 3 attempts, got %d", len(rec.Attempts))
+	}
+	if !rec.Attempts[0].Failed || !rec.Attempts[1].Failed || rec.Attempts[2].Failed {
+		t.Errorf("Expected first 2 attempts to fail, got %+v", rec.Attempts)
+	}
+	if n.n.Failed {
+		t.Error("should not have failed")
+	}
+	if n.n.Attempts != 3 {
+		t.Errorf("Expected 3 attempts, got %d", n.n.Attempts)
+	}
+	if n.n.Duration != rec.Duration {
+		t.Errorf("Expected notification duration %s to cover every attempt, got %s", rec.Duration, n.n.Duration)
+	}
+}
+
+func TestRetryBackoffCancelled(t *testing.T) {
+	var runner *testRunner
+	runner = &testRunner{
+		Name: "test",
+		t: func(t *T) {
+			time.AfterFunc(10*time.Millisecond, runner.cancel)
+			t.Errorf("no such host")
+		},
+		n:     &testNotifier{},
+		retry: RetryPolicy{MaxAttempts: 3, Backoff: time.Hour},
+	}
+	done := make(chan RunRecord)
+	go func() { done <- runner.runJob("") }()
+	select {
+	case rec := <-done:
+		if rec.State != TestStateCancelled {
+			t.Errorf("Expected %q, got %q", TestStateCancelled, rec.State)
+		}
+		if len(rec.Attempts) != 1 {
+			t.Errorf("Expected 1 attempt, got %d", len(rec.Attempts))
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("run wasn't cancelled during the retry backoff")
+	}
+}
+
+func TestRetryOnlyOnMarkers(t *testing.T) {
+	n := &testNotifier{}
+	runner := &testRunner{
+		Name: "test",
+		t: func(t *T) {
+			t.Errorf("expected 200, got 500")
+		},
+		n:     n,
+		retry: RetryPolicy{MaxAttempts: 5, RetryOn: []string{"no such host"}},
+	}
+	rec := runner.runJob("")
+	if rec.State != TestStateFailed {
+		t.Errorf("Expected %q, got %q", TestStateFailed, rec.State)
+	}
+	if len(rec.Attempts) != 1 {
+		t.Errorf("Expected 1 attempt, got %d", len(rec.Attempts))
+	}
+	if n.n.Attempts != 1 {
+		t.Errorf("Expected 1 attempt, got %d", n.n.Attempts)
+	}
+}
+
+func TestRetryExhausted(t *testing.T) {
+	n := &testNotifier{}
+	runner := &testRunner{
+		Name: "test",
+		t: func(t *T) {
+			t.Errorf("no such host")
+		},
+		n:     n,
+		retry: RetryPolicy{MaxAttempts: 3},
+	}
+	r := &Runner{}
+	r.runTest(runner)
+	hist := r.History("test")
+	if len(hist) != 1 {
+		t.Fatalf("Expected 1 run in history, got %d", len(hist))
+	}
+	if hist[0].State != TestStateFailed {
+		t.Errorf("Expected %q, got %q", TestStateFailed, hist[0].State)
+	}
+	if len(hist[0].Attempts) != 3 {
+		t.Errorf("Expected 3 attempts, got %d", len(hist[0].Attempts))
+	}
+	if !n.n.Failed {
+		t.Error("should have failed")
+	}
+}
+
+func TestFailureInterval(t *testing.T) {
+	tr := &testRunner{interval: 10 * time.Minute}
+	WithFailureInterval(30 * time.Second)(tr)
+	if d := tr.nextInterval(); d != 10*time.Minute {
+		t.Errorf("Expected %s, got %s", 10*time.Minute, d)
+	}
+	tr.ConsecutiveFailures = 3
+	if d := tr.nextInterval(); d != 30*time.Second {
+		t.Errorf("Expected %s, got %s", 30*time.Second, d)
+	}
+}
+
+func TestFailureBackoff(t *testing.T) {
+	tr := &testRunner{interval: time.Minute}
+	WithFailureBackoff(time.Minute, 10*time.Minute)(tr)
+	for failures, expected := range []time.Duration{
+		time.Minute,
+		time.Minute,
+		2 * time.Minute,
+		4 * time.Minute,
+		8 * time.Minute,
+		10 * time.Minute,
+		10 * time.Minute,
+	} {
+		tr.ConsecutiveFailures = failures
+		if d := tr.nextInterval(); d != expected {
+			t.Errorf("%d failures: expected %s, got %s", failures, expected, d)
+		}
+	}
+}
+
+func TestFailureBackoffOptionOrder(t *testing.T) {
+	for _, opts := range [][]ScheduleOption{
+		{WithFailureInterval(30 * time.Second), WithFailureBackoff(time.Minute, 10*time.Minute)},
+		{WithFailureBackoff(time.Minute, 10*time.Minute), WithFailureInterval(30 * time.Second)},
+	} {
+		tr := &testRunner{interval: time.Hour, ConsecutiveFailures: 2}
+		for _, opt := range opts {
+			opt(tr)
+		}
+		if d := tr.nextInterval(); d != time.Minute {
+			t.Errorf("Expected %s, got %s", time.Minute, d)
+		}
+	}
+}
+
+func TestScheduleNonPositiveInterval(t *testing.T) {
+	r := &Runner{}
+	r.Schedule("test", func(t *T) {}, 0)
+	r.Schedule("negative", func(t *T) {}, time.Minute, WithInterval(-time.Second))
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if len(r.tests) != 0 {
+		t.Errorf("Expected tests with non-positive intervals not to be scheduled, got %d", len(r.tests))
+	}
+}
+
+func TestNextRunAfterFailure(t *testing.T) {
+	r := &Runner{}
+	defer r.Stop()
+	r.Schedule("test", func(t *T) {
+		t.Errorf("failed")
+	}, time.Hour, RunOnStart(), WithFailureInterval(time.Minute))
+	r.mu.Lock()
+	tr := r.tests["test"]
+	r.mu.Unlock()
+	deadline := time.Now().Add(5 * time.Second)
+	for time.Now().Before(deadline) {
+		tr.stateMu.Lock()
+		next := tr.NextRun
+		tr.stateMu.Unlock()
+		if len(r.History("test")) == 1 && time.Until(next) <= time.Minute {
+			return
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+	t.Error("Expected the next run to use the failure interval")
+}
+
+func TestRunnerStop(t *testing.T) {
+	r := &Runner{}
+	var runs int32
+	r.Schedule("test", func(t *T) {
+		atomic.AddInt32(&runs, 1)
+	}, 10*time.Millisecond)
+	for atomic.LoadInt32(&runs) == 0 {
+		time.Sleep(time.Millisecond)
+	}
+	r.Stop()
+	r.Stop()
+	// a run triggered just before Stop may still be finishing
+	time.Sleep(20 * time.Millisecond)
+	stopped := atomic.LoadInt32(&runs)
+	time.Sleep(50 * time.Millisecond)
+	if n := atomic.LoadInt32(&runs); n != stopped {
+		t.Errorf("Expected no runs after Stop, got %d more", n-stopped)
+	}
+}
+
+func TestFirstInterval(t *testing.T) {
+	tr := &testRunner{Name: "TestStagger", interval: time.Minute}
+	if d := tr.firstInterval(); d != time.Minute {
+		t.Errorf("Expected %s, got %s", time.Minute, d)
+	}
+	RunOnStart()(tr)
+	if d := tr.firstInterval(); d != 0 {
+		t.Errorf("Expected 0, got %s", d)
+	}
+	WithStagger()(tr)
+	if d := tr.firstInterval(); d != 0 {
+		t.Errorf("Expected a staggered test to still run on start, got %s", d)
+	}
+	phase := tr.staggerPhase()
+	if phase < 0 || phase >= time.Minute {
+		t.Errorf("Expected phase within interval, got %s", phase)
+	}
+	other := &testRunner{Name: "TestStagger", interval: time.Minute, stagger: true}
+	if d := other.firstInterval(); d != time.Minute+phase {
+		t.Errorf("Expected phase to be deterministic, got %s and %s", time.Minute+phase, d)
+	}
+}
+
+func TestRunOnStartStaggered(t *testing.T) {
+	r := &Runner{}
+	defer r.Stop()
+	r.Schedule("test", func(t *T) {}, time.Hour, RunOnStart(), WithStagger(), WithJitter(time.Hour))
+	deadline := time.Now().Add(5 * time.Second)
+	for len(r.History("test")) == 0 {
+		if time.Now().After(deadline) {
+			t.Fatal("Expected the test to run on start")
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestJitter(t *testing.T) {
+	tr := &testRunner{interval: time.Minute}
+	if d := tr.jitter(); d != 0 {
+		t.Errorf("Expected no jitter, got %s", d)
+	}
+	WithJitter(time.Second)(tr)
+	for i := 0; i < 100; i++ {
+		if d := tr.jitter(); d < 0 || d >= time.Second {
+			t.Fatalf("Expected jitter within 1s, got %s", d)
+		}
+	}
+}
+
+func TestConcurrencyGroup(t *testing.T) {
+	r := &Runner{
+		ConcurrencyGroups: map[string]int{"db": 1},
+	}
+	var (
+		mu      sync.Mutex
+		running int
+		maxSeen int
+	)
+	test := func(t *T) {
+		mu.Lock()
+		running++
+		if running > maxSeen {
+			maxSeen = running
+		}
+		mu.Unlock()
+		time.Sleep(10 * time.Millisecond)
+		mu.Lock()
+		running--
+		mu.Unlock()
+	}
+	var runners []*testRunner
+	for _, name := range []string{"a", "b", "c"} {
+		tr := &testRunner{Name: name, t: test, n: defaultNotifier, group: "db"}
+		r.mu.Lock()
+		tr.sems = r.semaphores(tr.group)
+		r.mu.Unlock()
+		runners = append(runners, tr)
+	}
+	var wg sync.WaitGroup
+	for _, tr := range runners {
+		wg.Add(1)
+		go func(tr *testRunner) {
+			defer wg.Done()
+			r.runTest(tr)
+		}(tr)
+	}
+	wg.Wait()
+	if maxSeen != 1 {
+		t.Errorf("Expected at most 1 concurrent run, got %d", maxSeen)
+	}
+	var waited bool
+	for _, name := range []string{"a", "b", "c"} {
+		if r.History(name)[0].QueueWait >= 10*time.Millisecond {
+			waited = true
+		}
+	}
+	if !waited {
+		t.Error("Expected at least one run to record queue wait time")
+	}
+}
+
+func TestOverlapSkip(t *testing.T) {
+	r := &Runner{}
+	release := make(chan struct{})
+	tr := &testRunner{
+		Name:    "test",
+		t:       func(t *T) { <-release },
+		n:       defaultNotifier,
+		overlap: OverlapSkip,
+	}
+	r.trigger(tr, "schedule")
+	r.trigger(tr, "schedule")
+	if r.start(tr) {
+		t.Error("Expected start to fail while the test is running")
+	}
+	close(release)
+	waitIdle(t, tr)
+	if n := len(r.History("test")); n != 1 {
+		t.Errorf("Expected 1 run, got %d", n)
+	}
+	events := r.Events("test")
+	if len(events) != 1 || events[0].Type != EventSkipped {
+		t.Errorf("Expected 1 skipped event, got %+v", events)
+	}
+}
+
+func TestOverlapCancelPrevious(t *testing.T) {
+	r := &Runner{}
+	started := make(chan struct{}, 2)
+	tr := &testRunner{
+		Name: "test",
+		t: func(t *T) {
+			started <- struct{}{}
+			select {
+			case <-t.Context().Done():
+				t.Errorf("cancelled")
+			case <-time.After(50 * time.Millisecond):
+			}
+		},
+		n:       defaultNotifier,
+		overlap: OverlapCancelPrevious,
+	}
+	r.trigger(tr, "schedule")
+	<-started
+	r.trigger(tr, "schedule")
+	waitIdle(t, tr)
+	hist := r.History("test")
+	if len(hist) != 2 {
+		t.Fatalf("Expected 2 runs, got %d", len(hist))
+	}
+	if hist[0].State != TestStateCancelled {
+		t.Errorf("Expected %q, got %q", TestStateCancelled, hist[0].State)
+	}
+	if hist[1].State != TestStatePassed {
+		t.Errorf("Expected %q, got %q", TestStatePassed, hist[1].State)
+	}
+	if tr.Failures != 0 {
+		t.Errorf("Expected cancelled run not to count as a failure, got %d failures", tr.Failures)
+	}
+}
+
+func TestOverlapCancelPreviousQueued(t *testing.T) {
+	r := &Runner{}
+	sem := make(semaphore, 1)
+	sem <- struct{}{}
+	tr := &testRunner{
+		Name:    "test",
+		t:       func(t *T) {},
+		n:       defaultNotifier,
+		overlap: OverlapCancelPrevious,
+		sems:    []semaphore{sem},
+	}
+	r.trigger(tr, "schedule")
+	for queued := false; !queued; time.Sleep(time.Millisecond) {
+		tr.stateMu.Lock()
+		queued = tr.cancel != nil
+		tr.stateMu.Unlock()
+	}
+	r.trigger(tr, "schedule")
+	<-sem
+	waitIdle(t, tr)
+	hist := r.History("test")
+	if len(hist) != 2 {
+		t.Fatalf("Expected 2 runs, got %d", len(hist))
+	}
+	if hist[0].State != TestStateCancelled {
+		t.Errorf("Expected queued run to be %q, got %q", TestStateCancelled, hist[0].State)
+	}
+	if hist[1].State != TestStatePassed {
+		t.Errorf("Expected %q, got %q", TestStatePassed, hist[1].State)
+	}
+}
+
+func waitIdle(t *testing.T, tr *testRunner) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second)
+	for time.Now().Before(deadline) {
+		tr.stateMu.Lock()
+		running := tr.running
+		tr.stateMu.Unlock()
+		if !running {
+			return
+		}
+		time.Sleep(time.Millisecond)
+	}
+	t.Fatal("timed out waiting for test to finish")
+}
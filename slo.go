@@ -0,0 +1,246 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"net/http"
+	"sort"
+	"time"
+)
+
+// DefaultAlertBurnRate is the burn rate at which an SLO sends a notification,
+// if the SLO doesn't set AlertBurnRate. Over the default alert window, a twelfth
+// of the SLO's window, a burn rate of 3 uses a quarter of the error budget.
+const DefaultAlertBurnRate = 3
+
+// defaultAlertWindowFraction is the fraction of the SLO's window used as the
+// alert window, if the SLO doesn't set AlertWindow. A short alert window notices
+// a fast burn while there is still budget left.
+const defaultAlertWindowFraction = 12
+
+// SLO is a service level objective for a scheduled test, it is measured
+// against the runs of the test recorded in the Runner's history
+type SLO struct {
+	Name string
+	// Objective is the fraction of runs in the window that must be good, e.g. 0.99
+	Objective float64
+	// Window is the period of history the objective is measured over
+	Window time.Duration
+	// LatencyThreshold, if set, only counts passing runs that took less than the
+	// threshold as good. With an Objective of 0.95 this is equivalent to requiring
+	// that the p95 duration is below the threshold.
+	LatencyThreshold time.Duration
+	// AlertWindow is the period used to measure how fast the error budget is burning,
+	// it defaults to a twelfth of Window
+	AlertWindow time.Duration
+	// AlertBurnRate is the burn rate over AlertWindow at which a notification is sent,
+	// it defaults to DefaultAlertBurnRate
+	AlertBurnRate float64
+}
+
+// AvailabilitySLO requires that objective of runs pass over the window
+func AvailabilitySLO(objective float64, window time.Duration) SLO {
+	return SLO{
+		Name:      fmt.Sprintf("%g%% of runs pass over %s", objective*100, window),
+		Objective: objective,
+		Window:    window,
+	}
+}
+
+// LatencySLO requires that the quantile duration of runs is below threshold over the window,
+// e.g. LatencySLO(0.95, 2*time.Second, time.Hour) for p95 < 2s over 1h. Failed runs count
+// against the objective.
+func LatencySLO(quantile float64, threshold, window time.Duration) SLO {
+	return SLO{
+		Name:             fmt.Sprintf("p%g duration < %s over %s", quantile*100, threshold, window),
+		Objective:        quantile,
+		Window:           window,
+		LatencyThreshold: threshold,
+	}
+}
+
+// WithSLO adds a service level objective to the test, the status of each SLO is exposed
+// in the API and a notification is sent when the error budget burns too fast. The
+// Objective must be between 0 and 1, an objective of 1 has no error budget to measure
+// so the SLO is ignored when the test is scheduled.
+func WithSLO(slo SLO) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.slos = append(tr.slos, slo)
+	}
+}
+
+// checkSLOs drops any of the test's SLOs with an objective outside of (0, 1)
+func (tr *testRunner) checkSLOs() {
+	var valid []SLO
+	for _, slo := range tr.slos {
+		if slo.Objective <= 0 || slo.Objective >= 1 {
+			log.Printf("e2e: ignoring SLO %q for %s, objective must be between 0 and 1, got %g", slo.Name, tr.Name, slo.Objective)
+			continue
+		}
+		valid = append(valid, slo)
+	}
+	tr.slos = valid
+}
+
+// sloWindow gives the longest window of the test's SLOs, runs within it are kept
+// in the history so the SLOs can be evaluated
+func (tr *testRunner) sloWindow() time.Duration {
+	var window time.Duration
+	for _, slo := range tr.slos {
+		window = max(window, slo.Window, slo.AlertWindow)
+	}
+	return window
+}
+
+// SLOStatus is the current state of an SLO
+type SLOStatus struct {
+	SLO
+	// Runs is the number of passed or failed runs in the window
+	Runs int
+	// Good is the number of runs that met the objective
+	Good int
+	// Ratio is the fraction of good runs
+	Ratio float64
+	// Percentile is the Objective quantile of the run durations in the window, e.g. p95
+	Percentile time.Duration
+	// BurnRate is how fast the error budget is being used over the window, a
+	// burn rate of 1 uses exactly the whole budget by the end of the window
+	BurnRate float64
+	// AlertWindowBurnRate is the burn rate over the SLO's AlertWindow
+	AlertWindowBurnRate float64
+	// ErrorBudgetRemaining is the fraction of the error budget left in the window,
+	// it is 0 once the budget is used up
+	ErrorBudgetRemaining float64
+	// Burning is true when the burn rate over the alert window is at or above the alert burn rate
+	Burning bool
+}
+
+func (slo SLO) good(rec RunRecord) bool {
+	if rec.State != TestStatePassed {
+		return false
+	}
+	return slo.LatencyThreshold == 0 || rec.Duration < slo.LatencyThreshold
+}
+
+// evaluate calculates the status of the SLO from a test's history at now
+func (slo SLO) evaluate(history []RunRecord, now time.Time) SLOStatus {
+	s := SLOStatus{SLO: slo}
+	if s.AlertWindow == 0 {
+		s.AlertWindow = s.Window / defaultAlertWindowFraction
+	}
+	if s.AlertBurnRate == 0 {
+		s.AlertBurnRate = DefaultAlertBurnRate
+	}
+	var (
+		durations           []time.Duration
+		alertRuns, alertBad int
+	)
+	for _, rec := range history {
+		if rec.State != TestStatePassed && rec.State != TestStateFailed {
+			continue
+		}
+		age := now.Sub(rec.Start)
+		if age > s.Window {
+			continue
+		}
+		s.Runs++
+		durations = append(durations, rec.Duration)
+		good := slo.good(rec)
+		if good {
+			s.Good++
+		}
+		if age <= s.AlertWindow {
+			alertRuns++
+			if !good {
+				alertBad++
+			}
+		}
+	}
+	if s.Runs == 0 {
+		s.Ratio = 1
+		s.ErrorBudgetRemaining = 1
+		return s
+	}
+	s.Ratio = float64(s.Good) / float64(s.Runs)
+	s.BurnRate = burnRate(s.Runs-s.Good, s.Runs, slo.Objective)
+	s.ErrorBudgetRemaining = max(0, 1-s.BurnRate)
+	if alertRuns > 0 {
+		s.AlertWindowBurnRate = burnRate(alertBad, alertRuns, slo.Objective)
+	}
+	s.Burning = s.AlertWindowBurnRate >= s.AlertBurnRate
+	sort.Slice(durations, func(i, j int) bool { return durations[i] < durations[j] })
+	i := int(float64(len(durations))*slo.Objective+0.5) - 1
+	if i < 0 {
+		i = 0
+	}
+	if i >= len(durations) {
+		i = len(durations) - 1
+	}
+	s.Percentile = durations[i]
+	return s
+}
+
+// burnRate gives the rate the error budget is being used, the fraction of bad runs
+// divided by the fraction allowed by the objective. WithSLO ensures the objective is
+// less than 1, so there is always some budget.
+func burnRate(bad, runs int, objective float64) float64 {
+	return float64(bad) / float64(runs) / (1 - objective)
+}
+
+// evaluateSLOs updates the status of the test's SLOs after a run, and sends a
+// notification for any SLO that has started burning its error budget too fast
+func (r *Runner) evaluateSLOs(tr *testRunner) {
+	if len(tr.slos) == 0 {
+		return
+	}
+	now := time.Now()
+	statuses := make([]SLOStatus, len(tr.slos))
+	r.mu.Lock()
+	for i, slo := range tr.slos {
+		statuses[i] = slo.evaluate(r.history[tr.Name], now)
+	}
+	r.mu.Unlock()
+	tr.stateMu.Lock()
+	previous := tr.SLOs
+	tr.SLOs = statuses
+	tr.stateMu.Unlock()
+	for i, s := range statuses {
+		wasBurning := i < len(previous) && previous[i].Burning
+		if !s.Burning || wasBurning {
+			continue
+		}
+		status := s
+		tr.n.Notify(Notification{
+			Kind:     NotificationBudgetBurn,
+			Name:     tr.Name,
+			Output:   []byte(fmt.Sprintf("SLO %q error budget burn rate is %.2f over %s\n", s.Name, s.AlertWindowBurnRate, s.AlertWindow)),
+			Metadata: tr.Metadata,
+			SLO:      &status,
+		})
+	}
+}
+
+// SLOs returns the current status of the SLOs of every test
+func (r *Runner) SLOs() map[string][]SLOStatus {
+	r.mu.Lock()
+	tests := make([]*testRunner, 0, len(r.tests))
+	for _, tr := range r.tests {
+		tests = append(tests, tr)
+	}
+	r.mu.Unlock()
+	slos := make(map[string][]SLOStatus)
+	for _, tr := range tests {
+		tr.stateMu.Lock()
+		if len(tr.SLOs) > 0 {
+			slos[tr.Name] = append([]SLOStatus(nil), tr.SLOs...)
+		}
+		tr.stateMu.Unlock()
+	}
+	return slos
+}
+
+func (r *Runner) SLOHandler(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "application/json")
+	json.NewEncoder(w).Encode(r.SLOs())
+}
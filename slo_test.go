@@ -0,0 +1,138 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+)
+
+func TestSLOEvaluate(t *testing.T) {
+	now := time.Now()
+	var history []RunRecord
+	for i := 0; i < 100; i++ {
+		rec := RunRecord{
+			State:    TestStatePassed,
+			Start:    now.Add(-time.Duration(i) * time.Minute),
+			Duration: time.Second,
+		}
+		if i < 3 {
+			rec.State = TestStateFailed
+		}
+		if i >= 90 {
+			rec.Duration = 3 * time.Second
+		}
+		history = append(history, rec)
+	}
+	// blocked runs don't count towards SLOs
+	history = append(history, RunRecord{State: TestStateBlocked, Start: now})
+
+	s := AvailabilitySLO(0.99, 2*time.Hour).evaluate(history, now)
+	if s.Runs != 100 || s.Good != 97 {
+		t.Errorf("Expected 97 of 100 good runs, got %d of %d", s.Good, s.Runs)
+	}
+	if s.BurnRate < 2.99 || s.BurnRate > 3.01 {
+		t.Errorf("Expected burn rate of 3, got %f", s.BurnRate)
+	}
+	if s.ErrorBudgetRemaining != 0 {
+		t.Errorf("Expected no error budget remaining, got %f", s.ErrorBudgetRemaining)
+	}
+	if s.AlertWindow != 10*time.Minute {
+		t.Errorf("Expected alert window of 10m, got %s", s.AlertWindow)
+	}
+	if !s.Burning {
+		t.Error("Expected SLO to be burning")
+	}
+
+	// the same failures spread over the window don't burn the budget fast
+	// enough to alert
+	history[0].State, history[60].State = TestStatePassed, TestStateFailed
+	s = AvailabilitySLO(0.9, 2*time.Hour).evaluate(history, now)
+	if s.AlertWindowBurnRate < 1.81 || s.AlertWindowBurnRate > 1.82 || s.Burning {
+		t.Errorf("Expected burn rate of 1.82 over the alert window without burning, got %+v", s)
+	}
+	if s.ErrorBudgetRemaining < 0.69 || s.ErrorBudgetRemaining > 0.71 {
+		t.Errorf("Expected 70%% of the error budget remaining, got %f", s.ErrorBudgetRemaining)
+	}
+
+	s = LatencySLO(0.95, 2*time.Second, 2*time.Hour).evaluate(history, now)
+	if s.Good != 87 {
+		t.Errorf("Expected 87 good runs, got %d", s.Good)
+	}
+	if s.Percentile != 3*time.Second {
+		t.Errorf("Expected p95 of 3s, got %s", s.Percentile)
+	}
+
+	s = AvailabilitySLO(0.99, 30*time.Minute).evaluate(history, now)
+	if s.Runs != 31 {
+		t.Errorf("Expected 31 runs in window, got %d", s.Runs)
+	}
+}
+
+func TestSLOBudgetBurnNotification(t *testing.T) {
+	var notifications []Notification
+	r := &Runner{}
+	fail := true
+	tr := &testRunner{
+		Name: "test",
+		t: func(t *T) {
+			if fail {
+				t.Error("failed")
+			}
+		},
+		n: NotifierFunc(func(n Notification) {
+			notifications = append(notifications, n)
+		}),
+	}
+	WithSLO(AvailabilitySLO(0.9, time.Hour))(tr)
+	r.tests = map[string]*testRunner{tr.Name: tr}
+
+	r.runTest(tr)
+	r.runTest(tr)
+	var burns int
+	for _, n := range notifications {
+		if n.Kind == NotificationBudgetBurn {
+			burns++
+			if n.SLO == nil || !n.SLO.Burning {
+				t.Errorf("Expected burning SLO status, got %+v", n.SLO)
+			}
+			if n.Failed {
+				t.Error("Expected budget burn notification not to be reported as a failed run")
+			}
+		}
+	}
+	if burns != 1 {
+		t.Errorf("Expected a single budget burn notification, got %d", burns)
+	}
+	if s := r.SLOs()["test"]; len(s) != 1 || s[0].Runs != 2 {
+		t.Errorf("Unexpected SLO status %+v", s)
+	}
+}
+
+func TestWithSLOObjective(t *testing.T) {
+	tr := &testRunner{Name: "test"}
+	WithSLO(AvailabilitySLO(1, time.Hour))(tr)
+	WithSLO(AvailabilitySLO(0, time.Hour))(tr)
+	tr.checkSLOs()
+	if len(tr.slos) != 0 {
+		t.Errorf("Expected SLOs without an error budget to be ignored, got %+v", tr.slos)
+	}
+	WithSLO(AvailabilitySLO(0.99, time.Hour))(tr)
+	WithSLO(SLO{Objective: 0.9, Window: time.Hour, AlertWindow: 2 * time.Hour})(tr)
+	if w := tr.sloWindow(); w != 2*time.Hour {
+		t.Errorf("Expected SLO window of 2h, got %s", w)
+	}
+}
+
+func TestHistoryTrimmed(t *testing.T) {
+	r := &Runner{}
+	start := time.Now().Add(-time.Hour)
+	for i := 0; i < maxHistory+10; i++ {
+		r.addHistory(RunRecord{Name: "old", Start: start}, time.Minute)
+		r.addHistory(RunRecord{Name: "slo", Start: start}, 2*time.Hour)
+	}
+	if n := len(r.History("old")); n != maxHistory {
+		t.Errorf("Expected %d runs, got %d", maxHistory, n)
+	}
+	if n := len(r.History("slo")); n != maxHistory+10 {
+		t.Errorf("Expected runs within the SLO window to be kept, got %d", n)
+	}
+}
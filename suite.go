@@ -0,0 +1,148 @@
+package e2e
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// DependsOn declares that a test depends on other scheduled tests, while any of
+// them are failing runs of this test are recorded as blocked rather than failed,
+// so that only the root cause sends a notification. The dependencies must be
+// scheduled first, Runner.Load orders registered tests so they are, and a test
+// which depends on itself through its dependencies isn't scheduled.
+func DependsOn(names ...string) ScheduleOption {
+	return func(tr *testRunner) {
+		tr.dependsOn = append(tr.dependsOn, names...)
+	}
+}
+
+// failingDependencies returns the names of any dependencies of the test which are failing
+func (r *Runner) failingDependencies(tr *testRunner) []string {
+	if len(tr.dependsOn) == 0 {
+		return nil
+	}
+	r.mu.Lock()
+	deps := make([]*testRunner, 0, len(tr.dependsOn))
+	for _, name := range tr.dependsOn {
+		if dep, ok := r.tests[name]; ok {
+			deps = append(deps, dep)
+		}
+	}
+	r.mu.Unlock()
+	var failing []string
+	for _, dep := range deps {
+		dep.stateMu.Lock()
+		// a blocked dependency isn't known to be passing, so it blocks this test too
+		if dep.ConsecutiveFailures > 0 || dep.blocked {
+			failing = append(failing, dep.Name)
+		}
+		dep.stateMu.Unlock()
+	}
+	return failing
+}
+
+// checkDependencies returns an error if any of the test's dependencies isn't scheduled,
+// or if they lead back to the test, as it would block itself. It must be called with
+// r.mu held.
+func (r *Runner) checkDependencies(tr *testRunner) error {
+	for _, name := range tr.dependsOn {
+		if _, ok := r.tests[name]; !ok && name != tr.Name {
+			return fmt.Errorf("dependency %s isn't scheduled", name)
+		}
+	}
+	seen := make(map[string]bool)
+	queue := append([]string(nil), tr.dependsOn...)
+	for len(queue) > 0 {
+		name := queue[0]
+		queue = queue[1:]
+		if name == tr.Name {
+			return fmt.Errorf("its dependencies depend on it")
+		}
+		if seen[name] {
+			continue
+		}
+		seen[name] = true
+		if dep, ok := r.tests[name]; ok {
+			queue = append(queue, dep.dependsOn...)
+		}
+	}
+	return nil
+}
+
+// block records a run that was skipped because of failing dependencies,
+// no notification is sent and the run isn't counted as a pass or failure
+func (tr *testRunner) block(id string, failing []string) RunRecord {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	tr.State = TestStateBlocked
+	tr.BlockedBy = failing
+	tr.stateMu.Lock()
+	tr.blocked = true
+	tr.stateMu.Unlock()
+	return RunRecord{
+		ID:        id,
+		Name:      tr.Name,
+		State:     TestStateBlocked,
+		Start:     time.Now(),
+		BlockedBy: failing,
+	}
+}
+
+// blockedMessage describes why a run was blocked
+func blockedMessage(failing []string) string {
+	return "blocked by failing dependencies: " + strings.Join(failing, ", ") + "\n"
+}
+
+// Suite is an ordered sequence of tests that run on a single schedule, each test is run
+// as a subtest of the suite. Setup and Teardown can be used to share state between the tests.
+type Suite struct {
+	Name string
+	// Setup runs before the tests, if it fails the tests are not run
+	Setup Test
+	// Teardown runs after the tests, even if Setup or any of the tests failed
+	Teardown Test
+	// FailFast skips the remaining tests once one has failed
+	FailFast bool
+
+	tests []suiteTest
+}
+
+type suiteTest struct {
+	name string
+	t    Test
+}
+
+// Add a test to the end of the suite
+func (s *Suite) Add(name string, t Test) {
+	s.tests = append(s.tests, suiteTest{name: name, t: t})
+}
+
+// Test returns the suite as a single Test
+func (s *Suite) Test() Test {
+	return func(t *T) {
+		if s.Teardown != nil {
+			defer t.Run("teardown", s.Teardown)
+		}
+		if s.Setup != nil {
+			t.Run("setup", s.Setup)
+			if t.Failed() {
+				return
+			}
+		}
+		for _, st := range s.tests {
+			if s.FailFast && t.Failed() {
+				t.Run(st.name, func(t *T) {
+					t.Skip("skipped after earlier failure")
+				})
+				continue
+			}
+			t.Run(st.name, st.t)
+		}
+	}
+}
+
+// ScheduleSuite schedules a Suite to run every interval, it is scheduled using the suite's Name
+func (r *Runner) ScheduleSuite(s *Suite, interval time.Duration, opts ...ScheduleOption) {
+	r.schedule(s.Name, s.Test(), interval, nil, opts)
+}
@@ -0,0 +1,149 @@
+package e2e
+
+import (
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+)
+
+func TestSuiteOrder(t *testing.T) {
+	var calls []string
+	record := func(name string, fail bool) Test {
+		return func(t *T) {
+			calls = append(calls, name)
+			if fail {
+				t.FailNow()
+			}
+		}
+	}
+	s := &Suite{
+		Name:     "suite",
+		Setup:    record("setup", false),
+		Teardown: record("teardown", false),
+		FailFast: true,
+	}
+	s.Add("login", record("login", false))
+	s.Add("checkout", record("checkout", true))
+	s.Add("logout", record("logout", false))
+	tt := Run("suite", s.Test())
+	if !tt.Failed() {
+		t.Error("Expected suite to fail")
+	}
+	expected := []string{"setup", "login", "checkout", "teardown"}
+	if !reflect.DeepEqual(calls, expected) {
+		t.Errorf("Expected %v, got %v", expected, calls)
+	}
+	if !tt.subTests[3].Skipped() {
+		t.Error("Expected logout to be skipped")
+	}
+}
+
+func TestSuiteSetupFailure(t *testing.T) {
+	var ran bool
+	s := &Suite{
+		Name:  "suite",
+		Setup: func(t *T) { t.Fatal("setup failed") },
+	}
+	s.Add("test", func(t *T) { ran = true })
+	if tt := Run("suite", s.Test()); !tt.Failed() {
+		t.Error("Expected suite to fail")
+	}
+	if ran {
+		t.Error("Expected tests not to run after setup failure")
+	}
+}
+
+func TestDependsOn(t *testing.T) {
+	r := &Runner{}
+	n := &testNotifier{}
+	login := &testRunner{Name: "TestLogin", t: func(t *T) { t.Error("login broken") }, n: defaultNotifier}
+	checkout := &testRunner{Name: "TestCheckout", t: func(t *T) {}, n: n}
+	DependsOn("TestLogin")(checkout)
+	r.tests = map[string]*testRunner{"TestLogin": login, "TestCheckout": checkout}
+
+	r.runTest(login)
+	r.runTest(checkout)
+	hist := r.History("TestCheckout")
+	if len(hist) != 1 || hist[0].State != TestStateBlocked {
+		t.Fatalf("Expected a single blocked run, got %+v", hist)
+	}
+	if !reflect.DeepEqual(hist[0].BlockedBy, []string{"TestLogin"}) {
+		t.Errorf("Expected blocked by TestLogin, got %v", hist[0].BlockedBy)
+	}
+	if n.n != nil {
+		t.Error("Expected no notification for a blocked run")
+	}
+	if hist[0].Attempts != nil {
+		t.Errorf("Expected no attempts for a blocked run, got %+v", hist[0].Attempts)
+	}
+
+	login.t = func(t *T) {}
+	r.runTest(login)
+	r.runTest(checkout)
+	if state := r.History("TestCheckout")[1].State; state != TestStatePassed {
+		t.Errorf("Expected %q, got %q", TestStatePassed, state)
+	}
+}
+
+func TestDependsOnBlocked(t *testing.T) {
+	r := &Runner{}
+	login := &testRunner{Name: "TestLogin", t: func(t *T) { t.Error("login broken") }, n: defaultNotifier}
+	cart := &testRunner{Name: "TestCart", t: func(t *T) {}, n: defaultNotifier}
+	checkout := &testRunner{Name: "TestCheckout", t: func(t *T) {}, n: defaultNotifier}
+	DependsOn("TestLogin")(cart)
+	DependsOn("TestCart")(checkout)
+	r.tests = map[string]*testRunner{"TestLogin": login, "TestCart": cart, "TestCheckout": checkout}
+
+	r.runTest(login)
+	r.runTest(cart)
+	r.runTest(checkout)
+	hist := r.History("TestCheckout")
+	if len(hist) != 1 || hist[0].State != TestStateBlocked {
+		t.Fatalf("Expected a blocked dependency to block the test, got %+v", hist)
+	}
+	if !reflect.DeepEqual(hist[0].BlockedBy, []string{"TestCart"}) {
+		t.Errorf("Expected blocked by TestCart, got %v", hist[0].BlockedBy)
+	}
+
+	login.t = func(t *T) {}
+	r.runTest(login)
+	r.runTest(cart)
+	r.runTest(checkout)
+	if state := r.History("TestCheckout")[1].State; state != TestStatePassed {
+		t.Errorf("Expected %q, got %q", TestStatePassed, state)
+	}
+}
+
+func TestDependsOnValidation(t *testing.T) {
+	r := &Runner{}
+	defer r.Stop()
+	noop := func(t *T) {}
+	r.Schedule("TestUnknown", noop, time.Hour, DependsOn("TestMissing"))
+	r.Schedule("TestSelf", noop, time.Hour, DependsOn("TestSelf"))
+	r.Schedule("TestLogin", noop, time.Hour)
+	r.Schedule("TestCheckout", noop, time.Hour, DependsOn("TestLogin"))
+	// rescheduling TestLogin to depend on TestCheckout would block both forever
+	r.Schedule("TestLogin", noop, time.Hour, DependsOn("TestCheckout"))
+
+	reg := &Registry{}
+	reg.Register("TestB", noop, DependsOn("TestA"))
+	reg.Register("TestA", noop)
+	reg.Register("TestCycleA", noop, DependsOn("TestCycleB"))
+	reg.Register("TestCycleB", noop, DependsOn("TestCycleA"))
+	r.Load(reg, Filter{})
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var names []string
+	for name := range r.tests {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	if expected := []string{"TestA", "TestB", "TestCheckout", "TestLogin"}; !reflect.DeepEqual(names, expected) {
+		t.Errorf("Expected %v to be scheduled, got %v", expected, names)
+	}
+	if deps := r.tests["TestLogin"].dependsOn; deps != nil {
+		t.Errorf("Expected TestLogin to keep its original schedule, got dependencies %v", deps)
+	}
+}
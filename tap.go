@@ -0,0 +1,100 @@
+package e2e
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// tapReporter writes results in TAP version 14 format, documented at
+// https://testanything.org/tap-version-14-specification.html. Subtests are
+// written as nested TAP subtests once their top level test has finished.
+type tapReporter struct {
+	mu    sync.Mutex
+	w     io.Writer
+	count int
+}
+
+func newTAPReporter(w io.Writer) *tapReporter {
+	io.WriteString(w, "TAP version 14\n")
+	return &tapReporter{w: w}
+}
+
+func (tr *tapReporter) started(t *T) {}
+
+func (tr *tapReporter) logged(t *T, s string) {}
+
+func (tr *tapReporter) finished(t *T) {
+	if t.parent != nil {
+		return
+	}
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	tr.count++
+	tr.writeTest(t, tr.count, "")
+}
+
+func (tr *tapReporter) summary(failed bool, elapsed time.Duration, timedOut error) {
+	tr.mu.Lock()
+	defer tr.mu.Unlock()
+	if timedOut != nil {
+		fmt.Fprintf(tr.w, "Bail out! %v\n", timedOut)
+		return
+	}
+	fmt.Fprintf(tr.w, "1..%d\n", tr.count)
+}
+
+// writeTest writes the test point for t, preceded by its subtests, each line is
+// prefixed with indent. This must be called with tr.mu held.
+func (tr *tapReporter) writeTest(t *T, n int, indent string) {
+	t.mu.RLock()
+	subTests := t.subTests
+	t.mu.RUnlock()
+	if len(subTests) > 0 {
+		fmt.Fprintf(tr.w, "%s# Subtest: %s\n", indent, t.name)
+		for i, st := range subTests {
+			tr.writeTest(st, i+1, indent+"    ")
+		}
+		fmt.Fprintf(tr.w, "%s    1..%d\n", indent, len(subTests))
+	}
+
+	status := "ok"
+	if t.Failed() {
+		status = "not ok"
+	}
+	logs := t.logs()
+	var directive string
+	if t.Skipped() && !t.Failed() {
+		directive = " # SKIP"
+		if reason := skipReason(logs); reason != "" {
+			directive += " " + reason
+		}
+	}
+	fmt.Fprintf(tr.w, "%s%s %d - %s%s\n", indent, status, n, t.name, directive)
+	if !t.Failed() {
+		return
+	}
+	fmt.Fprintf(tr.w, "%s  ---\n", indent)
+	fmt.Fprintf(tr.w, "%s  duration_ms: %.3f\n", indent, float64(t.Duration())/float64(time.Millisecond))
+	if logs != "" {
+		fmt.Fprintf(tr.w, "%s  output: |\n", indent)
+		for _, line := range strings.Split(strings.TrimRight(logs, "\n"), "\n") {
+			fmt.Fprintf(tr.w, "%s    %s\n", indent, strings.TrimLeft(line, "\t"))
+		}
+	}
+	fmt.Fprintf(tr.w, "%s  ...\n", indent)
+}
+
+// skipReason gives the message of the last line logged by a skipped test, without
+// the file and line added by decorate
+func skipReason(logs string) string {
+	lines := strings.Split(strings.TrimRight(logs, "\n"), "\n")
+	last := strings.TrimSpace(lines[len(lines)-1])
+	if i := strings.Index(last, ": "); i >= 0 {
+		last = last[i+2:]
+	}
+	// a # would start a new directive
+	return strings.Replace(last, "#", "\\#", -1)
+}
@@ -0,0 +1,87 @@
+package e2e
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+	"time"
+)
+
+// testEvent is the event format written by go test -json, documented
+// at https://golang.org/cmd/test2json
+type testEvent struct {
+	Time    *time.Time `json:",omitempty"`
+	Action  string
+	Package string  `json:",omitempty"`
+	Test    string  `json:",omitempty"`
+	Elapsed float64 `json:",omitempty"`
+	Output  string  `json:",omitempty"`
+}
+
+// jsonReporter streams test2json events, so that tools which consume
+// go test -json output can consume e2e runs unchanged
+type jsonReporter struct {
+	pkg string
+
+	mu  sync.Mutex
+	enc *json.Encoder
+}
+
+func newJSONReporter(w io.Writer, pkg string) *jsonReporter {
+	return &jsonReporter{
+		pkg: pkg,
+		enc: json.NewEncoder(w),
+	}
+}
+
+func (jr *jsonReporter) emit(e testEvent) {
+	now := time.Now()
+	e.Time = &now
+	e.Package = jr.pkg
+	jr.mu.Lock()
+	defer jr.mu.Unlock()
+	jr.enc.Encode(e)
+}
+
+// output emits an output event for each line of s
+func (jr *jsonReporter) output(test, s string) {
+	for _, line := range strings.SplitAfter(s, "\n") {
+		if line != "" {
+			jr.emit(testEvent{Action: "output", Test: test, Output: line})
+		}
+	}
+}
+
+func (jr *jsonReporter) started(t *T) {
+	name := t.fullName()
+	jr.emit(testEvent{Action: "run", Test: name})
+	jr.output(name, fmt.Sprintf("=== RUN   %s\n", name))
+}
+
+func (jr *jsonReporter) logged(t *T, s string) {
+	jr.output(t.fullName(), indent(s, t.depth()))
+}
+
+func (jr *jsonReporter) finished(t *T) {
+	name := t.fullName()
+	jr.output(name, resultLine(t)+"\n")
+	jr.emit(testEvent{
+		Action:  strings.ToLower(result(t)),
+		Test:    name,
+		Elapsed: t.Duration().Seconds(),
+	})
+}
+
+func (jr *jsonReporter) summary(failed bool, elapsed time.Duration, timedOut error) {
+	if timedOut != nil {
+		jr.output("", fmt.Sprintf("panic: %v\n", timedOut))
+	}
+	action := "pass"
+	if failed {
+		action = "fail"
+	}
+	jr.output("", strings.ToUpper(action)+"\n")
+	jr.emit(testEvent{Action: action, Elapsed: elapsed.Seconds()})
+}
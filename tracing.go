@@ -0,0 +1,100 @@
+package e2e
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName is the instrumentation name of the spans created for tests
+const tracerName = "github.com/arussellsaw/e2e"
+
+// tracer returns the tracer for the Runner's TracerProvider, or the global provider if it isn't set
+func (r *Runner) tracer() trace.Tracer {
+	if r.TracerProvider != nil {
+		return r.TracerProvider.Tracer(tracerName)
+	}
+	return otel.Tracer(tracerName)
+}
+
+// startSpan starts the span for a test, and carries it in the test's context so
+// it's the parent of spans started by the test and its subtests
+func (t *T) startSpan() {
+	if t.tracer == nil {
+		t.tracer = otel.Tracer(tracerName)
+	}
+	ctx, span := t.tracer.Start(t.Context(), t.fullName(), trace.WithAttributes(
+		attribute.String("e2e.test", t.fullName()),
+	))
+	t.mu.Lock()
+	t.ctx = ctx
+	t.span = span
+	t.mu.Unlock()
+}
+
+// endSpan records the result of a finished test on its span
+func (t *T) endSpan() {
+	t.mu.RLock()
+	span, end, state := t.span, t.end, t.state()
+	t.mu.RUnlock()
+	if span == nil {
+		return
+	}
+	span.SetAttributes(attribute.String("e2e.state", string(state)))
+	if state == TestStateFailed {
+		span.SetStatus(codes.Error, "test failed")
+	}
+	span.End(trace.WithTimestamp(end))
+}
+
+// addSpanEvent adds a log record to the test's span as an event
+func (t *T) addSpanEvent(rec LogRecord) {
+	t.mu.RLock()
+	span := t.span
+	t.mu.RUnlock()
+	if span == nil {
+		return
+	}
+	attrs := []attribute.KeyValue{
+		attribute.String("log.severity", rec.Level.String()),
+	}
+	if rec.File != "" {
+		attrs = append(attrs,
+			attribute.String("code.filepath", rec.File),
+			attribute.Int("code.lineno", rec.Line),
+		)
+	}
+	for _, a := range rec.attrs {
+		attrs = append(attrs, attribute.String(a.Key, a.Value.String()))
+	}
+	span.AddEvent(rec.Message, trace.WithTimestamp(rec.Time), trace.WithAttributes(attrs...))
+}
+
+// startRunSpan starts the span for a scheduled run of a test, the span of each attempt is its child
+func (tr *testRunner) startRunSpan(ctx context.Context) (context.Context, trace.Span) {
+	if tr.tracer == nil {
+		tr.tracer = otel.Tracer(tracerName)
+	}
+	return tr.tracer.Start(ctx, "run "+tr.Name, trace.WithAttributes(
+		attribute.String("e2e.test", tr.Name),
+		attribute.String("e2e.owner", tr.Metadata.Owner),
+		attribute.String("e2e.severity", string(tr.Metadata.Severity)),
+		attribute.StringSlice("e2e.tags", tr.Metadata.Tags),
+	))
+}
+
+// endRunSpan records the result of a run on its span
+func endRunSpan(span trace.Span, rec RunRecord) {
+	span.SetAttributes(
+		attribute.String("e2e.state", string(rec.State)),
+		attribute.Int("e2e.attempts", len(rec.Attempts)),
+		attribute.Float64("e2e.queue_wait_seconds", rec.QueueWait.Seconds()),
+	)
+	if rec.State == TestStateFailed {
+		span.SetStatus(codes.Error, "test failed")
+	}
+	span.End()
+}
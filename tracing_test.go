@@ -0,0 +1,90 @@
+package e2e
+
+import (
+	"testing"
+	"time"
+
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// newInMemoryTracerProvider creates a TracerProvider which records spans in memory as
+// soon as they end, for inspecting the spans of test runs
+func newInMemoryTracerProvider() (*sdktrace.TracerProvider, *tracetest.InMemoryExporter) {
+	exp := tracetest.NewInMemoryExporter()
+	return sdktrace.NewTracerProvider(sdktrace.WithSyncer(exp)), exp
+}
+
+func TestTracing(t *testing.T) {
+	tp, exp := newInMemoryTracerProvider()
+	r := &Runner{TracerProvider: tp}
+	var childCtx trace.SpanContext
+	tr := &testRunner{
+		Name: "test",
+		t: func(t *T) {
+			t.Log("hello")
+			t.Run("child", func(t *T) {
+				childCtx = trace.SpanContextFromContext(t.Context())
+				t.Error("failed")
+			})
+		},
+		n:      noopNotifier{},
+		tracer: r.tracer(),
+	}
+	tr.runJob("")
+
+	spans := exp.GetSpans()
+	if len(spans) != 3 {
+		t.Fatalf("Expected 3 spans, got %d", len(spans))
+	}
+	child, test, run := spans[0], spans[1], spans[2]
+	if run.Name != "run test" || test.Name != "test" || child.Name != "test/child" {
+		t.Errorf("Unexpected span names %q %q %q", run.Name, test.Name, child.Name)
+	}
+	if test.Parent.SpanID() != run.SpanContext.SpanID() || child.Parent.SpanID() != test.SpanContext.SpanID() {
+		t.Error("Expected spans to be nested run > test > child")
+	}
+	if childCtx.SpanID() != child.SpanContext.SpanID() {
+		t.Error("Expected subtest context to carry its span")
+	}
+	for _, s := range spans {
+		if s.Status.Code != codes.Error {
+			t.Errorf("Expected span %q to have error status, got %v", s.Name, s.Status.Code)
+		}
+	}
+	if len(test.Events) != 1 || test.Events[0].Name != "hello" {
+		t.Errorf("Expected log event on test span, got %+v", test.Events)
+	}
+	if len(child.Events) != 1 || child.Events[0].Name != "failed" {
+		t.Errorf("Expected log event on child span, got %+v", child.Events)
+	}
+}
+
+func TestTracingTimeout(t *testing.T) {
+	tp, exp := newInMemoryTracerProvider()
+	r := &Runner{TracerProvider: tp}
+	tr := &testRunner{
+		Name: "test",
+		t: func(t *T) {
+			<-t.Context().Done()
+		},
+		n:       noopNotifier{},
+		tracer:  r.tracer(),
+		timeout: 10 * time.Millisecond,
+	}
+	tr.runJob("")
+
+	spans := exp.GetSpans()
+	if len(spans) != 2 {
+		t.Fatalf("Expected 2 spans, got %d", len(spans))
+	}
+	test := spans[0]
+	if test.Status.Code != codes.Error {
+		t.Errorf("Expected timed out test span to have error status, got %v", test.Status.Code)
+	}
+	if len(test.Events) != 1 || test.Events[0].Name != "test timed out after 10ms" {
+		t.Errorf("Expected timeout event on test span, got %+v", test.Events)
+	}
+}